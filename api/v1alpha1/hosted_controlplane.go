@@ -3,6 +3,9 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	configv1 "github.com/openshift/api/config/v1"
 )
 
 func init() {
@@ -31,12 +34,64 @@ type HostedControlPlaneSpec struct {
 	PodCIDR       string                      `json:"podCIDR"`
 	SSHKey        corev1.LocalObjectReference `json:"sshKey"`
 	ProviderCreds corev1.LocalObjectReference `json:"providerCreds"`
+	// +optional
+	Networking ClusterNetworking `json:"networking,omitempty"`
+	// +optional
+	Ingress IngressSpec `json:"ingress,omitempty"`
+	// +optional
+	Tunnel TunnelSpec `json:"tunnel,omitempty"`
+	// +optional
+	Services []ServicePublishingStrategyMapping `json:"services,omitempty"`
+	// +optional
+	EndpointAccess EndpointAccessType `json:"endpointAccess,omitempty"`
+	// +optional
+	APIDNSName string `json:"apiDNSName,omitempty"`
+	// +optional
+	OAuthDNSName string `json:"oauthDNSName,omitempty"`
+	// +optional
+	DNS DNSSpec `json:"dns,omitempty"`
+	// +optional
+	OAuth OAuthSpec `json:"oauth,omitempty"`
+	// KubeconfigSignerRotation is an opaque value. Changing it replaces the
+	// signer of HostedClusterKubeconfig client certificates, which revokes
+	// every kubeconfig it issued.
+	// +optional
+	KubeconfigSignerRotation string `json:"kubeconfigSignerRotation,omitempty"`
+	// +optional
+	Audit AuditSpec `json:"audit,omitempty"`
+	// +optional
+	SecretEncryption *SecretEncryptionSpec `json:"secretEncryption,omitempty"`
+	// +optional
+	FeatureGates configv1.FeatureGateSpec `json:"featureGates,omitempty"`
+	// +optional
+	Proxy configv1.ProxySpec `json:"proxy,omitempty"`
+	// +optional
+	ImageContentSources []ImageContentSource `json:"imageContentSources,omitempty"`
+	// +optional
+	ComponentImageOverrides map[string]string `json:"componentImageOverrides,omitempty"`
+	// +optional
+	ControlPlaneResources *ControlPlaneResources `json:"controlPlaneResources,omitempty"`
+	// +optional
+	ControlPlaneScheduling *ControlPlaneScheduling `json:"controlPlaneScheduling,omitempty"`
 }
 
 type ConditionType string
 
 const (
 	Available ConditionType = "Available"
+	// Unsupported is true when the control plane runs a configuration that
+	// is not supported, such as overridden component images.
+	Unsupported ConditionType = "Unsupported"
+	// UnsupportedRelease is true when the release image of the control plane
+	// is outside of the range of versions the control plane operator supports.
+	UnsupportedRelease ConditionType = "UnsupportedRelease"
+	// Drifted is true when objects applied by the control plane operator no
+	// longer match the state it applied.
+	Drifted ConditionType = "Drifted"
+	// UnmirroredImages is true when the control plane runs images that are
+	// referenced by tag while image content sources are set. They are pulled
+	// from their source rather than from its mirrors.
+	UnmirroredImages ConditionType = "UnmirroredImages"
 )
 
 type ConditionStatus string
@@ -94,12 +149,46 @@ type HostedControlPlaneStatus struct {
 	// for this control plane.
 	KubeConfig *corev1.LocalObjectReference `json:"kubeConfig,omitempty"`
 
+	// IngressEndpoint is the address of the load balancer in front of the
+	// guest's default ingress controller. It is only populated when the
+	// ingress strategy is GuestLoadBalancer, and is the target of the
+	// cluster's *.apps DNS record that the control plane operator publishes
+	// when it has a DNS provider.
+	// +kubebuilder:validation:Optional
+	IngressEndpoint string `json:"ingressEndpoint,omitempty"`
+
+	// AppliedObjects lists the objects applied by the control plane operator,
+	// which are applied again on every reconcile to repair drift from their
+	// rendered state.
+	// +kubebuilder:validation:Optional
+	AppliedObjects []AppliedObject `json:"appliedObjects,omitempty"`
+
 	// Condition contains details for one aspect of the current state of the HostedControlPlane.
-	// Current condition types are: "Available"
+	// Current condition types are: "Available", "Unsupported", "UnsupportedRelease", "Drifted"
 	// +kubebuilder:validation:Required
 	Conditions []HostedControlPlaneCondition `json:"conditions"`
 }
 
+// AppliedObject is an object applied by the control plane operator.
+type AppliedObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+
+	// UID is the UID of the object when it was last applied, which tells
+	// whether the object was removed and created again since.
+	// +optional
+	UID types.UID `json:"uid,omitempty"`
+
+	// Drifted is true when another manager changed fields of the object that
+	// the control plane operator applies, or the object was removed, before
+	// it was last applied.
+	// +optional
+	Drifted bool `json:"drifted,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // HostedControlPlaneList contains a list of HostedControlPlanes.
 type HostedControlPlaneList struct {
@@ -44,8 +44,610 @@ type HostedClusterSpec struct {
 
 	ProviderCreds corev1.LocalObjectReference `json:"providerCreds"`
 
+	// ServiceCIDR is the service network of the guest cluster. A dual-stack
+	// cluster has a comma separated IPv4 and IPv6 network, like
+	// 172.31.0.0/16,fd02::/112. The networks of the guest cluster can't be
+	// changed after the cluster is created.
 	ServiceCIDR string `json:"serviceCIDR"`
-	PodCIDR     string `json:"podCIDR"`
+	// PodCIDR is the pod network of the guest cluster. A dual-stack cluster
+	// has a comma separated IPv4 and IPv6 network, in the same order as the
+	// service network.
+	PodCIDR string `json:"podCIDR"`
+
+	// Networking specifies the network configuration of the guest cluster
+	// beyond the service and pod networks. The service, pod and machine
+	// networks must not overlap.
+	// +optional
+	Networking ClusterNetworking `json:"networking,omitempty"`
+
+	// Ingress specifies how application ingress traffic reaches the guest
+	// cluster's workers.
+	// +optional
+	Ingress IngressSpec `json:"ingress,omitempty"`
+
+	// Tunnel specifies how the API server reaches kubelets, pods and services
+	// in the guest cluster.
+	// +optional
+	Tunnel TunnelSpec `json:"tunnel,omitempty"`
+
+	// Services specifies how individual control plane services are published
+	// outside of the management cluster. Services without an entry use their
+	// default publishing strategy.
+	// +optional
+	Services []ServicePublishingStrategyMapping `json:"services,omitempty"`
+
+	// EndpointAccess specifies whether the control plane endpoints are
+	// reachable from the internet, from the private network only, or both.
+	// Private endpoints are published on internal load balancers, which are
+	// supported on AWS, Azure, GCP, IBMCloud and OpenStack management
+	// clusters. Services published with a Route are served by the shared
+	// router of the management cluster and stay reachable wherever that
+	// router is, so the Ignition and Konnectivity services can't be published
+	// with a Route unless endpoint access is Public.
+	// +kubebuilder:validation:Enum=Public;PublicAndPrivate;Private
+	// +kubebuilder:default=Public
+	// +optional
+	EndpointAccess EndpointAccessType `json:"endpointAccess,omitempty"`
+
+	// APIDNSName is a stable DNS name for the API server, for example
+	// api.<cluster>.<baseDomain>. When set, the name is used in certificates
+	// and kubeconfigs in place of the load balancer address once a DNS record
+	// pointing at the API server endpoint resolves. Changing the name reissues
+	// the certificates and kubeconfigs that include it.
+	// +optional
+	APIDNSName string `json:"apiDNSName,omitempty"`
+
+	// OAuthDNSName is a stable DNS name for the OAuth server, for example
+	// oauth.<cluster>.<baseDomain>. It is handled like APIDNSName.
+	// +optional
+	OAuthDNSName string `json:"oauthDNSName,omitempty"`
+
+	// DNS specifies the DNS configuration of the hosted cluster. When unset,
+	// the base domain is derived from the management cluster's base domain.
+	// +optional
+	DNS DNSSpec `json:"dns,omitempty"`
+
+	// OAuth configures the OAuth server of the hosted cluster.
+	// +optional
+	OAuth OAuthSpec `json:"oauth,omitempty"`
+
+	// Audit configures audit logging of the hosted cluster API servers.
+	// +optional
+	Audit AuditSpec `json:"audit,omitempty"`
+
+	// SecretEncryption configures encryption of secrets at rest in the etcd
+	// of the hosted cluster. Secrets are stored in plaintext when unset. It
+	// can't be changed after the cluster is created, except for rotating
+	// aescbc keys.
+	// +optional
+	SecretEncryption *SecretEncryptionSpec `json:"secretEncryption,omitempty"`
+
+	// FeatureGates selects the feature set of the hosted cluster or the
+	// individual feature gates of the CustomNoUpgrade feature set. They are
+	// applied to the control plane components, the kubelets and the FeatureGate
+	// cluster configuration. Feature sets other than the default prevent
+	// upgrades, so they can't be changed after the cluster is created.
+	// +optional
+	FeatureGates configv1.FeatureGateSpec `json:"featureGates,omitempty"`
+
+	// Proxy configures the egress proxy of the hosted cluster. It is applied
+	// to the guest Proxy cluster configuration, the workers, the control plane
+	// components that reach outside the management cluster, and the cluster
+	// api provider. TrustedCA references a ConfigMap in the namespace of the
+	// HostedCluster with the PEM encoded bundle of additional trusted CAs
+	// under the ca-bundle.crt key, which is trusted by the guest cluster, its
+	// workers and the OAuth server. ReadinessEndpoints are ignored.
+	// +optional
+	Proxy configv1.ProxySpec `json:"proxy,omitempty"`
+
+	// ImageContentSources lists mirrors of the repositories of the release
+	// image and its component images, for management and guest clusters
+	// without access to the source repositories. The control plane pulls
+	// images by digest from the first mirror of their repository, and guest
+	// workers are configured to try the mirrors in order. The release image
+	// must be referenced by digest when image content sources are set.
+	// +optional
+	ImageContentSources []ImageContentSource `json:"imageContentSources,omitempty"`
+
+	// ComponentImageOverrides maps release component names to images that
+	// replace the images the release image ships for them. Clusters running
+	// overridden components are not supported, overrides are only meant for
+	// testing fixes and development builds of components. Changing them rolls
+	// out the components running the affected images.
+	// +optional
+	ComponentImageOverrides map[string]string `json:"componentImageOverrides,omitempty"`
+
+	// ControlPlaneResources sets the compute resources of the control plane
+	// components. Changing them rolls out the affected components.
+	// +optional
+	ControlPlaneResources *ControlPlaneResources `json:"controlPlaneResources,omitempty"`
+
+	// ControlPlaneScheduling constrains the management cluster nodes the pods
+	// of the control plane run on, for instance to nodes dedicated to hosted
+	// control planes.
+	// +optional
+	ControlPlaneScheduling *ControlPlaneScheduling `json:"controlPlaneScheduling,omitempty"`
+}
+
+// ControlPlaneScheduling specifies how the pods of a control plane are
+// scheduled on the management cluster.
+type ControlPlaneScheduling struct {
+	// NodeSelector is added to the node selector of the control plane pods.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations are added to the tolerations of the control plane pods.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// PriorityClassName is the priority class of the control plane pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// SpreadAcrossZones spreads the replicas of each control plane component
+	// evenly across the zones of the management cluster nodes, as far as
+	// the nodes allow.
+	// +optional
+	SpreadAcrossZones bool `json:"spreadAcrossZones,omitempty"`
+}
+
+// ControlPlaneSize is a preset of compute resources for the control plane
+// components, sized for the number of workers and workloads of the guest.
+// +kubebuilder:validation:Enum=Small;Medium;Large
+type ControlPlaneSize string
+
+const (
+	// SmallControlPlane fits guests of a few workers.
+	SmallControlPlane ControlPlaneSize = "Small"
+
+	// MediumControlPlane fits guests of up to a few dozen workers.
+	MediumControlPlane ControlPlaneSize = "Medium"
+
+	// LargeControlPlane fits guests of up to a few hundred workers.
+	LargeControlPlane ControlPlaneSize = "Large"
+)
+
+// ControlPlaneResources specifies the compute resources of the control plane
+// components. Resources of a component replace those of the size preset.
+type ControlPlaneResources struct {
+	// Size is the preset the resources of components without their own
+	// resources come from. Components keep the resources of their manifests
+	// when neither is set.
+	// +optional
+	Size ControlPlaneSize `json:"size,omitempty"`
+
+	// +optional
+	KubeAPIServer *corev1.ResourceRequirements `json:"kubeAPIServer,omitempty"`
+	// +optional
+	KubeControllerManager *corev1.ResourceRequirements `json:"kubeControllerManager,omitempty"`
+	// +optional
+	KubeScheduler *corev1.ResourceRequirements `json:"kubeScheduler,omitempty"`
+	// +optional
+	OpenShiftAPIServer *corev1.ResourceRequirements `json:"openShiftAPIServer,omitempty"`
+	// +optional
+	OpenShiftControllerManager *corev1.ResourceRequirements `json:"openShiftControllerManager,omitempty"`
+	// +optional
+	ClusterPolicyController *corev1.ResourceRequirements `json:"clusterPolicyController,omitempty"`
+	// +optional
+	OAuthServer *corev1.ResourceRequirements `json:"oauthServer,omitempty"`
+	// +optional
+	ClusterVersionOperator *corev1.ResourceRequirements `json:"clusterVersionOperator,omitempty"`
+	// +optional
+	HostedClusterConfigOperator *corev1.ResourceRequirements `json:"hostedClusterConfigOperator,omitempty"`
+}
+
+// ImageContentSource specifies mirrors of a repository.
+type ImageContentSource struct {
+	// Source is the repository images are referred to by, like
+	// quay.io/openshift-release-dev/ocp-v4.0-art-dev. It also matches the
+	// repositories nested under it.
+	Source string `json:"source"`
+
+	// Mirrors are repositories with the same images as the source, in order
+	// of preference.
+	// +optional
+	Mirrors []string `json:"mirrors,omitempty"`
+}
+
+// NetworkType is the network plugin of a guest cluster.
+// +kubebuilder:validation:Enum=OpenShiftSDN;OVNKubernetes;Other
+type NetworkType string
+
+const (
+	// OpenShiftSDN is the OpenShift SDN network plugin.
+	OpenShiftSDN NetworkType = "OpenShiftSDN"
+
+	// OVNKubernetes is the OVN-Kubernetes network plugin.
+	OVNKubernetes NetworkType = "OVNKubernetes"
+
+	// OtherNetworkType leaves the network plugin to be installed by the user.
+	OtherNetworkType NetworkType = "Other"
+)
+
+// ClusterNetworking specifies the network configuration of a guest cluster.
+type ClusterNetworking struct {
+	// NetworkType is the network plugin to install.
+	// +kubebuilder:default=OpenShiftSDN
+	// +optional
+	NetworkType NetworkType `json:"networkType,omitempty"`
+
+	// MachineCIDR is the network the guest workers have their addresses in.
+	// +kubebuilder:default="10.0.0.0/16"
+	// +optional
+	MachineCIDR string `json:"machineCIDR,omitempty"`
+
+	// HostPrefix is the prefix length of the subnet of the IPv4 pod network
+	// that is assigned to each worker. Workers are assigned a /64 subnet of an
+	// IPv6 pod network.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:default=23
+	// +optional
+	HostPrefix int32 `json:"hostPrefix,omitempty"`
+
+	// MTU is the MTU of the pod network. It is detected by the network plugin
+	// when unset, and can only be set for the OpenShiftSDN and OVNKubernetes
+	// network types.
+	// +kubebuilder:validation:Minimum=576
+	// +kubebuilder:validation:Maximum=9216
+	// +optional
+	MTU int32 `json:"mtu,omitempty"`
+
+	// APIServer specifies how guest workers reach the kube-apiserver.
+	// +optional
+	APIServer *APIServerNetworking `json:"apiServer,omitempty"`
+}
+
+// APIServerNetworking specifies how guest workers reach the kube-apiserver.
+type APIServerNetworking struct {
+	// AdvertiseAddress is the node-local address that a proxy on every worker
+	// listens on for the kube-apiserver, and that the kube-apiserver
+	// advertises to the guest cluster. It must not be in any of the cluster
+	// networks. It defaults to 172.20.0.1, or to fd00::1 when the first
+	// service network is an IPv6 network.
+	// +optional
+	AdvertiseAddress string `json:"advertiseAddress,omitempty"`
+}
+
+// SecretEncryptionType is a way of encrypting secrets at rest.
+// +kubebuilder:validation:Enum=aescbc;kms
+type SecretEncryptionType string
+
+const (
+	// AESCBCSecretEncryptionType encrypts secrets with AES-CBC keys that are
+	// generated and stored by the control plane operator.
+	AESCBCSecretEncryptionType SecretEncryptionType = "aescbc"
+
+	// KMSSecretEncryptionType encrypts secrets with data encryption keys that
+	// are wrapped by an external key management service through a KMS v1
+	// plugin.
+	KMSSecretEncryptionType SecretEncryptionType = "kms"
+)
+
+// SecretEncryptionSpec configures encryption of secrets at rest.
+type SecretEncryptionSpec struct {
+	// Type is the way secrets are encrypted.
+	Type SecretEncryptionType `json:"type"`
+
+	// AESCBC configures the aescbc encryption type.
+	// +optional
+	AESCBC *AESCBCSpec `json:"aescbc,omitempty"`
+
+	// KMS configures the kms encryption type. It is required by the kms type.
+	// +optional
+	KMS *KMSSpec `json:"kms,omitempty"`
+}
+
+// AESCBCSpec configures encryption with AES-CBC keys managed by the control
+// plane operator.
+type AESCBCSpec struct {
+	// KeyRotation is an opaque value. Changing it generates a new key that
+	// encrypts secrets written from then on. Existing secrets are then
+	// rewritten with the new key, after which the previous keys are removed.
+	// +optional
+	KeyRotation string `json:"keyRotation,omitempty"`
+}
+
+// KMSSpec configures a KMS v1 plugin that runs next to the kube-apiserver.
+type KMSSpec struct {
+	// Image is the image of the KMS plugin. The plugin must serve the KMS v1
+	// API on the /var/run/kmsplugin/socket.sock unix socket and read its
+	// configuration from the KP_INFO, KP_REGION and KP_API_KEY environment
+	// variables.
+	Image string `json:"image"`
+
+	// Region is the region of the key management service.
+	Region string `json:"region"`
+
+	// KeyInfo identifies the root key that wraps data encryption keys. Its
+	// format is defined by the plugin.
+	KeyInfo string `json:"keyInfo"`
+
+	// Credentials references a Secret with the API key of the key management
+	// service under the apiKey key. It must be in the namespace of the
+	// HostedCluster.
+	Credentials corev1.LocalObjectReference `json:"credentials"`
+}
+
+// AuditProfileType is a set of audit policy rules.
+// +kubebuilder:validation:Enum=Default;WriteRequestBodies;AllRequestBodies;Custom
+type AuditProfileType string
+
+const (
+	// DefaultAuditProfileType logs the metadata of all requests except
+	// events, OAuth tokens and health checks.
+	DefaultAuditProfileType AuditProfileType = "Default"
+
+	// WriteRequestBodiesAuditProfileType additionally logs the request and
+	// response bodies of write requests.
+	WriteRequestBodiesAuditProfileType AuditProfileType = "WriteRequestBodies"
+
+	// AllRequestBodiesAuditProfileType additionally logs the request and
+	// response bodies of all requests.
+	AllRequestBodiesAuditProfileType AuditProfileType = "AllRequestBodies"
+
+	// CustomAuditProfileType uses a user provided audit policy.
+	CustomAuditProfileType AuditProfileType = "Custom"
+)
+
+// AuditSpec configures audit logging of the hosted cluster API servers.
+// Secrets and ConfigMaps referenced by it must be in the namespace of the
+// HostedCluster.
+type AuditSpec struct {
+	// Profile is the audit policy applied to the kube-apiserver and the
+	// oauth-apiserver. Bodies of secrets and OAuth tokens are never logged by
+	// the built-in profiles.
+	// +kubebuilder:default=Default
+	// +optional
+	Profile AuditProfileType `json:"profile,omitempty"`
+
+	// CustomPolicy references a ConfigMap with the audit policy to apply under
+	// the policy.yaml key. It is required by the Custom profile.
+	// +optional
+	CustomPolicy *corev1.LocalObjectReference `json:"customPolicy,omitempty"`
+
+	// Webhook sends audit events to a remote backend in addition to the audit
+	// log.
+	// +optional
+	Webhook *AuditWebhookSpec `json:"webhook,omitempty"`
+
+	// LogRetention configures the rotation of the audit log files.
+	// +optional
+	LogRetention AuditLogRetentionSpec `json:"logRetention,omitempty"`
+}
+
+// AuditWebhookSpec configures an audit webhook backend.
+type AuditWebhookSpec struct {
+	// KubeconfigSecret references a Secret with a kubeconfig for the webhook
+	// backend under the kubeconfig key. The current context of the kubeconfig
+	// selects the destination.
+	KubeconfigSecret corev1.LocalObjectReference `json:"kubeconfigSecret"`
+
+	// Mode is the strategy for sending audit events. Blocking modes slow down
+	// API requests when the backend is slow.
+	// +kubebuilder:validation:Enum=batch;blocking;blocking-strict
+	// +kubebuilder:default=batch
+	// +optional
+	Mode string `json:"mode,omitempty"`
+}
+
+// AuditLogRetentionSpec configures the rotation of audit log files.
+type AuditLogRetentionSpec struct {
+	// MaxSize is the size in megabytes at which an audit log file is rotated.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=100
+	// +optional
+	MaxSize int32 `json:"maxSize,omitempty"`
+
+	// MaxBackups is the number of rotated audit log files to keep.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=10
+	// +optional
+	MaxBackups int32 `json:"maxBackups,omitempty"`
+
+	// MaxAge is the number of days to keep rotated audit log files. Files are
+	// kept regardless of age when unset.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxAge int32 `json:"maxAge,omitempty"`
+}
+
+// OAuthSpec configures the OAuth server of a hosted cluster.
+type OAuthSpec struct {
+	// IdentityProviders is an ordered list of ways for a user to identify
+	// themselves. The HTPasswd, LDAP, OpenID and GitHub identity provider types
+	// are supported. Secrets and ConfigMaps referenced by an identity provider
+	// must be in the namespace of the HostedCluster.
+	// +optional
+	IdentityProviders []configv1.IdentityProvider `json:"identityProviders,omitempty"`
+
+	// Kubeadmin configures the generated kubeadmin user.
+	// +optional
+	Kubeadmin KubeadminSpec `json:"kubeadmin,omitempty"`
+}
+
+// KubeadminSpec configures the generated kubeadmin user of a hosted cluster.
+type KubeadminSpec struct {
+	// Disabled removes the kubeadmin user from the hosted cluster. It can only
+	// be set when at least one identity provider is configured.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// RotationTrigger rotates the kubeadmin password whenever it is changed to
+	// a new value, for example a timestamp.
+	// +optional
+	RotationTrigger string `json:"rotationTrigger,omitempty"`
+}
+
+// DNSSpec specifies the DNS configuration of a hosted cluster.
+type DNSSpec struct {
+	// BaseDomain is the base domain of the hosted cluster. Cluster DNS names
+	// are subdomains of <name>.<baseDomain>, for example apps.<name>.<baseDomain>
+	// for application ingress. When empty, the base domain of the management
+	// cluster is used.
+	// +optional
+	BaseDomain string `json:"baseDomain,omitempty"`
+
+	// PublicZoneID is the ID of the public DNS zone for the base domain, in
+	// which the guest cluster publishes public ingress records.
+	// +optional
+	PublicZoneID string `json:"publicZoneID,omitempty"`
+
+	// PrivateZoneID is the ID of the private DNS zone for the base domain, in
+	// which the guest cluster publishes internal records.
+	// +optional
+	PrivateZoneID string `json:"privateZoneID,omitempty"`
+}
+
+// EndpointAccessType is the network reachability of the control plane
+// endpoints.
+type EndpointAccessType string
+
+const (
+	// Public publishes control plane endpoints on internet-facing load
+	// balancers.
+	Public EndpointAccessType = "Public"
+
+	// PublicAndPrivate keeps the public endpoints for clients and adds
+	// internal load balancers that guest workers use to reach the API server
+	// and the ignition provider.
+	PublicAndPrivate EndpointAccessType = "PublicAndPrivate"
+
+	// Private publishes control plane endpoints on internal load balancers
+	// only.
+	Private EndpointAccessType = "Private"
+)
+
+// IngressStrategyType is a way of routing application ingress traffic to the
+// guest cluster's workers.
+type IngressStrategyType string
+
+const (
+	// ManagementRouterShard serves guest routes from a dedicated router shard
+	// on the management cluster. Guest routes are mirrored as management
+	// cluster routes and proxied to the guest's default ingress controller
+	// through the OpenVPN tunnel, so it can't be used with the Konnectivity
+	// tunnel.
+	ManagementRouterShard IngressStrategyType = "ManagementRouterShard"
+
+	// GuestLoadBalancer publishes the guest's default ingress controller
+	// through its own cloud load balancer. No routes are mirrored to the
+	// management cluster and *.apps DNS points directly at that load balancer.
+	GuestLoadBalancer IngressStrategyType = "GuestLoadBalancer"
+)
+
+type IngressSpec struct {
+	// Strategy is the ingress strategy used by the hosted cluster. When
+	// omitted, it is GuestLoadBalancer with the Konnectivity tunnel and
+	// ManagementRouterShard otherwise.
+	// +kubebuilder:validation:Enum=ManagementRouterShard;GuestLoadBalancer
+	// +optional
+	Strategy IngressStrategyType `json:"strategy,omitempty"`
+}
+
+// TunnelType is a way of connecting the API server to the guest cluster's
+// network.
+type TunnelType string
+
+const (
+	// OpenVPNTunnel routes API server traffic to the guest through an OpenVPN
+	// server exposed by a UDP load balancer. The VPN server and clients run as
+	// privileged pods.
+	OpenVPNTunnel TunnelType = "OpenVPN"
+
+	// KonnectivityTunnel routes API server traffic to the guest through the
+	// apiserver-network-proxy (konnectivity) egress selector. Agents in the
+	// guest dial out to the proxy server through a management cluster route,
+	// so no dedicated load balancer or privileged pods are needed. Guest
+	// routes can't be proxied from a management router shard without the
+	// OpenVPN tunnel, so it requires the GuestLoadBalancer ingress strategy,
+	// which is the default with this tunnel.
+	KonnectivityTunnel TunnelType = "Konnectivity"
+)
+
+type TunnelSpec struct {
+	// Type is the tunnel used by the hosted cluster.
+	// +kubebuilder:validation:Enum=OpenVPN;Konnectivity
+	// +kubebuilder:default=OpenVPN
+	// +optional
+	Type TunnelType `json:"type,omitempty"`
+}
+
+// ServiceType is a control plane service published outside of the management
+// cluster.
+// +kubebuilder:validation:Enum=APIServer;OAuthServer;VPN;Konnectivity;Ignition
+type ServiceType string
+
+const (
+	// APIServer is the kube-apiserver. Published with a LoadBalancer by default
+	// and cannot be published with a Route, because the API server proxy on
+	// guest workers connects without SNI.
+	APIServer ServiceType = "APIServer"
+
+	// OAuthServer is the oauth server. Published with a LoadBalancer by default.
+	OAuthServer ServiceType = "OAuthServer"
+
+	// VPN is the OpenVPN server. Published with a LoadBalancer by default and
+	// cannot be published with a Route.
+	VPN ServiceType = "VPN"
+
+	// Konnectivity is the konnectivity server agent endpoint. Published with a
+	// Route by default.
+	Konnectivity ServiceType = "Konnectivity"
+
+	// Ignition is the ignition provider workers fetch their configuration
+	// from. Published with a Route by default.
+	Ignition ServiceType = "Ignition"
+)
+
+// PublishingStrategyType is a way of exposing a service outside of the
+// management cluster.
+// +kubebuilder:validation:Enum=LoadBalancer;NodePort;Route
+type PublishingStrategyType string
+
+const (
+	// LoadBalancer exposes the service through a cloud load balancer.
+	LoadBalancer PublishingStrategyType = "LoadBalancer"
+
+	// NodePort exposes the service on a port of the management cluster nodes,
+	// reachable at a user provided address.
+	NodePort PublishingStrategyType = "NodePort"
+
+	// Route exposes the service through a management cluster route.
+	Route PublishingStrategyType = "Route"
+)
+
+// ServicePublishingStrategyMapping specifies how a control plane service is
+// published.
+type ServicePublishingStrategyMapping struct {
+	// Service is the control plane service being published.
+	// +kubebuilder:validation:Required
+	Service ServiceType `json:"service"`
+
+	ServicePublishingStrategy `json:",inline"`
+}
+
+type ServicePublishingStrategy struct {
+	// Type is the publishing strategy used for the service.
+	// +kubebuilder:validation:Required
+	Type PublishingStrategyType `json:"type"`
+
+	// NodePort configures the NodePort publishing strategy. It is required
+	// when Type is NodePort.
+	// +optional
+	NodePort *NodePortPublishingStrategy `json:"nodePort,omitempty"`
+}
+
+type NodePortPublishingStrategy struct {
+	// Address is the host name or IP address at which the management cluster
+	// nodes are reachable for the service.
+	// +kubebuilder:validation:Required
+	Address string `json:"address"`
+
+	// Port is the node port to use for the service. If not set, a port is
+	// allocated by the management cluster.
+	// +optional
+	Port int32 `json:"port,omitempty"`
 }
 
 type Release struct {
@@ -69,6 +671,18 @@ type HostedClusterStatus struct {
 	// for the cluster.
 	// +optional
 	KubeConfig *corev1.LocalObjectReference `json:"kubeconfig,omitempty"`
+
+	// IngressEndpoint is the address of the load balancer in front of the
+	// guest's default ingress controller, which the *.apps DNS records of the
+	// cluster point at. It is only populated when the ingress strategy is
+	// GuestLoadBalancer.
+	// +optional
+	IngressEndpoint string `json:"ingressEndpoint,omitempty"`
+
+	// Conditions reports the conditions of the hosted control plane of the
+	// cluster. Current condition types are: "Unsupported", "UnsupportedRelease"
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // ClusterVersionStatus reports the status of the cluster versioning,
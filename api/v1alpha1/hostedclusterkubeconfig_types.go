@@ -0,0 +1,101 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	HostedClusterKubeconfigIssuedConditionType  = "Issued"
+	HostedClusterKubeconfigRevokedConditionType = "Revoked"
+	HostedClusterKubeconfigExpiredConditionType = "Expired"
+
+	HostedClusterKubeconfigAsExpectedReason         = "AsExpected"
+	HostedClusterKubeconfigRevokedReason            = "Revoked"
+	HostedClusterKubeconfigSignerNotReadyReason     = "SignerNotReady"
+	HostedClusterKubeconfigSignerRotatingReason     = "SignerRotating"
+	HostedClusterKubeconfigSignerRotatedReason      = "SignerRotated"
+	HostedClusterKubeconfigCertificateExpiredReason = "CertificateExpired"
+)
+
+func init() {
+	SchemeBuilder.Register(&HostedClusterKubeconfig{})
+	SchemeBuilder.Register(&HostedClusterKubeconfigList{})
+}
+
+// HostedClusterKubeconfig requests a short-lived kubeconfig for a hosted
+// cluster. The client certificate is signed by the hosted cluster's
+// kubeconfig signer and written to a secret in the same namespace. Every
+// kubeconfig of a hosted cluster is signed by the same signer, so they can
+// only be revoked together.
+// +kubebuilder:resource:path=hostedclusterkubeconfigs,shortName=hckc;hckcs,scope=Namespaced
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.hostedCluster",description="Hosted cluster"
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.user",description="User name of the client certificate"
+// +kubebuilder:printcolumn:name="Expires",type="string",JSONPath=".status.expirationTime",description="Expiration time of the client certificate"
+type HostedClusterKubeconfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HostedClusterKubeconfigSpec   `json:"spec,omitempty"`
+	Status HostedClusterKubeconfigStatus `json:"status,omitempty"`
+}
+
+// HostedClusterKubeconfigSpec defines the desired state of HostedClusterKubeconfig
+type HostedClusterKubeconfigSpec struct {
+	// HostedCluster is the name of the HostedCluster in the same namespace
+	// the kubeconfig is issued for.
+	// +kubebuilder:validation:MinLength=1
+	HostedCluster string `json:"hostedCluster"`
+
+	// User is the user name of the client certificate.
+	// +kubebuilder:validation:MinLength=1
+	User string `json:"user"`
+
+	// Groups are the groups of the client certificate.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+
+	// Validity is how long the client certificate is valid for.
+	// +kubebuilder:default="24h"
+	// +optional
+	Validity metav1.Duration `json:"validity,omitempty"`
+
+	// Revoked revokes the issued kubeconfig by rotating the hosted cluster's
+	// kubeconfig signer. Client certificates can't be revoked individually,
+	// so this invalidates every kubeconfig issued by that signer, including
+	// those of other users. The other unexpired kubeconfigs of the cluster
+	// are re-issued with the new signer, and clients using them must read
+	// them again from their secrets.
+	// +optional
+	Revoked bool `json:"revoked,omitempty"`
+}
+
+// HostedClusterKubeconfigStatus defines the observed state of HostedClusterKubeconfig
+type HostedClusterKubeconfigStatus struct {
+	// KubeConfig is a reference to the secret containing the issued kubeconfig.
+	// +optional
+	KubeConfig *corev1.LocalObjectReference `json:"kubeConfig,omitempty"`
+
+	// ExpirationTime is when the issued client certificate expires.
+	// +optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
+	// SignerFingerprint is the SHA-256 fingerprint of the signer that issued
+	// the client certificate.
+	// +optional
+	SignerFingerprint string `json:"signerFingerprint,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// HostedClusterKubeconfigList contains a list of HostedClusterKubeconfigs.
+type HostedClusterKubeconfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HostedClusterKubeconfig `json:"items"`
+}
@@ -12,8 +12,9 @@
 // cmd/install/assets/cluster-api/infrastructure.cluster.x-k8s.io_awsmanagedclusters.yaml (4.268kB)
 // cmd/install/assets/cluster-api/infrastructure.cluster.x-k8s.io_awsmanagedmachinepools.yaml (10.107kB)
 // cmd/install/assets/hypershift-operator/hypershift.openshift.io_externalinfraclusters.yaml (2.916kB)
-// cmd/install/assets/hypershift-operator/hypershift.openshift.io_hostedclusters.yaml (8.487kB)
-// cmd/install/assets/hypershift-operator/hypershift.openshift.io_hostedcontrolplanes.yaml (6.73kB)
+// cmd/install/assets/hypershift-operator/hypershift.openshift.io_hostedclusterkubeconfigs.yaml (8.177kB)
+// cmd/install/assets/hypershift-operator/hypershift.openshift.io_hostedclusters.yaml (79.857kB)
+// cmd/install/assets/hypershift-operator/hypershift.openshift.io_hostedcontrolplanes.yaml (72.207kB)
 // cmd/install/assets/hypershift-operator/hypershift.openshift.io_nodepools.yaml (8.747kB)
 
 package assets
@@ -83,7 +84,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _clusterApiClusterXK8sIo_clustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5f\x73\xdb\x36\x12\x7f\xf7\xa7\xd8\x49\x1f\xd2\xce\x58\x94\xe3\xa4\xed\x8d\x66\xee\x21\x97\x34\x1d\xdf\x35\x89\xc6\x76\xf3\xd2\xe9\xc3\x8a\x58\x8a\xa8\x41\x80\x07\x80\x72\xd4\x4e\xbf\xfb\xcd\x02\x20\x45\xc9\xd4\x9f\xb8\xd7\xde\x5d\x8f\x7c\x49\x04\x82\xc0\xfe\xdf\x1f\x80\xc5\x18\x6b\xf9\x81\xac\x93\x46\xcf\x00\x6b\x49\x1f\x3d\x69\xfe\xe5\xb2\xbb\xbf\xb8\x4c\x9a\xe9\xea\xd9\xd9\x9d\xd4\x62\x06\xaf\x1a\xe7\x4d\x75\x4d\xce\x34\x36\xa7\xd7\x54\x48\x2d\xbd\x34\xfa\xac\x22\x8f\x02\x3d\xce\xce\x00\x50\x6b\xe3\x91\x9b\x1d\xff\x04\xc8\x8d\xf6\xd6\x28\x45\x76\xb2\x24\x9d\xdd\x35\x0b\x5a\x34\x52\x09\xb2\x61\xf0\x76\xea\xd5\x45\xf6\x22\x7b\x36\xb9\xc8\x2e\x2f\x2e\x2f\x9e\x5d\x5c\x5c\x5e\x5c\x5c\x7c\x7d\x79\x31\xf9\xf2\xeb\xcb\x2f\x2f\x10\xf3\x67\x5f\x17\x5f\x9d\x01\xe4\x96\xc2\xe0\xb7\xb2\x22\xe7\xb1\xaa\x67\xa0\x1b\xa5\xce\x00\x34\x56\x34\x83\x5c\x35\xce\x93\x75\x59\xfa\x4f\xf6\x71\x12\xd9\x38\x73\x35\xe5\x4c\xd1\xd2\x9a\xa6\xee\x3a\x6e\xde\xc7\x11\x5a\xa2\xd1\xd3\xd2\x58\xd9\xfe\x9e\xb4\xfd\x27\x58\xcb\xd0\x92\x44\x12\x5b\x43\x8b\x92\xce\xff\xa3\xdf\xfa\x9d\x74\x3e\xbc\xa9\x55\x63\x51\x6d\x88\x0b\x8d\xae\x34\xd6\xbf\xdb\x4c\xc9\x53\xc4\x17\x52\x2f\x1b\x85\xb6\xeb\x7f\x06\xe0\x72\x53\xd3\x0c\x42\xf7\x1a\x73\x12\x67\x00\x49\x74\xe1\xf3\x09\xa0\x10\x41\x19\xa8\xe6\x56\x6a\x4f\xf6\x95\x51\x4d\xa5\xbb\xc1\x05\xb9\xdc\xca\xda\x07\x61\x27\x02\xc1\x79\xf4\x8d\x03\xd7\xe4\x25\xa0\x83\x39\x69\x21\xf5\x72\x3a\xb7\x66\x25\x79\xec\xad\x1f\x24\xa6\xaf\x49\x91\xe7\xc6\x37\x28\x55\x20\x82\x9f\x9f\x9c\xd1\x73\xf4\xe5\x0c\xb2\x38\x60\x56\x97\xe8\x28\xbd\x8d\x7a\x99\xf7\x5a\xfc\x9a\x79\x71\xde\x4a\xbd\x3c\xdb\x74\x59\x3d\x43\x55\x97\xf8\x3c\x0a\x21\x2f\xa9\xc2\x59\xfa\xc2\xd4\xa4\x5f\xce\xaf\x3e\x3c\xbf\xd9\x6a\x86\x61\xae\xa4\x03\x5f\x12\xc4\xbe\x50\x18\x1b\x7e\xb6\xc2\x87\x97\xf3\xab\xee\xfb\xda\x9a\x9a\xac\xef\xf4\x1c\x9f\x9e\x4b\xf4\x5a\x77\x66\x7b\xca\x04\xc5\x5e\x20\xd8\x17\x28\x4e\x9b\xd4\x42\x22\xf1\x00\xa6\x00\x5f\x4a\x07\x96\x6a\x4b\x8e\x74\xf4\x0e\x6e\x46\x0d\x66\xf1\x13\xe5\x3e\x83\x1b\xb2\xfc\x21\x5b\x45\xa3\x04\x3b\xcd\x8a\xac\x07\x4b\xb9\x59\x6a\xf9\x73\x37\x9a\x03\x6f\xc2\x34\x0a\x3d\x39\x0f\x41\xd5\x1a\x15\xac\x50\x35\x74\x0e\xa8\x05\x54\xb8\x06\x4b\x3c\x2e\x34\xba\x37\x42\xe8\xe2\x32\x78\x6b\x2c\x81\xd4\x85\x99\x41\xe9\x7d\xed\x66\xd3\xe9\x52\xfa\xd6\xdd\x73\x53\x55\x8d\x96\x7e\x3d\x0d\x9e\x2b\x17\x8d\x37\xd6\x4d\x05\xad\x48\x4d\x9d\x5c\x4e\xd0\xe6\xa5\xf4\x94\xfb\xc6\xd2\x14\x6b\x39\x09\xc4\xea\xe0\xf2\x59\x25\x3e\xb3\x29\x40\xb8\xa7\x5b\xc2\x7b\xa0\xf5\xf8\x04\x3f\x3a\x20\x65\xf6\x28\xd6\x28\xa6\x4f\x23\x17\x1b\x61\x72\x13\xcb\xe3\xfa\x9b\x9b\x5b\x68\xa7\x8e\x02\x8f\xb2\xdd\x74\x75\x1b\x31\xb3\x88\xa4\x2e\xc8\xc6\x9e\x85\x35\x55\x18\x85\xb4\xa8\x8d\xd4\x3e\x59\x8c\x24\xed\xc1\x35\x8b\x4a\x7a\xd6\xdf\x3f\x1b\x72\x9e\x35\x90\xc1\xab\x10\xe7\x60\x41\xd0\xd4\x02\x3d\x89\x0c\xae\x34\xbc\xc2\x8a\xd4\x2b\x74\xf4\xbb\x0b\x99\xa5\xe9\x26\x2c\xbc\xd3\xc4\xdc\x0f\xd1\xbb\x9d\xa3\x9c\x7a\x2f\xda\x50\xb9\x47\x27\xc9\xcf\x6e\x6a\xca\xb7\x2c\x5f\x90\x93\x96\x2d\xd5\xa3\x27\xb6\xef\x7e\x74\x3c\xec\x71\xfc\x24\x07\x7d\x47\xfe\xde\xd8\xbb\xdd\xb7\x7b\x7c\x5d\xc7\xde\xec\x30\x85\x5c\x36\x36\xf8\x56\xf6\xe0\xdb\xfd\xb3\x42\xf4\xf7\x68\x18\x73\x63\xfd\x50\x87\x9d\xc9\x5f\xce\xaf\x36\xfd\x83\xb4\x64\x21\x93\x10\x6a\x6e\xe2\xff\xbc\x9c\x5f\x25\x73\x6b\x9d\x7a\xc1\xa6\xcc\xc6\xf3\x9a\x0a\x6c\x54\xb0\x24\xf8\xea\xc5\x8b\xe7\x0f\xe9\xe5\xa7\x30\xb6\x42\x3f\x63\x17\x7f\x7e\x39\xd8\x23\xaa\x8f\x43\xc0\x72\x47\xca\x89\x6b\x23\x06\xf9\xdd\x61\xe7\xb6\xa4\x4e\x8e\x16\xf5\x92\x92\x43\xdc\x97\x32\x2f\x61\x6e\x44\xfb\xd6\x01\x5a\x02\x54\xca\xe4\xc1\xe4\x07\x87\x3e\x2c\x6a\x7e\x72\x29\xec\xdf\x94\xc9\xef\xf6\xf6\x00\x90\x9e\xaa\x03\xaf\xf7\xda\xf9\x50\x27\xb4\x16\xd7\x83\x7d\xd8\xa5\xd9\x64\x87\x27\x9a\xf4\x28\x3d\x20\xff\x07\xee\xd3\x3e\x8e\xec\x4a\xe6\xf4\xda\x54\x28\xf5\x09\x7a\x88\x1d\x43\x36\x0c\x59\x2b\x7d\xef\x86\xe5\x7c\x44\x00\xed\xc7\xbf\x59\xff\x69\x20\xf8\x70\x35\x1f\xf5\x3f\x34\xfc\x1e\xfd\x1f\x78\x99\x20\xf1\x5c\xa1\xa6\x6f\x52\xc6\x39\x16\xef\x06\x3e\xe9\x65\xb6\xed\xe4\xd5\x38\xe2\x40\x03\x29\xcb\xb0\xb2\xe0\x5e\xfa\x32\x66\xb5\x38\x12\xd4\x3c\xd4\xa7\x46\xca\xd2\xb8\x53\x02\x24\x5b\x14\x77\x0d\xa6\x6c\x74\xb2\xa4\x36\x2a\xba\x18\x15\xa5\x8b\xb6\xa5\x97\x8f\x32\xf0\xfa\xb4\x58\x7d\xdb\xc6\xe4\xc7\x92\xf1\x5b\xc3\xf0\x7e\x1b\x9b\x04\x19\x0d\x34\x33\xbd\x8f\xb5\xa7\x6b\x2a\x3e\xc1\x94\xae\xa9\x08\xe0\x4a\x83\xa9\xe3\xea\x01\x2c\x15\x64\x49\x33\x88\x32\x80\x6c\x0f\x2b\x29\xc8\x4e\x52\x9a\xcb\xfb\x20\x0b\x3d\x94\x46\x89\x36\xfd\x7b\x94\xca\x85\xd0\x55\xf7\x56\x10\xe1\x65\x9a\x14\xc2\xac\xa1\x0b\xb6\x39\xfc\x11\xd9\x7a\x10\x9d\x0f\x32\xcb\x8a\x4e\x88\x3c\x02\x71\x6a\x19\xf4\x8f\xb2\xba\x42\x92\x12\x61\xb1\x73\x7c\xee\xa7\x57\x45\x9c\x2c\xe0\xd6\x28\x4d\x49\x39\x6d\x41\x7f\x90\xda\x79\x42\x91\x1a\x19\xe2\x59\x4a\xef\xce\x23\x3a\x4d\xc0\x77\xb3\x34\xf0\x9c\x26\x90\x91\xb0\x14\xf0\xf7\x9b\xf7\xef\xa6\xdf\x9a\x48\x19\x60\x9e\x93\x73\x11\x82\x55\xa4\xfd\x79\xb7\xb8\x4b\xe8\xec\x86\xdf\x64\x15\x6a\x59\x90\xf3\x59\x1a\x8d\xac\xfb\xe1\xf2\xc7\x0c\xde\x18\x0b\xf4\x11\xab\x5a\xd1\x39\xc8\x28\xaf\x0e\x46\xb7\x76\x21\x5d\x64\xa6\xfb\x36\x04\x97\x40\x52\x6d\x44\x22\xfa\x3e\x10\xeb\xf1\x2e\x04\x00\x4c\xb0\x5d\xc9\x3b\x9a\xc1\x13\xb6\xa5\xde\xd4\xbf\x70\x9c\xf8\xf5\x09\x7c\x7e\x5f\x92\x25\x78\xc2\x3f\x9f\xc4\x09\xbb\xf5\x4e\x0c\x25\x45\x17\xc2\xe2\xc4\xc1\x04\xbd\x95\xcb\x25\x31\xf2\x0c\x51\x90\x61\xf2\x17\x60\x2c\xd3\xaf\x4d\xaf\x73\x18\x82\xe5\x99\x00\x9b\x78\x40\xc8\x0f\x97\x3f\x3e\x81\xcf\xb7\xf9\x02\xa9\x05\x7d\x84\x4b\x90\x3a\x72\x56\x1b\xf1\x45\x06\xb7\x41\x33\x6b\xed\xf1\x23\x8f\x99\x97\xc6\x91\x06\xa3\xd5\x9a\x29\x2e\x71\x45\xe0\x4c\x45\x70\x4f\x4a\x4d\x22\x4a\x16\x70\x8f\x6b\xe6\xa1\x15\x25\x6b\x15\xa1\x46\x8e\x4e\x5b\xab\xc1\xdb\xf7\xaf\xdf\xcf\xe2\x6c\xac\xb6\xa5\xe6\x29\x78\xbd\x51\x48\x76\x51\x5e\xe4\xc5\x75\x4b\xd0\x39\x13\xd2\x44\x25\x71\xd0\x2f\x39\x85\x47\x6a\x09\x8a\x86\x57\x10\xd9\xd3\xc7\xd8\xfa\xc3\xa5\xd9\xb0\x99\x87\x25\xda\xae\x73\xfd\xc7\x16\x40\x27\x32\x17\xf6\x1c\x4e\x60\xee\x5d\xcf\xee\x0e\x32\x77\xd7\x2c\xc8\x6a\xf2\x14\xf8\x13\x26\x77\xcc\x5a\x4e\xb5\x77\x53\xb3\xe2\x0c\x43\xf7\x53\xc6\x58\x52\x2f\x27\x6c\x58\x93\xa8\x6d\x37\x0d\xfb\x4e\xd3\xcf\xc2\x3f\x8f\xe6\x25\x6c\x0b\x9d\xca\x50\xe8\xfc\x47\x70\xc5\xf3\xb8\xe9\xa3\x98\x6a\xd3\xcc\xe9\xb1\xfe\xe9\xcd\x6e\x8a\x6a\xf7\x67\xbc\xe9\x72\x7f\xd8\x88\xe9\x45\xb2\x0a\x45\x0c\x75\xa8\xd7\xbf\xbb\xd1\xb2\xe8\x1a\xcb\x73\xaf\x27\x29\x69\x4f\x50\x0b\xfe\xbf\x93\xce\x73\xfb\xa3\x64\xd5\xc8\x93\x1c\xf5\xfb\xab\xd7\x7f\x8c\x29\x37\xf2\x51\x5e\x79\x00\xe2\x48\x5d\x58\x74\xde\x36\x41\xb6\xc7\x41\xce\xd5\x6e\xff\xb8\x87\xf4\xef\x07\x37\xdb\x84\x25\x64\x93\xb7\x3b\x91\x1a\x1c\x4a\xd1\xcd\x33\x42\x9d\x11\xea\x8c\x50\x67\x84\x3a\x23\xd4\x19\xa1\x4e\xef\x19\xa1\xce\xe9\xb2\xfa\xf3\x43\x9d\x1a\x1b\x37\xb4\x5d\xb4\xc5\xe0\x3c\x74\x82\x1c\x75\x38\x83\x4a\x1b\x7e\xb5\x0d\x29\xa1\x77\xe6\x9e\xb6\x72\x6b\x6b\x38\x59\x76\xbb\x31\x09\x9f\x70\x74\x45\xa5\x40\x7a\x07\xe8\x9c\xc9\x25\x7a\x12\x89\xac\x81\x7d\xe7\x48\xf5\xc2\x18\x45\xa8\x4f\x3a\x49\x0a\xe7\xc1\x27\x9c\x25\xc5\x83\xe8\xfe\x69\x92\x59\x84\x0d\xba\xc7\x1f\x27\x19\x1d\x4f\xc4\x07\x90\xd5\xee\x7e\x58\xea\xd8\xcd\x1f\x2d\xd7\x77\x7b\xdf\x1d\x09\xbd\x83\xe4\x87\xd2\xd9\xbb\x67\x3d\x3c\x5d\x37\x5b\xc8\x86\x3c\xd5\xe6\x60\xb8\xd3\x10\x83\xba\x0e\x93\x32\xab\x98\xb6\xe9\x02\x49\x43\xb8\xee\xd8\xfe\xbb\x42\xe7\x6f\x2d\x6a\x27\xdb\x3a\x8a\x7d\xdb\xec\x5b\x64\x7f\x87\xce\x83\x97\x15\xb5\xb0\x24\x31\xe1\xbb\xa1\x48\x44\x5b\x33\x9a\xda\xc2\x02\xc6\x4e\xda\xf8\x92\x6c\x8b\x22\xd2\x49\x18\xc1\x7d\x49\x31\x6d\x37\x5a\x90\x55\x6b\x36\xcd\xcd\xa8\x31\xb3\x8b\x0c\xae\x8a\x08\x7c\x12\x28\xb8\xd3\xe6\x5e\x33\xec\x22\x0d\x4d\x67\xce\x81\xac\x6e\x40\x16\x59\x44\x0b\x69\x94\x00\xfd\x73\xf6\x69\x5c\xa8\x41\x99\x41\x6f\x9f\x57\xa0\xa7\x09\x8f\xb8\xa7\xdf\xd1\x83\x87\x8a\x9c\xc3\xe5\x69\x52\x7d\x09\x65\x53\xa1\x06\x4b\x28\x98\xb8\xf6\x63\x46\x62\x32\xc7\x70\xba\xdd\x2e\x3d\x70\x61\x9a\x78\xb4\xb8\x91\x79\x12\x6b\x64\xb7\xc2\x35\x4b\x96\xaa\xda\xaf\xf7\x71\x79\x94\x7a\x4b\xe8\xf6\xa5\xa0\x81\xad\xf5\xd8\x7d\x53\x64\xd1\x6a\xf0\xa9\x0b\x86\xd6\x23\x95\x61\x5a\xef\x7c\x9c\x3f\xee\x56\x5d\xac\x32\xa6\x3e\x2f\x8d\x71\x41\x95\x6c\x33\x0c\x6c\x59\xe9\xdb\xf8\x2f\x24\x10\x11\x30\x30\xc2\xb2\x41\x8b\xda\x13\x09\x1e\xe3\x81\x34\xd2\x11\xfd\x6f\x93\x88\xa3\x15\x59\xe9\xd7\x27\xc9\xe4\x26\x75\x6e\x97\x7c\xc1\xbb\xe9\x63\xad\x64\x2e\x3d\xe4\x0a\x9d\x63\x8e\x3b\x4f\xbf\x8e\xf2\xcb\x0d\xa7\x66\x17\xf1\x7f\xe3\x38\x6e\x1b\x0b\x15\xe6\x65\x8c\x47\xa8\x41\x56\x15\x09\x8e\xcf\x6a\x1d\x7d\xc6\xf9\x08\x8e\x69\x13\xae\xa4\x6f\xe2\xc8\x21\xb0\xe7\x9e\xcd\xde\x58\x21\xf5\x52\xad\xa3\xc8\x3b\xfa\xa2\x90\xde\x7e\x7f\x73\xcb\x12\x72\xe4\x23\xa0\x0f\x5e\x14\xa3\xf1\x5f\xdf\xa0\x72\x7b\xdd\xe5\xb8\xd8\x06\x42\xff\x3e\xa1\xc5\x68\xb1\x59\xf2\x44\x1b\x3a\x0f\xa1\xc4\x14\x70\x6b\x1b\x3a\x87\x40\xce\x39\x7c\xaf\x43\x10\x78\x34\x5d\xa1\xc3\x49\xe6\xbd\xae\xc3\xec\x9b\xa8\xd4\xb7\xe0\xb0\xea\x62\xc3\x37\x59\x5a\x45\x66\xb9\xa9\xa6\xfd\x0a\x10\xd4\x6b\xc8\xba\xaf\x33\x9e\x38\xd5\xe0\x84\x73\xd4\x0e\x09\xb1\x9a\xac\x71\xae\x0b\xf4\x2e\x2c\x1e\xe1\xe5\x0a\xa5\xe2\xa8\x70\x0e\x8b\x86\x0d\x39\xe7\xa4\x0f\x68\x17\xd2\x5b\xb4\xeb\x5e\x8a\xeb\x01\x81\xa2\x51\xf0\xb9\x23\x82\x4c\x1b\x41\x6d\x45\xd6\xa6\xeb\x17\x21\x7c\x02\x2e\xa4\x62\x3b\xf0\x06\x04\xe5\x46\x17\x4a\xe6\x21\xcc\xca\xaa\x36\xd6\xe3\xbe\x4d\x83\x13\xc0\xec\xfe\x33\xd5\x49\xb2\x8a\xc1\x57\x3c\xee\xc0\x8b\x13\x0e\x59\x87\x0e\x78\xfb\x67\x62\x57\x5a\x7a\x89\x4a\xfe\x7c\x14\x56\xbd\x1a\xfe\xaa\x4b\xd5\xb2\x78\x78\x86\x0a\x25\x3a\x58\x10\xb1\x7d\x74\x5f\x7c\x0a\x76\xda\x3d\xc0\x43\x31\x10\x6b\x0e\x1c\xe1\xa1\x58\x1f\x26\x30\x2c\x04\x50\x0c\x84\xc0\x43\x44\x15\x28\x55\x63\x53\xf9\xc2\x80\x23\xf7\x2b\x0e\x0f\x41\x8e\x2d\xca\xdf\xf4\x07\x0d\x15\x44\x0f\xab\xf5\xfa\xd8\x27\x11\x01\x22\x52\x91\xc1\x95\x0f\x05\x08\xf7\x6e\x0b\xe4\x7a\xd3\x8f\x89\xa5\xb9\x87\x8a\xbd\x6f\xe7\xeb\xde\xce\x5c\xde\x3b\xf2\x54\x6b\x70\x35\xea\xe4\x85\x8f\xc1\x54\xe8\xe3\x12\x69\x7f\xcd\xc3\xa9\xc2\xea\xab\xe5\x60\x71\xc3\x36\x8c\xe8\xe6\x8f\xdb\x9c\x85\xa5\xb0\x15\x59\x41\x85\x75\x00\x94\x9b\x0e\x9c\x4a\xb6\xf7\x2c\xdb\xdd\x49\xa8\xe4\xb2\x0c\xd5\x03\x1c\xdc\x92\x1f\x1f\x8e\x02\x7b\x6b\x5f\xb6\x6d\xfa\xa4\x78\xdb\x37\x6a\x06\x3d\x64\xab\x8d\x49\x73\x5a\xdf\x52\x66\xdc\x0a\x92\x01\xd2\x05\xab\x61\xb2\x17\xeb\x1d\xcb\x6f\x33\xe8\x61\x2e\x86\xed\xff\x28\x97\xfb\x0d\x3b\xa9\xc1\x29\x19\xf7\x1e\x76\x48\x4f\xeb\x2b\x70\x6b\x9d\xb7\xa8\x99\x7d\x60\x8f\x5e\xf6\xb9\xed\x20\x59\x69\xaa\xb7\xfb\x40\xe8\x10\xd1\x6f\xb7\x41\x67\x58\x80\x61\x80\x9a\x96\x92\x41\xa1\x47\xc5\x04\x2d\x14\x55\xa1\x2a\x55\xe7\x52\xb5\xf8\x3b\xac\x45\x62\xed\xe9\xbd\x54\xaa\x05\x14\x61\xe7\xb4\x9b\x6f\x45\x40\xd6\x32\xaa\x89\xb3\xed\xe3\x6a\xd0\xea\x13\x57\xd7\x7b\xc0\xe9\x10\x53\x09\x59\xfd\x1e\x3c\x79\x73\x47\x3a\x6d\xf3\x6e\xd9\x60\x6d\xcd\xd2\x62\x55\xa1\x97\x79\x2c\xcd\xad\x2d\xf9\x3d\x35\x89\x07\xd8\xdd\x3d\xed\x38\x9e\x11\x76\xcf\x3b\x38\x27\xa4\xc8\xba\xb5\x78\xfd\x44\x13\x1b\xf6\x8c\x76\x69\xfe\x2d\xe9\xb4\x20\x3d\x42\xdd\xfb\x07\x1f\xb4\xc4\xa5\x32\xe6\xe5\xe6\x45\xb7\xee\x5f\xac\xfb\xa9\x4c\x0d\x11\xd9\xab\xcd\xf9\xea\xc5\x1e\x16\x86\xeb\x72\x42\x85\xfa\xb1\x3d\x16\xee\xb3\x5b\x64\xd5\x62\xed\x30\x40\x80\x87\xed\x5e\x4a\x9e\xc0\x77\x06\xdf\x64\xcb\xac\x2d\xa6\x3f\x87\xeb\x46\xeb\xf0\x9f\xdb\x10\xd1\xc2\xa2\xee\x1c\x62\x05\x3d\x90\xcf\x3f\xc1\x32\x06\xfd\xfe\x41\x63\x94\xdf\x0c\xbc\x6d\x22\xa0\x72\xde\x58\x0e\x06\x50\x30\x84\x8e\x4d\xcd\xa2\x43\x9b\xad\x18\x12\x62\x87\x5f\x7e\xfd\x73\xdd\x28\x88\x96\x31\xde\x28\x18\x6f\x14\x8c\x37\x0a\xc6\x1b\x05\x47\x68\x18\x6f\x14\x8c\x37\x0a\xfe\x4b\x2b\xca\xc7\x1b\x05\xff\xdf\xfa\x1f\x6f\x14\x8c\x37\x0a\x60\xbc\x51\x30\xde\x28\x18\xcb\xec\xc6\x32\xbb\xb1\xcc\x6e\x2c\xb3\x1b\xcb\xec\x3e\x89\xa9\xb1\xcc\xee\x74\x59\xfd\xf9\xcb\xec\xc6\x1b\x05\x47\xd5\x3b\x42\x9d\x11\xea\x8c\x50\x67\x84\x3a\x0f\x9e\x11\xea\x8c\x50\x67\x84\x3a\xff\x33\x50\x67\xbc\x51\x30\xde\x28\x18\x6f\x14\x8c\x37\x0a\xc6\x1b\x05\xe3\x8d\x82\xf1\x46\xc1\x78\xa3\x60\xbc\x51\x30\xde\x28\x18\x6f\x14\x8c\x37\x0a\x0e\x48\x75\xbc\x51\x30\xde\x28\x48\xcf\x78\xa3\x60\xbc\x51\x30\xde\x28\xe8\xb1\x30\xde\x28\xd8\xdc\x28\xd8\xb4\x1c\xbe\x50\xb0\xc1\xee\x71\xdd\x4c\xa2\xf7\xa7\x0f\xe2\xdf\x52\x78\xf2\x24\xfc\x68\xff\x58\x42\xf8\xd9\xdb\x72\x81\x1f\x7e\x3c\x8b\x13\x93\xf8\xd0\xfe\xe9\x03\x6e\xfc\x57\x00\x00\x00\xff\xff\xa7\x54\x9e\xd8\xc1\x62\x00\x00")
+var _clusterApiClusterXK8sIo_clustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5f\x6f\x1b\xb7\xb2\x7f\xd7\xa7\x18\xa4\x0f\x69\x01\x6b\xe5\x38\x69\x7b\x21\xe0\x3e\xe4\x26\x4d\xe1\x7b\x9a\xc4\x88\xdd\xbe\x04\x79\x18\xed\xce\x6a\x59\x73\xc9\x3d\x24\x57\x8e\x5a\xf4\xbb\x1f\x0c\xc9\x5d\xad\xe4\x95\x2c\x2b\x48\x0f\x70\x0e\xab\x00\x95\xf8\x77\x66\x38\x33\xfc\x71\xc8\x81\xb1\x11\xbf\x91\xb1\x42\xab\x39\x60\x23\xe8\xb3\x23\xc5\xbf\x6c\x76\xfb\x3f\x36\x13\x7a\xb6\x7a\x36\xb9\x15\xaa\x98\xc3\xab\xd6\x3a\x5d\x7f\x20\xab\x5b\x93\xd3\x6b\x2a\x85\x12\x4e\x68\x35\xa9\xc9\x61\x81\x0e\xe7\x13\x00\x54\x4a\x3b\xe4\x62\xcb\x3f\x01\x72\xad\x9c\xd1\x52\x92\x99\x2e\x49\x65\xb7\xed\x82\x16\xad\x90\x05\x19\x3f\x78\x37\xf5\xea\x3c\x7b\x91\x3d\x9b\x9e\x67\x17\xe7\x17\xe7\xcf\xce\xcf\x2f\xce\xcf\xcf\x7f\xbc\x38\x9f\x7e\xff\xe3\xc5\xf7\xe7\x88\xf9\xb3\x1f\xcb\x1f\x26\x00\xb9\x21\x3f\xf8\x8d\xa8\xc9\x3a\xac\x9b\x39\xa8\x56\xca\x09\x80\xc2\x9a\xe6\x90\xcb\xd6\x3a\x32\x36\x8b\x5f\xb2\xcf\xd3\xc0\xc6\xc4\x36\x94\x33\x45\x4b\xa3\xdb\x66\x0e\xf7\xea\xc3\x08\x1d\xd1\xe8\x68\xa9\x8d\xe8\x7e\x4f\xbb\xf6\x53\x6c\x84\x6f\x11\x45\x12\x4a\x7d\x89\x14\xd6\xfd\x63\x58\xfa\x8b\xb0\xce\xd7\x34\xb2\x35\x28\x37\xc4\xf9\x42\x5b\x69\xe3\xde\x6d\xa6\xe4\x29\x42\x85\x50\xcb\x56\xa2\xe9\xdb\x4f\x00\x6c\xae\x1b\x9a\x83\x6f\xde\x60\x4e\xc5\x04\x20\x8a\xce\x53\x38\x05\x2c\x0a\xbf\x18\x28\xaf\x8c\x50\x8e\xcc\x2b\x2d\xdb\xba\x5b\x84\x29\x14\x64\x73\x23\x1a\x6e\xd2\x13\x08\xd6\xa1\x6b\x2d\xd8\x36\xaf\x00\x2d\x5c\x91\x2a\x84\x5a\xce\xae\x8c\x5e\x09\x1e\x7b\xeb\x07\x15\xb3\xd7\x24\xc9\x71\xe1\x1b\x14\xd2\x13\xc1\x83\xff\x6e\xb5\xba\x42\x57\xcd\x21\x0b\x03\x66\x4d\x85\x96\x62\x2d\x4b\x75\x0e\x57\x83\x12\xb7\x66\x5e\xac\x33\x42\x2d\x27\x9b\x26\xab\x67\x28\x9b\x0a\x9f\xfb\x22\x9b\x57\x54\x7b\x85\xe2\x5f\xba\x21\xf5\xf2\xea\xf2\xb7\xe7\xd7\x5b\xc5\x30\xce\x95\xb0\xe0\x2a\x82\xd0\x16\x4a\x6d\xfc\xcf\x4e\xf8\xf0\xf2\xea\xb2\xef\xdf\x18\xdd\x90\x71\xfd\x3a\x87\x7f\x03\x93\x18\x94\xee\xcc\xf6\x94\x09\x0a\xad\xa0\x60\x5b\xa0\x30\x6d\x5c\x16\x2a\x22\x0f\xa0\x4b\x70\x95\xb0\x60\xa8\x31\x64\x49\x05\xeb\xe0\x62\x54\xa0\x17\xbf\x53\xee\x32\xb8\x26\xc3\x1d\xc1\x56\xba\x95\x05\x1b\xcd\x8a\x8c\x03\x43\xb9\x5e\x2a\xf1\x47\x3f\x9a\x05\xa7\xfd\x34\x12\x1d\x59\x07\x7e\xa9\x15\x4a\x58\xa1\x6c\xe9\x0c\x50\x15\x50\xe3\x1a\x0c\xf1\xb8\xd0\xaa\xc1\x08\xbe\x89\xcd\xe0\xad\x36\x04\x42\x95\x7a\x0e\x95\x73\x8d\x9d\xcf\x66\x4b\xe1\x3a\x73\xcf\x75\x5d\xb7\x4a\xb8\xf5\xcc\x5b\xae\x58\xb4\x4e\x1b\x3b\x2b\x68\x45\x72\x66\xc5\x72\x8a\x26\xaf\x84\xa3\xdc\xb5\x86\x66\xd8\x88\xa9\x27\x56\x31\x53\x36\xab\x8b\x6f\x4c\x74\x10\xf6\xe9\x96\xf0\xee\xad\x7a\xf8\xe7\xed\xe8\x80\x94\xd9\xa2\x40\x58\xc0\xd8\x35\x30\xba\x11\x26\x17\xb1\x3c\x3e\xfc\x74\x7d\x03\xdd\xd4\x41\xe0\x41\xb6\x9b\xa6\x76\x23\x66\x16\x91\x50\x25\xb1\x6a\x08\x0b\xa5\xd1\xb5\x97\x2a\xa9\xa2\xd1\x42\xb9\xa8\x31\x82\x94\x03\xdb\x2e\x6a\xe1\x78\xfd\xfe\xd9\x92\x75\xbc\x02\x19\xbc\xf2\x7e\x0e\x16\x04\x6d\x53\xa0\xa3\x22\x83\x4b\x05\xaf\xb0\x26\xf9\x0a\x2d\x7d\x75\x21\xb3\x34\xed\x94\x85\x77\x9c\x98\x87\x2e\x7a\xf3\x1f\x8f\x32\x8f\x72\x1a\x54\x74\xae\x72\xcf\x9a\x44\x3b\xbb\x6e\x28\xdf\xd2\xfc\x82\xac\x30\xac\xa9\x0e\x1d\xb1\x7e\xc7\x86\x5b\x23\x8d\x5b\x1c\x7f\xa2\x81\xbe\x23\x77\xa7\xcd\xed\x6e\xed\x38\x0d\xa0\x42\x6b\x36\x98\x52\x2c\x5b\xe3\x6d\x2b\xbb\xd7\x77\xff\xac\xd1\xde\x83\x62\x5c\x69\xe3\xc6\x1a\xec\x4c\xfe\xf2\xea\x72\xd3\xde\x4b\x4b\x94\x22\x0a\xa1\xd1\x26\x68\xcf\xcb\xab\xcb\xa8\x6e\x9d\x51\x2f\x58\x95\x59\x79\x5e\x53\x89\xad\xf4\x9a\x04\x3f\xbc\x78\xf1\xfc\x3e\xbd\xfc\x29\xb5\xa9\xd1\xcd\xd9\xc4\x9f\x5f\x8c\xb6\x08\xcb\xc7\x2e\x60\xb9\x23\xe5\xc8\xb5\x2e\xec\x11\xec\xdc\x54\xd4\xcb\xd1\xa0\x5a\x52\x34\x88\xbb\x4a\xe4\x15\x5c\xe9\xa2\xab\xb5\x80\x86\x00\xa5\xd4\xb9\x57\xf9\xd1\xa1\x0f\x8b\x9a\x3f\xb9\x28\xcc\xff\x49\x9d\xdf\xee\x6d\x01\x20\x1c\xd5\x07\xaa\xf7\xea\xf9\xf6\x27\x34\x42\x63\x70\x3d\xda\x86\x4d\x9a\x55\x76\x7c\xa2\xe9\x80\xd2\xd1\x06\x7b\xcc\xa7\xfb\x58\x32\x2b\x91\xd3\x6b\x5d\xa3\xd8\xd9\x49\x46\xd7\x21\x34\xf4\xbb\xa1\xdf\xb5\x62\x7f\x9b\x4d\x4e\x10\x40\xd7\xf9\x8b\xd7\x3f\x0e\x04\xbf\x5d\x5e\xa5\xf5\x7f\xc4\xfa\x1f\xa8\x8c\x90\xf8\x4a\xa2\xa2\x9f\xe2\x8e\x33\x9f\x1c\x5c\xa3\x57\x23\x5d\x06\x3b\xdb\xf6\xe6\xd5\x5a\x62\x47\x03\x71\x97\xe1\xc5\x82\x3b\xe1\x2a\xdf\x2a\x4e\x0e\x0d\xcf\x9e\x4d\x1e\xb7\x7c\x95\xb6\xc7\x38\x48\xf6\x28\xdc\xd4\xab\xb2\x56\x51\x93\x3a\xaf\x68\x83\x57\x14\xd6\x7f\x13\x6a\x99\x4d\x4e\x58\xe1\xe6\x38\x5f\x7d\xd3\xf9\xe4\x53\xc9\xf8\x52\x37\xbc\x5f\xc7\xa6\x5e\x46\x23\xc5\x4c\xef\xa9\xfa\xf4\x81\xca\xf9\xe4\xa0\x44\x86\xaa\xf4\x81\x4a\x0f\xae\x14\x68\x5f\x8b\x12\x0c\x95\x64\x48\xe5\xc4\x1a\x84\x6c\xce\x2b\x51\x90\x99\xc6\x6d\x2e\x1f\x82\x2c\x74\x50\x69\x59\x74\xdb\xbf\x43\x21\xad\x77\x5d\xcd\xe0\x04\xe1\x2b\xe3\xa4\xe0\x15\xd8\x37\xc1\x6e\x0f\x7f\xac\x0e\xee\x43\xe7\xa3\xcc\xb2\xbe\x45\x44\xce\x88\x84\x49\x89\x0c\xba\x93\xb4\xae\x14\x24\x0b\x7f\xd8\x79\x78\xee\xa7\x97\x65\x90\x26\x8f\x15\xa5\x29\x28\xa7\x2d\xe8\x0f\x42\x59\x47\x58\xc4\x42\x86\x78\x86\x62\xdd\x59\x40\xa7\x11\xf8\x6e\x8e\x06\x0e\x85\x02\x64\x24\x2c\x0a\xf8\xff\xeb\xf7\xef\x66\x3f\xeb\x40\x19\x60\x9e\x93\xe5\x2e\xe8\xa8\x26\xe5\xce\xfa\xc3\x5d\x44\x67\xd7\x0c\xce\xb2\x1a\x95\x28\xc9\xba\x2c\x8e\x46\xc6\x7e\xbc\xf8\x94\xc1\x1b\x6d\x80\x3e\x63\xdd\x48\x3a\x03\x11\xe4\xd5\xc3\xe8\x4e\x2f\xf8\x7c\xc5\xcc\xf4\x7d\xbd\x73\xf1\x24\x35\xba\x88\x44\xdf\x79\x62\x1d\xde\x12\xe8\x48\x6c\x4b\x20\xc5\x2d\xcd\xe1\x09\xeb\xd2\x60\xea\x3f\xd9\x4f\xfc\xf5\x04\xbe\xbd\xab\xc8\x10\x3c\xe1\x9f\x4f\xc2\x84\xfd\x79\x87\xcb\xba\x15\xdc\x4c\xec\x55\xd0\x19\xb1\x5c\x12\x23\x4f\xae\xa4\x15\x29\xf7\x1d\x68\xc3\xf4\x2b\x3d\x68\xec\x87\x10\xb6\x07\x6c\xc5\x3d\x42\x3e\x5e\x7c\x7a\x02\xdf\x6e\x7a\x30\x5f\x20\x54\x41\x9f\xe1\x02\x84\x0a\x9c\x35\xba\xf8\x2e\x83\x1b\xfe\x6a\xd7\xca\xe1\x67\xb6\xa0\xbc\xd2\x96\x14\x68\x25\xd7\x4c\x71\x85\x2b\x02\xab\x6b\x82\x3b\x92\x72\x1a\x50\x72\x01\x77\xb8\x66\x1e\x3a\x51\xb2\x5a\x20\x34\x68\xdc\x96\x4a\x64\x70\xf3\xfe\xf5\xfb\x79\x98\x8d\x97\x6d\xa9\x78\x0a\x3e\x6f\x94\x82\xcf\x7a\x7c\xc8\xf3\x95\x61\xcd\x99\x90\xd6\xf7\xe4\xa9\xf3\x8a\xb7\xf0\x40\x2d\x41\xd9\xf2\x31\x2d\x7b\x3a\x19\x51\xd6\x87\x74\xfd\xfe\xd1\x6c\x5c\xcd\xfd\x11\x6d\xd7\xb8\xfe\x6d\x07\xa0\x23\x99\x63\x65\x38\x86\xb9\x77\x03\xbd\x3b\xc8\x1c\x07\xb6\x8c\x22\x47\x9e\xbf\x42\xe7\x96\x59\xcb\xa9\x71\x76\xa6\x57\xbc\xc3\xd0\xdd\x8c\x31\x96\x50\xcb\x29\x2b\xd6\x34\x18\x96\x9d\x31\x29\x76\xf6\x8d\xff\xdf\xc9\xbc\xf8\xb0\xd0\xb1\x0c\xf9\xc6\x7f\x07\x57\x3c\x8f\x9d\x9d\xc4\x54\xb7\xcd\x1c\xef\xeb\x9f\x5e\xef\x6e\x51\xb1\x2f\x9b\x45\xb7\xf7\x0b\xbb\xed\xc9\x6a\x2c\x82\xab\x43\xb5\xfe\xea\x4a\xcb\xa2\x6b\x0d\x9b\xfe\x7a\x1a\x37\xed\x29\xaa\x82\xbf\x5b\x61\x1d\x97\x9f\x24\xab\x56\x1c\x65\xa8\xbf\x5e\xbe\xfe\x7b\x54\xb9\x15\x27\x59\xe5\x01\x88\x23\x54\x69\xd0\x3a\xd3\x7a\xd9\x3e\x0c\x72\x2e\x77\xdb\xf3\x5a\xe3\x57\x00\x37\xdb\x84\x45\x64\x13\x43\x19\xec\x84\x2d\x8a\xa2\x9f\x27\x41\x9d\x04\x75\x12\xd4\x49\x50\x27\x41\x9d\x04\x75\x12\xd4\x49\x50\x67\x14\xea\x34\xc8\xf1\xbb\xf9\xe4\x20\x83\x57\xbe\x11\xe4\xa8\xfc\x1d\x54\x0c\xf8\x35\xc6\x9f\x7e\x07\x77\xee\x31\x94\xdb\x18\xcd\x71\x81\x3e\x1a\x13\xf1\x09\x7b\x57\x94\x12\xf8\x7a\x0b\xad\xd5\xb9\xe0\xb8\x7e\x24\x6b\x24\xee\x1c\xa8\x5e\x68\x2d\x09\xd5\xe4\x08\x7e\x78\x73\x6e\x77\xa0\xcd\xd8\x3d\x0e\x6f\xd5\xad\xdd\xba\x4d\xd2\x0b\x8e\x0e\x7e\xc1\x75\x92\x56\xe1\x46\xdc\x3e\x20\xca\x57\x7d\xc3\x7e\xfe\xa0\xb9\xae\x8b\xe0\x6f\x48\x18\x5c\x24\x67\x93\xa3\x63\xd6\xe3\xd3\xf5\xb3\xf9\xdd\x90\xa7\xda\x5c\x0c\x77\xbc\x7a\x50\xd7\x99\x38\x30\xab\x18\xc3\x74\x9e\xa4\xfb\x34\x3c\x84\x28\x01\x24\x5a\x77\x63\x50\x59\x2f\x1c\x7e\x47\x31\xde\x6e\x87\xec\x5f\xd0\x3a\x70\xa2\xa6\x0e\x96\x44\x26\x5c\x3f\x14\x15\x41\xd7\xb4\xa2\xee\x61\x01\x63\x27\xa5\x5d\x45\xa6\x43\x11\xf1\x26\x8c\xe0\xae\xa2\x10\xa1\x68\x55\x41\x46\xae\x59\x35\x37\xa3\x86\x20\x06\x5f\xaa\xb2\xcc\xd1\x75\xa0\xe0\x56\xe9\x3b\xc5\x58\x91\x14\xb4\xbd\x3a\x7b\xb2\xfa\x01\x59\x64\x21\x30\x12\x47\xe1\xce\x1c\x17\x6b\x1c\x2e\xe4\x48\xc8\x7b\x3b\xce\xcb\xb7\xb9\x53\x1e\x71\x4f\xbb\x07\xfc\x13\x5f\xb5\x5a\x8b\xcb\xe3\xa4\xfa\x12\xaa\xb6\x46\x05\x86\xb0\x60\xe2\xba\xce\x1c\x74\x12\x39\xf2\x5b\x8b\xfe\xe8\x81\x0b\xdd\x86\xab\xc5\x8d\xcc\xa3\x58\x03\xbb\x7c\xa9\xbd\x20\xa0\xba\x71\xeb\xec\x54\xea\x0d\xa1\xd5\xea\x28\xe2\x39\xb4\x1e\x9a\x6f\x1e\x59\x74\x2b\xf8\xd4\x7a\x45\x1b\x90\x0a\x62\xeb\x7e\x9c\x3b\xf7\xa7\x2e\x5e\x32\xa6\x3e\xaf\xb4\xb6\x5e\x37\x58\x67\x38\x86\xc7\x8b\xbe\x8d\xff\xfc\x59\xb9\xf0\x18\x18\x61\xd9\xa2\x41\xe5\x88\x0a\xb6\x94\x7b\xd2\x88\x57\xf4\x5f\x26\x11\x4b\x2b\x32\xc2\xad\x8f\x92\xc9\x75\x6c\xdc\x1d\xf9\x7c\x6c\x9d\x3e\x37\x52\xe4\xc2\x41\x2e\xd1\x5a\xe6\xb8\xb7\xf4\x0f\x41\x7e\xb9\xe6\xad\xd9\x06\xfc\xdf\x5a\x8e\x7b\x6a\x03\x35\xe6\x95\xf7\x10\xec\xe6\x45\x5d\x53\xc1\xfe\x59\xae\x83\xcd\x58\xc7\xee\x9b\x3b\xf4\xee\x4a\xb8\x36\x8c\xcc\x35\x98\x3b\x3e\x23\x69\xc3\x6f\x7a\xe4\x9a\x85\x43\x1b\xfa\x82\xca\xbc\xfd\xf5\xfa\x86\x37\x10\x4b\x2e\xc4\x2e\xbd\x15\x05\x6f\xfc\xbf\x6f\x50\x5a\x3a\x5d\x6c\x23\xae\x7f\x9f\xd0\x7c\xd3\xde\xbf\x76\x3a\x74\xe6\x5d\x89\x2e\xe1\xc6\xf0\xd3\x16\x4f\xce\x19\xfc\xaa\xbc\x13\x38\x99\x2e\xdf\xe0\x18\xaa\x6e\xd6\x8d\x9f\x7d\xe3\x95\x86\x1a\xcc\xca\x29\x14\x94\x5a\x67\xf1\x14\x99\xe5\xba\x9e\x0d\x34\xfc\x2d\xaa\x35\x64\x7d\xef\x8c\x27\xe6\x83\x6c\xcb\x2e\xdf\x78\x3e\x03\x12\xe2\x65\x32\xda\xda\x1e\xcb\x59\x1f\x27\x87\x97\x2b\x14\x92\xbd\xc2\x19\x2c\x5a\x56\xe4\x9c\x37\x7d\x40\xb3\x10\xce\xa0\x59\x6f\x24\x65\x07\x40\xa0\x6c\x25\x7c\x6b\x89\x20\x53\xba\xa0\xee\x45\xd6\xa6\xe9\x77\xde\x7d\x02\x2e\x84\x64\x3d\x75\x1a\x0a\xe2\xd7\x13\x52\xf0\x09\xdd\x82\xa8\xf9\xfa\x09\x95\x3b\x51\xc2\x87\xee\x54\xa7\x51\x2b\x46\xab\x78\xdc\x91\x8a\x03\x48\xe9\xd0\x05\x6f\x84\x40\xfe\xbe\xe9\x92\xdf\x29\xa2\x14\x7f\x8c\x51\xb5\xf7\x6e\x6c\xd0\xab\xdf\xaa\x63\x9c\x20\x0e\x1e\xee\x50\xa1\x42\x0b\x0b\x22\xd6\x8f\xbe\xc7\x63\xb0\xd3\xee\x05\x1e\x16\xeb\x47\x90\xe9\xdb\x1f\x26\xd0\x1f\x04\xb0\x58\x3f\x8e\xa8\x12\x85\x6c\x4d\x7c\xbe\x30\x62\xc8\xc3\x17\x87\x87\x20\xc7\x16\xe5\x6f\x86\x83\xf2\x21\x66\xe4\xb5\xde\x10\xfb\x44\x22\xa0\xf0\x1d\x6c\x06\x97\xce\x3f\x40\xb9\xb3\x1d\x8f\x32\x46\x4e\x06\x3e\xb1\xd2\x77\x50\xb3\xf5\xed\xf4\x1e\x44\xe6\xf2\xc1\x95\xa7\x5c\x83\x6d\x50\x45\x2b\x3c\x05\x53\xa1\x0b\xe7\xfa\x7d\xf5\xc7\x0b\xeb\x28\x13\xbb\x27\xd5\x97\xfd\xfc\x2c\x4e\x84\xd2\x10\xb1\x28\x6b\xa8\xb1\x61\x1f\xb6\x21\x90\xf7\xa3\x9d\x98\x65\x17\x9d\x84\x5a\x2c\x2b\xff\x7a\x80\x9d\x5b\xb4\xe3\x6c\x72\x88\xc4\x3d\x66\xb9\xab\xd3\xf3\x63\x98\x18\x2a\x35\x83\x1e\x32\xf5\x46\xa5\x85\xdd\x59\x4c\x66\xd4\xb6\xc2\x43\x3a\x66\xd5\x93\xbd\x58\xef\x68\x7e\xb7\x83\x1e\xe6\x62\x5c\xff\x1f\xe4\x72\xbf\x62\xdb\xf8\x62\x51\xf2\xc3\x19\x5d\xee\x92\x1e\xcf\x57\x1c\x53\xcb\x3b\xd4\xcc\x36\xb0\x67\x5d\xb2\xc9\x23\xc8\x8a\x53\xbd\xdd\x07\x42\xc7\x88\x7e\xbb\x0d\x3a\xfd\x4b\x36\x0e\x36\xfa\x10\xa5\xe7\xa4\x44\x87\x92\x09\x5a\x48\xaa\xfd\xab\x54\x95\x0b\xd9\xe1\x6f\x76\xea\xf1\xed\xe9\x9d\x90\xb2\x03\x14\x8c\xfe\x37\xf3\xad\x08\xc8\x18\x46\x35\x61\xb6\x6c\xf2\x08\xad\x8f\x5c\x7d\xd8\x03\x4e\xc7\x98\x8a\xc8\xea\x6b\xf0\xe4\xf4\x2d\xa9\x18\xe6\xdd\xd2\xc1\xc6\xe8\xa5\xc1\xba\x46\x27\x72\x7e\xb7\x47\xa6\x31\xe4\xf6\xbc\x49\x3c\xc0\xee\xb6\x22\x1c\xb3\x23\xec\xde\x77\xf0\x9e\x10\x3d\xeb\xd6\xe1\xf5\x91\x2a\x36\x6e\x19\xdd\xd1\xfc\x67\x52\xf1\x40\xfa\x00\x75\xef\xef\x75\xe8\x88\x8b\xcf\x98\x97\x9b\x8a\xfe\xdc\xbf\x58\x0f\xb7\x32\x39\x46\xe4\xe0\x6d\xce\x0f\x2f\x26\x8f\x79\x97\xe3\x5f\xa8\x3f\x40\xb5\x7f\xb3\xbe\xfb\xc8\xaa\xc3\xda\x7e\x00\x76\xad\xdd\x8e\x82\x79\x04\xdf\x19\xfc\x94\x2d\xb3\xee\x31\xfd\x19\x7c\x68\x15\xdf\x14\x9d\xc1\x8d\xf7\x68\xfe\x50\xc7\x68\x96\x5f\xd0\x03\xb9\xfc\x11\x9a\x31\x6a\xf7\xf7\x0a\x83\xfc\xe6\xe0\x4c\x1b\x1e\xe1\x5b\xa7\x0d\x9f\x48\xa1\x64\x08\x1d\x8a\xda\x45\x8f\x36\xe7\x93\xad\x60\x0d\xfc\xf9\xd7\x7f\x56\x46\xc1\x8b\x94\x51\x90\x32\x0a\x52\x46\x41\xca\x28\x48\x19\x05\x29\xa3\x20\x65\x14\xa4\x8c\x82\x94\x51\x90\x32\x0a\x52\x46\x41\xca\x28\x48\x19\x05\x29\xa3\x20\x65\x14\xa4\x8c\x82\x94\x51\x90\x32\x0a\x52\x46\x41\xca\x28\x48\x19\x05\x29\xa3\x20\x65\x14\xa4\x8c\x82\x94\x51\x90\x32\x0a\x52\x46\x41\xca\x28\x48\x19\x05\x29\xa3\x20\x65\x14\xa4\x8c\x82\x94\x51\x90\x32\x0a\x52\x46\x41\xca\x28\x48\x19\x05\x29\xa3\x20\x65\x14\xa4\x8c\x82\x94\x51\x90\x32\x0a\x52\x46\x41\xca\x28\x48\x19\x05\x29\xa3\xe0\xbf\x3c\xa3\x60\x53\x72\x38\xa1\x60\x83\xdd\xc3\xb9\x99\x8a\xc1\x9f\x3e\xe0\x40\xf4\x1c\x9e\x3c\xd9\xfa\x63\x09\xfe\xe7\x06\x64\xce\xe1\xe3\x27\xfe\x43\x08\x4e\x1b\x2a\x62\x68\xd2\xce\xe1\xe3\xa7\xc9\xbf\x06\x00\xa7\x54\x9e\xd8\xc1\x62\x00\x00")
 
 func clusterApiClusterXK8sIo_clustersYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -103,7 +104,7 @@ func clusterApiClusterXK8sIo_clustersYaml() (*asset, error) {
 	return a, nil
 }
 
-var _clusterApiClusterXK8sIo_machinedeploymentsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\xed\x8e\x1b\xb9\x91\xff\xfd\x14\xc4\x04\x0b\xdb\x80\xa4\x91\xbd\xbb\xd9\xdc\x00\xf7\x63\xe2\xf1\xe6\xe6\xe2\xb5\x0d\x8f\x37\x87\x43\x36\xb8\x50\xdd\x25\x89\x19\x36\xd9\x21\xd9\xa3\xd1\x06\x01\xee\x35\xee\xf5\xee\x49\x0e\x55\x45\xf6\x87\xd4\xfa\x1a\x8f\x73\x6b\xa4\xf5\xc7\x9e\x6e\x36\x3f\x8a\xf5\x5d\x45\x96\x2c\xd5\x1f\xc0\x79\x65\xcd\x85\x90\xa5\x82\xfb\x00\x06\xff\xf2\x93\xdb\xdf\xf8\x89\xb2\xe7\x77\x2f\x9e\xdc\x2a\x93\x5f\x88\x57\x95\x0f\xb6\xf8\x00\xde\x56\x2e\x83\x2b\x98\x2b\xa3\x82\xb2\xe6\x49\x01\x41\xe6\x32\xc8\x8b\x27\x42\x48\x63\x6c\x90\xf8\xd8\xe3\x9f\x42\x64\xd6\x04\x67\xb5\x06\x37\x5e\x80\x99\xdc\x56\x33\x98\x55\x4a\xe7\xe0\xa8\xf3\x34\xf4\xdd\x74\xf2\xcd\xe4\xc5\x78\x3a\x79\x39\x7d\x39\x7d\x31\x9d\xbe\x9c\x4e\xa7\xdf\xbd\x9c\x8e\xbf\xfd\xee\xe5\xb7\x53\x29\xb3\x17\xdf\xcd\x7f\xfd\x44\x88\xcc\x01\x75\xfe\x51\x15\xe0\x83\x2c\xca\x0b\x61\x2a\xad\x9f\x08\x61\x64\x01\x17\xa2\x90\xd9\x52\x19\xc8\xa1\xd4\x76\x5d\x80\x09\x7e\x92\xe9\xca\x07\x70\x93\xfb\x31\x2f\xe8\x89\x2f\x21\xc3\xb9\x2d\x9c\xad\xca\x0b\xb1\xf5\x9e\xfb\x4a\xd3\x97\x01\x16\xd6\xa9\xf4\xf7\x38\xb5\x1f\xcb\x52\xd1\x13\x06\xce\x0f\x3c\xf0\x55\x3d\x30\xbd\xd3\xca\x87\xdf\xf7\xbf\x7f\xa3\x3c\xb7\x29\x75\xe5\xa4\xee\x9b\x3a\xbd\xf6\x4b\xeb\xc2\xdb\x66\x42\x63\x51\xe4\xfc\x42\x99\x45\xa5\xa5\xeb\xf9\xf2\x89\x10\x3e\xb3\x25\x5c\x08\xfa\xb0\x94\x19\xe0\x37\x11\xd8\xd4\xd1\x58\xc8\x3c\xa7\xed\x93\xfa\xbd\x53\x26\x80\x7b\x65\x75\x55\x98\x7a\x98\x1c\x7c\xe6\x54\x19\x68\x7b\xb6\xa6\x2f\x7c\x90\xa1\xf2\xc2\x57\xd9\x52\x48\x2f\x6e\x32\xa9\x95\x59\xfc\x58\x9e\xc7\xff\x5d\xd9\x95\x39\xff\x50\x19\xa3\xcc\xe2\xfc\x7b\xa9\x34\xe4\xe7\x3f\x9a\x5b\x63\x57\x86\x06\x10\xe2\x2f\xde\x9a\xf7\x32\x2c\x2f\xc4\x84\x3b\x9b\x94\x4b\xe9\x21\xbe\xe5\xfd\x7c\xdf\x7a\x12\xd6\xb8\x22\x1f\x9c\x32\x8b\xbe\x39\x7e\xb4\x41\x6a\x61\xaa\x62\x06\x4e\xd8\xb9\x30\xd6\x8c\x03\xb8\x42\x19\x19\x20\x4f\x50\xf2\x22\x48\xb7\x00\x7c\x32\x5b\x8b\xb0\x54\x7e\xc7\xde\xf5\x4d\xd0\x41\xa9\x55\x26\x7d\x67\x8e\x1f\xba\x0f\x79\x9a\x08\xd1\x05\xb8\x63\xe6\xe9\x40\xe6\xeb\x47\x9a\x9e\xcc\xd7\x1f\xfa\xe7\x28\xf3\xf5\x43\x27\x78\x34\x20\x1b\x0c\x14\x61\x29\x83\x58\xca\x3b\x10\x61\x09\xd8\xbd\x72\x90\x8b\x00\x45\xa9\x65\x00\x81\x34\xb8\x73\x19\x55\x99\xe3\x40\xbd\x0b\xf9\x91\xdf\x3d\x74\x29\x95\x91\x77\x52\x69\x39\xd3\xf0\x28\x10\x6f\xf5\xd7\x3f\xdd\xe6\xfd\xce\x29\x73\xcb\xbb\x17\x52\x97\x4b\xf9\x35\xd3\x76\xb6\x84\x42\x5e\xc4\x4f\x6c\x09\xe6\xf2\xfd\xf5\x1f\xbe\xbe\xe9\x3c\x16\x87\x48\x54\x79\x02\x3e\x7f\x25\xe6\xd6\xd1\x9f\xdb\x7c\x46\x5c\xbe\xbf\xae\xfb\x2c\x9d\x2d\xc1\x85\x9a\xe1\xf1\xaf\x25\x25\x5a\x4f\x37\x66\xf0\x14\x27\xc9\xad\x44\x8e\xe2\x01\x78\x02\x91\xef\x40\x1e\xd7\x85\x1b\x41\x70\x76\x50\x3a\xf0\x60\x58\x60\xe0\x63\x69\x84\x9d\xfd\x05\xb2\x30\x11\x37\xe0\xf0\x43\x64\x80\x95\xce\x51\x8e\xdc\x81\x0b\xc2\x41\x66\x17\x46\xfd\x5c\xf7\xe6\x45\xb0\x34\x0c\x22\x96\x0f\x04\x5a\x67\xa4\x16\x77\x52\x57\x30\x12\xd2\x20\xca\xae\x85\x03\xec\x57\x54\xa6\xd5\x03\x35\xf1\x13\xf1\x83\x75\x20\x94\x99\xdb\x0b\xb1\x0c\xa1\xf4\x17\xe7\xe7\x0b\x15\x92\x04\xcc\x6c\x51\x54\x46\x85\xf5\x39\x09\x33\x35\xab\x82\x75\xfe\x3c\x87\x3b\xd0\xe7\x5e\x2d\xc6\xd2\x65\x4b\x15\x20\x0b\x95\x83\x73\x59\xaa\x31\x4d\xd6\x90\x14\x9c\x14\xf9\xaf\x5c\x94\x99\xfe\x69\x07\x78\x5b\x0c\x8d\x7f\x24\x50\xf6\x40\x19\x05\x0a\xee\xad\x8c\x9f\xf2\x2a\x1a\x60\xe2\x23\x84\xc7\x87\xd7\x37\x1f\x45\x1a\x9a\x01\xce\xb0\x6d\x9a\xfa\x06\xcc\x08\x22\x65\xe6\xe0\xb8\xe5\xdc\xd9\x82\x7a\x01\x93\x97\x56\x11\x45\x83\xc8\xb4\x22\xde\x5f\xcd\x0a\x15\x70\xff\xfe\x5a\x81\x0f\xb8\x03\x13\xf1\x8a\x44\xbf\x98\x81\x88\xf4\x3b\x11\xd7\x46\xbc\x92\x05\xe8\x57\xd2\xc3\x67\x07\x32\x42\xd3\x8f\x11\x78\xc7\x81\xb9\xad\xb5\x6c\x36\x66\x38\xb5\x5e\x24\x9d\x61\xc7\x9e\x6c\xd1\xde\x4d\x09\x59\x87\x06\x12\x07\x44\xbe\x01\x88\xe9\xbb\x78\xcc\x3e\x2a\xc4\x5f\x54\x3f\x50\xb0\x6f\xbe\xda\x98\xd4\xab\xa6\x65\x62\x05\xc8\x6a\x98\xfa\x20\xbd\xee\x20\xc6\x0c\xb4\x35\x0b\xda\xcf\xad\xbe\x0b\x65\xde\x80\x59\x20\xf7\x7b\xb1\xf5\x72\x07\x8c\xe3\x77\x24\x7d\x6e\x20\xb3\x26\xdf\x5a\xcf\x26\x24\x95\x51\x45\x55\xb4\x58\xb6\xe7\xef\x88\x7f\xad\x96\x0a\xd5\x0d\x61\x60\xa5\xd7\xac\x0c\x36\x02\x29\x71\x8a\x19\xb0\x48\x9d\x88\x2b\x98\xcb\x4a\x13\x7e\x8a\xa9\x78\x96\xda\xad\x94\xd6\xd8\x2a\xb3\xc6\xab\x1c\x70\x5b\x1a\xa9\x20\xbd\xf0\xd6\x1a\xfc\x57\x11\x0b\xa5\xbe\x9e\x6f\xcd\x7a\x6e\x5d\x21\x03\x71\xf2\xaf\x5f\xee\x80\x47\x9b\xcb\xb7\xf6\x56\x56\x1e\xf2\x03\x70\xb8\x36\xb9\x42\xdd\xd3\xb3\x24\x65\x14\x6a\x73\x76\xee\x65\x7b\x9b\x78\xe4\x99\xb5\x1a\xa4\xd9\x1c\xd9\xd9\x85\x03\xef\xaf\x40\xe6\x5a\x19\x38\x6e\x4b\x3e\x92\xd0\xb8\xa7\x6d\x09\x0a\x91\xc9\x74\x36\x45\x76\xe4\xbe\x15\x85\xbc\x85\x7a\x28\x31\x83\x39\xd1\x3d\x4d\xba\x05\xf1\x60\x71\x0b\xe6\xa4\x18\x4e\x68\x8c\x56\x2f\x8d\xd1\xc0\x9b\x85\x7f\x2b\x53\x01\x7e\x55\x3a\x9b\x61\xc7\xfc\xa9\x68\xcb\x31\x64\xf5\x12\x1b\xb3\x66\x2b\x56\x2a\x20\xb6\xbc\xdf\x58\xf6\xeb\xfb\x0c\x20\x87\x1c\xb7\xd6\x53\x33\xc6\x07\x5f\xb9\x39\xaa\xca\xb8\xc0\x0d\x80\x47\x71\x2f\xde\xda\x00\xbc\x23\xf5\x02\xe9\xeb\xc8\xf9\xc0\x07\x55\x10\x4e\xe6\x95\x4b\x7c\x98\x60\x26\xfb\xb7\xaf\x83\xa2\xbf\x9e\x4e\xfd\xf6\x86\x3e\x1c\xd1\x92\xb6\x7a\x60\x7f\xdf\xd6\xa4\x96\x98\x54\xd2\x8c\xba\xd3\x7b\x81\xdb\xa4\x3c\x8b\x1e\x92\x09\xc8\x3d\xac\xc8\x95\x47\x99\x53\x29\xbf\x14\x33\x08\x2b\x00\x23\xe0\x1e\x87\x56\x41\xfc\x0c\xce\xd2\xbe\x20\x84\x90\x8b\xaa\xb9\xea\x43\xdb\x4f\x59\xe5\x9d\x42\xf5\xe2\xdf\x94\x0f\xd6\xad\xdf\xa8\x42\x85\x23\x30\xba\x61\x30\x56\xe7\x89\x1b\xdf\x00\x2f\xd5\x41\x90\x88\x04\x56\x48\xad\xed\x4a\x20\x2e\xce\x64\x76\xfb\x88\x10\xd8\x00\xed\x23\x02\xc4\x83\x86\x2c\x58\x77\x00\x08\x6f\xe4\x0c\x74\xdd\x98\x28\xb9\xd9\xf7\xd7\xf7\xbc\xa4\x0e\x60\x56\x4b\xeb\x5b\x6a\xb3\x74\x10\x3f\x6f\xa9\xce\x89\x96\x10\xf1\x2d\xb5\x9a\xcf\xbb\x2d\x1a\x3a\x98\x88\xeb\x20\x8a\xca\x07\x51\xc8\x90\x2d\xdb\xda\x69\x6d\x29\x3c\xf5\x42\xe3\x44\x7b\x08\x63\xb7\x8c\xc4\x1f\x75\xf9\xfa\x1e\x15\x1d\xdf\x78\x23\xf6\x42\x64\xf3\x13\xde\x67\xb4\xe1\x11\x4d\x74\x17\x5e\xa8\xfb\x28\x07\xec\x63\x20\x8c\x6a\x3f\x21\xe0\x5c\xbe\xbd\xea\x43\x75\xfc\xa9\x00\xc5\x8e\x29\x6d\x4c\xea\x72\xcf\xc0\x51\x0b\x4c\x6f\x88\x2f\x21\xab\x94\xca\xf8\xa8\xdb\x8e\x84\x14\xb7\xb0\x66\x35\x18\xb5\xeb\x12\x9c\xac\x1b\x3b\xd0\x51\xc6\x00\xb6\xa2\x46\x51\x27\xde\x31\xb7\xfd\x40\xe7\xdf\x2d\xac\x77\xbf\xdc\x58\x1e\x8e\x1a\x95\x13\x5e\x27\x3e\xa8\x45\x5e\xbd\x34\x59\x96\x5a\x41\xbf\x62\xd2\xfc\xf6\x68\x21\xcd\x2f\x41\xe0\xe8\x29\xd6\x20\x6b\xd4\x66\x06\xea\x53\xcf\x00\x44\x5c\x59\xaa\x92\xd8\x85\xf0\x40\xd8\x92\x2c\x8b\x3f\x48\xad\xf2\xba\x0b\xc6\x8b\x6b\x33\x42\x41\x82\xff\x10\x9d\xb1\xe0\xba\xb2\xe0\xdf\xda\x40\x4f\x3e\x79\x91\x3c\xfc\xd1\x4b\xe4\xe6\x84\x4e\x46\x48\xe7\xe4\x9a\x34\xaf\x96\x79\xe1\x27\xe2\x9a\xf5\xc6\x1a\x1c\xca\xa3\x82\x6f\x5d\x5a\x0b\x99\x7a\xdc\x11\x77\x41\xa4\x3d\x03\xf2\x1f\x40\x51\x86\x75\x6f\x1f\x11\x04\xd6\x75\x20\xb0\xa7\xbb\xd8\x15\x71\x62\x7e\xc3\xa6\xa4\x26\xd9\x1d\x05\x2f\x19\x47\x32\xc0\x42\x65\xa2\x00\xb7\x00\x51\x22\x75\xef\x03\xec\x5e\x9a\x3c\x01\xf6\xa9\x19\xcd\x6d\x47\xab\x48\xc4\x3d\x5a\x20\xff\xc6\x88\x5f\x3b\xdf\x25\xf0\xed\x68\xb0\xc3\x86\x39\x76\x7e\xc4\x06\x49\x3a\xec\x80\x46\xdb\x73\x78\x88\x1b\x1c\x84\xd8\x36\x07\xe6\xa1\x99\xb7\x15\xb2\x44\x4c\xfc\x1b\xb2\x30\x42\x86\xbf\x8b\x52\x2a\xe7\x27\xe2\x92\x1c\xa0\x1a\x3a\xef\xa2\xe2\xd6\xee\x06\x7b\x50\x5e\x20\xbc\xef\xa4\x8e\x2a\xaa\x34\x02\x34\xb3\x50\x3b\xdf\xe2\xfb\xa3\x28\xe9\x90\x15\xcd\x15\x68\xb2\xb6\xcf\x6e\x61\x7d\x36\xda\xc2\xde\xb3\x6b\x73\xc6\xcc\x75\x0b\x5f\x6b\x4e\x6c\x8d\x5e\x8b\x33\x7a\x77\x76\xba\xa8\xd8\xbb\x9b\x7b\x5e\x46\xfc\xef\x61\xc5\x5b\xba\x50\x47\xd1\xe5\xaf\x10\x4a\x95\x07\x56\x86\x88\xb4\x04\x24\x9d\xa0\xd6\x00\x48\xbf\x36\xb0\x22\x49\x7f\xaa\x80\x46\x9d\x8a\x3c\xc6\xb9\x0c\x3d\xa6\xec\xd6\x44\x3f\x70\xfb\xe8\x5d\x40\xe8\xce\xd5\x42\x94\xd2\xc9\xc2\x4f\xc4\x7b\xe6\xcc\x0c\x6b\xd5\x63\x5e\xdf\xc4\x85\x7d\x5c\x97\x20\xfe\x35\xf5\xc6\xa3\xf7\x03\xfe\xb0\xa8\x2b\xe4\xfd\x4d\xe5\x16\x3b\x66\x8f\x3f\x69\xd6\xef\xe6\xbb\x5f\x8f\xf7\x2a\x72\xdb\xed\x0e\x70\x9e\xae\x9f\xa8\x6d\xb7\x35\xda\x6e\xe3\xf5\x24\x6d\x41\x1a\xb2\x7c\xb2\x25\xe4\x15\x9a\x53\x72\x66\x37\x7c\xb6\xdb\x9f\x92\x54\xab\x20\x7d\x8c\x22\x63\xe6\xad\xae\x42\xad\x55\x3f\x83\xfb\x0b\xf1\xed\x73\x41\xd6\x61\x09\x2e\x03\x13\xe4\x02\xfa\x6c\x0c\x6e\xfb\x62\xfa\xd5\xf3\xc8\xd2\xb1\xd7\x68\x4e\x4d\x79\x27\xef\x5b\x5e\x54\xa4\xb9\xe9\x44\x5c\x6e\x0c\x48\xdf\xe9\xac\xd2\x64\x7c\x91\xf3\xaa\x35\xec\x6c\x2d\x9c\xad\x4c\xce\xe8\xb3\x65\xd5\xbc\xbe\x97\x45\xa9\xe1\x42\xac\x96\x60\x58\x49\x55\x9e\x04\x79\xb0\xe2\xeb\xe9\x57\x4c\xf5\x88\xe7\x8d\x36\xdc\x40\x4e\x22\xd8\xaa\x52\xa8\xa2\x80\x5c\xc9\x00\x7a\x9d\x3a\x82\x84\xe4\x09\x69\x7d\x90\x2e\xf8\x11\x47\x4d\x6a\x2d\x27\x6c\x38\xa9\xd1\x20\x21\x83\x01\x56\x0d\x98\x72\x4b\x50\x01\xb2\x5d\xc5\x8b\xaf\xa7\x5f\xf5\x5b\x6c\xef\x4c\x06\xd4\x43\xfd\x25\x79\xe1\x67\x68\x93\xdc\x2a\xad\x21\x1f\x1d\x5c\xca\xbc\x72\x61\x09\x6e\x24\xc0\xf8\x64\xc7\xe2\x5c\x37\xe6\x59\x8f\xe0\x38\xcc\x23\x64\x40\x84\x67\x83\xb7\x65\x00\xc7\xc5\x23\x43\x0f\xa2\xb0\x3e\xec\x9e\xfe\xd3\x9d\xb8\x7d\x3f\xbe\xad\x66\xe0\x0c\x04\xf0\x63\x65\xc2\xd8\xba\x31\x93\xc3\x85\x08\xae\x82\xdd\x24\xda\xc2\x9e\x2f\x9e\x50\xdb\xf1\x8b\x2d\x08\x7f\x36\xba\x7c\x38\xb5\xe5\x76\x65\x76\xd3\x35\x31\xcf\x48\xd1\x1d\x5f\xdd\xb1\x34\xd9\x35\xdd\x37\x10\x19\xc7\xc6\xd6\xdf\xf5\xe3\xda\x09\x04\x1b\xa9\xaa\x43\x8f\x28\xb5\xc9\x35\x38\x3a\x62\x16\x35\x41\xcd\xad\xd6\x76\xc5\xd6\xb0\xe7\x40\x29\x12\xdc\x36\x7f\xd9\xa2\xbd\x1e\x3e\xd1\xcc\xa5\xf1\x5e\x06\x21\xb5\x26\x0a\xf4\x3b\x49\x50\x83\xf4\x61\x17\x58\x1e\x9b\x04\x0f\x28\xa3\xf4\xfa\xb0\xf0\x27\xa9\x4d\xb3\x6d\x9c\x07\xaf\x2a\xe7\xc0\x04\xbd\x8e\xce\x06\xbd\x16\xbe\x2a\x4b\xeb\x02\xf9\xd9\xa3\x1e\x83\x3a\x5a\x47\xd8\x9f\xd5\xb8\x86\xef\x8e\xd0\x03\xf6\x52\xf4\x9e\xe5\x25\x17\xc6\x21\x05\x2c\xc5\x44\xf9\xe9\x2c\x9a\xe3\x5d\xf2\xaf\x5d\xd6\xec\xf2\x3e\xd9\x19\xd2\x1b\xeb\xe8\x9d\xce\xd3\x9b\x20\x4d\x2e\x5d\x1e\x17\xf5\xf4\xa9\xaf\x3f\xff\xec\x31\x9c\x34\x50\x3f\x0e\x1e\x56\xc8\xb6\xb2\x4f\x7a\x1b\x9d\x60\xbe\x88\x63\x8d\xbe\x8d\x40\x68\x33\x8f\x68\x54\x57\xc6\x07\x57\xd1\xc2\x73\x32\x2c\x38\x68\x87\xd6\x89\x0f\x16\x1f\x46\x67\x75\x2b\x60\x87\xd2\x52\xae\x89\x97\x40\x40\x8e\x01\xf7\x31\xb4\x19\xac\xd5\xc4\x29\xe9\x5b\x52\x16\x1c\x04\xa7\xe0\x0e\x84\x74\x33\x15\x9c\x74\xeb\xd6\xb6\x7d\x5c\xc2\x9a\x38\x16\x32\xe0\xbf\x56\xe0\xd6\xcc\x2d\x4c\xde\x0a\x9a\x90\x73\xc3\xdd\xe1\x54\x90\x1b\x16\x36\x57\xf3\x35\x32\x10\x46\x84\xed\x30\xe9\xc5\xf9\x79\xc3\x10\x70\xff\x73\x9b\xf9\xf3\xca\x83\x1b\x2f\x2a\x95\xc3\x79\x6b\x3b\x76\x73\x95\x83\xd6\xaa\x10\x0b\x30\x68\x71\x41\x7f\xd0\xab\x77\x0b\xce\x7e\xd7\xfa\x26\xee\x81\x2d\x79\xdb\x71\xa5\x73\x75\x3f\x42\x03\x27\xfa\x25\x11\xc4\xee\x0e\x39\x74\xb0\xf5\x70\x42\x8a\xca\xa8\xbf\x56\x31\x6e\xf6\xee\xed\x9b\xff\x14\xd7\xdf\x53\x6b\xea\x95\x6d\xc3\xa5\xf4\x51\xae\x81\x41\x14\xbd\x53\x39\xc5\x3e\x63\x8c\xbb\x36\x20\x71\xb0\x51\x13\x85\x73\x10\x2a\x67\x38\x10\xd2\x0a\xad\x26\x42\xcf\xd5\x7c\x0e\x2e\x66\x52\x98\xe6\xb3\x52\x7a\xcf\x01\x13\x15\x5d\x7c\xfc\x89\xd4\xde\x72\x4c\xab\x98\x29\xd3\xa0\x53\x5c\x80\xaf\xe6\x73\x75\xcf\xc6\x67\x9a\x63\xfc\x1c\xe7\x4f\x00\xc0\xee\xef\xa4\x56\x39\x47\xe3\x5d\xa5\x51\xb4\xf8\x8d\xf5\x36\x01\xf5\x19\x20\xd7\x37\x99\x0c\x0d\x14\x35\x45\x08\x53\x88\x91\x47\xad\x9d\x1d\x75\x70\xa8\x36\x95\xd3\xf4\xac\x69\xed\xc1\x44\xfc\x64\xb6\xc1\x57\x7b\xce\x6b\x63\x3b\x6d\x53\xce\x90\x21\xf3\xd4\x8f\x5a\x1d\x31\x64\xde\xbe\xfb\x18\x81\x2d\xa4\xf8\x66\xfa\x2f\x62\x2c\x94\xf1\x01\x64\x3e\x12\x2a\x02\x1c\x14\x8a\xe7\xd4\xec\xe5\xf4\x85\x78\x15\x63\x8b\xd6\x89\x6f\xa7\x53\x86\xe6\x07\x0e\x17\x71\xc4\xfc\xa3\x2a\xc0\x56\x41\x28\x0e\xd4\xb1\xcf\xa9\x8d\x2e\x19\xd1\x55\xd4\x78\xe6\xa8\x10\x25\xf7\x04\x07\x84\xb4\xb6\x21\x40\xde\x38\x0f\x52\x70\x9d\x09\x12\xe9\x79\x2d\x9e\x25\x9c\xd5\x6b\x21\xe7\x1c\xae\x85\x14\x85\xe3\x08\x61\xdd\xed\x07\xfc\x62\x7c\x49\xad\x96\x20\x73\x70\xcf\x09\x94\x97\xe4\x3b\xcd\x6b\xd3\x38\x51\xc4\x46\x3c\xe2\x33\xb3\x76\x95\x43\x51\xda\x00\x26\x5b\x9f\x1d\xe0\x05\x7b\x59\xad\xde\xe3\x98\x12\xff\x18\xee\xfe\x03\x3b\xa5\xa2\x7b\xf4\x16\xd6\xbe\xe5\x38\xef\xaa\xeb\x9e\xb1\xde\xba\x85\x34\xea\x67\xe6\xb8\x29\xa5\xf0\x67\x10\xcf\x28\x4d\x8f\xf9\x30\xb9\xba\x9f\xb7\x98\xad\x5c\xc7\xb8\x48\xf2\x82\x7b\xce\x16\xa3\xa0\x1e\x91\x68\x13\x26\xf5\xb1\x0f\x77\xa7\xb2\x9e\x84\x96\x83\x9c\x9a\xa1\xfa\x49\x4c\xda\x1c\xcf\x9c\x9f\x12\x0a\x26\x7f\x6e\x24\x1a\x24\x31\x85\x34\x6a\x52\xce\xe2\x44\x5c\xfb\x86\x79\x90\x50\xe2\xfc\x4f\xb3\xa8\xc5\xa4\x1f\x09\xa9\xc3\xd2\x56\x8b\xa5\xf0\x96\xf8\x6a\x7c\x41\x3c\x8a\xc3\x77\x32\xd1\x16\x79\xb3\x28\x67\xa5\xcd\x41\x9a\xdc\x23\x59\xa2\x8e\xe1\xd0\x28\x60\x22\x96\x55\xb0\x85\x0c\x2a\x43\x0a\x9c\xd4\xa4\x53\x3a\x55\x48\xa7\x90\x9e\x4c\x00\x83\xcc\x74\x6e\x5d\x9d\x9d\x2a\x1a\x64\x8f\x5b\x4e\xce\xaa\x2a\x8e\x95\xd7\xa9\xb3\xbd\x59\x33\xa7\xee\x9d\xca\x91\xcc\xe6\x0a\x9c\xff\x15\xc1\xee\xd0\x36\xee\x45\xf6\x1a\xfa\xc7\x0a\xda\x3a\xc5\xb4\x93\xef\xc2\x4f\xe2\x9e\x82\xcc\x96\x0c\xcf\xee\x9e\x4f\xc4\xa5\x61\x77\x7e\x33\xec\xb6\xb3\x16\xbb\xfb\xe9\x2c\x67\x9d\xfd\xa7\xb3\xa6\xe9\x48\xcc\xaa\xd0\x79\x15\x23\x49\x99\x34\xd6\xe0\x9e\x6d\x64\x98\x51\xfc\x9e\x0c\xa4\x48\x65\xd1\x8a\x6b\xc4\x13\xd9\x6d\xb6\xe4\x3f\x5a\xa8\x88\x86\x7d\x2d\xb4\x6c\x47\x36\x71\x5e\x9d\xf5\x50\x77\x9a\x64\x78\x0c\x54\xfc\x64\xc4\x0f\x71\xd9\x52\x5c\xbd\xbd\xf9\xaf\x37\x97\xbf\x7d\xfd\xe6\x51\xb6\xbe\x9e\x9f\xff\x24\x9e\x6a\x57\x06\xdc\x07\x20\x85\x23\xdb\xc7\x2e\xbb\x01\xe4\x18\x18\x4d\xcb\xce\xa1\x64\x52\x48\x91\xde\x94\xc9\x77\x3d\x17\x97\x6f\xde\xd4\xed\xa2\xb8\xa2\xb8\x6a\xe3\x9b\xca\x41\x43\x60\x0d\xa9\xc9\x47\x4a\x90\x5c\x48\x37\x93\x0b\x94\xa9\x9a\xe3\xcd\x8d\x7e\x15\x5b\x2a\xa4\x77\x23\x17\x3c\xbc\x6c\xb1\x46\xd2\x08\x0c\xb9\xfc\x0d\x8a\x54\x15\xdd\x09\x34\x3e\x0d\x10\xf3\xdb\x2c\x3f\x6f\x7f\x49\x62\x9f\x30\xaa\x49\x48\xe1\x5d\x8f\x9e\x08\x34\x7b\x49\xab\x72\x84\x75\x71\x3f\x0b\xdc\x46\xd2\xdb\xac\x01\x9e\x17\xf2\xac\xa6\x93\xdd\x61\xa8\x83\x41\xa8\xce\x16\xbc\xeb\x6c\x5c\x13\x71\x00\x43\x0c\x11\x31\xc9\x15\xcc\x74\x82\x15\x1a\x82\x58\xdb\x4a\x44\x86\xb1\x26\x9d\x78\x65\x1a\x15\x9f\xe8\xb1\xf3\xa4\x26\xd8\xb8\x69\xa4\x24\x36\x54\x21\x53\x72\x1b\xed\xbc\x09\x23\xd4\x97\x50\x0b\x8d\x39\xf2\x4c\x4b\x23\xe1\x89\x8a\x5d\x54\x3b\x5a\x1d\x10\x30\xf7\x05\xe5\x8e\x89\x37\x8b\x3d\x09\xaa\x07\x00\x78\xf9\xfe\x3a\xa5\xa7\x26\xa5\xd5\x31\x38\xf7\xc6\x60\xc5\xf1\xb1\x40\x21\x66\xda\x66\xb7\xb4\x53\x57\x88\xe3\xa7\x4e\x11\x11\xdd\x55\x30\x12\x97\x6f\xaf\x50\x7d\x23\x67\x07\xf6\x56\x6b\xee\x67\x73\xeb\x60\x41\x3e\xb7\x34\xc2\x99\x98\x2b\x23\xb5\xfa\xb9\xc6\xff\xe6\xb3\x06\x4f\x23\xcd\x35\xc9\x9e\xb7\xb0\x1e\x33\x8f\x63\xc3\xb2\x32\x41\xe9\x94\xab\x9b\xb0\x8c\xfe\x28\xec\xdd\x66\x12\xcb\x5c\x6a\x8f\xc4\x60\x99\x38\x6a\x0e\x39\x42\xc5\xd8\x83\x13\x06\x20\xf7\xe2\x8c\x47\x3d\x13\x25\xb8\x42\xf9\x36\xe8\x69\x7e\x23\x61\x11\x57\x56\xca\x83\xf8\xe6\xe5\x4b\xf1\xec\x47\x13\x53\xbe\xc8\x66\x7d\x6d\x82\x0a\xeb\xe7\x35\x6b\x48\x76\xd4\x71\xdb\xd5\x9f\x17\xd7\xfd\x35\x64\xfa\xb0\x7d\xda\x00\x17\x71\x97\x3a\x49\xf9\x24\x66\x70\xea\xcc\xb7\x33\x87\x0f\xcc\x99\x73\x89\x37\x11\xff\xff\x2d\x53\x77\xd7\xda\x8f\x20\xb2\xfd\xda\x67\xdf\xd2\xdf\xb6\x72\x61\x77\x2e\xfd\x31\x95\xaf\x13\x57\x54\xa9\x13\xf7\xf2\xc7\xeb\xab\xc7\x5d\x4f\xa5\x1e\x6d\x83\x0e\xe5\x3b\x08\x8a\x9d\x34\x4c\x7c\x6f\x33\xc4\x9c\xbd\x0d\x70\x23\xf6\x36\xa8\xd4\xbe\x0e\x8e\x30\x76\x0e\xe7\x78\x1c\xe8\x64\x3b\xa1\x3c\xfd\x36\xbc\xb1\x6c\xa0\x67\xb5\x95\xd2\x0e\xcd\xce\x60\x29\xef\x94\x75\xe9\x79\xf4\x19\x7f\x76\x02\xc6\xc9\x8f\xa5\xc9\xc7\x9c\x16\xfb\x50\x6f\xed\xcc\xda\xe0\x83\x93\xe5\x91\x0a\xe7\x6f\x53\x7b\xce\x0d\x69\x58\x2c\xe9\xea\xda\xa2\xc2\xcf\x5e\xd6\x98\x28\x0e\x26\x93\xa5\xaf\x38\xc7\x8d\x64\x11\xf1\xe1\x64\x8d\xb1\x23\x2a\xc6\x5c\xfe\xf7\xbf\xff\xc7\x37\x33\x2a\x29\xcf\x01\xb2\xa5\x34\xca\x17\xbb\x39\xf4\x71\xea\x09\x0f\xf8\x01\xf6\x04\x18\xb7\x16\xfb\x2a\x7d\xd3\xbb\xd8\x7a\xa2\xc9\x9b\xe7\xc6\xd1\x95\x93\x6d\x78\x90\x97\x16\x57\xbd\x69\x80\x06\xa9\x74\x9d\xb6\xd8\x12\xec\xb5\x8b\xb4\x4e\x7d\x45\xde\xe0\xcf\x9b\x04\xb6\x60\xa3\xd3\x68\xdd\xec\xc7\xe4\x4a\x06\x49\xca\xb2\xad\x42\x0c\x66\x01\xc9\x15\x79\xb4\x9c\x3b\x56\xd1\x3b\x45\xd5\x7b\x3c\x65\xef\x04\xbe\x2d\x18\xd3\xe8\x9c\xd8\x69\xf3\x7b\x7a\x3d\xe7\x09\x71\xd8\x0e\xf7\xb9\x54\x90\x41\xe7\x60\x54\x72\x5e\xc6\x87\x48\x95\x2e\x99\x9e\x51\xf7\x88\x8e\xa9\xe6\xe0\x14\x25\x33\x4b\x76\xee\x8a\x7f\xbf\x79\xf7\xf6\xfc\x77\x36\xda\x31\x32\xa3\x64\x7a\x3a\x96\x52\x90\x06\x9f\x4e\x74\x46\x26\x73\x83\x6f\x26\x85\x34\x6a\x0e\x3e\x4c\x62\x6f\xe0\xfc\x1f\x5f\xfe\x69\x22\xbe\xb7\x4e\x00\x87\x6a\x47\xb5\x72\x9a\x0e\x19\xb5\xf0\x8a\x16\x53\x7f\xdb\xf8\x7a\x4a\x9b\x2c\xbe\x15\x4d\x36\xc8\x5b\xf2\x06\xcb\x68\x6a\x6b\x75\x0b\x17\xe2\x0c\x31\xae\x35\xf4\xdf\x90\xc1\xff\xfd\x4c\x3c\x5b\x91\x51\x71\x86\x7f\x9e\xf1\x80\xb5\xa2\xd5\x3e\xea\xd2\x0c\xcc\xb1\x54\xa7\x16\x0b\x3e\x84\xb0\x04\x01\xc8\xd9\x28\x24\xae\xe6\x68\x9c\x34\x8d\x4d\xf4\xf6\x34\xae\xe7\xcd\x89\xfc\xf1\xe5\x9f\xce\xc4\xb3\xee\xba\x84\x32\x39\xdc\x8b\x97\xb5\xa1\x59\xda\x3c\xe5\xb6\xf8\xb5\x09\xf2\x9e\xe2\xe7\x4b\xeb\xc1\xb0\x4f\x36\x58\xb6\x83\xc9\x7f\xb5\x02\xad\xc7\xec\x49\xc9\xc5\x8a\x13\x31\x13\x28\xd9\xc9\x5c\x4a\x17\x36\xce\xca\x7d\x7c\x77\xf5\xee\x22\xa5\x57\x7b\xb5\x30\xc9\xbf\x4b\x56\x40\xf4\x30\x77\xbc\xe9\x15\x6f\x12\x72\xc3\xa5\x34\x8b\xda\xc0\x9b\x57\xc8\xf4\xf7\x04\x81\x1f\x40\x0f\xc7\xa8\xa5\x5f\x9a\x62\x7a\x12\x00\x8e\x51\x4e\x1f\xa4\x9e\xfa\x7e\x7d\x2e\xb3\x26\x83\x32\xf8\x73\x7b\x07\xee\x4e\xc1\xea\x7c\x65\xdd\xad\x32\x8b\x31\x22\xe8\x38\x3a\x62\xd8\x7b\x74\x7e\x9c\xda\x7a\xf2\x7a\x0f\xf8\x10\x77\x2f\x9a\x1d\x03\xff\x80\x95\x93\xdf\xec\xfc\x51\x17\x9e\x44\xef\xc3\xe4\x53\xad\xec\x6d\xf6\x83\x64\xca\x1a\xcd\xb6\x29\x5e\xc8\x9c\x59\xaf\x34\xeb\xcf\x4e\x20\x08\x5e\xca\xba\xc8\xd6\xe3\x28\xd8\x49\x0f\xa4\x73\x5d\x9e\x82\x3b\x8f\x0a\xcf\x23\x6c\xa0\x87\x58\x41\x8f\x42\x36\xc7\x58\x47\x27\x2c\xf6\x28\xa3\x43\x88\xdd\x59\x1c\xbd\xe0\x38\x23\xcd\xac\xf6\x0b\x22\x54\x1a\xd5\x11\xfb\x6a\xe4\x7d\xa6\x6d\x95\x8f\x95\x51\x21\xe9\x87\x82\x3b\xe2\xd4\x7f\xa3\xf4\xa8\xad\x2f\x37\x71\xca\x02\xb5\x8b\x28\x3e\xde\x03\xe7\x7d\x91\x3e\x41\xae\xef\x2b\x28\x1d\x50\x9c\xf2\x82\x65\x60\x13\x35\x8f\x7e\xdf\xf4\x1e\xfb\x48\xa7\xe0\x49\x64\x35\x2e\x1e\xf2\x36\x09\xd2\x18\x58\x40\x25\x3d\x6e\x22\x6e\x56\x8a\xce\x0e\x59\x81\x4b\xbd\x81\xcc\x01\x5d\x97\x31\xd9\xed\x11\x3f\x61\x5f\xf2\x4e\x9f\x27\x00\xbe\x3b\x99\xbe\xf3\xb7\x9e\xde\xb2\x46\x42\xde\xb6\xbe\xed\x89\x5b\xf0\xe0\x1d\xf8\x74\x18\x1c\x81\x97\x7b\x8f\x24\xf7\x42\xe7\x91\x8f\x26\xa7\xdf\xde\x23\xca\x27\xac\x7a\x2e\x95\xae\x1c\x5c\x59\x04\xec\x91\x4b\xfa\xbe\xfd\x4d\x5a\x54\xec\x48\xe4\xfc\xb4\x7d\xc0\x6d\x23\xa3\x4b\x28\x33\xe1\x30\x11\xc7\x7c\x25\x9f\x8c\xe2\x6f\x3a\x7d\xfb\x76\xd2\x50\x4c\x9c\x88\x3b\x90\xf4\xc1\x4f\x59\xbb\x32\x73\x27\xeb\x5c\xa5\xbd\x36\xeb\xc6\x81\xe5\x8d\xef\x92\xdd\x1a\x83\x6b\x1b\x06\x6c\x46\x97\x09\x35\xb6\xaa\xa5\x74\x17\x0e\xde\x98\x8d\x49\xd4\x36\xee\xa7\xda\xe1\xc7\x5a\x8e\x8f\x63\x35\x1e\xc9\x62\x8e\xb4\x16\x07\x4b\x71\xb0\x14\xbf\x3c\x4b\xf1\x48\x1a\x38\x6c\x21\x7e\x49\xd6\xe1\x91\x8b\x3e\x6c\x15\xfe\x82\x2d\xc2\x13\xd6\x78\x84\x25\xf8\x0b\xb7\x02\x8f\x0e\x72\x9c\x60\xfd\xfd\xb3\x59\x7e\x47\xc2\xf0\xa0\xc5\xf7\x8b\xb5\xf6\x1e\x4b\x9b\x36\x36\x87\x2b\x27\x95\x89\x19\xa6\x47\xe7\xd4\x6d\x7c\x97\x74\x50\x3e\x35\x22\x0b\x5b\xf1\x69\x57\x4a\x1e\xad\xcf\x94\x6c\xde\xb4\xe2\x4b\x30\xa4\x56\xe6\xd8\x15\x8b\x11\x9c\x51\xba\xa2\x85\x0f\x4e\xb0\xc8\x55\x5e\x4c\x47\xa2\x00\x69\x3a\xc7\x54\xb0\x79\x4a\x80\xa4\x5e\x62\x36\x32\xce\xa9\x3e\x1e\xa6\x55\xa1\x62\x6a\xf8\x44\xbc\x7d\xf7\xf1\xf5\x85\xe8\x5b\x40\x93\x06\x4d\x39\x0a\x7f\xc6\x7d\xcc\x82\xe6\x7e\xc5\x78\x1c\xb8\xe9\x9f\x3f\x29\xe5\x2d\xa9\x97\xd7\x57\x47\xc2\xfa\x7d\xfd\x41\x02\x72\x0a\x9b\xc6\x38\x5d\x83\x95\x49\xdd\xaf\x93\xae\x63\xa2\x74\xad\xd2\xb6\x8d\xe2\x8d\xbb\x30\x52\x1b\xec\x4e\x7a\xe1\x81\xd4\x80\x06\xc6\x51\x7d\xca\xac\x73\xe0\x4b\xcb\x76\x5f\x4a\x63\xaa\x63\x81\x1f\x3b\xe2\xbc\xd6\xc7\x67\x6b\xb1\x54\x8b\x25\x38\xa1\x91\x09\xd0\xd5\x3c\x55\x01\x9c\x64\xda\xba\xe3\xb2\x3e\x8a\x45\xc7\x81\x33\xe9\x69\xdf\x93\xc1\x21\xab\x60\xe9\xa0\x53\xd4\x68\x5a\x1f\xe2\x94\x9b\x55\xbe\xd2\x20\xcd\xb8\x2a\x85\xb6\x0b\x95\x25\x1d\xa2\xf5\x79\x66\x8b\x52\x3a\xf0\xad\x83\x2f\x96\x96\xc9\xb9\x25\x24\xf0\xab\xd0\x3a\xf3\x14\x1a\xf8\x30\x87\x6c\x12\xae\x17\x80\x4a\xe5\x02\xf9\x12\x5d\xee\xe4\xc5\xef\x6b\xfa\xe7\x3e\x27\xe2\x3f\x7a\xa6\x2b\x39\x23\x54\x65\x38\xd4\xd8\xce\xc7\xc1\x41\x6b\x1b\xe8\xb2\xa3\x7a\xc6\xa3\x0d\x45\xa9\x0d\xd9\xd6\xba\x38\xa9\x90\x52\x57\x92\xf6\x26\x9b\x2e\x91\xef\x24\x54\x49\x17\x8d\x34\x67\x39\x2f\x0d\xa5\xc2\xd4\x6f\x18\x1c\xca\xd3\x11\x0e\xd5\x49\xdc\xf9\x8d\x47\xeb\x26\xe6\xbd\xc7\x8c\x72\x43\x9a\x34\x82\x55\x79\x4b\x8a\x5e\x6e\x0d\x74\xc0\x59\x99\x16\x9c\x1a\xd8\x9a\x9c\x32\x23\x0b\xe9\x6e\x63\x6a\x2b\x27\xed\x74\xb0\xa9\xbe\x36\x89\x0f\xa7\xd0\x7e\x66\xa1\x8a\xf7\x5c\x70\xd6\x2b\xa2\xd4\x36\xae\x21\xad\xa0\xad\x46\x4a\x7b\x07\x58\xed\xb3\x4e\x74\xbd\xce\x5c\x92\x2e\xdb\x87\x5c\x69\xaf\x0e\x5b\x87\x47\xf0\x80\xbb\x43\x32\xbb\xc3\x00\xfa\x6e\x39\x4c\x41\xf9\x16\xaa\xd5\x4e\xaa\x2e\x0d\x22\xc7\x0c\x11\x31\x5a\xf9\xfd\xe9\x34\xca\x76\x6c\x77\xe7\x6d\x2c\x47\x2c\x6d\x7f\xe6\xc5\xb8\x19\x6d\xc7\xfb\x96\x73\x67\x47\x8b\x2d\x8f\xc1\xe9\xf9\x10\x3b\x5f\xf6\xcf\x7e\xf7\xac\xc6\x75\xb2\xfc\xc6\xe3\x74\x18\xef\xc9\x11\xc3\x72\x56\xc3\x49\xd7\xfd\xf1\x65\xb8\x6d\x74\xb0\xb3\x78\x98\xea\x93\x6f\xfc\xdb\xba\x61\xf4\xd0\x81\xc2\x8d\x63\xa2\x3d\x37\x9e\x3e\xe3\x3b\x67\x89\xa1\xa5\xf3\xa0\x1b\xf7\xf4\x3d\xdf\x77\xbd\xeb\x63\x5e\x57\x95\x00\xf5\xbb\x3a\x19\xff\x88\x1b\x2b\xda\x99\xfb\x09\xd0\x91\x07\xf5\x5e\x23\xb7\x77\xc2\xbf\xfe\xe6\xa4\x09\xd3\x2d\xc5\x07\xe6\x48\xf7\x16\xb7\x2f\x0c\x22\x5d\x8b\x0f\xac\x72\x07\x9c\x1d\xb1\x7d\x69\xeb\xb3\xfa\x26\xe5\x91\x68\x5d\xa5\x3c\x12\xf1\x2e\xe5\x91\xe0\xcb\x94\x29\xd5\x36\x5e\xa8\xfc\x7c\xd7\x35\x80\xbd\x7c\xa1\x73\x4b\xf0\x89\xb8\x74\xe8\xae\xe2\xcf\x83\x22\x47\x5e\x64\xf7\x18\xf7\x16\x3f\x0b\x4b\x50\x2e\x9e\x32\x6a\xe9\x62\x89\xad\xf4\x80\xfa\xb3\xdf\xd4\xf6\xf4\x26\x5d\x83\xa5\x5a\x47\xf5\x64\xfb\xd6\xac\xfa\xa2\xac\x19\x1d\x49\xe3\x56\xec\xdc\xe3\xe9\x91\x5f\xed\xce\xaa\x1c\xa7\xe2\xac\x2f\x21\x23\xea\x99\xad\xe3\xd9\x98\x98\x0d\x14\x3f\x6a\x64\x70\x33\x60\xec\x28\x8e\x4b\xa7\x48\xc7\x74\xfd\x4a\x74\x56\xb5\x0c\x2f\x21\x67\xa8\x5d\x74\xe7\xe6\x4f\x3a\x8b\xf4\x2b\xfa\x67\x5c\x7f\xbc\xad\xe1\xef\x41\xf1\x9e\x6b\x99\x4f\xc4\x9d\xe3\x2e\x8a\x6e\x1f\x47\x4f\x37\x03\xee\x3d\xa9\x4f\x0a\x0e\x5d\x9c\x17\x10\xc2\xb5\xce\x98\x2e\x67\xee\xde\xa4\x49\xda\xe2\x8b\xe9\xf4\xab\x16\x0f\xcf\x64\x29\x33\xc5\x17\x60\xc1\x9a\x0e\x39\xc5\xd3\x8b\xb3\xcd\x43\xe3\x74\xb8\x25\x5e\xcd\x81\x78\x81\xaa\xf1\x1a\x42\xab\xb3\xd6\x9d\x7f\x29\x10\x85\xd3\xa2\x71\xeb\x33\xad\x3b\xcf\x9c\x3f\x1c\xf1\x37\x6e\xf8\xfe\x07\xd0\xf5\x11\xf7\x91\x3f\xde\x02\x7b\xf5\x8a\xad\x87\x2c\xb6\x5a\x57\x27\xf8\x60\x9d\x5c\xc0\x05\x67\xd2\xf3\xa3\x6a\x56\x1f\x68\x4b\x50\x22\x5d\xb9\x01\x19\xd1\x49\xe2\x10\xdd\xdb\xc9\xb7\x74\x21\x5c\x66\x02\x7a\x6a\x8a\x2b\xdf\xb8\xd7\x3e\xa9\x40\x9b\x4d\x7b\x2f\xc1\x8f\xda\x92\xf8\xdb\xdf\x87\xb2\x02\x43\x59\x81\xa1\xac\xc0\x2f\xb2\xac\x00\x6b\xb8\x43\x59\x81\xa1\xac\xc0\x4e\x28\x0f\x65\x05\x86\xb2\x02\x43\x59\x81\xa1\xac\xc0\x50\x56\x60\x28\x2b\x30\x94\x15\x18\xca\x0a\x0c\x65\x05\x86\xb2\x02\x43\x59\x01\x31\x94\x15\x68\xfd\x86\xb2\x02\x43\x59\x81\xa1\xac\xc0\x50\x56\x60\x28\x2b\x30\x94\x15\x18\xca\x0a\x0c\x65\x05\x86\xb2\x02\x43\x59\x81\xa1\xac\xc0\x50\x56\x60\x28\x2b\x30\x94\x15\xd8\x0f\xe9\xa1\xac\xc0\xe6\x6f\x28\x2b\x30\x94\x15\x18\xca\x0a\x0c\x65\x05\x86\xb2\x02\x43\x59\x81\xa1\xac\xc0\x50\x56\x60\x28\x2b\x30\x94\x15\x18\xca\x0a\x0c\x65\x05\x86\xb2\x02\x43\x59\x81\xa1\xac\xc0\x50\x56\x60\x28\x2b\x30\x94\x15\x18\xca\x0a\x0c\x65\x05\x4e\xea\x64\x28\x2b\x30\x94\x15\xd8\xbb\xd8\xa1\xac\xc0\xae\xdf\x50\x56\x60\xb8\x2c\x72\xb8\x2c\x72\x28\x2b\x30\x94\x15\x18\xca\x0a\x0c\x65\x05\xbe\xac\xcb\x25\x4f\x84\xe7\x50\x56\x60\x6b\xb1\xff\x14\x65\x05\xbe\x19\xca\x0a\x0c\x65\x05\x86\xb2\x02\x43\x59\x81\xa1\xac\x40\xef\x9c\x06\x4b\x71\xb0\x14\xbf\x08\x4b\x71\x28\x2b\xb0\xbb\xd9\x50\x56\x60\xcf\x42\x7f\x51\x56\xe0\x50\x56\x60\x28\x2b\x30\x94\x15\x18\xca\x0a\x0c\x65\x05\x86\xb2\x02\x43\x59\x81\xa1\xac\xc0\x50\x56\xa0\xdd\x62\x28\x2b\x30\x94\x15\x18\xca\x0a\x0c\x65\x05\x86\xb2\x02\x43\x59\x81\xa1\xac\xc0\xfe\x93\xfa\x43\x59\x01\xde\x9f\xa1\xac\xc0\xbe\xb2\x02\xcd\x93\x2f\xaa\xaa\x40\xa3\x37\xc9\x2c\x83\x32\x40\x4e\x9e\x3c\x9e\x2b\xb9\x78\xc5\x19\x47\x65\x4b\x5d\x39\xa9\xe3\x9f\xf5\x7d\xad\xfe\x42\xfc\xf1\x4f\x4f\x44\x8c\x6d\x45\x1d\x9b\x1f\xfe\x5f\x00\x00\x00\xff\xff\xb1\x5d\xb9\x1c\xfd\xc2\x00\x00")
+var _clusterApiClusterXK8sIo_machinedeploymentsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\xed\x6e\x1c\x37\x92\xff\xe7\x29\x0a\x5a\x04\xb2\x81\x99\xd1\x58\x49\x36\x7b\x03\xdc\x0f\xad\xe5\xec\xe9\xd6\x91\x0d\xc9\xd9\xc3\x21\x09\x6e\x39\xdd\x35\x33\x5c\x75\x93\xbd\x24\x5b\xd2\x64\xb1\xc0\xbd\xc6\xbd\xde\x3d\xc9\xa1\x8a\x64\x7f\xcc\xf4\x7c\x49\x72\x90\x1c\xda\x16\x60\xab\xbb\x49\x16\x8b\xf5\xcd\x22\x4b\x14\xf2\x2f\x68\xac\xd4\x6a\x0a\xa2\x90\xf8\xe8\x50\xd1\x6f\x76\x7c\xf7\x07\x3b\x96\xfa\xec\xfe\xcd\xe0\x4e\xaa\x74\x0a\x6f\x4b\xeb\x74\x7e\x83\x56\x97\x26\xc1\x4b\x9c\x4b\x25\x9d\xd4\x6a\x90\xa3\x13\xa9\x70\x62\x3a\x00\x10\x4a\x69\x27\xe8\xb1\xa5\x5f\x01\x12\xad\x9c\xd1\x59\x86\x66\xb4\x40\x35\xbe\x2b\x67\x38\x2b\x65\x96\xa2\xe1\xce\xe3\xd0\xf7\x93\xf1\x57\xe3\x37\xa3\xc9\xf8\x7c\x72\x3e\x79\x33\x99\x9c\x4f\x26\x93\x6f\xce\x27\xa3\xaf\xbf\x39\xff\x7a\x22\x44\xf2\xe6\x9b\xf9\xef\x07\x00\x89\x41\xee\xfc\x93\xcc\xd1\x3a\x91\x17\x53\x50\x65\x96\x0d\x00\x94\xc8\x71\x0a\xb9\x48\x96\x52\x61\x8a\x45\xa6\x57\x39\x2a\x67\xc7\x49\x56\x5a\x87\x66\xfc\x38\xf2\x13\x1a\xd8\x02\x13\x82\x6d\x61\x74\x59\x4c\x61\xe3\xbd\xef\x2b\x82\x2f\x1c\x2e\xb4\x91\xf1\xf7\x51\xfc\x7e\x24\x0a\xc9\x5f\x78\xe4\x7c\xe7\x07\xbe\xac\x06\xe6\x77\x99\xb4\xee\xcf\xdd\xef\xdf\x4b\xeb\xbf\x29\xb2\xd2\x88\xac\x0b\x74\x7e\x6d\x97\xda\xb8\xeb\x1a\xa0\x11\xe4\xa9\x7f\x21\xd5\xa2\xcc\x84\xe9\x68\x39\x00\xb0\x89\x2e\x70\x0a\xdc\xb0\x10\x09\x52\x9b\x80\x6c\x9e\xc9\x08\x44\x9a\xf2\xf2\x89\xec\xa3\x91\xca\xa1\x79\xab\xb3\x32\x8f\xcb\x36\x82\x14\x6d\x62\x64\x41\x9f\x74\x80\x0f\xd6\x09\x57\x5a\xb0\x65\xb2\x04\x61\xe1\x36\x11\x99\x54\x8b\xef\x8b\xb3\xf0\xbf\x4b\xfd\xa0\xce\x6e\x4a\xa5\xa4\x5a\x9c\x7d\x2b\x64\x86\xe9\xd9\xf7\xea\x4e\xe9\x07\xc5\xe0\x03\xfc\xcd\x6a\xf5\x51\xb8\xe5\x14\xc6\xbe\xb3\x71\xb1\x14\x16\xc3\x5b\x5a\x83\x29\x7c\x6c\x3c\x71\x2b\x9a\x91\x75\x46\xaa\x45\x17\x8c\x9f\xb4\x13\x19\xa8\x32\x9f\xa1\x01\x3d\x07\xa5\xd5\xc8\xa1\xc9\xa5\x12\x0e\xd3\x88\x25\x0b\x4e\x98\x05\xd2\x93\xd9\x0a\xdc\x52\xda\xcd\xc9\x6d\x05\xd0\x60\x91\xc9\x44\xd8\xf0\x81\x87\xf1\xa6\xfd\xd0\x83\x49\x18\x5d\xa0\x39\x04\x4e\x83\x22\x5d\xbd\x10\x78\x22\x5d\xdd\x74\xc3\x28\xd2\xd5\x53\x01\x3c\x18\x91\x35\x05\x82\x5b\x0a\x07\x4b\x71\x8f\xe0\x96\x48\xdd\x4b\x83\x29\x38\xcc\x8b\x4c\x38\x04\xe2\xc1\xad\xd3\x28\x8b\x94\x06\xea\x9c\xc8\xf7\xfe\xdd\x53\xa7\x52\x2a\x71\x2f\x64\x26\x66\x19\xbe\x08\xc6\x1b\xfd\x75\x83\x5b\xbf\xdf\x0a\xb2\xa7\xa2\xfb\x37\x22\x2b\x96\xe2\x4b\x7e\x64\x93\x25\xe6\x2c\x4f\xe9\x37\x5d\xa0\xba\xf8\x78\xf5\x97\x2f\x6f\x5b\x8f\xa1\x3d\xd5\x0d\xa0\x41\x5a\x46\xbe\x6f\x05\x73\x6d\xf8\xd7\x0d\x69\x61\xe1\xe2\xe3\x55\xd5\x67\x61\x74\x81\xc6\x55\x02\xcf\xff\x34\xb4\x44\xe3\xe9\x1a\x04\xa7\x04\xa4\xff\x0a\x52\x52\x0f\xe8\x01\x08\x72\x07\xd3\x30\x2f\x5a\x08\xc6\xb3\xc1\xc2\xa0\x45\xe5\x15\x06\x3d\x16\x0a\xf4\xec\x6f\x98\xb8\x31\xdc\xa2\xa1\x86\x60\x97\xba\xcc\x52\xd2\x23\xf7\x68\x1c\x18\x4c\xf4\x42\xc9\x9f\xab\xde\x2c\x38\xcd\xc3\x10\x61\x59\xc7\xa8\x35\x4a\x64\x70\x2f\xb2\x12\x87\x20\x14\xf1\xfe\x0a\x0c\x52\xbf\x50\xaa\x46\x0f\xfc\x89\x1d\xc3\x77\xda\x20\x48\x35\xd7\x53\x58\x3a\x57\xd8\xe9\xd9\xd9\x42\xba\xa8\x01\x13\x9d\xe7\xa5\x92\x6e\x75\xc6\xca\x4c\xce\x4a\xa7\x8d\x3d\x4b\xf1\x1e\xb3\x33\x2b\x17\x23\x61\x92\xa5\x74\x98\xb8\xd2\xe0\x99\x28\xe4\x88\x81\x55\x34\x29\x3b\xce\xd3\xdf\x99\xa0\x33\xed\x69\x0b\x79\x1b\x02\xcd\xff\xb0\x42\xd9\x81\x65\x52\x28\xb4\xb6\x22\x34\xf5\x13\xad\x91\x49\x8f\x08\x1f\x37\xef\x6e\x3f\x41\x1c\xda\x4b\x3a\x8f\xdb\xfa\x53\x5b\xa3\x99\x50\x24\xd5\x1c\x89\x48\xa4\x85\xb9\xd1\x39\x63\x15\x55\x5a\x68\xc9\x1c\x8d\x90\x64\x92\x08\xcb\x96\xb3\x5c\x3a\x0b\x06\xff\x5e\xa2\x75\xb4\x02\x63\x78\xcb\xaa\x1f\x66\x08\x81\x7f\xc7\x70\xa5\xe0\xad\xc8\x31\x7b\x2b\x2c\x7e\x76\x24\x13\x36\xed\x88\x90\x77\x18\x9a\x9b\x56\x4b\xfd\x87\x7a\x99\x06\x3c\x35\x5e\x44\x9b\x61\xcb\x9a\x6c\xf0\xde\x6d\x81\x49\x8b\x07\xa2\x04\x24\xb9\x81\x44\xe9\x1b\x4d\x5a\xbd\x77\x73\x21\xfd\x0d\xe6\x07\x29\xf6\xf5\x57\x6b\x40\xbd\xad\xbf\x8c\xa2\x80\x44\x0d\x0d\x4e\xff\x0f\xaf\x5b\x84\x31\xc3\x4c\xab\x05\xaf\xe7\x46\xdf\xb9\x54\xef\x51\x2d\x48\x5f\xbf\xd9\x78\xb9\x05\xc7\xa1\xdd\x0d\x69\xb8\x5b\x4c\xb4\x4a\xed\x1e\xa0\xbf\x93\x4a\xe6\x65\xde\x10\xd9\xd6\xb7\x63\xf9\xf5\xb0\x94\x64\x6e\x80\xc2\x87\x6c\xe5\x8d\xc1\x5a\x21\x45\x49\x31\x43\x30\x34\xe0\x18\x2e\x71\x2e\xca\x8c\xe9\x13\x26\xf0\x2a\x7e\xf7\x20\xb3\x8c\xe8\x34\xd1\xca\xca\x14\x69\x59\x2a\x29\x4d\xb6\x8c\xd5\x5a\xd1\xbf\xd2\x11\xde\xb8\xaf\xd7\x1b\x50\xcf\xb5\xc9\x85\x63\x45\xff\xe5\xf9\xc6\xdb\x4d\x29\x5f\xff\x29\x44\x69\x31\xdd\x83\x87\x2b\x95\xca\x84\x04\x9a\xd7\xa4\xb4\x60\x0d\xed\x2a\x6d\xe8\x65\xbc\xd1\x8b\x1f\x79\xa6\x75\x86\x42\x0d\x5a\xaf\xa0\x30\x7a\x61\xd0\xda\x4b\x14\x69\x26\x15\x1e\xb6\x24\x9f\x58\x69\x3c\xf2\xb2\x38\x49\xc4\xa4\x5a\x8b\x22\x9a\x90\x39\x0d\xb9\xb8\xc3\x6a\x28\x98\xe1\x9c\x85\x2b\xe3\xb2\x81\x71\xa7\x69\x09\xe6\x6c\x18\x8e\xe1\x53\x7b\x7e\xb5\xd3\xe0\x17\x8b\x7e\x97\xaa\x44\x5a\xc8\xc2\xe8\x84\x3a\xf6\x4d\x1b\xad\x2c\x8b\x7a\x41\x9a\xc2\x5b\xb6\xf0\x20\x1d\x51\xcb\xc7\xb5\x69\xbf\x7b\x4c\x10\x53\x4c\x89\x4c\x2c\x7f\xe6\xe9\xc1\x96\x66\x4e\xa6\x32\x4d\x70\x0d\xe1\x41\xdd\xc3\xb5\x76\x64\xd1\x08\x57\x4f\x90\x01\x0c\x92\x0f\xad\x93\x39\xd3\x64\x5a\x9a\x28\x87\x19\x67\xa2\x7b\xf9\x5a\x24\xfa\xfb\xc9\xc4\x8e\x5f\x90\xd0\xa2\xb5\xba\x67\x7d\xaf\x2b\x56\x8b\x42\x2a\x30\x8a\x6d\x73\xd0\x1b\x5a\x26\x69\x09\x7a\x01\xac\x13\x48\x59\x68\x48\xa5\x75\xe4\x8b\x48\xbb\x84\x19\xba\x07\x44\x05\xf8\x48\x43\x4b\x07\x3f\xa3\xd1\xbc\x2e\x84\x21\x92\xa2\x72\x2e\x31\x7d\xd9\x59\xde\x4b\x32\x39\xfe\x4d\x5a\xa7\xcd\xea\xbd\xcc\xa5\x3b\x80\xa2\x6b\x01\xa3\xb3\x34\x4a\xe3\x5b\xf4\x53\x35\xe8\x04\x11\x81\x06\x91\x65\xfa\x01\x88\x16\x67\x22\xb9\x7b\x41\x0c\xac\xa1\xf6\x05\x11\x62\x31\xc3\xc4\x69\xb3\x07\x09\xef\xc5\x0c\xb3\xea\x63\x1a\xaf\xb1\xee\xef\x1e\xfd\x94\x5a\x88\x79\x58\x6a\xdb\x30\x9b\x85\xc1\xd0\xbc\x61\x3a\x47\x5e\x22\xfe\xd1\x64\x5c\x8b\xf9\xbc\xfd\x45\xcd\x07\x63\xb8\x72\x90\x97\xd6\x41\x2e\x5c\xb2\x6c\x5a\xa7\x95\xa7\x70\x6a\x21\x23\x40\x3b\x18\x63\xbb\x8e\xa4\xbf\xdc\xe5\xbb\x47\xb2\x89\x2a\xa7\x17\x60\x27\x46\xd6\x9b\xf8\x75\x26\x1f\x9e\xc8\x24\x6b\xe3\x8b\x6c\x1f\x69\x90\xe6\x61\x89\x2c\xb0\xf5\x84\x91\x73\x71\x7d\xd9\x45\xea\xf4\x57\x3a\xcc\xb7\x80\xb4\x06\xd4\xc5\x8e\x81\x83\x15\x18\xdf\xb0\x5c\x22\x51\x29\xa4\xb2\xde\x2a\xb4\x43\x10\x70\x87\x2b\x6f\x06\x93\x75\x5d\xa0\x11\xd5\xc7\x06\xb3\xa0\x63\x90\xbe\xe2\x8f\x82\x4d\xbc\x05\xb6\xdd\x48\x0f\x06\x2c\xae\xb6\xbf\x5c\x9b\x1e\x8d\x1a\x8c\x13\x3f\x4f\x7a\x50\xa9\xbc\x6a\xd2\xa2\x28\x32\x89\xdd\x86\xc9\x41\x56\x48\xfd\x37\x62\xe0\x60\x10\x2b\x94\xd5\x66\xb3\x47\xea\x29\x19\x07\x19\xfb\x2e\x76\x29\x0b\x16\x17\x60\x91\xa9\x25\x60\x11\xfe\x22\x32\x99\x56\x63\x7a\xba\xb8\x52\x43\x52\x24\xf4\x0f\xf3\x99\x57\x5c\x97\x1a\xed\xb5\x76\xfc\xe4\xd9\x93\xf4\xc3\x1f\x3c\x45\xff\x39\x93\x93\x02\x61\x8c\x58\xd1\x1c\x9a\xee\x85\x1d\xc3\x95\xb7\x1b\x2b\x74\x48\x4b\x06\xbe\x36\x71\x2e\xf4\x32\x74\xe4\xbb\x60\xd6\x9e\x21\x07\x62\x30\x2f\xdc\xaa\xb3\x8f\x80\x02\x6d\x5a\x18\xd8\xd1\x5d\xe8\x8a\x25\xb1\x1f\x88\xad\xb4\x22\x13\x49\xad\x78\xd9\x39\xa2\xd8\x9d\x4c\x20\x47\xb3\x40\x28\x88\xbb\x77\x21\x76\x27\x4f\x1e\x81\xfb\xf8\x19\xc3\xb6\xe5\xab\xc0\xc4\x1d\x56\xa0\xff\x19\x11\x7d\x6d\x7d\x17\xd1\xb7\xe5\x83\x2d\x3e\xcc\xa1\xf0\xb1\x18\x64\xed\xb0\x05\x1b\xcd\xc8\xe1\x3e\x69\xb0\x17\x63\x2d\x3a\x6c\x0c\xcd\xc4\x08\xb9\x28\x88\x12\xff\x41\x22\x8c\x89\xe1\x9f\x50\x08\x69\xec\x18\x2e\x38\x00\x9a\x61\xeb\x5d\x30\xdc\x9a\xdd\x50\x0f\xd2\x02\xe1\xfb\x5e\x64\xc1\x44\x15\x0a\x30\xf3\x22\x54\xcf\x37\x54\xc5\x30\x68\x3a\x12\x45\x73\x89\x59\x4a\xb0\x9c\xdc\xe1\xea\x64\xb8\x41\xbd\x27\x57\xea\xc4\x0b\xd7\x0d\x7a\xad\x24\xb1\x56\xd9\x0a\x4e\xf8\xdd\xc9\xf1\xaa\x62\xe7\x6a\xee\x78\x19\xe8\xbf\x43\x14\xb7\x50\xbe\x66\x79\xc7\x56\x24\xcc\x4a\xcb\x06\x77\x60\x2d\xc0\x68\x13\x54\x16\x00\xdb\xd7\x0a\x1f\x58\xd3\x8f\x07\xc7\xe9\x0a\xb2\xa9\x38\x62\x4c\xc1\x82\xe9\x7e\xda\xb8\xf1\xdf\x87\xe8\x02\x59\xf9\x73\xb9\x80\x42\x18\x91\xdb\x31\x7c\xf4\x01\x0d\x8f\x6b\xd9\xe1\x5e\xdf\x86\x89\x7d\x5a\x15\x08\xff\x1a\x7b\xf3\xe1\xc4\xf1\xe0\x69\xaa\x2e\x17\x8f\xb7\xa5\x59\x6c\x81\x9e\x7e\x84\x5a\x7d\x98\x6f\x7f\x3d\xda\x69\xc8\x6d\x7e\xb7\x47\xf2\xb4\xf0\x75\xda\xf4\xdb\x6a\x6b\xb7\x5a\x3c\x56\xb2\x89\x50\x64\xad\x51\x5c\x2e\x2d\x33\x72\x84\x67\x7a\x2d\x66\xbb\xd9\x94\xb5\x5a\x89\xb1\xb1\x50\x20\x66\x56\x67\xa5\xab\xac\xea\x57\xf8\x38\x85\xaf\x5f\x03\x69\x6e\x28\xd0\x24\x14\xe3\x5b\x60\x97\x8f\xe1\xbf\x7d\x33\xf9\xe2\x75\x10\xe9\xd4\x6b\x70\xa7\x26\x7e\x25\x1f\x1b\x51\x54\xe2\xb9\xc9\x18\x2e\xd6\x06\xe4\x76\x59\x52\x92\x39\x93\xfa\xe0\x55\x63\xd8\xd9\x0a\x8c\x2e\x55\x4a\xc4\x5b\x16\x1b\x5e\xcd\xbb\x47\x91\x17\x19\x4e\xe1\x61\x89\x24\x40\xbc\x85\x4f\x8a\xdc\x69\xf8\x72\xf2\x85\xe7\x7a\xa2\xf3\xda\x1a\x8e\x93\xb7\x89\x20\xb4\x95\x05\xc8\x3c\xc7\x54\x0a\x87\xd9\x2a\x76\x84\x60\xda\x44\x6b\x9d\x30\xce\x0e\xfd\xae\x49\x65\xe5\xb8\xb5\x20\x35\x39\x24\x24\x51\x68\xc4\x0a\x4d\xa9\x66\x07\x02\xd9\x77\x85\x37\x5f\x4e\xbe\xe8\xf6\xd8\x3e\xa8\x04\x41\x67\xf5\x23\x1f\x85\x9f\x91\x57\x76\x27\xb3\x0c\xd3\xe1\xde\xa9\xcc\x4b\xe3\x96\x68\x86\x80\xca\x46\x3f\x56\xb8\x0d\x38\xab\x11\x8c\xdf\xe6\x01\xe1\x40\xa8\x95\x77\x78\x1b\x0e\x70\x98\x3c\x09\x74\x07\xb9\xb6\x6e\x3b\xf8\xa7\x83\x0e\xb2\xe6\x9f\xc7\x11\x6d\x1d\x1a\x85\x0e\xed\x48\x2a\x37\xd2\x66\xe4\xd9\x61\x0a\xce\x94\x38\xe8\x68\xc3\x6e\x40\x93\x7a\x7e\xf3\x8c\xda\xdc\xbf\xd8\xc0\xf0\x67\xe3\xcb\xa7\x73\x5b\xaa\x1f\xd4\x76\xbe\x66\xe1\x19\x38\xba\xc9\x93\x93\x43\x79\xb2\xed\xba\xaf\x11\x32\x8d\x4d\xbd\x7d\xd3\x4d\x6b\x47\x30\x6c\xe0\xaa\x16\x3f\x92\x21\xcf\xa1\xc1\xe1\x01\x50\x54\x0c\x35\xd7\x14\x4f\xf0\xfe\x32\x81\xe9\x87\xea\x90\x2f\x1b\xbc\xd7\x21\x27\x6a\x58\x2a\x9a\x20\x0e\xcc\x32\xe6\x40\xbb\x49\x20\x84\x42\xe1\x20\x43\x61\x1d\x7c\xf3\xcb\xb0\xe0\x4e\xf3\x25\xbc\x1e\xec\xe5\x11\xd6\xda\x7a\xde\xb0\x54\xc6\xf0\xb6\x34\x06\x95\xcb\x56\x21\xd8\x90\xad\xc0\x96\x45\xa1\x0d\x11\x65\x65\xc7\x90\xe9\xd6\x52\xf6\x27\x15\xad\x11\x3a\x0e\xb0\x03\x76\x72\xf4\x8e\xe9\xc5\x10\xc6\x74\xb0\x7b\x6a\xe1\xb3\xf0\x74\x16\xdc\xf1\x6a\x71\x59\xf4\xc6\xb0\x4a\x08\x79\x8f\x07\xc7\x19\x2b\xdd\x7b\x1d\x9d\xe0\x9c\xde\x3a\xa1\x52\x61\xd2\x30\xa9\xd3\x53\x5b\x35\xff\xec\x7b\x38\x71\xa0\xd3\x27\x1a\x64\x1b\xd9\x27\xcf\x75\x5f\xf6\xae\x7f\x37\x0e\x2f\x6a\x38\x82\x53\x5d\x2a\xeb\x4c\xc9\x13\x4f\xc9\xb7\x0b\x9b\x76\xe4\x9d\x50\xd8\x12\xd3\x18\xac\x6e\x6c\xd8\x09\xc7\x1b\x97\x24\x4b\xd0\x91\xc4\xa0\xe4\x1c\xde\xda\x74\x5a\x67\x14\x0c\xf1\x6d\xd9\xfd\x30\xe8\x8c\xc4\x7b\x04\x61\x66\xd2\x19\x61\x56\x8d\x65\xfb\xb4\xa4\xe0\x8e\x21\x57\xdc\xc1\xdf\x4b\x34\x2b\x2f\x2d\x54\xda\xd8\x34\x21\xff\x87\xf6\x5c\x53\x2f\x0d\x73\x9d\xca\xf9\x8a\x04\x88\x27\x84\xcd\x6d\xd2\xe9\xd9\x59\x2d\x10\x68\xfd\x53\x9d\xd8\xb3\xd2\x52\xaa\x4f\x29\x53\x3c\x6b\x2c\xc7\x76\xa9\xb2\x47\x40\xd0\xcf\x02\x15\x79\x5c\xd8\xbd\xe9\xd5\xb9\x04\x27\x7f\x6a\xb4\x09\x6b\xa0\xf9\x9d\xc8\xa0\x30\x38\x97\x8f\x43\xa0\x10\x3c\xe1\x95\xe4\x07\x4f\xdd\x0c\x09\xa9\x71\x38\x10\x50\x2a\xf9\xf7\x32\xec\x9b\x7d\xb8\x7e\xff\x9f\x70\xf5\x2d\x73\x27\x41\x12\x7c\xc3\xa5\xb0\x41\xaf\xa1\xa2\x5d\x81\x7b\x99\x52\x78\xf7\x2a\xec\x71\x57\x0e\x24\x0d\x16\x2c\x49\x6a\x6c\xd0\x95\x46\xf9\x8d\x90\xc6\xd6\x6a\x64\xf4\x54\xce\xe7\x68\x42\x26\x85\xaa\x9b\x15\xc2\xd2\xb6\x8f\x57\xa9\x9e\x86\xb8\x89\xc8\x2c\x6f\xa8\x24\x3a\x9f\x49\x55\x93\x53\x98\x80\x2d\xe7\x73\xf9\x48\x9a\x18\x2b\x18\x43\x73\x82\x9f\xba\xb7\x04\xd5\x3d\x85\xab\x98\x70\xc1\x94\x19\xa9\x16\xbb\x36\xdf\x7a\x43\x7d\x86\x24\xf5\x55\x22\xaa\xe8\x2e\x42\xc6\x3b\x84\x71\x8b\xd1\x8f\x1a\xbd\xdd\xb4\xda\x1c\xaa\x5c\xe5\x08\x9e\x56\x8d\x35\x18\xc3\x8f\x6a\x13\x7d\x55\xe4\xbc\x72\xb6\xe3\x32\xa5\x7e\x79\xd8\x3d\xb5\xc3\x46\x47\x7e\x63\xe7\xfa\xc3\xa7\x80\x6c\x10\xf0\xd5\xe4\x5f\x60\x04\x52\x59\x87\x22\x1d\x82\x0c\x08\x47\x49\xf6\x6e\xfc\xec\x7c\xf2\x06\xde\x86\xbd\x45\x6d\xe0\xeb\xc9\xc4\x63\xf3\xc6\x6f\x17\xf9\x1d\x73\xca\x40\xd3\x25\xa5\x1b\xf0\x46\x1d\xf1\x49\x9b\x5c\x12\x4e\x5b\x08\x16\xcf\x9c\x0c\xa2\xb8\xaf\x44\xda\x99\xd4\xb4\x76\x0e\x03\x3e\x9b\x9b\xeb\x9e\x21\x89\x9f\x57\xf0\x2a\xd2\x6c\xb6\x02\x31\xe7\xed\x86\xd8\x41\x18\xb8\xde\xae\xba\xa1\x16\xa3\x0b\xfe\x6a\x89\x22\x45\xf3\x9a\x51\x79\xc1\xb1\xd3\xb4\x72\x8d\x23\x47\xac\xed\x47\x7c\x66\xd1\x2e\x53\xcc\x0b\xed\x50\x25\xab\x93\xc1\x33\x44\x6d\xb6\x23\x30\xf5\x0b\x49\xf7\xef\x44\xd1\x08\x8f\xde\xe1\xca\x36\x02\xe7\x6d\x73\x9d\x04\x8c\xd3\xa0\xcd\x42\x50\xce\x0a\x7f\x17\x53\x0a\x7f\x46\x78\xc5\x69\x7a\xfc\xd4\x87\xba\x5f\x37\x84\x2d\x85\x3c\x29\x32\x55\x05\xf8\x2d\x8d\x1a\x36\xf5\x08\x94\x46\x6e\xa5\x0d\x7d\x98\x7b\x99\xe0\x13\x24\xb5\xc7\xea\xb3\x84\xb4\x3a\x5c\x38\x9f\x32\x09\xc6\x78\x6e\x60\x1a\x62\x31\xa9\x68\x73\x3f\xe6\x2c\x8e\xe1\xca\xd6\xc2\x83\x95\x12\xdb\x3f\xc4\x6b\x51\x4d\xd2\x06\x47\xe6\x96\xba\x5c\x2c\xc1\xea\x1c\xeb\x17\xac\x3b\x89\xcb\x1e\x40\x44\xde\xe2\x68\x16\xe7\xac\x34\x25\x48\x9d\x7b\x24\x0a\xb2\x31\x0c\x39\x05\x0c\x06\x88\xd2\xe9\x5c\x38\x99\x10\x07\x8e\x2b\xd6\x29\x8c\xcc\x85\x91\x14\x6a\x52\x0e\x15\xed\x25\xd3\xfe\x59\xcc\x4e\x85\x9a\xd8\xc3\x92\x73\xb0\xaa\x0c\x63\xa5\x55\xea\x6c\x67\xd6\xcc\xb1\x6b\x27\x53\x62\xb3\xb9\x44\x63\x7f\x47\x40\xdb\xd3\xe7\xf0\x57\x85\xfd\x03\xd7\xf2\xa4\x4a\x31\x6d\xe5\xbb\xf8\x27\x61\x4d\x51\x24\x4b\x50\x9b\x6b\x3e\x86\x0b\xe5\xc3\xf9\xf5\xb0\x9b\xc1\x5a\x5a\xa9\x1f\x4f\x52\xef\x1f\xfe\x78\x52\x7f\x3a\x84\x59\xe9\x5a\xaf\xc2\x4e\x52\x22\x94\x56\xb4\x66\xf5\x96\x0d\x63\x7e\x4c\x5b\x15\x24\x79\x23\x97\xb1\x4d\xd4\x54\x4f\x64\x6b\x11\x4b\x32\xd7\x36\x48\x11\x46\x0d\xa5\x15\xd3\xd7\xbc\x6a\xa7\x85\x77\x1c\x2f\x8e\x9d\x46\x1d\x1e\x36\x2a\x7e\x54\xf0\x5d\x98\xb6\x80\xcb\xeb\xdb\xff\x7a\x7f\xf1\xc7\x77\xef\x5f\x64\xe9\x2b\xf8\xec\xb3\x64\xaa\x7e\x50\x68\x6e\x90\x0d\x8e\x04\xed\x81\x2b\xff\x3e\x6c\x8c\xc6\x69\xa7\x58\x78\x56\x88\x3b\xbd\x31\x93\xef\x6a\x0e\x17\xef\xdf\x57\xdf\x05\x75\xc5\xfb\xaa\x75\x6c\x2a\xc5\x8c\x72\x30\x87\xad\x7c\xa4\x88\xc9\x85\x30\x33\x0a\x2d\x24\x24\xec\x12\xd7\xb4\xaf\xc2\x97\xd2\x42\x2e\x94\x58\x78\x83\x4e\x34\x44\x23\xf5\x88\x8a\xb8\x1b\x15\xa9\x54\x19\xc2\x09\x3c\x3e\x0f\x10\xf2\xdb\x88\xd2\xa4\x6d\xb5\x24\xfa\xa5\xe6\x8d\x87\x61\xd5\x43\x24\x82\x22\x4f\x6c\x55\x19\x0e\xbb\x84\xf5\xcc\x69\x19\xd9\x6e\xd3\x8a\x42\x3b\x4a\x2c\x48\x66\xd5\x9d\x8c\x07\x4f\xde\x84\x6a\x2d\xc1\x87\xd6\xc2\xd5\x3b\x0e\xa8\x58\x20\x12\x25\x51\x5a\x05\x09\x38\xa7\x21\x43\x07\x2b\x5d\x42\x10\x18\x2b\xc2\x89\x7e\x50\xb5\x89\xcf\xfc\xd8\x7a\x52\x31\x6c\x58\x34\x36\x12\x6b\xae\x08\xd6\x61\x58\x79\xe5\x86\x14\xf1\x9d\x61\x95\x23\xcf\xea\x2d\x1d\x82\x25\xdc\xa2\x09\x66\x47\x83\xad\x18\x99\xdb\xb1\x71\x88\xcf\xb7\x3b\x41\x75\x0f\x02\x2f\x3e\x5e\xc5\xf4\xd4\x68\xb4\x1a\xcf\x07\x6e\x17\x54\x07\xf2\x95\xff\x99\x65\x3a\xb9\xe3\x95\xba\x24\x1a\x3f\x16\x44\x22\x74\x43\x89\xac\x17\xd7\x97\x14\x0f\x27\x18\x99\x61\x2b\xcb\xfd\x84\x32\xac\xe8\x34\x83\x4a\xe3\x08\x27\x30\x97\x4a\x64\xf2\xe7\x8a\xfe\xeb\x66\x35\x9d\x06\x9e\xab\x93\x3d\xef\x70\x35\x62\x33\x26\x38\x96\xa5\x72\x32\x8b\xb9\xba\x91\xca\x78\xb7\x35\xd7\xf7\xeb\x49\x2c\x73\x91\x51\x9a\xe7\x27\xcd\xee\x6a\x2d\x21\x29\x05\x81\x64\x16\x28\xc4\xd4\xc2\x89\x1f\xf5\x84\x42\x86\xb9\xb4\x4d\xd4\x33\x7c\x43\xd0\x44\x2b\x0f\xd2\x22\x7c\x75\x7e\x0e\xaf\xbe\x57\x21\xe5\x8b\x7d\xd6\x77\xca\x49\xb7\x7a\x5d\x89\x86\xe8\x47\x1d\xb6\x5c\xdd\x79\x71\xed\x3f\x35\x9b\x3e\x6d\x9d\xd6\xd0\xc5\xd2\xa5\x4a\x52\x3e\x4a\x18\x1c\x0b\xf9\x66\xe6\xf0\x1e\x98\x7d\x2e\xf1\x3a\xe1\x7f\x6e\x57\x60\x6b\xa6\xee\x33\x98\x6c\xb7\xf5\xd9\x35\xf5\xeb\x46\x2e\xec\xd6\xa9\xbf\xa4\xf1\x75\xe4\x8c\x4a\x79\xe4\x5a\x7e\x7f\x75\xf9\xb2\xf3\x29\xe5\x8b\x2d\x50\xb4\xb1\x76\xcd\x68\xd4\x10\xe2\x3b\x3f\x23\xca\xd9\xf9\x01\x11\xc3\xce\x0f\x4a\xb9\xab\x83\x03\x9c\x9d\x7d\x39\x14\x7b\x3b\xd9\x4c\x28\xef\x5e\x54\x4a\x24\x97\xf3\xe8\xf0\x85\xe5\x8d\x31\xfb\x19\x2e\xc5\xbd\xd4\x26\x3e\x0f\x31\xe3\xcf\xce\xc0\x04\xfc\x48\xa8\x74\xe4\xd3\x62\x4f\x07\x4f\xd3\xdc\x33\xad\x1d\xc5\xe9\x8b\xe9\xe0\x20\x0a\xff\x63\xfc\x9e\xcc\x08\x11\x89\x9c\x42\xa5\x94\x78\x95\x69\x32\xf8\x7d\x94\x35\x24\x8a\xa3\x4a\x44\x61\x79\x53\x38\xe8\x22\x96\xc3\xd1\x1b\x23\x1b\x0d\xe3\x9e\xcb\xff\xfe\xf7\xff\xd8\x1a\xa2\x42\xaa\x05\xe4\x98\x2c\x85\x92\x36\x1f\x0f\x9e\x67\x9e\xf8\x01\x6f\x70\xc7\x06\xe3\xc6\x64\xdf\xc6\x36\x9d\x93\xad\x00\x8d\xd1\x3c\x33\x0a\xa1\x9c\xa4\xf2\x80\xc3\x19\x2c\x9d\xa5\x76\xc3\x01\x75\x42\x66\x55\xda\x62\xec\x59\xda\x3a\x44\x5a\xa5\xbe\x92\xac\xb3\x67\x31\xe5\x85\xf1\xe7\x47\x5a\xd5\xeb\x31\xbe\x14\x4e\x70\x8c\x8c\x22\x62\x84\x54\x52\xf5\x44\x95\xe2\x60\x3d\x77\x18\x26\x8f\x33\xf5\x5e\xce\xd8\x3b\x58\xd0\xd1\x0f\x53\x1a\x9f\x6c\x3c\x0e\xbe\xd3\x2b\x0a\xef\xcc\xd1\xd0\x18\x84\x68\x01\x85\xc4\x04\x5b\x07\xa3\x62\xf0\x32\x3c\x24\xae\x34\xd1\xf5\x0c\xb6\x47\x08\x4c\x85\x40\x62\x70\x0a\x40\x90\xf7\x2a\x53\xf8\xf7\xdb\x0f\xd7\x67\x7f\xd2\xc1\x8f\x11\x09\x27\xd3\x13\x23\x73\x3a\x52\xc8\x4d\x10\x36\x6e\x0c\xde\xd2\x9b\x71\x2e\x94\x9c\xa3\x75\xe3\xd0\x1b\x1a\xfb\xc3\xf9\x4f\x63\xf8\x56\x1b\x40\x9f\x3e\x31\xac\x8c\xd3\x78\xc8\xa8\x41\x57\x3c\x99\xaa\x6d\x8c\x0b\x50\x12\x75\xf4\xf8\x1e\x18\x58\x47\xe1\x61\x3a\x7d\x11\x5c\xed\x4c\xde\xe1\x14\x4e\x88\xe2\x1a\x43\xff\x83\x04\xfc\x3f\x4f\xe0\xd5\x03\x3b\x15\x27\xf4\xeb\x89\x47\x5d\x65\x68\x35\x8f\xba\xd4\x03\x33\x4b\x38\x23\x17\x0b\x7f\x08\x81\x0e\x38\x91\x69\xc2\x5b\xe2\x92\x8e\x3a\x36\x3e\x56\x21\xda\x53\x05\x49\x37\x00\xf9\xe1\xfc\xa7\x13\x78\x55\xb7\xa0\x79\x51\x44\x18\x1f\xe1\xbc\x72\x34\x0b\x9d\xc6\xdc\x16\xbb\x52\x4e\x3c\x52\x9f\x09\x45\x0c\xc8\x3f\xa4\xed\x4b\xed\x73\x34\x38\x7e\xf5\x80\x59\x36\xf2\x91\x94\x14\x1e\x7c\x22\x66\x44\x25\x91\x85\xa0\x94\x27\xd7\x22\x89\x31\x7c\xfa\x70\xf9\x61\x1a\xd3\xab\xad\x5c\xa8\x18\xdf\x65\x2f\x20\x44\x98\xab\x88\x05\x91\x48\xe9\x17\x89\xa4\xe1\x52\xa8\x45\xe5\xe0\xcd\x4b\xb2\xda\xc6\xa7\x7b\x29\xf7\x08\x7e\x38\xc4\x2c\xfd\xad\x19\xa6\x47\x21\xe0\x10\xe3\xf4\x49\xe6\xa9\xed\xb6\xe7\x12\xad\x12\x2c\x9c\x3d\xd3\xf7\x68\xee\x25\x3e\x9c\x3d\x68\x73\x27\xd5\x62\x44\x04\x3a\x0a\x81\x98\x33\x02\xcb\x9e\x1d\x66\xb6\x1e\x3d\xdf\x3d\x31\xc4\xed\x93\xe6\x86\xbf\xc4\xcc\x69\x1c\x7b\xf6\xa2\x13\x8f\xaa\xf7\x69\xfa\xe9\xf4\x76\x5d\x85\x87\x7e\x48\x42\x78\x8b\x66\xd3\x15\xcf\x45\xea\x45\xaf\x50\xab\xcf\xce\x20\x84\x5e\xce\xba\x48\x56\xa3\xa0\xd8\xd9\x0e\xe4\x73\x5d\x96\xe2\xdd\xab\x17\xc5\xe7\x01\x3e\xd0\x53\xbc\xa0\x17\x61\x9b\x43\xbc\xa3\x23\x26\xbb\xc7\x5f\x88\x7f\xb7\x67\x71\x74\xa2\xe3\x84\x2d\xb3\xa0\xa0\x7c\xb4\xa8\x36\x1d\xa9\xaf\x5a\xdf\x27\x99\x2e\xd3\x11\xdd\xe9\x11\xed\x43\xf0\x1d\xf9\xd4\x7f\x25\xb3\x61\xd3\x5e\xae\xf7\x29\x73\xb2\x2e\x82\xfa\xf8\x88\x3e\xef\x8b\xed\x09\xde\x7d\xbc\xa4\x08\x3c\x6d\x79\xa5\x74\x92\xb0\xd2\x41\x14\xbb\x0a\x71\xdf\xf8\x9e\x54\x50\x3c\x05\xcf\x2a\xab\x0e\xf1\x70\xb4\x89\xde\x8b\xa0\xa0\xa2\x1d\x37\x86\xdb\x07\x49\x7b\x64\x4e\x03\x4d\xf5\x16\x13\x83\x7c\x5d\xc6\xf8\xe4\x00\x74\xef\x5d\x97\xb4\xd5\xe7\x11\x88\x6f\x03\xd3\x75\xfe\xd6\x32\xa8\xde\x48\xe7\x68\x5b\xd7\xf2\x84\x25\x78\xf2\x0a\x3c\x1f\x07\x07\xd0\xe5\xce\x23\xc9\x9d\xd8\x79\xe1\xa3\xc9\x07\x1d\x51\x3e\x62\xd6\x74\xb4\xb4\x34\x78\xa9\x89\xb4\x0f\x9c\xd2\xb7\xcd\x36\x71\x52\xa1\x23\x48\xfd\xd3\x86\x8f\xbe\x9e\xd1\x05\x52\x8d\xfd\x36\x11\x9f\x46\xf0\x07\x8c\xe2\x9a\xb6\xfa\xb6\xcd\xa4\xa1\x90\x38\x11\x56\x20\xe0\x6a\xfc\x9c\xb9\x4b\x35\x37\xa2\xca\x55\xda\xe9\xb3\xb6\xe6\x7f\xb5\xde\x2e\xfa\xad\x61\x73\xad\x56\x5a\xde\x17\xe0\xcb\x84\x6a\x5f\x55\x73\xba\x8b\xdf\xbc\x51\x6b\x40\x54\x3e\xee\x78\xf0\x3c\xef\xf1\x50\xcf\xf1\x65\xbc\xc6\x03\x90\x7d\x84\xb7\xd8\x7b\x8a\xbd\xa7\xf8\xdb\xf3\x14\x0f\xe4\x81\xfd\x1e\xe2\x6f\xc9\x3b\x3c\x70\xd2\xfb\xbd\xc2\x5f\xb1\x47\x78\xc4\x1c\x0f\xf0\x04\x7f\xe5\x5e\xe0\x81\x93\x5d\xf3\xda\xa6\x83\xde\xf3\xab\x3d\xbf\x03\x71\xb8\xd7\xe3\xfb\xd5\x7a\x7b\x2f\x65\x4d\x2b\x9d\xe2\xa5\x11\x52\x85\x0c\xd3\xe9\xe0\x30\x54\x5c\xaf\xb5\x8b\x36\xa8\x3f\x5d\x26\x72\x5d\xd2\xf1\xc8\xb9\x4f\x1e\xad\xce\x94\xac\xdf\xb4\x62\x29\x93\x83\xf4\x6a\x4a\x5d\x79\x35\x42\x10\xc5\x2b\x5a\x78\xbb\x3f\xa8\x5c\x3a\xb8\x33\x84\x1c\x85\x6a\x1d\x53\xa1\xcf\x63\x02\x24\xf7\x12\xb2\x91\x75\xd9\x38\x1e\x96\xd1\x35\x1d\xbc\x1b\x61\xc7\x70\xfd\xe1\xd3\xbb\x29\x74\x4d\xa0\x4e\x83\xe6\x1c\x85\xbf\xd2\x3a\x26\x2e\xf3\xfd\xc2\x68\x44\x7d\xe9\xd2\xfd\xf5\x74\xf0\x8c\x65\x89\xe6\xe5\xd5\xe5\x81\xb8\xfe\x58\x35\x88\x48\x8e\xdb\xa6\x61\x9f\xee\xea\x72\x6d\x4b\x2e\x9a\xb0\x55\xa2\x74\x1c\x33\xa8\x7b\x36\x02\xd7\xef\xc2\x88\xdf\xc0\xd5\x25\x39\xe8\x96\xdc\xe5\x60\xf0\x33\x8e\x83\xf9\x94\x68\x63\xd0\x16\x74\xeb\x8d\x5a\x54\x69\x4c\x61\xe0\x56\xff\xb2\x91\x4e\x39\x5b\xc1\x52\x2e\x28\xeb\x39\x23\x21\x40\xc6\x8c\x2d\x73\x0a\xa0\xeb\x79\x95\xbf\x23\x0a\x59\x1d\xc5\xa2\x64\x56\x48\x84\x65\x43\x27\x3a\x1c\x94\x1f\x49\xe7\x98\xa2\x45\xd3\x68\x48\x20\xd7\xb3\x7c\x4b\xd7\x11\x8d\xca\x02\x32\xbd\x90\x49\xb4\x21\x1a\xcd\x13\x9d\x17\xc2\x60\x05\x38\x5b\x82\x34\x4d\xfe\xcf\x9c\xf3\x14\x4a\x57\xbf\xf5\x57\xf0\x10\x4e\xe3\xb5\x50\x75\xc2\xf5\x02\x69\xfb\x61\x21\x69\x0e\x74\xa6\xd5\xc2\x9f\x2b\xfe\xf7\x7d\x8e\xe1\x3f\x3a\xc0\x15\x3e\x23\x54\x26\xa0\x4b\x37\xd2\xf3\x91\x33\xd8\x58\x86\xb9\x6e\x4e\x78\xb8\x66\x28\x35\x31\xdb\x98\x97\xbf\xe7\x88\x53\x57\xa2\xf5\x26\xea\x2e\x49\xee\x44\x52\x89\x17\x8d\xc4\x29\x52\x36\x16\xa7\xc2\x54\x6f\x18\x74\x1a\x8b\x8e\x70\xc8\x56\xe2\xce\x1f\x2c\xed\x9e\x87\xbc\x77\x6f\xc5\x51\xd2\x5b\x40\xab\xa4\xbc\x75\xba\xa0\x91\xf2\xd1\x9a\xe8\x2c\x55\x03\x4f\x35\x6e\xe9\x1e\x25\x43\xc4\x6b\xee\x42\x6a\xab\x4f\xda\x69\x51\x53\xf4\x60\xc3\xe1\x14\x9a\x82\x48\x5c\x19\xee\xb9\xa0\x44\x67\x4f\x52\x9b\xb4\x46\xbc\x42\xbe\x1a\x1b\xed\x2d\x64\x35\xcf\x3a\xf1\xf5\x3a\x73\x91\x90\x70\xe9\x22\xae\xb8\x56\xfb\xbd\xc3\x03\x64\x40\xf0\xf0\x0e\x14\x00\x51\x3b\x37\x33\x5e\x83\x17\xd4\x24\xb5\xd0\xe9\x3a\x0f\x92\xc4\x74\x21\xdb\xb4\x91\xdf\x1f\x4f\xa3\xd4\x11\xa0\x38\x33\xfb\x8c\xa9\x45\xb2\xec\x9e\xd9\xa8\x1e\x6d\xcb\xfb\x80\xf4\xeb\x6d\x29\x15\xa3\x35\x67\xfd\x06\xe7\x83\xa3\x35\xdf\xd6\x97\xdd\xd0\x6f\x87\x6a\x54\x25\xcb\xaf\x3d\x8e\x87\xf1\x06\x07\x0c\x4b\x2e\x6e\xb9\x16\x48\x68\x2d\x7f\xc7\xe5\x02\xd4\xa2\x45\x0e\x7a\xc6\xcc\xf8\x02\x37\xfe\x6d\xdc\x30\x3a\x1d\xec\x24\xce\xf5\x3b\x4f\xab\xf6\xb5\xf0\x7c\xc5\x27\x57\x99\xb1\xab\xf3\xa0\x6b\xf7\xf4\xbd\xde\x75\xbd\xeb\x4b\x5e\x57\x15\x11\x15\x0e\x6c\x75\x32\x61\x7b\x7e\x6b\x99\xfb\x11\xd1\x41\x06\xd5\x50\xee\xcc\x3d\x68\x00\xfc\xfb\xaf\x8e\x02\x98\x6f\x29\xde\x03\x23\xdf\x5b\x5c\x67\x9f\x87\xb4\x74\xde\x3a\x71\xbe\x03\x5e\x99\x4d\x9a\x80\x57\xe1\xfe\xe4\xef\x8b\x61\xbc\x54\xf9\x52\x3f\xa8\x21\x84\xbb\x94\x87\x1c\x0d\xa4\x44\x69\x6d\x20\x5c\xa8\xfc\x7a\x3c\x38\x42\x2e\xb4\x6e\x09\x3e\x92\x96\xf6\xdd\x55\xfc\x79\x48\xc4\x3c\x0d\xd8\xa7\xdc\x5b\xfc\xca\x2d\x51\x9a\x70\xca\xa8\x61\x8b\x45\xb1\xf2\xfa\x25\xe7\x15\x3b\xdd\x33\xaf\xd3\xdb\xf0\x5d\xb4\x36\x39\x0b\x3b\x24\xff\xae\xdd\x0e\x46\x27\x22\x82\x71\x15\x82\x7b\x1e\xed\xa4\x6e\xc4\xbd\x96\x74\x5a\xcc\x19\x4d\xd1\x29\x52\x3c\x84\x02\x7f\x36\x26\x64\x03\x85\x46\xb5\x0e\xae\x07\x0c\x1d\x85\x71\xc9\x04\x59\x8d\xf8\xfa\x95\x10\xac\x6a\x38\x5e\x74\x8d\x48\xe9\xd6\x6e\x2e\xb3\x07\xa6\x20\x72\x2b\xfb\x3b\xfe\x67\x54\x35\x3e\x3d\x86\xc4\x4b\xf5\x5c\xa1\xd9\xe8\xe1\x30\x72\xaf\x6e\x06\xec\xba\xd1\xa3\xee\x81\x0c\x1c\x3a\x74\x62\x1d\x61\x38\xaa\xb7\x70\x88\xa4\x25\xbc\xa2\xb5\xf8\x66\x32\xf9\xa2\x21\xc3\x13\x51\x88\x44\xd2\x5d\x5a\x7c\xa8\x37\x17\xab\x78\x7a\x71\x86\x1d\xe3\xc4\xab\x39\x88\x2e\xc8\x34\x5e\xa1\x6b\x74\xd6\xb8\xf3\x2f\x6e\x44\x11\x58\x1c\x63\xac\xce\xb4\x6e\x3d\x73\xfe\x74\xc2\x0f\x07\x5e\x6e\x7e\x39\xbe\x3e\xe0\x3e\xf2\x97\x9b\x60\xa7\x5d\xb1\xf1\xd0\xab\xad\xc6\xd5\x09\xb4\xa7\x23\x16\x38\xf5\x99\xf4\xfe\x51\x39\x8b\x11\xa1\x0a\x4b\xec\x58\xd4\x28\x63\x3e\x89\x12\xa2\x7d\x3b\x79\x64\x9e\xea\x5b\x62\xfb\x88\xf4\xf8\x29\xcd\x3c\x0a\xd8\xfa\x43\xee\x60\xfd\xd3\xce\x4b\xf0\x83\xb5\x04\xff\xf8\x67\x5f\x56\xa0\x2f\x2b\xd0\x97\x15\xf8\x55\x96\x15\xf8\xaa\x2f\x2b\xd0\x97\x15\xe8\xcb\x0a\xf4\x65\x05\xfa\xb2\x02\x7d\x59\x81\xbe\xac\x40\x5f\x56\xa0\x2f\x2b\xd0\x97\x15\xe8\xcb\x0a\xf4\x65\x05\xfa\xb2\x02\x7d\x59\x81\xbe\xac\x40\x5f\x56\xa0\x2f\x2b\xd0\x97\x15\xe8\xcb\x0a\xf4\x65\x05\xfa\xb2\x02\x7d\x59\x81\xbe\xac\x40\x5f\x56\xa0\x2f\x2b\xd0\x97\x15\xe8\xcb\x0a\xf4\x65\x05\xfa\xb2\x02\x7d\x59\x81\xbe\xac\x40\x5f\x56\xa0\x2f\x2b\xd0\x97\x15\xe8\xcb\x0a\xf4\x65\x05\xfa\xb2\x02\x7d\x59\x81\xbe\xac\x40\x5f\x56\xa0\x2f\x2b\xd0\x97\x15\xe8\xcb\x0a\xf4\x65\x05\xfa\xb2\x02\x7d\x59\x81\xbe\xac\x40\x5f\x56\xa0\x2f\x2b\xd0\x97\x15\xe8\xcb\x0a\xf4\x65\x05\xfa\xb2\x02\x7d\x59\x81\xbe\xac\x40\x5f\x56\xa0\x2f\x2b\xd0\x97\x15\xe8\xcb\x0a\xf4\x65\x05\xfa\xb2\x02\x7d\x59\x81\x5f\x4d\x59\x81\xaf\xfa\xb2\x02\x7d\x59\x81\xbe\xac\x40\x5f\x56\xa0\x2f\x2b\xd0\x97\x15\xe8\xcb\x0a\xf4\x65\x05\xfa\xb2\x02\x7d\x59\x81\xbe\xac\x40\x5f\x56\xa0\x2f\x2b\xd0\x97\x15\xe8\xcb\x0a\xf4\x65\x05\xfa\xb2\x02\x7d\x59\x81\xbe\xac\x40\x5f\x56\xa0\x2f\x2b\xd0\x97\x15\xe8\xcb\x0a\xf4\x65\x05\xfa\xb2\x02\x7d\x59\x81\xbe\xac\x40\x5f\x56\xe0\xff\x47\x59\x81\xfa\xc9\x6f\xaa\xaa\x40\x6d\x37\x51\x56\x59\xe1\x30\xa5\x90\x65\x00\x9c\xe2\xa6\x53\x38\xf1\xe7\x94\x8a\xac\x34\x22\x0b\xbf\x56\xf7\xb5\xda\x29\xfc\xf0\xd3\x00\xc2\xde\x56\xb0\xb1\xed\x14\x7e\xf8\x69\xf0\x7f\x03\x00\xb1\x5d\xb9\x1c\xfd\xc2\x00\x00")
 
 func clusterApiClusterXK8sIo_machinedeploymentsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -123,7 +124,7 @@ func clusterApiClusterXK8sIo_machinedeploymentsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _clusterApiClusterXK8sIo_machinehealthchecksYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x5f\x6f\xe3\xb8\x11\x7f\xcf\xa7\x18\xb8\x0f\xf7\x12\x29\x4e\x76\xef\xb6\xf0\x5b\xe0\x5d\xf4\x82\xee\x66\x17\x9b\xdc\xf6\xa1\xe8\x03\x2d\x8d\x2d\x5e\x28\x52\x25\x47\x4e\xdc\xc3\x7d\xf7\x62\x48\xfd\xb5\x24\x3b\x8b\xe2\x8a\xa2\x90\x5e\x12\x91\x14\x67\xf8\x9b\xe1\x6f\x86\x1c\x58\x14\xf2\x1b\x5a\x27\x8d\x5e\x81\x28\x24\xbe\x10\x6a\x7e\x73\xf1\xd3\x9f\x5d\x2c\xcd\xd5\xfe\xfa\xe2\x49\xea\x74\x05\xeb\xd2\x91\xc9\xbf\xa2\x33\xa5\x4d\xf0\x3d\x6e\xa5\x96\x24\x8d\xbe\xc8\x91\x44\x2a\x48\xac\x2e\x00\x84\xd6\x86\x04\x37\x3b\x7e\x05\x48\x8c\x26\x6b\x94\x42\x1b\xed\x50\xc7\x4f\xe5\x06\x37\xa5\x54\x29\x5a\x3f\x79\x2d\x7a\xbf\x8c\xdf\xc6\xd7\xd1\x32\xbe\x59\xde\x2c\xaf\x97\xcb\x9b\xe5\x72\xf9\xee\x66\x19\xfd\xf8\xee\xe6\xc7\xa5\x10\xc9\xf5\xbb\xed\x4f\x17\x00\x89\x45\x3f\xf9\xa3\xcc\xd1\x91\xc8\x8b\x15\xe8\x52\xa9\x0b\x00\x2d\x72\x5c\x41\x2e\x92\x4c\x6a\xcc\x50\x28\xca\x92\x0c\x93\x27\x17\x27\xaa\x74\x84\x36\x7e\x89\xc2\x8a\x2e\x5c\x81\x09\x2b\xb7\xb3\xa6\x2c\x56\x30\xe8\x0f\x93\xd5\xfa\x0b\xc2\x9d\xb1\xb2\x7e\x8f\xea\xf1\x91\x28\xa4\x6f\x09\xe8\x7c\x0a\x92\x7f\xf6\x92\xd7\x2c\xd9\x77\x2a\xe9\xe8\xaf\x13\x03\x3e\x4a\x47\x7e\x50\xa1\x4a\x2b\xd4\xa8\xf6\xbe\xdf\x65\xc6\xd2\x7d\xab\x53\x04\x79\x96\xb4\xff\x55\x83\xa4\xde\x95\x4a\xd8\xb1\x69\x2e\x00\x5c\x62\x0a\x5c\x81\x9f\xa5\x10\x09\xa6\x17\x00\x15\xfa\x7e\xd6\x08\x44\x9a\x7a\x7b\x0a\xf5\xc5\x4a\x4d\x68\xd7\x46\x95\xb9\x6e\x64\xa6\xe8\x12\x2b\x0b\xf2\xf6\xfa\x24\x5e\x64\x5e\xe6\xa0\xcb\x7c\x83\x16\xcc\x16\x4a\x1d\x04\x1e\x6a\xf9\x0e\x84\x52\xe6\xd9\x4b\xe2\xe7\x57\x67\xf4\x17\x41\xd9\x0a\x62\xb6\x40\x9c\x8b\x97\x5f\xea\x6f\xaa\x21\xc1\x88\x9f\x86\x1d\x74\x60\xe5\x1d\x59\xa9\x77\x63\xea\xdc\x37\x6a\x34\xc2\x93\xd2\x5a\xd4\xa4\x0e\x90\x1b\x2d\xc9\xd8\x51\x45\x48\x50\xe9\x62\x7c\x29\x30\x21\x4c\x2b\x1b\xb9\x9e\x3a\x1f\xc6\x3b\x83\x4a\x0c\xd4\x0e\xed\x98\x4e\xeb\xa0\x00\x98\x8d\x43\xbb\xc7\x14\x8e\xf1\x99\x54\xa7\x52\xfd\xe7\x11\x6c\xd6\x63\x5d\x43\x55\xc2\xe0\xfd\xb5\x50\x45\x26\xde\x04\xff\x48\x32\xcc\xc5\xaa\xfa\xc4\x14\xa8\x6f\xbf\xdc\x7d\x7b\xf3\xd0\x6b\x86\x63\x2b\x1f\xfb\x2c\x48\x07\x94\x21\x84\xcf\x60\x6b\xac\x7f\x1d\xf1\x5c\xb8\xfd\x72\xd7\xcc\x5a\x58\x53\xa0\xa5\x66\x17\x85\xa7\xc3\x3d\x9d\xd6\x23\x1d\x7e\x60\x35\xc3\x28\x48\x99\x74\x30\x68\x50\x39\x2f\xa6\xd5\xca\xd8\xf8\x94\x49\x07\x16\x0b\x8b\x0e\x75\xa0\x21\x6e\x16\x1a\xcc\xe6\x57\x4c\x28\x86\x07\x36\x85\x75\xbc\xa5\x4a\x95\x32\x3b\xed\xd1\x12\x58\x4c\xcc\x4e\xcb\x7f\x35\xb3\x39\x20\xe3\xc5\x28\x41\xe8\xc8\x83\x6b\xb5\x50\xb0\x17\xaa\xc4\x4b\x10\x3a\x85\x5c\x1c\xc0\x22\xcf\x0b\xa5\xee\xcc\xe0\x87\xb8\x18\x3e\x19\x8b\x20\xf5\xd6\xac\x20\x23\x2a\xdc\xea\xea\x6a\x27\xa9\xe6\xd5\xc4\xe4\x79\xa9\x25\x1d\xae\x3c\x45\xca\x4d\x49\xc6\xba\xab\x14\xf7\xa8\xae\x9c\xdc\x45\xc2\x26\x99\x24\x4c\xa8\xb4\x78\x25\x0a\x19\x79\x65\xb5\xe7\xd6\x38\x4f\xff\x64\x2b\x26\x76\x3f\xf4\xc0\x1b\x6c\x95\xf0\x78\x96\x3a\x81\x32\x93\x14\x1b\x57\x54\x9f\x86\x55\xb4\x60\x72\x13\xe3\xf1\xf5\xc3\xc3\x23\xd4\xa2\x03\xe0\x01\xdb\x76\xa8\x6b\x61\x66\x88\xa4\xde\xa2\x0d\x23\xb7\xd6\xe4\x7e\x16\xd4\x69\x61\xa4\x26\xff\x92\x28\xc9\xfb\xc4\x95\x9b\x5c\x12\xdb\xef\x9f\x25\x3a\x62\x0b\xc4\xb0\xf6\x01\x05\x36\x08\x65\x91\x0a\xc2\x34\x86\x3b\x0d\x6b\x91\xa3\x5a\x0b\x87\x7f\x38\xc8\x8c\xa6\x8b\x18\xbc\xd7\xc1\xdc\x8d\x85\xc7\x83\x03\x4e\x9d\x8e\x3a\x10\x4d\xd8\xe4\xa1\xc0\x44\x6e\x65\xd2\xf8\x71\xb5\xcb\x2a\x22\x01\xbf\xcf\xa0\x30\x4a\x26\x87\xde\x2c\xe3\xbb\x8d\x9f\x2a\x76\x71\x14\x38\xee\x3a\x12\xbe\x6e\x47\xd6\x7b\x9e\x49\x25\xec\x32\xac\xbb\x7b\x0e\xb0\x41\x65\xf4\xce\xdb\x6d\x30\x77\x2e\xf5\x47\xd4\x3b\x66\xb9\xeb\x41\xe7\x04\x96\xfe\xbb\x4e\x2c\x18\x6a\x2c\xf4\xe1\xf3\x76\xd8\x1c\x8d\x30\xe2\x58\xff\xa8\xc4\x23\x1c\x6e\xf5\x01\xb6\xa5\xa5\x0c\x2d\x58\xcc\x31\x95\xc1\x1c\xbc\x6c\xad\x0e\x75\x8c\x03\xb9\x05\x41\x90\x1b\x47\xb0\xe8\x06\xb0\x45\x1b\x91\x1c\x2a\x1f\x48\x60\x73\x80\x45\x78\x31\x76\x01\xc2\x22\xb0\x8f\x57\x1f\x0c\xb1\x7b\x89\x38\x6b\xb2\x1a\x09\x5d\x24\x35\x45\xc6\x46\x41\xf5\x15\x90\x2d\xf1\xe8\x03\x6d\x52\x7c\x20\x61\xa9\x2c\x38\x4d\x32\x25\x9d\x31\x75\x1d\xd8\xc0\x70\x5a\x06\x94\x09\x1d\xec\x9a\x96\x36\x2c\xf6\x59\x52\x66\x4a\x02\xe1\x27\x87\x67\xa9\x14\x6f\xc9\xc4\x68\x27\x53\xb4\x98\x32\x5b\x66\x62\x8f\xb0\x15\x52\x61\xea\xe9\xb1\x1e\x55\xa3\x86\xe9\x70\x69\x27\xec\x50\x03\x74\x46\xf9\x8f\x62\x83\xaa\x19\xcc\x7a\xe4\x82\x92\xac\x45\xfd\x39\x33\xae\xd9\x32\xb5\x4e\xf8\x82\x36\x91\xae\x49\x09\xda\x67\x7a\xef\x80\x77\x47\x4a\xb2\x0f\x2f\x4c\x74\xae\xcd\x71\x4f\x2a\x78\xfc\x49\x20\x59\xce\x0b\x79\x2f\xa9\xbe\xfa\xcc\x7d\x92\x01\xf3\x2c\xfa\x98\x61\xaf\xc5\xbb\xca\xed\xfd\xfb\x31\x24\xf9\x91\x84\xf9\x84\x4a\xc7\x5e\x7d\x42\x70\x15\x05\x1a\x44\x33\x41\x3e\x8b\x17\x52\xbb\x2a\xb6\x5d\x82\x80\x27\x3c\x84\x30\xc8\xd1\xb5\x40\x2b\x9a\xc1\x16\x7d\xd0\xf4\x3c\xf1\x84\x07\x3f\xa8\x8a\x89\x13\xba\x9d\x06\x3d\x3c\x4f\x38\x42\x00\x13\xcb\x63\xa9\x15\x69\x85\x75\x72\x83\xd7\x8d\x9b\x9a\xa5\x89\xa2\x50\x12\xc7\x09\xab\x7d\x4e\x72\x45\xfd\xd4\x08\xbc\x5a\xc5\x06\xb2\x36\x6c\x06\x50\x7f\x70\x01\x40\xf6\x95\x4c\x16\xec\xd1\x6c\x0d\xef\x2d\x75\x66\xf1\x4d\x28\x99\x36\x53\x04\xbf\xb8\xd3\x97\x70\x6f\x88\xff\x7c\x78\x91\x1c\x3f\x19\xf7\xf7\x06\xdd\xbd\x21\xdf\xf2\x1f\x2f\x32\x88\x7f\xf5\x12\xc3\x70\xef\x4e\x1a\x84\xb5\xe2\xc0\x6b\xe8\xa6\x17\x2e\x86\xbb\x10\x4f\x1a\x38\xa4\xe3\x00\x6f\x6c\xbd\x16\x9f\xea\x85\x89\xc2\x14\x79\xe9\x7c\x3e\xa0\x8d\x8e\x30\x2f\xe8\x30\x3a\x47\x05\x81\xb1\x3d\x04\x4e\x4c\x57\x4d\xf5\xc8\xbc\x17\x7a\x42\x2a\xa9\xf8\xac\xc4\x4c\xc8\x4a\xfb\xe4\x88\x0f\x84\x32\x81\x1c\xed\x0e\xa1\xe0\xdd\x7d\x0a\xd8\x93\x7b\xf2\x3b\xb0\xaf\x87\x79\xdd\x26\x46\x55\x9b\x38\x9d\x12\x17\xb1\x7f\x4d\xf6\xd5\xf0\x4d\x0c\x98\xc8\x61\x5e\xab\x9f\xa7\x41\x4f\xd6\x13\x68\x74\x8f\x9f\xe7\xd8\xe0\x2c\x62\x43\x06\x0e\xa2\x03\xb7\xe5\xa2\x60\x4f\xfc\x8d\x29\xcc\x3b\xc3\xef\x50\x08\x69\x5d\x0c\xb7\xfe\x18\xad\xb0\xd7\x27\x75\x75\xc6\x69\xa7\xe1\x19\xa4\x03\xc6\x7b\x2f\x14\x93\x26\x6f\x53\x0d\xa8\x02\x85\xfa\x64\xad\xcf\xfb\x97\x55\x24\x62\x2a\xda\x4a\x54\x3e\xdb\x5e\x3c\xe1\x61\x71\x39\xf0\xde\xc5\x9d\x5e\x04\x72\x1d\xf8\x6b\xc3\xc4\x3e\xfb\x58\xf8\xbe\xc5\xf7\x87\x8a\x93\xd6\x3c\xd1\xd9\x9c\xf2\xd7\x46\x07\x7b\x8d\x18\xa9\x87\xfe\x2f\xc3\x2f\xda\x45\xb4\x81\xd0\x1f\x04\xda\x11\x9e\xac\x53\x24\xb4\x39\x67\xbc\xcf\x19\xfa\x0c\xac\x4a\x40\xa4\xeb\xe6\x1e\x8d\x4e\x31\x78\x1c\x3a\xd3\x30\x0a\x89\xc9\x37\x92\xcf\x89\x52\xb3\x3c\xb3\x93\x89\x50\xf0\xf9\xeb\x25\xc8\x18\x63\x9f\xb8\xe9\xc3\x88\x0a\xd2\x71\x36\x1f\x8c\x53\x4b\x6d\x45\x0d\x16\x3d\xb9\xd1\xcf\xa0\xd1\x0f\x00\xf7\x2c\xa8\x51\xc2\x5b\xa2\x8d\x9f\x3e\x0f\x03\x01\x14\xd2\x3a\x7f\x7e\x90\x5b\xc9\xf9\x16\x7f\x5b\xa7\x6b\x31\xc0\xdf\x32\xd4\x4d\xce\x9e\x76\x26\xcc\x84\x83\x0d\xa2\xae\x9d\x7a\x27\xf7\xa8\x21\xdc\x3b\xf8\xd3\xbc\x20\x50\x28\x5c\x88\x94\xb5\xa0\xfa\xd0\x7b\x1a\xfd\x91\xb5\x9f\x0b\xec\x41\xf0\xd4\x36\x3f\x79\x68\xe8\xba\xea\x09\x26\xa0\xa9\x14\xf8\xb5\xdf\xf3\x80\x3f\x4c\xbf\x53\x8c\x1d\x55\xe8\x8c\x76\x55\xcb\x1a\xef\x3b\x14\xc7\x67\x02\x38\xb7\xe5\x73\xa9\xef\xbc\x03\x4f\x1e\xcf\x86\xc4\x3e\xae\x7c\xd4\x3d\x64\x1e\xf5\xd4\x99\xd7\x51\xf3\x08\xa9\xbc\xea\xf8\x3c\xe2\x3c\xfd\x83\x0d\x9f\xc6\x2c\x26\xe1\x1a\xb0\xb9\x87\xab\xbc\xdd\x6c\xc7\xee\xb7\xea\xab\x8d\xd7\x1e\xa8\x7b\xf7\x71\x67\xa8\x90\x0c\x09\xd5\xb9\x32\x1d\x5c\x98\x26\xa6\xd4\xd5\x01\xd1\x1f\xc0\xc6\x0e\xfc\x03\x11\x5b\x63\x73\x41\xfe\xb4\xfb\xe6\x66\xcc\xb0\x32\x2f\xf3\x15\x2c\x27\xec\x3a\x7e\x48\x3e\xbe\x11\xfd\xce\x95\xfd\x0f\xae\xa8\x36\xff\x5f\x50\x63\xe0\xc9\x33\x6b\xfa\x3c\xf8\xa0\x3d\x53\xf8\x3b\xc1\x5d\xdb\xd1\xf8\x96\x5f\x27\x76\xaa\x1e\x43\x56\xec\xac\xee\xa7\xb7\xdf\xb5\x04\x12\x76\x87\x74\xce\x16\x8f\x61\x14\xb8\xcc\x3c\x07\x7d\x2b\x27\x6d\x62\x6d\x63\x9d\xce\xd5\x6d\xff\x52\x49\x3a\x78\xe6\xfc\x65\x8c\xb5\x26\x03\xdd\x49\xae\x9b\x22\x91\xd1\xbd\x3d\x68\x0c\xe8\x76\xae\x3b\x1c\x19\x2b\x76\xb8\x82\xad\x50\xae\x6a\x2a\x37\xcd\x95\x68\xad\x5e\x45\x11\xf0\xdb\xef\x73\x89\xe3\xff\xb9\xc4\xf1\x76\x2e\x71\xcc\x25\x8e\xb9\xc4\x31\x97\x38\xe6\x12\x47\xbf\x7f\x2e\x71\xcc\x25\x8e\xe3\xc9\xe7\x12\xc7\x5c\xe2\xe8\x3f\x73\x89\xa3\x7e\xe6\x12\xc7\x5c\xe2\x98\x4b\x1c\x73\x89\x63\x2e\x71\xcc\x25\x8e\xb9\xc4\x31\x97\x38\xe6\x12\xc7\x5c\xe2\x98\x4b\x1c\x73\x89\xa3\xa7\xfa\x7f\xb9\xc4\xd1\xb6\x9c\xae\x70\xb4\x64\x21\x92\x04\x0b\xc2\xb4\xf3\x9b\x91\xf0\x2b\x95\xc5\xc2\xbf\xd4\x3f\x38\xf1\xaf\x6d\x3e\xb0\x82\xbf\xff\xe3\x22\x08\xc6\xf4\x5b\xfd\xe3\x10\x6e\xfc\x77\x00\x00\x00\xff\xff\xfe\xe3\x08\x5a\x26\x34\x00\x00")
+var _clusterApiClusterXK8sIo_machinehealthchecksYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xdf\x6f\xdb\x38\xf2\x7f\xf7\x5f\x31\xf0\xf7\xa1\x2f\x91\xe2\xa4\xdd\xed\x17\x7a\x0b\xd2\xe2\x36\xb8\x36\x2d\x9a\x6c\xef\x61\xb1\x0f\xb4\x34\xb6\xb8\xa1\x48\x1d\x39\x72\xe2\x5b\xec\xff\x7e\x18\x92\x92\x6c\x4b\x76\x92\x3b\x2c\x70\x77\x50\x1d\xa0\x16\x87\x22\x67\x3e\xf3\x93\x1c\x58\xd4\xf2\x3b\x5a\x27\x8d\xce\x40\xd4\x12\x9f\x08\x35\x3f\xb9\xf4\xe1\xff\x5d\x2a\xcd\xf9\xe6\x62\xf6\x20\x75\x91\xc1\x75\xe3\xc8\x54\xdf\xd0\x99\xc6\xe6\xf8\x01\x57\x52\x4b\x92\x46\xcf\x2a\x24\x51\x08\x12\xd9\x0c\x40\x68\x6d\x48\xf0\xb0\xe3\x47\x80\xdc\x68\xb2\x46\x29\xb4\xc9\x1a\x75\xfa\xd0\x2c\x71\xd9\x48\x55\xa0\xf5\x8b\xb7\x5b\x6f\x16\xe9\xbb\xf4\x22\x59\xa4\x97\x8b\xcb\xc5\xc5\x62\x71\xb9\x58\x2c\xde\x5f\x2e\x92\x1f\xde\x5f\xfe\xb0\x10\x22\xbf\x78\xbf\xfa\x71\x06\x90\x5b\xf4\x8b\xdf\xcb\x0a\x1d\x89\xaa\xce\x40\x37\x4a\xcd\x00\xb4\xa8\x30\x83\x4a\xe4\xa5\xd4\x58\xa2\x50\x54\xe6\x25\xe6\x0f\x2e\xcd\x55\xe3\x08\x6d\xfa\x94\x04\x89\x66\xae\xc6\x9c\x99\x5b\x5b\xd3\xd4\x19\x0c\xe8\x61\xb1\x96\x7f\x41\xb8\x36\x56\xb6\xcf\x49\x3b\x3f\x11\xb5\xf4\x33\x02\x3a\x9f\xc3\xce\x3f\xf9\x9d\xaf\x79\x67\x4f\x54\xd2\xd1\x5f\x8f\x4c\xf8\x24\x1d\xf9\x49\xb5\x6a\xac\x50\xa3\xdc\x7b\xba\x2b\x8d\xa5\xdb\x9e\xa7\x04\xaa\x32\xef\xbf\x39\xff\xd5\x49\xbd\x6e\x94\xb0\x63\xcb\xcc\x00\x5c\x6e\x6a\xcc\xc0\xaf\x52\x8b\x1c\x8b\x19\x40\x44\xdf\x4b\x96\x80\x28\x0a\xaf\x4f\xa1\xbe\x5a\xa9\x09\xed\xb5\x51\x4d\xd5\xea\x31\x81\x02\x5d\x6e\x65\xcd\x53\x58\xdc\x27\x59\x35\x15\xe8\xa6\x5a\xa2\x05\xb3\x82\x46\x07\xd4\xb7\xed\xfe\x0e\x84\x52\xe6\xd1\xef\xc4\x2b\xfc\xe6\x8c\xfe\x2a\xa8\xcc\x20\x65\x0d\xa4\x95\x78\xfa\xb9\x7d\x27\x4e\x61\xdc\xfd\xda\x87\x04\xda\x32\xf3\x8e\xac\xd4\xeb\x31\x76\x6e\x3b\x36\xba\xcd\xf3\xc6\x5a\xd4\xa4\xb6\x50\x19\x2d\xc9\xd8\x51\x46\x48\x50\xe3\x52\x7c\xaa\x31\x27\x2c\xa2\x8e\x5c\x9c\x18\xd8\xf9\x38\x4e\x0c\x2c\x31\x50\x6b\xb4\x63\x3c\x5d\x07\x06\xc0\x2c\x1d\xda\x0d\x16\x70\x88\xcf\x51\x76\x22\xeb\x3f\x8d\x60\x73\x3d\x46\x1a\xb2\x12\x26\x6f\x2e\x84\xaa\x4b\xf1\xd6\x4f\x73\x79\x89\x95\xf7\x51\x7e\x32\x35\xea\xab\xaf\x37\xdf\xdf\xde\xed\x0d\xc3\xbe\x08\x43\x9b\x05\xe9\x80\x4a\x84\xf0\x1a\xac\x8c\xf5\x8f\x43\x93\x73\x70\xf5\xf5\xa6\x5b\xb5\xb6\xa6\x46\x4b\x9d\x17\x85\xbf\x9d\xd8\xb3\x33\x7a\xc0\xc3\x1b\x66\x33\xcc\x82\x82\x83\x0e\x06\x0e\xa2\xf1\x62\x11\x25\x63\x1b\xa4\x52\x3a\xb0\x58\x5b\x74\xa8\x43\x18\xe2\x61\xa1\xc1\x2c\x7f\xc3\x9c\x52\xb8\x63\x55\x58\x07\xae\x34\x8d\x2a\x38\x3a\x6d\xd0\x12\x58\xcc\xcd\x5a\xcb\x7f\x74\xab\x39\x20\xe3\xb7\x51\x82\xd0\x91\x07\xd7\x6a\xa1\x60\x23\x54\x83\x67\x20\x74\x01\x95\xd8\x82\x45\x5e\x17\x1a\xbd\xb3\x82\x9f\xe2\x52\xf8\x6c\x2c\x82\xd4\x2b\x93\x41\x49\x54\xbb\xec\xfc\x7c\x2d\xa9\x8d\xab\xb9\xa9\xaa\x46\x4b\xda\x9e\xfb\x10\x29\x97\x0d\x19\xeb\xce\x0b\xdc\xa0\x3a\x77\x72\x9d\x08\x9b\x97\x92\x30\xa7\xc6\xe2\xb9\xa8\x65\xe2\x99\xd5\x2c\x94\x4b\xab\xe2\xff\x6c\x8c\xc4\xee\xcd\x1e\x78\x03\x57\x09\x7f\x3e\x4a\x9d\x40\x99\x83\x14\x2b\x57\xc4\x57\x83\xa0\x3d\x98\x3c\xc4\x78\x7c\xfb\x78\x77\x0f\xed\xd6\x01\xf0\x80\x6d\x3f\xd5\xf5\x30\x33\x44\x52\xaf\x90\xad\x44\x3a\x58\x59\x53\x79\x54\x51\x17\xb5\x91\x9a\xfc\x43\xae\x24\x6a\x02\xd7\x2c\x2b\x49\xac\xbf\xbf\x37\xe8\x88\x35\x90\xc2\xb5\x4f\x28\xb0\x44\x68\xea\x42\x10\x16\x29\xdc\x68\xb8\x16\x15\xaa\x6b\xe1\xf0\x4f\x07\x99\xd1\x74\x09\x83\xf7\x32\x98\x77\x73\x61\xff\x8f\x57\xc9\xa2\x0d\xee\x10\xda\x44\x74\x44\x27\x77\x35\xe6\x72\x25\xf3\xce\x8e\xa3\x97\xc5\x40\x02\x3e\xbf\x41\x6d\x94\xcc\xdb\x48\x70\xca\xdb\xf8\x13\x73\x17\x67\x81\x43\xd2\xc1\xe6\xd7\xfd\xcc\xd6\xe7\x39\xa8\xb0\x3b\xf1\xf7\x48\xde\x33\x80\x25\x2a\xa3\xd7\x5e\x6f\x83\xb5\x2b\xa9\x3f\xa1\x5e\x73\xf4\xbf\x18\x10\x8f\x60\xc9\x7f\xbb\x49\x62\xc8\xb1\xd0\xdb\x2f\xab\xe1\x70\x32\x12\x11\xc7\xe8\xa3\x3b\x1e\xe0\x70\xa5\xb7\xb0\x6a\x2c\x95\x68\xc1\x62\x85\x85\x0c\xea\x60\xbb\xd7\x6a\xdb\xe6\x38\x90\x2b\x10\x04\x95\x71\x04\xf3\xdd\x04\x36\xef\xd3\xa1\x43\xe5\x13\x09\x2c\xb7\x30\x0f\x0f\xc6\xce\x41\x58\x04\xb6\xf1\xf8\x42\x3a\xe0\xe7\x29\xe1\xaa\xc9\x6a\x24\x74\x89\xd4\x94\x18\x9b\x04\xd6\x33\x20\xdb\xe0\xc1\x0b\xda\x14\x78\x47\xc2\x52\x53\x73\x99\x64\x1a\xca\x4e\x8b\xd8\x26\x36\x30\x5c\x96\x01\x95\x42\x07\xbd\x16\x8d\x0d\xc2\x3e\x4a\x2a\x4d\x43\x20\xfc\xe2\xf0\x28\x95\x62\x97\xcc\x8d\x76\xb2\x40\x8b\x05\x47\xcb\x52\x6c\x10\x56\x42\x2a\x2c\x7c\x78\x6c\x67\xb5\xa8\x61\x91\xbe\x46\xf3\x2d\x40\xcf\x30\xff\x49\x2c\x51\x45\x68\x39\x19\x19\xa8\x04\xe5\x65\x8f\xfa\x63\x69\x5c\xe7\x32\x2d\x4f\xf8\x84\x36\x97\xae\x2b\x09\x5e\xe2\x3b\xfc\xf1\x6b\x7f\x7c\xe2\x04\xd3\x55\x4e\x00\x27\x19\x3c\x7c\x85\xbd\x49\xf8\xba\x90\x7d\x49\xed\xb3\xcf\xb1\x4f\x32\x60\x3e\x8a\xde\x97\xb8\x37\xe2\x4d\xe5\xea\xf6\xc3\x18\x92\xfc\x91\x84\xd5\x11\x96\x0e\x98\xba\x3a\xb1\x71\xcc\x02\x2d\x85\x4a\x41\x9c\x27\x49\x48\xed\x42\x56\x70\x67\x20\xe0\x01\xb7\x21\x0d\x72\x76\xad\xd1\x8a\x6e\xb2\x45\x9f\x34\x7d\x6c\x7f\xc0\xad\x9f\x14\x73\xe2\x11\xde\x4e\x83\x1e\x13\x18\x6e\x8f\x13\x0f\xc4\xe3\x5d\x63\xd0\x0a\x72\xf2\x80\xe7\x8d\x79\xea\x84\x16\x75\xad\x24\x8e\x07\xac\x17\xd9\x68\xff\x69\x11\x78\x31\x8b\x1d\x64\x7d\xda\x0c\xa0\xbe\xe1\x0c\xa8\xbc\xdf\xb9\x52\xd6\x6c\xd1\xac\x0d\x6f\x2d\x6d\x65\xf1\x5d\x28\x59\x74\x7b\x06\xbb\xb8\xd1\x67\x70\x6b\x88\xff\xfb\xf8\x24\x39\x7f\x32\xee\x1f\x0c\xba\x5b\x43\x7e\xe4\xdf\x16\x32\x6c\xff\x62\x11\xc3\x74\x6f\x4e\x1a\x84\xb5\x62\xcb\x32\xec\x96\x17\x2e\x85\x9b\x90\x4f\x3a\x38\xa4\xe3\x04\x6f\x6c\x2b\x0b\x13\xe3\x42\x61\x89\xaa\x71\xc4\x2e\xac\x8d\x4e\xb0\xaa\x69\x3b\xba\x46\x84\xc0\xd8\x3d\x04\x4e\x2c\x17\x97\xba\xe7\xb8\x17\x36\x0a\xa5\xa4\xe2\xb3\x12\x14\x8d\x67\xda\x17\x47\x7c\x20\x94\x39\x54\x68\xd7\x08\x35\x7b\xf7\x29\x60\x4f\xfa\xe4\x2b\xb0\x6f\xa7\x79\xde\x8e\xcc\x8a\x4e\x7c\x50\xe7\xf5\x9f\x84\xed\xeb\x28\xad\x85\xef\xc8\x84\x23\x35\xcc\x4b\xf9\xf3\x61\xd0\x07\xeb\x23\x68\xec\x1e\x3f\x9f\x8b\x06\xcf\x22\xb6\x67\x87\x3b\x5b\x7b\x63\x84\x4a\xd4\x6c\x89\xbf\x73\x08\xf3\xc6\xf0\x07\xd4\x42\x5a\x97\xc2\x95\x3f\x46\x2b\xdc\xa3\x49\x1d\xcf\x38\xfd\x32\xbc\x82\x74\xc0\x78\x6f\x84\xe2\xa0\xc9\x6e\xaa\x01\x55\x08\xa1\x66\x35\x88\xfb\x67\x31\x13\x71\x28\x5a\x49\x54\x05\xf3\x32\x7f\xc0\xed\xfc\x6c\x60\xbd\xf3\x1b\x3d\x0f\xc1\x75\x60\xaf\x5d\x24\xf6\xd5\xc7\xdc\xd3\xe6\xaf\x4f\x15\x27\xb5\x79\x82\xd8\x9d\xf2\xaf\x8d\x0e\xfa\x1a\x51\xd2\x1e\xfa\x3f\x0f\xdf\xe8\xd3\x49\x9f\x08\x59\xd0\xbc\x9f\xe1\x83\x75\x81\x84\xb6\xe2\x8a\xf7\xb1\x44\x5f\x81\xc5\x02\x44\xba\xdd\xda\xa3\xe3\x29\x05\xb8\xdf\x5f\x86\x03\x63\x6e\xaa\xa5\xd4\x5c\xa2\x69\x10\xa0\xcc\x5a\xe6\x42\xc1\x97\x6f\x67\x20\x53\x4c\x7d\xe1\xa6\xb7\x23\x2c\x48\x07\x15\x52\x50\x4e\xbb\x6b\xbf\xd5\xec\xc5\x8e\xfe\x0c\x1a\xfb\x09\xe0\xd6\x14\x3b\x4c\x78\x9f\xef\xf3\xa7\xaf\xc3\x40\x00\x85\xb2\xce\x9f\x1f\xe4\x4a\x72\xbd\xc5\xef\xb6\xe5\x5a\x0a\xf0\xb7\x12\x75\x57\xb3\x17\x3b\x0b\x96\xc2\xc1\x12\x51\xb7\x46\xbd\x96\x1b\xd4\x10\xee\x1d\xfc\x69\x5e\x10\x28\x14\x2e\x9c\xcc\xda\x8d\xda\x43\xef\x69\xf4\x67\xaf\x4f\xec\x61\xe3\x71\xda\x33\x87\x86\x17\x46\x82\x28\x42\xf6\x2f\xbf\xcf\xbe\xf0\xa7\xf1\x77\x2a\x62\x27\x51\x2d\xa3\xa4\x28\xd6\x38\x6d\x5b\xe3\xec\x18\x2b\x47\x5c\xbe\x92\xfa\xc6\x1b\xf0\x88\x24\xc7\x02\xfb\x38\xf3\xdd\x05\x29\x1f\x1d\x0f\x28\x6d\xe5\x75\x30\xdc\x0c\x9c\xc2\xcd\x86\x0c\x0c\x38\x1f\x33\x9e\x3d\x67\xfb\xcc\xa7\x31\x8b\x79\xb8\x06\xec\xee\xe1\xa2\xb5\x9b\xd5\xd8\xfd\x56\x7b\xb5\x31\x7b\x99\x19\xef\x5f\xd5\x65\xb3\x93\xce\x4f\x86\x84\xda\xb9\x32\x8d\x52\xf7\x67\x95\xdc\x34\x3a\x1e\x10\xfd\x01\x2c\x12\xda\xd3\x4b\x7b\x97\xbb\xff\x6f\x65\x6c\x25\xc8\xdf\xff\xbd\xbd\x1c\x50\x2b\xa9\xf9\xa2\x36\x83\xc5\x80\x74\xea\x90\x7c\x78\x23\xfa\x4a\xc9\xfe\x03\x25\x6a\xd5\xff\x17\xd4\x9c\x68\x07\x97\x8e\x03\x99\xbe\x0c\x5e\xe8\xcf\x14\xfe\x4e\x70\xdd\x13\x3a\xdb\xf2\x9a\xc3\x9d\xae\x47\x7a\x4a\xba\x1f\xdf\xbd\x4a\x04\x12\x76\x8d\xf4\x9c\x2e\xee\xc3\x2c\xbe\xe2\x7c\x0c\xfc\x46\x23\xed\x72\x6d\xa7\x9d\x9d\xab\xdb\x3d\x8d\xb0\xa0\x8f\x5c\xbf\x8c\x45\xad\xa3\x89\xee\x64\xac\x3b\x16\x44\x46\x7d\x7b\x30\x18\xd0\xdd\xb9\xee\x70\x64\xac\x58\x63\x06\x2b\xa1\x5c\x1c\x6a\x96\xad\xf3\x76\xec\xc5\x10\x01\xbf\xff\x31\xb5\x38\xfe\x97\x5b\x1c\xef\xa6\x16\xc7\xd4\xe2\x98\x5a\x1c\x53\x8b\x63\x6a\x71\x4c\x2d\x8e\xa9\xc5\x31\xb5\x38\xa6\x16\xc7\xd4\xe2\x98\x5a\x1c\x53\x8b\x63\x6a\x71\x4c\x2d\x8e\xa9\xc5\x31\xb5\x38\xa6\x16\xc7\xd4\xe2\x98\x5a\x1c\x53\x8b\x63\x6a\x71\x4c\x2d\x8e\xff\xb6\x16\x47\x3f\x72\xba\xc3\xd1\x07\x0b\x91\xe7\x58\x13\x16\x3b\xbf\x19\xe1\xfb\xbd\x0c\xe6\xf3\xbd\x1f\x9c\xf8\xc7\x2e\x73\xba\x0c\x7e\xf9\x95\x7f\x2a\xe2\x3b\x09\xf1\x42\xda\x65\xf0\xcb\xaf\xb3\x7f\x0e\x00\xfe\xe3\x08\x5a\x26\x34\x00\x00")
 
 func clusterApiClusterXK8sIo_machinehealthchecksYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -143,7 +144,7 @@ func clusterApiClusterXK8sIo_machinehealthchecksYaml() (*asset, error) {
 	return a, nil
 }
 
-var _clusterApiClusterXK8sIo_machinesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x6b\x6f\x1c\x37\x92\xdf\xfd\x2b\x0a\xde\x0f\x4a\x80\x99\x96\xec\xcd\x6e\x16\x02\xee\x83\xcf\x8f\x3d\xdd\xc6\xb6\x60\xc9\xb9\x03\xb2\x01\x96\xc3\xae\x9e\xe1\xaa\x9b\xec\xf0\x21\x79\x6e\xb1\xc0\xfd\x8d\xfb\x7b\xf7\x4b\x0e\x55\x24\xfb\x31\x0f\xcd\xd8\x42\x72\x76\xd2\xf3\x45\x1a\x36\x9b\xac\x2a\xd6\x9b\x35\x25\x5a\xf5\x3d\x5a\xa7\x8c\x3e\x07\xd1\x2a\xfc\xe0\x51\xd3\x37\x57\xdc\xfc\xc9\x15\xca\x9c\xde\x3e\x79\x74\xa3\x74\x79\x0e\xcf\x83\xf3\xa6\x79\x87\xce\x04\x2b\xf1\x05\x56\x4a\x2b\xaf\x8c\x7e\xd4\xa0\x17\xa5\xf0\xe2\xfc\x11\x80\xd0\xda\x78\x41\xc3\x8e\xbe\x02\x48\xa3\xbd\x35\x75\x8d\x76\xbe\x44\x5d\xdc\x84\x05\x2e\x82\xaa\x4b\xb4\xbc\x78\xde\xfa\xf6\xac\xf8\xa6\x78\x32\x3f\x2b\x9e\x9e\x3d\x3d\x7b\x72\x76\xf6\xf4\xec\xec\xec\xdb\xa7\x67\xf3\x3f\x7c\xfb\xf4\x0f\x67\x42\xc8\x27\xdf\x56\x7f\x7c\x04\x20\x2d\xf2\xe2\xd7\xaa\x41\xe7\x45\xd3\x9e\x83\x0e\x75\xfd\x08\x40\x8b\x06\xcf\xa1\x11\x72\xa5\x34\xba\x42\xd6\xc1\x79\xb4\xc5\x87\x79\x44\xe3\x91\x6b\x51\x12\x44\x4b\x6b\x42\x7b\x0e\x5b\xcf\xe3\x0a\x19\x68\xe1\x71\x69\xac\xca\xdf\xe7\x79\xfe\x5c\xb4\x8a\x47\x22\x49\x5e\xc7\xed\x78\xa4\x56\xce\xff\x65\x38\xfa\x9d\x72\x9e\x9f\xb4\x75\xb0\xa2\xee\x81\xe3\x41\xb7\x32\xd6\xbf\xe9\xb7\x9c\x43\x23\xe2\x03\xa5\x97\xa1\x16\xb6\x9b\xff\x08\xc0\x49\xd3\xe2\x39\xf0\xf4\x56\x48\x2c\x1f\x01\x24\xd2\xf1\xeb\x73\x10\x65\xc9\x87\x21\xea\x4b\xab\xb4\x47\xfb\xdc\xd4\xa1\xd1\xdd\xe2\x25\x3a\x69\x55\xeb\x99\xd8\x97\xd6\xdc\xaa\x12\x2d\x5c\xbc\xe0\xc7\x00\x7f\x77\x46\x5f\x0a\xbf\x3a\x87\x82\x08\x55\xb4\x69\x46\x37\x21\x92\xf7\x72\x73\xd8\xaf\x09\x2e\xe7\xad\xd2\xcb\x5d\x3b\x25\x52\x80\xf3\xc2\x07\x07\x2e\xc8\x15\x08\x07\xd7\x68\x1b\xa5\x85\x57\x7a\x79\x7a\x89\xba\xa4\xbf\xef\x82\xd6\xf4\xf7\x95\x50\x35\x96\x80\x5e\xee\x80\x8d\x97\x29\xda\x95\x70\x38\x06\x6c\x30\x72\x08\xa6\xbf\x84\x05\x5a\x8d\x1e\x5d\xa6\x21\x08\xe7\x8c\x54\xc2\x63\x09\x77\xca\xaf\xc0\xaf\x94\x1b\x1d\xee\x36\x85\xd2\xab\x23\x28\xbe\x1f\x8d\x1d\x82\xe3\x8d\x29\x91\x5f\xdc\xbd\x7d\xb3\x77\xfb\x48\x04\x6d\x4a\x7c\x87\x55\x41\x2b\x8c\xa0\xa0\x75\xdf\xf4\x83\xad\x55\xc6\x2a\xbf\x3e\x87\x27\xfb\x00\x8b\xef\xdd\x3e\x11\x75\xbb\x12\xbf\x8f\x5c\x28\x57\xd8\x88\xf3\xf4\x86\x69\x51\x3f\xbb\xbc\xf8\xfe\xf7\x57\xa3\x61\xd8\x7d\xd8\xca\x81\x5f\x21\xc4\xb9\x50\x19\xcb\x5f\x33\xf7\xc3\xb3\xcb\x8b\xee\xfd\xd6\x9a\x16\xad\xef\x04\x2d\x7e\x06\x3a\x69\x30\xba\xb1\xdb\x09\x01\x94\xce\xaf\x24\x65\x84\x71\xdb\x74\x30\x58\x26\x1c\xc0\x54\x91\xa0\x16\x5b\x8b\x0e\x75\x54\x4f\x34\x2c\x34\x98\xc5\xdf\x51\xfa\x02\xae\xd0\xd2\x8b\x24\x96\xa1\x2e\x49\x6b\xdd\xa2\xf5\x60\x51\x9a\xa5\x56\xff\xd5\xad\xe6\xc0\x1b\xde\xa6\x16\x1e\x9d\x07\x96\x35\x2d\x6a\xb8\x15\x75\xc0\x19\x08\x5d\x42\x23\xd6\x60\x91\xd6\x85\xa0\x07\x2b\xf0\x14\x57\xc0\x6b\x63\x11\x94\xae\xcc\x39\xac\xbc\x6f\xdd\xf9\xe9\xe9\x52\xf9\xac\x6f\xa5\x69\x9a\xa0\x95\x5f\x9f\xb2\xea\x54\x8b\xe0\x8d\x75\xa7\x25\xde\x62\x7d\xea\xd4\x72\x2e\xac\x5c\x29\x8f\xd2\x07\x8b\xa7\xa2\x55\x73\x06\x56\xb3\xce\x2d\x9a\xf2\x77\x36\x69\x68\x77\x32\x22\xde\xd6\xa9\xc7\x0f\x2b\xb2\x7b\xa8\x4c\x2a\x8d\x4e\x54\xa4\x57\x23\x16\x3d\x31\x69\x88\xe8\xf1\xee\xe5\xd5\x35\xe4\xad\x23\xc1\x23\x6d\xfb\xa9\xae\x27\x33\x91\x48\xe9\x0a\x6d\x9c\x59\x59\xd3\xf0\x2a\xa8\xcb\xd6\x28\xed\xf9\x8b\xac\x15\x6a\x0f\x2e\x2c\x1a\xe5\xe9\xfc\x7e\x0a\xe8\x3c\x9d\x40\x01\xcf\xd9\xd0\xc0\x02\x21\xb4\x25\x49\x4e\x01\x17\x1a\x9e\x8b\x06\xeb\xe7\xc2\xe1\xcf\x4e\x64\xa2\xa6\x9b\x13\xf1\x8e\x23\xf3\xd0\x46\x6e\x4e\x8e\x74\x1a\x3c\xc8\xb6\x6a\xcf\x99\x24\x39\xbb\x6a\x51\x8e\x38\xbf\x44\xa7\x2c\x71\xaa\x17\x1e\x89\xbf\xc7\x1a\xec\x3e\x89\xa3\xcf\xc2\x18\xef\xbc\x15\xed\xe6\x83\x8d\xed\xff\x35\xcf\x8b\x6c\x61\xb1\x42\x8b\x9a\x0e\xdd\x80\x80\xda\x48\x51\x13\xfe\x41\x7a\xb8\x5b\x29\xb9\x02\xd4\x52\xb4\x2e\xb0\xc4\x40\xa5\xb0\x2e\x59\x8a\xa4\xd1\x95\x5a\x06\x8b\x0c\x7c\x82\xf5\x7f\xff\xfb\x7f\x5c\x0f\x49\x4b\xdc\xd5\xa0\x5c\x09\xad\x5c\x53\x6c\xc1\xb5\x1f\x19\x88\xae\x47\xa5\x96\xef\xb0\xda\xf5\x70\x03\xa9\xe7\x79\xee\x4e\xa4\x3a\x80\x20\x1b\xc6\x39\x9d\x91\xaa\x94\x1c\xf2\xbc\xf0\xb0\x32\x84\x5d\x46\x2d\x6a\x9a\x12\xbd\x50\xb5\x2b\xe0\x7a\x85\x83\x95\x49\x42\xda\x68\xb3\x79\x97\xba\x36\x77\x10\x1c\x5a\x77\x4a\x58\x09\xe2\x48\x7a\x10\x77\x5a\xf7\x74\x2f\x5e\x08\x2f\xd8\x52\x98\x10\x25\x45\x23\x96\xac\xd0\x06\xee\xd6\x36\xb5\x0e\x53\x0c\xee\x51\xbe\x7b\x49\xf7\xec\xf2\xa2\x33\xa4\xac\x6b\x3b\x24\xfd\x6e\x18\x60\xbf\x98\x0c\x3f\xcc\x29\x6c\xf3\x8e\x83\xe3\xe4\xa2\x8a\x1b\xb3\x9a\xe2\x73\x6b\x15\x4a\x1c\x69\x7a\x50\xda\x79\x14\x65\x1a\x24\x89\xb6\x98\x9e\xcd\xa2\x32\x4a\x7a\xae\xb7\x04\x5e\x28\x0d\x82\x14\x9f\x2a\xe1\xdf\xaf\xde\xbe\x39\xfd\xb3\x89\xd0\x81\x90\x12\x9d\x8b\x12\xd7\xa0\xf6\xb3\xce\xc5\x49\xc2\x78\x45\x4f\x8a\x46\x68\x55\xa1\xf3\x45\x5a\x0d\xad\xfb\xe1\xe9\x8f\x05\xbc\x32\x16\xf0\x83\x68\xda\x1a\x67\xa0\x22\xed\x3a\xad\x39\xe0\x13\x46\xa6\x7b\x97\x8f\x9e\x41\x6a\x4d\x99\x80\xbe\x63\x60\xbd\xb8\x41\x30\x09\xd8\x80\x50\xab\x1b\x3c\x87\xc7\xec\xb0\xf4\x5b\xff\x83\xec\xfd\x3f\x1f\xc3\x57\x77\x2b\xb4\x08\x8f\xe9\xeb\xe3\xb8\x61\x67\xde\xd8\x2f\x49\xa7\xd9\x6f\xcc\x2c\xee\xad\x5a\x2e\x91\x14\x0d\x6b\x6c\xd2\x8a\x5f\x83\xb1\x04\xbf\x36\x83\xc9\xbc\x04\xd1\x33\x4a\x0a\x96\x5b\x80\xfc\xf0\xf4\xc7\xc7\xf0\xd5\x18\x2f\x50\xba\xc4\x0f\xf0\x14\x94\x8e\x98\xb5\xa6\xfc\x9a\x44\x87\x56\x5a\x6b\x2f\x3e\xd0\x9a\x72\x65\x1c\x6a\x30\xba\x5e\x13\xc4\x2b\x71\x8b\xe0\x4c\x83\x70\x87\x75\x3d\x8f\x4a\xb1\x84\x3b\xb1\x26\x1c\x32\x29\xe9\x54\x05\xb4\xc2\xfa\x0d\xe3\x7f\xfd\xf6\xc5\xdb\xf3\xb8\x1b\x1d\xdb\x52\xd3\x16\x64\x5e\x2a\x45\xc2\x49\x36\x3d\x9a\x29\x3e\x73\x02\x24\xc4\x43\x22\x2d\xb6\x12\x7a\x89\x11\x5a\x84\x2a\x90\xc1\x28\x4e\x1e\xc2\xf7\xdb\x16\x79\x3f\xcb\xb3\x75\xde\x14\xba\xff\x37\xdb\xf7\x91\x88\xb2\xdb\x79\x24\xa2\x6f\x06\xfc\x78\x2f\xa2\x37\x9d\x77\x4f\xb8\x96\x46\x3a\x42\x53\x62\xeb\xdd\xa9\xb9\x45\x7b\xab\xf0\xee\xf4\xce\xd8\x1b\xa5\x97\x73\x62\xb8\x79\xe4\x02\x77\xca\xf1\xdf\xe9\xef\xf8\xcf\x83\xf1\xe2\x30\xed\x63\x90\xe3\x17\x7e\x09\x0c\x69\x1f\x77\xfa\x20\x04\xb3\xc9\xfb\x38\x3b\x71\x72\xb5\x69\x32\xb3\xfb\xee\x4d\xf2\x14\x92\x9f\x3e\xd0\x7c\x8d\x28\xa3\x6a\x14\x7a\xfd\xb3\x33\x36\x91\x31\x58\xda\x7b\x3d\x4f\x86\x74\x2e\x74\x49\xff\x3b\xe5\x3c\x8d\x3f\x88\x6e\x41\x1d\x2d\xd8\xef\x2f\x5e\xfc\x32\xec\x1e\xd4\x83\xa4\x78\x8f\x07\xdb\x21\xb5\xe5\xf2\xee\x44\xf7\x31\x7b\x34\xc9\x10\x44\x67\xb6\x77\xb9\x68\x8d\xde\xae\xca\xda\x84\x72\xae\xb4\xf2\xd9\xaf\x82\xb8\x90\x2b\xe0\xa2\x02\xad\xea\xd9\xd0\x9f\xcc\x66\xdc\x62\x43\x56\x3c\xa9\xe9\x94\x73\x88\x76\xbb\x80\xbf\x6a\x78\x41\x41\x8a\xa4\x40\xe2\x3c\xda\x9a\xa8\xeb\x57\xc2\xc1\x02\x91\x7c\xb8\xfc\x9c\xd6\xc8\xa1\x32\x9b\x86\x3b\x55\xd7\x14\x88\x58\x6c\xcc\x6d\x7c\x2e\x92\x21\xc8\x7e\x51\x01\x57\x77\xca\x13\x83\x1b\x20\x54\xaf\x50\x5a\xe4\xec\x4f\xf1\xf8\x1e\xb2\xee\xa5\x7b\x39\x5a\xe3\x08\x02\x8f\x37\xcd\x11\xfa\xd0\xc8\x3b\x7e\x1a\x2d\xbc\xf3\xc6\xe2\xae\x63\x48\xa4\xfe\x64\x4a\x7f\x3c\xae\xf7\xf0\x57\x4a\xc9\xed\xa6\xc0\xd8\xb9\xef\x67\xee\x42\x3d\x3d\x1e\x05\xad\x0b\xac\x8d\x5e\x72\xac\xb9\xb5\x76\xa3\xf4\x77\xa8\x97\x7e\xd5\xa7\x54\x8e\xc2\xa6\x12\xaa\x0e\x16\x5f\x18\x22\xd0\x01\x90\x5f\x0d\xe7\x66\xa0\xd3\x02\x50\xc6\xd1\x41\x56\xa5\x63\x42\x4e\x90\x32\x13\x16\xf0\x3a\x38\x0f\x8d\x20\xbe\x13\x70\x83\xeb\x7c\x26\xa3\xb5\x49\xc5\xb6\xf1\xc4\x4b\x72\x1d\x63\xe0\x1d\x09\x92\xfc\xa3\x8f\xc1\x51\xe9\xca\x8a\x18\xfd\x05\x8b\x3b\x63\xaf\x11\x9e\x17\x9b\xf3\x73\xfc\xf5\x53\xe0\x58\x76\x23\x10\x93\x9c\x83\xee\x63\x2e\x53\x55\xec\x89\x2e\xd6\xe4\xcf\x8d\x37\xef\x62\xb5\x8f\x8d\x1b\x0f\x45\x40\x0f\x8b\x7e\x0e\x88\xf6\x81\xa8\x67\x8a\x78\xa6\x88\xe7\xcb\x89\x78\x0e\xf0\xfa\xfe\x48\xe7\x4b\x88\x72\x0e\x20\xb7\x3f\xba\xf9\x0c\x23\x9b\x23\x70\xb9\x27\xa2\xf9\x4c\xa3\x99\x03\x48\x1d\x15\xc5\xfc\x56\x22\x98\x03\xb4\xda\x1b\xb9\x7c\x76\x51\xcb\xa7\x7a\x93\xda\x94\xf8\xc2\x0a\xc5\x57\xcb\x26\xf8\x03\x7e\xcb\xc9\x9b\x8d\xf9\xd9\x47\xf3\xc6\x93\x02\x6d\x4c\xd0\xac\x74\xbd\x6a\x52\x6a\x38\x1b\x96\x98\x9e\x8d\x1e\x9b\x6b\x51\xb3\xdb\x55\xd2\x52\x51\x5d\x13\x24\x31\x57\x5c\x62\x25\x42\xed\x93\x69\x53\x0e\xce\x66\xd0\xa0\xd0\xf1\xce\x25\x2d\x49\xd3\x41\x0a\x4d\xde\x1f\xaf\x92\x6e\x11\x09\x26\xa1\xd7\x11\x80\x5a\x35\x2a\x5d\xcb\x17\xf0\xe6\xed\xf5\xcb\x78\x4d\xb8\x89\x40\xa9\xaa\x78\x6a\xf1\x46\xe6\x6f\x74\x4e\xd2\xd7\x71\x5d\x98\xcf\x7d\x9c\xfa\xb7\x6d\xd2\xdf\x43\xf6\xfe\x2e\xf9\x00\x4d\xfb\xdb\xe5\x4c\x4c\x55\x12\x6f\x57\x4a\xc6\x44\x7a\xcf\x5d\xd9\xed\x4d\x6b\xb3\x03\x48\xe3\x9d\xcb\x37\x0c\xe2\x9a\xde\x0f\x1e\xce\xa1\xe5\x84\x03\x87\x6c\x56\x7b\x5a\x26\x77\x44\x1a\x6b\xd1\xb5\x26\xc6\x2f\xec\x24\xf4\xb7\xb2\xa3\xf5\x95\xeb\xfd\xd5\xc5\x1a\x56\x6a\xb9\x42\x0b\x35\x09\x2d\x1d\xb8\x0b\x0d\xb9\x19\xa6\x1a\x16\x11\x14\xf0\x32\x7a\x41\x10\x1c\x1d\x9f\xe3\xf3\xcd\x8e\xb7\x08\xde\x38\x29\xea\xec\x21\x0c\x5e\x24\x90\x7b\x2c\x9f\xd7\x28\xf4\x3c\xb4\x50\x9b\xa5\x92\xd9\x26\x0f\x5e\x97\xa6\x69\x05\x05\x73\xdd\xf5\xab\x37\x8c\x26\xff\x53\xb1\x61\x0d\xbe\x7f\x2a\x7c\x4f\x9f\xa8\xd1\x24\xfb\x59\xe4\x1b\x2c\x91\x9c\xb4\x25\xe9\x11\x42\x55\xb8\xe1\x55\x3a\xaf\x59\xc0\x7f\xec\x00\x57\xc0\x12\x35\x5a\x25\x69\xab\xb9\xa9\xe6\xde\xe2\xe0\x18\x2a\x33\x44\x78\xb6\xe1\x78\x0c\x29\x3b\xc0\xcb\x1b\xe2\x77\xb1\xa8\xb1\xf3\x86\x44\xbf\x24\xe9\x8f\xcc\x2a\xb5\x72\x2c\x88\x5d\x69\x08\x3c\xd3\xc6\xf3\x09\xa5\x27\x91\x1c\xca\xc1\x4f\x01\x2d\x79\x6d\xdd\x75\xe4\xcd\x9f\x1c\x45\x03\x8e\x6f\x2d\x93\x57\x84\x9a\x3d\x53\x22\xab\x72\x86\x1d\xa7\xd2\x68\x1c\x91\x33\xe8\x01\x9d\x7a\xda\xea\x12\x84\x25\xe6\xb5\x37\xb4\x8b\xb1\x50\x62\x8d\x7e\xcc\x4d\x39\x92\x73\xe8\x33\x57\x0b\xe9\x43\xbc\x0e\xa2\x25\x12\x4b\x6d\xf3\x1a\xc9\x0a\xc5\x34\xec\x04\x8f\x88\x45\xba\x22\xaf\xcb\x37\xd6\x95\x60\xdf\x70\x17\x73\xe5\xb3\xda\x1f\x3d\xdd\x23\xeb\xb7\xfb\x6c\xe9\x48\xd0\x77\x5d\xdb\xe7\xcb\xcb\xed\xea\x8c\x4d\x59\x23\x0d\xe8\x13\x03\xe4\x0b\xb4\x7a\x4d\x72\xc4\x24\xd9\xbe\xab\x73\x1f\x81\x42\x66\xb7\x31\x06\xf3\x7e\xd5\x8d\xf1\x41\x12\x62\xe3\xc9\x56\x24\x7c\xd4\xcd\x2f\xd7\x76\x1c\x71\xf7\x1b\xeb\x69\x86\x04\x34\x0b\x66\xd3\x4f\xbe\xfe\x15\x65\x69\xd1\xb9\x5d\x21\xf1\x38\xe0\xcd\xf3\x62\xa4\x9e\x85\xa8\x7b\x1d\x84\xa3\x80\x82\x82\x25\x33\xd4\xd5\x9b\xc7\x28\x4d\x3b\x92\xb5\x3d\xc1\x7b\xef\x4c\x6d\x9f\xa2\xf2\xd8\xec\x8c\xe0\x77\x91\x2c\xc1\xdd\x67\x19\xc9\x25\xb1\x4d\xb4\x2c\xb9\x48\x85\x54\xc1\x89\xcb\xc8\xec\x0a\xe0\x0f\x5d\xa0\xa6\x57\xf7\xe5\x79\x47\x90\x5d\x0f\x2c\xd9\x3d\x5b\xc2\xd1\x89\xd8\x63\x36\x7d\x3d\xde\x90\x5f\x9c\x01\x69\x30\x53\xc1\xbf\x19\xe7\xc9\xc5\x9a\xc1\xcb\x0f\xb1\xb0\xe5\xe2\x92\x22\xdd\x0b\x9d\xbf\x7d\x22\x80\xbb\xe5\x2a\x7e\xe6\x19\x96\x9d\xcf\x68\xe1\x1d\x0f\xee\x4d\x3c\xc7\x87\xc2\x5a\xb1\xde\x57\xe4\xf0\x0e\x45\xb9\x3e\xb6\xd2\x81\x27\x67\xa7\xa4\x93\xaf\x71\x7e\xf4\x90\xbe\x5c\x18\x43\xc6\x7a\x33\x89\x49\xfe\xc5\xa0\x5c\x72\x2f\x2c\xcf\xbb\x89\x9d\xd4\x47\x4f\xdf\x03\xc9\xbd\x92\x1b\x80\xa5\x63\xfe\x54\xa1\xe9\xb6\xeb\x76\xe3\xec\x01\x6d\xd5\x97\x4f\x75\x99\xd3\x67\x97\x17\x83\x9c\x1c\x17\x2f\xc4\xba\x86\xbd\x69\xdf\x43\x62\x54\x0b\xe7\xaf\xad\xd0\x4e\xe5\x72\xcf\xa3\x98\xfb\x3b\xe1\x7c\x76\xb9\xb1\x27\x2e\xf8\x6e\xa9\xac\x72\x4c\x5f\x95\xe8\x0d\x88\xe8\x12\xe4\xac\x4b\x4c\x84\x2d\x10\xee\xc8\xdc\xd3\x52\x41\x97\x68\xeb\x35\x19\xcd\x7e\xd5\x98\x09\x29\x39\x1d\xce\x36\x36\x25\x51\x6e\xb4\xb9\xd3\xb3\xe8\x2b\x04\x97\x2b\xa4\x18\xac\x6e\x41\x22\x59\xd4\x85\x69\x15\x56\xa7\x92\x62\x20\xf2\x6a\xf6\x89\x59\x54\x59\xe7\x50\x0a\x8f\xec\x8e\x7f\xaa\xbe\x68\xd0\x39\xb1\x3c\x8e\xaa\xcf\x60\x15\x1a\xa1\xc1\xa2\x28\xd9\xe5\x4a\x2f\x83\xd2\x25\xfb\xe6\x7a\xd9\x5d\xc6\x88\x45\x2e\x4a\xe9\x69\x3e\xf6\xc6\xc5\x9a\x28\x8b\x4d\xeb\xd7\x9f\xac\xed\x2c\x0a\xb7\xff\xe2\x71\x4b\xc9\xc6\xe9\x9d\x96\xef\x4e\xf0\xc4\x31\xa3\x0d\x40\x25\x17\x7a\x50\x45\x46\x2f\x77\x95\x3e\x74\x64\x04\xbd\x5c\x19\xe3\xf8\x28\xd9\x8d\x34\x96\x0f\xdd\x6f\x58\x37\xed\x48\x1b\x90\x9f\x0c\xcb\x20\xac\xd0\x1e\xb1\xa4\x35\xb6\xa8\x91\x0a\xd9\x1e\x46\x11\x87\xb7\xc8\x65\x9e\xc7\xd0\xe4\x2a\x4d\xce\x4a\x8b\xa5\x1b\x3f\xb4\xb5\x92\xca\x83\xac\xc9\x84\x77\x51\x97\xa9\xe0\x5d\xa4\x9f\x34\x25\xce\xc0\x45\xb3\xce\x95\x4a\x84\x7c\xe7\xdf\x52\x10\xaa\x9a\x06\x4b\x25\x3c\x92\x47\x46\x32\xe3\x7c\x72\x9b\x7b\x75\xa5\xc8\x9b\xe5\xb2\x5b\x72\x88\xa5\x27\xb6\x37\x96\xc2\xac\x7a\x1d\x49\xde\xc1\x17\x89\xf4\xfa\xfd\xd5\x75\x76\x89\x39\x01\xca\x52\x14\x7d\xa0\x7f\x79\x25\x6a\xb7\x57\x5c\x0e\x93\x6d\x87\xc3\xb5\x8f\x68\x51\x5b\xf4\x29\xe2\xc8\x43\x9d\xed\xbc\xb6\x01\x67\xc0\xe0\xcc\xe0\xbd\x66\x25\xf0\xf3\x9b\xf3\xeb\x75\xcb\xbb\xf7\x5a\x69\xc8\xc1\x9c\xa5\x26\xc6\x37\x45\xca\xba\x17\xd2\x34\xa7\xc3\x3a\x49\xa1\xd7\x50\x74\x6f\x17\xb4\x71\xaa\x54\xe5\x60\xa5\xcb\x1c\xd1\x31\x59\xe3\x5c\xa7\xe8\x53\x9c\xf1\xec\x56\xa8\x9a\xb4\xc2\x0c\x16\x81\x18\x59\x0a\x0a\x69\x85\x5d\x28\x6f\x85\x5d\x0f\x4c\x5c\x4e\x53\x04\x87\x55\xa8\xe1\x2b\x87\x08\x5c\xc7\x9c\x6b\x9a\xfb\xa9\x5f\xc7\xbb\x45\xb1\x50\x35\xf1\x81\x37\x50\xa2\x34\xba\xaa\x95\x64\x35\xab\x9a\xd6\x58\x2f\xf6\x97\x98\x3d\xc8\x1f\x89\xe0\xfc\x02\xee\x48\xba\xd1\x7b\xbd\x4f\x19\x8f\x6f\xc9\x5f\x8d\x66\x8f\x22\xc5\x14\xf9\xf3\xdd\x44\x97\x1b\xb2\x18\x7d\x74\x1f\xcb\xed\x6b\x12\xf7\x45\x8d\x0d\x97\x35\x6b\xa9\xea\x6c\x9a\x3a\x9f\x30\x5f\x67\xf7\x77\x40\x8d\x89\xd7\xd8\x0b\x52\x79\xf9\xa2\x28\x28\x36\x52\xac\x50\x6b\xb3\x5c\x72\xe2\x4a\x97\xc9\x4c\xc4\x00\xb5\x8d\x9a\xff\xaf\x7a\xa8\xee\x92\x65\x4d\x1a\x8f\x00\x67\x9d\x9c\xd4\xef\x2d\x02\x5a\xcb\x65\x8f\x84\xc1\xb0\x9a\x11\x2a\x21\x31\x8f\x5b\x24\x65\x85\xd2\xc7\x20\x63\x81\x50\xa9\x0f\xa4\x69\x83\x37\xe4\xd0\x4b\x0e\x08\x0d\xc5\xec\x6c\x74\xbf\x62\x46\xcd\x6e\x92\x09\x5e\x2c\x91\x38\x8c\xf8\x35\xdf\x8e\x25\x53\x96\x72\x75\xce\x34\xe8\x57\x84\x16\x81\x1e\x74\x29\x1a\xd4\x9e\x97\xbd\xb3\x26\x47\xce\x03\xd2\x9d\xc4\x5b\x1f\xe8\x2d\xcc\xa0\x00\x74\x70\xab\x14\xb1\x99\xa5\x5c\x82\xf0\xd0\x08\x1d\x44\x1d\xa3\xf2\x94\xc9\x1d\xa6\x3d\xba\x3c\x51\x54\x3c\xf9\x20\x13\x99\xee\xb2\x9f\xa2\x74\xbc\xab\x93\xa6\x59\xf0\x0f\x2b\x48\xda\x4c\x45\x14\x26\xeb\xec\x32\x7f\x10\x8c\xb3\x2c\xde\x1d\x31\x83\x76\xa1\x25\x79\xea\x13\x69\x43\x58\x13\x4e\x31\x1b\xe6\x14\x1d\xfc\xe0\x60\x94\x77\x58\x57\xb0\x40\xf6\x8d\xac\xca\xe4\x6f\x94\xeb\x2a\x7c\x4b\xe6\x83\x67\x7a\x9d\x8e\x9a\x98\x74\x78\xd2\x46\xca\x60\xa1\x0c\x36\x33\x64\xc7\xa0\x1d\x01\x5f\x0f\xad\xcc\x82\xc3\x97\x98\x83\x62\x96\xef\x6e\xf1\x32\x27\xa7\x24\x9e\xd0\xe5\x69\xe2\xd1\x58\x99\x31\xc6\xed\xc4\x11\x37\xb4\xc1\xef\x28\xc3\x38\x7c\x91\xff\x6e\x8f\x13\xb2\x53\x68\x93\x09\xfd\x45\x64\xd6\x05\x29\x95\x96\x39\x71\x3c\x12\xd7\x1c\x6f\x32\xc3\xb5\x16\x63\x56\x78\x92\xd3\x49\x4e\x7f\x7d\x72\xba\x99\x82\x3b\x1c\xf1\x6f\x96\xa3\xec\x0b\xfb\x8f\xae\x34\xb9\x2f\xf6\xa7\xd0\xe3\x7d\xfc\xf5\xca\x01\xb0\xbe\xeb\x67\x76\x77\x22\xe8\xfa\x60\x92\x7f\x10\xb7\x11\xf9\x6f\x46\x36\x58\x6e\x43\x77\x38\x9a\xbc\x87\xba\xe9\x07\x68\x07\x40\x7f\x13\x67\x45\x15\x95\x7e\xdb\x63\xd2\xf1\x0e\x2f\x58\xf8\xd7\x70\xaa\x02\xe5\x01\x3f\x28\xe7\x77\x64\xc2\xa6\xaa\x9d\xa9\x6a\x67\xaa\xda\x99\xaa\x76\xa6\xaa\x9d\xe3\x71\x99\xaa\x76\xa6\xaa\x9d\x43\xf4\xf9\x82\xab\x76\xf2\xa5\xe7\x9f\x51\xa7\x4b\x87\x03\xee\xc8\xdb\xad\x17\xb2\x7f\x97\x7e\xd0\xbd\xec\x1f\x74\x37\xaa\x5b\xbe\xf6\x7e\x4f\x4a\x69\xff\xc7\x6f\xf6\x60\x40\xb1\xc3\x12\xed\x66\x79\x0c\xf9\x6e\x87\x2a\x63\xd8\xbf\xeb\x7f\x48\x3d\xca\xa7\x76\xce\x5f\x77\x95\x28\x53\x82\xb5\x80\x97\xc5\xb2\xc8\x35\xf8\x33\x48\x2d\x16\x66\xc3\xfe\x0b\x33\xe8\x1b\x2e\xfc\x8c\x57\xfd\xd9\xb8\x8e\x41\x1f\xb8\x60\x83\x7b\x7f\x1b\xc1\xec\xaa\xd1\xb7\xfc\xc4\x64\x66\x37\x6a\x01\x04\x7f\xe3\x70\x6a\x11\x16\x0b\x0e\x94\x43\x9b\x2f\x79\xba\x7b\x66\x76\x34\xc7\x51\x48\x01\x17\x9c\x54\x8c\x5e\x16\x85\xa4\xa9\xac\x20\x86\x9e\x29\x5d\x49\x11\x30\xea\x72\x1e\x1c\x5a\x78\xff\x9f\xd1\x59\xe5\xdf\x2b\xa7\x63\xf9\x08\xf2\xed\x64\xe9\xad\xc1\xc8\x7e\xe7\xe0\x6d\x88\x9e\xb9\xf3\xc6\x8a\x25\x9e\x43\x25\xea\xd4\xef\xc2\x85\x45\x97\x90\xcd\x67\x91\x92\xda\xf0\x8f\x7f\x4e\xbd\x41\xa6\xde\x20\x43\x28\x1e\xd8\x1b\x24\x6a\xb6\xa9\x37\xc8\xd4\x1b\x64\xea\x0d\x32\xf5\x06\xd9\xb1\xfd\xd4\x1b\x64\xea\x0d\x32\xf5\x06\x99\x72\x6e\x53\xce\x6d\xea\x0d\x32\xf5\x06\xd9\x83\xd7\xd4\x1b\x64\x1b\xcd\x5f\x6f\x8e\xee\x48\xba\x4d\xbd\x41\xf6\xa0\xfb\x85\xf6\x06\xf9\x66\xea\x0d\x32\xf5\x06\x39\x1e\x9b\xa9\x37\xc8\x36\x9e\x53\x6f\x90\x8d\xcf\x54\x65\x30\x45\x3c\xbf\x9a\x88\x67\xaa\x32\x38\x8c\xdc\x67\x11\xd9\x4c\x55\x06\x53\x95\xc1\x6f\xbb\xca\x60\xea\x0d\x32\xf5\x06\x99\x7a\x83\x4c\xbd\x41\xa6\xde\x20\x53\x6f\x90\xa9\x37\xc8\x3d\x87\x37\xf5\x06\x99\x7a\x83\x7c\x3c\x80\x53\x6f\x90\xa9\x37\xc8\xf0\x33\xf5\x06\x99\x7a\x83\x4c\xbd\x41\xa6\xde\x20\x53\x6f\x90\xa9\x37\xc8\xd4\x1b\x64\xea\x0d\x32\xf5\x1c\x98\x7a\x0e\x7c\x31\x3d\x07\xa6\xde\x20\x93\x9c\x4e\x72\xfa\xf9\xcb\xe9\xd4\x1b\x64\xea\x0d\xb2\x1b\xaa\xa9\x6a\x67\xaa\xda\x99\xaa\x76\xa6\xaa\x9d\xad\xcf\x54\xb5\x33\x55\xed\x4c\x55\x3b\x5f\x4c\xd5\xce\xd4\x1b\x64\xea\x0d\xf2\x5b\xec\x0d\xd2\x8f\xdc\xdf\x1a\xa4\x4f\x6f\xc7\xab\x25\x2c\x59\xcf\xc7\x99\x6c\xc8\xe1\x71\x0c\xa9\xda\x3a\x58\x51\xa7\xaf\x83\x5b\x49\xf8\xe1\xc7\x47\x90\x7e\x1f\x90\x0e\x33\x0e\xfe\x5f\x00\x00\x00\xff\xff\x13\x3c\xcc\x5f\x55\x8a\x00\x00")
+var _clusterApiClusterXK8sIo_machinesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x6b\x6f\x1c\x39\x72\xdf\xe7\x57\x14\x7c\x1f\xb4\x0b\x68\x5a\xb2\x6f\xef\x81\x01\xf2\xc1\x91\xec\x8b\x72\x6b\x5b\x90\xe4\x4d\x80\xbd\x05\x8e\xd3\x5d\x3d\xc3\x13\x9b\xec\xe5\x43\xd2\xe4\x70\x40\xfe\x46\xfe\x5e\x7e\x49\x50\x7c\xf4\x63\xa6\x47\x23\xc9\xd9\xcd\x5e\x8e\x96\x01\x69\x9a\x6c\xb2\xaa\x58\x6f\xd6\x14\x6b\xf9\x77\xa8\x0d\x57\x72\x01\xac\xe5\xf8\x60\x51\xd2\x27\x53\xdc\xfe\xde\x14\x5c\x9d\xdc\xbd\x9e\xdd\x72\x59\x2d\xe0\xcc\x19\xab\x9a\x2b\x34\xca\xe9\x12\xcf\xb1\xe6\x92\x5b\xae\xe4\xac\x41\xcb\x2a\x66\xd9\x62\x06\xc0\xa4\x54\x96\xd1\x63\x43\x1f\x01\x4a\x25\xad\x56\x42\xa0\x9e\xaf\x50\x16\xb7\x6e\x89\x4b\xc7\x45\x85\xda\x2f\x9e\xb6\xbe\x3b\x2d\xbe\x29\x5e\xcf\x4f\x8b\x37\xa7\x6f\x4e\x5f\x9f\x9e\xbe\x39\x3d\x3d\xfd\xdd\x9b\xd3\xf9\x6f\x7e\xf7\xe6\x37\xa7\x8c\x95\xaf\x7f\x57\xff\x76\x06\x50\x6a\xf4\x8b\xdf\xf0\x06\x8d\x65\x4d\xbb\x00\xe9\x84\x98\x01\x48\xd6\xe0\x02\x1a\x56\xae\xb9\x44\x53\x94\xc2\x19\x8b\xba\x78\x98\x07\x34\x66\xa6\xc5\x92\x20\x5a\x69\xe5\xda\x05\xec\x8c\x87\x15\x12\xd0\xcc\xe2\x4a\x69\x9e\x3e\xcf\xd3\xfc\x39\x6b\xb9\x9f\x11\x48\xf2\x21\x6c\xe7\x9f\x08\x6e\xec\x1f\x87\x4f\xbf\xe5\xc6\xfa\x91\x56\x38\xcd\x44\x0f\x9c\x7f\x68\xd6\x4a\xdb\x8f\xfd\x96\x73\x68\x58\x18\xe0\x72\xe5\x04\xd3\xdd\xfc\x19\x80\x29\x55\x8b\x0b\xf0\xd3\x5b\x56\x62\x35\x03\x88\xa4\xf3\x10\xce\x81\x55\x95\x3f\x0c\x26\x2e\x35\x97\x16\xf5\x99\x12\xae\x49\x87\x30\x87\x0a\x4d\xa9\x79\x4b\x53\x16\x70\xa9\xd5\x1d\xaf\x50\xc3\xc5\xb9\xdf\x12\xe0\x2f\x46\xc9\x4b\x66\xd7\x0b\x28\x88\x50\x45\x1b\x67\x74\x13\x88\x38\xfd\x8b\xdd\x63\xbb\x21\xb8\x8c\xd5\x5c\xae\xa6\x76\x8a\xa4\x00\x63\x99\x75\x06\x8c\x2b\xd7\xc0\x0c\xdc\xa0\x6e\xb8\x64\x96\xcb\xd5\xc9\x25\xca\x8a\x7e\x5f\x39\x29\xe9\xf7\x7b\xc6\x05\x56\x80\xb6\x9c\x80\xcd\x2f\x53\xb4\x6b\x66\x70\x0c\xd8\xe0\xc9\x21\x98\xfe\xe8\x96\xa8\x25\x5a\x34\x89\x86\xc0\x8c\x51\x25\x67\x16\x2b\xb8\xe7\x76\x0d\x76\xcd\x4d\x82\x7d\x02\x0a\xa2\x50\x7c\x35\x8e\x06\xf2\x7c\x37\x7a\x76\x08\x8e\x8f\xaa\x42\x0f\xfe\xf4\xf6\xcd\xde\xed\x03\x11\xa4\xaa\xf0\x0a\xeb\x82\x56\x88\x93\xe8\xcf\xb0\xee\xc7\xfe\x61\xab\xb9\xd2\xdc\x6e\x16\xf0\x7a\x1f\x60\xe1\xbd\xbb\xd7\x4c\xb4\x6b\xf6\x6b\x3f\xcb\x94\x6b\x6c\xbc\x44\xd3\x27\xd5\xa2\x7c\x7b\x79\xf1\xdd\xaf\xaf\x47\x8f\x61\xfa\xb0\xb9\x01\xbb\x46\x08\x73\xa1\x56\xda\x7f\x8c\xf8\x18\x78\x7b\x79\xd1\xbd\xdf\x6a\xd5\xa2\xb6\x9d\xa0\x85\xff\x03\x9d\x34\x78\xba\xb5\xdb\x11\x01\x14\xcf\xaf\x22\x65\x84\x61\xdb\x78\x30\x58\x45\x1c\x40\xd5\x81\xa0\x1a\x5b\x8d\x06\x65\x50\x4f\xf4\x98\x49\x50\xcb\xbf\x60\x69\x0b\xb8\x46\x4d\x2f\x82\x59\x2b\x27\x2a\xd2\x5a\x77\xa8\x2d\x68\x2c\xd5\x4a\xf2\xff\xe8\x56\x33\x60\x95\xdf\x46\x30\x8b\xc6\x82\x97\x35\xc9\x04\xdc\x31\xe1\xf0\x18\x98\xac\xa0\x61\x1b\xd0\x48\xeb\x82\x93\x83\x15\xfc\x14\x53\xc0\x07\xa5\x11\xb8\xac\xd5\x02\xd6\xd6\xb6\x66\x71\x72\xb2\xe2\x36\xe9\xdb\x52\x35\x8d\x93\xdc\x6e\x4e\xbc\xea\xe4\x4b\x67\x95\x36\x27\x15\xde\xa1\x38\x31\x7c\x35\x67\xba\x5c\x73\x8b\xa5\x75\x1a\x4f\x58\xcb\xe7\x1e\x58\x49\x48\x99\xa2\xa9\x7e\xa5\xa3\x86\x36\x47\x23\xe2\xed\x9c\x7a\xf8\xef\x15\xd9\x23\x54\x26\x95\x06\xdc\x00\x8b\xaf\x06\x44\x7b\x62\xd2\x23\xa2\xc7\xd5\xbb\xeb\x1b\x48\x5b\x07\x82\x07\xda\xf6\x53\x4d\x4f\x66\x22\x11\x97\x35\x12\x6b\x70\x03\xb5\x56\x8d\xa7\x2a\xca\xaa\x55\x5c\x5a\xff\xa1\x14\x1c\xa5\x05\xe3\x96\x0d\xb7\x74\x7e\x3f\x3a\x34\x96\x4e\xa0\x80\x33\x6f\x68\x60\x89\xe0\xda\x8a\x04\xb7\x80\x0b\x09\x67\xac\x41\x71\xc6\x0c\xfe\xe4\x44\x26\x6a\x9a\x39\x11\xef\x69\x64\x1e\xda\xc8\xfe\x1f\xad\xb2\x88\x74\x1a\x0c\x24\x5b\xb5\xe7\x4c\xa2\x9c\x5d\xb7\x58\x8e\x38\xbf\x42\xc3\x35\x71\xaa\x65\x16\x89\xbf\xe3\xc4\xd1\x4a\xd3\x12\x47\x3f\x4b\xa5\xac\xb1\x9a\xb5\xdb\x03\x5b\xdb\xff\x73\x9a\x17\xd8\x42\x63\x8d\x1a\x25\x1d\xba\x02\x06\x42\x95\x4c\x10\xfe\xae\xb4\x70\xbf\xe6\xe5\x1a\x50\x96\xac\x35\xce\x4b\x0c\xd4\x1c\x45\x45\x67\x48\x32\x56\xf3\x95\xd3\xe8\x0f\x3b\xc2\xfa\xdf\xff\xf9\x5f\xa6\x87\xa4\xe5\x72\x05\x0d\x96\x6b\x26\xb9\x69\x8a\x1d\xb8\xf6\x23\x13\x5d\x8f\x9a\xaf\xae\xb0\x9e\x1a\xdc\x42\xea\x2c\xcd\x9d\x44\xaa\x03\x08\x92\x61\x9c\xd3\x19\xf1\x9a\x97\x43\x9e\x67\x16\xd6\x8a\xb0\x4b\xa8\x05\x4d\x53\xa1\x65\x5c\x98\x02\x6e\xd6\x38\x58\x99\x1b\x50\x7e\x77\x26\x88\x1c\x4c\x08\x75\x0f\xce\xa0\x36\x27\x84\x15\x23\x8e\xa4\x81\xb0\xd3\xa6\xa7\x7b\x71\xce\x2c\xf3\x86\x4a\xb9\x20\x29\x12\xb1\xa2\x03\x67\x03\x77\x6b\x97\x5a\x87\x29\xf6\x98\xf2\xdd\x4b\xba\xb7\x97\x17\x9d\x21\xf5\xba\xb6\x43\xd2\x4e\xc3\xf0\x88\x98\x0c\x7f\x3c\xa7\x78\x9b\xf7\x34\x38\x8e\x2e\xea\xb0\x31\xad\x49\x84\x63\xd0\x72\x2c\x71\xa4\xe9\x81\x4b\x63\x91\x55\xf1\x21\x49\xb4\xc6\x38\x76\x1c\x94\x51\xd4\x73\xbd\x25\xb0\x8c\x4b\x60\xa4\xf8\x78\x05\xff\x7a\xfd\xe9\xe3\xc9\x1f\x54\xe0\x63\x60\x65\x89\x86\x5e\x61\x16\x1b\x94\xf6\xb8\x73\x71\xa2\x30\x5e\x93\x2c\x16\x0d\x93\xbc\x46\x63\x8b\xb8\x1a\x6a\xf3\xfd\x9b\x1f\x0a\x78\xaf\x34\xe0\x03\x6b\x5a\x81\xc7\xc0\x03\xed\x3a\xad\x39\xe0\x13\x8f\x4c\xf7\xae\x3f\x7a\x0f\x52\xab\xaa\x08\xf4\xbd\x07\xd6\xb2\x5b\x04\x15\x81\x75\x08\x82\xdf\xe2\x02\x5e\x11\x07\x0d\xb6\xfe\x2b\xd9\xfb\xbf\xbd\x82\xaf\xee\xd7\xa8\x11\x5e\xd1\xc7\x57\x61\xc3\xce\xbc\xd1\xb3\x74\x9a\xfd\xc6\x9e\xc5\xad\xe6\xab\x15\x92\xa2\xa1\x41\xbc\x43\x69\xbf\x06\xa5\x09\x7e\xa9\x06\x93\xfd\x12\xdc\x44\xfe\xe5\x58\xed\x00\xf2\xfd\x9b\x1f\x5e\xc1\x57\xfd\x1b\x84\x17\x70\x59\xe1\x03\xbc\x01\x2e\x03\x66\xad\xaa\xbe\x26\xd1\xa1\x95\x36\xd2\xb2\x07\x92\xd0\x72\xad\x0c\x4a\x50\x52\x6c\x08\xe2\x35\xbb\x43\x30\xaa\x41\xb8\x47\x21\xe6\x41\x29\x56\x70\xcf\x36\x84\x43\x22\x25\xb1\x05\x83\x96\x69\x3b\x62\x89\x02\x6e\x3e\x9d\x7f\x5a\x84\xdd\xe8\xd8\x56\x92\xb6\x20\xf3\x52\x73\x32\xed\x64\xd3\xfd\x60\x38\x73\x02\xc4\xf9\x37\x69\x6b\xd2\x4d\x2b\x32\xe7\x9e\x6c\xb5\x23\xab\x5c\x1c\xcd\x26\x98\xf5\xa9\x7c\xbf\x6b\x91\xf7\xb3\xbc\xb7\xce\xdb\x42\xf7\x7f\x66\xfb\x9e\x89\x28\x31\xc8\x53\x11\xfd\x38\xe0\xc7\x47\x11\xbd\xed\xbc\x7b\xb2\xf3\x95\x2a\x0d\xa1\x59\x62\x6b\xcd\x89\xba\x43\x7d\xc7\xf1\xfe\xe4\x5e\xe9\x5b\x2e\x57\x73\x62\xb8\x79\x10\x38\x73\x42\xe0\x98\x93\x5f\xf9\x5f\x5f\x8c\x97\x0f\xd3\x9e\x83\x9c\x7f\xe1\xe7\xc0\x90\xf6\x31\x27\x5f\x84\x60\x32\x79\xcf\xb3\x13\x47\xd7\xdb\x26\x33\xbe\x4f\x62\x14\x3c\x85\xe8\xa7\x0f\x34\x5f\xc3\xaa\xa0\x1a\x99\xdc\xfc\xe4\x8c\x4d\x64\x74\x9a\xf6\xde\xcc\xa3\x21\x9d\x33\x59\xd1\xdf\x86\x1b\x4b\xcf\xbf\x88\x6e\x8e\x3f\x59\xb0\x3f\x5f\x9c\xff\x3c\xec\xee\xf8\x17\x49\xf1\x1e\x0f\x36\xfd\xec\xba\xbc\x93\xe8\xbe\xf2\x1e\x4d\x34\x04\xc1\x99\xed\x5d\x2e\x5a\xa3\xb7\xab\xa5\x50\xae\x9a\x53\xea\x29\xf9\x55\x10\x16\x32\x05\x5c\xd4\x20\xb9\x38\x1e\xfa\x93\x29\xa0\xd3\xd8\x90\x15\x8f\x6a\x3a\xe6\x1c\x82\xdd\x2e\xe0\x4f\x12\xce\x29\x48\x29\x29\x90\x58\x04\x5b\x13\x74\xfd\x9a\x19\x58\x22\x92\x0f\x97\xc6\x49\xd5\xa7\x50\xd9\x87\x7b\xf7\x5c\x08\x0a\x44\x34\x36\xea\x2e\x8c\xb3\x68\x08\x92\x5f\x54\xc0\xf5\x3d\xb7\xe5\x9a\x38\x9d\x50\xbd\xc6\x52\xa3\xcf\xfe\x14\xaf\x66\x2f\xa0\x7b\x35\x5a\xe3\x09\x04\x1e\x6f\x9a\x22\xf4\xa1\x91\x37\x1e\xa4\x60\xe1\x8d\x55\x1a\xa7\x8e\x21\x92\xfa\xc5\x94\x7e\x3e\xae\x8f\xf0\x57\x4c\xc9\x4d\x53\x60\x84\xfd\x59\x3f\x73\x0a\xf5\x38\x3c\x0a\x5a\x97\x28\x94\x5c\x91\x3b\xb4\xeb\xc3\x36\x5c\x7e\x8b\x72\x65\xd7\x7d\x4a\xe5\x49\xd8\xd4\x8c\x0b\xa7\xf1\x5c\x11\x2b\x1e\x00\xf9\xfd\x70\x6e\x02\x3a\x2e\x00\x55\x78\x3a\xc8\xaa\x74\x4c\xe8\x13\xa4\x9e\x09\x0b\xf8\xe0\x8c\x85\x86\x11\xdf\x31\xb8\xc5\x4d\x3a\x93\xd1\xda\x06\x1a\xd6\x86\x13\xaf\xc8\x75\xa4\x45\x23\x65\x23\x2d\x8a\xe7\xe0\xc8\x65\xad\x59\x88\xfe\x9c\xc6\xc9\xd8\x6b\x84\xe7\xc5\xf6\xfc\x14\x7f\xfd\xe8\x7c\x2c\xdb\x1b\x83\xe0\x03\xfb\x1c\x74\x1f\x73\xa9\x9a\x86\x2b\x58\x6e\xc8\x9b\x1f\x6f\xde\xc5\x6a\xc5\xec\x79\x51\xd0\xa1\x08\xe8\xcb\xa2\x9f\x47\x88\xf7\x84\xa8\x27\x47\x3c\x39\xe2\xf9\xfb\x89\x78\x0e\xf0\xfa\xfe\x48\xe7\xef\x21\xca\x39\x80\xdc\xfe\xe8\xe6\x17\x18\xd9\x3c\x01\x97\x47\x22\x9a\x5f\x68\x34\x73\x00\xa9\xad\x28\x64\x31\xfb\x47\x8e\x60\x0e\xd0\x6a\x6f\xe4\xf2\x8b\x8b\x5a\x5e\xea\x4d\xd2\x75\xda\xb9\x66\xdc\x5f\x2d\x2b\x67\x17\xb3\xc7\x51\xfd\xb8\x35\x3f\xf9\x68\x56\x59\x4a\x19\x35\xca\x49\x9f\x66\xb2\xbc\x89\xa9\xe1\x64\x58\xc2\x6d\x78\xf0\xd8\x4c\x8b\xa4\xda\x24\x54\xb4\x75\x50\xd7\x04\x09\xa9\x7f\x4a\xe8\xd7\xcc\x09\x1b\x4d\x1b\x37\x70\x7a\x0c\x0d\x32\xba\x26\xed\x97\xa4\xe9\x50\x32\x49\xde\x9f\x5f\x25\xde\x22\x52\x6e\x98\xc9\x4d\x00\x40\xf0\x86\xc7\x6b\xf9\x02\x3e\x7e\xba\x79\xb7\x80\x29\x04\x2a\xee\xfd\x29\x69\xc3\x8d\xcc\x9f\xe9\x9c\x4a\x2b\x02\x74\x30\x9f\xd3\x5a\xca\xd9\x3f\x1f\xcd\x9e\x41\xf6\xe4\x86\x5d\x9c\x1f\xa0\x69\x7f\xbb\x9c\x88\xc9\x2b\xe2\xed\x9a\x97\x1e\x74\xe8\xb9\x2b\xb9\xbd\x71\x6d\xef\x00\xda\x75\xf7\x59\x47\xf3\xe9\x9d\x29\x68\x7a\x3f\x78\x38\x87\x96\x63\x06\x0c\x85\x77\xd1\xf1\xf5\xb4\x8c\xee\x48\xa9\xb4\x46\xd3\xaa\x10\x29\x5a\x35\xba\x95\x1d\xad\xcf\x4d\xef\xaf\x2e\x37\xb0\xe6\xab\x35\x6a\x10\x24\xb4\xe4\x1c\x18\xd7\x50\x62\x55\xd5\xc3\x22\x82\x02\xde\x85\xbc\x2f\x65\xfc\xa1\x64\xc6\x3b\x0e\xc9\xf1\x66\xce\x2a\x53\x32\x91\x3c\x84\xc1\x8b\x14\x03\xf7\x58\x9e\x09\x64\x72\xee\x5a\x10\x6a\xc5\xcb\x64\x93\x07\xaf\x97\xaa\x69\x99\xc6\x0e\x70\xef\x59\x11\x9a\xfe\x8f\xda\xa7\x0f\x9d\xed\x47\x99\xed\xe9\x13\x34\x5a\xe9\x9d\x42\xf2\x0d\x56\x68\x41\xe3\x8a\x32\x21\x84\x2a\x33\xc3\xab\x74\xbf\x66\x01\xff\x36\x01\x2e\x83\x15\x4a\xd4\xbc\x04\xe5\xec\x5c\xd5\x73\xab\x71\x70\x0c\xb5\x1a\x22\x7c\xbc\xe5\x78\x0c\x29\x3b\xc0\xcb\x2a\xe2\x77\xb6\x14\xd8\x79\x43\xac\x5f\x92\xf4\x47\x62\x15\xaa\xcb\xa0\xbf\x13\x8a\x05\xbc\x95\xca\xfa\x13\x8a\x23\x1e\x74\xda\xeb\x47\x87\x9a\xf2\xd4\xdd\x75\xe4\xed\xef\x0d\x15\xc5\x18\x7f\x6b\x19\xbd\x22\x24\x67\x33\x90\x95\x1b\xe5\x53\xc5\x95\x92\x38\x22\xa7\x93\x03\x3a\xf5\xb4\x95\x15\x30\x4d\xcc\xab\x6f\x69\x17\xa5\xa1\x42\x81\x76\xcc\x4d\x29\x92\x33\x68\x13\x57\xb3\xd2\xba\x70\x1d\x44\x20\x44\x96\xda\xe5\x35\x92\x15\x8a\x69\x7c\xda\x7f\x44\x2c\xd2\x15\x69\x5d\x7f\x63\x5d\xb3\x92\xb8\x7a\x8a\xb9\xd2\x59\xed\x8f\x9e\x1e\x91\xf5\x18\x09\x1d\x10\xf4\xa9\x6b\xfb\x18\x3d\x4c\x54\x67\x6c\xcb\x1a\x69\x40\xef\x88\x2e\xb1\xbb\x40\x13\x1b\x92\x23\x4f\x92\x3e\x63\x91\x30\x30\xcf\x40\x21\xb1\xdb\x18\x83\x79\x9f\x07\xd9\x7a\x1e\x89\x37\xa8\xb7\x48\x23\x3b\x91\xf0\xec\x09\x96\x88\xc2\x2a\xb7\x15\x94\x8e\x48\x17\x93\x2d\x14\x64\x39\x33\x22\xa0\x5a\x7a\x36\x7d\xf1\xf5\x2f\xab\x2a\x8d\xc6\xa0\x39\x70\x78\x6f\xd3\x3c\x3a\x0b\xd6\x09\x51\xf7\x3a\x55\xb3\xf0\x15\x5d\xbf\xc4\xc0\x2a\xf2\xff\xf6\x31\x96\xaa\x1d\xc9\xda\x9e\xe0\xbd\x77\xa6\x76\x4f\x91\x5b\x6c\x26\xc0\x9d\x26\x59\x84\x3b\x85\x6b\xc6\x7b\x61\xba\x09\x96\x25\x15\xa9\x90\x2a\x38\x32\x09\x99\xdd\x1d\x0f\xa5\x0e\x3a\x32\x4e\x0f\x6e\x41\x76\x33\xb0\x64\x8f\x6c\xf9\x28\xc3\xa6\x1f\x3f\xe1\x29\x9b\x7e\x18\x6f\xe8\x39\xf1\x18\x48\x83\xa9\x1a\xfe\x45\x19\x4b\x2e\xd6\x31\xbc\x7b\x08\x85\x2d\x17\x97\x74\xb7\x77\x11\xcb\x5c\x2e\x2e\x5f\x08\xe0\xb4\x5c\x25\x59\x89\xb0\x4c\x8e\xd1\xc2\x13\x03\x8f\xb8\x72\x69\x90\x69\xcd\x36\x5b\x63\x9d\x1c\x5f\x21\xab\x36\x8b\xd9\xa3\xa4\xea\x6e\xdc\xfd\xe4\xe4\x94\x74\xf2\x35\xce\x8f\x26\x86\xdd\x25\x50\x80\x66\xa9\x14\x19\xeb\xad\xd1\x92\xfc\x8b\x41\xb9\xe4\x5e\x58\xce\xba\x89\x9d\xd4\x07\x4f\xdf\x02\xc9\x3d\x2f\xb7\x00\x8b\xc7\xfc\x52\xa1\xe9\xb6\xeb\x76\xf3\xd9\x03\xda\xaa\x2f\x9f\xea\x32\xa7\x54\x02\x90\x42\x22\x20\xf9\x60\xb1\xae\x61\x6f\xda\xf7\x90\x18\x09\x66\xec\x8d\x66\xd2\x78\x20\xc8\x27\x9f\x9e\xb7\x05\xf6\xb7\xcc\xd8\xe4\x72\x7b\x6f\x3b\x22\x61\xbb\xa5\x92\xca\x51\x7d\x55\x22\xe5\x9a\x82\x4b\x10\x75\x54\x4c\x84\x2d\x11\xee\xd7\x18\x5c\x2a\x27\x2b\xd4\x62\x43\x46\xb3\x5f\x35\xdc\xfd\x56\x3e\x1d\xee\xfd\xf1\x98\x44\xb9\x95\xea\x5e\x92\x23\x83\x12\x9c\x49\x15\x52\x1e\xac\x6e\x41\x22\x59\x70\x4f\xe3\x2a\xc4\x5e\x54\x4e\xd0\x5a\xf2\x6a\xa6\x68\x46\x3f\x41\x65\x2d\x28\x1b\x8f\xde\x1d\x7f\x99\x38\x52\x41\x92\x31\x6c\xf5\x34\xaa\xbe\x85\xb5\x6b\x98\x04\x8d\xac\x22\xe0\xd2\xcb\x74\x57\xef\x7d\x73\xb9\xea\x2e\x63\xd8\x32\x15\xa5\xf4\x34\x1f\xa9\xfe\x86\x6d\xc8\x0d\xc1\xa6\xb5\x9b\xe2\xa5\xd0\x6b\x64\x46\xc9\x27\x01\x4f\x4a\x36\x4c\xef\xb4\x7c\x77\x82\x47\xc6\x33\xda\x00\x54\xe0\xa3\x2a\x32\x7a\x39\x66\xf3\x4a\x9f\xea\x25\xe8\xcb\xb5\x52\xc6\xf3\x06\xf1\x0c\xa9\x47\xf2\x8e\xc7\x6e\x2b\x39\x69\xa4\x0d\xc8\x4f\x86\x95\x63\x9a\x49\x4b\x45\x3a\x6f\x2f\x2f\x76\xa8\x11\x0b\xd9\xbe\x8c\x22\x06\xef\xd0\x97\x79\x3e\x85\x26\xd7\x71\x72\x52\x5a\xe4\x58\x02\x3e\xb4\x82\x97\xdc\x42\x29\xc8\x84\x77\x51\x97\xaa\xe1\x2a\xd0\xaf\x54\x94\xca\x30\xc1\xac\xfb\x4a\x25\x42\x3e\x5a\x30\xe3\x83\x50\xde\x34\x58\x51\x29\x2b\x79\x64\x24\x33\xc6\x46\xb7\xb9\x57\x57\x9c\xbc\x59\xa2\x35\x8d\xb0\xd2\x52\x4e\x59\x69\x0a\xb3\xc4\x86\x88\x83\x3d\x7c\x41\x42\x3e\x7c\xbe\xbe\x49\x2e\xb1\x4f\x80\x7a\x29\x0a\x3e\xd0\x3f\xbd\x67\xc2\xe0\xcb\xc9\x36\xe1\x70\xed\x23\x9a\x9f\x9a\xf4\x6b\xc7\x43\x9d\xed\xbc\xd1\x54\x00\xea\xc1\x39\x86\xcf\xd2\x2b\x81\x9f\xde\x9c\xdf\x6c\x5a\xbf\x7b\x07\xcf\x88\x83\xe9\x7c\x38\xb9\x37\xaa\x88\x59\xf7\xa2\x54\xcd\xc9\x80\xc3\x3f\x50\x8e\xa0\xe8\xde\x2e\x08\xb2\x90\x70\x30\x3e\x58\xe9\x32\x47\x74\x4c\x5a\x19\xd3\x29\xfa\x18\x67\xbc\xbd\x63\x5c\x90\x56\x38\x86\xa5\x23\x46\x2e\x19\x85\xb4\x4c\x2f\xb9\xd5\x4c\x6f\x7a\x4a\x99\x94\xa6\x70\x06\x6b\x27\xe0\x2b\x83\x08\x05\xb9\x5c\xa9\xa6\xb9\x9f\xfa\xb5\x57\x9f\xc0\x96\x5c\x10\x1f\x58\x05\x15\x52\x55\x9d\xe0\x74\xa3\x61\x80\x37\xad\xd2\x96\x49\xfb\x42\x0a\x3f\xee\x8f\x04\x70\x7e\x06\x77\x24\xde\xe8\x7d\xd8\xa7\x8c\x47\x47\xfd\xea\xfd\x68\x76\x17\xd1\x91\x58\xc4\xc8\xdf\x57\x63\x75\xb9\x21\x4a\x35\x92\x8f\x6e\x43\xb9\xbd\x20\x71\x5f\x0a\x6c\x7c\x59\xb3\x2c\xb9\x48\xa6\xa9\xf3\x09\xd3\x75\x76\x74\x92\x81\x41\x43\xd9\xbb\x3b\xd4\x4b\x52\x79\xe9\xa2\xc8\x71\x6f\xa4\xbc\x42\x15\x6a\xb5\xa2\x75\x48\x98\x83\x99\x20\x96\x71\x8d\x07\xda\xdf\xad\x0f\xd4\x5d\xb4\xac\x51\xe3\x11\xe0\xb4\x44\x52\xbf\x77\x08\xa8\x35\xc5\xb9\x1e\x83\x61\x35\x23\xd4\x94\xe8\x8d\xcf\x35\x92\xb2\xc2\x92\x0a\xe6\x43\x1c\x58\xf3\x07\xd2\xb4\xce\x2a\x72\xe8\x4b\x26\xc4\x06\x28\x91\x18\x7c\x81\xaf\x3c\xa3\x26\x37\x49\x39\xcb\x56\x68\xbe\x0e\xfc\x9a\x6e\xc7\xa2\x29\x8b\xb9\x3a\xaa\x25\xb3\x6b\x42\x8b\x40\x77\xb2\x62\x54\xdd\xe7\x97\xbd\xd7\x2a\x45\xce\x03\xd2\x1d\x85\x3a\x37\xe8\x2d\xcc\xa0\x00\x54\xd5\x5b\xc9\xbf\xe3\x98\x4b\x60\x94\x79\x91\x8e\x89\x50\x47\x1e\x33\xb9\xc3\xb4\x47\x97\x27\xf2\x09\xa4\xee\x20\x23\x99\xee\x93\x9f\xc2\x65\xa8\x4e\x2c\x55\xb3\xf4\x5f\xac\x20\x69\x53\x35\x18\xb4\x64\x9d\x4d\xe2\x0f\x82\xf1\x38\x89\x77\x47\x4c\x27\x8d\x6b\x49\x9e\xfa\x44\xda\x10\xd6\x88\x53\xc8\x86\x19\xbe\x14\xc3\x61\xe0\xd6\xa0\xa8\x61\x89\x44\xad\x52\xf3\x44\xfe\x86\x9b\x44\x05\x42\xe3\x4f\x12\xde\x52\x36\xd2\x1f\x35\x31\xe9\xf0\xa4\x55\x59\x3a\x0d\x95\xd3\x89\x21\x3b\x06\xed\x08\x18\xe9\xdc\xe9\x10\x56\x55\x74\xe2\x26\x5c\xc7\x75\xb7\x78\x71\x5a\x94\x44\x22\xf3\x49\xe4\x51\x7f\x29\xbe\x85\xdb\x91\xa1\x94\x54\xeb\xec\x44\x19\xc6\x23\x0a\x24\x4a\xed\xd5\x1e\x27\x64\x52\x68\xa3\x09\xfd\x59\x64\xd6\xb8\xb2\xe4\xb2\x4c\x89\xe3\x91\xb8\xa6\x78\xd3\x33\x5c\xab\xd1\xb2\x2c\xa7\x59\x4e\xff\x7f\xca\xe9\x76\x0a\xee\x70\xc4\xbf\x5d\x8e\xb2\x2f\xec\xdf\x93\xac\x7a\x5e\xec\x4f\xa1\xc7\xe7\xf0\xed\x95\x03\x60\x7d\xdb\xcf\xec\xee\x44\xd0\xf4\xd1\xa9\xff\x42\x5c\x82\x2d\x91\x76\x2b\xb2\xc1\xaa\x98\x3d\x3f\x9a\x7c\x84\xba\xf1\x0b\x68\x07\x40\xa7\x6b\xa6\x2b\xac\x83\x5b\x11\xbf\xdb\xa3\xe2\xf1\x0e\x2f\x58\x68\x1e\x15\x54\x70\x0b\xf8\xc0\x8d\x9d\xc8\x84\xe5\xaa\x9d\x5c\xb5\x93\xab\x76\x72\xd5\x4e\xae\xda\xc9\x55\x3b\xb9\x6a\xe7\x1f\xbc\x6a\x27\x54\xed\xa4\x4b\xcf\x3f\xd0\xa5\x35\xb3\x93\x0c\x31\x42\xf6\xd3\xce\x0b\xc9\xbf\x8b\x5f\xe8\x5e\xf5\x03\xdd\x8d\xea\x8e\xaf\xbd\xdf\x93\xe2\xd2\xfe\xf6\x9b\x9d\xd1\x80\x01\x85\x5c\x2b\xd4\x5b\xa3\xde\x77\x3b\x00\xf5\x25\xcd\x19\x7c\x91\x7a\x94\x4f\xed\x9c\xbf\x14\xda\x85\x72\x01\x9f\x7d\x79\x57\xac\x8a\x54\x83\x7f\x0c\xb1\xc5\xc2\xf1\xb0\xff\xc2\x31\xf4\x0d\x17\x8a\xd9\x33\xce\x25\xba\x4f\x07\x20\x4f\xa2\x96\x8c\xeb\x18\xf4\x81\x0b\x36\xb8\xf7\xd7\x01\xcc\xae\x1a\x7d\xc7\x4f\x8c\x66\x76\xab\x16\x80\xf9\x4f\x3e\xfe\x5a\xba\xe5\xd2\x27\xb7\x5c\x1b\x73\x79\xfd\x3d\xb3\x77\x34\xc7\x51\x48\x01\x17\x3e\xa9\x48\x22\xa9\x51\x6c\xba\xb2\x82\x10\x7a\xc6\x74\x25\x45\xc0\x28\xab\x39\x25\xbe\xe1\xf3\xbf\x93\x1f\xc1\xad\xff\xbe\x72\x3c\x96\x67\x90\x6f\x92\xa5\x77\x1e\x06\xf6\x5b\x80\xd5\x2e\x78\xe6\x54\xa6\x4f\x97\x36\x50\x53\x96\x39\x3c\x72\xcb\xa4\xd6\x3a\xb7\x38\x56\x11\xc0\x5f\xff\x96\x7b\x83\xe4\xde\x20\xff\x8b\xbd\x41\xbe\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x64\xd8\x1b\xe4\x9b\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\xc9\xbd\x41\x72\x6f\x90\xdc\x1b\x24\xf7\x06\x99\xec\x0d\xd2\x3f\x79\xbc\x35\x48\x9f\xde\x0e\x57\x4b\x58\x91\xe1\x8a\x33\xc9\x4a\x2e\xe0\x55\x08\xa9\x5a\xe1\x34\x13\xf1\x63\x9f\x87\x5d\xc0\xf7\x3f\xcc\x20\x7e\x3f\x20\x1e\xa6\x59\xc0\xf7\x3f\xcc\xfe\x67\x00\x13\x3c\xcc\x5f\x55\x8a\x00\x00")
 
 func clusterApiClusterXK8sIo_machinesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -163,7 +164,7 @@ func clusterApiClusterXK8sIo_machinesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _clusterApiClusterXK8sIo_machinesetsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7c\xdd\x72\x1b\x37\xb2\xff\xbd\x9f\xa2\x8b\x7b\x61\xbb\x8a\xa4\x64\x6d\xb2\xd9\xbf\xee\xb4\x96\x93\xd2\x7f\x6d\xd9\x65\x69\x73\xea\x54\x9c\x3a\x0b\xce\x34\x49\xac\x30\xc0\x04\xc0\x88\x62\x52\xa9\x3a\xaf\x71\x5e\xef\x3c\xc9\xa9\x6e\x00\xf3\xc1\x6f\xca\xf2\x6e\x52\x3b\xbc\xb1\x35\x83\xc1\x47\x77\xa3\xfb\xd7\x8d\x46\x8b\x52\x7e\x8f\xd6\x49\xa3\xcf\x41\x94\x12\x1f\x3c\x6a\xfa\xcb\x8d\xef\xfe\xec\xc6\xd2\x9c\xdc\xbf\x7a\x76\x27\x75\x7e\x0e\xaf\x2b\xe7\x4d\xf1\x11\x9d\xa9\x6c\x86\x97\x38\x95\x5a\x7a\x69\xf4\xb3\x02\xbd\xc8\x85\x17\xe7\xcf\x00\x84\xd6\xc6\x0b\x7a\xec\xe8\x4f\x80\xcc\x68\x6f\x8d\x52\x68\x47\x33\xd4\xe3\xbb\x6a\x82\x93\x4a\xaa\x1c\x2d\x77\x9e\x86\xbe\x3f\x1d\x7f\x35\x7e\x35\x3a\x1d\x9f\x9d\x9e\x9d\xbe\x3a\x3d\x3d\x3b\x3d\x3d\xfd\xe6\xec\x74\xf4\xf5\x37\x67\x5f\x9f\x0a\x91\xbd\xfa\x66\xfa\xa7\x67\x00\x99\x45\xee\xfc\x56\x16\xe8\xbc\x28\xca\x73\xd0\x95\x52\xcf\x00\xb4\x28\xf0\x1c\x0a\x91\xcd\xa5\x46\x87\xde\x8d\x33\x55\x39\x8f\x76\xfc\x30\x0a\x2b\x79\xe6\x4a\xcc\x68\x52\x33\x6b\xaa\xf2\x1c\xd6\xde\x87\x4e\xd2\xbc\x85\xc7\x99\xb1\x32\xfd\x3d\x4a\xed\x47\xa2\x94\xfc\x24\x50\xe5\x5d\x18\xf1\x06\x3d\x3f\x54\xd2\xf9\xbf\xae\xbc\x78\x2b\x5d\x78\x59\xaa\xca\x0a\xd5\x99\x25\x3f\x77\x73\x63\xfd\x75\x33\xf6\x08\x8a\xf8\x42\xea\x59\xa5\x84\x6d\x7f\xf2\x0c\xc0\x65\xa6\xc4\x73\xe0\x2f\x4a\x91\x61\xfe\x0c\x20\x52\x92\x7b\x18\x81\xc8\x73\xe6\x8d\x50\x1f\xac\xd4\x1e\xed\x6b\xa3\xaa\x42\xd7\xfd\xe7\xe8\x32\x2b\x4b\xcf\xb4\xbf\x35\x5e\x28\xd0\x55\x31\x41\x0b\x66\x0a\xda\xe8\x91\x47\x5b\x48\x2d\x3c\xe6\xf5\xd0\xe0\x85\x9d\x21\x3d\x99\x2c\xc1\xcf\xa5\xeb\x4e\x8a\x7e\xff\x70\x46\x7f\x10\x7e\x7e\x0e\x63\xe7\x85\xaf\xdc\xd8\x62\xa9\x64\x26\x5c\x6c\x10\xb8\xf4\xb1\xfb\xd0\x2f\x69\x35\x34\xcd\x19\xda\x43\x26\x28\xee\x85\x54\x62\xa2\xb0\x99\xdb\x0b\x8b\x22\x5f\xc2\xd4\x58\x10\x1e\x14\x0a\xe7\xa1\x90\xfa\x23\x3d\xbd\xc1\xcc\xe8\xdc\xbd\xdc\x3a\xc9\xba\xbf\x8f\x9b\x66\x7b\x91\xde\x3e\x76\xba\x61\x6a\x87\x90\x71\xbc\x83\x8e\x22\x5f\x6e\x9c\x1e\x2f\x71\xeb\xd4\x42\x9b\xfb\x57\x42\x95\x73\xf1\xc7\x20\x54\xd9\x1c\x0b\x71\x1e\x3f\x31\x25\xea\x8b\x0f\x57\xdf\xff\xf1\xa6\xf3\x18\xba\x4b\x6a\x84\x19\xa4\x03\x3f\x47\x08\xcd\x99\xe0\xf4\x67\x4b\xa4\xe1\xe2\xc3\x55\xdd\x4b\x69\x4d\x89\xd6\xd7\xdb\x28\xfc\x5a\x4a\xa7\xf5\x74\x65\xcc\xe7\x34\xad\xd0\x0a\x72\xd2\x36\x18\x46\x8e\x92\x8e\x79\x5c\x09\x91\x98\xe9\x68\xb1\xb4\xe8\x50\x07\xfd\xc3\x82\xa2\xc1\x4c\xfe\x81\x99\x1f\xc3\x0d\x5a\xfa\x90\xf6\x5a\xa5\x72\x52\x4b\xf7\x68\x3d\x58\xcc\xcc\x4c\xcb\x9f\xeb\xde\x1c\x78\xc3\xc3\x28\xe1\xd1\x79\x26\xa6\xd5\x42\xc1\xbd\x50\x15\x0e\x41\x68\xda\x11\x4b\xb0\x48\xfd\x42\xa5\x5b\x3d\x70\x13\x37\x86\x77\xc6\x22\x48\x3d\x35\xe7\x30\xf7\xbe\x74\xe7\x27\x27\x33\xe9\x93\x42\xcd\x4c\x51\x54\x5a\xfa\xe5\x09\xeb\x46\x39\xa9\xbc\xb1\xee\x24\xc7\x7b\x54\x27\x4e\xce\x46\xc2\x66\x73\xe9\x31\xf3\x95\xc5\x13\x51\xca\x11\x4f\x56\xb3\x52\x1d\x17\xf9\x1f\x6c\x54\xc1\xee\x79\x87\x78\x81\xf9\xce\x5b\xa9\x67\xad\x17\xac\xa6\x76\x50\x99\xb4\x15\x31\x55\xc4\x4f\xc3\x2a\x1a\x62\xd2\x23\xa2\xc7\xc7\x37\x37\xb7\x90\x86\x0e\x04\x0f\xb4\x6d\x9a\xba\x86\xcc\x44\x22\xa9\xa7\x68\x43\xcb\xa9\x35\x05\xf7\x82\x3a\x2f\x8d\xd4\x9e\xff\xc8\x94\x44\xed\xc1\x55\x93\x42\x7a\xe2\xdf\x4f\x15\x3a\x4f\x1c\x18\xc3\x6b\xb6\x24\x30\x41\xa8\xca\x9c\xf4\xd0\x18\xae\x34\xbc\x16\x05\xaa\xd7\xc2\xe1\x17\x27\x32\x51\xd3\x8d\x88\x78\x87\x91\xb9\x6d\x04\x57\x1b\x07\x3a\xb5\x5e\x24\x4b\xb4\x85\x27\xcd\x6e\xbb\x29\x31\xeb\x08\x7f\x8e\x4e\x5a\x12\x56\x2f\x3c\x92\x88\xaf\xd8\x9f\xdd\xfb\x8e\x7e\xd1\x8c\x91\xf1\x58\x7d\xb5\x32\x8d\xd7\x4d\xcb\xb4\xeb\x49\x9d\x84\xfd\x86\xe9\x75\x47\x14\x26\xa8\x8c\x9e\x31\x07\xd7\xfa\x2e\xa4\x7e\x8b\x7a\x46\x5a\xed\xd5\xda\xcb\x2d\x54\x0d\x73\x52\xe8\xf1\x83\x51\x32\x5b\xee\x99\xf1\x65\xab\x69\x87\x6c\x65\x78\x54\x39\xcc\x69\x7f\xcb\x9c\x38\x3d\x5d\x82\x36\x39\xf2\x8e\x0f\x83\xc0\x62\x8e\x1a\x72\xb3\xd0\x2e\x13\x4a\xea\xd9\x18\x2e\x71\x2a\x2a\xc5\x42\x09\x83\x8f\x42\xe7\xa6\x18\x8c\x01\xbe\x17\x4a\xa6\x0d\x0f\xc2\x62\x7a\x37\x84\xc1\x35\x2e\xd0\xf9\xc1\x10\x06\xef\x55\x4e\xff\x5b\x9b\x33\xea\xaa\x58\x5f\xc9\x08\x42\x17\x1b\x5e\x84\x2e\x37\xbc\x08\x23\x1c\x43\xcd\x15\xa3\xb8\x87\xa0\xef\xba\xad\x93\x18\x14\x52\xcb\xa2\x2a\x5a\x36\xce\xc5\x06\x64\x0f\x16\x73\x99\xcd\x41\x80\xc6\x85\x5a\x06\xc8\xd6\xc0\x88\xa4\x80\x27\x18\xec\x62\x97\xc2\xa7\xf0\x22\xb5\x5b\x48\xa5\xa8\x55\x66\xb4\x93\x39\x92\xd0\x37\x76\x5f\x38\x70\xc6\x68\xfa\x57\xb2\x49\xe2\xbe\x5e\xae\x2d\x66\x6a\x6c\x21\x3c\x9b\xc4\x3f\x9e\x6d\x21\x53\xdb\x5c\x36\xbf\x04\x5c\xf6\x10\x28\x99\xe4\x7a\x83\xd4\x14\x49\x3b\x35\x75\x34\x86\x5b\xda\x28\xac\x69\x59\x05\xd2\xd6\x31\x90\x4b\x47\x2a\xb6\x92\x6e\x0e\x13\xf4\x0b\x44\x0d\xf8\x40\x9f\x48\x0f\x3f\xa3\x35\x6c\x71\x2a\x4d\x2a\x43\x4e\x25\xe9\xc1\x36\xc1\x5e\xad\xef\xb3\xc7\xaf\xd9\xa1\xc2\xcc\x1b\xbb\x67\xcd\xcf\x6f\x62\xbb\xb0\x18\x25\x26\xa8\xe0\xa7\x0a\xed\x12\xcc\x3d\xda\x16\xd2\x99\x0b\x9f\xf8\x5d\x08\x9f\xcd\x99\x44\x91\x20\x90\x99\x4a\xfb\x31\xbc\xe5\xcf\xef\x70\xe9\x78\xa5\x71\x4b\xf1\xa7\x45\x45\x28\x8e\x3f\x94\x1a\x8c\xcd\x03\xc9\x82\x50\x04\xaf\xa2\x41\x51\x8d\x22\x1c\xc3\x55\xe7\xdb\x16\x3e\x01\x8f\x45\x49\x96\xfd\xf9\x73\x17\x26\xbe\xd9\x5c\x93\x9b\x62\x35\x7a\x64\x63\x92\x9b\xcc\x91\x1d\xc9\xb0\xf4\xee\x84\xd6\x78\x2f\x71\x71\xb2\x30\xf6\x4e\xea\xd9\x68\x21\xfd\x7c\x14\x14\xa0\x3b\x09\x9d\x9e\xfc\x81\xff\x1d\x25\x8a\xae\xd8\x10\xd8\xa9\xa1\xe9\xc7\x33\x7f\xf3\x40\x86\xd5\x35\xce\xd4\x4e\xb6\xac\x7e\x12\xb9\x23\x9d\x27\x71\x0c\x5c\x4a\xf3\x61\x5b\x2b\x2d\x16\xc1\x6a\xdf\x32\x5b\x9a\x27\xac\xd1\x2e\xae\x2f\x31\x5f\x17\x2f\xfa\x49\x8f\xc5\x96\x29\xad\x4c\xea\x62\xc7\xc0\x11\x75\xa4\x37\xcc\x72\x62\xac\x90\xda\x45\x39\x18\x82\x20\xd1\x08\xb0\x8b\xd0\x5c\x89\x56\xd4\x8d\x2d\x32\x48\x63\x06\xdf\xe1\xb2\x25\x3f\x9b\xa7\xbd\x8f\xe8\xe1\x77\x87\x1b\xcc\xcc\x96\xe5\xd1\xa8\x71\xe7\xab\x24\xc7\x61\x6e\xf4\xa8\x5e\x9a\x28\x4b\x25\x71\xb3\x59\x6c\x7e\x3b\xb4\x76\xf3\x4b\x14\x38\x78\x8a\x35\xc9\x1a\x98\x16\x88\xfa\xdc\x05\x02\x92\xac\xcc\x65\x49\x3b\x8b\xb8\xc1\xd2\x92\x90\x6c\x30\x73\xa9\x8b\x20\x17\x57\x7a\x08\xd7\xc6\xd3\x3f\x6f\x1e\x24\xe1\x35\xa2\xfb\xa5\x41\x77\x6d\x3c\x3f\xf9\xec\x45\x86\xe1\x0f\x5e\x62\xd4\x19\x24\x4e\x1a\x84\xb5\x62\xc9\x26\xa9\x05\x67\xdd\x18\xae\x02\x6a\xa9\xc9\x21\x1d\x01\x4a\x63\xd3\x5a\xd8\xb5\x48\xf6\x9c\xba\x60\x0d\x32\x41\x76\x87\xb1\x28\xfd\x72\x63\x1f\x91\x04\xc6\x76\x28\xb0\xa3\xbb\xd8\x15\x1b\x83\xf0\x26\xb8\x2e\x8a\x1c\x79\xc8\x2b\x9e\x34\x83\x71\xe1\x71\x26\x33\x28\xd0\xce\x10\x4a\xda\xdd\xbb\x08\xbb\x73\x4f\x1e\x41\xfb\xd4\x8c\xe7\xb6\xa5\x55\xdc\xc4\xf9\xb6\xe1\x46\x24\x5f\x5b\xdf\x25\xf2\x6d\x69\xb0\x05\x33\x1f\x3a\x3f\x56\x83\x6c\x54\xb6\x50\xa3\x1d\x1b\xd9\xa7\x0d\xf6\x52\x6c\x5d\x03\x87\xa1\x83\x6e\x2b\x44\x49\x92\xf8\x0b\xa9\x30\x16\x86\x5f\xa1\x14\xd2\xba\x31\x5c\x70\x6c\x47\x61\xe7\x9d\xd4\xd1\x56\x35\xdd\x50\x0f\xd2\x01\xd1\xfb\x5e\x28\x52\x9a\xb4\x4d\x35\xa0\x0a\x2a\xd4\x4c\xd7\xf4\xfe\x10\x16\x73\xe3\x82\x46\x9c\x4a\x54\xec\xdd\x0d\xee\x70\x39\x18\xae\x49\xef\xe0\x4a\x0f\x82\x72\x5d\x93\xd7\x5a\x13\x1b\xad\x96\x30\xe0\x77\x83\xe3\x4d\xc5\x4e\x6e\xee\x78\x99\x0c\xf5\x1e\x2c\x72\x1b\x9b\x25\x2d\x1c\xfd\x10\x56\xc1\xa1\xe1\x24\x1a\x88\x1a\x01\xd0\xab\x1a\x5c\x46\x70\x2a\xa7\x20\xb5\xab\xa6\x53\x99\xb1\x4b\x9a\x40\x1b\xaf\x2e\x47\xf2\x13\x09\x79\xbd\x4f\x0e\xef\x14\x2d\xea\x2c\xb8\x0e\x19\xc7\x45\x6b\xd7\xd8\xb5\xfe\x47\x5f\xfb\x38\x84\x70\xf5\x9a\x36\x98\xa7\x3d\x68\x60\xa3\x73\xb9\x91\x20\xcf\x6f\xbc\xd0\xb9\xb0\x79\x24\x05\x01\x9d\xf4\xf9\x17\x77\x9a\xd3\x40\xeb\x68\x67\xff\x1a\xe9\xb7\x16\x3d\xde\xd8\xe8\x88\xfd\x0b\x87\x6a\xbd\x95\xc8\x53\x33\x8f\x68\x55\x2a\xed\xbc\xad\x78\xe1\x39\xef\xac\x10\x25\xa1\xed\xe9\xbc\xa1\x87\x84\x02\x41\xb4\x23\x24\x84\x60\xc5\x92\xc4\x8c\xac\xea\x64\x09\xf8\x10\x63\x49\xde\x18\xc5\xb2\xc3\xdf\xf2\xfe\xb3\xe8\xad\xc4\x7b\x04\x61\x27\xd2\x5b\x61\x97\x2d\xb6\xdd\xce\x09\xdd\x58\xb2\x45\x3e\x20\xed\xe0\x05\xe9\xbc\xe5\x4e\xb1\x75\xb7\xf7\x34\x15\xf2\x62\x0b\x93\xcb\xe9\x92\x8c\x49\x84\xa6\x6b\xdc\xdf\x8c\x73\x2b\x87\x76\x34\xab\x64\x8e\x27\x2d\x76\x6c\xe6\x28\x1c\xa2\xae\x01\x66\xa8\x49\xe5\xe0\xe6\x98\xc3\x46\x16\x0c\xbe\x6b\x7d\x13\x79\x60\xca\xc0\x76\x5a\xe9\x54\x3e\x0c\x83\x3b\xcf\xf8\x9f\x48\x6c\xef\xd1\x0e\x89\xa8\x69\x38\x10\x50\x69\xf9\x53\x15\xc3\x16\xef\xaf\xdf\xfe\x27\x5c\x7d\xcb\xad\xb9\xd7\xa0\x1c\xe7\xc2\x41\x88\x34\xa1\x26\x11\xbd\x97\x39\x07\x9b\x62\x50\xb1\xd6\xa0\x34\xd8\xb0\x09\x82\x58\xf4\x95\xd5\x21\x9c\xd0\x8a\x65\x25\xc5\x92\xcb\x29\xeb\x08\x56\x45\xba\xf9\xac\x14\xce\x51\xf7\x6c\xfe\x83\x0c\xf1\x27\x42\xb9\xe8\xd8\x14\x13\xa9\x1b\x71\x8a\x0b\x60\xd5\xf4\x10\xb4\x6f\x9a\x63\xfc\x9c\xe6\xcf\x04\xa0\xee\xef\x09\xaf\x85\xf0\xa7\xad\x14\x69\x20\xb7\xb2\xde\x26\x82\x39\x21\xdd\x54\xe9\x4c\xf8\x86\x8a\x8a\x03\x34\x29\xc2\x13\x46\xad\xad\x3d\x2d\xb5\x10\x77\xd8\xd8\x8a\x34\x3d\xa3\x5b\x3c\x18\xc3\x27\xbd\x4e\xbe\xda\x7f\xad\xad\x4d\x62\x53\x1e\x28\x83\x0c\xa4\x86\xad\x8e\x02\x65\xae\xdf\xdf\x46\x62\x83\x80\xaf\x4e\xff\x1f\x8c\x48\x55\x7b\x14\xf9\x90\x7c\x7f\x6e\x83\xd2\xcf\xd1\xa6\x66\x67\xa7\xaf\xe0\x75\xd4\xba\xc6\xc2\xd7\xa7\xa7\x81\x9a\x1f\x51\x38\xa3\x63\x88\xf2\x56\x16\x68\x2a\x0f\x52\xe7\x32\x13\x3e\x80\xae\xb6\xb8\x64\xbc\xaf\x62\x0c\x72\x6a\x2a\x9d\x27\xfb\xec\x65\x81\x20\x94\x32\xde\x63\xde\x58\xcf\x14\xcd\x0c\x1b\x92\xf6\xf3\x12\x5e\x24\x99\x55\x4b\x10\xd3\x10\x2d\x8b\x1d\xc4\x81\xb1\xee\xf6\x23\x7d\x31\xba\xe0\x56\x73\x14\x39\xda\x97\x4c\xca\x0b\x76\x1e\xf2\x60\x87\xe5\x14\xd2\x8e\xa0\xa9\xb5\xa2\x02\x5f\x58\xb5\xcb\x1c\x8b\xd2\x78\xd4\xd9\x72\x3d\xa0\x75\x84\xaa\x55\x3b\x90\x19\xfc\x73\xb4\xfb\xbb\x80\xca\xa2\x7f\xb0\x31\xf2\x90\x09\xcd\xa1\xe7\x18\x2f\x34\x76\x26\xb4\xfc\x39\x68\xdc\x74\x32\xf8\x33\xc2\x0b\x3e\x89\x0b\x7a\x98\x7d\xbd\x97\x2d\x65\x4b\x98\x9f\xe3\x0f\x75\x10\x20\x1c\x06\x31\xb2\xe0\x2d\xda\x9c\x8d\xba\xd8\x87\xbd\x97\xd9\x86\x13\x84\xbd\x9a\x3a\x50\xf5\xb3\x94\xb4\x3e\x5c\x39\x3f\x67\x11\x4c\x0e\x4d\xdc\x34\xb4\xc5\x24\xed\x51\x9d\x8e\x25\xc7\x70\xe5\x1a\xe5\xc1\x46\x29\x9c\xdf\xea\x59\x83\x91\x86\x20\x94\x9f\x9b\x6a\x36\x07\x67\x58\xaf\x26\xf0\x44\x3a\x8a\x76\xd9\x02\x44\xda\x5b\xde\xa4\x43\x82\xb6\x06\x69\x0e\x7b\x44\x49\x18\xc3\x4a\x52\xfe\xbc\x89\x45\xe5\x4d\x21\xbc\xcc\x68\x07\x8e\xeb\xad\x53\x5a\x59\x08\x2b\x69\x3f\x69\x8f\x9a\x94\xe9\xd4\xd8\xfa\x74\x19\x1a\x61\x8f\x2c\x37\x7a\x2a\x67\x55\x1c\x2b\xaf\x8f\xbe\x37\x1e\x53\x1c\xcb\xbb\x18\x8c\x96\x68\xdd\x1f\x98\x76\xfb\xd8\xb8\x53\xd8\x6b\xea\x1f\x6a\x68\xeb\x53\xe4\x4e\xc0\x3c\x3c\x89\x3c\x45\x91\xcd\x03\x3d\xbb\x3c\x1f\xc3\x85\x0e\xfe\x6c\x33\xec\xba\xb7\x42\xdd\x7d\x1a\xe4\x21\x68\xf9\x69\xd0\x34\x1d\xc2\xa4\xf2\x9d\x57\x11\xc4\x67\x42\x1b\x4d\x3c\x5b\x39\xd2\x1b\x93\xaf\x4e\x32\x91\x76\x19\x63\xa2\xb6\x79\x22\xac\x45\x5b\x32\x0f\xd1\x8c\x66\x56\xa3\x96\xd1\x32\x1d\xdb\x14\x4e\x30\xc9\x61\x4a\x9d\x26\x1b\x1e\x3d\xf5\x4f\x1a\xde\xc5\x65\x0b\xb8\xbc\xbe\xf9\xaf\xb7\x17\x7f\x79\xf3\xf6\x49\x58\x5f\xcf\xcf\x7d\x96\x4e\x35\x0b\x8d\xf6\x63\xed\x94\x1c\xc8\xf9\xb7\x31\x32\x98\x96\x9d\x63\x19\xb6\x42\x8a\xa8\xa6\xa3\xd3\xab\x29\x5c\xbc\x7d\x5b\xb7\x8b\xe6\x8a\x03\x8b\x73\x71\x8f\x01\x39\x85\x03\x14\x46\x48\xcd\x71\x50\xa2\xe4\x4c\xd8\x89\x98\x91\x4d\x55\x2a\xba\x52\x09\x20\xc4\x96\x7c\x0c\xae\xc5\x2c\x0c\x2f\x5a\xaa\x91\x11\x81\x66\x9f\x57\x93\x49\xe5\xe1\xa5\x0b\xe3\xf3\x00\xf1\x40\xd1\x84\xe7\xed\x2f\xd9\xec\xb3\x44\xd5\x0f\x23\xd7\x09\x91\xd3\x17\x96\xa4\xf8\x76\x8e\x96\xa5\x2e\xf2\xb3\x20\x36\x32\x6e\x33\x1a\xc3\xbc\x48\x67\x35\x9d\x6c\x8f\xc3\xec\x8d\xc2\x74\x58\xf0\xbe\xc3\xb8\xc6\xe5\x46\xcd\x0a\x91\x24\xc9\x16\x41\xe9\x78\x03\x0a\x3d\x2c\x4d\xd5\x9c\x5e\xd1\x04\x17\xba\x81\xf8\xbc\x1f\x3b\x4f\xea\x0d\x1b\x99\xc6\x20\xb1\xd9\x15\x22\x1d\x2a\x32\xe7\xb5\x1f\x12\x5e\x22\x14\x1a\x53\x5d\xc2\x5e\x1a\x82\xe3\x5d\x6c\x23\xec\x68\x75\xc0\xc4\xdc\x15\x95\x3a\x24\xe0\x0a\x3b\x32\x02\xf6\x10\xf0\xe2\xc3\x55\xca\x07\x48\xa0\x35\x3a\xe7\x3b\x83\x90\x70\x78\x30\x0c\x60\xa2\x4c\x76\xc7\x9c\xe2\xe3\xc5\x63\xa7\x48\x82\x6e\x2b\x1c\xc2\xc5\xf5\x25\xc1\x37\x8e\x52\x50\x6f\x35\x72\x1f\x4c\x8d\xc5\x99\x25\x88\x99\x46\x18\xc0\x54\x6a\xa1\xe4\xcf\xb5\xfc\x37\x9f\x35\x72\x1a\xf7\x5c\x73\xba\x7e\x87\xcb\x51\xd0\x71\xc1\xb1\xac\xb4\x97\x2a\x25\x47\x24\x29\xe3\x3f\x0a\x73\xbf\x7a\x94\x34\x15\xca\xd1\x66\x30\x61\x73\xd4\x1a\x72\x48\xc0\xd8\xa1\x05\x8d\x98\x3b\x18\x84\x51\x07\x50\xa2\x2d\xa4\x6b\x93\x9e\xe7\x37\x04\x43\xb2\xb2\x90\x0e\xe1\xab\xb3\x33\x78\xf1\x37\x5d\x5a\x93\xa1\x73\xec\xb3\xbe\xd1\x5e\xfa\xe5\xcb\x5a\x35\x24\x3f\xea\x30\x76\x4d\x8c\x51\x28\xf4\xce\xb6\xcd\x36\x7d\x1c\x9f\x56\xc8\xc5\xda\xa5\xce\x0a\x39\x4a\x19\x1c\x3b\xf3\xf5\x54\x8d\x3d\x73\x0e\xc9\x1b\xab\x82\xff\x2f\x4b\x8d\xd8\xb6\xf6\x03\x36\xd9\x6e\xf4\xb9\x69\xe9\xd7\xad\x54\x84\xad\x4b\x7f\x4a\xf0\x75\xe4\x8a\x2a\x79\x24\x2f\xff\x76\x75\xf9\xb4\xeb\xa9\xe4\x93\x31\x68\x5f\xc0\x1f\x38\xb0\xdf\x28\xf1\x9d\xcd\x48\x72\x76\x36\x20\x46\xec\x6c\x50\xc9\x5d\x1d\x1c\xe0\xec\xec\x3f\xe4\xd8\xd3\xc9\x7a\x06\x4f\xfa\xad\x44\x63\x83\x83\x9e\xd5\x5e\x4a\x3b\x89\x67\x82\x73\x71\x2f\x8d\x4d\xcf\x63\x88\xfa\x8b\x6f\x60\x9a\xfc\x48\xe8\x7c\x14\x92\x0b\x1f\x1b\xad\x9d\x18\xe3\x9d\xb7\xa2\x3c\x10\x70\xfe\x25\xb5\x0f\x87\x23\x8d\x8a\x65\xac\xae\x0c\x01\xfe\x10\x65\x8d\x29\x24\xa8\x33\x51\xba\x2a\x1c\xf2\xb2\x2d\x0a\x01\xf7\xe8\x8d\x85\x40\x54\x3c\xf7\xff\xdf\xff\xfe\x1f\xd7\xcc\xa8\x24\x15\x5d\x60\x36\x17\x5a\xba\x62\xbb\x86\x3e\x0c\x9e\x84\x01\x3f\xe2\x74\xf7\x86\xee\x26\x4f\xa5\x6f\x36\x2e\xb6\x9e\x68\x8a\xe6\xd9\x51\x0c\xe5\x64\x2b\x11\xe4\xb9\xa1\x55\xaf\x3a\xa0\x5e\x48\x55\x9f\xdb\xb7\x0c\x7b\x1d\x22\xa5\x51\xd8\x77\x26\xdd\xe0\x4e\x9a\x13\x5c\x6f\x62\xd0\x68\xd9\xf0\x63\x7c\x29\xbc\x60\xb0\x6c\xaa\xe0\x59\x93\xa9\x67\x9f\xfa\x60\x3b\x77\x28\xd0\x3b\x06\xea\x3d\x1d\xd8\x3b\x42\x6f\x43\x90\x34\x4e\xbf\x3d\x6e\x7e\xcf\xaf\xa6\x61\x42\x1c\x57\x62\x3e\x97\x12\x33\xec\x64\xa2\xa6\xe0\x65\x7c\x48\xbb\xd2\x26\xd7\x33\x62\x8f\x18\x98\x6a\x32\x55\xc9\x29\x00\x11\x82\xbb\xf0\xff\x6f\xde\x5f\x9f\x7c\x67\xa2\x1f\x23\x32\x42\x56\x21\x1d\xb0\x60\x04\xef\xaa\x6c\x4e\xc0\x3e\x2a\x99\x1b\x7a\x33\x2e\x84\x96\x53\x74\x7e\x1c\x7b\x43\xeb\x7e\x38\xfb\x71\x0c\xdf\x1a\x0b\xf8\x20\x8a\x52\xe1\xb0\x06\xa7\x2b\x87\x5c\xec\x95\x9b\x28\x0a\xfc\x6d\x13\xeb\x29\x4d\xf2\xf8\x16\x3c\x59\x2f\xee\x38\x1a\x2c\xa2\xab\xad\xe4\x1d\x9e\xc3\x80\x24\xae\x35\xf4\x2f\xa4\xe0\x7f\x1d\xc0\x8b\x05\x3b\x15\x03\xfa\x73\x10\x06\xac\x81\x56\x3b\xd3\xb0\x19\x38\xa4\x56\x58\x39\x9b\x71\x42\x18\x67\x94\x92\x66\x7b\x49\x8e\x8b\x9c\x92\x73\xd2\x34\xd6\x31\xda\xd3\x84\x9e\x57\x27\xf2\xc3\xd9\x8f\x03\x78\xd1\x5d\x17\x48\x9d\xe3\x03\x9c\xd5\x8e\x66\x69\xf2\x97\x31\x60\xef\x96\xda\x8b\x07\xea\x33\x9b\x1b\x87\x3a\xc4\x64\xbd\x09\x7e\x30\xc7\xaf\x16\xa8\xd4\x28\x44\x52\x72\x58\x84\x4c\x84\x44\xca\x10\x64\x2e\x85\xf5\x2b\xc9\xc9\xb7\xef\x2f\xdf\x9f\x87\xd1\x88\x6d\x33\x9d\xe2\xbb\xec\x05\xc4\x08\x73\x27\x9a\x5e\xc5\x73\x4e\x03\xa4\xe3\x66\xb5\x83\x37\xad\x48\xe9\x8f\xf7\x99\xfd\xa3\xf6\xc3\x21\xb0\xf4\xf7\x06\x4c\x8f\x22\xc0\x21\xe0\xf4\x51\xf0\xf4\xf3\x52\xcd\x18\xaf\x9e\x1c\x06\x5b\x8f\x5e\xef\x9e\x18\xe2\xf6\x45\x87\xc0\xc0\x3f\x61\xe5\x1c\x37\x3b\x79\xd2\x85\x27\xd3\xfb\x38\xfb\x54\x83\xbd\xd5\x7e\x68\x9b\x06\x44\xb3\xee\x8a\x17\x22\x0f\xaa\x57\xe8\xe5\x17\xdf\x20\x44\xde\xca\xd2\xd8\xcb\x51\x34\xec\x8c\x03\x39\xc7\xd6\xf1\xe1\xce\x93\xd2\xf3\x00\x1f\xe8\x31\x5e\xd0\x93\x6c\x9b\x43\xbc\xa3\x23\x16\x7b\x90\xd3\x01\xb0\x3d\x8b\x63\x23\x39\x06\x8c\xcc\xea\xb8\x20\x51\xa5\x81\x8e\xd4\x57\x63\xef\x33\x65\xaa\x7c\x24\xb5\xf4\x09\x1f\x42\xe8\x28\xe4\xbe\x69\xa9\x86\x6d\xbc\xdc\x9c\x53\x16\x84\x2e\xa2\xf9\xf8\x80\x3a\x67\xec\xc1\xa8\x01\x3e\x69\xb8\xc4\xd2\x22\x9f\x53\x9e\x07\x1b\xd8\x9c\x9a\xc7\xb8\x6f\x7a\x4f\x7d\xa4\x8b\x46\x6c\xb2\x9a\x10\x0f\x47\x9b\x80\x11\x43\x30\x50\x09\xc7\x8d\xe1\x66\x21\x39\x47\xd7\x00\x2d\xf5\x06\x33\x8b\x7c\x15\x6e\xbc\x3d\x22\x7e\x04\x5f\xf2\x4e\x9f\x47\x10\xbe\x3b\x99\x4d\xd7\x1f\x1c\xbf\x8d\x39\xce\xde\x58\xdc\xc4\x9e\xc8\x82\x47\x73\xe0\xf3\x69\x70\x80\x5c\xee\xbc\x11\xb2\x91\x3a\x4f\x7c\x33\x24\xfd\x76\xde\x10\x39\x62\xd5\x53\x21\x55\x65\xf1\xd2\x10\x61\x0f\x5c\xd2\xb7\xed\x6f\xd2\xa2\x62\x47\x90\x87\xa7\xed\x34\xb2\xb5\x0c\x32\x3d\x0e\xc7\x44\xe1\xcc\x57\x84\xd4\xe0\xf0\x4d\xa7\x6f\xd7\x4e\x1a\x8a\x89\x13\x91\x03\x09\x0f\x7e\xce\xda\xa5\x9e\x5a\x51\xe7\x2a\xed\xf4\x59\xbb\xe1\xcf\xd5\xef\x92\xdf\x1a\x0f\xd7\x56\x1c\xd8\x95\xa4\x37\x30\x9c\xee\x12\x0e\x6f\xf4\xca\x24\x6a\x1f\xf7\x73\xfd\xf0\x43\x3d\xc7\xa7\xf1\x1a\x0f\x54\x31\x07\x7a\x8b\xbd\xa7\xd8\x7b\x8a\xbf\x3f\x4f\xf1\xc0\x3d\xb0\xdf\x43\xfc\x3d\x79\x87\x07\x2e\x7a\xbf\x57\xf8\x1b\xf6\x08\x8f\x58\xe3\x01\x9e\xe0\x6f\xdc\x0b\x3c\xf8\x90\xe3\x08\xef\xef\xdf\xcd\xf3\x3b\x90\x86\x7b\x3d\xbe\xdf\xac\xb7\xf7\x54\x68\x5a\x9b\x1c\x2f\xad\x90\x3a\x66\x98\x1e\x9c\x53\xb7\xf2\x5d\xc2\xa0\x9e\xab\x49\x88\xc2\x54\xe1\xba\x07\x27\x8f\xd6\x57\xcc\x5a\x89\x2d\x8c\x48\x5d\x89\x9a\x61\x65\x4e\x5d\x05\x33\x42\x33\x0a\x67\x05\x31\xd3\x2a\x9a\x5c\xe9\xe0\x74\x08\x05\x0a\x1d\xee\xf8\xc7\x2e\xa9\x79\x4a\x80\xe4\x5e\x62\x36\x32\xcd\x49\xe8\x65\x98\x80\x92\x85\x8c\xa9\xe1\x63\xb8\x7e\x7f\xfb\xe6\x1c\x36\x2d\xa0\x49\x83\xe6\x1c\x85\xbf\x13\x1f\x33\xaf\x42\xbf\x30\x1a\xf9\xd0\xf4\xef\x9f\x95\xf2\x96\xe0\xe5\xd5\xe5\x81\xb4\xfe\x50\x7f\x90\x88\x9c\x8e\x4d\xe3\x39\x5d\x23\x95\x09\xee\xd7\x49\xd7\x31\x51\xba\x86\xb4\x6d\xa7\x78\xe5\xce\x69\x6a\x43\xdd\x09\x07\x0e\x19\x06\x34\x34\x8e\xf0\x29\x33\xd6\xa2\x2b\x4d\xf0\xfb\x52\x1a\x53\x7d\x16\x78\xdb\x31\xe7\x35\x1e\x9f\x2c\x61\x2e\x67\x73\xb4\xa0\x48\x09\xf0\x35\xe9\xaa\xc0\x90\x64\xda\x2a\x55\x33\x86\x37\x01\xb5\x41\xe5\x88\xad\x8e\xf9\x9e\x1c\x0e\x51\x79\xe3\x32\xa1\x12\xa2\x69\x7d\x48\x53\x6e\x56\xf9\x5a\xa1\xd0\xa3\xaa\x04\x65\x66\x32\x4b\x18\xa2\xf5\x79\x66\x8a\x52\x58\x74\xad\xdb\xbf\xa6\xb9\x5e\x3f\x65\x83\x5f\xf9\xe6\xad\xf0\x0d\x7d\x82\x86\x6c\x12\xae\x67\x48\xa0\x72\x46\x7a\xc9\x86\x9b\x33\x7f\xad\xf7\x7f\xe8\x73\x0c\xff\xb1\x61\xba\x22\x64\x84\xca\x8c\x86\x1a\x99\xe9\xc8\x5b\x6c\xb1\x81\x8b\xc3\xd4\x33\x1e\xae\x00\xa5\x36\x65\x5b\xeb\x0a\x49\x85\x9c\xba\x92\xd0\x9b\x68\xba\x24\xbd\x93\x44\x25\xdd\xb4\x4d\x4b\x1c\xc3\x85\xe6\x54\x98\xfa\x4d\x20\x87\x74\x7c\x85\x43\x76\x12\x77\xfe\xec\xc8\xbb\x89\x79\xef\x31\xa3\x5c\x33\x92\x26\xb2\x4a\x67\x18\xe8\xe5\x46\x63\x87\x9c\x95\x6e\xd1\xa9\xa1\xad\xce\x39\x33\xb2\x10\xf6\x2e\xa6\xb6\x86\xa4\x9d\x8e\x34\x25\x0f\x36\x5e\x4e\x61\x7e\x66\xbe\x8a\x17\x3d\x43\xd6\x2b\x89\xd4\xba\xac\xd1\x5e\x21\x5f\x8d\x41\x7b\x87\x58\xed\xbb\x55\x7c\xc5\x7d\x2a\x18\xcb\x6e\x12\xae\xc4\xab\xfd\xde\xe1\x01\x3a\xe0\x7e\x9f\xcd\xee\x28\x80\x4d\x65\x65\xd2\xa1\x7c\x4b\xd4\xea\x20\x55\x77\x0f\x92\xc6\xf4\x51\x30\x5a\xf9\xfd\xe9\x36\xca\xfa\xd9\xee\xd6\xeb\xc8\x07\x2c\x6d\x77\xe6\xc5\xa8\x19\x6d\xcb\xfb\x56\x70\x67\x4b\x8b\xb5\x88\xc1\xf1\xf9\x10\x5b\x5f\x6e\x9e\xfd\xf6\x59\x8d\xea\x64\xf9\x67\x07\xf4\x1f\xd2\x17\x0e\x2b\xa4\xc2\x4d\x3b\x0c\x37\x93\x78\x5d\xea\xf1\xb5\x54\xd6\x0a\x47\xed\xbb\xad\xd8\xa9\x10\xd1\x94\xb3\xa8\xaf\x1b\x1e\x52\xc6\x2a\x66\x2c\x77\x4b\x1e\xac\x8d\xfb\xf8\x2a\x10\x31\xe8\xf5\x0e\x9d\x13\xb3\x0d\xc0\x7f\x87\xc8\xc6\x4f\xc3\x45\x9b\x3d\xb4\x18\x5c\xe9\xc6\x2b\xaf\xd1\x47\xc8\x31\x15\x10\xeb\x8f\x29\x22\xfe\x44\x61\xc1\x85\x9a\x74\x26\x55\x2a\x47\x94\x48\x36\x84\x89\xf1\xf3\x14\x62\x8b\x57\x7c\x48\x7b\x7d\xdb\x59\x46\x5b\xdb\x8d\x57\x5a\xa7\x57\xa5\x29\x39\xd7\xa4\xbe\x7c\xe5\xaa\x2c\x93\x3a\x4b\x78\xc9\x55\xd2\x33\xbb\x88\x01\x09\x18\xb0\x96\x2b\x2d\x06\x30\x34\x24\x5b\xa6\x70\xe3\xe0\x4d\x78\x86\xf3\x8b\xef\xd1\x4e\x8c\xc3\x3a\x86\xd3\xee\x5c\x99\x19\x27\x19\xd2\x3a\xe6\x55\x21\x74\xd4\xc5\x65\xc8\x82\xff\xa4\x49\x92\x1c\xa6\x9c\x98\x18\xff\x89\x59\xa1\xa4\xcf\x59\x44\xac\xd0\x4e\x7a\x79\x8f\x80\xd6\x72\xee\x07\x11\x59\x74\x92\xa1\x45\x96\xae\xbc\x90\xc1\xc0\x87\x92\xd3\xb3\xa3\x72\x9b\xca\x07\x32\xbf\xed\x3b\x14\xa1\xc2\x07\x43\xc0\x17\xac\xfd\xe3\x9d\x15\xb2\x46\x62\x86\xee\x65\x48\xea\x4f\xa1\xab\x74\xc9\x29\x86\xc9\x4d\x81\x7e\x4e\x0b\x23\x75\x5a\xe9\x5c\x14\xa8\x3d\x77\xbb\xb0\x26\x99\x89\x56\x88\x3c\xdd\xee\x7d\x1e\x42\x33\x10\x8b\x8d\x75\xb3\x61\x56\x90\x5a\x3b\xff\x3b\x18\x52\xbe\x87\xa9\x2b\xa1\x02\xb3\xa2\x5b\xd4\xb6\xf9\x35\x48\x62\xf4\x54\xcb\x5e\x24\xdb\x22\xdd\xb0\x94\x3a\x04\xd6\xc2\x25\xbd\x78\x3f\x94\x8b\xdf\x78\x2f\xf5\xac\x4e\x8d\xa7\xb9\x0e\x3b\xf7\x89\x04\x27\xe3\xba\xaa\x2c\x8d\x6d\x5d\xb7\xdb\x34\xe7\xb8\xc6\x00\x09\x9d\x6c\x55\xb9\x6b\x33\x4e\x7a\x87\x6a\x0a\x13\xe4\x5c\x54\x2b\x13\x7b\x0a\xe9\xea\xf4\xa8\x3c\x5c\x1f\x23\xc8\xce\xa2\x40\xfb\xac\x2d\x09\x26\xcb\x2a\x9b\x2a\x0e\x84\x31\xe3\x1e\xab\x09\xfb\x2e\x41\x8a\xe8\x0f\x88\x3c\x0f\x80\x8c\x77\x6d\x1d\x82\x6b\x95\x87\x8b\xa0\x56\xe8\xfc\x24\x0a\x72\x73\xc3\xad\x99\xff\x73\x47\x02\x53\x56\x7e\xc3\x31\xcf\x2e\xfd\x52\x29\xb5\xe4\x9b\xf1\x98\x3f\x4a\xe5\xd6\x8a\x36\xe4\x56\x11\x9a\x53\xe9\xa2\xbd\xcf\xe6\x89\x10\xf1\xd9\x8a\x64\xa5\xeb\xdb\xe9\xf9\x97\x51\xbf\xc9\x2c\x7d\x57\x5f\x6e\xda\xb3\xc2\xf7\x6b\x1f\x90\x2f\xad\xf8\xae\xc6\xfa\x2d\x29\x5e\x90\x71\x5c\xf9\x0e\xb5\xa7\x1d\x9d\xec\xe0\x81\xeb\xf9\xd3\x57\x47\xad\xa7\x53\xb9\xf0\x48\x66\x91\x2d\xac\x59\xb6\xc9\xec\xc1\x45\xa3\x88\x5d\xbb\x54\x54\xf8\x76\x91\x32\xe7\xd9\xdf\xaa\xcf\x2c\xd3\x99\x8d\x08\x95\xef\x06\x6c\x5e\x07\x4f\xc9\xc4\x47\x56\x8f\xda\xc2\x98\x75\xf9\xfd\x57\x57\x7d\xaa\xef\x92\x88\x76\xf1\x9b\xba\xde\x4d\x30\x01\xa1\x55\x30\x6f\x61\x7a\x7c\x3a\x70\x6f\x24\x69\x04\x6f\x0d\xe9\x49\x96\xcb\xc9\x32\xde\xf0\x8b\x39\x8d\xf1\xa3\xc6\x93\x68\x06\x8c\x1d\xc5\x71\xf9\x2e\xfc\xa8\x14\x56\x14\x31\xe4\xde\x0a\x1f\x81\x98\x90\x8f\xd4\x9d\x9b\x3b\xea\x46\xe5\xfe\x32\x4e\x5b\xb5\xd5\x46\xcc\xba\xf6\x30\x70\xf8\x9c\xaf\x1e\x84\x07\xde\x58\x82\x5d\xe1\x3a\x46\x78\x54\x4d\xea\x5b\x91\x89\x49\xec\x70\x35\x1c\xe3\x69\x26\x06\x75\x2b\x87\xae\x01\x6a\xa2\x7a\x12\xbc\xd4\xb4\xc4\x6c\xb5\x54\x6b\x82\xd7\xab\x4d\x37\xd6\x75\x8d\x48\x1c\x7e\xf9\xb5\x2f\x3f\xdb\x97\x9f\xdd\x52\x7e\x36\xd8\x8d\xbe\xfc\x6c\x5f\x7e\xb6\x2f\x3f\xdb\x97\x9f\xed\xcb\xcf\xf6\xe5\x67\xfb\xf2\xb3\x7d\xf9\xd9\xe6\xd7\x97\x9f\xed\xcb\xcf\xf6\xe5\x67\xfb\xf2\xb3\x7d\xf9\xd9\xbe\xfc\x6c\x5f\x7e\x16\xfa\xf2\xb3\x7d\xf9\xd9\xbe\xfc\x6c\x5f\x7e\xb6\x2f\x3f\xdb\x97\x9f\xed\xcb\xcf\xf6\xe5\x67\xfb\xf2\xb3\x7d\xf9\xd9\xbe\xfc\x6c\x5f\x7e\xb6\x2f\x3f\xdb\x97\x9f\xed\xcb\xcf\xf6\xe5\x67\x37\xfd\xfa\xf2\xb3\xdd\x5f\x5f\x7e\xb6\x2f\x3f\xdb\x97\x9f\xed\xcb\xcf\xf6\xe5\x67\xf7\x2e\xa8\x2f\x3f\x9b\x1a\xf4\xe5\x67\xfb\xf2\xb3\x7d\xf9\xd9\xbe\xfc\x6c\x5f\x7e\xb6\x2f\x2a\xd4\x17\x15\xea\xcb\xcf\xf6\xe5\x67\xfb\xf2\xb3\x7d\xf9\xd9\xdf\x42\x11\xa2\x23\xe9\xd9\x97\x9f\x5d\x5b\xec\xbf\x45\xf9\xd9\xaf\xfa\xf2\xb3\x7d\xf9\xd9\xbe\xfc\x6c\x5f\x7e\xb6\x2f\x3f\xbb\x71\x4e\xbd\xa7\xd8\x7b\x8a\xbf\x0b\x4f\xb1\x2f\x3f\xbb\xbd\x59\x5f\x7e\x76\xc7\x42\x7f\x53\x5e\x60\x5f\x7e\xb6\x2f\x3f\xdb\x97\x9f\xed\xcb\xcf\xf6\xe5\x67\xfb\xf2\xb3\x7d\xf9\xd9\xbe\xfc\x6c\x5f\x7e\xb6\xdd\xa2\x2f\x3f\xdb\x97\x9f\xed\x7c\xd9\x97\x9f\xed\xcb\xcf\xf6\xe5\x67\xfb\xf2\xb3\x7d\xf9\xd9\xbe\xfc\xec\x86\xf5\xf4\xe5\x67\x0f\x59\x74\x5f\x7e\xb6\x2f\x3f\xfb\x59\xe5\x67\x9b\x27\xbf\xab\xea\xb3\x0d\x26\x17\x59\x86\xa5\xc7\x9c\xc3\xc1\x61\xae\x7c\x4e\x00\x83\xa0\xf3\x4b\x55\x59\xa1\xe2\x9f\x04\x6d\x65\x28\xfc\x00\x3f\xfc\xf8\x0c\xe2\x01\x69\x74\xd4\xc2\xc3\xff\x0b\x00\x00\xff\xff\xb4\x23\x0d\xc7\x2a\xaf\x00\x00")
+var _clusterApiClusterXK8sIo_machinesetsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xeb\x6e\x1c\xb9\xb1\xf0\xff\x79\x8a\xc2\xe4\x87\x6c\x60\xa6\x25\x2b\x9b\xcb\x37\xff\x14\xcb\x1b\xe8\x8b\x2d\x1b\x96\x92\x83\x83\xdd\xc5\x09\xa7\xbb\x66\x86\x11\x9b\xec\x90\x6c\xc9\xb3\x41\x80\xf3\x1a\xe7\xf5\xce\x93\x1c\x54\x91\xec\xcb\xdc\x34\xb2\xe4\x20\x0b\xf4\x4a\xc0\xae\xba\xd9\x64\xdd\x58\x37\x16\x6b\x45\x25\xff\x82\xd6\x49\xa3\x67\x20\x2a\x89\x5f\x3c\x6a\xfa\xcb\x65\x77\xbf\x77\x99\x34\xa7\xf7\x6f\x46\x77\x52\x17\x33\x78\x5b\x3b\x6f\xca\xcf\xe8\x4c\x6d\x73\xbc\xc4\x85\xd4\xd2\x4b\xa3\x47\x25\x7a\x51\x08\x2f\x66\x23\x00\xa1\xb5\xf1\x82\x1e\x3b\xfa\x13\x20\x37\xda\x5b\xa3\x14\xda\xe9\x12\x75\x76\x57\xcf\x71\x5e\x4b\x55\xa0\xe5\xc9\xd3\xd2\xf7\x67\xd9\x77\xd9\x9b\xe9\x59\x76\x7e\x76\x7e\xf6\xe6\xec\xec\xfc\xec\xec\xec\x77\xe7\x67\xd3\xdf\xfc\xee\xfc\x37\x67\x42\xe4\x6f\x7e\xb7\xf8\xed\x08\x20\xb7\xc8\x93\xdf\xca\x12\x9d\x17\x65\x35\x03\x5d\x2b\x35\x02\xd0\xa2\xc4\x19\x94\x22\x5f\x49\x8d\x0e\xbd\xcb\x72\x55\x3b\x8f\x36\xfb\x32\x0d\x98\x8c\x5c\x85\x39\x01\xb5\xb4\xa6\xae\x66\xb0\xf5\x3e\x4c\x92\xe0\x16\x1e\x97\xc6\xca\xf4\xf7\x34\x8d\x9f\x8a\x4a\xf2\x88\x40\x95\x0f\x61\xc5\x1b\xf4\xfc\x50\x49\xe7\xff\xb4\xf1\xe2\xbd\x74\xe1\x65\xa5\x6a\x2b\x54\x0f\x4a\x7e\xee\x56\xc6\xfa\xeb\x76\xed\x29\x94\xf1\x85\xd4\xcb\x5a\x09\xdb\xfd\x64\x04\xe0\x72\x53\xe1\x0c\xf8\x8b\x4a\xe4\x58\x8c\x00\x22\x25\x19\xda\x29\x88\xa2\x60\xde\x08\xf5\xc9\x4a\xed\xd1\xbe\x35\xaa\x2e\x13\x4f\xa6\x50\xa0\xcb\xad\xac\x68\xc8\x0c\x6e\x8d\x17\x0a\x74\x5d\xce\xd1\x82\x59\x80\x36\x7a\xea\xd1\x96\x52\x0b\x8f\x45\xb3\x34\x78\x61\x97\x48\x4f\xe6\x6b\xf0\x2b\xe9\xfa\x40\xd1\xcf\xdf\x9c\xd1\x9f\x84\x5f\xcd\x20\x73\x5e\xf8\xda\x65\x16\x2b\x25\x73\xe1\xe2\x00\x22\xf0\x0c\x3e\xf7\x1f\xfa\x35\x61\x43\x60\x2e\xd1\x1e\x03\xa0\xb8\x17\x52\x89\xb9\xc2\x16\xb6\x57\x16\x45\xb1\x86\x85\xb1\x20\x3c\x28\x14\xce\x43\x29\xf5\x67\x7a\x7a\x83\xb9\xd1\x85\x7b\xbd\x17\xc8\x66\xbe\x0d\xc0\x02\xb4\x17\xe9\xed\xd7\x82\x1b\x40\x3b\x86\x8c\xd9\x5e\x10\x79\x8e\x9d\xe0\x31\x8a\x7b\x41\x0b\x28\xdc\xbf\x11\xaa\x5a\x89\x5f\xf3\x23\x97\xaf\xb0\xe4\xcd\x4a\x7f\x99\x0a\xf5\xc5\xa7\xab\xbf\xfc\xfa\xa6\xf7\x18\xfa\x28\xb5\xc2\x0c\xd2\x81\x5f\x21\x84\xe1\x4c\x70\xfa\xb3\xc5\xc1\xc1\xc5\xa7\xab\x66\x96\xca\x9a\x0a\xad\x6f\xb6\x51\xf8\xed\x28\x9d\xce\xd3\x8d\x35\x4f\x08\xac\x30\x0a\x0a\xd2\x36\x44\xbb\x15\x26\x49\xc7\x22\x62\x42\x24\x66\x71\xb4\x58\x59\x74\xa8\x83\xfe\xa1\xc7\x42\x83\x99\xff\x0d\x73\x9f\xc1\x0d\x5a\xfa\x10\xdc\xca\xd4\xaa\x20\xb5\x74\x8f\xd6\x83\xc5\xdc\x2c\xb5\xfc\xb9\x99\xcd\x81\x37\xbc\x8c\x12\x1e\x9d\x67\x62\x5a\x2d\x14\xdc\x0b\x55\xe3\x04\x84\xa6\x1d\xb1\x06\x8b\x34\x2f\xd4\xba\x33\x03\x0f\x71\x19\x7c\x30\x16\x41\xea\x85\x99\xc1\xca\xfb\xca\xcd\x4e\x4f\x97\xd2\x27\x85\x9a\x9b\xb2\xac\xb5\xf4\xeb\x53\xd6\x8d\x72\x5e\x7b\x63\xdd\x69\x81\xf7\xa8\x4e\x9d\x5c\x4e\x85\xcd\x57\xd2\x63\xee\x6b\x8b\xa7\xa2\x92\x53\x06\x56\x13\x52\x2e\x2b\x8b\x5f\xd9\xa8\x82\xdd\x49\x8f\x78\x61\x1b\x39\x6f\xa5\x5e\x76\x5e\xb0\x9a\x3a\x40\x65\xd2\x56\xc4\x54\x11\x3f\x0d\x88\xb6\xc4\xa4\x47\x44\x8f\xcf\xef\x6e\x6e\x21\x2d\x1d\x04\x37\xd0\xb6\x1d\xea\x5a\x32\x13\x89\xa4\x5e\xa0\x0d\x23\x17\xd6\x94\x4c\x55\xd4\x45\x65\xa4\xf6\xfc\x47\xae\x24\x6a\x0f\xae\x9e\x97\xd2\x3b\xb0\xf8\xf7\x1a\x9d\x27\x0e\x64\xf0\x96\x2d\x09\xcc\x11\xea\xaa\x20\x3d\x94\xc1\x95\x86\xb7\xa2\x44\xf5\x56\x38\xfc\xe6\x44\x26\x6a\xba\x29\x11\xef\x38\x32\x77\x8d\x60\xfb\x0f\xcd\x32\x8b\x74\xea\xbc\x48\x96\x68\x0f\x4f\xda\xdd\x76\x53\x61\xde\x13\xfe\x02\x9d\xb4\x24\xac\x5e\x78\x24\x11\x6f\xc7\xf6\xe6\xdb\xbd\xef\xe8\x27\x9a\x31\x32\x1e\x9b\xaf\x36\xc0\x78\xdb\x8e\x4c\xbb\x9e\xd4\x09\xad\x4a\xff\x1d\x5f\xf7\x44\x61\x8e\xca\xe8\x25\x73\x70\x6b\xee\x52\xea\xf7\xa8\x97\x64\x1d\xde\x6c\xbd\xdc\x43\x55\xfa\x2d\x50\xa1\xc7\x4f\x46\xc9\x7c\xfd\x08\xc4\x97\x9d\xa1\x3d\xb2\x55\xe1\x51\xed\xb0\xa0\xfd\x2d\x0b\xe2\xf4\x62\x0d\xda\x14\x34\xc2\xc4\x45\xe0\x61\x85\x1a\x0a\xf3\xa0\x5d\x2e\x94\xd4\xcb\x0c\x2e\x71\x21\x6a\xc5\x42\x09\xe3\xcf\x42\x17\xa6\x1c\x67\x00\x7f\x11\x4a\xa6\x0d\x0f\xc2\x62\x7a\x37\x81\xf1\x35\x3e\xa0\xf3\xe3\x09\x8c\x3f\xaa\x82\xfe\x6b\x0b\x66\xd4\x75\xb9\x8d\xc9\x14\xc2\x14\x3b\x5e\x84\x29\x77\xbc\x08\x2b\x3c\x85\x9a\x1b\x46\xf1\x11\x82\x7e\xe8\x8f\x4e\x62\x50\x4a\x2d\xcb\xba\xec\xd8\x38\x17\x07\x90\x3d\x78\x58\xc9\x7c\x05\x02\x34\x3e\xa8\x35\xe4\x16\xbb\x6e\x44\x52\xc0\x73\x04\x4b\x70\xf4\x29\x7c\x06\xaf\xd2\xb8\x07\xa9\x14\x6d\xff\xdc\x68\x27\x0b\x24\xa1\x6f\xed\xbe\x70\xe0\x8c\xd1\x20\x1c\x48\x4f\x50\xf1\x5c\xaf\x47\x3d\x4c\x00\xc8\x3c\x95\xc2\xb3\x73\xf1\xeb\xf3\xad\xb7\xdb\xe6\xb2\xfd\x27\x39\x2e\x8f\x10\x28\x99\xe4\x66\x83\x34\x14\x49\x3b\x35\x4d\x94\xc1\x2d\x6d\x14\xd6\xb4\xac\x02\x49\x37\x1a\x28\xa4\xf3\xe4\xe5\x49\xb7\x82\x39\xfa\x07\x44\x0d\xf8\x85\x3e\x91\x1e\x7e\x46\x6b\xd8\xe2\xd4\x9a\x54\x86\x5c\x48\x2c\xfa\x04\x7b\x93\x8d\x7a\xc0\x3d\x0b\x67\x87\x0a\x73\x6f\xec\x23\x38\x9f\xdc\xc4\x71\x84\xb4\x00\x25\xe6\xa8\xe0\xef\x35\xda\x35\x98\x7b\xb4\x8d\x2f\x00\x7e\x25\x7c\xe2\x77\x29\x7c\xbe\xe2\xbd\x18\x09\x02\xb9\xa9\xb5\xcf\xe0\x3d\x7f\x7e\x87\x6b\xc7\x98\xc6\x2d\xc5\x9f\x96\x35\x79\x71\xfc\xa1\xd4\x60\x6c\x11\x48\x16\x84\x22\x44\x15\xad\x17\xd5\x2a\xc2\x0c\xae\x7a\xdf\x76\xfc\x13\xf0\x58\x56\x64\xd9\x4f\x4e\x5c\x00\x7c\xb7\xb9\xa6\x30\xc5\x6a\xf4\x48\x81\xc1\x69\x61\x72\x47\xc6\x3a\xc7\xca\xbb\x53\xc2\xf1\x5e\xe2\xc3\xe9\x83\xb1\x77\x52\x2f\xa7\x0f\xd2\xaf\xa6\x41\x01\xba\xd3\x30\xe9\xe9\xaf\xf8\xdf\xd3\x44\xd1\x0d\x1b\x72\x58\x43\xd3\x0f\x93\xeb\xdd\x17\xb2\xc1\x8d\x5b\x0f\x70\x90\x2d\x9b\x9f\x44\xee\x48\xe7\x49\x63\x33\x3c\x90\xe0\x61\x5b\x2b\x2d\x96\xc1\x6a\xdf\xae\xb0\xf7\x84\x35\xda\xc5\xf5\x25\x16\xdb\xe2\x45\x3f\xd2\x63\xb9\x07\xa4\x0d\xa0\x2e\x0e\x2c\x1c\xbd\x8e\xf4\x86\x59\x4e\xd6\x5a\x48\xed\xa2\x6a\x9d\x80\x80\x3b\x5c\x07\xb7\x8b\xbc\xb9\x0a\xad\x68\x06\x5b\x24\x56\x86\x8d\x77\x87\xeb\x8e\xfc\xec\x06\xfb\x31\xa2\x47\x87\x09\xd7\xfb\x5f\x6e\xa0\x47\xab\xc6\x9d\xaf\x92\x1c\x07\xb1\x27\x98\x1a\xa4\x45\x55\x29\x89\xbb\xcd\xe2\x51\x5a\xbb\xfd\x49\x14\x38\x1a\xc4\x86\x64\xad\x9b\x16\x88\x7a\x42\x5a\x53\xb1\xaf\xec\x56\xb2\xa2\x9d\x45\xdc\x60\x69\x49\x9e\x6c\x30\x73\x69\x8a\x20\x17\x57\x7a\x02\xd7\xc6\xd3\xbf\xde\x7d\x91\xe4\xaf\x11\xdd\x2f\x0d\xba\x6b\xe3\xf9\xc9\xb3\x91\x0c\xcb\x1f\x8d\x62\xd4\x19\x24\x4e\x1a\x84\xb5\x62\x4d\x38\x74\xdd\x59\x97\xc1\x55\xf0\x5a\x1a\x72\x48\x47\x0e\xa5\xb1\x09\x17\x7a\x19\x27\x0a\x53\xb0\x06\x99\x23\x87\xc3\x58\x56\x7e\xbd\x73\x8e\x48\x02\x63\x7b\x14\x38\x30\x5d\x9c\x8a\x8d\x41\x58\x88\xcd\x57\xa5\x28\x90\x87\xa2\x66\xa0\xd9\x19\xa7\x0c\x84\xcc\xa1\x44\xbb\x44\xa8\x68\x77\x1f\x22\xec\xc1\x3d\xf9\x04\xda\xa7\x61\x0c\xdb\x9e\x51\x71\x13\x6f\xc4\x15\xed\xcf\x94\xe4\x6b\xef\xbb\x44\xbe\x3d\x03\xf6\xf8\xcc\xc7\xc2\xc7\x6a\x90\x8d\xca\x1e\x6a\x74\x73\x23\x8f\x69\x83\x47\x29\xd6\x93\xc3\xce\xd2\x41\xb7\x95\xa2\x22\x49\xfc\x07\xa9\x30\x16\x86\x7f\x42\x25\xa4\x75\x19\x5c\x70\x6e\x47\x61\xef\x9d\xd4\x2c\x36\xdd\x69\x68\x06\xe9\x80\xe8\x7d\x2f\x14\x29\x4d\xda\xa6\x1a\x50\x05\x15\x6a\x16\x5b\xa6\x62\x02\x0f\x2b\xe3\x82\x46\x5c\x48\x54\x05\xc1\x32\xbe\xc3\xf5\x78\xb2\x25\xbd\xe3\x2b\x3d\x0e\xca\x75\x4b\x5e\x1b\x4d\x6c\xb4\x5a\xc3\x98\xdf\x8d\x9f\x6e\x2a\x0e\x72\xf3\xc0\xcb\x64\xa8\x67\xa3\x83\x24\xbf\x8d\xc3\x92\x16\x8e\x71\x08\xab\xe0\x30\x70\x8e\xae\xef\x01\xd0\xab\xc6\xb9\x8c\xce\xa9\x5c\x80\xd4\xae\x5e\x2c\x64\xce\x21\x69\x72\xda\x18\xbb\x02\x29\x4e\x24\xcf\xeb\x63\x0a\x78\x17\x68\x51\xe7\x34\xb3\x81\x9c\xf3\xa2\x4d\x68\xec\x3a\xff\x45\x5f\xfb\xb8\x84\x70\x8d\xf3\xe1\xb2\xd1\xd3\x0c\xd3\xee\xe0\x72\x27\x41\x4e\x6e\xbc\xd0\x85\xb0\x45\x24\x05\x39\x3a\xe9\xf3\x6f\x1e\x34\xa7\x85\x4e\x46\x5f\x67\x7c\xb7\xb2\xc7\xcf\xdd\xbf\x47\xed\xe1\x6d\x1a\x5e\xb4\x70\x90\x60\x09\x0d\xb5\x76\xde\xd6\x8c\x78\xc1\x3b\x8b\xf7\x03\x6f\x4f\xe7\x0d\xf9\xf9\xe4\x05\x82\x68\x98\x1f\xe4\xac\x14\x6b\x12\x33\xb2\xaa\xf3\x35\x50\x72\x9d\x73\x49\xde\x18\xc5\xb2\xc3\xdf\xf2\xfe\xb3\xe8\xad\xc4\x7b\x04\x61\xe7\xd2\x5b\x61\xd7\x1d\xb6\xdd\xae\xc8\xbb\xb1\x64\x8b\x7c\xf0\xb4\x29\x1f\xc9\xdf\xb5\xe1\x14\x29\x00\xb4\xf7\x04\x0a\x45\xb1\xa5\x29\xe4\x62\x4d\xc6\x24\xba\xa6\x5b\xdc\xdf\xed\xe7\xd6\x8e\x52\xf5\xb5\x2c\xf0\xb4\xc3\x8e\x93\xd1\x61\xf2\xee\xd9\xe0\xe1\x77\x89\x9a\x54\x0e\xee\xce\x39\xec\x64\xc1\xf8\x8f\x9d\x6f\x22\x0f\x0c\xbf\x13\x0a\x2a\x8b\x0b\xf9\x65\x12\xc2\x79\xf6\xff\x89\xc4\x94\x78\x9a\x10\x51\xd3\x72\x20\xa0\xd6\xf2\xef\x75\x4c\x5b\x7c\xbc\x7e\xff\x9f\x70\xf5\x3d\x8f\x26\x48\xa2\x72\x5c\x09\xc7\x64\x9d\x53\xe4\x55\x59\x73\x2f\x0b\xda\xea\x57\x31\xa9\xd8\x68\x50\x5a\x6c\xd2\x26\x41\x2c\xfa\xda\xea\x90\x4e\xe8\xe4\xb2\x92\x62\x29\xe4\x82\x75\x04\x39\x82\x42\xb7\x9f\x55\xc2\x39\x9a\x9e\xcd\x7f\x90\x21\xfe\x44\x28\x17\x03\x9b\x72\x2e\x75\x2b\x4e\x11\x01\x56\x4d\x5f\xe8\x33\x6c\x60\x8c\x89\x3a\x82\x9f\xa6\x77\x84\xd2\x3d\xf9\x6b\x2c\xb8\x60\x6b\x45\xba\xdc\x6d\xe0\xdb\x66\x30\xe7\xa4\x9b\x6a\x9d\x8b\x26\x17\x8d\xa0\x38\x41\x93\x32\x3c\x61\xd5\xa4\xee\x19\xd5\x52\xdc\x61\x6b\x2b\x12\x7d\x8d\xee\xf0\x20\x83\x1f\xf5\x36\xf9\x9a\xf8\xb5\xb1\x36\x89\x4d\x45\x60\x0f\xb2\x23\x35\xe9\x4c\x14\x28\x73\xfd\x91\xd2\x8e\x44\x6c\x10\xf0\xdd\xd9\xff\x83\x29\xa9\x6a\x8f\xa2\x98\x50\xec\xcf\x63\x50\xfa\x15\xda\x34\xec\xfc\xec\x0d\xbc\x8d\x5a\xd7\x58\xf8\xcd\xd9\x59\xa0\xe6\x67\x14\xce\xe8\x98\xa2\xa4\x13\x24\x53\x53\x7e\xb7\x90\xb9\xa0\x10\x7c\x43\x5c\x72\xde\x57\x31\x07\xb9\x30\x35\x65\x49\x03\x9a\x5e\x96\x08\x42\x29\xe3\x3d\x46\x7a\x76\xb3\x99\x61\x43\xd2\x7e\x5e\xc3\xab\x24\xb3\x6a\x0d\x62\x11\xb2\x65\x71\x82\xb8\x30\x36\xd3\x7e\xa6\x2f\xa6\x17\x3c\x6a\x85\xa2\x40\xfb\x9a\x49\x79\xc1\xc1\x43\x11\xec\xb0\x5c\x40\xda\x11\x04\x5a\x27\x2b\xf0\x8d\x55\xbb\x2c\xb0\xac\x8c\x47\x9d\xaf\xc7\xa3\x3d\x3b\xf8\x18\x55\xab\x0e\x78\x66\xff\x22\xed\xfe\x41\x54\x9d\xf8\x60\x67\xe6\x21\x17\x9a\x76\x63\xca\x17\x1a\xbb\x14\x74\x48\xc0\xe3\xd2\xc9\xe0\xcf\x08\xaf\xf8\x24\x8e\x9f\x86\x58\xef\x75\x47\xd9\x92\xcf\x4f\xae\x59\x13\x06\x3a\x5a\x35\x7a\x16\x04\x4a\xe7\x6c\xd4\xc5\x39\xec\xbd\xcc\xf1\x2b\x34\x75\xa0\xea\xb3\x94\xb4\x3e\x5e\x39\x9f\xb0\x08\xa6\x80\x26\x6e\x1a\xda\x62\x52\x53\xda\x2f\x1d\x4b\x66\x70\xe5\x5a\xe5\xc1\xa9\x55\x4e\x06\xd2\x5e\x6b\x7c\xa4\x09\x08\xe5\x57\xa6\x5e\xae\xc0\x99\x12\xdb\x17\x7c\xca\x42\xbb\xec\x01\x44\xda\x5b\xde\xa4\x43\x82\xae\x06\x69\x0f\x7b\x44\x45\x3e\x86\x95\xe4\x10\x12\x3e\x20\x6a\x6f\x4a\xe1\x65\x4e\x3b\x30\x6b\xb6\x4e\x65\x65\x29\xac\xa4\xfd\xa4\x3d\xea\x02\x0b\xca\x9a\x35\xa7\xcb\xd0\x0a\x7b\x64\xb9\xd1\x0b\xb9\xac\xe3\x5a\x45\x73\xf4\xbd\xf3\x98\xe2\xa9\xbc\x8b\xc9\x68\x89\xd6\xfd\x8a\x80\x76\x27\xcf\xd9\x5f\x0d\xf5\x8f\xe4\xe5\xb8\x39\x45\xee\x25\xcc\xc3\x93\xc8\x53\x14\xf9\x8a\xd9\xda\x04\xb1\x81\xe7\x19\x5c\xe8\x10\xcf\xb6\xcb\x6e\x47\x2b\xc4\xa9\x1f\xc7\x45\xc8\xa3\xff\x38\x6e\x87\x4e\x60\x5e\xfb\xde\xab\xe8\xc4\xe7\x42\x1b\x4d\x3c\x6b\x73\x16\x4c\xf9\x8c\x62\x75\xd2\xbc\x69\x97\xb1\x4f\xd4\x35\x4f\xe4\x6b\xd1\x96\x64\x5b\xd5\x11\x45\x98\x76\x8c\x56\x3a\x2f\x0c\xa6\x9d\x18\xef\x39\x60\x4a\x93\x26\x1b\x1e\x23\xf5\x1f\x35\x7c\x88\x68\x0b\xb8\xbc\xbe\xf9\xaf\xf7\x17\x7f\x78\xf7\xfe\x45\x58\xdf\xc0\xe7\x9e\xa5\x53\xcd\x83\x46\xfb\xb9\x09\x4a\x8e\xe4\xfc\xfb\x98\x19\x4c\x68\x17\x58\x85\xad\x90\x32\xaa\xe9\xe8\xf4\x6a\x01\x17\xef\xdf\x37\xe3\xa2\xb9\xa2\x4a\x07\x58\x89\x7b\x0c\x9e\x53\x38\x40\x29\x26\xbd\xe3\xa0\x44\xc9\xa5\xb0\x73\xb1\xa4\xb4\xad\x52\x31\x94\xba\x5a\xf4\x46\x72\x35\x81\x16\xcb\xe0\x8a\x88\x8e\x6a\xa4\x19\x51\xd3\xee\x46\x4d\x26\x95\x97\x97\x2e\xac\xcf\x0b\xc4\x03\x45\x92\x34\xe9\x7a\x5f\x92\xfc\xd2\xe7\x9d\x87\x91\xeb\xe4\x91\xd3\x41\xaf\x25\x29\xbe\x5d\xa1\x45\xc8\x1b\x7e\x96\xc4\x46\xf6\xdb\x8c\xa6\xd4\xb1\x16\x4b\xd2\x59\xed\x24\xd9\xe8\xab\xb3\x30\x3d\x16\x7c\xec\x31\xae\x0d\xb9\x51\xb3\x42\x24\x49\xb2\xa4\xbe\xc8\xbf\x32\xa0\xd0\xc3\xda\xd4\xed\xe9\x15\x01\xf8\xa0\x5b\x17\x9f\xf7\x63\xef\x49\xb3\x61\x23\xd3\xd8\x49\x6c\x77\x45\xf4\x0e\x23\xe7\xb5\x9f\x80\xb1\xc4\xaf\x54\xea\xc2\xe6\xad\x98\x80\x23\xda\x12\x8d\xd8\xed\xe8\x6c\x2b\x26\xe6\x7e\x6a\x1c\x13\xf3\x1d\xae\x08\x78\x84\x80\x17\x9f\xae\x52\x3d\x40\x72\x5a\x63\x70\xde\xd4\x52\x3c\x63\x5f\x85\xdf\xb9\x32\xf9\x1d\x73\x8a\x8f\x17\x9f\x0a\x22\x09\xba\xa5\xca\x81\x8b\xeb\x4b\x90\x31\x81\x48\xb3\x35\x9e\xfb\x78\x61\x2c\x52\x51\x92\x2e\xd2\x0a\x63\x58\x48\x2d\x94\xfc\xb9\x91\xff\xf6\xb3\x56\x4e\xe3\x9e\x6b\x4f\xd7\xef\x70\x3d\x0d\x51\x41\x08\x2c\x6b\xed\xa5\x4a\xc5\x11\x49\xca\x38\xdd\x58\x9a\xfb\xcd\xa3\xa4\x85\x50\x74\xae\x7e\x6b\x38\x09\xdc\x6a\x48\xca\xc1\x93\xce\x02\x8d\x58\x38\x18\x87\x55\xc7\x50\x51\x89\x90\xeb\x92\x9e\xe1\x9b\x80\x21\x59\x79\x90\x0e\xe1\xbb\xf3\x73\x78\xf5\x67\x5d\x59\x93\xa3\x73\x1c\xb3\xbe\xd3\x5e\xfa\xf5\xeb\x46\x35\xa4\x38\xea\x38\x76\xcd\x8d\x51\x28\xf4\xc1\xb1\xed\x36\xfd\x3a\x3e\x6d\x90\x8b\xb5\x4b\x53\x15\xf2\x24\x65\xf0\x54\xc8\xb7\x4b\x35\x1e\x81\x39\x14\x6f\x6c\x0a\xfe\xb7\x0e\x05\xf6\x96\x46\x3c\x63\x93\x1d\xf6\x3e\x77\xa1\x7e\xdd\x29\x45\xd8\x8b\xfa\x4b\x3a\x5f\x4f\xc4\xa8\x96\x4f\xe4\xe5\x9f\xaf\x2e\x5f\x16\x9f\x5a\xbe\x18\x83\x92\x8f\x75\x08\xa3\x69\x47\x89\x1f\x1c\x46\x92\x73\x70\x00\x09\xc3\xc1\x01\xb5\x3c\x34\xc1\x11\xc1\xce\x63\x87\x08\x8f\x4e\xb2\x5d\xc1\xb3\x9b\xa9\x54\xc0\x23\x17\x29\xe0\x33\x8b\x5e\x11\xcf\x1c\x57\xe2\x5e\x1a\x9b\x9e\xc7\x14\xf5\x37\xdf\xc0\x04\xfc\x54\xe8\x62\x1a\x8a\x0b\x4f\x46\x5f\x67\xb9\xe7\xc6\x78\x3a\xa6\xaa\x66\xa3\xa3\x24\xfc\x0f\x69\x3c\xc5\x08\x22\x09\x39\x15\x93\xd1\xc9\xa3\x32\xe4\xf0\x87\x2c\x6b\x2c\x21\x41\x9d\x8b\xca\xd5\x74\x0c\x10\x6d\x11\xeb\xe1\x14\x8d\x91\x8f\x86\xe9\xdc\xff\x7f\xff\xfb\x7f\x5c\x0b\x51\x25\xf5\x12\x4a\xcc\x57\x42\x4b\x57\x66\xa3\xe7\xb9\x27\x61\xc1\xcf\xb8\x38\x34\x68\x03\xd9\xb7\xe9\x9b\x9d\xc8\x36\x80\xa6\x6c\x9e\x9d\xc6\x54\x4e\xde\x44\xc0\x94\x39\xf4\xb0\x32\xaa\x70\x5b\x01\xa8\x17\x52\x35\xe7\xf6\x69\x66\xe9\xda\x14\x29\xad\xc2\xb1\x33\xe9\x3a\x77\x9a\xce\x7c\x98\x7e\x61\xa5\x75\xcb\x8f\xec\x52\x78\xc1\x39\x32\xca\x88\x11\x51\xc9\xd4\x93\x54\x8a\xa3\xed\xdc\x71\x94\x7c\x9a\xab\xf7\x72\xce\xde\xd1\x8a\x8e\x7e\x59\xd2\xb8\x8c\xf9\x69\xf0\x9d\x5c\x51\x7a\x67\x81\x96\xd6\x20\x42\x0b\xa8\x24\xe6\xd8\xab\x44\x4d\xc9\xcb\xf8\x90\x76\xa5\x4d\xa1\x67\xf4\x3d\x62\x62\x2a\x26\x12\x63\x50\x00\x82\xa2\x57\x59\xc0\xff\xbf\xf9\x78\x7d\xfa\x47\x13\xe3\x18\x91\x93\x67\x15\xca\x01\xe9\x40\x70\x02\xae\xa6\xe2\x2b\x97\x94\xcc\x0d\xbd\xc9\x4a\xa1\xe5\x02\x9d\xcf\xe2\x6c\x68\xdd\x0f\xe7\x3f\x65\xf0\xbd\xb1\x80\x5f\x44\x59\x29\x9c\x34\xce\xe9\xc6\x21\x17\x09\x30\x23\xd3\x7c\x9b\xf2\x02\x02\x2a\x93\x22\xbe\x07\x06\xd6\x53\x7a\x98\xea\xb2\x62\xa8\xad\xe4\x1d\xce\x60\x4c\x12\xd7\x59\xfa\x1f\xa4\xe0\xff\x39\x86\x57\x0f\x1c\x78\x8d\xe9\xcf\x71\x20\x5d\xe3\x68\x75\x2b\x0d\xdb\x85\x79\x4b\x78\x2b\x97\x4b\x2e\x08\xa3\x97\x48\xae\xc9\x6b\x0a\x5c\x24\x95\xae\x77\x06\xeb\x98\xed\x69\x92\xa4\x5b\x80\xfc\x70\xfe\xd3\x18\x5e\xb5\x5f\x10\x5e\x94\x11\xc6\x2f\x70\xde\x04\x9a\x95\x29\x5e\xc7\x84\xbd\x5b\x6b\x2f\xbe\xd0\x9c\x39\x65\x0c\x74\xc8\xc9\x7a\x13\xe2\x60\xce\x5f\x3d\xa0\x52\xd3\x90\x49\x29\xe0\x21\x54\x22\x24\x52\x92\x58\x08\xa8\x84\xf5\x3d\x91\xc8\xe0\xf6\xe3\xe5\xc7\x59\xa0\x23\xb1\x6d\xa9\x53\x7e\x97\xa3\x80\x98\x61\x6e\x32\x16\x04\x48\x1d\xcf\x39\x0d\x90\x8e\x5b\x36\x01\xde\xa2\x26\xaf\x2d\x3b\x79\xc9\xfd\x70\x8c\x5b\xfa\x4b\x73\x4c\x9f\x44\x80\x63\x9c\xd3\xaf\x72\x4f\x9f\x57\x6a\x46\x60\xb9\xd3\xe3\xdc\xd6\x27\xe3\xfb\x48\x0e\x71\x3f\xd2\xfc\xe1\xbf\x02\x73\x5a\xc7\x9d\xbe\x28\xe2\xc9\xf4\x7e\x9d\x7d\x3a\xb9\xd9\x34\xe1\x71\x1e\xd2\x69\xc1\xa3\xd9\x0e\xc5\x4b\x51\x04\xd5\x2b\xf4\xfa\x9b\x6f\x10\x22\x6f\x6d\x49\x15\xad\xa7\xd1\xb0\xb3\x1f\xc8\x35\xb6\x8e\xf2\xdd\xeb\x17\xa5\xe7\x11\x31\xd0\xd7\x44\x41\x2f\xb2\x6d\x8e\x89\x8e\x9e\x80\xec\x23\xf1\x42\xfa\xd9\x5f\xc5\xb1\x93\x1c\x63\xf6\xcc\xa2\x81\x0a\xd9\xa2\xd6\x75\xa4\xb9\x5a\x7b\x9f\x2b\x53\x17\x53\xba\x93\x97\xfc\x43\x08\x13\x85\xda\x37\x2d\xd5\xa4\xeb\x2f\xb7\xe7\x94\x25\x79\x17\xd1\x7c\x7c\x42\x5d\x90\x95\x62\x7f\x82\x4f\x1f\x2f\x29\x03\x4f\x47\x5e\xc5\x2c\xd8\xc0\xf6\xd4\x3c\xe6\x7d\xd3\x7b\x32\x41\xe9\xa2\x11\x9b\xac\x36\xc5\xc3\xd9\x26\x7a\x2f\xa2\x81\x4a\x7e\x5c\x06\x37\x0f\x92\xce\xc8\xbc\x01\x42\xf5\x06\x73\x8b\x7c\x15\x2e\x1b\x1f\x41\xee\x47\xf9\x52\xf4\xe6\x7c\x02\xe1\xfb\xc0\xec\xba\xfe\xe0\x18\xd4\x58\xe3\x4c\xd9\xb6\x5d\xec\x89\x2c\xf8\x6a\x0e\x3c\x9f\x06\x47\xc8\xe5\xc1\x1b\x21\x3b\xa9\xf3\xc2\x37\x43\x8e\xba\x21\xf2\x04\xac\x17\x42\xaa\xda\xe2\xa5\x21\xd1\x3e\x12\xa5\xef\xbb\xdf\x24\xa4\xe2\x44\x50\xf0\x4c\xdd\x18\xbd\xc9\x5f\xa6\xeb\x0d\x52\x67\xe1\x98\x88\xcb\xf1\x42\x85\x6d\xe2\x69\x6f\x6e\xd7\x2d\x1a\x8a\x85\x13\x91\x03\x91\x56\xd9\x73\x70\x97\x7a\x61\x45\x53\xab\x74\x30\x66\xed\xe1\x7f\xb5\xf9\x5d\x8a\x5b\xe3\xe1\x5a\x6b\xb4\x42\x2c\xd0\x2f\x7a\x03\xc3\xe5\x2e\xe1\xf0\x46\x6f\x00\xd1\xc4\xb8\xd9\xe8\x79\xd1\xe3\xb1\x91\xe3\xcb\x44\x8d\x47\x10\xfb\x09\xd1\xe2\x10\x29\x0e\x91\xe2\x2f\x2f\x52\x3c\x72\x0f\x3c\x1e\x21\xfe\x92\xa2\xc3\x23\x91\x7e\x3c\x2a\xfc\x37\x8e\x08\x9f\x80\xe3\x11\x91\xe0\xbf\x79\x14\x78\x24\xb2\x1b\x51\xdb\x6c\x34\x44\x7e\x6d\xe4\x77\x24\x0d\x1f\x8d\xf8\xfe\x6d\xa3\xbd\x97\xf2\xa6\xb5\x29\xf0\xd2\x0a\xa9\x63\x85\xe9\x6c\x74\x1c\x29\xae\x37\xbe\x4b\x3e\xa8\xe7\xee\x1c\xa2\x34\xb5\xe6\xb4\x21\x17\x8f\x36\x57\xcc\x22\x2f\x55\xaa\x96\x75\x54\xc9\x41\x76\xb5\xa0\xa9\x82\x19\x21\x88\xc8\x2c\x51\xc1\x00\x1f\xf7\x47\x93\x2b\x1d\x9c\x4d\xa0\x44\xc1\x05\x23\xcd\x94\x34\x3c\x15\x40\xf2\x2c\xb1\x1a\x99\x60\x12\x7a\x1d\xaa\x57\x95\x2c\x65\x2c\x0d\xcf\xe0\xfa\xe3\xed\xbb\x19\xec\x42\xa0\x2d\x83\xe6\x1a\x85\xbf\x12\x1f\x73\xaf\xc2\xbc\x30\x9d\xd2\x5c\xa6\xf6\x7f\x3d\x19\x3d\x83\x2d\xc9\xbd\xbc\xba\x3c\x92\xd6\x9f\x9a\x0f\x12\x91\xd3\xb1\x69\x3c\xa7\xbb\xba\xdc\x38\x92\x4b\x2e\x6c\x53\x28\x9d\xd6\x8c\xe6\x9e\x9d\xc0\xcd\x3b\xa7\x69\x0c\x5c\x5d\x52\x80\xee\x28\x5c\x8e\x0e\x3f\xd3\x38\xba\x4f\xb9\xb1\x16\x5d\x65\x42\xe4\x9d\xca\x98\xe2\xc2\xbd\xf9\x65\xa7\x9c\x72\xbe\x86\x95\x5c\x52\xd5\xb3\x22\x25\x40\xce\x8c\xab\x4b\x4a\xa0\x9b\x45\x53\xbf\x23\x2a\x99\xc1\xbb\x90\xdf\xa7\x6a\x79\xc8\x85\x63\x47\x27\x05\x1c\x54\x1f\x49\x57\xdd\x93\x47\xd3\xf9\x90\x40\x6e\xb1\x7c\x4b\x65\x17\xd3\xba\x02\x65\x96\x32\x4f\x3e\x44\xe7\xf3\xdc\x94\x95\xb0\xd8\x00\xce\x9e\x60\x73\xbd\x7e\xc1\x75\x0a\xb5\x6f\xdf\x0a\xdf\xd2\x27\x68\xc8\xb6\xe0\x7a\x89\x74\xfc\xb0\x94\x84\x43\xb8\x39\xf3\xa7\x66\xff\x87\x2b\xfb\x19\xfc\xc7\x0e\x70\x45\xa8\x08\x95\x39\x98\xda\x4f\xcd\x62\xea\x2d\x76\xd8\xb0\x30\x5d\x84\x27\x1b\x8e\x52\x97\xb2\x1d\xbc\x42\x51\x21\x97\xae\x24\xef\x4d\xb4\x53\x92\xde\x49\xa2\xa2\x62\x3d\x5d\x42\x91\xaa\xb1\xb8\x14\xa6\x79\xc3\xa0\xd3\x5a\x74\x85\x43\xf6\x0a\x77\x7e\xef\xe8\xf4\x3c\xd6\xbd\x07\x2f\x8e\x8a\xde\xe8\x3e\x40\x25\xac\xa4\xba\x75\xe9\xa0\xa0\x7a\xb4\x2e\x39\x6b\xdd\xa1\x53\x4b\x5b\x5d\x70\x65\x64\x29\xec\x5d\x2c\x6d\x0d\x45\x3b\x3d\x69\x4a\x11\x6c\xbc\x9c\x42\x28\x88\xdc\xd7\xf1\xa2\x27\x15\x3a\x07\x91\xda\x96\x35\xda\x2b\x14\xab\xb1\xd3\xde\x23\x56\xf7\x6e\x15\x5f\x71\x5f\x88\x9c\xa4\x7a\x97\x70\x25\x5e\x3d\x1e\x1d\x1e\xa1\x03\x62\x84\x77\xa4\x02\x48\xd6\xb9\x5b\xf1\x1a\xa3\xa0\xae\xa8\xc5\x49\x37\xf7\x20\x69\x4c\x1f\xab\x4d\x3b\xf5\xfd\xe9\x36\x4a\x9b\x01\x4a\x98\xb9\x6c\x2f\x58\x8f\xa2\x96\xc4\x72\x37\x66\xd3\x76\xb5\x3d\xef\x23\xd1\xaf\xf7\x95\x54\x4c\x37\x82\xf5\xcf\xb8\x18\x3d\xd9\xf2\xed\x7d\xb9\x1b\xfa\xfd\x50\x4d\x9b\x62\xf9\xd1\x11\xf3\x53\x2c\x5b\x6f\x64\x0c\x7a\x7c\x8e\x99\xb6\x1b\xf4\x14\xdc\xd6\xae\xc7\x70\x33\xe7\xed\xf6\x9c\x5e\x2a\x4d\x43\x8a\xd4\x06\x62\x36\x3a\x28\x77\xb7\xbd\x0e\x11\xcd\xd7\x4d\x8f\x88\xa3\xda\x58\xc5\x8a\xe5\x7e\xcb\x83\x17\xec\x02\x11\x93\x5e\x1f\xa8\x62\x6f\xb9\xc3\xf1\x3f\x20\xb2\xf1\xd3\x70\xd1\xe6\x11\x5a\x8c\xaf\x74\x1b\x95\xc7\x70\x3d\xd5\x98\x0a\x88\xfd\xc7\xe8\xae\x97\x99\x2b\xa4\x4c\x53\x6e\x74\x2e\x55\x6a\x47\x94\x48\x36\x81\xb9\xf1\xab\x94\x62\x8b\x57\x7c\x48\x81\x7e\xdf\x43\xa3\xd1\x4a\x8e\xfa\x43\xf4\x47\xa7\x57\x95\xa9\xb8\xd6\xa4\xb9\x7c\xe5\xea\x3c\x97\x3a\x4f\xfe\x92\xab\xa5\x67\x43\x40\x0c\x88\xca\x96\x69\x68\x2b\x8b\xc1\x19\xa2\xbb\xb7\x52\xe1\xce\xc5\x63\xf2\x00\x04\x94\xe4\xe0\xde\xa3\x9d\x53\xd5\x79\xca\xe1\x74\x27\x57\x66\xc9\x45\x86\x84\xc7\xaa\x2e\x85\x8e\xba\x98\x49\xc7\x69\xf9\xdb\x15\xba\x58\x7e\xdb\xf4\xb4\x8a\x55\xa1\xa4\xcf\x69\x12\x6f\x85\x76\xd2\xcb\x7b\x04\xb4\x96\x94\x3a\x13\xb9\x5b\xd2\x01\x0b\x8a\x9e\xe2\x73\x4b\x77\xb0\x2a\x2e\xcf\x8e\xca\x6d\x21\xbf\x60\xd1\xbf\x43\x11\x3a\x7c\x90\xdb\x06\xaf\x58\xfb\xc7\x3b\x2b\x64\x71\xc5\x12\xdd\xeb\x50\xd4\x9f\x52\x57\xe9\x92\x53\x4c\x93\x9b\x12\xe9\x42\xc1\x92\x78\xbc\xa8\x75\x21\xa8\x64\x81\xa7\x7d\xb0\x26\x99\x89\x4e\x8a\x3c\xdd\xee\x3d\x09\x87\xf8\x10\x9b\x8d\xf5\xab\x61\x36\x3c\xb5\x16\xbb\x74\x35\x8b\x3a\x89\x08\x5d\x0b\x15\x98\x15\xc3\xa2\xae\xcd\x6f\x9c\x24\xf6\x9e\x1a\xd9\x8b\x64\x7b\x48\x37\x2c\xa5\x0e\x25\x18\xe1\x92\x5e\xbc\x1f\x4a\x9d\x06\xd0\xd3\x05\xb2\xa6\x34\x9e\x60\x9d\xf4\xee\x13\x91\x35\xae\xb5\xab\xab\xca\xd8\xce\x75\xbb\x5d\x30\x47\x1c\x83\x4b\xe8\xe4\x5c\xed\x1a\x06\xd2\x3b\x54\x0b\x98\x23\x51\x33\xb7\x32\xb1\xa7\x94\x2e\x51\x87\xd0\xa2\xeb\x63\xe4\xb2\xb3\x28\xd0\x3e\xeb\x4a\x82\xc9\xf3\xda\xa6\x8e\x03\x44\xd7\x66\x8f\x35\x84\x8d\x7c\x70\x29\x1e\x10\x05\xf9\xc0\xc2\x85\x5c\x5a\x93\x82\x8b\xc3\x6e\xd0\x47\x25\x4d\x94\x3f\x8d\x82\xdc\xde\x70\x6b\xe1\x3f\x71\x24\x30\x55\xed\xb3\xf1\x93\xf4\x4b\xad\xd4\x9a\xef\xf6\x63\xf1\x55\x2a\xb7\x51\xb4\xcc\x15\xce\xc5\xa9\x74\xd1\xde\x13\x0e\xcb\xb6\x97\x87\xdb\x94\xac\x74\x7d\x3b\x3d\xff\x36\xea\x37\x99\xa5\x78\x01\x56\x3e\xaa\x48\x3f\x6e\x7d\x40\xb1\x34\xdd\xb3\x70\x3b\x6e\x49\xd1\x93\xd2\x38\x4f\x0a\x15\xb5\x57\xeb\x66\xc1\x63\xf1\xf9\xed\x77\x4f\xc2\xa7\xd7\xb9\xf0\x89\xcc\x22\x5b\xd8\xb0\x6c\x97\xd9\x83\x8b\x86\x3b\xd2\x75\x5b\x45\xf1\xaa\xf0\x90\x2a\xe7\xc9\xf3\x6e\xcf\x2c\xd3\x99\x0d\xa9\x07\xea\x8d\xc0\xe6\x75\xfc\x92\x4c\xb4\xc7\xe1\x9b\xc8\x92\xc2\xd0\x3d\x8c\xd9\x96\xdf\x97\x84\x35\x79\x5c\x4f\xe9\xfa\xd4\xdc\x25\x89\x57\x18\x36\x9a\xfc\x04\x13\xc0\x6f\xa2\x79\x0b\xe0\x91\xbe\x10\xf7\x46\x92\x46\xf0\xd6\x90\x9e\x64\xb9\x9c\xaf\xe3\x0d\xbf\x58\xd3\x18\x3f\x4a\x86\x39\x4d\x45\x59\xfa\x38\x51\x5c\x97\x02\xa9\xf5\xb4\x12\x56\x94\x31\xe5\xde\x49\x1f\x81\x98\x53\x8c\xd4\x87\xcd\x1d\x59\x48\xcd\x5f\xb9\xc7\xdb\x38\xed\xd5\x56\x3b\x7d\xd6\xad\x87\x81\xc3\x33\xbe\x22\x12\x1e\x78\x63\xc5\x12\x67\xe1\x3a\x46\x78\x54\xcf\x53\x5a\xb1\x11\x28\x8e\x4e\x5b\x8e\x31\x98\x89\x41\xfd\xce\xa1\x09\xf6\x66\x2c\x51\x3d\x09\x5e\x1a\x4a\xa7\x2e\x49\xb6\xda\x81\x3c\xc1\xe6\xd0\x9d\x7d\x5d\xa3\x27\x0e\xff\xf8\xe7\xd0\x7e\x76\x68\x3f\xbb\xa7\xfd\xec\x77\x43\xfb\xd9\xa1\xfd\xec\xd0\x7e\x76\x68\x3f\x3b\xb4\x9f\x1d\xda\xcf\x0e\xed\x67\x87\xf6\xb3\x43\xfb\xd9\xa1\xfd\xec\xd0\x7e\x76\x68\x3f\x3b\xb4\x9f\x1d\xda\xcf\x0e\xed\x67\x87\xf6\xb3\x43\xfb\xd9\xa1\xfd\xec\xd0\x7e\x76\x68\x3f\x3b\xb4\x9f\x1d\xda\xcf\x0e\xed\x67\x87\xf6\xb3\x43\xfb\xd9\xa1\xfd\xec\xd0\x7e\x76\x68\x3f\x3b\xb4\x9f\x1d\xda\xcf\x0e\xed\x67\x87\xf6\xb3\x43\xfb\xd9\xa1\xfd\xec\xd0\x7e\x76\x68\x3f\x3b\xb4\x9f\x1d\xda\xcf\x0e\xed\x67\x87\xf6\xb3\x43\xfb\xd9\xa1\xfd\xec\xd0\x7e\x76\x68\x3f\x3b\xb4\x9f\x1d\xda\xcf\x0e\xed\x67\x87\xf6\xb3\x43\xfb\xd9\xa1\xfd\xec\xd0\x7e\x76\x68\x3f\xfb\xdd\xd0\x7e\x76\x68\x3f\x3b\xb4\x9f\x1d\xda\xcf\x0e\xed\x67\x87\xf6\xb3\x43\xfb\xd9\xa1\xfd\xec\xd0\x7e\x76\x68\x3f\x3b\xb4\x9f\x1d\xda\xcf\x0e\xed\x67\x87\xf6\xb3\x43\xfb\xd9\xa1\xfd\xec\xd0\x7e\x76\x68\x3f\x3b\xb4\x9f\x1d\xda\xcf\x0e\xed\x67\x87\xf6\xb3\x43\xfb\xd9\xa1\xfd\xec\xd0\x7e\x76\x68\x3f\x3b\xb4\x9f\x1d\xda\xcf\x0e\xed\x67\x87\xf6\xb3\x43\xfb\x59\x6e\x3f\xdb\x3e\xf9\x45\x75\x9f\x6d\x7d\x72\xfa\x3f\xe3\x57\x1e\x0b\xca\x7b\x47\xc0\x29\xf9\x3e\x83\x71\xd0\xf9\x95\xaa\xad\x50\xf1\xcf\x9c\xfe\xef\x3b\x24\x0b\x6e\x06\x3f\xfc\x34\x82\x78\x40\x1a\x03\x35\x37\x83\x1f\x7e\x1a\xfd\xdf\x00\xb4\x23\x0d\xc7\x2a\xaf\x00\x00")
 
 func clusterApiClusterXK8sIo_machinesetsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -183,7 +184,7 @@ func clusterApiClusterXK8sIo_machinesetsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _clusterApiInfrastructureClusterXK8sIo_awsclustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xdd\x72\xdc\x36\xd2\xe8\xbd\x9f\xa2\x6b\xcf\x85\xed\xaf\x34\x54\x6c\xe7\xec\xc9\xaa\xea\xd4\x96\x2c\x39\x9b\xa9\xc4\xb6\xca\x52\x9c\xaa\xec\xee\x05\x86\xec\x99\xc1\x8a\x04\xb8\x00\x28\x79\x9c\xca\xbb\x7f\x85\x06\xc0\xbf\x21\x39\x18\x8d\xbd\x71\xb2\x33\xb9\x88\x45\x02\x60\xa3\xd1\x7f\x68\x74\x37\x58\xc9\xdf\xa3\xd2\x5c\x8a\x33\x60\x25\xc7\x0f\x06\x85\xfd\x4b\x27\xb7\xdf\xe8\x84\xcb\xd3\xbb\x67\x8f\x6e\xb9\xc8\xce\xe0\xa2\xd2\x46\x16\xef\x50\xcb\x4a\xa5\x78\x89\x4b\x2e\xb8\xe1\x52\x3c\x2a\xd0\xb0\x8c\x19\x76\xf6\x08\x20\x67\x0b\xcc\xb5\xfd\x17\x40\x9a\x57\xda\xa0\x4a\x3e\xcc\xea\xa1\x58\x5e\xae\xd9\xd7\x67\xe0\xff\xf5\xe2\x11\x00\x13\x42\x1a\x66\x07\x0a\xdd\xa4\x30\x4a\xe6\x39\xaa\xd9\x0a\x45\x72\x5b\x2d\x70\x51\xf1\x3c\x43\x45\x63\x04\x60\xef\xbe\x4a\x9e\x27\x7f\x79\x04\x90\x2a\xa4\xee\x37\xbc\x40\x6d\x58\x51\x9e\x81\xa8\xf2\xfc\x11\x80\x60\x05\x9e\x01\xbb\xd7\x1e\x12\x9d\x70\xb1\x54\x4c\x1b\x55\xa5\xa6\x52\x98\xf4\x21\x7c\xa4\x4b\x4c\x2d\x14\x2b\x25\xab\xf2\x0c\x76\x35\x77\x9f\x08\x70\x33\x83\x2b\xa9\x78\xf8\x7b\x16\x10\x30\x63\x25\xa7\x27\x0e\x8f\xe7\x3f\x5d\x5f\xb8\x17\xf4\x30\xe7\xda\x7c\xdf\x7b\xf1\x03\xd7\x86\x5e\x96\x79\xa5\x58\xde\x99\x03\x3d\xd7\x5c\xac\xaa\x9c\xa9\xf6\x9b\x47\x00\x3a\x95\x25\x9e\xc1\x1b\x0b\x54\xc9\x52\xcc\x1e\x01\x78\x84\x11\x50\x33\x8f\x12\x8f\xfe\xe7\x6e\xac\x74\x8d\x05\x73\x30\x03\xc8\x12\xc5\xf9\xd5\xfc\xfd\x8b\xeb\xce\x63\x80\x0c\x75\xaa\x78\x69\x08\xf9\x0d\xa8\xc0\x35\x98\x35\x82\x6b\x0e\x4b\xa9\xe8\xcf\x16\xc0\x70\x7e\x35\xaf\x47\x29\x95\x2c\x51\x99\x1a\x49\xee\xd7\x22\xc2\xd6\xd3\xde\x37\x1f\x5b\xb0\x5c\x2b\xc8\x2c\xf5\xa1\xfb\xb2\x9f\x20\x66\x7e\x26\x20\x97\x60\xd6\x5c\x83\xc2\x52\xa1\x46\xe1\xa8\xcb\x3e\x66\x02\xe4\xe2\x5f\x98\x9a\x04\xae\x51\xd9\x8e\xa0\xd7\xb2\xca\x33\x4b\x74\x77\xa8\x0c\x28\x4c\xe5\x4a\xf0\x8f\xf5\x68\x1a\x8c\xa4\xcf\xe4\xcc\xa0\x36\xc0\x85\x41\x25\x58\x0e\x77\x2c\xaf\xf0\x04\x98\xc8\xa0\x60\x1b\x50\x68\xc7\x85\x4a\xb4\x46\xa0\x26\x3a\x81\xd7\x52\xa1\xa5\x25\x79\x06\x6b\x63\x4a\x7d\x76\x7a\xba\xe2\x26\x30\x58\x2a\x8b\xa2\x12\xdc\x6c\x4e\x89\xf2\xf9\xa2\x32\x52\xe9\xd3\x0c\xef\x30\x3f\xd5\x7c\x35\x63\x2a\x5d\x73\x83\x44\x83\xa7\xac\xe4\x33\x02\x56\x10\xcb\x24\x45\xf6\x7f\x94\x67\x49\xfd\xb8\x83\x3c\xb3\xb1\xb4\xa0\x8d\xe2\x62\xd5\x7a\x41\x44\x38\x81\x65\x4b\x8b\x76\x51\x99\xef\xea\x66\xd1\x20\xd3\x3e\xb2\xf8\x78\xf7\xea\xfa\x06\xc2\xa7\x1d\xc2\x1d\x6e\x9b\xa6\xba\x41\xb3\x45\x11\x17\x4b\x54\xae\xe5\x52\xc9\x82\x46\x41\x91\x95\x92\x0b\x43\x7f\xa4\x39\x47\x61\x40\x57\x8b\x82\x1b\xbb\x7e\xff\xae\x50\x1b\xbb\x02\x09\x5c\x90\x9c\x80\x05\x42\x55\x66\xcc\x60\x96\xc0\x5c\xc0\x05\x2b\x30\xbf\x60\x1a\x3f\x3b\x92\x2d\x36\xf5\xcc\x22\x2f\x0e\xcd\x6d\xa1\xd8\x6f\xec\xf0\xd4\x7a\x11\xc4\xce\xc8\x9a\x34\xdc\x76\x5d\x62\xda\x21\xfe\x0c\x35\x57\x96\x58\x0d\x33\x68\x49\xbc\x27\x5d\xa6\xf9\x8e\x78\x2f\xcb\x48\x8c\xb3\xfc\x86\xad\xb6\xde\xb6\xdf\x5f\x8d\x8e\x31\x81\x87\xa1\xe9\x74\xbe\x48\xb4\x26\x40\x96\xee\x11\x68\x34\xc4\xc1\xf6\x95\x91\xf6\xf3\xf6\x7f\xe7\x3f\x5d\xd7\xc4\x66\xa9\x49\xb0\x15\x66\xb0\xd8\x10\x12\xec\xcb\x52\xc9\x3b\x9e\xa1\x3a\x01\x2e\x6a\x98\x03\xef\x4a\x8b\x2e\x96\x65\xae\x4b\x86\x4b\x56\xe5\x26\xd9\x82\x72\x64\x75\xa0\x51\x4a\x57\x39\x13\xf8\x83\x64\xd9\x4b\x96\x33\x91\xa2\xda\xc6\x44\x67\xaa\x17\x23\xdd\xec\xa4\xeb\x19\xa7\x52\x2c\xf9\xaa\x52\x4e\x4c\x59\x09\x9a\x92\xaa\xe5\x1f\x2d\xaf\xf9\x2f\x43\x69\xc7\x80\x05\xae\xd9\x1d\x97\x6a\xeb\xb3\xe3\x0b\x0c\x41\xc6\xe3\xd0\x9b\x1e\xc0\x24\xc6\xd1\x2e\x82\xa3\x2f\xd7\xd1\x89\x54\x84\x5c\xb2\x0c\x16\x61\x0e\x4f\x3c\x22\x69\x9d\xe6\x24\x17\xd1\xcc\x96\x2c\xe5\x62\xf5\x74\xf0\x53\x93\x34\x32\x81\xfd\x8c\x6b\xb6\xc8\xf1\x25\xd3\x16\xca\xef\xa4\x36\x3b\xf0\x7e\xb9\xd5\xa1\x4f\x66\x3d\xa4\x73\xcc\x89\xce\x4a\x85\x96\xeb\x9d\x38\xf2\x86\x05\x69\x0e\x58\xb8\xb1\x60\x4d\x83\x09\x6d\x2c\x12\xc6\x68\x68\x21\x65\x8e\x4c\xf4\xde\x0a\x34\xf7\x52\xdd\x5e\x6f\x71\xfb\x16\xfc\x6f\x9a\x96\x80\x22\x65\xa5\xae\x48\xff\x00\xcb\x73\x2b\x41\xc5\xca\x8a\x47\xfb\xa8\xe6\x0e\x3f\xf8\x36\x44\x3b\x28\xa3\x5a\x08\x34\x83\xaf\xfa\xa4\xe1\x5a\x76\x31\xb7\xfd\x39\xfb\xe3\x06\x8b\x91\x21\x07\x07\xa5\x79\x86\x71\x91\x16\xca\x4e\xc9\xbd\x1c\xfe\xc4\xae\x79\xb9\x1f\xbb\x63\x3c\x67\x0b\x9e\x73\xb3\xf9\x59\x8a\x11\x0e\x18\x80\xeb\xbc\xd7\xb1\x23\x74\xdb\xa3\xc2\x47\xfb\xd6\x48\xa8\x34\x7a\xeb\x87\x6b\x8f\x55\x2b\x8a\x9c\x5e\x23\x81\xfc\xd8\xae\xd9\x6a\x14\x69\xee\x37\xc9\x22\xe1\x97\xf2\x4c\xbd\xcc\x65\x7a\x1b\x3d\x9f\x8b\xd0\x23\xd8\x6b\x17\xf3\xcb\x77\xb0\xa0\x27\x46\x92\x5a\xd5\x98\xc1\xfd\x1a\x1d\xc8\x41\x9c\x3a\x26\xb0\x4b\x52\x0b\xdd\xf7\x57\x17\x07\x4f\x80\x67\xd1\x90\xcf\x2f\x6b\xdc\x33\xa8\x04\xff\x77\x85\xc0\x33\xab\x99\x97\xdc\x9a\x13\x12\x14\x2e\x51\xa1\x08\x56\x48\x50\x13\x87\x03\xa9\xaf\xaa\x45\xce\x07\x78\x75\x0c\x54\xdf\xa1\x43\x2c\x9e\x14\x98\x05\xbf\x74\xaf\xdd\xa3\x04\xce\x6b\x3a\xd1\xe1\x15\x2d\x00\x77\xd2\x4a\x6b\x99\x72\x62\xf1\x7b\x6e\xd6\xc0\x40\xc9\xca\x20\x18\x2b\xd9\xc0\xac\x99\x81\x35\x8d\xea\x1f\x4b\xcb\x36\xdc\x8b\x61\x58\x31\x83\xf7\x6c\xb3\x1b\x09\xc3\xa2\xaa\xf9\x09\x66\xfe\xe6\xc6\x9a\xc7\x2f\xda\x9b\xa6\xd3\x65\xa0\xb8\x37\xe7\x37\x01\x2a\xe0\xd9\xd6\xf4\x1a\x5c\x25\x30\x5f\x09\x69\xad\x9a\x4a\xe4\xa8\x3b\x68\xe4\x5b\xca\xbf\xa3\xf8\xef\xd7\x3c\x5d\x43\xca\xb4\xa7\x05\xcb\x8a\xd6\x9e\xf0\x44\xdd\x46\xbf\x45\xb5\xc2\x2d\xc8\x14\x6a\x9e\x59\xa3\x7d\x6e\x3c\xe0\xc2\xb1\x86\x91\x90\xa1\x41\x55\x70\x81\x0e\xe5\x9a\xf8\xbd\x54\xfc\xce\xda\x5e\x5e\x90\x06\x9e\xd7\xac\x40\x38\xff\xd9\xae\xfb\xd6\xa7\x0f\xa6\x4c\xfa\xfc\x8d\xa5\x83\x3d\xd6\xe4\x5d\xd3\xa9\x5e\x13\x3b\x10\x19\xf4\x44\x53\xd3\xab\x72\x28\xd0\x66\xd0\xbc\x6c\x7e\xb1\x86\xe6\x9e\x9f\xed\x61\xa1\x36\x39\x21\x95\x79\x8e\x69\x50\xf0\x64\x6f\xba\x96\x8b\xb0\xc5\x89\x97\x24\x83\x56\x4b\x74\x13\xd7\x80\x29\xc5\x36\x03\xef\xef\xca\x11\x09\xd4\x99\xd7\xfb\xab\x8b\x18\xcd\xbc\x5b\x69\x46\xe8\x96\xcf\xa9\x59\xe0\xb2\x65\x54\x3e\xfb\x2a\xa1\xff\x4e\x9f\xfd\x79\x7c\x0d\x22\xa8\x60\x4a\xdd\xf4\x95\x8d\x9f\xc5\x5d\x99\xce\x78\x16\x0c\x5f\x8b\x5d\x12\x28\x35\xf4\xde\x6f\x60\xb5\xbe\x91\x7e\x2e\xcd\x0e\xe5\x30\x68\xbd\x0c\x8f\x10\xbb\x5d\xe0\x7b\xfd\xea\xb9\x34\xf3\xe8\xab\x87\x41\x7e\x9f\x54\xf0\x11\xf0\x4f\x33\xfa\xbe\x6c\x1e\xc5\xe4\x9f\x91\xc5\x23\xb9\x77\xa4\xc1\xc4\x4b\x67\x0b\xee\xd8\x08\xdc\xf8\xed\xed\x3b\x6a\xdc\xb6\x26\x21\xe7\x77\x68\xd5\xcd\xd8\x1e\x64\x10\x65\x5a\xaf\xbf\xc7\xcd\x1b\x36\xb4\x19\xec\x1a\xe6\xd7\xdf\xf9\x86\x81\x8a\x04\x6b\x36\x82\x5a\xaf\xe1\x16\x37\x64\x74\x18\xc3\xd2\x75\xd8\x69\xb7\x77\x49\x7b\x00\x36\xe6\x17\x31\xcc\x54\x3a\xce\x33\x42\x4d\x3b\x96\x97\x5c\x68\x54\x77\x87\x39\x47\x4a\xfe\xca\x7b\xa8\x06\x88\xb4\x0b\xca\xd5\xbc\x6e\xda\x72\x81\x75\xbc\x5c\x24\xd4\xbc\x2b\x2a\xb5\x30\xd5\x3c\xd7\xd9\xe5\x6f\x23\x6e\x74\x43\x35\x06\x42\x1b\x02\x06\x0a\x59\xba\x26\x15\xff\x7d\xb5\x20\x01\x80\xe4\x96\xad\x01\x1b\x22\xff\x5d\xaa\x62\x3d\xb8\x0d\x1f\x00\xcb\xd2\xb0\x6d\xec\x08\x28\x18\x69\xe4\xb8\xb9\x9a\x03\xad\x91\x72\x96\x9a\xba\xe3\x62\x35\xc6\x8a\x3b\x05\x41\x29\x55\x3c\x40\xb6\xf1\xc1\xc0\x58\x71\xba\xc2\x6d\x67\x0c\x90\xef\x92\x2b\x1c\x14\xdd\x33\xc2\xc6\xe0\x0b\x0b\xd5\xa8\x6f\x6d\x52\xc4\x0c\x59\x0f\x9e\x19\x77\x10\xee\xdc\xfb\x32\x82\x68\x6c\xf6\xdf\xe3\x5e\x8e\x69\xda\x60\x59\xa6\x50\xeb\x31\xc2\xe9\x3b\x03\x5d\x5b\x62\x01\xc6\x85\xae\x5d\x7a\xe1\xf3\x6d\x25\x55\x0f\x7d\xa8\xe3\xe1\x35\x4b\xd7\x5c\xa0\xff\x7c\xf3\x71\x2e\x96\x52\x15\x8d\x2b\x8e\x24\x9f\xcc\xf0\xb1\x0e\xdf\x3e\xc8\x1f\xe1\x86\x88\xb6\xd9\x2d\xa5\x16\x0e\xd2\x5d\x9f\x87\x68\x3b\xdc\x36\x8a\x05\xe0\x75\xf7\xe3\xd4\xf9\x04\xa4\x20\x71\xfa\x9d\x67\xea\x13\x78\xf5\xc1\x9d\x8a\xcc\xaf\x40\x2a\x6f\x88\xd8\xbf\x0e\x04\x76\x8a\x8b\x80\x18\xc6\xc3\x35\xfa\xde\x7e\xe4\xf3\x98\xe5\xb8\xd0\x6f\x4b\xc3\x0b\xfe\x71\x0c\xbe\x1e\x97\x65\x24\xf2\xb5\xb5\x84\xcd\x1a\x95\xb7\xc7\x3c\x89\x7b\x7f\x30\x8d\x46\x74\x77\x5e\xb0\x8f\x52\xc0\xab\x97\xd7\x30\x3f\x7d\x3b\x8c\xc7\x5d\x1b\x78\x14\xec\xba\x2a\xc7\xc5\x62\x57\xe1\x97\x98\xf2\x25\x6f\x01\x88\x62\x6d\x61\xcb\x82\x6b\xd1\xda\x4c\xa4\xb0\x5e\xbd\x39\xb7\x00\xa3\xb0\x2a\x25\x7b\x18\x6c\x9c\x15\x57\x4a\x2e\x79\x1e\xe3\x95\xbe\xe9\x99\x1f\xf3\xf3\xd7\x0d\xea\x4a\x37\xcc\xa0\x29\x1b\x1a\x9d\x00\x5f\x02\x2b\xcb\x9c\xa7\x16\xe6\x29\x90\x47\x09\x72\x6c\x13\xb1\xab\x5b\xc1\x56\xa3\xdb\xf4\xad\x59\xce\x2f\xc3\x1c\xcf\x5f\xcf\x6b\xc7\x43\xce\x2a\x91\x76\x67\xf4\xb0\x29\xf8\xce\xd6\x58\x8a\xc5\x7b\x5a\x29\x45\xa7\x72\xc1\x88\x3a\x18\x0a\x4f\x4e\x24\x25\x96\x2c\x8d\x52\x13\x0d\x75\xbe\x7a\x33\xd7\xde\xe8\x74\xc8\x09\xb0\xec\xaf\x10\x76\x4a\xa0\x69\x09\xe0\x3d\x3f\xf3\x32\x12\x93\xc1\x53\x34\xbf\xba\xfb\xba\x16\xa8\x4c\x6b\xbe\x12\x6e\x26\x07\x63\xd6\x79\x99\xe2\x01\x72\x4e\xa9\x28\x78\x3e\x05\x07\x29\x29\xcd\x25\xde\xf1\x14\xaf\xf9\xc7\xa8\xd3\xa8\x7a\xd5\x35\xff\x88\xf0\x84\x0b\xf8\x1b\x7f\x1a\x88\xd0\x0e\x07\xda\x48\xc5\x56\xd6\x78\xb1\xe3\x0e\x0e\xe9\x14\x3a\x59\x6b\x7f\xfe\x7a\x02\xee\x71\x6b\x4e\x63\x5a\x29\x6e\x36\x7f\x53\xb2\x2a\xe7\x59\x14\xc1\x76\xba\x5c\x6a\x60\x0a\x9d\xde\x54\x50\x48\x85\xf5\x98\x2e\xd6\x04\x6c\x13\xe7\xb2\x0c\x72\x6d\x81\xb9\x14\x74\x08\xfa\x00\x5b\xe7\x40\xd2\x9e\xda\x26\x6e\x4d\xb5\x2f\x9d\xaf\xaf\xbf\xa3\xcd\x61\xc9\xb8\x7a\x10\x9d\x38\x87\xe3\x03\x64\xa6\xf7\xec\x7e\x0a\x21\x35\xee\xc4\xd8\xc7\x81\xb1\x73\x19\xb6\x26\x43\x4e\x8a\x29\x55\x36\x35\x9b\x51\x63\x66\xdc\xe6\xdb\x02\xa0\xa6\x3f\xdb\xe7\x41\xa8\xab\x34\xaa\xcb\xad\xd0\x87\xc1\x0f\xfe\xe8\x9b\xd6\xfe\x68\x76\x0f\x99\xfd\xdb\xb5\x81\x92\x69\xbd\x2d\x8b\xfc\xa6\x8d\x6b\x50\x95\x80\xaa\x94\xc2\x1a\x1a\x46\x1b\xc5\xca\x04\x6e\x28\xc0\x84\x0e\x74\x8b\x4a\x1b\xf0\x61\x23\x0b\xa6\xf1\xcf\x5f\x03\x8a\x54\x66\x76\x23\x21\xb2\xe0\xc3\x93\x22\xdf\x74\xfd\x94\xaa\x12\xc2\x5a\x3b\x0c\x04\xde\x1f\x42\x47\xe3\x06\xec\x0c\x78\xb6\x8f\xb7\xc8\x2b\xcd\xb8\x73\xe3\xee\x99\x71\xeb\x74\xd8\x4e\x6a\xc2\x49\xb9\x63\x5b\x57\x72\x17\xce\xf3\x2a\x5f\xc4\xec\xec\xae\xe6\xbe\xf9\x0f\x2f\xc3\xfa\xb6\xdc\x0f\xac\xe4\x61\xbb\x9d\xe6\x56\xeb\xa4\xdd\xf0\x82\x87\xfa\xaf\x99\x71\xe1\x3d\x53\xdb\xb0\x2e\x9c\x75\x87\xda\x7b\x84\x1f\x8c\x62\xad\x91\x06\x19\x32\x02\xda\x38\x88\x81\x8c\xcb\x1c\x6f\x78\x81\xb2\x1a\x75\x61\x0c\x80\x3e\x6f\x7a\x11\x86\x79\xe1\x0f\x06\xbd\x4b\x49\x78\xe7\x27\xa7\xc0\x01\x79\xef\x58\x69\x81\xf4\x39\x78\x22\xa4\x63\xb6\x35\xd3\xb0\x40\x14\xa0\xad\xa1\x27\xef\xfc\xe6\xa4\x19\xe0\x29\x2c\x70\x49\xf1\x55\xf4\x9d\x34\x97\xba\x39\x80\x8b\xc4\x03\x44\x69\xe2\xf0\xdb\xa5\x91\xdb\xad\x26\x0f\x5f\x32\xa1\xc7\xd5\xd8\x16\x42\x2f\xdf\x5c\xb7\x5d\x9e\x99\xd0\x1d\xcd\x16\x39\xd5\x88\x9d\xed\x1a\x59\x6e\xd6\x17\x6b\x4c\x6f\x63\xe9\xf4\xbb\xa6\x4b\x80\x2f\x30\x0e\xe6\x0b\x3f\x22\xa4\xf4\xfe\xb3\xd3\xab\xfb\xda\xe6\x66\xad\x50\xaf\x65\x3e\x79\x0a\xf9\xe9\xd7\xdd\x9f\x91\xdc\xb1\x7c\x0f\x66\x39\x87\xcb\x10\xee\xd3\x77\xcf\xe6\xac\x24\x35\x63\xf9\x67\x81\xe6\xde\xf2\x82\xb9\x0f\x5b\x0b\xa3\xe9\xf4\x5e\x38\xf0\x41\x30\x21\x35\xa6\x52\x64\x90\xca\x4a\x98\x84\xd4\x66\x2f\xd8\x34\xe7\x14\xc2\xe8\x02\x47\xd5\x0a\x75\xcb\x23\x4b\x8e\xd8\x2c\xc0\x62\xa4\x35\xab\x95\xfc\xc0\x0b\x66\x30\xdf\xc0\xf3\xbf\x7c\x05\x1b\x64\x6a\xd2\xd3\xf3\x39\x70\x6a\x2c\xa0\x3b\xc4\x4f\xe4\x91\xab\xd9\x5b\x94\x1d\x57\x67\xc7\xea\x54\xe2\xb7\xe5\xb9\x5d\xbe\x38\xf2\x6b\xf7\x20\x9c\x68\x1a\x18\x78\xa2\x89\xa3\xc7\xa9\x06\x8e\xca\x26\x5a\x6c\x23\xed\x10\x5d\x92\x73\x6d\x50\xa0\x8a\x95\xd8\x3f\x84\xf6\x3e\xf8\x90\x76\x5b\x56\x97\xb4\xe5\x76\x3d\x68\x84\xd0\xb6\xb4\xac\xd0\x99\xb5\x0b\x04\x66\x20\x47\xa6\x8d\xdd\x57\x8e\x93\xe3\xe4\x3e\x71\x0b\xe6\x0b\x07\xda\xab\x1f\x5e\x06\xe8\x9b\xf0\x2b\x77\x2a\x30\x68\xad\xd5\xd3\x98\x62\x8b\x38\xd5\xd2\xb8\xa9\xae\x26\x0e\x74\xc2\x2f\x9e\xcc\xe3\x59\xad\x05\x81\x92\x46\xa6\x72\x87\x9a\x19\x45\x61\xe8\x5e\xa3\x30\x60\xc9\xa2\x82\xde\xb8\x18\x22\xb6\x8f\x09\xdc\x9f\xcf\x4e\x61\x3c\x7d\x32\x16\x7e\x9f\x07\x91\xe5\x1f\x01\x81\xbb\x45\x9f\x93\x68\x0d\xd5\xc6\x35\xf4\x53\x98\x6c\x3c\x72\x22\xd8\x6a\xb0\x7b\x94\x08\xd1\xb6\xcb\x21\x04\xe0\xd3\xa9\xe2\x24\x5f\xdf\x25\xd4\x13\x63\x73\x53\xcb\x30\x1f\xd1\x69\x05\x5e\x88\x9c\x73\x1e\x9c\x4e\x97\xb0\x35\xcb\x42\x78\x9d\x0f\xa4\xb5\x23\xb1\x5c\x4b\xb7\x93\x24\x4b\xa0\x89\x0d\x3d\xc8\x40\x9f\x8a\x98\xdf\x9a\xae\x8f\x9a\xf7\x56\x79\x57\x30\xba\x81\x4e\x00\x39\x1d\xaa\xf0\x6e\x9c\x3c\x34\x11\x84\x87\x81\x1b\xe5\xa1\xdc\x06\xbc\xef\xa5\xec\x29\xaa\xae\x93\x72\xdb\x3b\x1c\xc9\x6d\x3b\x55\x50\xfc\xc1\xe5\x24\x85\x06\xc7\x61\xf4\xf4\x5d\xfb\x81\x79\x77\x83\x39\xdf\x5f\x5d\x74\x8e\x1c\xbe\xc0\xa9\x7f\x39\x41\x57\x05\x2b\xeb\x68\xab\x03\xf6\xa1\x87\xc5\x5d\xf5\x38\xe2\x13\xb8\x72\xc7\xf9\xa6\x6f\x20\x75\x99\xe6\x90\x70\x81\xfd\xc2\xe4\xf9\x60\x84\xfc\xe1\x61\xf0\x62\xa5\x50\xeb\x77\xd5\xd8\x51\xed\x10\x34\x4d\x1f\x5d\x47\x21\x8a\x85\xac\x44\x06\x8a\x1e\x0e\x06\x1a\x47\x21\x0e\x62\xb8\x6a\x1c\x9e\xfe\x6a\xf9\xe9\x11\x58\x64\x4b\xf4\x64\xde\xb4\x0d\x11\x6b\xd2\xb6\xe2\x6a\x77\xb6\x1c\xd8\x45\x58\x86\x6a\xe2\x6a\x5d\x62\x5c\x9e\xcb\x7b\x60\x69\x6a\x81\x5f\x2a\x59\xb4\x73\x24\xb5\x3f\x38\xf3\xc8\xbd\x26\x87\x6f\x4f\xd8\xef\x32\x8e\xa2\xb0\xec\x7e\xd1\x86\x28\x44\xc9\xb2\x21\x34\x44\x0e\x1b\x05\x83\xc5\x56\xcc\xde\x62\x3f\xa3\x78\x1f\xb3\x38\xde\x30\x9e\x12\x3c\x5b\xd6\xb1\x8f\xaf\x76\x0f\x2d\x34\xde\x38\xee\x9d\x35\x5a\x52\xdf\xbd\xfa\x7b\x20\x54\x0f\xd0\xd7\x94\x12\x1e\x9c\xda\xcd\x96\x04\x00\x9e\xed\x4b\xe9\x75\x34\xfa\x0e\xbe\x85\x2f\x80\xbc\x8d\xfc\x6d\x89\x30\x66\x5b\x63\x77\x18\xad\x55\xda\xd1\x32\x30\xd6\x8e\x66\x11\x5b\x16\x72\xef\xc8\x9d\x63\x45\xed\x6c\xe2\xd6\x64\x7a\x77\xb3\x95\xdf\xd4\x98\xfb\x3d\x9a\xb5\xe3\xfc\xe1\x72\x65\x76\xd9\x74\xf1\x9a\x3b\x62\xc5\x76\x47\xfd\x0d\x1c\x9d\x86\x57\x16\xfd\x63\x26\xf2\xae\x4f\x8f\x4b\xda\x16\x1a\xea\xd2\x05\x4a\xe6\x78\x7a\xcb\x45\x9d\x5c\xd1\x23\x04\x23\x81\x1b\x1d\x0c\x32\x17\x27\xc9\x97\xc0\xc4\x48\x52\xde\xc3\xf3\x08\x58\xb6\xd9\xc6\xd5\x78\x00\xde\x30\x7e\x67\x6e\xa0\x47\x3b\xbf\xba\xf5\xd0\x45\xd9\x9f\x81\x51\x95\x43\xbd\x8f\xc9\x39\x83\x25\xcb\xb5\x7f\x54\x2d\xea\x73\xe7\xf0\x5d\x1f\xdd\x0f\xbf\xfc\xfa\xc8\x87\x72\xd6\x34\x4d\xbb\xe4\x0b\x99\x57\x85\xa8\xab\xa9\xf4\x5c\x44\x2e\x09\xc2\xc8\x3a\x82\x9b\xeb\x76\x59\x12\x1f\x3d\xe3\x3f\xf5\x2f\x2d\xc5\x15\x33\xeb\x33\x48\x42\x61\x86\xc4\x95\xaa\x09\x45\x5d\xfe\xe1\xaa\xba\xfc\x83\x8a\x45\xf8\xba\x2d\x2d\x5a\x72\x75\x53\xba\xc9\x03\x5b\x2c\x35\x02\x63\xb7\x8a\x0c\x85\x2d\x58\x4c\x93\x6e\x7e\x75\xf1\xbc\xf6\x07\x0d\x00\xeb\x50\x94\xb4\x57\xc6\x41\xf2\xae\xf5\x64\x17\x1c\xd6\xca\x75\x09\x4c\x4d\xf2\x08\xd7\x50\xe9\x46\x14\xb4\x3f\x59\x62\x9a\xb4\x32\xd5\x93\xbb\x32\x4d\x6a\x86\x73\x5f\x7f\x7f\x75\x11\xfb\xed\xab\x39\x84\x9c\x84\xd1\xe9\xb5\xb3\x2c\xfe\xfe\xd5\x3f\x3b\x9f\xea\x75\x2e\x15\x97\x96\xc7\xce\xe0\x59\x24\x04\xbe\x06\x00\xcc\xaf\xea\x18\x37\x8b\xf8\x85\x42\x76\xbb\xca\x99\xd6\xde\xb2\x18\x85\xce\x47\xd1\x27\x21\xb8\xae\x03\x5e\x33\xfa\x18\x38\x9d\x8a\x3b\x2f\x1c\x33\x1c\x2b\xee\x1c\x2b\xee\x1c\x2b\xee\x1c\x2b\xee\x1c\x2b\xee\x8c\x9b\x35\x71\xc9\x4b\x41\xfe\xd6\xf9\x3b\x6e\x56\x3e\xd1\xce\x17\x14\x89\xc8\x4c\xdc\x11\xf9\xe6\x82\xa7\x2e\xe6\x97\xef\xa6\x5c\x38\x5d\x9c\xf7\xfb\x38\x16\xcf\x07\xbc\x39\xad\xd8\x2c\xbf\xcb\xdd\x02\xd8\xee\x8e\x37\x14\x43\xac\x5d\x31\x89\xb6\xdb\x4a\xd7\x4a\xe0\x65\xab\xcf\x63\x5d\xdb\xb0\xe0\xfc\x94\x9d\x82\x3d\x21\xb5\xfa\xab\xa7\xff\xf9\xf0\x62\x56\xf0\x18\x0c\xbe\x9e\xc3\x3d\xcf\x73\x97\x64\xbd\x6e\xef\xf5\xed\x2b\x23\x29\xea\xb3\x8d\xab\x04\xe6\x4b\x8a\xf7\xac\x5b\x9e\xd4\xb9\x14\x34\x92\x47\x80\xed\x2b\x05\x42\xc9\xd3\x5b\xcc\x80\xc2\xe7\x44\x5d\xa3\xa1\x64\x0f\x8c\x19\xf6\x65\x8a\xda\xce\xcf\x88\x69\x5e\x6e\xf7\x72\xc0\xa2\xd0\x9e\x78\x15\xd2\xd2\x0b\x19\x1c\x99\x7e\xd9\xfd\xf1\xc4\xa2\xbb\xea\xbd\xcd\x18\xbc\x73\xf6\xbe\x1e\x20\x49\x17\x0d\x88\x45\x69\x26\x37\x25\x93\x29\x46\x8b\x3c\x2a\x01\xea\x95\x6b\xe9\x88\x5d\xf7\xb2\xe9\x9b\x14\xfa\x91\x42\x4b\xa1\x04\x8a\x5f\x23\x5e\xf6\x98\xc5\xda\xb7\x21\xbf\x62\xb4\x12\x52\xcc\x84\xc2\x07\x6f\xe2\x22\xa6\xe7\xad\xe6\x63\xc4\xda\x89\xa8\xae\x59\x75\x82\x64\x83\xa1\x67\x8d\xe7\xab\x80\x22\x2b\x72\x9b\x0f\xbc\x48\x0a\x9e\x2a\xe9\xfc\x7b\x79\xee\xcf\x43\x35\xe0\x87\x14\x4b\x03\x95\x9e\x21\xd3\x66\xf6\xec\x24\x94\x39\x79\xee\x3b\xd0\x10\x0b\xf4\xaa\x6c\x44\x2c\xc3\x01\xb5\xba\xda\x95\xd2\x82\xf1\xbe\x43\x88\x5f\x0c\x74\x19\x4b\xa1\xae\x73\xa2\x1e\x92\x46\x3d\x2d\xe5\xc7\x13\x9a\x3f\x7d\x3a\xf3\xae\x44\x9e\xb8\x9c\xbd\x83\x13\x99\x5b\xae\xc5\x17\xcf\x27\x00\x1d\x73\x27\x4e\xc5\xb7\x0f\x26\x39\x8f\x04\x34\xfc\x8e\x2a\xef\xed\x4b\x55\xa9\x92\x5a\xff\x2c\xdb\x50\x70\xb1\x8a\x58\xdc\x3f\x5d\x0c\xf6\xf4\x22\xb7\x1b\x00\xfc\xea\x87\x97\xee\x43\x03\xb5\xc9\x16\xa1\x67\x02\xff\x10\xf0\x93\x65\x15\x6a\x3a\xa3\xb7\xad\xd3\x58\x2e\x56\x27\x80\x2c\x5d\xf7\x22\x18\x84\xcc\x9c\xd8\xda\xc8\x4a\x85\xe0\x1c\xb0\x40\x41\x8d\xd5\x8c\xeb\x3a\x58\xbe\xde\x45\xe0\x1d\x8a\x7c\x03\xcc\x41\x16\xc2\x36\xac\x68\x6b\x49\x45\xd2\x62\x8c\xd4\x9d\x53\x10\xed\xd2\x6b\x60\xa7\xaf\x49\x46\x8e\xc2\x6c\x57\xd4\x6b\xde\x6c\x1c\xfe\x03\x01\xb4\xbb\xa3\x2d\xc0\x28\x73\x84\xb0\xda\x2e\x5a\x43\x4e\xaf\xe4\x4f\x0f\xd2\x3e\xbf\x87\x3a\x8d\x0f\xa9\x19\xd8\xc0\xe9\x1f\x50\x96\x82\xf7\x04\x2c\xd0\x25\x16\x36\xb1\x15\x5d\xce\x9b\x98\x4d\xc6\x75\x2a\xef\x68\xb9\xc2\xc8\x96\x50\x5b\x05\x85\x34\x58\x0d\x29\x9c\x8d\x43\xc6\xb3\x6d\x50\x89\x76\x93\x61\x24\x7c\x3e\x03\x78\x42\xda\x51\xb6\xf0\x0f\x52\xde\x56\xe5\x4b\xa6\xf1\xed\xf5\xae\xe2\x0d\xfd\xf6\x43\xd5\x5a\x16\x4c\x23\x58\x19\xc5\xc8\x97\xa0\x37\xda\x60\xd1\x24\x17\x4b\x79\x0b\x55\x59\xd7\x19\x20\x10\xb4\x4b\x84\x62\xf5\xd3\x4c\xa2\x6e\xd9\x29\x1b\x3a\x3e\x7f\x3d\x4f\xe0\xa7\x35\x25\x8e\x98\x13\x67\xd2\x05\xfb\x82\x46\x0f\xd6\x49\xf0\x3a\xfa\xc1\x74\x28\xec\xd6\x0c\xaf\xeb\x4c\x4f\x06\x19\x5f\x52\x41\x3f\xb3\x3d\xbb\xbd\x6a\xdd\xb4\x70\xf9\xad\xd3\x73\xd3\xb8\x7c\x3c\xef\x77\x08\xc8\xb4\xfb\x07\xc1\x0a\x8b\x3c\xa7\x31\x7f\x3f\x78\x7b\xab\x56\x09\xf8\x2a\x00\x54\x97\x52\x1b\x6e\x2a\xb7\x47\xb6\x9f\xf9\xe5\x97\xc4\xa1\xf6\xd7\x5f\xc9\xb3\xf6\xcb\x2f\xc9\xf7\xdf\x68\xef\xf5\xfb\xf5\xd7\xc6\xb4\x22\x22\x7a\x7b\x4d\x8d\x6e\x9b\x34\x2e\xef\x0b\x3c\x01\x85\x16\x16\xc3\xef\xd0\xca\xc4\x1b\xb7\x19\xb5\x04\xd9\xb6\x38\x9d\x67\x8b\x8b\x01\xba\xb5\x5c\xb9\xa8\x84\xa9\xe0\x49\xcb\x36\x7d\xea\xfc\x7d\xf6\xc9\xf6\x47\xed\x3e\x38\xc4\x05\x86\x6a\x80\x2c\xbd\xa5\x65\x28\x95\xcc\xaa\xd4\xbd\x68\x7a\x9e\x2a\xcc\xd1\x4e\xc4\x4e\xcb\x6e\xfb\xee\x7c\x61\x46\x85\x4b\xfe\xe1\x0c\x9e\x25\xcf\x5e\x24\x5f\x9d\xd8\xff\x3f\x4f\xfe\xef\xac\xd8\x50\xe5\xf9\xe4\xd9\x89\x05\xef\x59\xf2\xec\xff\x25\x2f\x12\xf8\x56\x2a\xc0\x0f\xac\x28\x73\x3c\x69\xdb\xd1\x8e\x02\x02\x85\xc8\x25\xa4\xac\x64\x33\x56\xf0\x59\x0b\xc9\xb3\xbf\xf6\x30\x3c\xfb\x9f\xb0\xdb\xcb\x2c\x35\x69\x24\xbf\x98\xa3\x34\x4b\x29\x5e\x56\x16\xcc\x78\xf3\xae\x64\xc6\x8a\xf0\x66\x74\x87\xb6\xd9\x5f\x9f\x25\xcf\xbe\x49\xbe\x9a\xfd\x8f\x3f\xf4\x0f\xa5\x3b\xa8\xbb\x25\x64\x0a\xa7\xb7\x23\xb7\x31\xe9\x3a\xd5\x48\xf6\x4b\xe0\x17\x3b\x81\x6b\x44\x0a\xb0\x6c\xb9\xf8\x64\xce\xc4\x2a\x91\x6a\x75\x5a\xde\xae\x4e\x0d\x7e\x30\xa7\x06\x8b\x32\x67\x06\x4f\x1f\x3f\x90\x3f\xdf\xaa\x01\x8b\x68\x4c\xd0\xbd\x55\xab\x9a\x31\x7f\xba\x86\xb7\x6a\xc5\x04\xff\xe8\x6c\xb8\xf9\xe5\xef\x8b\x35\xf7\xc1\xd7\x97\x58\x3e\x38\x15\x31\x9e\x9c\x8b\x37\xf3\xae\xad\x3d\xd8\x25\xa2\x36\xa1\xe0\xbb\x9d\x2b\x43\x5f\xef\x38\x57\xc2\x72\x3b\x1f\x8a\xcb\xf0\xc9\x37\xde\x63\xd8\xb2\x3b\x2c\x4f\x58\x9c\x04\x13\xb2\x1f\x18\xe6\x22\x56\x9c\x37\x3e\xec\xea\x29\x8a\x2c\x10\x8c\xb5\x36\xa6\x46\xdd\x2e\x01\xf0\xc9\xb2\x38\x3a\x53\xde\x19\xf2\x66\xd7\xc7\x6f\xe5\x0a\x3a\x45\x98\xf8\x4e\x6c\xc4\xdb\x1e\xc1\x5b\xd1\xf1\x35\xb1\x81\x5b\x5f\x50\x2e\xc3\x7f\x2c\x60\x2b\x1a\x89\x71\x61\x47\x9f\x03\x85\x71\x79\x14\x71\xe1\x46\x51\xc1\x46\x51\xa1\x46\x11\x81\x46\x9f\x24\x81\x62\x57\xbc\xf2\xb1\x10\xfb\x10\x5c\xc7\x42\xec\xc7\x42\xec\xc7\x42\xec\x70\x2c\xc4\x7e\x2c\xc4\x7e\x2c\xc4\xfe\x5f\x55\x88\xbd\xaf\x32\xaf\xd1\x63\x69\x6a\xdb\x43\x8e\x88\x33\x78\xab\x32\x54\x38\x9e\xec\xde\x0b\xf3\x1a\xfb\x52\x6b\x0f\xbb\x96\xf7\x70\xfe\xb3\x6e\xf9\x6c\x35\xb5\xc2\x0c\xf8\xb2\x75\x46\x4d\xb5\xcd\x6c\x43\x2e\xa8\xf0\xb0\x2f\x5b\xcd\x44\xeb\x40\x74\xb1\xd9\x52\xeb\x3f\x6a\xbb\x2d\xe6\x05\x37\x21\xc9\xdd\x0e\xf6\xdc\x7f\x84\x40\x21\x0f\xb7\xae\xe7\x06\x33\xff\x52\x93\xb7\x22\xb3\xb2\x85\x62\xec\x16\x68\x78\xca\x72\x90\xb6\x1d\xbc\x63\x22\x93\x45\xab\xb1\x85\x4d\xd1\xc3\x7c\xd3\x01\xb2\xed\xc3\xf7\xdf\xd8\x76\x67\x84\x1f\x8a\xaa\x98\xaa\x84\xb0\x6b\x01\x66\x1e\xb0\x1d\xd6\xe4\x24\x4b\xf5\x09\xa4\xc1\x61\x04\x85\xbc\x88\xa3\x8d\xf1\x75\x6a\xd1\x86\xa1\x2a\xb5\x1f\x78\x51\x15\x20\xaa\x62\x81\x8a\x42\xff\xfa\xc6\x99\x86\x27\xe7\x3f\x3f\xed\xbb\xfe\x49\xa6\xb7\xd7\xc1\x79\x69\x2a\x23\x0b\x46\x0b\x99\x6f\xfc\x4d\x4c\x62\x15\x64\x3b\x1d\x08\xd5\x1d\xac\xf2\x25\xb2\x23\x32\x23\x75\xd3\x40\x61\x97\xdb\x38\x63\x6a\xeb\x79\xd8\xa7\xfb\x30\x90\x9a\x2a\x08\x82\xfa\x9b\xc1\xbb\x57\x7f\xbb\x4d\x28\xe3\x68\x2c\xb8\xb0\x08\x69\x02\x55\xc7\xd6\x78\x7a\xdf\x74\xbc\x8e\x01\x8e\xd7\x31\x1c\xaf\x63\xe8\x7d\xff\x78\x1d\xc3\x03\xae\x63\x80\xf7\x2c\xe7\x21\x46\x9a\xf4\xab\x3f\x14\x75\x51\xc7\x4f\x32\x49\xde\x70\xcb\x43\xbe\x84\xa7\x7e\x7a\x02\xcc\x76\xe0\x59\x5d\xd5\xd3\x25\xb2\x48\x05\xb2\xe0\xc6\x12\xea\x93\x10\xf1\x14\x04\x65\xbb\xe5\xf6\xd1\xea\x28\x36\xc6\x22\x75\x7f\xeb\x3b\x20\x8e\x55\xf4\xc7\xbf\x7b\xac\xa2\x1f\x05\xc0\xb1\x8a\xfe\x68\x24\x70\x94\x5f\x70\xd4\x24\x75\x4e\x3f\xb9\x9c\x2e\x02\xbe\x03\x47\xc7\x4a\xfe\xc7\x4a\xfe\x51\xdd\x8e\x95\xfc\x7b\xbf\x3f\x4a\x25\x7f\x21\xc5\x3b\x29\xcd\x7b\x99\x57\x45\xd4\x24\x2e\x3a\x81\xa3\x21\xe7\xa4\xd1\x74\xa2\x5b\x9c\xfe\xce\x0d\x7c\xa8\xb6\x75\xf0\x75\x4f\xf4\x7d\xb8\xdc\x04\x34\x11\x15\xf2\xe3\xd4\xae\x1b\x60\xba\x9a\x6e\x3f\xb7\x81\xba\x4c\x25\x65\x43\xac\xc2\x45\x91\xaa\x4d\x69\xa6\x4f\x0f\x7b\x19\x07\xbe\x87\x15\x71\x6d\x59\xed\x96\xa3\xe5\x83\xa8\xc7\xb6\x7a\x57\xc8\x08\x17\xeb\x2e\x67\xbd\x1f\x91\x4b\xf1\x3d\x0e\x24\x67\x4f\x43\xec\x7a\xd5\x35\xb3\x5f\x5f\x07\xe3\xde\x6f\x6f\xfd\xe0\xad\xb9\x50\xb6\x1e\xcd\xc4\x55\x19\x63\x75\x2f\x2b\x86\x14\x9c\xbf\x7b\x43\x3e\x93\x0e\x4a\x28\x93\x88\xe2\x7e\x9c\x83\xde\x1b\xf4\xdd\x98\x26\x6b\x42\xda\x71\xda\x41\x30\x2e\xf2\xc1\x3e\xa5\x2a\x6e\x2c\x77\xf9\xcc\xf8\x81\x6b\x37\xe2\x02\x7d\x6e\x06\x5f\xe4\x18\xce\x07\x7c\x18\x44\xfe\x29\x4a\x12\xc9\xb1\x8a\x4e\x03\x48\x9d\xbf\xbd\x6a\xa2\x22\x6b\xff\x0f\x3d\xf5\x81\xc0\x5e\x51\xd3\xb4\xb9\xbe\x4d\xe0\x8d\x34\x2d\x1d\xe3\x6b\x90\x10\x68\x93\x86\x63\xec\x99\x79\xdc\x79\xb9\x1e\xbd\x23\x63\x60\x92\xd7\xfc\x63\x3b\xe2\x68\xe8\xba\x8c\xae\x1c\x48\xe0\xb5\xaf\xc0\xb7\x22\x67\x89\x0a\xde\x33\x1f\x2d\x05\x5a\xb0\x52\xaf\xad\x10\xb3\x63\x49\x05\xdf\xc0\x13\x3a\xd5\x41\x9f\x58\xe0\xfb\x8d\xa4\x8f\xed\x87\x91\xda\x59\xf6\xcd\xc1\x68\xdb\xcb\x30\xa7\xb4\x1d\x4f\x1b\x14\x7e\xe1\x71\xe5\x25\xc4\x13\x4c\x56\x09\xac\xca\xe7\x27\xc0\xe5\xb3\x13\x40\x93\x26\x49\x32\x39\xe3\x4f\x62\x8f\x5b\x94\x7f\x1e\x7b\xfb\x78\x67\xcd\x9e\xe0\xa9\xda\x2e\x38\xd8\x2c\x18\x30\x09\x1e\x7a\x36\x14\xa3\x8a\xf7\x56\xc4\x11\xa4\x1b\xa1\x84\x3f\xbb\x0a\x8e\x51\xc0\x91\xea\xf7\xa8\x7c\xf7\x5c\xff\x69\xc5\xfb\x9b\xa8\xdd\x38\x15\x13\xa3\x3b\xa6\x15\xee\x97\xaf\x6e\xe3\x30\x11\xa1\x6a\x63\x90\x35\xad\x66\x3f\xab\x92\xdd\x49\xa7\xd3\x0a\x76\x42\xbd\xee\x53\x52\xf4\xbf\xe2\x1a\xb0\x52\x9a\xd7\x4c\xdd\xa2\x79\xeb\x74\x59\xd4\xfe\xbe\xd7\xc7\xeb\x41\x97\x5e\xe9\x15\x24\xa5\xed\xd5\xf9\x75\xee\x5c\x92\xee\x72\xd2\xf6\x0d\x85\x1c\x96\xb2\xc9\xfe\x1e\x61\xff\xdd\x7a\xb2\x60\x1f\xae\x14\x4f\x63\x29\xf5\xb5\x6f\xde\x39\x42\x08\x27\xdb\x25\xbd\xa1\x24\x09\xed\x58\xd2\x8a\x65\x3a\xb9\x92\x50\x32\x57\xcf\x89\xc0\x7e\xff\x7a\xab\xa6\xd3\x83\x97\x66\x9c\x18\x8f\x57\xb4\xfd\xb1\xae\x68\x43\xc1\x44\x3a\x62\xac\x74\x61\x70\x2d\x81\xd7\x8e\x68\xde\x38\xc0\x83\x49\x65\xb9\x49\x0a\xd0\x6b\xa6\x9c\x4d\x65\x39\x2b\xc7\x19\x7d\xc6\xc0\x9a\xa9\xec\x9e\xa9\x07\x22\x3e\xae\x36\xc2\xf1\x36\xb9\x2f\xf6\x36\xb9\x54\x0a\xc7\x21\xbb\x6e\xb5\xbf\xa8\x1b\x86\x58\x0b\x7f\xa4\xca\xbc\x6c\x77\x7c\xec\x93\x4b\x5d\x39\xa1\xe0\x5e\x66\x9d\x42\x16\xe3\x47\xfb\x71\x97\xdb\xd7\x90\xb4\xb3\x59\x5a\xb0\x8c\x7f\x71\x1b\xba\x87\x5c\x77\x9f\x33\x6d\x6e\x14\x13\x9a\x80\xb8\xe1\xe3\x5b\xaf\x6e\xf5\x66\xa6\x4d\xb8\x98\x0d\x1b\xbc\x83\xa9\x87\xb2\x66\xb0\x92\x05\x59\x05\xee\xb0\xdb\x85\x10\x4b\xbb\x99\xf0\x04\xd8\xec\x93\xea\xc0\x9d\x4a\x64\xa8\xf2\x8d\x2f\x54\xe0\x47\x4d\xd7\x4c\xac\xa8\x52\xd7\xb2\xce\xfb\xb3\x14\x7b\x2b\xe4\xbd\x38\x09\x41\xb4\x21\xda\x82\xc0\xaa\x07\xb4\x28\x73\x84\xee\x47\xa1\xa0\x8d\x34\xc5\xd2\x8c\xef\x9e\x1b\xab\x33\x63\x06\x67\x76\xc4\x87\xca\xd9\x02\xb5\x66\xab\x38\xac\x9e\xc3\xba\x2a\x98\xa0\x42\x8a\xb4\x57\xf0\x9d\x83\x4c\x74\x71\x5b\x86\xf1\x5c\x03\x5b\xc8\xca\x6d\xd7\x1a\x9c\x77\xf9\x9a\x6d\x76\x54\xc1\x89\xb2\x39\x99\x1e\x0f\xcd\xdc\x92\x8b\xae\x79\xbd\xff\xa9\x57\xf0\xb1\x26\x42\x6b\x81\x0a\xbc\x53\x74\xed\xa6\x29\x2d\x93\xd2\x92\x59\xe8\xd3\xb5\x94\x1a\xeb\x0d\xb6\xdb\x41\xfb\xba\x6f\x34\x45\x4e\x67\xf3\x9a\xbb\xc8\x49\x06\xab\x8a\x29\x26\x0c\x62\x66\xc7\xd8\xc2\x86\x17\x72\x87\x61\x44\xdb\x9d\x0b\x37\xa3\xdb\xef\x9e\x9d\xec\x1a\x07\x29\x43\xdc\x8d\x1f\xec\x5e\x90\x1b\x5f\xe6\x62\x49\x2b\xeb\x38\xfd\x9d\xc3\x9f\x95\xbe\x27\xa0\x65\x6d\x96\x51\xba\x7f\x9d\x93\x99\x32\x01\xbc\x28\x30\xe3\xee\xf2\x2e\xe2\x19\x2b\x7e\x5d\xb2\x6b\x7d\x20\xc6\x4d\xe5\x46\xb6\x6f\x58\x6a\x2c\xd9\x4b\x95\x59\x8d\xe9\x73\x99\x6b\xf8\x1c\x92\x5e\xff\x78\x7d\x13\x32\xfc\x48\xe0\x13\x17\xb9\xf8\x93\xff\xff\x2d\x15\x80\x78\x30\xda\x06\xe2\x5d\xc6\x90\xe6\xa4\x85\x97\xc1\x35\x0d\xd5\x91\x05\x37\xaa\xc2\x13\x20\x70\x4e\xe0\x47\x41\x42\xe0\xc1\x70\x4d\x6d\xf8\xb6\xb7\x7b\x72\xd9\x92\x4a\x6d\x0a\xb6\xeb\xc3\x2d\xe1\xcb\xc4\xe7\x50\x27\xa9\x2c\x4e\xdb\x65\x05\x99\xd8\x40\x52\xf7\x4e\x68\xcb\xd8\x0a\x5a\x22\x42\xd6\xc6\x2e\x9c\x2f\xdc\xd1\x94\xa9\xcb\xf9\x2d\x86\x00\x81\x1c\x4f\x60\x51\x59\x42\x4e\x59\xa5\x11\x98\x5a\x70\xa3\x98\xda\xb4\xb4\x1f\x51\x88\x53\xd6\xcb\x2a\x87\x27\x1a\x11\x12\x21\x33\x0c\xc5\x3f\x9b\xa6\x4f\x9d\x6b\x26\x04\x1e\x50\x16\x82\xdd\xce\xe4\x3c\x25\x31\xcb\x8b\x52\x2a\xc3\xc4\xa8\xe7\x6a\x97\xa3\x71\x62\xd3\x3a\xf3\x54\x31\xf8\x6a\x24\x38\x23\x22\x0e\x6e\x68\xdb\xb7\x64\x3c\xaf\x14\x5e\xca\x82\xf1\x21\xeb\x20\xd6\xb4\xee\x90\xc4\xb7\xed\x41\x29\xc7\x6c\xbb\x86\x69\x5b\x73\x7b\x20\x20\x73\x50\xf8\x6b\x7e\xa8\x6e\x58\xe3\xc8\x22\x35\xd9\xe2\xe8\xb5\xbc\x87\xc2\xd2\x4e\xaf\x37\x5d\x0e\xe5\xc6\x4e\x5b\x65\x0f\xf3\x0d\xe8\x92\x09\x4f\x43\x0f\xb1\x08\x76\xdf\x34\xbb\x5f\x70\x65\x84\xff\xad\xab\x04\x9b\xfb\x69\x29\xbc\x72\xa9\xd0\x55\x1f\x08\xe5\xba\xdb\x17\xd8\x8a\x7e\x19\xe2\x3a\x74\xb6\xe0\xab\xb5\x8b\xfa\x93\x2a\x50\xe1\x34\x0d\x4f\x9c\x3e\xb4\xab\x35\x45\x49\x8b\x76\x9d\xa6\x26\xaf\x47\xbb\xa4\x02\xab\x94\x3a\x8b\x49\x9e\xd3\x8a\xbb\x2c\x19\x2a\xe4\xa2\xc9\xc5\xd9\x4d\xb1\x0e\xf2\x7f\x7a\x16\xe3\x1e\xe4\xc9\x59\x8e\x13\xb6\x5f\x06\x9d\xf3\x94\x04\x60\xf7\xe5\x5e\xc5\x2f\x8f\xb7\x3d\x47\x40\x08\xbf\xdb\xdb\x9e\xf7\xa9\x09\x36\x38\x8b\x3d\x4a\x83\xf5\xae\x69\xec\x94\xce\x9a\xce\x29\x8f\x8b\x74\x38\xde\x5d\x3d\x8c\xb7\x4f\x70\x77\x75\x3f\x38\x32\x9a\xc6\xfb\xfd\xfa\xd7\x9b\x0d\xa4\xe6\xfc\x7e\x6e\x3a\x3b\xde\xe8\x7d\xbc\xd1\x3b\x78\x01\x8e\x77\x46\x1f\x6f\xf4\xfe\xdd\xae\xce\xf1\x46\xef\xed\x06\xc7\x1b\xbd\x8f\x37\x7a\x1f\x6f\xf4\x3e\xde\xe8\x7d\xbc\xd1\xfb\x78\xa3\xf7\xf1\x46\xef\xe3\x8d\xde\xc7\x1b\xbd\x47\x7e\xc7\x1b\xbd\x87\x7d\xf2\xc7\x1b\xbd\x8f\x37\x7a\x1f\x6f\xf4\x1e\xfb\xfd\xd6\x57\x1e\xef\x51\x14\x76\x2f\x18\x8e\x37\x7a\x1f\x6f\xf4\xfe\x02\xc8\xfb\x78\xa3\xf7\x74\xb3\xe3\x8d\xde\xa3\xbf\xe3\x8d\xde\x63\xaf\x8e\x37\x7a\xd7\x93\xf1\xb5\xfb\x9a\x6b\xb5\xb7\x07\xfc\x6d\x2f\xfc\x6e\x9e\x4c\xdf\xf7\xdd\x04\xb9\xb9\x00\x53\xcc\x2c\xff\xf9\x96\x74\x5f\x2e\xfc\xc9\xdd\xa0\x54\xe6\x95\x62\xb9\xff\xb3\x15\xb6\x0c\x7f\xff\xe7\x23\xf7\x61\xcc\xfc\x5d\x1a\xee\xe1\xff\x06\x00\x00\xff\xff\xcf\x1f\xa2\xe3\xd1\xc2\x00\x00")
+var _clusterApiInfrastructureClusterXK8sIo_awsclustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\x6b\x6f\x1c\x37\x92\xdf\xfb\x57\x14\xf6\x3e\xd8\x5e\x68\x5a\x7e\xe4\x72\xd9\x01\x0e\x0b\x59\x72\x36\x83\x58\xb6\x60\x29\x0e\x90\xdd\xfd\xc0\xe9\xe6\xcc\x70\xd5\x4d\x76\x48\xb6\xe4\x71\x90\xff\x7e\x28\x3e\xfa\x31\xd3\x0f\xce\xc8\x4e\x9c\x5c\x67\x0c\xc4\x6e\xbe\xaa\x8a\xc5\xaa\x62\xb1\x58\x24\x05\x7b\x4f\xa5\x62\x82\xcf\x81\x14\x8c\x7e\xd0\x94\xe3\xbf\x54\x7c\xfb\x8d\x8a\x99\x38\xbd\x7b\x16\xdd\x32\x9e\xce\xe1\xbc\x54\x5a\xe4\xef\xa8\x12\xa5\x4c\xe8\x05\x5d\x31\xce\x34\x13\x3c\xca\xa9\x26\x29\xd1\x64\x1e\x01\x64\x64\x49\x33\x85\x7f\x03\x48\xb2\x52\x69\x2a\xe3\x0f\xb3\xaa\x2b\x92\x15\x1b\xf2\xd5\x1c\xdc\xdf\x5e\x44\x00\x84\x73\xa1\x09\x76\xe4\x9b\x09\xae\xa5\xc8\x32\x2a\x67\x6b\xca\xe3\xdb\x72\x49\x97\x25\xcb\x52\x2a\x0d\x38\x1e\xd8\xbb\xa7\xf1\xf3\xf8\x6f\x11\x40\x22\xa9\x69\x7e\xc3\x72\xaa\x34\xc9\x8b\x39\xf0\x32\xcb\x22\x00\x4e\x72\x3a\x07\x72\xaf\x1c\x24\x2a\x66\x7c\x25\x89\xd2\xb2\x4c\x74\x29\x69\xbc\x0b\x61\xa4\x0a\x9a\x20\x14\x6b\x29\xca\x62\x0e\x63\xd5\xed\x10\x1e\x6e\xa2\xe9\x5a\x48\xe6\xff\x3d\xf3\x04\x98\x91\x82\x99\x1a\x96\x8e\x67\x3f\x5e\x9f\xdb\x02\xf3\x31\x63\x4a\x7f\xbf\x53\xf0\x9a\x29\x6d\x0a\x8b\xac\x94\x24\x6b\xe1\x60\xbe\x2b\xc6\xd7\x65\x46\x64\xb3\x24\x02\x50\x89\x28\xe8\x1c\xde\x90\x9c\xaa\x82\x24\x34\x8d\x00\x1c\xc1\x0c\x50\x33\x47\x12\x47\xfe\xe7\xb6\xaf\x64\x43\x73\x33\x79\xf8\x2f\x51\x50\x7e\x76\xb5\x78\xff\xe2\xba\xf5\x19\x20\xa5\x2a\x91\xac\x40\x4a\x37\x41\x05\xa6\x40\x6f\x28\xd8\xea\xb0\x12\xd2\xfc\xb3\x01\x30\x9c\x5d\x2d\xaa\x5e\x0a\x29\x0a\x2a\x75\x45\x24\xfb\xa7\xc1\x84\x8d\xaf\x3b\x63\x3e\x42\xb0\x6c\x2d\x48\x91\xfb\xa8\x1d\xd9\x21\x48\x53\x87\x09\x88\x15\xe8\x0d\x53\x20\x69\x21\xa9\xa2\xdc\x72\x17\x7e\x26\x1c\xc4\xf2\x3f\x34\xd1\x31\x5c\x53\x89\x0d\x41\x6d\x44\x99\xa5\xc8\x74\x77\x54\x6a\x90\x34\x11\x6b\xce\x3e\x56\xbd\x29\xd0\xc2\x0c\x93\x11\x4d\x95\x06\xc6\x35\x95\x9c\x64\x70\x47\xb2\x92\x9e\x00\xe1\x29\xe4\x64\x0b\x92\x62\xbf\x50\xf2\x46\x0f\xa6\x8a\x8a\xe1\x52\x48\x8a\xbc\x24\xe6\xb0\xd1\xba\x50\xf3\xd3\xd3\x35\xd3\x7e\x81\x25\x22\xcf\x4b\xce\xf4\xf6\xd4\x70\x3e\x5b\x96\x5a\x48\x75\x9a\xd2\x3b\x9a\x9d\x2a\xb6\x9e\x11\x99\x6c\x98\xa6\x86\x65\x4f\x49\xc1\x66\x06\x58\x8e\x48\xa9\x38\x4f\xff\x4b\xba\x25\xa9\x1e\xb5\x88\xa7\xb7\xc8\x0b\x4a\x4b\xc6\xd7\x8d\x02\xc3\x84\x03\x54\x46\x5e\xc4\x49\x25\xae\xa9\x45\xb4\x26\x26\x7e\x42\x7a\xbc\x7b\x75\x7d\x03\x7e\x68\x4b\x70\x4b\xdb\xba\xaa\xaa\xc9\x8c\x24\x62\x7c\x45\x91\x3b\x98\x82\x95\x14\xb9\xa1\x2a\xe5\x69\x21\x18\xd7\xe6\x1f\x49\xc6\x28\xd7\xa0\xca\x65\xce\x34\xce\xdf\xcf\x25\x55\x1a\x67\x20\x86\x73\x23\x27\x60\x49\xa1\x2c\x52\xa2\x69\x1a\xc3\x82\xc3\x39\xc9\x69\x76\x4e\x14\xfd\xec\x44\x46\x6a\xaa\x19\x12\x2f\x8c\xcc\x4d\xa1\x58\xff\x87\xbd\xcc\x1d\x9d\x1a\x05\x5e\xec\xf4\xcc\x49\xbd\xda\xae\x0b\x9a\xb4\x98\x3f\xa5\x8a\x49\x64\x56\x4d\x34\x45\x16\xaf\xeb\xb6\xfa\xeb\x5e\x77\xf8\x23\x69\x6a\xc4\x38\xc9\x6e\xc8\x7a\xaf\xb4\x59\x7e\xd5\xdb\xc7\x00\x1d\xba\xd0\x69\x8d\x68\x78\x8d\x83\x30\x85\x24\x03\x45\x35\xe2\xa1\xb1\x48\x0b\x1c\x1e\x57\xe0\xd9\x8f\xd7\x15\xb3\x21\x37\x71\xb2\xa6\x29\x2c\xb7\x86\x08\x58\x58\x48\x71\xc7\x52\x2a\x4f\x80\xf1\x0a\x66\xbf\x76\x05\xa7\x0a\x3f\xda\x26\x29\x5d\x91\x32\xd3\xf1\x1e\x94\x3d\xb3\xd3\x50\x4a\x57\x19\xe1\xf4\xb5\x20\xe9\x4b\x92\x11\x9e\x50\x39\x1f\x46\xf5\xbc\xa7\x19\x22\x5d\x61\x9c\x08\xbe\x62\xeb\x52\x5a\x31\x85\x12\x34\x31\xaa\x96\x7d\xc4\xb5\xe6\x46\x86\x02\x87\x86\x25\xdd\x90\x3b\x26\xda\x93\x3b\x3c\xc1\x95\x8c\xa7\x5d\x25\x3b\x00\x1b\x31\x4e\x71\x12\xac\x70\xb5\x0d\xcd\x84\xa0\x0c\x14\x24\x85\xa5\xc7\xe1\xb1\x23\xa4\x99\xa7\x85\x91\x8b\x54\xcf\x56\x24\x61\x7c\xfd\xa4\x73\xa8\x41\x1e\x19\xa0\x7e\xca\x14\x59\x66\xf4\x25\x51\x48\xa2\xef\x84\xd2\xf3\x68\x10\x8d\x8b\xbd\x06\xbb\x6c\xb6\x43\x74\x46\xb3\x14\xd1\x28\x24\xbd\xa3\x5e\x1c\x39\xc3\x02\xb1\x27\xb0\xb4\x83\xc3\xc6\x74\xc6\x95\x46\x22\xf4\xf1\xd0\x52\x88\x8c\x12\xbe\x53\xca\xa9\xbe\x17\xf2\xf6\x7a\x6f\xb5\xef\xc1\xff\xa6\xae\x09\x94\x27\xa4\x50\xa5\xd1\x3f\x40\xb2\x0c\x25\x28\x5f\xa3\x78\xc4\x4f\xd5\xea\x70\x9d\xc7\x87\x72\x46\xb9\xe4\x54\x77\x16\xed\xc0\x74\x6d\x6b\xb6\x29\xb7\x3f\x1c\xfe\x98\xa6\x79\x4f\x97\x9d\x9d\x1a\x3c\x7d\xbf\xb8\x54\xb9\x41\xc9\x8e\xd8\x3d\xc4\x18\x5e\x4e\x78\xdd\x11\x96\x91\x25\xcb\x98\xde\xfe\x24\x78\xcf\x0a\xe8\x80\xeb\x6c\xa7\x61\x4b\xe8\x92\x46\x21\x7c\xc4\x52\x2d\xa0\x54\xd4\x59\x3f\x4c\x39\xaa\xa2\x28\x32\x8c\x64\x05\xf2\x23\x9c\xb3\x75\x2f\xd1\x02\x96\x88\xff\x25\x2c\x95\x2f\x33\x91\xdc\x06\xe3\x73\xee\x5b\x78\x7b\xed\x7c\x71\xf1\x0e\x96\xe6\x8b\x16\x46\xad\x2a\x9a\xc2\xfd\x86\x5a\x90\xbd\x38\xb5\xd6\x35\x4e\x49\x25\x74\xdf\x5f\x9d\x3f\x18\x01\xb6\x63\x82\x0c\x40\xbe\xb8\xa8\x68\x4f\xa0\xe4\xec\xe7\x92\x02\x4b\x51\x33\xaf\x18\x9a\x13\x02\x24\x5d\x51\x49\xb9\xb7\x42\xbc\x9a\x78\x38\x90\xea\xaa\x5c\x66\x2c\x99\xf7\xd6\xd8\x05\xd5\x35\x68\x31\x8b\x63\x05\x82\x24\x2c\x6c\xb1\xfd\x14\xc3\x59\xc5\x27\xca\x17\x99\x09\x60\xe6\x0b\x51\x4a\x24\xcc\x2c\xf1\x7b\xa6\x37\x40\x40\x8a\x52\x53\xd0\x28\xd9\x40\x6f\x88\x86\x0d\x51\xf5\x67\x81\xcb\x86\x39\x31\x0c\x6b\xa2\xe9\x3d\xd9\x8e\x13\xa1\x5b\x54\xd5\xff\x71\xa2\xff\x61\xfb\x5a\x84\x4f\xda\x9b\xba\xd1\x85\xe7\xb8\x37\x67\x37\x1e\x2a\x60\xe9\x1e\x7a\x35\xad\x62\x58\xac\xb9\x40\xab\xa6\xe4\x19\x55\x2d\x32\xb2\x3d\xe5\xdf\x52\xfc\xf7\x1b\x96\x6c\x20\x21\xca\xf1\x02\x2e\x45\xb4\x27\x1c\x53\x37\xc9\x8f\xbc\x2e\xe9\x1e\x64\x92\x2a\x96\x52\x15\xc3\x42\x3b\xc0\x39\x2e\x6d\x23\x66\x53\xaa\xa9\xcc\x19\xa7\x96\xe4\xca\xac\xf7\x42\xb2\x3b\xb4\xbd\x94\x13\x8f\x6e\xcd\x2b\x92\x53\x38\xfb\x09\x88\xda\x1f\xfa\xc1\x9c\x69\x86\xbf\x41\x3e\x38\x60\x4e\xde\xd5\x8d\xaa\x39\xc1\x8e\xd0\xc8\xb0\x3c\x35\x3c\x2b\x0f\x05\x5a\x77\x9a\x97\x87\x1b\x9a\x07\x0e\xbb\x43\x85\xca\xe4\x84\x04\x7d\x0c\x89\x57\xf0\x08\x9d\xab\xb9\xf4\x5b\x9c\x70\x49\xd2\x69\xb5\x04\x57\xb1\x15\x88\x94\x64\xdb\x51\x7e\x57\xf4\x48\xa0\x16\x5e\xef\xaf\xce\x43\x34\xf3\xb8\xd2\x0c\xd0\x2d\x9f\x53\xb3\xc0\x45\xc3\xa8\x7c\xf6\x34\x36\xbf\xd3\x67\x5f\xc7\xd1\x03\xb8\x80\xa5\x81\xc8\xd4\x2b\xe3\xae\x48\x66\x2c\xf5\x86\x2f\x52\xd7\x08\x94\x0a\x7a\xe7\x37\x40\xad\xaf\x85\xc3\xa5\x62\x18\xf5\x30\x68\x9d\x0c\x0f\x10\xbb\x6d\xe0\x77\xda\x55\xb8\xd4\x78\xec\xaa\x87\xce\xf5\x3e\xa8\xe0\x03\xe0\x1f\x5e\xe8\x87\x2e\xf3\x80\x01\x3f\xeb\x12\x0f\x5c\xbd\x3d\x15\x06\x0a\xad\x2d\x38\x8f\x86\x71\x71\xdb\xdb\x77\xa6\x72\xd3\x9a\x84\x8c\xdd\x51\x54\x37\x71\x74\x00\xc9\x94\xda\x7c\x4f\xb7\xe8\x20\x1c\x19\xf8\xfa\xfa\x3b\x57\xd1\x73\x11\xba\x0e\x3d\x1f\x29\xb5\x81\x5b\xba\xc5\x05\x4e\xb4\x26\xc9\xc6\xef\xb4\x9b\xbb\xa4\x03\x00\xeb\x21\x93\xd2\x44\x97\x3b\xdc\xd1\xe7\x19\x31\x55\x5b\x96\x97\x58\x2a\x74\xf2\x3d\xc8\x39\x52\xb0\x57\xce\x43\xa5\x46\x08\x76\x76\xb5\xa8\xaa\x36\x5c\x60\x2d\x2f\x97\x11\x6a\xce\x15\x95\x20\x4c\xd5\x9a\x6b\xed\xf2\xe3\x28\x78\x43\xd5\x07\x42\x13\x02\x02\x92\x92\x64\x63\x54\xfc\xf7\xe5\xd2\xc8\x08\xaa\xb0\x76\x05\x58\x1c\x1d\xae\x2a\x36\x9d\xdb\xf0\x0e\xb0\x6e\x36\xd4\xec\x9a\x2d\x03\x79\x23\x0d\xe9\x82\x20\x98\x39\x92\xc8\x64\xf8\x37\xc6\xd7\x5d\xb0\x0c\x32\x8f\xff\x15\x42\x86\x03\x84\x95\x1f\x0c\x0c\x8a\xd3\xf5\x0e\x33\xf9\xc5\xfd\x73\x89\x8e\xb9\x2e\x78\x66\x86\x1a\x9d\x05\x08\x55\x47\xc1\x80\x14\x19\xb2\x1e\xdc\x62\x9c\x47\x83\xd4\x58\x38\x5f\x86\xfb\xba\xac\xf7\xdf\xfd\x5e\x8e\x61\xde\x20\x69\x2a\xa9\x52\x7d\x8c\xd3\x1a\xfd\xcc\xd7\x45\xeb\x45\x13\xc6\x55\xe5\xd2\xf3\xc3\x37\x95\x54\xd5\xf5\x43\x1d\x0f\x97\x24\xd9\x30\x4e\xdd\xf0\xf5\xe0\xe8\xa6\x97\x79\xed\x8a\x43\x60\xb8\x48\xe9\x23\xe5\xc7\xee\x1e\x79\x7c\xbd\x34\x28\xd3\x5f\x61\x07\x4a\xe4\xd4\xdc\x42\x3a\x36\x7c\xd0\x12\xa9\x2a\x85\x02\x70\xd9\x1e\xdc\x30\xdb\x09\xa0\xcb\x43\xac\xe0\x3b\xb7\xa8\x4f\xe0\xd5\x07\xb4\x2b\x48\xb6\xb8\x02\x21\x9d\x21\x82\xff\x7a\x20\xb0\x43\xab\x08\x7f\x33\x0f\x57\x6f\x39\x0e\xd2\x53\x38\xb8\xa8\x86\x17\x16\xfe\xe8\x52\xbd\x2d\x34\xcb\xd9\xc7\x3e\xf8\x5a\x84\x5c\xf0\xd4\x88\x7c\x85\xfb\x4e\xbd\x41\xcf\xc5\x86\xd6\x2c\xee\xfc\xc1\xa6\x37\xb3\xad\x3c\xcb\xc9\x47\xc1\xe1\xd5\xcb\x6b\x58\x9c\xbe\x8d\xa3\x63\x36\xf0\x94\x93\xeb\xb2\xe8\x17\x8b\x2d\x00\xd1\x07\xc7\x56\xac\x01\x20\xe5\x1b\x5c\x7e\xa9\x77\x2d\xe2\xb6\xc8\x28\xac\x57\x6f\xce\x50\x44\x52\x8e\x2a\x25\x3d\x0e\x36\x46\xf2\x2b\x29\x56\x2c\xa3\x01\xb0\xdd\xec\x98\x1f\x8b\xb3\xcb\x9a\x74\x85\xed\xa6\xd3\x94\xf5\x95\x4e\x80\xad\x80\x14\x45\xc6\x12\x84\x39\x8e\x8e\x60\xc8\xbe\x4d\xc4\x58\xb3\x9c\xac\x7b\xb7\xe9\x7b\x58\x2e\x2e\x3c\x8e\x67\x97\x8b\xca\xf1\x90\x91\x92\x27\x6d\x8c\x8e\x43\xc1\x35\xbe\xc6\xd3\xa2\x40\x88\x92\x52\x4a\xf4\x88\x57\x46\xd4\x83\xa1\x70\xec\x64\xa4\xc4\x8a\x24\x54\x1d\xc4\x9d\xaf\xde\x2c\x94\x33\x3a\x2d\x71\x3c\x2c\x87\x2b\x84\x11\x38\xc7\x24\x80\xf3\xfc\x2c\x8a\x00\xf8\x91\x83\xbd\xa7\x68\x71\x75\xf7\x55\x25\x50\x89\x52\x6c\xcd\x2d\x26\x0f\xa6\xac\xf5\x32\x85\x03\x64\xaa\x87\xc1\xf3\x29\x56\x90\x14\x42\x5f\xd0\x3b\x96\xd0\x6b\xf6\x91\x1e\x34\xeb\x8a\x7d\xa4\xf0\x98\x71\xf8\x07\x7b\xe2\x99\x10\xbb\x03\xa5\x85\x24\x6b\x0a\xa9\xe9\xb7\xb3\x4b\xab\xd0\x31\x9a\x43\x7f\xfd\x55\x74\x8c\x35\xa7\x68\x52\x4a\xa6\xb7\xff\xc0\xb0\x90\x45\x1a\xc4\xb0\xad\x26\x17\x0a\x88\x34\xe7\x90\xa8\x1f\x73\x8c\x07\xf0\x7d\xda\x58\x13\x58\x5c\x28\xe7\xb2\x74\x04\x87\x25\xcd\x04\x9e\xf3\x68\x11\xff\xe6\xac\x3d\xb4\x4d\x1c\x95\xce\xd7\xd7\xdf\x99\xcd\x61\x41\x98\x3c\x8a\x4f\xac\x2b\xf5\x08\x99\xe9\x3c\xbb\x62\xd5\x62\xdd\xa3\x60\xe8\x77\x62\x1c\xe2\xc0\x18\x19\xa4\x03\x19\x1c\x77\x50\x95\xc5\xd1\x11\xc6\x4c\xbf\xcd\xb7\x07\x80\x1f\xc7\xb0\xd0\x51\xa4\x2b\x15\x95\x17\x7b\xa1\x0f\x9d\x03\xfe\xe0\xaa\x7a\x1f\x83\x24\xf7\x80\x51\x13\x60\xeb\x40\x41\x94\x53\x81\x4d\x12\xb8\x4d\x1b\x86\xf8\x94\x1c\xca\x42\x70\x58\x0a\xa1\x95\x96\xa4\x88\xe1\x06\xfd\x74\xf6\x40\x37\x2f\x95\x06\x17\x36\xb2\x24\x8a\x7e\xfd\x15\x9e\xa6\x8a\x94\xa6\x26\x6e\xc7\xf9\xf0\x04\xcf\xb6\x6d\x3f\xa5\x2c\x39\x47\x6b\x87\x00\xa7\xf7\x41\x94\xef\x21\x46\xbf\x01\x3b\x03\x96\x46\x07\xcc\xa2\x53\x9a\xf3\x68\x90\x9e\xee\xdc\xb8\x7d\x66\xdc\x38\x1d\x46\xa4\x06\x9c\x94\x23\xdb\xba\x82\xd9\x70\x9e\x57\xd9\x32\x60\x6e\xcf\xae\x16\xae\xfa\xeb\x97\x7e\x7e\x1b\xee\x07\x52\x30\xbf\xdd\x4e\x32\xd4\x3a\x49\x3b\xbc\x20\x8e\x8e\xdb\x64\x11\x6d\x63\xa8\xfa\x6b\xec\xc2\x59\x35\xa8\xbc\x47\xf4\x83\x96\xa4\xd1\x53\xe7\x82\x0c\x80\x36\x0c\x62\xfc\xb1\x34\xa3\x18\xc0\x28\xca\x1e\x5b\xbd\x13\xf4\x45\xdd\xca\x50\x98\xe5\xee\x60\xd0\xb9\x94\xb8\x73\x7e\xa2\x33\x34\xcb\xc4\xbd\x5d\x4a\x4b\x3c\xe9\xc9\x28\x3c\xe6\xc2\x2e\x36\x3c\x46\x5c\x52\xca\x01\xfd\x45\x20\xee\xa8\xdc\xe9\xe0\x09\x2c\xe9\x0a\x95\x96\x3d\x99\x4c\x32\xa1\xea\x03\xb8\x40\x3a\x84\x69\xe2\x50\x8d\x3c\xba\x5e\xfc\x2f\xe5\xaa\x5f\x8d\xed\x11\xf4\xe2\xcd\x75\xd3\xe5\x99\x72\xd5\xd2\x6c\x81\xa8\x8e\xc8\x47\xfc\xb3\xa1\x24\xd3\x9b\xf3\x0d\x4d\x6e\x43\xf9\xf4\xbb\xba\x89\x87\xcf\x2f\x1c\x9a\x2d\x5d\x8f\x90\x98\xf2\xcf\xce\xaf\x76\xb4\xed\xcd\x46\x52\xb5\x11\x59\x8f\xaa\xfe\x5c\xf3\xee\xce\x48\xee\x48\x36\x3c\x6e\x8b\x7e\x67\x70\xe1\x8e\xc6\xf6\xdc\xb3\x19\x29\x90\xa1\xcd\xfa\x59\x52\x7d\x8f\x6b\x41\xdf\xfb\xad\x85\x46\xab\xd8\x1d\xae\x7f\xfd\x15\x70\xc2\x85\xa2\x89\xe0\x18\x35\x5a\x72\x8d\x1a\xa7\x11\x1f\x69\x47\xc8\x98\x09\x61\xc4\xde\x33\x22\xd7\x18\x39\x5a\xd7\x40\x47\x6c\xea\x61\x41\x17\x7a\x51\x48\xf1\x81\xe5\x44\xd3\x6c\x0b\xcf\xff\xf6\x14\xb6\x94\x48\x15\xff\xc6\x34\xd5\x08\xe8\x88\xf8\x09\x60\x6d\xfc\xa3\x0f\x16\x65\xd3\xec\x8c\xcc\x4e\xc9\x7f\xdf\x35\x37\xe6\x8b\x43\x93\x66\x17\xc2\x81\xaa\x7e\x01\x0f\x54\xb1\xfc\x38\x54\xc1\x72\xd9\x40\x8d\x7d\xa2\x3d\x44\x97\x60\xa8\x3e\xe5\x54\x86\x4a\xec\xd7\xbe\xbe\x0b\x3e\x34\xbb\x2d\xdc\xbd\x36\xe5\x76\xd5\x69\x80\x91\x81\x92\x46\x52\x6b\xd6\x2e\x29\x10\x0d\x19\x25\x0a\xe3\x5b\x7a\x8c\xd3\xd1\x7d\xe2\x1e\xcc\xe7\x16\xb4\x57\xaf\x5f\x7a\xe8\x2b\xab\xc8\x9d\x0a\x78\xe0\x5b\xa0\x55\x68\x0c\x2d\x8b\x30\xd5\x52\xbb\xa9\xae\x06\x0e\x74\x0e\x67\xf3\x50\x46\x6f\x41\x20\x85\x16\x89\x18\x51\x33\xbd\x24\xf4\xcd\x2b\x12\x7a\x2a\xa1\x96\x35\x25\x36\x86\x88\x74\x13\x35\x0e\xc2\x67\x54\x18\x0f\x9f\x8c\x7d\x5e\x42\x16\x7f\x06\x02\x8e\x8b\x3e\x14\x7e\x4d\xae\x0d\xab\xe8\x50\x18\xac\xdc\x73\x22\xe8\x7f\x33\x28\xc6\x7b\x09\x10\x6d\x63\x0e\x21\xfc\xf1\x70\x3b\x7a\xd7\x25\xd4\x9e\x15\x8c\xad\xf3\x32\xcc\x45\x74\xa2\xc0\xf3\x91\x73\xd6\x83\xd3\x6a\xa2\x1c\x03\xa4\x3e\xbc\xce\x05\xd2\x62\x4f\x24\x53\xc2\xee\x24\x8d\x25\x50\xc7\x86\xc6\xd1\x03\xe6\x7d\x28\x62\xbe\x2f\x6a\x9e\xa9\x7d\x64\x5d\x04\xfd\x09\x50\x66\x0e\x55\x58\x3b\x4e\x1e\xea\x08\xc2\x87\x81\x1b\xe4\xa1\xdc\x07\xbc\xd5\xec\x62\x4f\x51\xb5\x9d\x94\xfb\xde\xe1\x16\xaa\x0f\x50\x41\x01\x18\x86\x70\xa8\x77\x1c\x06\xa3\x6f\xeb\x77\xe0\xdd\x0e\xe6\xc4\xf0\xaf\xe6\x91\xc3\x17\x88\xfa\x97\x13\x74\x95\x93\xa2\x8a\xb6\x7a\xc0\x3e\x74\x54\x68\x8d\x54\x68\xad\x88\x4f\xe0\xca\x6d\x33\x4e\xb3\xf3\x5d\x03\xa9\xbd\x68\xe2\xe8\x78\x63\x68\x28\x6e\x71\x07\x22\x1b\xb9\xd8\x11\x21\xdf\x4f\xe1\xc0\x69\x66\x7c\x8d\x27\x55\xef\xca\x8c\x86\x43\x53\xb7\x51\x5e\x2c\x32\xbe\x14\x25\x4f\x41\x96\x59\x8f\x47\x2d\x8c\x70\x41\xab\xaa\x1f\x9e\xdd\xd9\x72\xe8\x19\xb0\x8c\x2d\xd1\x86\x62\x64\x9f\x17\x6a\xd2\x36\xe2\x6a\x47\x6b\xee\xc0\x8e\x76\x38\x2e\xa8\x3a\xae\x16\xcf\x84\xac\x43\x0f\x48\x92\x20\xf0\x78\x9f\xb2\x79\x47\x12\x2f\x14\xe2\xec\x3b\xe2\x5e\x9b\x18\xc7\x1d\x61\x3f\x8c\x58\x20\x95\x0f\xe0\xa3\x70\x59\xd6\x45\x86\xe8\x13\xc2\x80\xd4\x0a\xd9\x5b\x1c\x66\x14\x1f\x62\x16\x87\x1b\xc6\x43\x82\x67\xcf\x3a\x76\xf1\xd5\xc6\x1e\x34\xd0\x38\xe3\xb8\xcd\xd2\x86\xd5\xe3\x4f\x49\x50\xd5\xc1\x5f\x43\x4a\xb8\x13\xb5\x9b\x3d\x09\x80\xd7\x44\x0e\xe4\xf4\x2a\x1a\x5d\x7d\xf9\xec\xad\xc5\xef\xcb\x84\x21\xdb\x1a\xdc\x61\x34\x66\x69\xa4\xa6\x5f\x58\x23\xd5\x3c\x8b\x8e\x54\xd3\x62\xb4\xaf\x51\x23\x21\x7c\x4e\x86\x77\x37\x3b\xbc\xda\x3c\x24\xd8\xe1\x59\xec\x27\x8e\x1e\xc8\x4c\xc3\xd6\xdc\xe1\xf6\x5c\xe0\xb0\x47\xdb\x74\x6d\x1a\xc4\xd1\x83\x66\x6c\x8c\x2f\x3b\x8f\x4e\x7d\x11\x92\x3f\x3a\x72\xe8\x7e\x49\xdb\x20\x43\x95\xba\x40\x8a\x8c\x9e\x62\x42\x00\xbf\xc9\xdd\x61\x04\x0c\x4d\xd2\xca\x1b\x64\x08\x97\x8d\xa1\xe1\x3d\x97\xf2\x06\xc1\x1b\x28\x94\x94\xa4\xdb\x79\x14\x1e\x80\xd7\x4d\xdf\x19\x46\x89\xa7\xdb\x68\x74\xd4\xbd\x8f\x66\xef\x9d\xce\x41\xcb\x92\xda\x0f\x36\x26\x67\x0e\x2b\x92\x29\xf7\xa9\x5c\x56\xe7\xce\xf3\xa8\x15\xdd\x0f\xbf\xfc\x1a\xb9\x50\xce\x8a\xa7\x51\x7c\xc9\x73\x91\x95\xb9\xcf\x0a\xd3\x12\x43\x73\x70\x61\xfc\x68\x08\xf9\x08\x6e\xa6\x1a\xf1\xfd\x3e\x7a\xc6\x0d\xf5\x1f\x25\xf8\x15\xd1\x9b\x39\xc4\x3e\x31\x43\x6c\x53\xd5\xf8\xa4\x2e\xff\xb2\x59\x5d\xfe\x65\x92\x45\xb8\xbc\x2d\x0d\x5e\xc2\xbf\x56\xa3\x46\x3d\x4b\xaa\x07\xc6\x76\x16\x19\xe4\x24\x43\x69\xa3\x9b\x5f\x9d\x3f\xaf\xfc\x41\x1d\xc0\x5a\x12\xc5\xcd\x99\xb1\x90\xbc\x6b\x7c\x19\x83\x03\xad\x5c\xdc\xc1\x36\x2f\x8f\x30\x05\xa5\xf2\xf5\xdb\x43\x16\x34\x89\x5d\xb8\x00\xc6\x5f\xc5\x77\x45\x12\x57\x0b\xce\x8e\xfe\xfe\xea\x3c\x74\xec\xab\x05\xf8\x3b\x09\x1d\x63\x59\xf4\x9a\xb7\x2c\xfe\xf9\xf4\xdf\xae\x9e\x1d\x6a\xa7\x71\x21\x99\xc0\x35\x36\x87\x67\x81\x10\xb8\x1c\x00\xb0\xb8\xaa\x62\xdc\x90\xf0\x4b\x49\xc9\xed\x1a\x9d\x86\xce\xb2\xe8\x85\xce\x45\xd1\xc7\x3e\xb8\xce\x55\xb4\xe0\xd5\xbd\xf7\x81\xd3\xca\xb8\xf3\x62\xca\xb8\x33\x65\xdc\x99\x32\xee\x4c\x19\x77\xa6\x8c\x3b\x63\x19\x77\xc2\x2e\x2f\x79\xf9\x5b\xdd\xdf\xb1\x58\xb9\x8b\x76\x2e\xa1\x48\xc0\xcd\xc4\xfe\xd9\xc2\x9f\x0b\x9e\x42\x0f\xcc\x90\x0b\xa7\x4d\xf3\xdd\x36\x28\xb7\x09\x64\x1d\xde\x9c\x46\x6c\x96\xdb\xe5\xee\x01\x8c\x27\xbf\x5b\x13\x43\x8c\xf9\x91\x88\x6a\xb9\xad\x54\xa5\x04\x3c\x39\xb0\xcd\x23\x55\xd9\xb0\x60\xb6\xe4\xed\x84\x3d\xfe\x6a\xf5\xd3\x27\xfb\xd4\x18\xdd\x1d\x0f\xb2\xde\xf8\x8e\x8b\xe4\x2c\x84\x82\x97\x0b\xb8\x67\x59\x86\xd7\x20\xcc\x14\xd6\x7b\x7d\xbc\x1c\x81\x69\x4b\x30\x02\xbc\x41\xab\x18\x16\x2b\x13\xef\x59\xd5\x3c\xa9\xee\x52\x98\x9e\x1c\x01\x10\x7f\x8c\xc5\x2e\x58\x72\x4b\x53\x30\xe1\x73\xbc\xca\xd1\x80\x99\xf3\xe2\xe8\x08\xb4\x5d\x9a\xa2\x86\xb3\x31\x84\x51\x2e\xf6\x5b\x59\xb4\x29\x57\x8e\x79\x25\x35\x53\xcf\x05\x2c\x5a\xd3\xce\x78\x13\x7b\x3f\xeb\x3b\x9b\x31\x78\x67\xed\x7d\xd5\xc1\x92\x48\x43\x0a\x34\x2f\xf4\xe0\xa6\x64\xf0\x8a\x11\xde\x01\x0a\x40\xf3\x95\xbd\x2d\x64\xbd\x39\x6a\xe7\x36\x7d\x7d\x85\xbe\x27\xd1\x92\x4f\x81\xe2\xe6\x88\x15\x3b\x8b\x05\xed\x5b\x7f\xbf\xc2\x19\xaf\xc7\x21\xe4\x07\xbc\x09\x8b\x98\xf6\x97\x27\xb1\x7a\x1f\xb3\x56\x38\x54\x1e\xb9\x11\x96\x75\xea\x04\xcd\x38\xb8\xf2\x24\x42\x91\x5b\x0f\xf0\x22\xce\x59\x22\x85\xe9\x0d\x73\x42\xd9\xf3\x50\x05\xf4\x43\x42\x0b\x0d\xa5\x9a\x61\x60\xc8\xec\xd9\x09\x06\x34\x23\x13\x3d\x77\x0d\x4c\x17\x4b\xea\x54\x59\x8f\x58\x1e\xe5\xf5\x01\xb9\xed\x6e\x32\x9b\x94\x67\xde\x78\x9f\x47\x83\x44\x3c\xef\x68\xd2\xb0\x69\xda\x66\x8b\xbf\x13\x75\xcc\x35\xea\x61\x29\xdf\x7f\xa1\xf9\xd3\x5f\x67\x1e\x91\x24\x81\x77\xf6\x1e\x7c\x91\xb9\xe1\x5a\x7c\xf1\x3c\x3a\xdc\x9d\xd8\xef\xaa\xe9\xb9\xe4\xdc\x13\xd0\xf0\x07\xca\xbc\x77\x28\x57\x25\x52\x28\xf5\x93\x68\x42\xc1\xf8\x3a\x60\x72\xff\x72\xde\xd9\xd2\x89\xdc\x76\x00\xf0\xab\xd7\x2f\xed\x40\xad\x8c\x67\x36\x37\xd9\xd2\xb7\x8c\xe1\x5f\x1c\x7e\x44\x39\x6a\xaa\xce\x4c\x69\xe3\x34\x96\xf1\xf5\x09\x60\xa6\x80\xf6\x11\xad\xb9\xfe\x6c\x88\xb3\x15\xa5\xf4\xc1\x39\x80\x40\x41\x45\xd5\x94\xa9\x2a\x58\xbe\xda\x45\x60\x2a\xbd\x6c\x0b\xc4\x0c\x57\x85\x6d\xa0\xa5\xdc\x90\x8a\x68\xca\xa0\x52\xf0\xaa\xa4\x95\x7a\x0d\x10\x7d\x4c\xc6\xb4\xea\x87\x19\x67\xd4\x69\xde\xb4\x1f\xfe\x07\x02\x88\xe1\xa2\x7b\x80\x99\x9b\x23\x86\xaa\xcd\xa4\x35\xc6\xe9\x15\xff\xe5\x28\xed\x33\x14\x75\xd2\x15\x71\xf2\x7b\xe4\x69\xf4\x61\x16\x2a\x04\x4c\x17\x47\x51\xc3\xe9\x3e\x98\x5b\x0a\xce\x13\x80\x91\x8c\x78\xb1\xb0\x8e\xad\x68\xaf\xbc\x01\x6c\x52\xa6\x12\xbc\xaf\x40\xd3\xaa\x67\x64\xd4\x46\x42\x21\x85\x11\x36\x84\x5b\x1b\xc7\x6c\x75\xb0\x42\xc9\x9b\x55\x9e\xfc\xc6\x06\xf0\x80\xb4\x33\xb7\x85\x5f\x0b\x71\x5b\x16\x2f\x89\xa2\x6f\xaf\xe7\xd1\x20\x81\x17\xbb\xf5\xbd\x77\xa8\x19\x7d\x85\x57\x9f\x00\x65\x14\x31\xbe\x04\xb5\x55\x9a\xe6\xf5\xe5\x62\x21\x6e\xa1\x2c\xaa\x3c\x03\x06\x04\x73\x0b\x9c\x1b\x97\xb7\x4d\x00\x90\x0a\xaa\x1a\x76\xca\x16\x77\x90\x67\x97\x8b\x18\x7e\xc4\x0b\x53\x8a\x6a\xb4\xb4\x99\xaa\xec\x0b\xd3\xbb\xb7\x4e\xbc\xd7\xd1\x75\xa6\x7c\x62\xb7\xba\x7b\x6f\xfd\xe0\x16\x29\x65\x2b\x93\xd0\x4f\xef\x63\x17\x47\x07\x4c\x44\x83\x96\xdf\x5a\x3d\x37\x4c\xcb\x47\x8d\xe1\x6c\x03\x4f\x4c\xdc\x6e\x70\x92\x23\xf1\xac\xc6\xfc\xe3\xd0\xed\xad\x5c\xc7\xe0\xb2\x00\x98\xbc\x94\x4a\x33\x5d\x22\xc2\x76\xd7\xf8\xcb\x2f\xb1\x65\x9c\x5f\x7f\x35\x77\xe2\x7e\xf9\x25\xfe\xfe\x1b\xe5\xbc\x7e\xbf\xfe\x5a\x9b\x56\x86\x89\xde\x5e\x9b\x4a\xb7\xf5\x35\x2e\xe7\x0b\x3c\x41\x5f\x41\x81\xb7\x85\xee\x28\xca\x44\xb4\x4c\x1c\x43\x7a\xcc\xb0\x13\xeb\xd9\x62\x7c\x7f\x66\x71\x99\x96\xcb\x92\xeb\x12\x1e\x37\x6c\xd3\x27\xd6\xdf\x87\x5f\xf6\x07\x05\x52\xc7\x05\x3a\xc7\x44\x41\x92\x5b\x33\x0d\x85\x14\x69\x89\xc9\x0b\x96\xdb\x46\xcb\x53\x49\x31\x62\xda\x5a\x8c\xb8\xed\xbb\xc3\x3e\x08\x14\x92\xae\xd8\x87\x39\x3c\x8b\x9f\xbd\x88\x9f\x9e\xe0\xff\x9f\xc7\xff\x3d\xcb\xb7\x26\xf3\x7c\xfc\xec\x04\xc1\x7b\x16\x3f\xfb\x9f\xf8\x45\x0c\xdf\x0a\x09\xf4\x03\xc9\x8b\x8c\x9e\x34\xed\x68\x7b\xd5\xdf\x73\x08\x86\x79\x93\x82\xcc\x48\xce\x66\x0d\x22\xcf\xfe\xbe\x43\xe1\xd9\x5f\xfd\x6e\x2f\x45\x6e\x52\xd4\xf8\xc5\x2c\xa7\xe1\xc6\xd5\xc9\xca\x9c\x68\x67\xde\x15\x44\xa3\x08\xaf\x7b\xb7\x64\x9b\xfd\xfd\x59\xfc\xec\x9b\xf8\xe9\xec\xaf\xee\xd0\xdf\xa7\xee\x30\xcd\x91\x91\x4d\x38\x3d\xf2\x70\x93\x92\xb6\x51\x45\x64\x37\x05\x6e\xb2\xd1\x69\x4b\x4d\x80\x65\xc3\xc5\x27\x32\xc2\xd7\xb1\x90\xeb\xd3\xe2\x76\x7d\xaa\xe9\x07\x7d\xaa\x69\x5e\xe0\x5d\xc3\xd3\x47\x47\xae\xcf\xb7\x72\x3d\xb2\x38\xdb\x2c\x5d\x2d\xcc\x1f\xaf\xe1\xad\x5c\x13\xce\x3e\x5a\x97\xf3\xe2\xe2\x8f\xb5\x34\x0f\xa1\x57\xe3\x50\x26\xec\x1a\xe8\x6f\x90\x3e\x38\xe1\x21\x9e\x9c\xf3\x37\x8b\xb6\xad\xdd\xd9\x64\x78\x24\x37\x5a\xd3\x4d\xd2\x57\x6d\x7f\xf4\x66\xab\x6a\xba\x31\x0c\x46\xb9\x1b\x3e\xd9\xd6\x79\x0c\x1b\x76\x07\xae\x09\x24\xa3\x37\x21\xdb\x7e\x15\x65\x85\x9c\xf3\xc6\xfb\x5d\x3d\xf6\x59\x31\x0c\x5a\x1b\x43\xbd\xb6\x3b\xc4\x14\x00\x71\xd4\x83\xd0\xb0\x35\x32\x82\xf2\x68\xc8\x1b\xce\x8f\xdb\xca\xe5\x94\xeb\x01\x30\x42\x66\x69\x1f\x9e\xc1\x8a\x83\x3c\x7f\x5c\xe0\xd6\x21\x11\x33\xe1\xf1\x32\x3e\x6c\x65\x6c\xf4\xfe\x30\x82\xcf\x19\xb0\x15\x4c\x44\x2d\x7e\x2f\x12\xf6\x7b\x0b\xea\xff\x5a\xa7\xb7\xd1\x03\x83\x8d\x82\x42\x8d\x02\x02\x8d\x06\x0c\xf6\x30\x8b\x3f\xa0\x13\xb7\x7f\x99\x47\xe3\x2c\xe5\x76\x3a\x2d\x89\x1a\x47\x07\x4b\x8d\x29\x11\xfb\x94\x88\x7d\x4a\xc4\x3e\x25\x62\x9f\x12\xb1\x4f\x89\xd8\xa7\x44\xec\x5f\x5c\x22\xf6\x5d\x95\x79\x4d\x1d\x95\xfa\x1a\x80\x77\x44\xcc\xe1\xad\x4c\xd1\x29\x1a\x05\xcd\xc4\xa3\xb3\xbe\x91\x1a\x7b\xd8\x8d\xb8\x87\xb3\x9f\xaa\xe8\x2d\xb3\xbb\xc1\x5a\x34\xc5\xa0\xd6\xfa\x8c\x3a\xc7\xa0\x20\xac\x88\x5e\x7d\xa7\x66\xd1\x83\x51\xf7\x65\x04\xc6\xee\x90\x3f\x28\xdc\xde\x63\x5a\x06\x7f\xc9\x1d\x3b\x7b\xee\x06\xc1\x3e\xac\x6b\x5c\x55\xb8\xc1\xcc\x15\x2a\x3c\x31\xc6\x53\x7c\x0e\x26\xc6\x6e\x49\x35\x4b\x48\x06\x02\x69\x00\xef\x08\x4f\x45\xde\xa8\x8c\xb0\x49\xf3\x31\xdb\xb6\x80\x6c\xfa\xf0\xdd\x18\x8f\xa2\x1e\xfa\x01\xe5\x65\xde\x3f\x0f\x33\xdf\xc1\x40\x0d\x0b\x58\xf4\x80\x25\x45\x7a\x69\x18\xc0\x21\x2f\xc2\x78\xa3\x7f\x9e\x1a\xbc\x81\x4b\x34\x27\x1f\x58\x5e\xe6\xc0\xcb\x7c\x49\x25\x2e\xe4\x26\x78\xc6\x38\x53\xf0\xf8\xec\xa7\x27\xbb\xae\x7f\x23\xd3\x9b\xf3\x60\x4c\x1f\x52\x6a\x81\x59\x78\x13\x92\x65\x5b\xf7\x12\x13\x5f\x3b\x09\x88\x4a\x61\x55\x37\xc0\xf4\x45\x86\xed\x0c\x9b\x19\x2f\x74\x0d\x05\x4e\xb7\xde\xd0\xce\xef\x7e\x9f\xee\xc2\x40\x2a\xae\x30\x10\x54\x63\x3a\x92\xd5\x63\x37\x19\xa5\x9f\x8c\x39\xe3\x48\x90\x3a\x50\xf5\xb8\x7d\x53\x80\x85\xf9\x39\xed\xcb\xe9\x39\x86\xe9\x39\x86\xe9\x39\x86\x3f\xc7\x73\x0c\xf0\x9e\x64\xcc\xc7\x48\x9b\x80\x32\x77\x28\x6a\x26\x13\x1e\xa7\xc2\x78\xc3\x4b\x55\xa5\xf0\x54\x78\xfc\x82\x0d\x58\xea\x3f\x99\x7c\x68\xe6\x38\x44\xe4\x4c\x23\xa3\x3e\xf6\x11\x4f\x5e\x50\x36\x6b\x3e\x09\xa7\xc6\x97\xfa\x06\x84\xa3\xe1\xc8\x04\xf9\x40\x30\xf7\x75\xca\xa2\x3f\x65\xd1\x9f\xb2\xe8\x8f\x67\xd1\xdf\xb5\x61\xe7\xd1\x28\x31\x9b\x26\xa9\xb1\x2b\x51\x0a\x7a\x2e\x8f\x8e\xa0\xd1\x94\xc9\x7f\xca\xe4\x3f\x65\xf2\xff\xff\x9c\xc9\x9f\x0b\xfe\x4e\x08\xfd\x1e\x2f\x9c\x06\x21\x71\xde\x74\xf0\xb8\x78\xd2\xfa\x16\x06\x17\xbc\x9d\x9c\xfe\xce\x76\x1c\x1f\x8e\x58\x6b\x54\x0b\x5f\xfb\x44\xdf\x85\xcb\x0d\x40\xe3\x81\x18\xc8\x90\x1f\xa6\x76\x6d\x07\xc3\xd9\x74\x77\x20\xb6\xd9\xfe\x87\x2e\x65\x07\xcd\x1e\xfe\xa1\x3c\x91\xdb\x42\xd3\x34\x78\xf0\x57\xbe\x05\xb0\x5a\x14\x22\xbd\xec\x74\x34\x7c\x10\x55\xdf\x68\xd7\x72\x11\xe0\x62\xed\x17\x87\x2d\x68\x99\xe0\xdf\xd3\xed\xbc\xb7\x5a\x37\xc4\xb6\x95\x37\xf4\xbf\xbf\xbc\xf6\xc6\xbd\xdb\xde\xba\xce\x1b\xb8\x98\xdb\x7a\xe8\xca\x70\x59\xc6\x48\xd5\x0a\xc5\x90\x84\xb3\x77\x6f\x8c\xcf\xa4\x45\x12\x0c\x4f\xc0\x18\x07\xef\xa0\x77\x06\x7d\x3b\xa6\x09\x4d\x48\x1c\xdd\xfb\x4a\x50\x90\x19\x17\x9d\xe9\xdd\x64\x71\x23\x99\xb9\x9f\x0c\xf4\x03\xde\x6d\xc2\x1e\x31\xa2\xd3\x5c\x64\x62\xe8\xcd\x76\xe7\x03\x2e\x0c\x22\xfb\x14\x29\x89\x44\xa1\x82\x89\xba\x78\x7b\x55\x47\x45\x56\xfe\x1f\xf3\xd5\x05\x02\x3b\x45\x6d\xd0\x66\xea\x36\x86\x37\x42\x37\x74\x8c\xcb\x41\x62\x66\x7e\xd0\x70\x0c\x3d\x33\x0f\x3b\x2f\x57\xbd\x6f\x64\x74\x20\x89\x0f\x6a\x80\x1a\x7c\x2e\xa3\x2d\x07\x62\xb8\x74\x19\xf8\xd6\xe8\xe8\x32\x56\x8c\xf1\x9e\xb9\x68\x29\x50\x9c\x14\x6a\x83\xd1\x51\xd8\x97\x90\xf0\x0d\x3c\x36\xa7\x3a\xd4\x5d\x2c\x70\xed\x9e\x7c\x0a\x8a\x54\xce\xb2\x6f\x1e\x4c\xb6\x83\x0c\xf3\x1b\xbc\x91\xe3\x78\x03\x1b\x7a\x5a\x39\x09\xf1\x98\xc6\xeb\x18\xd6\xc5\xf3\x13\x60\xe2\xd9\x09\x50\x9d\xc4\x71\xfc\xe4\x81\xfc\x3b\x6e\x8f\xe3\xd4\x7f\x1e\x7b\x7b\x7a\xb3\xe6\x88\x37\x6b\xac\xde\x7d\xb0\x59\xd0\x61\x12\xc4\xd1\x71\xca\x38\x44\x15\x1f\xac\x88\x47\x28\x11\xa8\x84\xbb\x14\xda\x27\x55\xc1\x21\x0a\x38\x50\xfd\x4e\xca\xb7\xa9\x7c\x03\xe6\x7f\x58\xf1\xfe\x2e\x6a\x37\x4c\xc5\x84\xe8\x8e\x61\x85\xfb\xe5\xab\xdb\x30\x4a\x04\xa8\xda\x10\x62\x0d\xab\xd9\xcf\xaa\x64\x47\xf9\x74\x58\xc1\x0e\xa8\xd7\x11\xe5\xaa\x82\x12\xdc\xb5\x19\xe5\x8f\xfd\x0c\x58\x21\xf4\x25\x91\xb7\x54\xe3\x53\x97\xc2\xe7\x62\x1a\xc6\x78\xb7\x8d\xd3\x83\x46\x0d\xfa\x9d\x2a\x3a\xa0\x3c\x86\xfe\x16\xba\x2c\x31\xc0\x07\x4b\x50\xec\x5d\x17\xa2\xbe\xfd\xad\x8e\xd5\x93\x39\xf9\x70\x25\x59\x12\xca\xa9\x97\xae\x7a\xeb\x08\xc1\x9f\x6c\x17\xd8\x91\xf9\x82\x0f\x5c\x81\xbb\x18\x80\xf0\x6a\x01\x05\xd9\x1a\x04\x0d\xd8\xef\x2f\xf7\x72\x3a\x1d\x3d\x35\xfd\xcc\x38\x3d\xd1\xf6\xe7\x7a\xa2\x8d\x72\xc2\x93\xed\x3c\x00\x06\x5b\x13\x58\xe5\x88\x66\xb5\x03\xdc\x9b\x54\xb8\x9a\x30\x6a\x66\x43\x30\x56\x06\xd3\xda\x32\xbe\xce\xe8\x4c\x63\x63\x0d\x1b\x22\xd3\x7b\x22\x8f\x24\x7c\xaf\xec\xdf\xa3\xd5\xf4\x9a\xdc\x17\xf9\x9a\x1c\xbe\x8f\xc3\x7a\x24\x7a\x8b\xa4\xe7\x55\x45\x1f\x6b\xe1\x8e\x54\x89\x93\xed\x76\x1d\xbb\xcb\xa5\x18\x33\x58\xbb\x97\x49\x95\x01\x0f\x33\x12\xf4\x1f\xed\xf7\x6a\xb0\x6e\x48\x2a\xe1\x4c\x78\x13\x96\xfe\x11\xf7\xa1\x8b\xa3\xc3\x95\x49\x46\x94\xbe\x91\x84\x2b\x43\x37\x7c\xab\xad\xbb\xde\x0e\xd8\xaf\xf1\x7d\x16\xf3\x74\x91\x33\xc3\x7d\x9e\xa4\xaa\x2b\xf4\x3e\x49\x91\x63\xca\x24\x77\xd8\x8d\x0a\x91\x70\x81\x9e\x3c\xc7\x80\x8e\xb7\x96\xb4\x0e\xdc\x29\x79\x4a\x65\xb6\x75\x89\x0a\x5c\xaf\xc9\x86\xf0\x35\xee\x11\x16\xab\xea\xde\x1f\x9e\xe9\xdf\x72\x71\xcf\x4f\x7c\x10\xad\x8f\xb6\x30\x60\x55\x1d\x22\xc9\x2c\xa3\xbb\x5e\xb0\x31\xee\x24\x0a\xdd\xbf\x7b\xae\xad\x4e\x4c\x11\x36\xc3\x1e\x7b\xea\x8d\x2c\x73\xcc\xdf\xa5\x30\x08\x30\x88\xaa\x67\xb0\x29\x73\xc2\x4d\xca\x4a\x04\xce\x37\xf6\x32\x11\x31\x4c\xa9\x26\x2c\x53\x40\x96\x78\xd1\xd3\xe0\x5b\xd1\xbc\xbd\xae\xc9\x76\x24\x0b\x4e\x00\xf4\x92\x12\x25\x78\x10\xf0\xa8\x10\x6d\xf5\x6a\xff\x53\xcd\xe0\x23\x65\x18\xad\x01\x2a\xb0\x56\xd2\xb5\x9b\x3a\xb5\x4c\x62\x12\x8e\x60\x2a\xbc\x64\x23\x84\x32\xbc\x81\x3c\xe3\x76\xd0\x2e\xef\x9b\x41\x91\x99\xb3\x79\x0c\xa8\x46\x6d\x40\x60\x5d\x12\x49\xb8\xa6\x98\x6e\xe1\x6a\xb1\x47\x0d\x27\xe4\x1e\x46\x11\x85\x3b\x17\x4c\xd5\x18\x42\x93\x6b\x57\xd9\x4b\x19\x13\x3d\x41\x3f\xe0\x5e\x90\x69\x97\xe6\x62\x65\x66\x16\x1d\x2b\x2b\x78\x67\xe9\x97\x88\x94\x9e\x80\x12\x95\x59\x66\xae\xfb\xbb\xb3\x7e\x05\x09\x06\xe2\xe7\x39\x4d\xf1\x30\x31\xdb\xda\x35\x83\xe2\x17\x9d\xde\x8d\x03\x31\xa6\x4b\xdb\x33\x96\x90\x44\xa3\xf7\x5a\xc8\x14\x35\xa6\xbb\xcb\x5c\xc1\x67\x57\xc8\xe5\x0f\xd7\x37\xfe\x86\x9f\x11\xf8\x66\x15\xe1\x61\x5c\xa9\xfe\xf7\x5b\x93\x00\xe2\x68\xb2\x75\xc4\xbb\xf4\x11\xcd\x54\xf5\x32\xb8\xe2\xa1\x2a\xb2\xe0\x46\x62\xbe\x44\x03\xce\x09\xfc\xc0\x8d\x10\x38\x7a\x3a\x87\x36\x7c\x2d\xa8\x6e\xdc\x16\xaf\x82\xa7\xc5\xc1\x38\x3f\x8c\xc3\x4a\x88\xd8\xdd\xa1\x8e\x13\x91\x9f\x36\x38\xfc\x92\xf0\x2d\xc4\x55\xeb\x18\x07\x6e\x06\x2d\x19\x46\xc6\x57\x80\xb4\x4f\xdc\xe1\x05\x3d\x3e\x0f\x74\x4b\xfd\x95\x21\xbc\x9d\xbd\x2c\x91\x91\x13\x82\x07\x27\x44\x2e\x99\x96\x44\x6e\x6b\x4a\x59\x0e\xb1\x31\x91\xab\x32\x83\xc7\x8a\x52\x88\x31\xdf\x89\x4f\xfe\x59\x57\x7d\x62\xc4\x27\xf8\xc0\x03\x4c\x3e\x81\x8f\x00\xae\x32\x96\x98\x0b\x0a\x2c\xc7\xcc\x36\x84\xeb\x23\x29\x3c\xb4\x69\x9d\x39\xae\x88\x0e\x08\xce\x18\x34\x37\xfb\xb7\x7d\x2b\xc2\xb2\x52\xd2\x0b\x91\x63\x20\xcf\x3c\x3a\xd6\xb4\x6e\xb1\xc4\xb7\xcd\x4e\xf1\x00\xb8\x23\x87\x69\x53\x73\x3b\x20\x20\xb5\x50\x98\x3b\x20\x2e\x6f\x58\xed\xc8\x32\xfb\xc6\xc6\x8a\xc6\x08\xf5\x1c\x79\x67\xa7\x35\x06\x1e\xba\xbe\x93\x46\xda\xc3\x6c\x0b\xaa\xc0\x37\x5f\x4c\x62\x99\x63\x2c\x82\xf1\x97\x66\x0f\xd9\x87\x8c\x32\xc8\xd0\xc3\xb5\xa8\xa9\x61\x25\x29\x45\x52\xe6\x3e\x5d\x77\xf3\x01\x5b\xbe\x9b\x86\xd8\x09\x5a\x09\x39\x5b\x6f\x4c\x5a\x2d\x5c\x9a\x8e\x0b\x87\x79\xb8\x87\xa9\x76\xb3\x35\xcd\x43\x90\x68\xe6\x69\xaa\xef\xf5\x28\xf4\xdc\x5b\xfd\xd5\x9a\x4c\xe4\x1b\x55\x32\x63\x90\x20\xaa\x06\xec\xe5\x76\xe7\x8a\xb5\x97\xff\x71\x74\x9c\x07\x79\x10\xcb\x7e\xc6\x76\xd3\xa0\x32\xf4\x13\x88\xd5\x4e\x61\x1c\x1d\x30\x8c\x0b\xac\x98\x0f\x8f\x3e\xbd\xf6\xfc\x07\x7d\xed\xf9\x90\x9c\x60\x9d\x58\x1c\x90\x1a\xac\x05\x78\x13\x8d\xde\xa4\x70\xa1\xcb\x64\x7a\xbb\xfa\x33\xbf\x5d\xbd\x1b\x1c\x19\xcc\xe3\xbb\xed\x76\x9f\x37\xeb\xb8\x9a\xf3\xc7\x79\xe9\x6c\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\x7b\x7a\xd1\xfb\x4f\xf1\xa2\xb7\xbb\x15\xd6\x7c\x56\x3b\xec\xb8\xf6\xb7\x7b\xf0\xbb\xfe\x32\xfc\xde\x77\x9d\xd4\xc9\x06\x98\xd2\x14\xd7\x9f\xab\x89\x4f\xbe\xce\xe1\x2f\xf6\x05\xa5\x22\x2b\x25\xc9\xdc\x3f\xeb\x68\xac\x39\xfc\x13\xdf\x89\xc6\x4b\x56\x34\x75\x6f\x69\xa8\x39\xfc\xf3\xdf\xd1\xff\x0d\x00\xcf\x1f\xa2\xe3\xd1\xc2\x00\x00")
 
 func clusterApiInfrastructureClusterXK8sIo_awsclustersYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -203,7 +204,7 @@ func clusterApiInfrastructureClusterXK8sIo_awsclustersYaml() (*asset, error) {
 	return a, nil
 }
 
-var _clusterApiInfrastructureClusterXK8sIo_awsmachinepoolsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x69\x6f\x1b\x39\x96\xdf\xfd\x2b\x1e\x66\x3f\x24\x19\x58\xe5\x38\xe9\xdd\xed\x35\xb0\x18\x78\x92\x4e\x46\xe8\x38\x36\x22\x77\x1a\xd8\x03\x0b\xaa\xea\x49\xe2\x98\x45\xd6\x92\x2c\xd9\x4a\xa3\xff\xfb\xe2\x3d\x92\x75\x48\x2a\xa9\xec\xf4\x01\x6c\x8f\xbe\x24\xae\xe2\xf1\xee\x8b\x8f\x25\x2a\xf9\x19\xad\x93\x46\x5f\x80\xa8\x24\x3e\x78\xd4\xf4\x97\xcb\xee\xbe\x75\x99\x34\x67\xeb\xf3\x93\x3b\xa9\x8b\x0b\x78\x53\x3b\x6f\xca\x4f\xe8\x4c\x6d\x73\x7c\x8b\x0b\xa9\xa5\x97\x46\x9f\x94\xe8\x45\x21\xbc\xb8\x38\x01\x50\x62\x8e\xca\xd1\xff\x00\x72\x55\x3b\x8f\x36\x7b\x98\x34\x4b\x09\x55\xad\xc4\x37\x17\x10\xff\xf7\xfa\x04\x40\x68\x6d\xbc\xa0\x85\xd2\x34\xa3\xbd\x35\x4a\xa1\x9d\x2c\x51\x67\x77\xf5\x1c\xe7\xb5\x54\x05\x5a\x5e\x23\x01\xbb\x7e\x99\xbd\xca\xfe\xed\x04\x20\xb7\xc8\xd3\x6f\x65\x89\xce\x8b\xb2\xba\x00\x5d\x2b\x75\x02\xa0\x45\x89\x17\x20\xee\x5d\x29\xf2\x95\xd4\x58\x19\xa3\x5c\x26\xf5\xc2\x0a\xe7\x6d\x9d\xfb\xda\x62\xb6\x0d\xe5\x89\xab\x30\x27\x48\x96\xd6\xd4\xd5\x05\x1c\x1b\x1e\xb6\x49\xb0\x0b\x8f\x4b\x63\x65\xfa\x7b\x92\x88\x30\x11\x95\xe4\x27\x81\x96\x97\x3f\xce\xae\x02\x4c\x37\xc6\x28\x7e\xa1\xa4\xf3\xdf\xef\x79\xf9\x41\x3a\xcf\x03\x2a\x55\x5b\xa1\x76\xf0\xe1\x77\x4e\xea\x65\xad\x84\xdd\x7e\x7b\x02\xe0\x72\x53\xe1\x05\x7c\x24\x20\x2b\x91\x63\x71\x02\x10\x89\xc8\x40\x4e\x40\x14\x05\x33\x52\xa8\x1b\x2b\xb5\x47\xfb\xc6\xa8\xba\xd4\x0d\x0a\x05\xba\xdc\xca\xca\x33\xd9\x23\x64\x60\x51\x14\x1b\x70\x5e\xf8\x3a\x80\x00\xf0\x77\x67\xf4\x8d\xf0\xab\x0b\xc8\xc2\xf3\x8c\x07\xc5\xb7\x81\x19\x9f\x3a\x4f\xfc\x86\x00\x73\xde\x4a\xbd\xfc\x05\xb6\xaa\x94\xcc\x85\xdb\xda\xad\xf7\x30\x6c\x48\x28\x2e\xd1\xee\xdd\x51\x6a\x59\xd6\x25\x48\xed\xbc\xd0\xe8\x40\x6a\xb8\x9c\xbd\xdf\xb3\x69\x85\x79\x56\x4a\x3d\x93\x5f\xb0\xb7\xe3\x55\xef\xd9\xf1\x0d\xc5\xc3\x63\x36\x14\x0f\xbb\x1b\xf6\x9e\x1d\xdd\xf0\x83\xa8\x75\xbe\x82\x5b\x2c\x2b\x25\x3c\xc2\xf4\xed\x20\x45\x15\x0f\x4d\x23\x9b\x81\x61\xdb\x0f\xbd\x97\xed\x32\x3b\x3c\x0d\xc3\x3b\xfa\x4e\x12\xb9\xc2\x52\x5c\xc4\x19\xa6\x42\x7d\x79\x33\xfd\xfc\x7a\xd6\x7b\x0c\x7d\xb8\xfb\x3a\x01\xd2\x81\x5f\x21\x84\x29\xb0\x30\x96\xff\xdc\xd2\x0c\xb8\xbc\x99\x36\xab\x55\xd6\x54\x68\x7d\xa3\x99\xe1\xd7\xb1\x7e\x9d\xa7\x5b\x7b\x3f\x23\xf0\xc2\x28\x28\xc8\xec\x61\xd8\x3d\x6a\x11\x16\x11\x23\x30\x0b\xf0\x2b\xe9\xc0\x62\x65\xd1\xa1\x0e\x66\x8d\x1e\x0b\x0d\x66\xfe\x77\xcc\x7d\x06\x33\xb4\x34\x11\xdc\xca\xd4\xaa\x20\x6b\xb7\x46\xeb\xc1\x62\x6e\x96\x5a\x7e\x69\x56\x73\xe0\x0d\x6f\x43\x04\x76\x9e\x99\x6a\xb5\x50\xb0\x16\xaa\xc6\x53\x10\xba\x80\x52\x6c\xc0\x22\xad\x0b\xb5\xee\xac\xc0\x43\x5c\x06\x57\xc6\x22\x19\x30\x73\x01\x2b\xef\x2b\x77\x71\x76\xb6\x94\x3e\x59\xf6\xdc\x94\x65\xad\xa5\xdf\x9c\xb1\xc9\x95\xf3\xda\x1b\xeb\xce\x0a\x5c\xa3\x3a\x73\x72\x39\x11\x36\x5f\x49\x8f\x6c\xf8\xce\x44\x25\x27\x0c\xac\x66\x5b\x9d\x95\xc5\x3f\xd9\xe8\x0b\xdc\xb3\x1e\xf1\x76\x64\x20\xfc\xd8\xf2\x1d\xa0\x32\x19\x3f\x62\xac\x88\x53\x03\x16\x2d\x31\xe9\x11\xd1\xe3\xd3\x77\xb3\x5b\x48\x5b\x07\x82\x07\xda\xb6\x43\x5d\x4b\x66\x22\x91\xd4\x0b\xb4\x61\xe4\xc2\x9a\x92\x57\x41\x5d\x54\x46\x6a\xcf\x7f\xe4\x4a\xa2\xf6\xe0\xea\x79\x29\x3d\xf1\xef\x7f\x6b\x74\x9e\x38\x90\xc1\x1b\x76\x50\x30\x47\xa8\xab\x42\x78\x2c\x32\x98\x6a\x78\x23\x4a\x54\x6f\x84\xc3\x5f\x9d\xc8\x44\x4d\x37\x21\xe2\x8d\x23\x73\xd7\x1b\x6f\x0f\x0e\x74\xea\xbc\x48\xbe\x6e\x80\x27\x7d\xad\x9b\x55\x98\xf7\x14\xa0\x40\x27\x2d\x09\xac\x27\x1b\x60\x16\xfb\xdc\xda\x61\xfd\x63\x1d\x6c\xdc\xcf\xad\x58\xee\xbc\x85\x9e\x7b\x1a\x5a\xe3\x00\x3d\xf6\xa1\xd5\xdb\x91\x65\x4e\x83\xa9\xc2\x23\x70\xe8\x59\x93\xe9\x95\x37\xb4\x3d\xff\xa3\xa3\x91\xce\xf1\x94\xac\x74\x82\x2a\x69\xa9\x21\xa2\x88\xa2\xc0\x02\xe6\x1b\xa2\x92\xa8\x95\xa7\xff\xd2\xcb\xcb\x1f\x67\x44\x80\xb5\xa4\x20\x66\x07\xb6\x01\xde\x30\xee\x6b\x21\x95\x98\x4b\x25\xfd\xe6\x3f\x68\x8b\x5d\xc4\xfb\x98\x6d\x8f\x8f\xc8\x09\x6b\xc5\x86\x0d\x51\x67\x00\x7c\x09\x23\x22\x5a\x0e\x72\xa1\xc1\xd6\x84\xe8\xce\x2e\xd2\x63\xf9\x78\xaa\x87\x97\xbc\xf9\x36\x62\xf7\xae\xef\x42\x8e\x21\xf6\xe3\x6c\xcb\xe5\x90\xe8\xca\x85\x8c\xa2\x18\x9c\x15\xf8\xf4\x96\xac\x63\xb4\xcf\xc4\xa1\xda\x21\xdc\xaf\x50\x77\xd9\x48\xa4\x09\xd3\xb0\xd8\x65\xca\xb0\xbc\x42\x4f\x26\x67\x98\xd7\x56\xfa\xcd\x7b\x8a\x16\xf7\x8e\x1d\x14\xbe\xfe\xd4\x6d\x4e\x59\x5c\xa0\x45\xe6\x8b\x37\xe0\xe2\xd8\x10\x95\x12\xce\xc2\x27\x17\x32\x47\x10\x55\xa5\x24\x16\x49\x18\x1b\x96\x66\x70\xbb\x42\x87\x3b\xd3\xef\xd3\x44\x12\xf6\x2d\x69\x16\x7a\xb3\x33\x3e\x68\x7d\x01\x22\xd9\x4b\x0e\x6a\x41\x91\x15\x03\x63\x69\x09\x76\xc0\xb9\xaf\x85\x37\x7b\x64\xfc\xa0\x0c\xed\xe3\x76\xca\x33\x3e\x25\x32\x04\xd7\xd0\x50\x85\x21\x4d\x42\x90\xb3\x86\x35\x3e\x61\xbe\x81\xe9\xdb\x53\xb8\xfc\xf4\xf1\x94\x80\x5b\x48\xe5\xd1\xba\x0c\xae\xb5\xda\x90\xa2\x12\x79\xe3\x00\x7a\xff\x2e\xbc\x67\x57\x31\x6f\x05\xab\xc8\x60\xc6\xff\xdd\x90\xe7\x29\xc9\xca\xfb\x15\x19\x0a\x8d\x70\x2f\x95\xa2\xfd\x48\xc7\x89\x7c\xe4\xab\x64\x11\xdc\x3d\x5a\x3b\x44\x81\x63\x62\x15\x85\xcb\xea\xe1\x97\xdb\x94\x22\x14\x16\x0d\xea\x07\xa6\x1d\x54\xd4\xf4\x8b\xa4\x1a\xbd\xfd\xb3\x44\xbb\xe0\xb7\x83\xe5\xbc\xc3\xcd\x59\xf0\xdd\x95\x90\xd6\x91\xf2\xb1\x64\xca\x82\xdc\xda\x62\xc3\x6c\x8c\xac\xba\x5d\xe1\x06\x84\x6d\x25\x58\xe4\xb9\xb1\x05\xfb\xfa\x20\xcb\xb6\x56\xd8\x0a\x60\xc7\xa0\x5e\xde\x4c\x5b\x8f\x5b\x98\xdc\x65\xe2\xde\x65\xa2\x14\x5f\x8c\xce\x72\x53\x9e\x5d\xfe\x38\xfb\xee\xcd\xab\xb3\x10\x3f\x9d\xfd\xe0\xd0\xbe\xaf\x65\x81\x67\x3f\x50\xb6\xf4\x3f\x01\x72\xa9\x97\xd9\xca\x97\xea\xd9\x01\x8c\x0f\xca\xed\x1e\xa2\x84\x95\x03\x49\x02\x41\xf7\x90\x40\xf7\x44\xf6\xe0\xe2\x63\x64\x26\xfc\x38\xd8\x3e\x32\x66\x0b\x58\xca\x0b\x43\xe0\x8a\x11\xd8\x2c\x21\xc0\x79\x2d\x33\x27\x17\x0e\x27\x0e\xb5\x93\x5e\xae\x71\x48\xb6\xdb\xdf\x28\x59\x0b\xbf\x10\xaa\x3e\x12\xe8\xcf\x3c\x09\xa4\xce\x55\x5d\xa0\x0b\x3a\x6d\x83\x8e\x46\x8a\xa7\x10\xf8\x5d\xf7\xcf\x27\x21\x33\x82\xfd\x8f\xc6\xfa\x90\x6b\xec\xff\x28\x16\xa5\x20\xeb\x30\x00\x13\x66\xd6\x91\x21\x81\x06\x07\x07\x1d\x08\x45\x1e\x0b\xbc\x3c\x08\x72\x8f\x9d\xd3\xb7\xbf\x9c\x0d\x3b\x8a\xc1\x61\xd8\x45\x29\xc7\xf8\xf0\xab\x69\x4a\x41\x7b\x0e\x89\x4d\xd3\xd5\x34\x64\x19\xf7\x2b\x49\x11\x89\x09\x05\x2a\xe4\x97\x31\x3d\x6d\x1c\xf4\x7e\xe9\x3b\xae\xf0\x07\x5d\xc4\x93\x1c\xc4\x08\xd2\x1e\x75\x0e\x7f\x34\xd7\x70\xd4\x32\xfc\x8a\x6e\x61\xac\x53\x18\xe3\x12\x7e\x6d\x87\x30\xda\x30\x8e\x73\x06\xbf\x97\x2b\x18\xe9\x08\x1e\xe1\x06\xc6\x39\x81\x31\x2e\xe0\xa8\x03\x18\x61\xfe\x47\x19\xff\xe3\x20\x1f\x32\xfc\x4f\x31\xfb\x47\xe9\x79\x04\x6e\x29\xca\x69\x34\xb7\x37\xd6\x2c\xa4\x1a\xd0\x87\x1e\x6c\xb7\x2b\x64\x8a\x42\x2c\x2d\x5e\xb2\xed\x80\x94\x95\x04\x2d\x79\x7e\xf9\xe9\xe3\x8b\xa4\x2b\x4d\x5a\x59\x85\x4d\x40\x38\x67\x72\x29\x3c\x16\x70\x2f\xfd\x8a\x07\x4d\x2f\xaf\xc0\x1a\x85\x4d\xc9\xb2\x71\x04\xbc\xe3\xce\x1a\xb9\xd1\x5e\x48\xed\x7a\x93\xf7\xcb\xe9\x11\x32\x0d\x71\x65\x07\xeb\xc0\x95\x3d\x39\xf5\xd3\xb6\x2d\xc5\x12\x3f\x18\x73\x57\x57\x7f\x15\x0e\xaf\x67\x23\xa0\x98\x6e\xcf\x49\xbe\x56\x77\x4c\xd3\x5c\x38\x04\x32\x81\x82\x0b\x83\x6e\xe3\x3c\x96\x29\xd5\x27\xea\xf2\xce\xa0\x78\x99\xc6\x31\x4b\x07\xda\x78\xf2\x46\x5f\x8b\xcd\x3b\x63\x4b\xe1\x47\x60\xf3\x6c\xba\x3d\x29\xa1\x43\x00\x69\x51\x12\xf8\x8b\xf0\xc2\x1b\x06\x18\x22\xc4\x01\x85\x20\x2a\xd2\x35\xc1\xc3\xd4\x87\xfc\x73\x8e\x20\x97\xda\x58\x2c\x40\x72\x89\x19\x1f\x2a\x25\x73\xe9\x13\xaa\x84\x26\xcc\xea\xaa\x32\xd6\x3b\x70\xf5\xdc\x79\xe9\x6b\xae\x2c\xf2\xaa\x3f\xfd\x94\x05\x02\xff\xfc\x33\x17\x4c\x7e\xfa\x29\xfb\xfe\x5b\x17\x4b\xdd\x3f\xff\xdc\x8a\x2d\x13\xfb\x7a\xc6\x83\xee\xea\x39\x5a\x8d\x1e\x5d\x2a\xb0\x9c\x92\x0e\x57\x98\x93\x0d\x55\x9b\x20\xc9\x91\x71\x09\x4e\x2e\x97\xb3\xdf\x97\x7a\x0f\x7f\x8d\x85\x7a\x5e\x6b\x5f\xc3\xf3\x50\x57\xe4\xea\xd9\x8b\x50\xe4\xa6\x27\xbb\x9b\x82\xd8\xf1\xfc\x95\xc8\xef\xc4\x12\x1d\x69\x4f\x51\xe7\xe1\x45\x3b\xf3\xcc\xa2\x42\x42\x84\xd0\x32\xb5\x87\x35\xad\x21\xa0\xb2\xb8\x90\x0f\x17\x70\x9e\x9d\xbf\xce\x5e\x9e\xd2\xbf\xaf\xb2\x7f\x9e\x94\x1b\x3e\x75\xcc\xce\xb9\x86\x70\x9e\x9d\xff\x6b\xf6\x3a\x83\x77\xc6\x02\x3e\x88\xb2\x52\x78\x0a\x1d\x58\x5b\x5e\x11\x1f\xcd\x02\x72\x51\x89\x89\x28\xe5\xa4\x43\xe4\xc9\x5f\xb6\x28\x3c\xf9\x73\xa0\x10\xea\x82\x78\xee\x90\x8b\xc1\x41\x1e\x88\x89\xb1\xce\x53\x0a\x9f\xaf\x22\x8a\xde\xa3\xd5\xed\xea\x81\x6c\x93\xbf\x9c\x67\xe7\xdf\x66\x2f\x27\x7f\xe6\x99\xa2\x39\x3a\xe3\xe9\x24\x6e\xc2\x2e\x91\x15\xa5\x4b\xc9\x30\xa9\x21\x72\x64\x41\x64\x76\x06\x33\x44\x10\xca\x75\xeb\xda\x46\x09\xbd\xcc\x8c\x5d\x9e\x55\x77\xcb\x33\x8f\x0f\xfe\x2c\x99\x87\xb3\xfd\x71\xd2\x78\x8d\xba\xb6\xcb\xc7\x19\x87\x6b\xbb\x6c\x54\xe9\xc7\x19\x5c\xdb\xa5\xd0\xf2\x4b\x28\xc2\x4c\xdf\x0e\xda\x02\xb9\xf8\x45\x4c\x41\x34\xd5\xb7\x34\x6c\x8c\x15\xe8\x8c\x4f\x50\xd3\x16\x24\x2b\x8d\xd9\x6f\x92\x85\x0c\xbe\x0b\x42\x76\x01\xe5\x37\xd9\x83\x22\xf6\x3d\x89\xbe\xc3\xe1\xdf\x80\xbb\xfb\xe5\x4c\xbf\x35\xc6\x7f\x36\xaa\x1e\x05\xc0\xa7\x66\x30\xa0\xce\x45\xe5\x6a\x8e\xcd\x43\xb5\xd1\xe8\x85\x5c\xd6\x36\x9e\xa6\x55\xad\x01\xe3\x24\xc0\x18\x0f\x6b\x9e\xfa\xc4\x64\xaa\xc0\xb5\xcc\xf1\xe3\xc1\x40\xb9\x07\xec\x5b\x9e\x70\x38\xe2\x1a\x11\x05\xa2\xce\xed\xa6\xf2\x87\x02\xbb\xde\xb6\xdf\xa5\xf1\x24\x3f\xf7\x2b\xf4\x2b\x0c\x24\x08\xd8\x77\xca\xc1\xcd\xca\x64\xba\xb4\x19\x90\xf0\x16\xcc\xb9\x31\x0a\xc5\x6e\xd5\xbf\x07\xa7\x34\xfa\x7b\xdc\x3c\x0e\xd6\x30\x27\xc9\xfb\xf7\x57\x33\xca\x00\x93\x66\x7a\x93\x96\xee\x60\xc1\x07\x6e\x8c\x83\x64\xfc\x44\x33\x8b\x02\x13\x4b\xf9\x6c\x06\xd3\x45\x9f\x18\x94\x5d\x06\x2b\x26\x39\xdf\x34\xa5\xf4\x1e\x8b\xbe\x85\x26\x1b\x41\xeb\x24\x9f\x44\xc9\x57\x70\x56\xf4\xb4\xac\x9d\x07\xa1\x42\xaf\x01\x3e\x48\x17\x56\x9c\x23\xa5\x9d\xe8\x9c\x9c\x2b\x4c\x8e\xa6\xed\x50\x39\x46\xd8\x83\xfc\x97\x66\xe8\xc0\x60\x87\x9c\xd3\xeb\x9b\x36\x0a\xaa\xcb\x39\x5a\xae\x61\xd3\xd3\x78\x58\x89\x45\xa3\x11\x85\x74\x77\x19\x7c\x34\x3e\x64\xce\xb9\x20\xd0\xbd\x01\xa1\x14\x83\xe5\x86\xa1\x0e\xfe\x8b\x3b\x08\xfe\xe5\x9b\x23\xb8\x75\xbb\x0c\x76\x7f\x4e\x7e\x19\xab\x4e\x33\xf9\xa5\x7b\x9c\x43\x33\xe1\xb9\xd4\xf0\x5e\x36\xc1\xb5\xf3\xc6\x92\x09\x0f\xaa\x9a\xc1\x15\x71\x6b\x8e\xb0\x64\x6b\x69\x43\x61\xbe\x0d\x99\x9c\x16\x95\x5b\x91\x75\xa7\xb5\x8c\x85\x6f\xe1\x39\x97\x63\x70\x1d\x32\xf0\x38\xef\xc5\xd7\x52\xa2\x0c\x0d\x23\x17\xf0\xed\x57\x11\xcb\x0f\x3a\x91\x1d\x62\xed\xf3\x1f\x1d\x1b\xf0\x1c\xb3\x65\x06\xcb\xea\xd5\x29\x48\x73\x7e\x0a\xe8\xf3\x2c\xcb\x0e\xe0\x79\x54\x4e\x0f\x67\x9f\x13\x26\xf1\x53\xd2\x32\xe7\x56\xdf\xe3\x66\xd8\xea\xf6\x45\x64\xf6\xb7\x38\x78\x5f\x2e\xe0\xdc\x2a\xd9\x15\xe1\xbd\x08\x55\xb7\x7e\x76\xf5\x59\x28\x59\x74\x53\x7f\x2c\x2b\xbf\x49\x9d\x06\xcf\x0b\xc3\xc1\x00\x59\xa5\xd9\xec\x6f\xb4\x98\xa3\xe8\x33\x1c\xeb\xa4\x47\xbc\x27\x47\x83\xd1\xbe\xc0\x73\x36\x63\x1d\x1b\xd3\x1d\xf9\xe2\x29\x4e\x33\x46\xb7\x1f\x59\xc7\xc7\xc4\x15\x9f\xbb\x13\x12\x71\x52\x8c\xbc\xdf\x9b\x37\x51\x61\x2c\xac\x65\x24\x54\x32\x17\x4a\x6d\x40\x80\xc6\xfb\x66\xbe\x74\x31\x1e\x29\xe2\xe9\xa9\x07\x0a\xa1\x7d\x3a\x44\xe3\x1a\x91\x51\xca\xdc\x13\x15\x57\xa2\xaa\x50\xbb\x0b\x38\x7f\x01\x97\xbc\xce\xf6\xc6\xa4\xe4\xbd\x8d\x5f\xbd\x80\xeb\x4e\xc1\xa6\x12\x56\x94\x18\xaa\x86\x3a\x64\x34\xd2\x85\xc6\x8f\xb4\x04\x81\xb4\x12\x7a\x49\xb3\x5f\xa7\x7d\x62\x3c\x57\x48\x97\x9b\x35\x5a\x2c\xb2\xfd\xc1\xd2\x71\xa5\x3e\xac\xad\x07\x44\x3a\x4a\xc0\x1b\x63\xd4\x5b\x73\xbf\xa7\x3a\xbb\x13\x6f\x89\xd2\xd4\x3a\xb4\x1a\xc8\x32\x34\x15\x38\xcc\x8d\x2e\xdc\x29\x88\x85\x67\xdf\xe7\x72\xa1\x08\x7d\x41\xc9\x95\xf4\x1b\xc8\x0d\x45\x85\x14\x19\xcd\x71\x41\x24\x13\xda\xb0\x9f\xdc\x1d\x29\x34\x38\x2f\xac\x67\x77\xa9\x4d\xca\xbf\x28\x11\x8c\x05\xd5\xf9\x86\x24\x9e\xf6\x49\xf2\x1b\xc6\x98\x05\xbc\x7e\xf9\x32\x41\x13\x1d\xec\x00\x31\xf6\x4a\x72\x6c\x54\xdb\x47\x04\xde\xe7\x02\xce\x8f\xd3\xa7\x8c\xbd\x71\xc1\x86\x07\x61\xe3\xe3\xe8\x5d\x63\xd6\xe1\xeb\xeb\x57\x3b\x6f\x1b\x23\xbd\xbb\xe9\x21\x76\xc7\xf6\xbe\xaf\x44\x22\x76\x14\xfe\x7e\x48\x3c\x60\x91\x72\x0f\x77\x63\x94\xcc\xf7\x84\x70\x5b\x4d\x90\xbb\x53\xe2\x88\x39\x3a\x58\x99\x7b\x28\x6b\xe5\x65\xa5\x3a\x05\x2b\x8e\x2b\x7a\xa1\x55\x53\x8f\x9f\xbd\x7f\x6c\x77\x45\xd3\xbe\xf0\x56\xba\xd0\x32\xb5\xd3\xa0\xb7\x17\xf2\xe9\xbe\x79\x9c\x54\xc5\x0c\x82\x63\xa3\xf6\x8d\x59\xc0\xb5\x9e\xbc\xc5\x92\x22\xbd\x66\x32\xc7\x7d\xb3\xca\xf8\xf6\xd1\x53\x8f\x69\x8c\x0e\x8b\x5f\x2a\x65\x72\x4e\x5f\x66\xde\x0a\x8f\xcb\x83\x61\x74\x94\xae\xca\x4a\x63\xa5\x97\x5f\xb8\x65\x77\x68\x70\x07\xfd\xeb\xc1\xdd\x40\xea\x42\xe6\x9c\x50\x11\xfb\x42\x2c\x48\x63\x76\x38\xe8\x0d\x2c\x6a\xb5\x20\x3e\xb6\xa4\xc9\x45\x25\x72\xe9\x37\xc3\x51\x04\xea\xba\x1c\x46\x69\x32\x0a\x97\x11\x11\x73\xa2\xe7\x5f\x85\xc3\x37\x11\xa8\x11\x94\x7c\xf9\x1b\xc4\xbb\x09\xb4\x1b\xb4\x39\x6a\x2f\x96\x78\x39\x37\x6b\x7c\x24\xa4\xe7\x2f\x7f\x0b\x58\x5d\x65\xfc\x93\x44\x52\x99\x7b\x74\x7e\x52\x59\x79\xa0\x5e\xdf\x0f\xdc\xf6\x6e\x35\x42\x1e\x1d\x88\xdc\x1a\xe7\xfa\x9a\x08\xa1\x85\xff\xc9\x72\x38\x0a\x81\x49\x23\xf0\x13\x53\x79\x59\x7e\x9d\xd4\x1e\x09\x83\x29\x5e\xb1\xb2\x18\xb2\x21\xe3\x7b\xa6\xae\xd3\x42\x1c\xde\xa6\x93\xc5\xb4\x7c\xff\x88\x82\x13\x87\xa6\xcf\x29\x59\xea\xed\x68\x8d\xcb\xbf\x43\xa6\x9e\x63\xc8\x3c\xa4\xea\x69\xb3\xb8\xc0\xd7\xd8\xd4\x71\x07\x9b\xc7\x8b\x6f\x5d\xda\x1f\x34\x29\xc7\x0e\xd7\x26\xbd\xcd\x0e\xe5\x6f\x4f\x6d\x3a\x38\x30\x39\xf5\x8c\x4e\xdf\x1e\xf1\xd9\x37\xcd\xc0\xa6\x22\x1a\xce\xfd\xb9\x2b\xaf\x3d\x88\x6a\xef\x16\x8c\x22\x52\xbb\xff\x07\xe9\xf6\x1c\x76\x0c\xc0\xf0\x81\xab\x27\x36\x0a\x5d\x38\xdb\x96\x79\x2a\xcc\x3a\x82\x6b\xbb\x11\xc2\xa5\xbd\xda\x6a\x7e\xea\x97\x85\x5b\x6e\xdc\x96\xa8\x8a\x50\xa5\xe9\x94\xc3\xe3\x18\x5e\x55\x50\x98\x8a\x1a\x4c\xa8\x04\x68\x53\x60\xa4\xaa\x83\xdc\x58\x8b\xae\x32\x3a\xb5\x0f\x88\x06\x02\xb2\x28\xcf\xdc\x2e\x40\xbb\xf2\xf9\x4b\xf7\xc0\xba\x7a\xae\xd1\x1f\x6b\xe9\x9d\x85\x51\xdb\xed\xa1\x61\x72\xbf\x36\xba\x7b\xb8\x3b\x08\xf2\x1f\xa0\xdd\xf2\x98\x21\x39\xd0\x43\xf3\x84\x0e\x9a\xa3\xa6\xe6\x48\xf7\xcc\x1f\xab\x77\xe6\x48\x2b\xc5\xaf\xd6\x37\x33\xae\x6b\xe6\x78\xcf\xcc\xaf\xdb\x31\x33\xb2\x83\x64\x4c\xb7\xcc\xef\xd3\x2b\x33\xaa\x53\x66\x74\x9f\xcc\x98\x2e\x99\xe3\x3d\x32\x47\x3a\x64\x8e\xf6\xc7\x8c\xe8\x8e\x39\x06\xe8\x70\x67\xcc\xe3\xfb\x62\x8e\x50\xef\x20\xb4\x43\x70\xee\x27\xe2\x64\xf7\xb2\xc6\xd6\xfb\xfe\xbd\xc4\xe6\x69\xef\x2a\xe4\x41\xb0\xc2\x8d\xc3\xf1\x17\x91\x78\x78\xef\x2a\x92\x99\x3b\xb4\xeb\xaf\xbf\x8b\xe4\x96\xb3\x3d\xb0\xec\xc2\x33\x7b\x1f\x81\x88\x17\xd6\xf8\xff\xb1\x9a\x6c\xd1\xd7\xb6\x63\x55\x45\xed\x4d\x2a\xcf\x75\xaf\x24\xf6\x89\xb2\x97\x95\x39\x45\x2c\x9d\xbb\xd1\x83\x00\xbd\x69\x06\x36\x64\xc9\x6b\x6b\xf9\x56\x1b\x5a\x3e\xae\x6c\x28\x13\x2d\x7d\x87\x3a\x8f\x88\x75\xf6\xef\xda\x6c\xca\x17\x1d\x69\xc7\xf6\xea\x23\xbc\x89\xb7\x45\x2e\x6f\xa6\xad\x87\x8a\xad\x3b\xe1\xc6\x95\x1f\x38\x63\x3e\x66\xae\x95\x70\xfe\xd6\x0a\xb6\x49\xe1\x02\xf8\x28\x05\xfb\x20\x9c\xe7\xa2\x6b\x3a\xcd\x4b\x97\x5f\x9a\xa5\xb0\x08\x3d\xbc\x64\x21\x23\x73\xf9\x66\x0c\xd7\x5a\x63\x44\xda\x1e\xb7\x72\x6d\x9c\x3b\x28\x74\x81\x56\x71\x00\xd3\xae\xda\x94\xab\xa7\x8b\xa6\xf2\xae\x8d\x87\x3b\x6d\xee\x35\x1f\x51\x6a\xa8\x5d\xba\xdd\xc8\x60\x35\x0b\x12\xc9\x42\xe4\x1b\x57\x61\x79\xcb\x73\xac\xbc\x98\x0f\x75\x82\xb5\xa5\x82\x42\x78\x9c\xd0\x8a\x4f\x0d\x5a\x4a\x74\x4e\x2c\xc7\x51\xf5\x12\x56\x75\x29\x34\x5f\xd7\xe6\x23\xc7\x38\x39\x25\xfb\x84\x61\x81\x5e\x48\xe5\x40\xcc\x4d\x1d\x4e\x7d\x5b\x9a\xf7\x03\xfd\x10\x1c\xf2\x29\xcd\x10\x96\x23\x8e\xae\x84\x1b\x2a\x1c\xee\xa9\xd5\x86\xe1\xcd\x31\x6a\xc3\xc1\x67\x8e\x05\xad\x03\x2a\x05\xa1\x9d\x1b\xa0\x34\xb9\x0d\x8d\x6f\xa6\x0c\x7d\xbe\x32\x26\xdc\x3a\xe3\xfa\x7c\x38\x88\x8f\x97\x50\x19\x45\x49\x69\x89\x76\x94\xbf\x50\x80\x06\xcb\x5a\x58\xa1\x3d\x52\x9a\x76\x33\xdd\xa1\x46\xbc\x84\xfa\x75\x14\x71\xb8\x46\x7b\xa0\x14\xd5\xcf\x3c\xe2\xe0\x94\x66\xb9\x5e\x8f\x59\xae\x84\x73\x6d\x5e\x67\x16\xf0\x29\xd0\x2f\x37\x05\x9e\x82\x0b\x61\x66\xed\x28\x8c\xa5\x20\x23\x58\x9b\x70\xc9\x50\x96\x25\x16\x94\x91\xaa\x4d\xd0\x19\xca\xb9\x42\x13\x52\x63\xb5\xa4\xaf\xc3\xca\xf4\x46\xe4\xbe\x0d\x61\x53\x8f\x59\x03\x5f\x20\xd2\xd5\x0f\xb3\xdb\x74\xa5\xcd\x50\xea\xc1\x5a\x14\xcc\xf4\xbf\xbf\x13\xca\x0d\xaa\xcb\x71\xb2\x0d\xf8\x84\x7d\x44\x0b\xd6\x22\x9a\xd9\x46\x86\x4e\x53\x22\x74\x6b\x6b\x3c\x05\x06\xe7\x14\x7e\xd0\x6c\x04\x9e\x0c\xd7\xa1\x73\xe3\xdd\x53\x63\xb3\xe8\x58\xa5\xae\x04\xc7\x1b\x7c\x0b\x63\xb2\xd8\xdb\xc6\x81\x7e\xf7\x8e\xb3\xd0\x1b\xc8\x9a\xd9\x19\x17\x90\xba\xc1\x21\x09\xb2\xf3\xc4\xb8\x58\xb6\x6b\xee\x86\x83\x92\x77\x98\x2e\xa6\x2a\x3c\x85\x79\x4d\x82\x9c\x8b\xda\x21\x08\x3b\x97\xde\x0a\xbb\xe9\x78\xba\x4e\x55\x69\x51\x2b\x78\xee\x10\x21\xa3\x6c\x3e\x7d\x98\xa0\x1d\xfa\x22\x74\x78\xa4\xfb\xac\xde\x40\x81\x94\x11\x2b\x99\xb3\x99\x95\x65\x65\xac\x17\x7a\xb0\x01\xe6\x58\x0b\xd3\x81\xa8\x72\xd2\xff\x22\x45\xff\x95\xdf\x5f\x2b\x7a\x52\x54\x06\xb0\x10\x52\xd5\x16\xaf\x86\x8c\x71\x8f\xd5\x7f\x7a\xd7\x1b\xdd\x9c\xc9\xc4\x9b\x9e\x7c\xf7\x7d\x8d\x7c\xf1\x3d\xdc\xe6\xb4\x31\xd7\xf7\x68\x4b\x49\xee\xb8\xb2\x66\xae\xb0\xe4\x4f\x12\xe8\x5c\xaa\xe4\x9a\x52\x53\x21\x29\x24\xaf\x1a\xbb\x93\x41\x84\x14\x62\x8d\x76\x4e\x26\x2f\x86\x41\xae\x96\xec\xa4\xd8\xa0\x2a\xb3\x5c\xf2\xf1\xa4\x2e\xa2\x9b\x20\x91\xa9\xcb\x2a\x58\xfe\xff\xd2\x5d\x73\x17\x3d\x6b\xb4\x78\x04\x38\xdb\xe4\x68\x7e\xd7\x18\x72\xfe\x58\x83\x14\x9d\x76\x1c\x58\x88\x3c\xd5\x26\xf9\x90\xff\xa1\xc2\xdc\x87\x5c\x71\x4e\x39\xce\x03\x59\xda\xda\x9b\x52\xf8\x78\xe0\x6d\xd6\x68\x83\xd3\x7d\xce\x82\x9a\xa2\x25\x53\x7b\xb1\x44\x92\x30\x92\x57\xa9\x9d\x47\x51\x34\x75\xeb\x78\xff\xd6\x94\xe8\xb9\x5f\x93\x40\xaf\x75\x21\x4a\xd4\x9e\x97\xbd\xb7\x46\x2f\xdb\x06\xda\x48\xba\x67\x2e\x1c\x81\xb7\x1e\xa6\xdb\xd8\xb6\xd8\x6a\x2e\x4a\xed\xaf\xdc\x01\xaa\x6b\xa1\xc2\x37\x20\xe2\xc7\x01\x40\xba\x46\x3e\x9b\x76\xc1\x60\x78\x12\x23\x23\x99\x9a\xcb\xbe\x52\x87\x7e\x86\xdc\x94\x73\xa9\x43\xc9\x88\x6b\x49\xe8\xc9\x3b\xbb\x24\x1f\x04\xe3\x69\x52\xef\x86\x98\xb5\x76\xa1\x95\xb8\x8d\x6c\xbb\xb0\xa6\x76\x3c\x2e\xb3\x85\x76\xa9\x0e\x63\xa4\x77\xa8\x16\x30\x47\x8e\x8d\xac\x4c\xe4\x2f\xa5\x6b\x0e\xe7\x0a\x96\x83\x4b\xbd\x89\xac\x26\x21\xed\x72\xda\xe4\x79\x6d\xa1\xa8\x6d\x12\xc8\x46\x40\x1b\x02\x5e\x75\xbd\xcc\x1c\xe3\x85\x7c\xe1\x82\xc8\x37\x9f\xd4\x48\x92\x1c\xbf\x1b\x21\x74\x71\x16\x65\x94\x22\x2c\xbd\x85\xdb\x33\x47\xd2\x50\xd5\x3e\xfb\xd3\x63\x62\xf7\xa8\xb5\x9f\x06\x82\x90\xbd\x4a\x1b\x5d\xe8\x6f\xa2\xb3\xae\xce\x73\xa9\xf3\x74\xe8\xdf\x53\xd7\xb6\x1a\xea\xd1\x56\x16\xc3\x97\x4c\xfe\xa1\xa7\xff\xd0\xd3\xff\x7f\x7a\xba\xfd\x9d\xa3\x11\xad\x3b\x83\x77\x64\x1e\xb3\x2f\xb7\x9a\x1e\xd9\x8c\x3f\x97\xc5\x47\x3a\xb6\xee\xa4\x85\xcd\xb9\x47\x93\x4e\xb3\x90\x89\x62\x4f\x66\x70\xa8\xc3\x37\x7d\x34\xeb\x28\x14\x61\x58\x3a\x5b\x2a\x8d\xe3\x0f\x16\xa1\xf6\xa4\x99\xa9\xf4\xd2\x36\xa6\x6e\x7d\x8c\xab\xfd\x1d\xee\x71\x19\x3e\xbb\xde\x1b\x38\xed\x3c\x0c\x70\x5c\x30\xb1\xc2\x83\xd0\x36\xda\x7d\x52\xcf\x9b\xc8\x34\x61\x1d\xa3\x7b\xf8\xe9\xe7\x93\x36\xd0\x0f\x49\x36\x16\x1f\xdb\x8f\xbb\x85\x4f\xb7\xfd\x29\x08\x57\xfa\x1a\x1b\xff\xd9\x29\xd3\xc0\x7f\xfe\xf7\x49\xd8\x18\x8b\xcf\xe9\x33\x6b\xf4\xf0\xff\x02\x00\x00\xff\xff\xff\x9e\x47\x96\x69\x4f\x00\x00")
+var _clusterApiInfrastructureClusterXK8sIo_awsmachinepoolsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7c\x7b\x6f\x1b\x39\x92\xf8\xff\xfa\x14\x85\xf9\xfd\xe1\x64\x61\xb5\xe3\x64\x7e\x77\x39\x01\x87\x85\xce\x9e\x64\x85\xc4\xb1\x61\x79\x12\xe0\xf6\x16\x07\xaa\xbb\x24\x71\xcd\x26\xfb\x48\xb6\x6d\x25\xc8\x77\x3f\x14\x1f\xfd\xd0\xa3\xd5\x92\x33\x3b\xc0\xcd\xc6\x06\x62\x75\xf3\x51\xef\x2a\x56\x15\xc5\x0a\xfe\x19\xb5\xe1\x4a\x8e\x80\x15\x1c\x9f\x2c\x4a\xfa\x64\x92\xfb\xb7\x26\xe1\xea\xec\xe1\x7c\x70\xcf\x65\x36\x82\x8b\xd2\x58\x95\xdf\xa2\x51\xa5\x4e\xf1\x12\xe7\x5c\x72\xcb\x95\x1c\xe4\x68\x59\xc6\x2c\x1b\x0d\x00\x04\x9b\xa1\x30\xf4\x17\x40\x2a\x4a\x63\x51\x27\x4f\xc3\x6a\x29\x26\x8a\x25\xfb\x79\x04\xe1\xaf\x37\x03\x00\x26\xa5\xb2\x8c\x16\x8a\xd3\x94\xb4\x5a\x09\x81\x7a\xb8\x40\x99\xdc\x97\x33\x9c\x95\x5c\x64\xa8\x1d\x38\x11\xd8\x87\x57\xc9\xeb\xe4\xdf\x06\x00\xa9\x46\x37\xfd\x8e\xe7\x68\x2c\xcb\x8b\x11\xc8\x52\x88\x01\x80\x64\x39\x8e\x80\x3d\x9a\x9c\xa5\x4b\x2e\xb1\x50\x4a\x98\x84\xcb\xb9\x66\xc6\xea\x32\xb5\xa5\xc6\x64\x1d\xca\x81\x29\x30\x25\x48\x16\x5a\x95\xc5\x08\xf6\x0d\xf7\xdb\x44\xd8\x99\xc5\x85\xd2\x3c\x7e\x1e\x46\x22\x0c\x59\xc1\xdd\x08\x4f\xcb\xf1\x97\xe9\x95\x87\xe9\x46\x29\x02\x15\x40\x70\x63\x3f\x6c\x79\xf9\x91\x1b\xeb\x06\x14\xa2\xd4\x4c\x6c\xe0\xe3\xde\x19\x2e\x17\xa5\x60\x7a\xfd\xed\x00\xc0\xa4\xaa\xc0\x11\x7c\x62\x39\x9a\x82\xa5\x98\x0d\x00\x02\x11\x1d\x90\x43\x60\x59\xe6\x18\xc9\xc4\x8d\xe6\xd2\xa2\xbe\x50\xa2\xcc\x23\x3b\x86\x90\xa1\x49\x35\x2f\x68\xc8\x08\x02\x64\xa0\x91\x65\x2b\x30\x96\xd9\xd2\x83\x00\xf0\x77\xa3\xe4\x0d\xb3\xcb\x11\x24\xfe\x79\xe2\x06\x85\xb7\x44\xa5\x11\xdc\x36\x9e\xd8\x15\x01\x66\xac\xe6\x72\xf1\x03\xb6\x2a\x04\x4f\x99\x59\xdb\xad\xf5\xd0\x6f\x48\x28\x2e\x50\x6f\xdd\x91\x4b\x9e\x97\x39\x70\x69\x2c\x93\x68\x80\x4b\x18\x4f\xdf\x6f\xd9\xb4\xc0\x34\xc9\xb9\x9c\xf2\xaf\x18\xde\xfa\x1d\xaf\x5a\xcf\xf6\x6f\xc8\x9e\x0e\xd9\x90\x3d\x6d\x6e\xc8\x9e\x0e\xd9\xf0\x23\x2b\x65\xba\x84\x3b\xcc\x0b\xc1\x2c\xc2\xe4\x72\xcb\x5e\x9e\x79\xc2\x0d\x8d\x23\xab\x81\x1e\xcf\x8f\xad\x97\xf5\x32\x1b\x3c\xf5\xc3\x1b\xfa\x4e\x12\xb9\xc4\xdc\x59\x0b\x9a\xa1\x0a\x94\xe3\x9b\xc9\xe7\x37\xd3\xd6\x63\x68\xc3\xdd\xd6\x09\xe0\x06\xec\x12\xc1\x4f\x81\xb9\xd2\xee\xe3\x9a\x66\xc0\xf8\x66\x52\xad\x56\x68\x55\xa0\xb6\x95\x66\xfa\xdf\x86\xf5\x6b\x3c\x5d\xdb\xfb\x84\xc0\xf3\xa3\x20\x23\xb3\x87\x7e\xf7\xa0\x45\x98\x05\x8c\x40\xcd\xc1\x2e\xb9\x01\x8d\x85\x46\x83\xd2\x9b\x35\x7a\xcc\x24\xa8\xd9\xdf\x31\xb5\x09\x4c\x51\xd3\x44\x30\x4b\x55\x8a\x8c\xac\xdd\x03\x6a\x0b\x1a\x53\xb5\x90\xfc\x6b\xb5\x9a\x01\xab\xdc\x36\x44\x60\x63\x1d\x53\xb5\x64\x02\x1e\x98\x28\xf1\x14\x98\xcc\x20\x67\x2b\xd0\x48\xeb\x42\x29\x1b\x2b\xb8\x21\x26\x81\x2b\xa5\x91\x0c\x98\x1a\xc1\xd2\xda\xc2\x8c\xce\xce\x16\xdc\x46\xcb\x9e\xaa\x3c\x2f\x25\xb7\xab\x33\x67\x72\xf9\xac\xb4\x4a\x9b\xb3\x0c\x1f\x50\x9c\x19\xbe\x18\x32\x9d\x2e\xb9\x45\x67\x27\xcf\x58\xc1\x87\x0e\x58\x49\x48\x99\x24\xcf\xfe\x9f\x0e\xbe\xc0\x9c\xb4\x88\xb7\x21\x03\xfe\xd7\x59\xbe\x0e\x2a\x93\xf1\x23\xc6\xb2\x30\xd5\x23\x5a\x13\x93\x1e\x11\x3d\x6e\x7f\x99\xde\x41\xdc\xda\x13\xdc\xd3\xb6\x1e\x6a\x6a\x32\x13\x89\xb8\x9c\x23\x49\x08\x37\x30\xd7\x2a\x77\x54\x45\x99\x15\x8a\x4b\xeb\x3e\xa4\x82\xa3\xb4\x60\xca\x59\xce\x2d\xf1\xef\x7f\x4a\x34\x96\x38\x90\xc0\x85\x73\x50\x30\x43\x28\x8b\x8c\x59\xcc\x12\x98\x48\xb8\x60\x39\x8a\x0b\x66\xf0\x37\x27\x32\x51\xd3\x0c\x89\x78\xfd\xc8\xdc\xf4\xc6\xf5\x3f\x5a\x65\x14\xe8\xd4\x78\x11\x7d\xdd\x0e\x9e\xb4\xb5\x6e\x5a\x60\xda\x52\x80\x0c\x0d\xd7\x24\xb0\x96\x4c\x89\x9a\xaf\x69\x69\x6b\xdd\xed\xfa\x47\x3f\xb5\xfb\xb9\x63\x8b\x8d\xb7\xcd\xf7\x37\x3b\xd7\xe8\xa0\xc7\x36\xb4\x5a\x3b\x3a\x99\x93\xa0\xdc\x4b\x26\xc0\xa0\x25\x95\xb5\xf4\xca\x2a\xda\x9e\x34\x91\xc9\x60\xa4\x53\x3c\x25\xb7\x10\xa1\x8a\x5a\xaa\xc8\x7a\xb3\x2c\xc3\x0c\x66\x2b\xa2\x12\x2b\x85\xa5\x3f\xe9\xe5\xf8\xcb\x14\x0a\xad\x1e\x38\x05\x31\x1b\xb0\xed\xe0\x0d\xfd\xb2\x07\xc6\x05\x9b\x71\xc1\xed\xea\x3f\x95\xdc\x86\x78\x1b\xb3\xf5\xf1\x01\x39\xa6\x35\x5b\x11\x56\xcd\x05\xe1\xab\x1f\x11\xd0\x32\x90\x32\x09\xba\x24\x44\x37\x76\xe1\x16\xf3\xc3\xa9\xee\x31\x73\x9b\xaf\xbd\x63\x8f\xa6\xed\x42\xf6\x21\xf6\x65\xba\xe6\x72\x48\x74\xf9\x9c\x07\x51\xf4\xce\x0a\x6c\x7c\x4b\xd6\x31\xd8\x67\xe2\x50\x69\x10\x1e\x97\x28\x9b\x6c\x24\xd2\xf8\x69\x98\x6d\x32\x65\xb7\xbc\xb6\x65\x72\x8a\x69\xa9\xb9\x5d\xbd\xa7\x68\x71\xeb\xd8\x75\x4c\x76\x4c\x5d\xe7\x94\xc6\x39\x6a\x74\x7c\xb1\x0a\x4c\x18\xeb\xa3\x52\xc2\x99\xd9\xe8\x42\x66\x08\xac\x28\x04\xc7\x2c\x0a\x63\xc5\xd2\x04\xee\x96\x68\x70\x63\xfa\x63\x9c\x48\xc2\xbe\x26\xcd\x4c\xae\x36\xc6\x7b\xad\xcf\x80\x45\x7b\xe9\x62\x66\x10\x64\xc5\x40\x69\x52\x08\x7a\xce\x52\x5b\x32\xab\xb6\xc8\x78\xa7\x0c\x6d\xe3\x76\x3c\x67\xdc\x46\x32\x38\xfa\xd4\x54\x21\x54\x59\x14\x82\x94\x66\xd4\x3e\x61\xb6\x82\xc9\xe5\x29\x8c\x6f\x3f\x9d\x12\x70\x73\x2e\x2c\x6a\x93\xc0\xb5\x14\x2b\x50\xd2\x99\xaa\x30\x80\xde\xbf\xf3\xef\x9d\x37\x9d\xd5\x82\x95\x25\x40\x16\x8f\xcf\x57\xe4\x79\x72\x72\xa5\x76\x49\x8e\x5c\x22\x3c\x72\x21\x68\x3f\xd2\x71\x22\x1f\xf9\x2a\x9e\x79\x77\x8f\x5a\xef\xa2\xc0\x3e\xb1\x0a\xc2\xa5\xd7\xa2\x91\x2e\x4a\xdd\x7e\x22\x6c\x22\xea\x1d\xd3\x3a\x15\x35\xfe\x04\x52\xf5\xde\xfe\x24\xd2\xce\x31\x27\x58\xce\x7b\x5c\x9d\x79\xdf\x5d\x30\xae\x0d\x94\xc6\x4b\x26\xcf\xc8\xad\xcd\x57\xc0\x6a\x56\xdd\x2d\x71\x05\x4c\xd7\x12\xcc\xd2\x54\xe9\x8c\x28\x1e\x64\x59\x97\x02\x6b\x01\x6c\x18\xd4\xf1\xcd\xa4\xf6\xb8\x99\x4a\x4d\xc2\x1e\x4d\xc2\x72\xf6\x55\xc9\x24\x55\xf9\xd9\xf8\xcb\xf4\x97\x8b\xd7\x67\x64\x2d\x8c\x3d\xfb\xd5\xa0\x7e\x5f\xf2\x0c\xcf\x7e\xa5\xd3\xd2\x7f\x7b\xc8\xb9\x5c\x24\x4b\x9b\x8b\x93\xc1\x4e\x84\xbb\xe5\x76\x0b\x51\xfc\xca\x5e\x5e\x3d\x41\xb7\x90\x40\xb6\x44\xb6\x73\xf1\x3e\x32\xd3\x88\xcd\xf7\x8c\x59\x03\x96\xce\x85\x24\x41\xa4\xbe\x1e\xd8\x24\x28\x84\x3b\x60\x18\xc7\x9c\x94\x19\x1c\x1a\x4a\x0c\x58\xfe\x80\xc9\xa0\x73\xf9\xbe\xb2\xe6\x7f\x9c\xa0\x98\x03\x81\xfe\xec\x26\x01\x97\xa9\x28\x33\x34\x4e\x25\x95\xf6\x3a\x1a\x28\x1e\x43\xe0\x80\x8b\xff\x78\x14\x32\x3d\xd8\x7f\x30\xd6\x5d\xae\xb1\xfd\x8f\x62\x51\x0a\xb2\xba\x01\x18\x3a\x66\xed\x19\xe2\x69\xd0\x39\xa8\x23\x14\x39\x14\x78\xde\x09\x72\x8b\x9d\x93\xcb\x1f\x67\xc3\xf6\x62\xd0\x0d\x3b\xcb\xf9\x68\xb0\x17\xe0\xf1\xd5\x24\x1e\x41\x5b\x0e\x89\x1e\xd0\x3b\x77\xca\x78\x5c\x72\x8a\x48\x94\x4f\x50\x91\xf3\x40\x08\xc7\xd3\xca\x41\x27\x83\xe3\x14\xbe\xd3\x45\x1c\xe5\x20\x7a\x90\x76\xaf\x73\xf8\xa3\xb9\x86\xbd\x96\xa1\x45\x90\x1f\xeb\x16\xfa\x3a\x85\x3e\x2e\xe1\xb7\x76\x08\x3d\x64\xeb\x10\x67\xf0\x7b\xb9\x82\x9e\x8e\xa0\x37\xb6\xfb\x6c\xd1\x21\x2e\x60\xaf\x03\xe8\x61\xfe\x7b\x19\xff\xfd\x20\x77\x19\xfe\x63\xcc\xfe\x5e\x7a\xee\x81\x9b\xb3\x7c\x12\xcc\xed\x8d\x56\x73\x2e\x76\xe8\x43\x0b\xb6\xbb\x25\x3a\x8a\x42\x48\x2d\x8e\x9d\xed\x80\x78\x2a\xf1\x5a\xf2\x62\x7c\xfb\xe9\x65\xd4\x95\x68\xd2\x29\xc4\xa7\x4d\x80\x19\xa3\x52\x4e\x19\x23\x78\xe4\x76\xe9\x14\x6a\x32\xbe\x02\xad\x04\x56\x29\xcb\xca\x11\xc0\xdd\xb6\x35\x28\x67\xc4\xb8\x34\xad\xc9\xc9\xe0\x08\x32\xf1\xac\x27\xd6\xde\x19\x6f\x39\x53\x1f\xb7\x6d\xce\x16\xf8\x51\xa9\xfb\xb2\xf8\x0f\x66\xf0\x7a\xda\x03\x8a\xc9\xfa\x9c\xe8\x6b\x65\xc3\x34\xcd\x98\x41\x20\x13\xc8\x2c\x1d\x16\xcc\xca\x58\xcc\xe3\x51\x9f\xa8\xcb\x69\x15\x10\x6e\xeb\xca\x31\x73\x03\x94\xcc\x33\x68\x9f\x8b\xcd\x3b\xa5\x73\x66\x7b\x60\x73\xd2\x40\xc7\x4f\x8a\xe8\x50\xa4\x20\x59\x4e\xe0\xcf\xfd\x0b\xab\x1c\xc0\x10\x20\xf6\x28\x10\x32\x2e\x77\x19\x83\x87\x89\xf5\xe7\xcf\x19\x02\x5f\x48\x45\x19\x38\xee\x52\xcc\xf8\x44\xe5\x0e\x6e\x23\xaa\x84\x26\x4c\xcb\xa2\x50\xda\x1a\xca\x6e\x1a\xcb\x6d\x49\x21\xb4\x71\x02\xf8\xed\x5b\xe2\x09\xfc\xfd\xbb\x4b\x27\x7f\xfb\x96\x7c\x78\x6b\x42\x39\xf0\xfb\xf7\x5a\x6c\x1d\xb1\xaf\xa7\x6e\x10\xd5\xe3\xb4\x44\x8b\x26\x26\x58\x4e\x49\x87\x0b\x4c\xc9\x86\x8a\x95\x4b\x39\x40\x60\x5c\x84\x93\x16\x71\xf6\x87\x32\x05\x9b\xfc\x55\x1a\xca\x59\x29\x6d\x09\x2f\x68\x64\xc8\x9e\xbd\xf4\x49\x6e\x7a\xb2\xb9\x29\xb0\x0d\xcf\x5f\xb0\xf4\x9e\x2d\xd0\x90\x06\x66\x65\xea\x33\x71\xf5\xcc\x33\x8d\x02\x09\x11\x42\x4b\x95\x16\x1e\x68\x0d\x06\x85\xc6\x39\x7f\x1a\xc1\x79\x72\xfe\x26\x79\x75\x4a\xff\xbf\x4e\xfe\xff\x30\x5f\xb9\xaa\x63\x72\xee\x72\x08\xe7\xc9\xf9\xbf\x26\x6f\x12\x78\xa7\x34\xe0\x13\xcb\x0b\x81\xa7\x21\x07\xea\x60\x0d\xe2\x16\xf8\xa8\xe6\x90\xb2\x82\x0d\x59\xce\x87\x0d\x22\x0f\xff\xbc\x46\xe1\xe1\x9f\x3c\x27\x51\x66\xc4\x73\x83\x2e\xe3\xee\xe5\x81\x98\x18\xf2\x3c\x39\xb3\xa4\x87\x0e\x45\x6b\x51\xcb\x7a\x75\x4f\xb6\xe1\x9f\xcf\x93\xf3\xb7\xc9\xab\xe1\x9f\xdc\x4c\x56\x95\xce\xdc\x74\x12\x37\xa6\x17\xe8\x14\xa5\x49\x49\x3f\xa9\x22\x72\x60\x41\x60\x36\xa5\xd0\x11\x98\x30\xcd\xbc\xb6\x12\x4c\x2e\x12\xa5\x17\x67\xc5\xfd\xe2\xcc\xe2\x93\x3d\x8b\xe6\xe1\xec\xe4\x99\x1a\x75\xad\x17\x87\x19\x87\x6b\xbd\xa8\x54\xe9\xcb\x14\xae\xf5\x82\x49\xfe\xd5\x27\x61\x26\x97\x3b\x6d\x01\x9f\xff\x10\x53\x10\x4c\xf5\x1d\x0d\xdb\x0f\xf6\x49\x74\x41\x34\x3e\x42\x4d\xb4\x21\x7b\x56\x99\xfd\xea\xb0\x90\xc0\x2f\x5e\xc8\x46\x90\xff\x9c\x3c\x09\x62\xdf\x51\xf4\xdd\x1d\xfe\xed\x70\x77\x3f\xce\xf4\x6b\xa5\xec\x67\xaa\x24\xf7\x01\xe0\xb6\x1a\x0c\x28\x53\x56\x98\x92\x64\xca\x93\x29\x55\x72\xce\x17\xa5\x0e\xd5\xb4\xa2\x36\x60\xf4\x96\xb6\x81\x07\x37\x75\x70\x5c\xa0\x9c\xe1\x03\x4f\xf1\x53\x67\xa0\xdc\x02\xf6\xd2\x4d\xe8\x8e\xb8\xf6\x10\x87\x7e\x51\xa6\x7a\x55\x58\xcc\x7a\x6e\xfb\x4b\x1c\x4f\xf2\xf3\xb8\x44\xbb\x74\x55\x2d\x0c\xd8\x37\xd2\xc1\xd5\xca\x64\xba\xa4\xb2\xc9\x1e\x30\x67\x4a\x09\x64\xb2\x1b\x4e\xae\xe4\x07\x5c\x1d\x06\xab\x9f\x13\xe5\xfd\xc3\xd5\x14\xee\x71\x15\x35\xd3\xaa\xb8\x74\x03\x0b\x57\x70\x23\x97\x81\xdc\xe1\xc7\xaa\x59\x14\x98\x68\x3a\xcf\x26\x30\x99\xc7\x0d\x3c\x31\x28\xf1\xe8\xad\x18\x77\xe7\x4d\x95\x73\x6b\x31\x6b\x5b\x68\xca\xb7\xd1\xee\xd1\x27\xd1\xd9\xd3\x3b\x2b\x7a\x9a\x97\xc6\x02\x13\xbe\xd7\x00\x9f\x38\x7d\x92\x8e\x98\x2c\x4d\xd1\x18\x3e\x13\x18\x1d\x4d\xdd\xa1\x92\x3c\x87\xff\x5c\x15\xa6\x27\x39\x27\xd7\x37\x75\x14\x54\xe6\x33\xd4\x64\x37\xdc\xd3\x50\xac\xc4\xac\xd2\x88\x8c\x9b\xfb\x04\x3e\x29\xeb\x4f\xce\x29\x23\xd0\x29\x5d\x2e\x84\xe3\xb7\xd9\x0d\xb5\xf7\x5f\xae\x65\xe1\x5f\x7e\xde\x39\x6a\xb3\xcb\x60\xf3\x9f\xe1\x5f\xfb\xaa\x13\xf5\x2f\x54\x59\x77\xe3\x66\xc2\x0b\x2e\xe1\x3d\xaf\x82\x6b\x63\x95\x26\x13\xee\x55\x35\x81\x2b\xe2\xd6\x0c\x61\xe1\xac\x25\x69\x01\x93\x8d\x90\xc9\x48\x56\x98\x25\x05\x7a\xb4\x96\xd2\xf0\x16\x5e\xb8\x74\x0c\x3e\xf8\x13\x78\x98\xf7\xf2\xb9\x94\xc8\x7d\xc3\xc8\x08\xde\x3e\x8b\x58\x76\x55\xf4\x25\xd6\x36\xff\xd1\xb0\x01\x2f\x30\x59\x24\xb0\x28\x5e\x9f\x02\x57\xe7\xa7\x80\x36\x4d\x92\xe4\xe5\x33\xe4\xb4\xfb\xf4\x39\x74\x24\x3e\xe6\x58\x66\xcc\xf2\x03\xae\x76\x5b\xdd\xb6\x88\x4c\xff\x12\x06\x6f\x3b\x0b\x18\xb3\x8c\x76\x85\x59\xcb\xd2\xe5\x7a\x1d\x2c\xa1\x34\x01\x8f\x7d\x11\x2e\xa9\x84\x79\x61\x57\x01\x6f\x78\x91\x29\x77\x2e\x20\xab\x34\x9d\xfe\x85\x16\x33\x14\x7d\xd2\x04\x9e\xc5\x47\xce\xe0\xbb\x68\x30\xd8\x17\x78\x41\x13\x9a\x36\xa6\x39\xf2\xe5\xe0\x08\x7a\x87\xe8\xf6\x93\xd3\xf1\x1e\x84\x39\x09\xb1\xa4\x9f\x10\x89\x13\x63\xe4\xed\xde\xbc\x8a\x0a\x43\x62\x2d\x81\xbb\x55\xc1\x53\x26\x04\x95\x66\x24\x3e\x56\xf3\xb9\x09\xf1\x48\x16\xaa\xa7\x16\x28\x84\xb6\xb1\x88\x46\x7b\xcd\x95\x10\xea\x91\x42\xcb\x25\x2b\x0a\x94\x66\x04\xe7\x2f\x61\xec\xd6\x59\xdf\x98\x94\xbc\xb5\xf1\xeb\x97\x70\xdd\x48\xd8\x14\x4c\xb3\x1c\x5d\x31\x8e\xaa\x6a\xd2\x1b\x62\x5a\xbb\x5a\x82\x40\x5a\x32\xb9\x20\xdb\xfd\x26\xee\x13\xe2\xb9\x8c\x9b\x54\x3d\xa0\xc6\x2c\x39\x19\x1c\xa7\xd4\xdd\xda\xda\x21\xd2\x41\x02\x2e\x94\x12\x97\xea\x71\x4b\x76\xb6\xc5\x36\xf2\x3a\x2c\x57\xa5\x74\x05\x33\xcb\x73\xdf\x54\x60\x30\x55\x32\x33\xa7\xc0\xe6\x94\xb8\x62\x60\x52\x26\x08\x7d\x46\x87\x2b\xaa\xc7\xa6\x8a\xa2\x42\x8a\x8c\x66\x38\xa7\x72\x07\x93\xca\xf9\xc9\xcd\x91\x4c\x52\x63\x86\xb6\xce\x5d\x4a\x15\xcf\x5f\x74\x10\x0c\x09\xd5\xd9\x8a\x32\x90\xb4\x4f\x94\x5f\x3f\x46\xcd\xe1\xcd\xab\x57\x11\x9a\xe0\x60\x07\x07\x48\x72\x68\x54\xdb\x46\x04\xb7\xcf\x08\xce\xf7\xd3\x27\x0f\xbd\x71\x64\x60\xa2\xb0\xb9\x72\x74\x32\xe8\xe0\xeb\x9b\xd7\x83\x9d\x46\xfa\x7c\x70\x08\xbb\x43\x7b\xdf\x33\x91\xf0\x7b\xff\x8e\x48\x3c\x61\x16\xcf\x1e\xe6\x46\x09\x9e\xae\x46\xdd\x60\x5f\x6d\x99\x12\x46\xcc\xd0\xc0\x52\x3d\x42\x5e\x0a\xcb\x0b\xd1\x48\x58\x11\x21\x4d\x2b\xb4\x8a\xc1\xd2\x78\xfa\x3e\x19\x1c\x16\x94\xc7\x55\xcd\x25\x27\x03\x3d\x73\x29\x8b\x6d\x03\xd7\x20\x9f\x6c\x9b\x47\xbe\x20\x9e\x20\x10\xb2\xe6\x1b\x35\x87\x6b\x39\xbc\xc4\x9c\x62\xc7\x6a\xb2\x8b\xfb\xa6\x85\xb2\xf5\xa3\xe4\xc8\x93\x85\x92\x7e\xf1\xb1\x10\x2a\x75\xc7\x97\xa9\xd5\xd4\x22\xdc\x19\x46\x07\xe9\x2a\x34\x57\x9a\x5b\x6a\xe8\xeb\x18\xdc\x40\xff\x7a\xe7\x6e\xc0\x65\xc6\x53\x77\xa0\x22\xf6\xf9\x58\x90\xc6\x6c\x70\xd0\x2a\x98\x97\x62\x4e\x7c\xac\x49\x43\xd9\x86\x94\xdb\xd5\x76\x32\xd0\x0f\xca\x32\xdf\x8d\xd2\xb0\x17\x2e\x7b\x3c\x63\x93\x9e\x94\x5d\xba\x08\x40\xf5\xa0\xe4\xab\x9d\x43\xf6\x3b\x84\x7d\x0a\xb6\x0e\xda\x0d\xea\x94\x3a\x3f\x17\x38\x9e\xa9\x07\x3c\x10\xd2\xf3\x57\xff\x08\x58\x4d\xa1\xec\x51\x22\x29\xd4\x23\x1a\x3b\x2c\x34\x4f\xb1\x9f\x4c\x4e\xb7\x6e\xd5\x43\x1e\x0d\xb0\x54\x2b\x63\xda\x9a\x08\xae\xb1\xf7\x78\x39\xec\x85\xc0\xb0\x12\xf8\x21\x35\x05\xe6\xcf\x93\xda\x3d\x61\x30\xc5\x2b\x9a\x67\xbb\x6c\x48\x67\xcd\xa9\xad\xfd\x71\x21\x17\xde\xc6\xca\x62\x5c\xbe\x15\x0e\x3b\xa0\xeb\x3e\xa7\x68\xa9\xd7\xa3\x35\xca\x93\xee\x34\xf5\x2e\x86\xa4\x86\xc1\x68\xee\x29\x77\xed\x17\x78\x8e\x4d\xed\x63\x55\xfb\x24\xdf\x7a\x32\x67\x7f\x71\x6d\xd8\xda\x6c\xc7\xa0\x3d\x3c\xee\xae\x9a\x75\x4c\x8e\x3d\xa3\x93\xcb\xd1\xa0\x93\xf9\x37\xd5\xc0\x78\x04\x08\x75\x7f\xbb\x6c\x15\xa2\xea\xbb\x05\xbd\x88\x54\xef\xff\x91\x1b\xdb\x1b\x06\x1a\xec\xa4\x90\x76\x0f\xb5\x6d\x52\x77\xf2\xb9\x93\x4b\x43\xc1\xdc\x7a\x23\x84\x89\xfd\xb1\x75\x36\x3f\x2c\x47\x29\x1a\x6a\xdc\xe6\x28\x32\x9f\xa5\x69\xa4\xc3\xc3\x18\xb7\x2a\xa3\x30\x15\xa9\x3f\xcf\xcd\x97\x2a\xc3\x40\x55\x03\xa9\xd2\x54\xa3\x50\x32\xb6\x0f\xb0\x0a\x02\xb2\x28\x27\x66\x13\xa0\x64\xd0\x5b\x17\x3b\xc5\x6c\x37\xeb\x4d\x39\x93\x68\xcd\x1e\xb2\x4e\xfd\xa8\xf5\xf6\x50\x3f\xb9\x9d\x1b\x35\xfd\x41\xfe\x03\xb4\x5b\xee\x33\x24\x1d\x3d\x34\x47\x74\xd0\x74\xca\x40\x8f\xee\x99\x3f\x56\xef\xcc\x4e\xb9\xdc\x42\x8c\x1f\xd9\x37\xd3\xc7\xb9\xf4\xe9\x99\xf9\x6d\x3b\x66\xf6\xca\x52\xff\x6e\x99\xdf\xa7\x57\x66\x0f\x7b\x0f\xc2\xb2\xcb\x82\xf6\x77\xe3\x7b\x3b\x64\xf6\xf6\xc7\xec\xf5\xf1\xfb\x01\xdd\xd5\x83\x71\x4c\x5f\xcc\x1e\xea\x75\x42\xbb\x0b\xce\xed\x44\x1c\x6e\x5e\xd6\x58\x7b\xdf\xbe\x97\x58\x3d\x6d\x5d\x85\xec\x04\xcb\xdf\xe1\x1c\x0d\x76\x92\x64\xed\x22\x92\x1b\x1e\x8c\x97\x0f\x77\xd4\xcc\xd0\xbd\xba\x67\xdf\x45\x32\x8b\xe9\x16\x58\x36\xe1\x99\xbe\xf7\xe3\x82\x85\xf6\x7f\x87\x6c\xb2\x46\x5b\xea\x86\x55\x65\xa5\x55\x31\x3d\xd7\xbc\x92\xd8\x83\x95\x29\x45\x2c\x8d\xbb\xd1\x3b\x01\xba\xa8\x06\x56\x64\x49\x4b\xad\xdd\xad\x36\xd4\x54\x34\xa9\x29\x13\x2c\x7d\x83\x3a\xc9\xa0\xb7\x06\x6f\xdf\xb5\xda\x94\xee\x47\x38\x56\xd4\x57\x1f\xe1\x22\xdc\x16\x19\xdf\x4c\x2a\xa9\x8e\xad\x3b\x74\x85\xc6\x43\x96\x0c\x0e\x37\xd7\x82\x19\x7b\xa7\x99\x6b\xe5\xf6\x17\xc0\xb7\x8f\x5b\x03\xfb\x23\xa5\xb0\x29\xe9\x1a\xab\x79\xf1\xf2\x4b\xb5\x14\x55\xd3\xa8\x87\x97\x82\x0f\x82\xae\xf4\x57\xc1\x7c\xae\x35\x44\xa4\x75\xb9\xd5\xe5\xc6\x69\xa9\x52\x66\xa8\x85\xbb\x2f\x52\xaf\x5a\xa5\xab\x27\xf3\x2a\xf3\x4e\xc5\x86\x7b\xa9\x1e\xa5\x2b\x51\x4a\x28\x4d\xbc\xdd\xe8\xc0\xaa\x16\x24\x92\xf9\xc8\x37\xac\x42\x93\xa9\x20\x59\x58\xaa\xea\x25\x83\xee\x54\x01\xdd\x57\x1c\xd2\x8a\xc7\x19\x11\x80\x1c\x8d\x61\x8b\x7e\x54\x1d\xc3\xb2\xcc\xe9\x06\x19\xb2\x8c\x80\x8b\x93\xe3\x61\x9f\x30\xcc\xd0\x32\x2e\x0c\xb0\x19\x35\xe0\x10\xc9\x6a\x9a\xb7\x03\x7d\x1f\x1c\xba\x2a\x4d\x72\x2c\xf4\x1a\x99\x51\xb2\x17\xf0\x94\xab\xf5\xc3\xab\x32\x6a\xc5\xc1\x13\xe3\x04\xad\x01\x2a\xf0\xd6\x0d\xd0\xbb\x65\x15\xc6\xa6\x14\x3f\x39\xe8\xd3\xa5\x52\x06\xab\x3a\xbd\x2f\xc4\x87\x4b\xa8\x0e\x45\xaa\x64\x28\x69\xe8\xc8\x44\x01\x1a\x2c\x4a\xa6\x99\xb4\x48\xc7\xb4\x9b\xc9\x06\x35\xc2\x25\xd4\xe7\x51\xc4\x50\x01\xb4\x23\x15\xd5\xa2\xc9\x34\x0c\x8e\xc7\x2c\xd3\xea\x31\x4b\x05\x33\xa6\x3e\xd7\xa9\x39\xdd\xed\x37\x4a\x42\xaa\x32\x3c\x05\xe3\xc3\x4c\xaa\x2f\x18\x17\x64\x78\x6b\xe3\x2f\x19\xf2\x3c\xc7\x8c\x5a\x23\xc5\xca\xeb\x0c\x9d\xb9\xa8\x7c\x8f\xb5\xd5\xe2\x74\x95\x8c\x56\xa6\x37\x2c\xb5\x75\x08\x1b\x7b\xcc\x2a\xf8\xbc\x86\x5c\xfd\x3a\xbd\x8b\x57\xda\x14\xdd\xf4\x72\x5a\xe4\xcd\xf4\xbf\xbf\x63\xc2\xe0\xf1\x64\xdb\xe1\x13\xb6\x11\xcd\x0d\x8d\x66\xb6\x92\xa1\xd3\x78\x10\xba\xd3\x74\x79\xdb\x81\x73\x0a\xbf\x4a\x67\x04\x8e\x86\xab\xab\x6e\xdc\x82\xea\x2e\x54\x8a\x2b\x78\x5a\x12\x1c\x6e\xf0\xcd\x95\x4a\x42\x6f\x9b\xbb\x3f\xd5\x90\xf0\x2b\xba\x14\x98\x54\xb3\x13\xda\xb8\x15\x1c\x92\x20\x1b\x4b\x8c\x0b\x69\xbb\x68\xe8\x0d\x08\x7e\x8f\xf1\x62\x2a\x75\xcd\xcd\x4a\x12\xe4\x94\x51\xed\x94\xe9\x19\xb7\x9a\xe9\x55\x0d\x99\x97\x10\x9f\x55\x9a\x97\x02\x5e\x18\x44\x48\xe8\x34\x1f\xbf\x98\xa0\x1e\xfa\xd2\x99\x4f\x88\xf7\x59\xad\x82\x8c\x6a\x56\x73\xc1\x53\x57\xe0\xe4\x39\xb5\x3e\x32\x69\x8f\xa4\x70\x57\x54\x39\x0c\x52\xb1\xf5\x95\xdd\x9e\x2b\x3a\x2a\x2a\x03\x98\x33\x2e\x4a\x8d\x57\xbb\x8c\x71\x8b\xd5\x3f\xbd\x6b\x8d\xae\x6a\x32\xa4\x16\xe1\x9a\x26\x3e\x10\xa3\x9c\x2f\x22\xdb\x14\xce\xfa\x16\x75\xce\xc9\x1d\x17\x5a\xcd\x04\xe6\xee\x2b\x09\x64\xca\x45\x74\x4d\x21\x60\x70\xf5\x11\xb7\x6a\xe8\x4e\x06\xe6\x6f\x5e\x3d\xa0\x9e\x91\xc9\x0b\x61\x90\x29\xb9\x73\x52\xce\xa0\x0a\xb5\x58\xd0\x3a\x34\xd7\xbb\x89\x54\x49\x53\xe6\x0e\xe8\x04\xfe\x4b\x36\xcd\x5d\xf0\xac\xc1\xe2\x11\xe0\xb4\x44\x34\xbf\x0f\xe8\xcf\xfc\x21\x07\xc9\x1a\x5f\x18\x03\x73\x96\xc6\xdc\x24\x89\x25\x3e\x51\x5b\xaa\x3f\x26\xcf\xe8\x7e\xdb\x13\x59\xda\xd2\xaa\x9c\xd9\x50\xf0\xa6\x04\x29\x90\x8b\x84\x17\x4e\x50\x63\xb4\xa4\x4a\xca\xe1\x53\x17\x00\xc9\x2b\x65\x85\x90\x65\x55\xde\xda\x6f\x6d\x54\x8e\x76\x49\x68\x91\xa5\x2e\x65\xc6\x72\x4a\xfd\x53\x1d\xfd\x51\x2b\xb9\xa8\x1b\x68\x03\xe9\x4e\x8c\xcb\xba\xc6\xa6\xf2\x56\xf2\xa6\x61\x32\x02\x36\xb1\xfd\xd5\x75\x80\xca\x92\x09\x57\xe3\xd3\xe1\xcb\x01\x88\x69\x51\x3e\xab\x76\x41\x97\x5b\xab\x18\x19\xc8\x54\x5d\xf6\xe5\xd2\xf7\x33\xa4\x2a\x9f\x71\xe9\x76\x75\x13\x0c\x5a\xea\x0d\x35\x51\x3e\x08\xc6\xd3\xa8\xde\x15\x31\x4b\x49\x85\x63\xa5\x6d\x1d\xd9\x36\x61\x0d\x38\xf9\x34\x9b\x6f\x97\xaa\x5f\x03\xb7\x06\xc5\x1c\x66\x48\xd4\x4a\xa9\x14\xe4\xc9\x9f\x73\x53\x15\xe7\x32\x27\x07\x63\xb9\x0a\xac\x26\x21\x6d\x72\x5a\xa5\x69\xa9\x21\x2b\x75\x14\xc8\x4a\x40\x2b\x02\x06\x3a\x57\x36\xc4\x5f\xc8\x67\xc6\x8b\xbc\x89\xc9\x8f\x30\x2c\x68\x22\x91\xf9\x2c\xc8\x28\xf5\x93\xc9\x35\xdc\x4e\x0c\xa8\xd2\x16\xa5\x4d\x7e\x1a\x1c\x60\x40\x82\xd6\xde\xee\x08\x42\xb6\x2a\x6d\x70\xa1\xff\x10\x9d\x35\x65\x9a\x72\x99\xc6\xa2\x7f\x4b\x5d\xeb\x6c\xa8\x45\x5d\x68\xb4\xec\x9f\x7a\xfa\x4f\x3d\xfd\xbf\xa9\xa7\xeb\xdf\x73\x34\x1a\x1c\x7f\x47\xe6\x90\x7d\x5d\xab\xe9\x9e\xcd\x6e\x69\x0c\x59\x7a\xab\xcb\xc6\xb1\x30\xd6\x46\xea\xe3\xb4\x13\x32\x96\x6d\x39\x19\x74\x75\xf8\xc6\x2f\xcd\xda\x0b\x85\x1f\x16\x6b\x4b\xb9\x32\x96\xa2\x03\x94\x56\xac\xea\xd4\x4b\xdd\x98\xba\xf6\x65\x5c\x9b\x07\xd3\xed\x3d\x2e\xbb\x6b\xd7\x5b\x03\xa7\x8d\x87\x64\x13\x30\x1b\x39\x62\xf9\x07\xbe\x6d\xb4\xf9\xa4\x9c\x55\x91\xe9\x68\xd0\xca\x3e\xc1\xb7\xef\x83\x3a\x11\xe5\x0f\xd9\x98\x7d\xaa\xbf\xdc\x8d\xbe\x83\x67\x04\x3f\xfd\xd4\xfa\x36\x36\xf7\xb1\x8e\x48\x47\xf0\xd7\xbf\xd1\x97\xae\x59\xba\x96\xf3\x39\x7e\xcd\x1a\xfc\xf5\x6f\x83\xff\x1d\x00\xff\x9e\x47\x96\x69\x4f\x00\x00")
 
 func clusterApiInfrastructureClusterXK8sIo_awsmachinepoolsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -223,7 +224,7 @@ func clusterApiInfrastructureClusterXK8sIo_awsmachinepoolsYaml() (*asset, error)
 	return a, nil
 }
 
-var _clusterApiInfrastructureClusterXK8sIo_awsmachinesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6d\x73\x1b\x37\x92\xf0\x77\xfd\x8a\xae\x3c\x1f\x6c\x6f\x91\xa3\xb5\xbd\xcf\x5d\x4e\x55\x5b\x7b\x8a\x6c\x27\xac\x44\xb6\xca\x54\x9c\xaa\xdd\x6c\x5d\x81\x33\x4d\x12\x2b\x0c\x30\x0b\x60\x24\xd1\xa9\xfc\xf7\xab\x6e\x00\x33\x43\x72\x86\xa4\x14\x3b\xd9\xdc\x92\x1f\x76\x2d\x0c\x5e\x1a\x8d\x7e\x47\x37\x22\x2a\xf9\x01\xad\x93\x46\x9f\x81\xa8\x24\xde\x7b\xd4\xf4\x97\xcb\x6e\xbe\x74\x99\x34\xa7\xb7\xcf\x4f\x6e\xa4\x2e\xce\xe0\xa2\x76\xde\x94\xef\xd1\x99\xda\xe6\xf8\x0a\xe7\x52\x4b\x2f\x8d\x3e\x29\xd1\x8b\x42\x78\x71\x76\x02\xa0\xc4\x0c\x95\xa3\x7f\x01\xe4\xaa\x76\x1e\x6d\x76\x3f\x6e\xa6\x12\xaa\x5a\x8a\x3f\x9d\x41\xfc\xd7\xcb\x13\x00\xa1\xb5\xf1\x82\x26\x4a\xc3\x8c\xf6\xd6\x28\x85\x76\xbc\x40\x9d\xdd\xd4\x33\x9c\xd5\x52\x15\x68\x79\x8e\x04\xec\xed\x1f\xb3\x17\xd9\x7f\x9d\x00\xe4\x16\x79\xf8\xb5\x2c\xd1\x79\x51\x56\x67\xa0\x6b\xa5\x4e\x00\xb4\x28\xf1\x0c\xc4\x9d\x2b\x45\xbe\x94\x1a\x5d\x26\xf5\xdc\x0a\xe7\x6d\x9d\xfb\xda\x62\xb6\x09\xe1\x89\xab\x30\x27\x28\x16\xd6\xd4\xd5\x19\xec\xeb\x1e\x96\x48\x70\x0b\x8f\x0b\x63\x65\xfa\x7b\x9c\x10\x30\x16\x95\xe4\x96\x80\xc7\xf3\x1f\xa6\x97\x01\x1e\x6e\x54\xd2\xf9\x6f\x37\x3e\x7c\x27\x9d\xe7\x8f\x95\xaa\xad\x50\x6b\x7b\xe0\x76\x27\xf5\xa2\x56\xc2\x76\xbf\x9c\x00\xb8\xdc\x54\x78\x06\x6f\x09\xa8\x4a\xe4\x58\x9c\x00\x44\x84\x31\x50\xe3\x88\x92\x88\xfe\x17\x61\xae\x7c\x89\xa5\x08\x30\x03\x98\x0a\xf5\xf9\xd5\xe4\xc3\xcb\xe9\x5a\x33\x40\x81\x2e\xb7\xb2\xf2\x8c\xfc\x16\x54\x90\x0e\xfc\x12\x21\x74\x87\xb9\xb1\xfc\x67\x07\x60\x38\xbf\x9a\x34\xb3\x54\xd6\x54\x68\x7d\x83\xa4\xf0\xeb\x10\x61\xa7\x75\x63\xcd\x27\x04\x56\xe8\x05\x05\x51\x1f\x86\x95\xe3\x06\xb1\x88\x3b\x01\x33\x07\xbf\x94\x0e\x2c\x56\x16\x1d\xea\x40\x5d\xd4\x2c\x34\x98\xd9\x3f\x30\xf7\x19\x4c\xd1\xd2\x40\x70\x4b\x53\xab\x82\x88\xee\x16\xad\x07\x8b\xb9\x59\x68\xf9\xb1\x99\xcd\x81\x37\xbc\x8c\x12\x1e\x9d\x07\xa9\x3d\x5a\x2d\x14\xdc\x0a\x55\xe3\x08\x84\x2e\xa0\x14\x2b\xb0\x48\xf3\x42\xad\x3b\x33\x70\x17\x97\xc1\xa5\xb1\x48\xb4\x64\xce\x60\xe9\x7d\xe5\xce\x4e\x4f\x17\xd2\x27\x06\xcb\x4d\x59\xd6\x5a\xfa\xd5\x29\x53\xbe\x9c\xd5\xde\x58\x77\x5a\xe0\x2d\xaa\x53\x27\x17\x63\x61\xf3\xa5\xf4\xc8\x34\x78\x2a\x2a\x39\x66\x60\x35\xb3\x4c\x56\x16\xff\xcf\x46\x96\x74\x4f\xd6\x90\xe7\x57\x44\x0b\xce\x5b\xa9\x17\x9d\x0f\x4c\x84\x3b\xb0\x4c\xb4\x48\x87\x2a\xe2\xd0\xb0\x8b\x16\x99\xd4\x44\xf8\x78\xff\x7a\x7a\x0d\x69\xe9\x80\xf0\x80\xdb\xb6\xab\x6b\xd1\x4c\x28\x92\x7a\x8e\x36\xf4\x9c\x5b\x53\xf2\x2c\xa8\x8b\xca\x48\xed\xf9\x8f\x5c\x49\xd4\x1e\x5c\x3d\x2b\xa5\xa7\xf3\xfb\x67\x8d\xce\xd3\x09\x64\x70\xc1\x72\x02\x66\x08\x75\x55\x08\x8f\x45\x06\x13\x0d\x17\xa2\x44\x75\x21\x1c\x7e\x76\x24\x13\x36\xdd\x98\x90\x77\x18\x9a\xbb\x42\x71\xb3\x73\xc0\x53\xe7\x43\x12\x3b\x03\x67\xd2\x72\xdb\xb4\xc2\x7c\x8d\xf8\x0b\x74\xd2\x12\xb1\x7a\xe1\x91\x48\x7c\x43\xba\xec\xe6\x3b\xe6\xbd\xa2\x60\x31\x2e\xd4\x14\xf3\xda\x4a\xbf\xfa\x9a\x84\xdf\x56\xbf\x4d\x98\x06\x86\x31\xe9\x68\x10\xd6\x8a\x15\xc1\x63\x71\x8e\x16\x75\x8e\xcc\x48\x2e\xf6\x0d\x02\x96\xb6\x20\x7c\x62\xc1\x19\x82\xa8\x2a\x25\xb1\x48\x2c\x27\xb5\xf3\x42\xe7\x98\xc1\xf5\x12\x1d\x6e\x8d\xbe\x4b\xe3\x1c\x12\x63\x36\x5b\xa1\xf1\x42\xaf\xb6\xfa\x07\xc4\x15\x20\x12\xb9\xb1\x78\x06\x45\x44\x00\xc6\xd2\x14\x2c\xbb\x72\x5f\x0b\x6f\x6c\xb6\x85\x01\xe9\xb1\xec\x41\xcc\xf6\x71\x25\x2d\xf9\x3e\xed\x3e\x70\x54\x83\x0c\x86\x90\x8f\x5d\xce\x65\x4e\x23\x5a\x56\x9a\xad\x60\xf2\x6a\x04\xe7\xef\xdf\x8e\x08\xa8\xb9\x54\x1e\xad\xcb\xe0\x9d\x56\x2b\x30\x9a\x4f\x39\x76\xa0\xef\x6f\xc2\x77\xe6\x30\x42\x45\x98\x93\xf8\x63\xca\xff\x5c\x11\xc3\x96\xc4\x1c\x7e\x49\xf2\x4f\x23\xdc\x49\xa5\x68\xbd\x5a\x05\xb4\x11\x8b\xcb\x22\x48\x49\xb4\xb6\x6f\xe7\xbb\x28\x28\xd2\x91\xd5\xfd\x1f\x36\xb1\x43\x60\xcf\x9b\xed\x0e\x0c\x19\xe0\xaa\xf6\x17\xd1\x72\xd0\x92\x4f\x12\x8e\x82\x58\x43\x4f\x00\xdc\xe0\xea\x34\x88\xb6\x4a\x48\xeb\xa0\x76\x81\xf0\x64\x41\x5c\x3f\x5f\xf1\x71\xc5\x23\xb9\x5e\xe2\x0a\x84\x6d\x09\x54\xe4\xb9\xb1\x05\x8b\xc2\x40\xaa\xb6\x56\xd8\x12\xd8\x6c\xc5\x8d\x74\xac\xe7\x57\x93\x56\x20\x15\x26\x77\x99\xb8\x73\x99\x28\xc5\x47\xa3\xb3\xdc\x94\xa7\xe7\x3f\x4c\x5f\x5f\xbc\x38\x0d\xea\xe5\xf4\x7b\x87\xf6\xeb\x5a\x16\x78\xfa\x3d\xe9\xf8\xff\x09\x90\x4b\xbd\xc8\x96\xbe\x54\x4f\x06\x76\x3b\x48\x97\x3d\xc8\x08\x33\x06\x54\x04\x24\xf6\x6c\x5d\xaf\x91\xe4\xe0\xc4\xfb\x68\x22\xfc\xd8\xe6\xd8\xf1\x7d\x03\x40\xb2\x5e\x82\x0e\xc7\x08\x60\x96\x80\x66\x6b\x8b\x0f\x22\x17\x0e\xc7\x8e\x4c\x55\x2f\x6f\xb1\x8f\x5e\xdb\xdf\x5e\x5a\x0a\xbf\xa0\xad\x1f\x00\xe8\x07\x1e\x00\x52\xe7\xaa\x2e\xd0\x05\xde\xb4\x81\xd7\x22\x66\x93\x05\xf0\xa6\xfb\xe7\x83\x37\xb0\xe7\x78\x1f\xb4\xcb\xd4\x91\x05\xf4\x60\x3f\x52\xbd\xa4\x57\x86\x17\x0d\xa6\xe4\x8e\xcf\x61\xaf\x83\x1d\x06\x34\xe1\x43\x00\x95\x83\xe0\xad\x1d\xd3\xe4\xd5\x2f\x97\x37\x3b\xa1\x1d\x86\xb3\xd5\xad\xd7\x62\xd1\x73\x82\xed\xf7\xab\x9d\x9c\xb4\x13\xba\x01\xb5\x4c\x2b\x46\x65\x6c\xaa\xd0\x94\x44\x9f\xa7\x4f\xa4\x81\x8a\x22\xa8\xca\x46\xd3\x8e\x36\xd5\x28\x31\xa1\x21\x63\x43\x14\x45\x10\x6c\x05\xce\x05\x29\x8e\x8e\x8c\xab\xac\xb9\x95\xe4\x9f\xc1\x64\x0e\x33\xe3\x97\xe9\xcb\x45\xd4\xb0\x64\x24\xc7\xa6\xe4\x36\x04\x3d\x15\xe6\x70\xc4\xf1\x5e\x2c\x98\xa4\xe0\x4e\xfa\x25\x14\x72\xce\xaa\xd2\x47\x3a\x1a\x6d\x8c\x7f\xe2\xa2\x5d\xea\xc5\x0d\x3a\xa8\x2c\xe6\x58\x90\x66\xdd\xe6\xa4\x1d\x67\x27\x4a\xb9\xcf\xcc\xb9\x9c\x24\x0f\x67\x4d\x79\x33\x34\x97\x93\x60\xc8\xde\x2d\x65\xbe\xa4\x56\x76\x45\x91\x3f\x96\xc9\x3d\x4a\x36\xcc\xd6\x3a\xbb\x85\xe7\xa0\x3a\x7d\xb0\x32\xdd\x43\xda\x3b\x15\xe9\xbf\x93\x1a\xdd\x29\x65\x3f\x93\x0a\x3d\x44\x81\xee\x53\x9f\x9f\x53\x79\x1e\xa4\x54\xf6\x2b\xce\xdf\x42\x6d\x1e\xa0\x34\x0f\x54\x99\xfb\x15\xe6\x3e\x75\xb9\x53\x59\xee\x51\x95\x7b\x15\xe5\x6e\xf0\x86\x94\xe4\x43\x55\xe4\x4e\x5c\xed\x12\xb1\xb7\x42\x2a\x31\x93\x4a\xfa\xd5\x5f\x8d\xee\xa1\xe4\x75\x81\xb6\xd1\x1d\x38\x8e\xd3\xba\x90\x51\x1c\x74\x67\x85\x8f\xd4\xcf\x1b\xe2\xc1\x18\x7f\x92\xae\xe3\x3b\x4e\xe6\x50\xd6\xca\xcb\x4a\x21\xb8\x7a\xa6\xd1\x07\x52\x2a\x85\xcf\x97\x58\xb4\x21\xab\xcd\x39\x47\x91\x8b\xac\xf3\x4c\xa4\x16\x7d\x6d\x35\x41\x54\xc9\xfc\x06\x8b\x21\x55\xd3\x8b\xa4\x5c\x99\xba\x98\x68\xe9\xf7\x20\xe0\x22\xf5\x6b\xdc\xfc\xa0\xbd\x09\x0d\x24\xdc\x1a\xe7\x78\x66\x8c\x77\xde\x8a\xaa\x22\xf9\xe9\x56\x8e\xe8\x1d\xee\x96\x68\xb1\x33\x89\x0c\x12\xf9\xa1\xda\x07\xb5\x98\x29\x64\xcf\x9e\xfe\xd7\xa2\x77\x97\x42\x8b\x05\xda\x03\xa8\x69\x78\xf0\x88\xe0\xd3\xac\x3a\x68\x17\xb6\x8e\xbe\x28\x9d\x1c\x1d\x6b\xec\x0d\xb1\x3b\x75\x42\xed\x6a\x8b\xd4\xc3\x16\xc2\x0b\xa8\xac\xbc\x15\xf9\x2a\x83\xf3\x80\xd2\xb1\xa4\x6d\x12\x32\x96\xc6\xdc\x80\x5b\xa2\x52\x10\x80\xe1\x93\xb2\x58\xa1\x2e\x02\xda\x0a\x73\xa7\x95\x11\xc1\x16\x69\x66\x64\x15\xbe\xb9\x32\x59\x2c\xad\x75\xa6\xc8\xec\x51\x18\x55\xbb\xe3\xbe\xfd\xb2\x27\x50\xc0\xcc\x18\x85\x42\xf7\xf4\x08\x63\x2f\x4c\xad\x7b\x08\x61\x0b\x93\xd3\xb6\x77\xb2\x42\x74\x5d\xce\xd0\x12\xbb\xba\x08\x72\x52\x3d\x73\x63\x43\x4c\x2d\x37\x65\xc5\xc0\x86\x1e\xfd\xee\xb3\xb1\xa5\xf0\x67\x20\xb5\x7f\xf9\x62\xc7\x4e\xa4\xf6\xb8\x40\x3b\xb8\x93\x2b\x8b\x73\x79\x7f\xf0\x56\x42\xf7\xb4\x97\x2a\xfc\x95\x18\x30\xcc\xc8\xa2\x32\x83\x6b\x66\x62\x07\xce\x1b\x4b\xfb\xc3\xb2\x32\x56\x58\xa9\x56\x21\xe6\x1a\xce\xa3\x08\x04\xd5\x35\xb8\x2c\x2e\xa4\x63\x33\x45\x90\x7e\xd6\xa6\x40\x10\x0b\x41\xf2\x80\xfb\xdd\x19\x7b\xc3\x44\x90\xa2\xf9\x9f\x54\xdc\x49\x51\x4e\xa2\xe4\xb9\xb2\x66\x2e\xd5\x3e\x81\x37\x39\xbf\xdc\x18\x10\xec\x0a\x1d\x15\xb9\xd0\xd4\xa5\x11\x67\xc4\xb7\xdc\x89\xec\x76\xe7\xe4\x42\x6f\x06\xcb\x1e\x22\x94\x64\x29\x16\xf8\x9d\x31\x37\x75\xf5\xce\x2e\xf6\x41\xba\xd6\x39\x9d\x21\xb1\xed\x3b\xbb\x10\x5a\x7e\x0c\x01\xa4\xc9\xab\xae\x2c\xe6\x15\x40\xf1\x28\x90\xf3\x64\x4f\x6b\xe3\x49\x0a\x3c\x48\x82\xa6\x0d\x5e\x53\x97\xdd\xa0\x3e\x99\x74\xfa\x26\x48\x69\x6a\xc2\x68\x83\xca\xc6\x60\xcf\xe0\xf5\xbd\x20\x9e\x39\x83\xf2\x4f\xd9\xbd\x12\x76\x81\xdb\x36\xe2\x0e\xd0\x34\x7a\x22\xab\x89\xf6\x68\xe7\x22\xef\x93\xa9\xeb\x56\xda\x66\xff\x70\xe6\x4a\x3a\xb6\xa9\x5f\xbf\x9d\xb8\x78\xc0\x26\x97\xe4\x51\xb0\x4f\xb4\x1e\x12\x3d\x87\x52\xdc\xcb\xb2\x2e\x69\xc4\x8b\xed\xf0\xdf\xe1\xc1\xcb\x9d\xb4\x5e\x8a\xfb\x09\x0f\x84\x6d\x31\x31\x6c\x79\x24\x8f\x70\xf2\x6a\x0f\x26\xae\x9a\x8e\xe9\x98\x6a\x2d\xff\x59\x63\x32\xa4\x25\xc9\x62\xd7\x6e\x2b\xf9\x04\x2c\xfb\x5b\xc7\xf3\x21\x87\x55\xd5\x33\x25\xf3\xc9\xd5\x3e\x1a\xba\x8a\xfd\x9a\xc5\x59\xbb\xfa\x25\xda\xb5\x93\x48\xe1\xeb\x05\x7a\x10\x71\x72\x98\x5c\x65\x70\xd5\xf8\xa4\xad\x55\xe2\xd0\xf3\x05\x8a\x64\xe9\x34\x37\x4a\x99\x3b\x77\x06\xcf\xa3\xbc\x9b\x4b\x54\x05\xb1\x09\x69\xc8\x17\x19\x44\xff\xf9\x74\xae\xc4\xad\xb1\xcd\xe8\x97\x19\x4c\xd9\x90\x49\xce\xf8\x10\xb1\xf6\xeb\x21\x6b\x8c\x7f\x85\xb7\x32\xc7\xa9\xfc\xb8\x8f\x95\xde\xaf\x75\x4e\x87\xe4\xe8\xdf\xd1\xcd\xa0\xe9\xe0\xd6\xa8\xba\x24\xa4\xc0\x42\x2e\xc4\x6c\xe5\xd1\x3d\xfd\xfa\xab\x67\xdb\x07\xd3\x51\x3c\xff\xf1\xa7\x01\xb0\xfb\x95\x8e\x73\xcb\x6f\x71\xf5\xb6\xd7\x1b\x5a\x57\x35\xd3\x6f\x62\xc7\x46\x69\x76\xbc\x22\xe7\x96\xe4\xb4\x32\x7f\x79\x2f\x82\xe3\xbe\xc6\x5a\x0f\x21\xa6\x60\x50\xee\x03\x28\x1c\xd6\xf6\x15\x00\xc3\x13\x3e\xee\xb4\x5f\x59\x5c\x26\x16\x18\xad\x5d\x5e\xc4\xe1\x6c\x46\xcd\xf0\x51\xc6\xde\x31\xd4\x70\x0c\x35\x84\xdf\x31\xd4\x70\x0c\x35\xfc\x5e\x43\x0d\x43\x57\xeb\x5e\xf8\xda\x1d\x76\xb9\xce\x5d\xd7\xae\xd7\xcd\xcc\xa1\xbd\xfd\xa5\xf7\xeb\x16\x9d\xdb\x6b\x13\x9e\xa7\x7e\x9c\x00\x45\xfe\x4a\x23\x91\x1a\x53\xa3\x31\x08\x8b\x76\xda\xc7\xde\x52\xc7\x5d\xc4\x65\xdb\x45\xa5\x0e\x2a\x9a\x6c\xf9\xe4\x99\x91\x13\xf5\xc4\xa5\x35\x1f\x75\x3b\x1c\x86\x1e\x24\xf3\xae\x3b\xde\xdc\x8e\x25\xe1\x10\xb6\xf2\xbd\xce\xc2\x30\x3a\xd2\x82\x3c\x70\x94\xae\xdb\xbf\x31\xce\x13\x5b\x8d\xe0\xf5\x7d\x48\xff\x99\x5c\x91\x20\x99\xe8\xf4\xd7\x23\x01\xdc\xc5\xcd\xe3\x04\x4b\xef\x37\x9a\xf8\xd3\x5d\x54\xf1\xad\xff\x25\x3a\x27\x16\xfb\xac\xab\x2f\x5e\x77\xfa\x36\x76\x47\xcc\xc0\xe0\x94\x9e\x5b\xe4\x7c\x9e\x90\x65\x61\xa3\x3f\xeb\xd1\x96\x52\x0b\x45\x94\x32\x53\x58\x72\xa6\x95\xce\xa5\x4a\xf9\x44\xcd\x01\xe8\x22\xcc\x1a\x29\x12\x44\x90\xd7\xb7\x68\x67\xc6\x61\xca\x4a\x72\xb5\xf4\x62\xa6\x82\xc5\xa4\xcc\x62\x41\x8d\x34\x76\x59\x97\x42\xd3\x60\x57\x97\x0c\x72\x06\x3f\xea\xae\x79\x1d\xed\xf5\x98\x4d\x44\x80\x33\x99\x5b\x11\x25\x7d\x40\x46\xcc\x4d\x11\x9d\x74\x44\x08\xae\x5a\x68\xb7\x08\x78\x5f\x61\x1e\x23\x72\x33\x52\x28\xf7\xc4\x97\xb5\x37\xc4\x3d\x39\x47\x8d\xcc\x2d\x79\x0b\xb2\x44\x78\xaa\xe4\x0d\xad\x66\xc9\x94\x06\x53\x7b\xb1\x40\xf7\x6c\x04\xb3\xda\x33\x7f\xa3\x28\x40\xea\x42\xe6\xe1\x02\x49\x78\x70\xa6\x44\xbf\x8c\xde\xc2\xbc\xd6\x85\x28\x51\x7b\x9e\xf6\xce\x1a\xbd\x68\x9d\xc2\xf6\x4e\x8c\x8c\x44\x88\x4c\x9b\x1b\x3d\x97\x8b\xda\x36\xd9\x6f\xb1\x31\xee\x66\x14\x6f\xe5\x84\x87\x52\xe8\x5a\xa8\x90\xda\x16\x73\x9e\x42\xdc\x35\x10\x67\xe3\x1e\x3b\x9e\x25\x1d\x64\x44\x53\x93\x84\x23\x35\xa7\x91\x40\x6e\xca\x99\xd4\x21\xa3\x33\xc4\xa9\xd8\x6b\x71\x89\x3e\x08\xc6\x51\x52\xb4\x0d\x32\x6b\xed\xea\xaa\x32\xd6\x77\x7c\xbc\x0e\xac\x71\x4f\x16\x5d\x65\xb4\x93\x74\xf0\x9d\x83\x91\xde\xa1\x9a\xc3\x0c\x09\x5b\xb9\x95\x09\xfd\xa5\x74\x09\x0b\xb4\x8d\x1f\x35\x9c\xeb\x55\x3c\x6a\x22\xd2\xee\x49\x9b\x3c\xaf\x2d\x14\xb5\x4d\x04\xd9\x10\x68\x83\xc0\xcb\x94\xd1\x98\x0b\xcd\xe9\x4a\x7c\x27\x2a\x5c\x20\xf9\x26\x53\x30\x51\x72\x4c\x87\x13\xba\x38\x8d\x34\x8a\x45\x42\x42\x0b\xfc\x13\x47\xd4\x50\xd5\x3e\xfb\xe2\x21\x8e\x07\x83\xfe\x1e\x85\xdb\xcc\x99\x1c\x60\xd8\xd0\xf5\xd7\xe1\x57\x57\xe7\xb9\xd4\x79\xbc\xbb\x5d\x67\xd5\xf6\x5e\xd4\xa3\xad\x2c\x86\xe4\xcc\x23\x8f\x1e\x79\xf4\xff\x1e\x8f\x26\xbb\x8d\x0c\xcb\xbd\x71\xe0\x6e\xdf\x26\xcc\x92\xac\xce\x2d\x4b\xb0\x89\x13\x44\x7e\x7a\x50\xd0\xc2\xa2\x28\x56\xfb\xe2\x3e\xd4\x87\xe1\xe0\x5b\x9a\x14\x65\x4f\x11\xb7\xd6\xd3\x66\x22\x14\xc5\x6a\x08\x82\xbe\x18\x54\xaf\x95\xb2\xd5\x18\x4c\xef\x33\x86\x20\x34\x78\x63\xc9\x40\x81\xb9\x50\x2e\x36\xd5\xb3\x26\xfd\x39\xed\x28\x9a\xfc\xf0\xd3\xcf\x27\xd1\x8a\x6a\x92\x6c\x98\x7d\x2e\x8c\xaa\x4b\xdd\x64\xe9\x6f\x5c\xbf\x85\xb0\x8a\x37\x29\xab\x83\x90\xdc\xc9\x5b\x99\xa1\x32\x7a\x91\x6c\xb2\x7f\x38\xa3\xaf\x84\x5f\x9e\x41\x96\x12\x7e\xb3\x50\x02\x91\x8a\x05\x7e\x0c\xd5\x02\x3f\x72\x12\x72\xac\x07\xe8\x78\x68\x21\x1f\x3f\xae\x7a\x32\x70\x72\x1b\x30\xbe\xbe\x78\xd1\x89\x3e\x12\x89\x6c\x43\x13\x70\x90\xad\x51\xe0\xda\x92\xdd\x96\x7d\x0b\x5e\x36\x57\x2b\x44\x13\x61\xea\xc1\x25\xb9\xd3\xda\x52\xef\x3b\x2d\x0f\xda\xdb\xe4\x55\xcf\x2a\x15\xe6\x59\x1b\x5e\x5e\x5b\x28\xf1\x50\xd3\x7c\xe8\xc6\xa2\x14\x08\x27\x6e\xee\xb4\x4b\x72\x7b\xed\xec\x77\x9c\xb9\xb9\xd3\x68\x9b\x64\x5f\xf7\xb7\xbf\x3c\xfd\xef\xec\x46\xea\xe2\xcf\x7f\xfe\x22\x0e\xfe\xe2\xd9\xdf\xb3\xad\x73\x5f\x9f\x78\x0b\xda\xb5\x62\x8d\x97\x81\xde\x8f\xc5\x1a\xc7\x62\x8d\x63\xb1\xc6\xb1\x58\xe3\x58\xac\x71\x2c\xd6\x38\x16\x6b\xfc\xfe\xae\x7e\x8e\xc5\x1a\xc7\x62\x8d\x63\xb1\x46\xf7\x77\x2c\xd6\x38\x16\x6b\x1c\x72\x76\xc7\x62\x8d\x63\x06\x45\xf3\x3b\x66\x50\x6c\xff\x8e\x19\x14\xc7\x0c\x8a\xee\x2a\x0f\xcf\x5e\xfe\x7d\x15\x29\x48\xed\x42\x8d\xc1\x8d\xac\x1e\x5c\xa4\x30\x19\x1c\x3c\x54\xa4\xa0\x8d\x1f\x2c\x54\x30\x36\x34\xc7\x0d\xa6\xe6\x2b\x61\x45\x89\xc4\x15\x53\xcf\x4e\x5d\x53\xcf\x10\xcb\x18\xe8\x24\x53\x21\x42\x06\x5f\x35\x0a\x7b\x04\xe2\x58\xde\x70\x2c\x6f\xf8\x6d\xca\x1b\x00\x5c\xb7\x76\xe7\x2b\x91\xdf\xe0\x66\x18\xb2\x45\x02\xd3\xeb\x59\x3a\xae\x71\x19\x28\xec\x20\x8c\x6d\xad\xb1\xce\x7a\x55\xe2\x9e\x31\x63\x29\x96\x0a\x79\xa9\xe4\x47\x6c\x6c\x8d\xdd\x2c\x27\x16\xcc\x74\x85\x74\x21\x8c\x98\x4e\xc1\x75\x99\x8d\xb8\xb7\xb9\xa7\x0d\xe6\x51\x4b\x7f\x69\x43\xa3\xdd\x95\x4a\xf1\x7a\xb8\x1f\xdb\xa8\xeb\xb2\x1f\x7d\xe3\x83\xf0\x36\x06\xe7\xca\xf1\x06\x3a\x3e\xa9\xe0\x9f\x0b\xa9\x6a\x8b\xaf\x4c\x29\xe4\xbe\x7c\x82\x37\xdd\xbe\x89\xd6\xe3\x04\x50\x84\xd6\xed\x24\x7e\x8e\x24\x37\xfe\x44\x1b\x44\xe4\x14\x6c\xe6\xf0\x11\x51\x74\xb2\x26\xa5\x6e\xae\xe2\xce\xaf\x26\x19\xbc\x69\xf3\xa2\xbb\x4f\x72\x35\xde\x4c\x70\x39\x42\x15\x01\xe9\xf7\x5b\xa1\x38\xab\xc1\x24\xdb\xae\x5b\x58\x08\x7f\x35\xfa\xd3\x17\x06\x62\xf1\xc8\xd2\xc0\xdf\x6d\xcd\xd0\x57\xc2\xe1\xbb\xe9\xe1\x65\x43\xa1\x7f\x5f\x52\xfe\x4c\x38\x04\xd2\xf5\xc2\xb7\x86\xc2\x60\x1d\x51\xf2\xe7\x1e\x5b\x48\xd4\x42\xf4\x26\xe8\x89\xdd\x3b\x78\x32\xd9\x1c\xd0\x14\x3f\x5d\x4e\x68\x1b\x04\x72\xd0\x38\x04\x32\x01\x09\x11\xca\x00\xf6\xe6\x65\x3d\x4c\xda\x84\x7d\xb9\xd0\xac\x01\x24\x1f\x1b\xde\x57\x4a\xe6\xd2\xa7\xed\xd1\xd6\x60\x1a\x92\x2e\x1c\x11\xa9\xf3\xd2\xd7\xc1\xd4\xa2\x59\x7f\xfa\x29\x0b\x48\xfd\xf9\x67\x56\x1b\x3f\xfd\x94\x7d\xfb\xa5\x8b\x17\x6f\x3f\xff\xdc\x8a\x35\x46\xf0\xbb\x29\x77\xba\xa9\x67\x68\x35\x7a\x74\xe9\x3a\x6e\xc4\x49\x1b\x98\x93\x99\xae\x56\x1c\xc1\x87\x78\x58\x09\xce\x56\x36\x4a\xdd\x73\xa6\xc6\x42\x3d\xab\xb5\xaf\xe1\x69\xb8\xe9\x60\xc9\xfa\x6c\xd4\x44\x13\xb6\x17\xed\xb2\x7b\x74\x1e\x2b\x91\xdf\x88\x05\x87\x08\x4c\x51\xe7\xe1\x43\x3b\xf2\xd4\xa2\x42\xda\x08\x6d\xcb\xd4\x1e\x6e\x83\x12\x0c\x8a\xf6\x0c\x9e\x67\xcf\x5f\x66\x7f\x1c\xd1\xff\xbf\xc8\xfe\xff\xb8\x5c\xf1\xbb\x81\xd9\x73\x0e\xcd\x3f\xcf\x9e\xff\x67\xf6\x32\x48\x12\x0c\xb9\x32\x23\xe8\xc0\xda\x9e\x15\x9d\xa3\x99\x43\x2e\x2a\x31\x16\xa5\x1c\x77\x90\x3c\xfe\xcb\x06\x86\xc7\x7f\x08\x18\x42\x5d\xd0\x99\x3b\xe4\xab\xa9\x40\x0f\x74\x88\x2e\x65\xef\xf8\x7c\x19\xb7\xe8\x3d\x5a\xdd\xce\x1e\xd0\x36\xfe\xcb\xf3\xec\xf9\x97\xd9\x1f\xc7\x7f\xe0\x91\xa2\x11\x95\x3c\x9c\xc8\x4d\xd8\x05\x32\x73\x74\x31\x19\x06\x35\x48\x8e\x47\x10\x0f\x3b\x83\x29\x22\x08\xe5\xba\xb7\x6c\x46\x09\xbd\xc8\x8c\x5d\x9c\x56\x37\x8b\x53\x8f\xf7\xfe\x94\x8c\x0f\xb2\xda\x4f\x1f\x54\xf3\x76\xac\x1d\x3c\xd6\x0e\x7e\x96\xda\x41\x6d\xf4\x7b\x63\xfc\x07\xae\x28\xdb\x87\x8d\x8b\xf5\x04\xba\xaa\x15\x8d\x21\x6f\x5d\x87\xf2\xb4\x98\x39\x14\xcb\xd4\x1e\x9d\x33\x1f\x60\x02\xd4\xb9\xa8\x5c\xcd\xe1\xab\xbe\x34\xbe\x0d\x28\xd2\xe2\x05\x97\xd1\x3d\xe2\xb6\x2e\x0c\xec\xaf\x7c\xeb\x81\x32\x94\xeb\xed\x8a\x62\xec\x8d\xa5\xa0\xce\xed\xaa\xf2\xc3\x41\x92\xf5\xa4\x9d\xd4\x9b\x48\xb1\x5b\x23\x19\xab\x02\x5b\x73\xaf\x99\x97\x24\xb2\x36\x03\x5e\xe6\x7e\x3f\xb3\x81\x50\x1a\xfd\x2d\xf6\xe4\xb2\x0d\x43\x19\x46\x24\xc6\xfe\xf6\x72\x9a\xaa\x01\x49\xec\xb0\x83\xce\xdd\x3a\xf0\x73\x3e\x03\x43\x2f\x79\x67\xa2\x19\x35\x79\xc5\x9e\xff\xfb\xb7\x6c\x4c\xae\xa1\x81\x7c\x98\x20\x96\x83\xa3\x67\x4a\xe9\x7d\x2a\xde\x4b\x2a\x87\x04\x20\xcd\xb3\x56\xbd\xc7\xda\x97\x5a\xcb\xda\x79\x10\x2a\x64\x5d\xe1\x3d\x31\x38\xcd\x48\x56\x73\x9e\xa3\x0b\x19\x96\x5b\x79\x98\x8f\x2e\x95\x90\xa6\x2f\x8f\xa0\x07\x91\x93\x77\x57\xd3\x6d\x7f\x9d\x5b\x63\x16\x48\xc7\x78\x2e\xa4\xbb\xc9\xe0\xad\xf1\x21\x8e\x9c\x73\x22\x30\xc9\x27\xa5\x18\xa4\xc1\xd2\x8e\xdd\x85\xa2\xdd\x3d\x0d\x3b\xf1\xc0\x85\xaa\x07\x6d\x8a\xab\x5b\xdb\x4a\x5f\x2e\x70\x7d\x2a\x35\x7c\x2d\x9f\x35\x95\xa3\x6b\x7c\x9c\xc1\x25\x9d\xcf\x0c\x61\xc1\x8a\xc0\x86\x2b\xfb\xd6\xea\x73\x5a\x54\x6e\x49\xc2\x87\x8b\x65\x2d\x7c\x09\x4f\xf9\xf2\x01\x6f\x43\x3c\x3a\x8e\xeb\x29\x92\x3d\x1c\x03\xa5\xd4\x24\xc7\xcf\xe0\xcb\x47\xa3\xe8\xe0\x12\x99\x3e\x85\xd8\xe1\xf2\xa7\x98\x2d\x32\x58\x54\x2f\x46\x20\xcd\xf3\x11\xa0\xcf\xb3\x2c\x1b\xdc\xdd\x2f\xaa\x8c\x21\x94\x7e\xba\x9b\xbf\x63\xb5\xfa\xbf\x78\xb5\x7a\xd0\xbb\x07\x54\xaa\x3f\x4e\x41\x77\x2a\xd8\x1f\x18\x82\xde\xa7\x99\x1f\xa4\x97\xf7\x70\xc4\x1e\x9d\xfc\x59\x35\xf2\x3e\x7d\x7c\x80\x36\x3e\xea\xe2\x03\xcf\x79\x58\x0f\xff\xea\x5a\x78\xbf\x06\xda\x1b\x46\x1f\xd4\xbf\xff\xda\xda\x77\xff\xce\xf7\x68\xde\x7d\x88\x19\xd6\xba\x9f\x4d\xe7\xee\xa4\xbb\x61\x7d\x3b\xa0\x6d\x77\xe8\x5a\x57\x19\x7f\x29\xec\x0d\xfa\x77\x41\xd2\xee\x7b\x1f\x63\xb3\x3f\xd1\xa5\xb9\xe3\xbb\x1f\xcb\xde\x6c\x53\x21\xd4\x28\x32\x17\x2b\xba\x6c\xad\xa1\x76\xa4\x67\x38\x2c\x5f\x19\xdf\x76\x79\xe8\x85\x62\x29\xee\xaf\xac\xcc\x0f\x39\x97\xcb\xd8\x75\x2d\xbf\x37\x39\xd5\x15\x7f\x49\xb7\x70\x2c\x82\xa5\x52\x31\x73\xa2\x12\x2b\x66\x4b\x06\xf5\x43\x1b\xa5\xed\xbf\x52\x7e\x6c\x40\xff\x57\x7b\x2c\x05\x3e\x70\x41\x59\xe7\xd6\x1f\xcb\xca\xaf\x52\x3e\xfa\xd3\xc2\x34\x17\xa7\xd3\xe9\x37\x34\x99\x7b\x36\x4a\x59\xac\xa9\x09\x42\x71\xb5\xb1\x49\x34\xc3\x53\xd6\x00\x1d\xf1\xdc\xed\xf9\xec\x21\x06\xd4\xf1\x85\x96\x03\x11\x05\xc7\xfc\xa2\xf6\x77\xcc\x2f\xda\xfe\x1d\xf3\x8b\x8e\xf9\x45\xdd\x55\x1e\xae\x95\x3c\x6a\xa1\xf3\x7d\xa5\xa7\xd7\xa1\x57\x53\x6a\xed\xc8\xc7\xdc\x74\x60\x49\xef\x1b\x0d\x6e\x29\x6c\xf0\x5d\x88\xb5\x15\x8e\x79\x09\x0f\x4b\x61\x8b\x3b\x61\x7b\x88\xa2\xff\x32\x7e\x9c\x14\x4d\xef\x97\x00\x47\xd1\xf3\x6d\x69\xdc\x50\xa4\xa1\x17\x3d\xb5\xce\x4d\x59\xf1\xcb\x2d\x05\xc9\xa5\x57\x5b\x55\x47\x5b\xe8\xf8\xbe\x67\x48\x93\x91\xdb\xf5\xed\xd8\xd8\xe0\x9c\x1f\x32\x6f\x3f\xca\x6a\xdc\x0e\x84\x19\xce\xb9\xe6\xca\x07\x87\x28\xdc\x8d\x63\xfe\xa2\xa3\xc6\x3a\x09\x47\x4b\xe1\x60\x56\x4b\xe5\xc7\x52\x43\x2c\xfa\x66\xcd\xb7\x39\x6d\xbb\x66\xfb\xaf\x98\xd3\x22\x35\x88\x3b\x97\xd2\x5e\xca\x94\x28\x41\xc6\xdd\x9d\x58\x6d\x41\xf8\x8b\xeb\x87\x8f\x6f\xfd\x0c\xae\x77\x7c\xeb\xe7\xf8\xd6\x4f\xf3\xcb\x8d\x0e\x89\x7f\x07\xdc\xad\xc5\x8e\x0d\x9f\xe4\xb5\xe5\x3c\xff\xf4\x46\xc6\xe6\x03\x05\x97\x43\xaf\x11\x1c\x46\xa7\xcd\x8a\xcd\x82\x5c\x04\x4c\xab\xb5\x65\xc1\xdd\xc4\xa0\xd6\x9c\x8c\xc9\x23\xa1\x72\x82\xa0\x7a\x0c\xe5\x2a\xe1\xfc\x75\x7c\x64\x24\xfc\x07\x05\x0f\xa2\xa7\xef\x84\xf3\xe1\x05\x91\x18\xfe\x49\x25\x19\xcd\x54\x58\x84\x3c\x4c\xa2\xb1\x20\xa5\x42\x6a\x92\x21\xe1\x1d\xc3\xa9\x6d\x4c\xae\x49\xfa\xab\x75\x81\x56\x71\x51\x60\x3b\x6b\xbe\x14\x7a\xc1\x25\xb5\xf3\x26\x3b\x80\xdc\x8f\x1b\x6d\xee\x34\xbb\x1e\xc9\x23\xe6\xa0\x01\x81\xd5\x4c\x48\x28\x0b\x61\xdb\x38\x0b\xcb\xe3\x3c\xc7\x8a\x5f\x68\xd9\x77\x13\x51\x08\x8f\x63\x9a\xf1\xb1\x2c\x5a\x0e\x3d\x31\xd5\x83\xd5\xf3\xf8\x94\x93\x45\x51\x70\xbc\x2a\x0e\x4e\x76\x01\xed\xb0\x40\x2f\xa4\x72\x20\x66\xa6\x0e\x61\xc2\x16\xe7\x6b\x51\xea\x78\xc5\xcd\xbe\xe9\xa3\x05\x8c\x1d\x78\x6e\xa7\x07\xf8\x6b\xae\x41\xe1\x27\x77\xe6\xed\x5b\x2f\xe1\x04\x9f\x38\x26\xb4\x0e\xa8\x9c\xee\xd6\x56\x47\x5f\x2f\xb1\x53\x6e\x7a\x35\x61\xe8\xf3\xa5\x31\x0e\x1b\x85\x1f\xa2\xb5\xb1\x40\x3b\x04\xe2\x59\xb0\x3b\x59\x20\x69\x5f\x01\x8b\x5a\x58\xa1\x3d\x62\x11\x52\xe8\x36\xb0\x11\x0b\xb4\x7f\x19\x46\x1c\xde\xa2\x95\xfe\xb0\x0b\xd8\x69\xec\x9c\x2e\x42\xdc\x5a\xc6\x53\xae\x84\x73\xb4\xe3\x86\xd3\xe3\x93\x45\xb9\x29\x70\x04\xce\x34\x36\x8e\x83\xf6\x1d\xa1\xf0\xce\x8b\x2c\x4b\x2c\x48\x57\xaa\x55\xe0\x19\xd2\xa1\x21\x25\xa6\x91\x58\xd2\xd7\x61\x66\xce\x7d\xce\x7d\xeb\x6f\xa6\x8c\xa7\x06\xbe\x80\xa4\xcb\xef\xa7\xd7\xa9\x5e\xd9\x68\xb5\x0a\x5c\x14\x0c\x87\x3f\xbf\x11\xca\x0d\xb2\xcb\x7e\xb4\xf5\x18\x2a\x43\x48\x0b\xd2\xa2\x7d\x1a\x28\xd0\x50\xa3\xae\xae\x6d\x8d\x23\x60\x70\x46\xf0\xbd\x66\x21\xf0\xf9\x35\xe8\x75\x0c\x45\xb6\x52\xa9\x4b\xc1\xb1\x3c\x7b\x6e\x4c\x16\x33\xad\xd8\x2b\xef\xd6\xff\x0b\xbd\x82\xac\x19\x9d\x71\x68\xb3\xeb\xd9\x11\x21\x3b\x4f\x07\x27\x72\x6b\x9c\x6b\x04\xbd\x03\x7e\xa4\x29\xa6\x73\x2a\x0c\xaf\x33\xcd\x30\x17\xb5\x43\x10\x76\x26\xbd\x15\x76\xd5\xd1\x72\xe9\x25\xa0\xda\xe1\xbc\x56\xf0\xd4\x21\x42\x46\x56\x4e\x7a\xb1\xa4\xed\xfa\x2c\x44\x6e\x52\xae\xa7\x37\x50\x60\x6e\xf4\x5c\xc9\x9c\xc5\xac\x2c\xc9\x10\x16\x7a\x4f\xde\xc2\x63\x2f\x35\xbb\x0f\xab\xac\x7f\xfa\xb4\x16\x40\x4c\xd6\x3d\xf0\xbd\xbf\x37\x6b\xbd\x8f\x2f\xfe\x1d\x5f\x13\x3b\xbe\x26\xf6\x5b\xbc\x26\x16\xb9\xf6\xb0\x37\xff\xde\x74\x3b\x1f\x5f\xfd\x3b\xf2\xe9\x91\x4f\xff\xdd\x5f\xfd\x63\x22\xb2\x75\xe5\xe9\x8c\xf6\xc2\xd5\xe9\x0b\x16\x43\xfd\x41\x94\x16\x9d\x42\x06\xae\xdc\x64\xe5\xbc\x76\xf1\xca\x04\x9c\x87\xfb\x78\x1b\x02\x8f\x33\x6c\x01\x08\x84\x74\x41\x9e\x0d\xdb\xd4\x92\x9f\xf2\x42\x79\x8b\xa1\xa4\xcd\x87\xeb\xd3\xf8\xf2\xcf\xda\xd4\x8c\x23\x66\xea\x48\xed\x71\xb2\xf3\x1f\xa6\xd1\xc3\xe9\xca\xba\xa6\x7e\x93\x2d\xf7\xad\x5b\xe6\xe8\x38\x6b\xa9\xe0\xa9\xcc\x30\x1b\xd8\x9b\x58\x07\xa1\xe7\x76\x7d\x67\xfe\xd2\xef\xfd\xb1\xc5\xb6\x65\xf7\x5b\x8b\xad\x53\x13\x02\x0a\x58\xbc\x15\x4d\x02\x37\x3f\x64\x06\x5f\x04\x46\xaa\x54\x6d\x85\x8a\x7f\x76\xc2\x51\xf0\xb7\xbf\x9f\x40\x0c\x1f\xc7\x0a\x8b\xd0\xf8\xbf\x01\x00\x00\xff\xff\x4e\x8e\x31\x2f\xa5\x82\x00\x00")
+var _clusterApiInfrastructureClusterXK8sIo_awsmachinesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7b\x6f\x23\x37\x92\xf8\xff\xfa\x14\x85\xfc\xfe\xb0\x67\x21\xb5\x33\x33\xf9\xdd\xe5\x04\x04\x39\xc7\x9e\x49\x84\xc4\x33\xc2\xc8\x49\x80\x4b\x82\x03\xd5\x5d\x92\xb8\x66\x93\xbd\x24\xdb\xb6\x26\xc8\x77\x3f\x14\x1f\xfd\x90\x5a\x2f\x67\x26\xd9\xec\x76\x3c\xd8\x95\xba\x8b\x64\xb1\x58\x2f\x16\xab\x28\x56\xf0\x1f\x50\x1b\xae\xe4\x18\x58\xc1\xf1\xd1\xa2\xa4\x6f\x26\xb9\xfb\xdc\x24\x5c\x5d\xdc\x3f\x1f\xdc\x71\x99\x8d\xe1\xaa\x34\x56\xe5\xef\xd0\xa8\x52\xa7\x78\x8d\x0b\x2e\xb9\xe5\x4a\x0e\x72\xb4\x2c\x63\x96\x8d\x07\x00\x82\xcd\x51\x18\xfa\x04\x90\x8a\xd2\x58\xd4\xc9\xe3\xa8\xea\x8a\x89\x62\xc5\x3e\x1b\x43\xf8\xf4\x72\x00\xc0\xa4\x54\x96\x51\x47\xb1\x99\x92\x56\x2b\x21\x50\x8f\x96\x28\x93\xbb\x72\x8e\xf3\x92\x8b\x0c\xb5\x43\x27\x22\x7b\xff\x69\xf2\x22\xf9\xaf\x01\x40\xaa\xd1\x35\xbf\xe5\x39\x1a\xcb\xf2\x62\x0c\xb2\x14\x62\x00\x20\x59\x8e\x63\x60\x0f\x26\x67\xe9\x8a\x4b\x34\x09\x97\x0b\xcd\x8c\xd5\x65\x6a\x4b\x8d\xc9\x26\x86\x03\x53\x60\x4a\x58\x2c\xb5\x2a\x8b\x31\x1c\x02\xf7\x43\x44\xbc\x99\xc5\xa5\xd2\x3c\x7e\x1f\x45\x02\x8c\x58\xc1\x1d\x84\xa7\xe3\xe5\x8f\xb3\x1b\x8f\x8f\x7b\x28\xb8\xb1\xdf\x6e\xbc\xf8\x8e\x1b\xeb\x5e\x16\xa2\xd4\x4c\xb4\xe6\xe0\x9e\x1b\x2e\x97\xa5\x60\xba\xf9\x66\x00\x60\x52\x55\xe0\x18\xde\xb0\x1c\x4d\xc1\x52\xcc\x06\x00\x81\x60\x0e\xa9\x51\x20\x49\x20\xff\x0b\xdf\x57\xba\xc2\xdc\x2d\x1e\x7d\x53\x05\xca\xcb\xe9\xe4\x87\x97\xb3\xd6\x63\x80\x0c\x4d\xaa\x79\x41\x94\x6e\xa2\x0a\xdc\x80\x5d\x21\x78\x70\x58\x28\xed\xbe\x36\x10\x86\xcb\xe9\xa4\xea\xa5\xd0\xaa\x40\x6d\x2b\x22\xf9\x7f\x0d\x26\x6c\x3c\xdd\x18\xf3\x8c\xd0\xf2\x50\x90\x11\xf7\xa1\x1f\x39\x4c\x10\xb3\x30\x13\x50\x0b\xb0\x2b\x6e\x40\x63\xa1\xd1\xa0\xf4\xdc\x45\x8f\x99\x04\x35\xff\x3b\xa6\x36\x81\x19\x6a\x6a\x08\x66\xa5\x4a\x91\x11\xd3\xdd\xa3\xb6\xa0\x31\x55\x4b\xc9\xdf\x57\xbd\x19\xb0\xca\x0d\x23\x98\x45\x63\x81\x4b\x8b\x5a\x32\x01\xf7\x4c\x94\x38\x04\x26\x33\xc8\xd9\x1a\x34\x52\xbf\x50\xca\x46\x0f\x0e\xc4\x24\x70\xa3\x34\x12\x2f\xa9\x31\xac\xac\x2d\xcc\xf8\xe2\x62\xc9\x6d\x14\xb0\x54\xe5\x79\x29\xb9\x5d\x5f\x38\xce\xe7\xf3\xd2\x2a\x6d\x2e\x32\xbc\x47\x71\x61\xf8\x72\xc4\x74\xba\xe2\x16\x1d\xcb\x5e\xb0\x82\x8f\x1c\xb2\x92\x26\x65\x92\x3c\xfb\x7f\x3a\x88\xa4\x39\x6b\x11\xcf\xae\x89\x17\x8c\xd5\x5c\x2e\x1b\x2f\x1c\x13\xee\xa1\x32\xf1\x22\x2d\x2a\x0b\x4d\xfd\x44\x6b\x62\xd2\x23\xa2\xc7\xbb\x57\xb3\x5b\x88\x43\x7b\x82\x7b\xda\xd6\xa0\xa6\x26\x33\x91\x88\xcb\x05\x12\x77\x70\x03\x0b\xad\x72\x47\x55\x94\x59\xa1\xb8\xb4\xee\x4b\x2a\x38\x4a\x0b\xa6\x9c\xe7\xdc\xd2\xfa\xfd\xa3\x44\x63\x69\x05\x12\xb8\x72\x7a\x02\xe6\x08\x65\x91\x31\x8b\x59\x02\x13\x09\x57\x2c\x47\x71\xc5\x0c\x7e\x74\x22\x13\x35\xcd\x88\x88\x77\x1c\x99\x9b\x4a\xb1\xfe\x8f\x7a\x19\x07\x3a\x35\x5e\x44\xb5\xb3\x63\x4d\x6a\x69\x9b\x15\x98\xb6\x98\x3f\x43\xc3\x35\x31\xab\x65\x16\x89\xc5\x6b\xd8\x56\x7f\xdd\x72\x47\x7f\x2c\xcb\x9c\x1a\x67\x62\x86\x69\xa9\xb9\x5d\x7f\x4d\xca\x6f\x0b\x6e\x13\xa7\x1d\xcd\x1c\xeb\x48\x60\x5a\xb3\x35\xe1\xa3\x71\x81\x1a\x65\x4a\xf8\x2a\x30\x01\xd6\x2b\x58\x92\x5f\x66\xa3\x08\xce\x11\x58\x51\x08\x8e\x59\x14\x39\x2e\x8d\x65\x32\xc5\x04\x6e\x57\x68\x70\xab\xf5\x43\x6c\x67\x90\x04\xb3\x9a\x0a\xb5\x67\x72\xbd\x05\xef\x09\x97\x01\x8b\xec\xe6\xb4\x3f\x08\x62\x02\x50\x9a\xba\xa0\xe7\x2c\xb5\x25\xb3\x4a\x27\x5b\x14\xe0\x16\xf3\x0e\xc2\x6c\x2f\x57\xb4\x92\xef\xe2\xec\x1d\x59\x6a\x62\x38\x0c\xdd\xb2\xf3\x05\x4f\xa9\x45\x2d\x4a\xf3\x35\x4c\xae\x87\x70\xf9\xee\xcd\x90\x90\x5a\x70\x61\x51\x9b\x04\xde\x4a\xb1\x06\x25\xdd\x2a\x07\x00\x7a\xff\xda\xbf\x77\x4a\x68\x8e\xb1\x4f\x92\x0f\x62\x16\xbe\x58\x93\xc0\xe6\xa4\x81\xec\x8a\xf4\x9f\x44\x78\xe0\x42\xd0\x78\xa5\xf0\x64\x23\x11\xe7\x99\xd7\x92\xa8\x75\xd7\xcc\xf7\x71\x50\xe0\x23\x2d\xbb\x5f\x6c\x52\xe7\xdd\x1b\x9a\x41\x9c\xee\x8e\x26\x3b\xa4\xaa\xfe\x0b\x64\x39\x6a\xc8\xb3\x48\x23\xb7\x08\xc4\x2c\x6a\x01\x77\xb8\xbe\xf0\xaa\xad\x60\x5c\x1b\x28\x8d\x67\x3c\x9e\x91\xd4\x2f\xd6\xc0\xea\x25\xb9\x5d\xe1\x1a\x98\xae\x19\x94\xa5\xa9\xd2\x19\x51\x36\xb0\xaa\x2e\x05\xd6\x0c\x36\x5f\xbb\x87\xb4\xac\x97\xd3\x49\xad\x90\x32\x95\x9a\x84\x3d\x98\x84\xe5\xec\xbd\x92\x49\xaa\xf2\x8b\xcb\x1f\x67\xaf\xae\x5e\x5c\x78\xf3\x72\xf1\xbd\x41\xfd\x75\xc9\x33\xbc\xf8\x9e\x6c\xfc\xff\x7a\xcc\xb9\x5c\x26\x2b\x9b\x8b\xb3\x41\xc7\x54\xf7\xf1\x65\x07\x31\x7c\x8f\x9e\x1f\x3d\x11\x3b\xa6\x2e\x5b\x2c\xb9\xb3\xe3\x43\x3c\xe1\xff\x9c\xcf\xb1\xe7\xfd\x06\x82\xe4\xbd\x10\x87\x10\x05\x3d\x82\x49\x60\x72\xe7\xbd\x18\xb7\x10\x29\x33\x38\x32\xe4\xaa\x5a\x7e\x8f\xc9\x60\x67\xd7\xc7\xf0\x92\xff\x73\xcc\x60\x4e\x40\xf4\x07\xd7\x00\xb8\x4c\x45\x99\xa1\x71\xa2\xa5\xb4\x97\xb5\x40\xd9\xe8\x01\x04\xfc\xfd\xd7\x93\x27\x70\x60\x79\x4f\x9a\x65\x04\x74\x0a\x7a\x27\x1c\x99\x5e\xb2\x2b\xbb\x07\xf5\xae\xe4\x9e\xd7\x7e\xae\x3b\x01\x76\x58\xc2\x53\x10\xe5\x3b\xd1\x6b\x2d\xd3\xe4\xfa\xf7\xeb\x9b\xbd\xd8\xee\xc6\xb3\xb6\xad\xb7\x6c\xd9\xb1\x82\xf5\xfb\xe9\x5e\x49\xda\x8b\xdd\x0e\xb3\x4c\x23\x06\x63\xac\xdc\x4b\x26\xa2\xea\xb3\xf4\x8a\x2c\x50\xe6\xc4\x9e\xc9\xca\xd2\x0e\x37\xcd\x28\x09\xa1\x22\x27\x9e\x65\x99\x57\x6c\x19\x2e\x18\x19\x8e\x86\x8e\x2b\xb4\xba\xe7\xb4\x3f\x83\xc9\x02\xe6\xca\xae\xe2\x9b\xab\x60\x61\xc9\x49\x0e\x8f\x82\x73\x12\xec\x94\xef\xc3\x90\xc4\x5b\xb6\x74\x2c\x05\x0f\xdc\xae\x20\xe3\x0b\x67\x39\x6d\x10\xcb\xe1\x46\xfb\x33\x13\xfc\x52\xcb\xee\xd0\x40\xa1\x31\xc5\x8c\x2c\x6b\x32\x38\x61\xed\x58\xce\xc7\x07\xe8\x79\x33\x89\x3b\x9c\x96\xf1\xa6\x07\x97\x37\x13\xef\xc8\x3e\xac\x78\xba\x22\x6a\xb9\xad\x28\x19\x5a\x84\xb0\xfb\xa9\x7d\x98\xc1\x69\xca\x73\xa7\x39\x3d\xd9\x98\x1e\x60\xed\xbd\x86\xf4\xdf\xc9\x8c\xee\xd5\xb2\x2d\x42\x7c\x38\x13\x7a\x8c\x01\x3d\x64\x3e\x3f\xa6\xf1\x3c\xc0\x3b\xc7\x1a\xce\x3f\xc3\x6c\x1e\x61\x34\x8f\x9a\xdd\x3e\xfd\x7e\xac\xb9\xdc\x6b\x2c\x0f\x98\xca\xbd\xa6\xe7\x30\x7a\xbb\x8c\xe4\xa9\x26\x72\x2f\xad\xf6\xa9\xd8\x7b\xc6\x05\x9b\x73\xc1\xed\xfa\x7f\x94\xec\xe0\xe4\x16\x26\x97\x1b\xe0\x24\x64\x95\xe2\x35\x95\x3a\x68\xf6\x0a\xef\x09\xce\x2a\x92\xc1\x10\x7f\xe2\xa6\xd6\xbb\x64\x93\xf2\x52\x58\x5e\x08\xa4\xd0\x82\x44\xeb\x59\x29\x67\x36\x5d\x61\x56\x87\xac\x36\xfb\x1c\x06\x29\xd2\xc6\x3a\x26\xd5\x68\x4b\x2d\x09\xa3\x82\xa7\x77\x98\x25\x83\x13\x88\x94\x0a\x55\x66\x13\xc9\xed\x01\x02\x5c\x45\xb8\xa0\x05\x4d\xb0\xde\x44\x06\xda\x23\x64\x51\x55\xce\x95\xb2\xc6\x6a\x56\x14\xb4\x0d\x31\x6b\x43\xfc\x0e\x0f\x2b\xd4\xd8\xe8\x84\x7b\x8d\x9c\x0c\x4e\xd3\x3c\x28\xd9\x5c\xa0\x0b\x08\xd0\xff\x6a\xb4\xe6\x86\x49\xb6\x44\xdd\x05\xbd\x31\x85\xdd\x8d\x87\x84\x9f\x74\xa6\x83\x66\xa1\xcb\xb0\x17\xa5\x95\xa3\x65\x0d\xd0\x10\xc0\x69\xaa\x28\x4d\xa9\x91\x26\xa1\x29\x9c\x02\x85\xe6\xf7\x2c\x5d\x27\x70\xe9\x49\x3a\xa2\x28\xb4\x23\xc6\x4a\xa9\x3b\x30\x2b\x14\x02\x3c\x32\x6e\xa5\x34\x16\x28\xc9\x75\xb1\x0a\x32\xf5\x20\x85\x62\xde\x17\xa9\x7a\x74\x26\x7c\x73\x64\xf2\x58\x6a\xef\x4c\xac\x21\x43\x81\xc1\xb4\x1b\x07\xbb\x4d\xd2\x9a\x03\xe6\x4a\x09\x64\xb2\x03\xc2\xb7\xbd\x52\xa5\xb4\x47\x50\x72\x56\x43\x47\x2f\x44\x96\xf9\x1c\x35\x59\x5e\x13\x50\x8e\xa6\x67\xa1\xb4\x8f\xa9\xa5\x2a\x2f\x1c\xb2\x1e\xa2\x73\x18\x02\x66\x96\x22\xdc\xf6\xe5\x8b\x4e\x08\x3f\x13\x0a\x77\x2e\x51\x77\x40\xf8\xce\xa7\x1a\x17\xfc\xf1\xe8\xa9\x78\xf0\x38\x97\xc2\x7f\x8b\x02\xe8\x7b\x74\xaa\x92\x22\x3e\x24\xc4\x06\x8c\x55\x14\xdf\xb2\x98\x17\x4a\x33\xcd\xc5\xda\xc7\x5c\xfd\x7a\x64\x9e\xa1\x9a\x0e\x97\xc6\x25\x27\xa7\xd3\x00\x23\xfb\x2c\x55\x86\xc0\x96\x8c\xf4\x81\xa3\xce\x83\xd2\x77\x8e\x09\x42\xf8\x27\xf9\xa0\xea\x8e\xb3\x7c\x12\x34\xcf\x54\xab\x05\x17\x87\x14\xde\xe4\xf2\x66\xa3\x01\xcd\x9a\x39\x2a\x84\x90\xf5\xe4\xf2\xa6\x52\x67\xe4\x35\x52\xaf\xb4\xe0\xcc\x18\xbe\xac\xbc\xf4\x08\x31\x38\x61\x2a\x3c\x67\x4b\xfc\x4e\xa9\xbb\xb2\x78\xab\x97\x87\x30\x6d\x01\xc7\x35\x24\xb1\x7d\xab\x97\x4c\xf2\xf7\x3e\x80\x34\xb9\x6e\xea\x62\x37\x02\x08\x37\x04\xf0\x45\xf4\xa7\x29\x94\x6b\xba\x64\x68\x1f\xb2\x61\x82\xb7\x04\xb2\x1f\xd5\xb3\x48\x52\x82\x8d\x98\x52\xd7\x44\xd1\xd8\x4f\xed\xb0\x27\xf0\xea\x91\x91\xcc\x8c\x21\xff\x2c\x79\x14\x4c\x2f\xf1\xec\x14\xd4\x24\x5a\x62\xab\x09\x9d\x0d\x2c\x58\x8a\xe6\x00\x7e\x6f\x36\xe1\x09\x47\xe6\x8e\x82\x08\xc3\x57\x6f\x26\x26\x2c\xb0\x4a\x39\x05\x76\xdd\x9e\xa8\x1d\x12\xbd\x84\x9c\x3d\xf2\xbc\xcc\xa9\xc5\x8b\xed\xf0\xdf\xe0\x68\x87\x68\xcf\xc4\x80\x06\x99\xb8\x86\xf0\x62\x70\xbc\xe7\x11\x77\x84\x93\xeb\x03\x94\x98\x56\x80\x71\x99\x4a\xc9\xff\x51\x62\x74\xa4\x39\xed\x1e\x4d\x3d\xad\xb8\x27\x70\xba\xbf\x1a\xe6\x24\x3e\x2a\xca\xb9\xe0\xe9\x64\x7a\x00\xb3\xb3\x69\x80\xab\x06\x37\xa4\x6c\xec\x0a\x75\x6b\x25\x62\xf8\x7a\x89\x16\x58\xe8\x1c\x26\xd3\x04\xa6\xd5\x9e\x34\x68\x38\x6e\xc8\xf2\x59\x32\xd7\xb4\xdc\x06\x16\x4a\x08\xf5\x60\xc6\xf0\x3c\xe8\xbb\x05\x47\x91\x91\x98\x90\x85\x7c\x91\x40\xd8\x3f\x5f\x2c\x04\xbb\x57\xba\x6a\xfd\x32\x81\x99\x73\x64\xe2\x66\xfc\x6c\x70\x8a\x1d\xd2\x4a\xd9\x6b\xbc\xe7\x29\xce\xf8\xfb\x43\xa2\xf4\xae\x05\x1c\x17\xc9\xf0\xf7\xd5\x36\x83\xba\x83\x7b\x25\xca\x9c\xd8\x13\x96\x7c\xc9\xe6\x6b\x8b\xe6\xfc\xeb\xaf\x9e\x25\x83\x3d\x86\xe7\x3f\x3e\x1b\x9c\x62\x74\x8c\x59\x7d\x8b\xeb\x37\x2c\x3f\x84\xf2\x6c\xf6\x4d\x00\x8c\xe8\x46\x65\x4a\x9f\x8d\x59\x51\xec\xd7\xc9\x97\xb5\xcc\x6f\xdc\x5b\xa2\x75\x0a\x33\x79\x87\xf2\x10\x42\x0e\xa8\xe3\x08\x80\x86\xf5\x3d\xec\xf7\x5f\x9d\xba\x8c\x22\x30\x6c\x1d\x5e\x84\xe6\xce\x8d\x9a\xe3\x93\x9c\xbd\x3e\xd4\xd0\x87\x1a\xfa\x50\x43\x1f\x6a\xf8\x6b\x87\x1a\x76\xbc\x30\x96\xd9\x72\x63\x71\x5a\x08\xd5\x31\xe5\x99\x03\xad\xf6\xdd\xa4\x24\xd4\xdc\x50\x9e\xc8\xef\x3c\x5f\xd7\x68\x4c\x17\xd7\xb6\xf1\x88\x70\x94\x8b\x62\x69\xbf\x52\x69\xa4\x68\x0b\x6a\x87\x30\xab\xbb\x4d\x06\x47\xb3\x63\x6b\xbc\x30\x8b\x30\x6c\x3d\x28\xe5\xa9\x90\x89\x26\x5f\x3e\xee\xcc\x68\x13\x75\x66\xe2\x98\xc9\xe0\x74\xed\x12\x9a\x76\xbf\xdc\xc0\xec\xb6\xb1\x9b\xdb\x33\xe4\x41\x56\xa9\x00\x8e\x19\x34\x90\x23\x22\xea\xf8\x6c\x18\x8f\xdb\xbf\x51\xc6\x92\x4e\x1b\xc2\xab\x47\x9f\xfe\x33\x99\x52\xcc\x72\x12\x92\x81\x26\xd3\x27\x22\xb8\x4f\x9a\x47\x11\x97\xce\x77\xd4\x71\xc7\x8b\x9d\xe2\xb1\x5f\x7c\xdd\xa9\xff\x0d\x1a\xc3\x96\x1d\xe4\x6a\x11\xea\x93\x57\x0d\xd8\xca\xef\x08\x19\x18\xc4\x2c\x78\x4f\x49\x3c\x2e\xb3\x83\x9c\xe5\x90\xfa\x60\x51\xe7\x9c\xf2\xa6\x0a\xad\xe6\x02\x73\x97\x69\x25\x53\x2e\x62\x3e\x51\xb5\x00\x32\xf3\xbd\x06\x8e\x04\xe6\xf5\xf5\x3d\xea\xb9\xa2\x24\x10\xe7\x86\x81\x29\xb9\xa5\x30\x93\xf3\xad\x85\x5a\x2e\xe9\x21\x05\x06\x56\x65\xce\x24\xb1\xb3\x29\x73\x87\x72\x02\x3f\xcb\xa6\x7b\x1d\xfc\xf5\x90\x4d\x44\x88\x53\x17\x56\xb3\xa0\xe9\x3d\x31\x42\x6e\x0a\x6b\xa4\x23\x82\xdb\xda\x85\xe7\x1a\x01\x1f\x0b\x4c\x43\x44\x6e\x4e\x06\xe5\x91\xfc\x8c\xd2\x2a\x92\x9e\xd4\x45\x8d\xd4\x3d\xed\x16\x78\x8e\x70\x2e\xf8\x1d\x85\x38\x34\xf9\xdd\xa0\x4a\xcb\x96\x68\x9e\x0d\x61\x5e\x12\xe1\x8c\x45\x96\x01\x97\x19\x4f\x49\xcf\xb8\x21\x8c\xca\xd1\xae\xc2\x6e\x61\x51\xca\x8c\xe5\x94\xcb\x46\xdd\x3e\x68\x25\x97\xf5\xa6\x30\x90\xee\xcc\xef\x93\x20\x08\x6d\xaa\xe4\x82\x2f\x4b\x5d\x65\xbf\x85\x87\x61\x36\x3e\x8c\xe2\x46\xca\x99\x2c\x99\x70\x6e\xb7\x0e\x39\x4f\xb4\x68\x91\x39\xab\xed\xb1\x21\x59\xa8\x16\x32\x90\xa9\x4a\xc2\xe1\xd2\xa5\x91\x40\xaa\xf2\x39\x97\x6e\x54\xd7\x20\xec\x5a\x4c\xe4\x0f\xc2\x71\x18\x0d\x6d\x45\xcc\x52\x9a\xb2\x28\x94\xb6\x8d\x3d\x5e\x03\xd7\x30\x27\x8d\xa6\x50\xd2\xf0\xb9\x68\xbe\x06\x6e\x0d\x8a\x05\xcc\x91\xa8\x95\x6a\x1e\xc9\x9f\x73\x13\xa9\x40\xd3\xf8\x59\xc2\xa5\x5c\x87\xa5\x26\x26\x6d\xae\xb4\x4a\xd3\x52\x43\x56\xea\xc8\x90\x15\x83\x56\x04\x0c\x74\x36\x90\x32\x49\x9b\x6d\x7f\x26\xca\x8c\x67\x79\x13\x3d\xcb\x00\x16\xa4\x90\xc8\x7c\x11\x78\x14\xb3\x48\x84\x1a\xf9\x33\x43\xdc\x50\x94\x36\xf9\x64\x70\x82\xf6\x70\xa8\xbf\x43\x66\x94\x3c\x4a\x60\x3d\xe8\x1f\x23\xaf\xa6\x4c\x53\x2e\xd3\x70\x76\xdb\x16\xd5\xa8\xd8\x1d\xb3\x15\x1a\x2d\xeb\x65\xb4\x97\xd1\x7f\x4d\x19\x8d\x7e\x1b\x39\x96\x87\xcc\x6a\x8c\x58\x3a\xd8\x2a\xcc\x12\xbd\xce\x2d\x4f\xb0\x8a\x13\x04\x79\x4a\x4e\xc1\x4b\x23\xcb\xd6\x07\xf0\x79\x47\x30\x0e\x0f\x77\x4a\x13\xa3\xec\x31\xe2\x56\x79\xf0\x04\xe2\xfa\x4b\x4e\x88\x41\x75\x7a\x29\x5b\x0f\x49\x10\x31\x1b\xbb\x73\x22\xff\xc0\x2a\x4d\x0e\x0a\x2c\x98\x30\xde\xff\x36\xe5\x3c\x62\x52\x79\x9e\xc1\xe5\x87\x5f\x7f\x1b\x04\x2f\x2a\xa4\xc4\x4c\xb5\xd3\x3a\x57\x14\xab\x8a\xd5\x06\xa3\xf6\xb4\x63\xc6\x8a\x55\x31\xab\x83\x94\x52\xed\xf4\xc3\x1c\x85\x92\xcb\xe8\x93\xfd\xdd\x28\x39\x65\x76\x35\x86\x24\x26\xfc\x26\xbe\x04\x22\x16\x0b\xfc\xec\xab\x05\x7e\x76\x49\xc8\xa1\x1e\xa0\xb1\x43\xa3\x8f\xd5\xa8\x83\x1d\x2b\xb7\x81\xe3\xab\xab\x17\x35\x23\xd0\x64\x71\x1b\x1b\x7a\x5c\x9a\xa4\xc5\x81\x01\xca\x0f\xd9\x7c\x72\x68\xc0\x38\x77\xb7\xce\x6e\x2b\x54\x9a\x9d\x43\x3a\xa0\xf0\xd6\x0f\xf5\xae\xf1\xe4\xa4\xb9\x4d\xae\x3b\x46\x29\x30\x4d\x22\x17\x56\x00\x7e\xa0\x28\x43\x93\xeb\x23\x47\xdb\xd0\x02\x7e\xc5\xd5\x83\x34\x51\x6f\xb7\xd6\x7e\x1b\x99\x6a\xcd\xd5\x83\x44\x5d\x25\xfb\x9a\x9f\xbe\x3c\xff\xef\x84\x72\xc5\xbf\xf8\xe2\x93\xd0\xf8\x93\x67\xbf\x24\x5b\xeb\xde\xee\x78\x0b\xdb\x56\xb1\xc6\xcb\xbe\x58\xa3\x2f\xd6\xe8\x8b\x35\xfa\x62\x8d\xbe\x58\xa3\x2f\xd6\xe8\x8b\x35\xfa\x62\x8d\xbe\x58\xa3\x2f\xd6\xe8\x8b\x35\xfa\x62\x8d\xbe\x58\xa3\x2f\xd6\xe8\x8b\x35\xfa\x62\x8d\xbe\x58\xa3\x2f\xd6\xf8\x9d\xc5\x1a\x7f\xad\x22\x05\x2e\xdd\x9e\x11\x67\x77\xbc\x08\xf9\xfb\x21\x7d\xff\x18\x2a\xee\x6c\xbc\xab\x48\x81\x0e\x8c\x77\x15\x2a\x28\xed\x14\xc8\x2c\x4c\x30\x3e\x9e\x32\xcd\x72\x24\xa9\x98\x51\x1a\x7b\xa3\x9e\x21\x94\x31\x90\x52\x8e\x85\x08\x09\x7c\x55\x19\xec\x21\xb0\xbe\xbc\xa1\x2f\x6f\xf8\x73\xca\x1b\x00\x82\x68\x38\x84\xcd\x57\x2c\xbd\xc3\xcd\x30\x64\xfc\x2f\xf0\xeb\x38\xcc\xce\x8c\x72\xcf\x61\x47\x51\x6c\x6b\x8c\xb6\xe8\x15\x51\x7a\x46\x8e\x4a\xa1\x54\xc8\x72\x41\xc9\xc7\x76\x85\x47\x88\x1c\xa5\xa8\x50\xe1\x0f\x27\x5d\x38\x2f\x2b\xa6\x31\x4d\x61\xa3\x7d\x60\x75\x4e\xeb\xd4\x7b\x83\xff\xe2\x84\x86\xfb\x2b\x95\xc2\xf1\x70\x37\xb5\x51\x96\x79\x37\xf9\x46\x9b\xc3\xec\x82\x32\xf9\x68\x83\x1c\xa7\xaf\xeb\x1e\xc5\xbf\x60\x5c\x94\x1a\xaf\x55\xce\x78\x87\x2b\xdb\x5a\xb8\xd7\x4d\xd8\xc8\xeb\xa1\x03\xc8\x5c\x0f\x1d\x49\xfc\x2e\x92\x5c\xed\x27\xea\x20\xa2\x4b\xc1\x26\x09\x50\x43\x60\xb5\x37\xc9\x65\x75\x14\x77\x39\x9d\x24\xf0\xba\xce\x8b\x6e\x5e\xc9\x55\xed\x66\x7c\x52\xf4\xe4\x9a\xf0\x21\xfb\x7e\xcf\x84\xcb\x6a\x50\xd1\xb7\x6b\x16\x16\x02\x15\x22\x7e\xf0\xc2\x40\xcc\x9e\x58\x1a\xf8\x97\xad\x19\xfa\x8a\x19\x7c\x3b\x3b\xbe\x6c\xc8\xc3\x47\x96\x89\xd8\xd2\xe7\x39\x33\x08\x64\xeb\x99\xad\x1d\x85\x9d\x75\x44\x71\x3f\xf7\xd4\x42\xa2\x1a\xa3\xd7\xde\x4e\xec\x9f\xc1\x59\x63\x0a\xbe\x41\x9c\x02\x21\x21\x59\x4e\x28\x93\x79\xa2\x50\xb5\x72\xc5\x4e\x10\xb0\xf4\x68\x6f\x1e\xd6\xc3\xa4\x4e\xd8\xe7\x4b\xe9\x2c\x00\x77\xa5\x5e\xf8\x58\x08\x9e\x72\x1b\xa7\x47\x35\x52\x30\xf3\x49\x17\x86\xce\x5a\x8c\xe5\xb6\x24\xb4\xa8\x7a\x44\xc3\xaf\xbf\x26\x9e\xa8\xbf\xfd\xe6\x6c\xfa\xaf\xbf\x26\xdf\x7e\x6e\xc2\xc1\xdb\x6f\xbf\xd5\x6a\xcd\x11\xf8\xed\xcc\x01\xd1\x8d\x7a\x5a\xa2\x45\x13\x8f\xe3\x86\xe4\x4e\x52\x32\x1b\xbf\x47\xb1\x26\xeb\x84\x10\x16\x2b\xe2\x59\xeb\x46\x2e\x3b\xd6\x54\x69\x28\xe7\xa5\xb4\x25\x9c\x13\x64\xd0\xac\xcf\x62\x02\x0b\x76\x0c\x0a\x6c\x6b\xf3\x58\xb0\xf4\x8e\xf2\xe2\x88\x63\xb3\x32\xf5\xc1\xd9\xba\xe5\x85\x46\x81\x34\x11\x9a\x96\x2a\x2d\xdc\x53\x1f\x2c\x18\x5a\x2a\xa3\x79\xfe\x32\xf9\x74\x48\xff\xff\x22\xf9\xff\xa3\x7c\xed\xee\x0d\x4c\x9e\xbb\xd0\xfc\xf3\xe4\xf9\x7f\x26\x2f\xbd\x26\x41\x9f\x2b\x33\x0c\xa7\x32\x0e\xd7\xc0\x62\x61\x1d\xd5\x02\x52\x56\xb0\x11\xcb\xf9\xa8\x41\xe4\xd1\x97\x1b\x14\x1e\xfd\xcd\xaf\x24\xca\x8c\xd6\xdc\xa0\x3b\x9a\xf2\xfc\x40\x8b\x18\x4e\x4d\x9c\x4a\x09\x53\xb4\x74\x26\x59\xf7\xee\xc9\x36\xfa\xf2\x79\xf2\xfc\xf3\xe4\xd3\xd1\xdf\x5c\x4b\x56\xa9\x4a\xd7\x9c\xd8\x8d\x8a\xd2\x9c\x70\x34\x29\xe9\x1b\x55\x44\x0e\x4b\x10\x16\x9b\xce\x4d\x11\x98\x30\xcd\x53\x36\x25\x98\x5c\x26\x4a\x2f\x2f\x8a\xbb\xe5\x85\xc5\x47\x7b\x41\xb5\x95\xe4\xb5\x5f\x9c\x3d\x51\x8a\xfa\xda\xc1\xbe\x76\xf0\xc3\xd5\x0e\x4a\x25\xa9\xe8\xec\x07\xca\xd2\x39\x48\x8d\xab\x76\x02\x5d\xd8\x85\x46\xcb\x2d\x95\xf4\xe5\x69\x21\x73\x28\x94\xa9\x99\x13\x26\xd8\x1a\xcd\xe3\x04\x28\x53\x56\x98\x92\x64\xc6\x74\xa5\xf1\x6d\x60\x11\x07\xcf\x90\x72\x7f\x07\xa7\x07\x96\x7c\xc3\xee\xca\xb7\x0e\x2c\x7d\x6d\xdf\xbe\x28\xc6\xde\x55\xa3\x7f\x28\x53\xbd\x2e\x2c\x66\x47\x0d\xf8\x2a\x42\x03\x6f\xd7\x48\x7a\x72\x37\xdc\xbd\xaa\x5f\xd2\xc8\x52\xd9\x64\x2f\x82\xbb\xf7\x99\x15\x86\x5c\xc9\x6f\x71\x7d\x0a\x96\xbe\x45\x14\xec\x6f\x6f\x66\xb1\x1a\x90\x5c\x0d\xb7\x41\x77\x60\x0d\xfc\x5d\x3e\x03\xd9\x40\xe4\x94\xd0\x0e\xac\x6a\x45\xa7\x15\x9a\x0e\x49\x9d\x33\xd9\x22\x03\x1d\x5c\x7a\xb5\xec\x37\x7a\x2a\xe7\xd6\x62\xd6\x36\x39\xa4\x00\x69\xf4\x68\x64\x69\xaf\xeb\xad\x2f\x3d\xcd\x4b\x63\x81\x09\x97\x50\x05\xf8\x48\xc5\xc1\xd4\x23\x79\xcd\x69\x8a\xc6\x67\x58\xce\xd7\x1b\xb9\x8a\xc9\x53\xd7\x9c\xab\xc2\x1c\x45\xc8\xc9\xdb\xe9\x6c\x7b\xbf\xee\x9e\x86\x2c\x90\x86\xf3\x9c\x71\x73\x97\xc0\x1b\x65\x7d\x1c\x39\x75\x39\xfb\x74\x8e\x21\x84\x5b\xe5\x9d\xa5\x1d\xfb\x0b\x45\x8f\xdb\xc4\x03\x98\xce\x0a\xd7\x8e\x49\x51\x29\x6c\xa5\x01\x8d\x6b\x07\xe7\x5c\xc2\xd7\xfc\x59\x74\x52\xdb\x72\x9c\xc0\x0d\xad\xcf\x1c\x61\xe9\x0e\x12\x88\xe3\x99\x6c\x78\x7d\x46\xb2\xc2\xac\x48\xf9\x50\x5f\x4a\xc3\xe7\x70\xee\x0e\x1f\x90\x8a\x02\xb8\x89\xed\x9e\xfd\x1e\x0a\xe4\x5c\x92\x1e\x1f\xc3\xe7\x4f\x26\xd1\xd1\x25\x32\x5d\x06\xb1\x21\xe5\xe7\x98\x2c\x13\x58\x16\x2f\x86\xc0\xd5\xf3\x21\xa0\x4d\x93\x24\x79\xf6\x44\x7e\xdc\x17\xa5\x1d\x39\x92\x76\xbc\xd8\xb3\xc3\xdd\x67\x71\xfa\x6a\xf5\x7f\xf2\x6a\x75\x6f\x77\x0f\x90\xa0\x76\x1a\x4e\x35\xd0\x8d\x0a\xf6\xc1\x69\xb6\xf9\x90\x65\x3e\xc9\x2e\x1f\x90\x88\x03\x36\xb9\xcb\xd6\x7d\x30\x8b\x7c\xc8\x1e\x1f\x61\x8d\x7b\x5b\x1c\x6d\xf1\x81\x75\xde\x6d\x87\xff\x70\x2b\x7c\xd8\x02\x1d\x32\x2f\xbb\xed\xef\x3f\xb7\xf5\x3d\x3c\xf3\x03\x96\xf7\x10\x61\x76\x5b\xdd\x8f\x66\x73\xf7\xf2\xdd\x6e\x7b\xbb\xc3\xda\xee\xb1\xb5\xa6\x50\xf6\x86\xe9\x3b\xb4\x6f\x8b\xc6\x0f\x3b\xec\x9c\xe4\x6c\x13\x9e\xbc\x43\xf5\xe0\xce\x7e\xa8\x3c\x4f\x55\xfa\xbb\x36\x64\x26\x54\x74\xe9\x52\x42\x49\xd9\x77\x2e\xca\x4b\x3d\xd5\x20\xc9\x89\xda\x3c\x67\x8f\x53\xcd\xd3\x63\xd6\xe5\x26\x80\x86\x08\x96\x09\x67\x34\xfe\x42\x9e\x82\x3a\xa9\x4e\xe1\x88\xd9\x48\xa9\x84\xcc\x89\x82\xad\x9d\x85\x75\xa8\xfe\x50\x47\x69\xcd\xe9\x6b\xb6\x6f\x09\xfe\xa8\xcb\x52\x28\xb1\x8e\xc7\xec\x79\x77\xea\x8f\x79\x61\xd7\x01\x65\x38\xcf\x54\x75\x70\x3a\x9b\x7d\x43\xbb\x26\xaa\xa8\x0b\x59\xac\xf1\x91\xdb\xab\xba\x28\x5d\x50\xcd\x70\xee\x76\x63\x0d\xf5\xdc\x84\x7c\x36\x38\x81\x4c\xfd\x0d\x2d\xfd\x0d\x2d\xfd\x0d\x2d\xfd\x0d\x2d\xfd\x0d\x2d\x7f\xf6\x0d\x2d\x00\x16\x25\x93\x69\xc7\x06\xa1\x85\xc0\xad\x87\xaa\x4a\xad\x0d\xed\x31\x37\x37\xb0\x64\xf7\x95\x04\xb3\x62\x74\x7a\x46\x97\xa3\x71\xb9\x14\x38\x72\x43\x58\x58\x31\x9d\x3d\x30\xdd\xc1\x14\xdd\x87\xf1\xa3\x68\x68\x3a\xdf\xf8\x92\xef\xac\xe3\xdd\x4a\x85\x5f\x6a\x3a\x92\x3c\xa5\xa4\xdb\x39\xe9\x56\x0f\xcc\x48\x2f\x5d\x6f\x55\x1d\x6d\x91\xe3\xfb\x8e\x26\xc1\xd6\xac\x5b\x7b\x7c\xf2\x96\x80\x32\x89\x48\x19\x2d\xdf\xf3\x62\x54\x37\x84\x39\x2e\x48\xa6\x7c\xb6\x95\x09\x67\xe3\x98\xd6\xd5\x96\x49\x33\xe1\x68\xc5\x0c\xd0\xe1\x99\x1d\x71\x09\xa1\xe8\xdb\x79\x2d\x9b\xdd\xd6\x63\xd6\x9f\x42\x4e\x0b\x25\x27\x3f\x98\x90\xe0\x00\x21\xbf\x81\x46\x67\xe2\x81\xad\xb7\x30\x4c\x06\xc7\x6f\x3a\xfb\xbb\x7e\xfa\xbb\x7e\xfa\xbb\x7e\x9e\x78\xd7\x4f\xaa\xa4\xaf\xbc\xeb\x58\xa1\x16\x99\xae\x2a\xc0\x4a\x4e\xd2\x52\xbb\x3c\xff\x78\x47\x46\x25\x2a\x81\x7d\x03\x65\x9f\xca\xa7\xd5\x88\xd5\x80\xf4\xeb\x15\x4e\x2e\x43\xe4\x6e\x01\xac\x99\x18\x54\x99\xa9\x98\x3c\xe2\x2b\x27\x08\xab\xa7\x70\xae\x60\xc6\xde\x86\x4b\x46\xfc\x0f\x0a\x76\xc3\x6d\xa0\xfd\x1d\xa3\xe4\x3b\xba\x41\x24\x84\x7f\x62\x49\x46\xd5\x15\x1d\x88\x50\xf1\x01\xb9\x37\x44\xb3\xd2\x6d\x5f\x99\x54\xa4\xbc\x43\x38\xb5\x8e\xc9\x55\x49\x7f\xa5\xcc\x50\x0b\xf7\x0b\x4e\x75\xaf\xe9\x8a\xc9\x25\xc5\xa6\x26\xb4\x47\xf3\xd9\x01\xb4\xfd\xb8\x93\xea\x41\xba\x98\x56\xdc\x11\x13\x36\x96\xe7\x8d\x0e\x2f\xa7\x93\x10\xb6\x0d\xbd\x50\x63\x8a\x60\x15\x96\x42\x42\x5d\x34\x6b\x46\x43\xa8\x96\x77\x44\x3d\x3e\x4d\x02\x00\xf2\x5d\x57\x4c\x75\x50\xf5\x32\x5c\xe5\x44\xe7\x5f\x84\x5c\x6c\x1c\xfd\x02\x9a\x61\x86\x96\x71\x61\x80\xcd\x29\x1d\xc4\xcd\xb7\xa2\x79\x2b\x4a\x1d\x8e\xb8\xdd\xde\x34\x79\x2a\xf6\x7a\xc7\x75\x3b\x1d\xc8\x93\x5e\xf3\xe0\x95\x62\xad\x56\xf0\xcc\x38\x46\x6b\xa0\x0a\xbc\x55\x1d\x7d\x1b\x6e\x60\xf1\xe5\xa6\xd3\x89\x2b\x17\x4d\x57\x8a\xae\xbf\x8a\x06\xdf\x9f\x9f\x86\x02\x6d\x37\x45\xee\xac\x89\xa1\x83\x0c\xb2\x19\xb0\x2c\x99\x66\xd2\x22\x66\xd4\xc7\x16\x35\x42\x81\xf6\xef\xa3\x88\xa1\x68\x1a\xb7\xeb\xa3\x68\x32\x0b\xc0\xf1\x20\xc4\xb4\x32\x9e\x52\x41\xb9\x69\x0b\xb7\xb2\x74\x94\xbf\x80\x70\x65\x51\xaa\x32\x1c\x82\x51\x95\x8f\x63\xa0\xbe\x47\xc8\xdf\xc5\xc4\xf3\x1c\x33\xba\x17\x4f\xac\xbd\xcc\x90\x4b\x43\x67\xaf\x58\x6b\x2c\x4e\x3f\xea\x46\xb4\xa6\x37\x2c\xb5\xf5\x7e\x33\x66\x3c\x55\xf8\x79\x09\xb9\xf9\x7e\x76\x1b\xeb\x95\x15\xfd\xf6\x9a\x93\x22\xef\x38\x7c\xf1\x9a\x6e\x20\x79\x3a\xd9\x3a\x1c\x95\x5d\x44\x73\xa0\x51\xc5\x56\x3c\x54\x99\xab\x5b\x4d\x17\x1b\x38\x74\x86\xf0\xbd\x74\x4a\xe0\xe3\x5b\xd0\xdb\x10\x8a\xac\xf0\x69\x71\x70\x28\xcf\x5e\x28\x95\x84\x4c\x2b\x57\x3c\xdb\xe0\xf0\x1b\xaa\xf8\x4e\xaa\xd6\x09\x61\xd6\xda\xd9\xd1\x05\x42\xc6\xd2\xc2\xb1\x54\x2b\x63\x2a\x45\x6f\xc0\x5d\xa4\x16\xd2\x39\x29\x87\x8b\x6e\x67\x9a\x63\xca\x28\x38\xc3\xf4\x9c\x5b\xcd\xf4\xba\xa6\x54\x75\x13\x50\x69\x70\x51\x0a\x38\x37\x88\x90\x90\x97\x13\x6f\x2c\xa9\x41\x9f\x39\xf5\x09\x31\xd7\x93\x32\x87\xe9\xd6\xab\x85\xe0\xa9\x53\xb3\x9c\x2e\xa1\xb7\x4c\xda\x27\x52\x78\xbf\x0b\xd0\xba\x58\xe5\x63\x7a\x00\x21\x59\xf7\xc8\xfb\xfe\x5e\xb7\xa0\xab\x38\xd6\x47\xbd\x41\xac\xbf\xf1\xaf\xbf\xf1\xaf\xbf\xf1\xaf\x7d\xe3\x5f\x90\xda\xe3\xee\xfc\x0b\x42\x1b\x4c\x68\x7f\xeb\x5f\x7f\xeb\x5f\x7f\xeb\xdf\xbf\xf9\xad\x7f\x8e\x89\x74\x59\x58\x5a\xa3\x83\x78\x35\x60\x41\x23\x2d\x7f\x58\x8d\xe6\xf8\x84\xb1\xdf\x6a\x9a\xd6\xc1\x2b\x51\xd6\xa9\x4c\xa7\x5a\x5c\xe0\x71\x1e\x84\x9f\x10\xf0\x8c\x74\x45\x9b\x51\xe7\x53\x73\xf7\xbb\xeb\xc8\xef\xa9\x25\x99\x69\x32\x98\x51\x35\xb5\xbb\xa6\x11\xbd\xf1\x0d\xdc\x1e\x3a\xbb\xfc\x71\x16\x76\x38\x4d\x5d\x57\xd5\x6f\xd2\x28\xdb\xa7\xcc\x61\xe3\x2c\xb9\x80\x73\x9e\x60\xb2\x63\x6e\xac\x8d\xc2\xb3\x53\x22\x95\xff\x02\x97\x2d\xd6\x4f\xf6\xdf\xb5\x58\x6f\x6a\x7c\x40\x01\x33\x3a\x55\x0e\x90\x74\x17\xd7\x18\x3e\xf1\x82\x54\x88\x52\x33\x11\xbe\xd6\xde\xf7\x18\x7e\xfa\x65\x00\x21\x7c\x1c\x2a\x2c\xcc\x18\x7e\xfa\x65\xf0\x7f\x03\x00\x4e\x8e\x31\x2f\xa5\x82\x00\x00")
 
 func clusterApiInfrastructureClusterXK8sIo_awsmachinesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -243,7 +244,7 @@ func clusterApiInfrastructureClusterXK8sIo_awsmachinesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _clusterApiInfrastructureClusterXK8sIo_awsmachinetemplatesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5f\x73\xdb\x38\x92\x7f\xf7\xa7\xe8\xda\x7b\x48\xb2\x25\x51\xeb\x64\xee\x2e\xa7\x97\xad\x4c\x9c\x99\x55\x65\x9d\xb8\xa2\x4c\xb6\x6a\xaf\xae\xae\x20\xb2\x25\xe1\x0c\x02\x5c\x00\xb4\xad\x4c\xcd\x77\xbf\xea\x06\x41\x52\xff\x28\xfa\xcf\x64\x6b\x66\xc9\x87\xc4\x82\x1a\x60\x77\xa3\xd1\xfd\x43\xa3\x61\x8b\x42\x7e\x41\xeb\xa4\xd1\x53\x10\x85\xc4\x3b\x8f\x9a\x3e\xb9\xe4\xfa\xb5\x4b\xa4\x99\xdc\x9c\x9f\x5d\x4b\x9d\x4d\xe1\x6d\xe9\xbc\xc9\x3f\xa1\x33\xa5\x4d\xf1\x02\x97\x52\x4b\x2f\x8d\x3e\xcb\xd1\x8b\x4c\x78\x31\x3d\x03\x50\x62\x81\xca\xd1\x4f\x00\xa9\x2a\x9d\x47\x9b\xdc\x8d\xeb\xa1\x84\x2a\xd6\xe2\xbb\x29\x54\x3f\xbd\x3a\x03\x10\x5a\x1b\x2f\x68\xa0\xd8\xcd\x68\x6f\x8d\x52\x68\xc7\x2b\xd4\xc9\x75\xb9\xc0\x45\x29\x55\x86\x96\xc7\x88\xcc\xde\xfc\x29\x79\x99\xfc\xd7\x19\x40\x6a\x91\xbb\x7f\x96\x39\x3a\x2f\xf2\x62\x0a\xba\x54\xea\x0c\x40\x8b\x1c\xa7\x20\x6e\x5d\x2e\xd2\xb5\xd4\xe8\x31\x2f\x94\xf0\xe8\x12\xa9\x97\x56\x38\x6f\xcb\xd4\x97\x16\x93\x5d\x4e\xcf\x5c\x81\x29\x71\xb3\xb2\xa6\x2c\xa6\x70\x8a\x3c\xbc\x2a\xf2\x2f\x3c\xae\x8c\x95\xf1\xf3\x38\x2a\x62\x2c\x0a\xc9\x2d\x41\x9f\x6f\xfe\x36\xbf\x0c\x7c\x7d\xae\xf8\xe2\x2f\x95\x74\xfe\xfd\x11\x82\xbf\x4a\xe7\x99\xa8\x50\xa5\x15\xea\xa0\x6c\xfc\xbd\x93\x7a\x55\x2a\x61\x0f\x51\x9c\x01\xb8\xd4\x14\x38\x85\x0f\xc4\x74\x21\x52\xcc\xce\x00\x2a\xc5\x32\xd3\xe3\x4a\x75\xd5\x34\xbd\x0c\x63\xa6\x6b\xcc\x45\x90\x09\xc0\x14\xa8\xdf\x5c\xcd\xbe\xbc\x9a\x6f\x35\x03\x64\xe8\x52\x2b\x0b\xcf\x93\xb4\x2f\x02\x48\x07\x7e\x8d\x10\xba\xc1\xd2\x58\xfe\x78\x40\x10\x78\x73\x35\xab\x47\x2d\xac\x29\xd0\xfa\x5a\xa9\xe1\x69\x19\x6f\xab\x75\x87\x87\x67\xc4\x66\xa0\x82\x8c\xac\x16\x03\x07\x95\xc0\x98\x55\x92\x81\x59\x82\x5f\x4b\x07\x16\x0b\x8b\x0e\x75\xb0\x4a\x6a\x16\x1a\xcc\xe2\xff\x30\xf5\x09\xcc\xd1\x52\x47\x70\x6b\x53\xaa\x8c\x8c\xf5\x06\xad\x07\x8b\xa9\x59\x69\xf9\xb5\x1e\xcd\x81\x37\xfc\x1a\x96\xc5\x83\xd4\x1e\xad\x16\x0a\x6e\x84\x2a\x71\x04\x42\x67\x90\x8b\x0d\x58\xa4\x71\xa1\xd4\xad\x11\x98\xc4\x25\x70\x69\x2c\x92\xed\x99\x29\xac\xbd\x2f\xdc\x74\x32\x59\x49\x1f\x17\x66\x6a\xf2\xbc\xd4\xd2\x6f\x26\xbc\x62\xe4\xa2\xf4\xc6\xba\x49\x86\x37\xa8\x26\x4e\xae\xc6\xc2\xa6\x6b\xe9\x91\x6d\x76\x22\x0a\x39\x66\x66\x35\x2f\xb5\x24\xcf\xfe\xcd\x56\x4b\xd9\x3d\xdb\x52\x9e\xdf\x90\x6d\x38\x6f\xa5\x5e\xb5\xbe\x60\xa3\xed\xd0\x32\xd9\x2c\x4d\xae\xa8\xba\x06\x29\x1a\x65\x52\x13\xe9\xe3\xd3\xbb\xf9\x67\x88\xaf\x0e\x0a\x0f\xba\x6d\x48\x5d\xa3\x66\x52\x91\xd4\x4b\xb4\x81\x72\x69\x4d\xce\xa3\xa0\xce\x0a\x23\xb5\xe7\x0f\xa9\x92\xa8\x3d\xb8\x72\x91\x4b\x4f\xf3\xf7\x8f\x12\x9d\xa7\x19\x48\xe0\x2d\xfb\x17\x58\x20\x94\x45\x26\x3c\x66\x09\xcc\x34\xbc\x15\x39\xaa\xb7\xc2\xe1\xaf\xae\x64\xd2\xa6\x1b\x93\xf2\xfa\xa9\xb9\xed\x4c\x77\x89\x83\x9e\x5a\x5f\x44\x37\x75\x64\x4e\xf6\x57\xdf\xbc\xc0\x74\x6b\x11\x64\xe8\xa4\x25\xa3\xf5\xb4\x36\xcd\xf2\x98\x57\xea\x5e\x87\xcc\x62\x45\xbe\xdb\x7e\x92\xa7\x18\x51\x2a\xba\x45\xe4\x4c\x78\x01\x1a\x31\xc3\x8c\x96\x12\x7b\x79\x04\x91\xb7\x46\x08\xe6\x20\xc0\x1f\xe2\xb4\x9b\xdb\xc3\xda\x3b\xc8\x31\xeb\xac\xf2\x5a\xd4\x45\x2e\x65\x5a\x7b\x86\xb6\x0e\x17\xb8\x16\x37\xd2\xd8\xd8\x5e\x39\xb4\xe4\xe0\x2b\xba\x59\xa3\x47\x64\x19\xc7\x57\xa1\xe6\x98\x96\x56\xfa\xcd\x8f\x14\x8d\x8e\xd2\xef\x2a\xfa\x48\x77\x5e\xa3\x1a\x84\xb5\x62\x43\x9c\x5a\x5c\xa2\x45\x9d\x22\x7b\x2c\x57\xd1\x86\xc8\x47\x42\x0b\x1f\x7d\xdd\x02\x41\x14\x85\x92\x61\x42\x48\x42\xa9\x9d\x17\x3a\xc5\x04\x3e\xaf\xd1\xe1\x5e\xef\xdb\xd8\xcf\x21\x79\xc0\x5a\x24\xea\x2f\xf4\x66\x8f\x3e\x58\x66\x06\x22\xae\x6b\x8e\x9b\xa0\x68\xb5\x81\xb1\x34\x04\x07\x8b\xd4\x97\xc2\x1b\x7b\x58\xb3\xf4\x48\x8f\x79\x87\xa2\xf6\x6d\x32\x1a\xe1\xa7\xa8\x8d\xe0\xca\x6a\xe5\x30\xc7\xf5\xf4\x53\x8f\xc6\x87\x2d\x36\x30\xbb\x18\xc1\x9b\x4f\x1f\x46\xc4\xe4\x52\x2a\x8f\xd6\x25\xf0\x51\xab\x0d\x18\xcd\xcb\xaa\x22\xa0\xef\x7f\x08\xdf\xb3\x6b\x5b\xd4\x26\x45\x8e\x69\xce\x3f\x6e\xc8\x53\xe6\xe4\x95\xfc\x9a\x02\x8f\x46\xb8\x95\x4a\xd1\xfb\x4a\x15\xd4\x48\xbe\x55\x66\xc1\x08\xd1\xda\x2e\x4d\xf4\xb1\xb4\xca\xde\xac\xee\x26\xd8\xd5\x1a\x89\xb3\xac\xd5\x70\xa2\xeb\x11\x77\xb7\xff\x54\xea\xbb\x17\x2b\xcf\xa2\x4e\x43\xfc\x41\x4f\x8c\x5d\xe3\x66\x12\x62\x50\x21\xa4\x75\x50\xba\x60\xb8\x32\x23\xf7\xbc\xdc\xf0\xf4\x56\x53\xf8\x79\x8d\x1b\x10\xb6\x31\x70\x91\xa6\xc6\x66\x1c\xb3\x82\xa9\xdb\x52\x61\x63\xa0\x8b\x0d\x37\x92\x19\xbc\xb9\x9a\x35\x91\x23\x33\xa9\x4b\xc4\xad\x4b\x44\x2e\xbe\x1a\x9d\xa4\x26\x9f\xbc\xf9\xdb\xfc\xdd\xdb\x97\x93\x80\x03\x26\x3f\x39\xb4\x3f\x96\x32\xc3\xc9\x4f\x04\xd2\xfe\x37\x70\x2e\xf5\x2a\x59\xfb\x5c\x3d\x3b\x21\xf5\x49\xbb\x3e\xa0\x9c\xf0\x86\xa0\x9a\xa0\xdc\x03\xaa\xd0\x5b\x26\x7d\xf2\x05\x7d\x6d\x2a\x3c\x0c\x26\x7b\xd0\xed\x30\x4e\xf0\x34\xfa\xd2\xc0\x78\x12\x85\x61\xb8\xcd\x13\x96\x0a\x87\x63\x47\x7b\x16\x2f\x6f\x8e\xf8\xda\xdd\xa7\xb7\x2d\x86\x27\xc0\xb1\x07\x08\xf0\x85\x3b\x82\xd4\xa9\x2a\x33\x74\xc1\x17\xd8\xb0\xb6\xab\x99\x88\x50\xef\x87\xf6\xc7\x07\x0b\xd6\xd3\x3c\xc2\x73\x4f\x2d\xc4\x0e\x1c\x38\x4e\xd2\x13\x06\xa3\xa0\x78\x9a\x99\xb0\xd7\xe8\x41\x16\x74\x73\x92\xf0\x08\x54\x3a\x4e\x7a\x5a\x20\x79\x52\x8c\xad\x69\x9f\x5d\x3c\xbd\x5f\xec\x25\xd5\x69\x79\x1a\x4c\xf1\x59\xac\x3a\x2c\xa5\xa1\xbb\xea\xb5\xd2\x7b\x49\x71\x04\x9e\x10\x27\x15\x28\x31\x45\x68\x8a\x2e\xdc\xd3\x57\x14\x79\xb3\x2c\x40\x86\x1a\x71\x8c\x76\xe1\x04\x39\x09\x43\xa8\x56\x64\x59\x70\xd0\x19\x2e\x05\x05\xcc\x96\xaf\x2e\xac\xb9\x91\x19\xf9\x91\xd9\x12\x16\xc6\xaf\xe3\x37\x6f\x2b\xa4\x41\xbb\xb2\xaa\x29\x62\xcc\x10\x9f\xc3\x18\x8e\x3c\x92\x17\x2b\x36\x59\xb8\x95\x7e\x0d\x99\x5c\x32\x44\xf0\x95\x7d\x8e\x76\xfa\x3f\x73\xd5\x46\xc8\x8b\x6b\x74\x50\x58\x4c\x31\x23\x44\x71\x7c\x45\xf7\x98\x6b\x91\xcb\xbe\x30\xf0\x72\x16\xc1\xeb\x16\x98\x61\x2e\x2f\x67\x01\x42\xdf\xae\x65\xba\x6e\xa1\xec\x16\x7a\x6d\x30\xde\xd1\xf7\xf5\x0b\x06\x27\xe1\xc5\x83\xc1\x45\xcf\x25\xd4\x0b\x58\xfc\x2b\xc2\x8a\x5e\x51\xe3\x57\x86\x14\xf7\x01\x14\x7d\xe1\xc4\xb7\x00\x13\xf7\x0a\xa2\xfd\x81\xc4\x3f\x13\x46\xdc\x03\x44\xdc\x13\x42\xf4\x07\x10\x7d\xe1\x43\x2f\xf0\xd0\x13\x3a\xf4\x06\x0e\xfd\xc4\x38\x05\x1a\x1e\x0a\x19\x7a\xe9\xbc\x4f\x08\xb9\x11\x52\x89\x85\x54\xd2\x6f\xfe\x6e\x74\xc7\x8a\xda\x76\xcc\x3b\xdd\x80\x13\xa6\x4d\x0a\xa1\x72\x5f\xed\xd1\xe1\x2b\xd1\x79\x43\x3e\xa2\x4a\xf8\x4a\xd7\xca\x1d\xcc\x96\x90\x97\xca\xcb\x42\x21\xb8\x72\xa1\xd1\x07\xd3\xcd\x85\x4f\xd7\x98\x35\x39\xe2\xdd\x31\x47\xd5\x6a\xb6\xce\xf3\xa2\xb0\xe8\x4b\xab\x89\xa3\x42\xa6\xd7\x98\x9d\x0a\xb1\x9d\x4a\x4c\x95\x29\xb3\x99\x96\xbe\xa7\x62\xde\x46\xfa\x3a\xbf\x16\xd0\x0c\xa9\x87\x9c\x74\x9d\x34\x59\x18\xe3\x9d\xb7\xa2\x28\x28\x0e\xb8\x8d\xa3\xf5\x06\xb7\x6b\xb4\xd8\x1a\x44\x86\xc8\xf2\xd8\xa8\x8b\x5a\x2c\x14\x72\x06\x88\xfe\xb5\xe8\xdd\xa5\xd0\x62\x85\xf6\x1e\xd6\x79\x7c\x90\x11\xf1\xad\x39\x34\x92\x74\xb6\xac\x72\x15\x34\xd3\x64\x06\x15\x35\x54\xe4\x44\x84\xda\x95\x16\x89\xc2\x72\x8a\xaf\xb0\xf2\x46\xa4\x9b\x04\xde\x04\x95\x8f\x25\x89\x4f\x4a\x5a\x1b\x73\x0d\x6e\x8d\x4a\x41\x60\x86\x67\xd6\x62\x81\xba\x4a\x0a\x66\xe6\x56\x2b\x23\x02\x66\xab\x47\x64\x48\xb3\xfb\x66\x42\x76\x0d\xaa\x55\x04\x0f\x15\x56\x50\xc7\x31\x6d\xb7\x6f\x0c\x16\xb3\x30\x46\xa1\xd0\x1d\x94\x61\xac\xb7\xa6\xd4\x1d\x86\xb3\xa7\xe1\x79\xd3\x2b\xa2\x35\x5d\xe6\x0b\xe4\x5c\xa2\xab\x44\x89\x21\x76\x69\x6c\x48\x82\xa7\x26\x2f\x58\x88\x40\xd1\x9d\x56\x31\x36\x17\x7e\x0a\x52\xfb\x57\x2f\x7b\x48\x2a\xb5\xc7\x15\xda\x93\x92\x5e\x59\x5c\xca\xbb\x7b\x8b\x1a\xba\x45\x59\x8b\xf0\x29\x2e\xf4\x30\x32\xbb\xf6\x04\x3e\xb3\xb3\x70\xe0\xbc\xb1\x24\x3f\xe6\x85\xb1\xc2\x4a\xb5\x09\x87\x28\x61\x1e\xb3\x60\x88\x6d\xe0\x6a\x71\x25\x1d\xc3\x37\x41\x38\x45\x9b\x0c\x41\xac\x04\xf9\x1d\xa6\xbb\x35\xf6\x9a\x8d\x27\x1e\xe7\x7d\x13\xb7\x2b\x45\x3e\xab\x3c\xdf\x95\x35\x4b\xa9\xfa\x3a\xde\xd9\x9b\xcb\x9d\x8e\x01\x7f\xe9\x0a\xd8\x08\x4d\x24\xb5\x5b\x25\xff\xc0\x44\xb4\x6f\x72\x4e\xae\xf4\x6e\xd2\xf6\x31\xce\x51\xe6\x62\x85\x7f\x35\xe6\xba\x2c\x3e\xda\x55\x5f\x09\xb6\x3a\xc5\xb9\x27\x37\xf1\xd1\xae\x84\x96\x5f\x43\x42\x73\x76\xd1\x8e\x15\xfc\x26\x50\xdc\x0b\xe4\x32\xee\x67\xb4\xf1\xe4\x75\x1e\xe5\xe1\xa3\x22\x3e\x13\x69\x3f\x11\x9e\xcd\x5a\x7d\xa2\x04\xf4\x2a\x9a\x81\x5a\xf5\xf5\x46\x2a\x81\x77\x77\x82\xd6\xe8\x14\xf2\xef\x92\x3b\x25\xec\x0a\x8f\x63\xf1\x1e\x2c\x6b\xf4\x64\xb6\x33\xed\xd1\x2e\x45\xda\xe5\xfb\xb7\xd1\xef\x6e\xbf\x60\x3b\x4a\x3a\xde\xd3\xbc\xfb\x30\x73\x95\xa1\x98\x54\xd2\x0e\x90\xf7\xb6\xdb\x29\xfe\x37\x90\x8b\x3b\x99\x97\x39\xf5\x78\xb9\x9f\xbe\x7e\x78\x32\xbe\xd7\xda\xca\xc5\xdd\x8c\x07\x82\xe3\xee\xeb\x34\x32\x8b\x19\x80\xd9\x45\x4f\xcd\x5d\xd5\x1d\xe2\x74\x97\x5a\xfe\xa3\xc4\xb8\xd1\x91\x14\x5b\x5c\xa3\x86\xb8\x87\xe3\x58\xd6\x24\x1c\x1e\x33\xe9\x45\xb9\x50\x32\x9d\x5d\xf5\xb5\xd1\xab\x8a\xbe\x66\x8a\xd1\x85\x5f\xa3\xdd\x9a\xd1\x78\xac\xb3\x42\x0f\xa2\x7a\x09\xcc\xae\x12\xb8\xaa\x73\x14\x0d\x5a\x73\xe8\xf9\x04\x57\xb2\x37\x5d\x1a\xa5\xcc\xad\x9b\xc2\x79\xe5\x9f\x97\x12\x55\x46\xcb\x93\x90\xc0\xcb\x04\xaa\x7c\xca\x64\xa9\xc4\x8d\xb1\x75\xef\x57\x09\xcc\x19\xe0\xc5\xe4\xcc\xa9\xc5\xd0\x1d\x6f\xad\x31\xfe\x02\x6f\x64\x8a\x73\xf9\xb5\xef\x12\xfe\xb4\xd5\xa9\x3e\xd8\xa3\x9f\xab\xed\x21\x0d\x0b\x37\x46\x95\x39\x29\x0b\x56\x72\x25\x16\x1b\x8f\xee\xf9\x8f\xdf\xbf\x38\x3e\x91\xad\x00\xfb\x1f\xdf\x9d\x10\xab\x3b\xb8\x3a\xb7\x7e\x8f\x9b\x0f\x9d\xbb\xdc\xed\x90\x3a\xff\x4b\xd5\xa1\x06\x0f\xad\xdd\xae\x73\x6b\xb8\xc6\x0d\xaf\x6f\xef\x45\x48\xf4\x6c\x2d\xed\xc7\x18\x67\x00\xec\x7d\x19\x0d\x93\xbf\x7f\xb4\xc6\x7c\x86\x2f\x3b\xf7\x09\xec\xf6\xe3\x52\x1b\x6d\x1d\x12\x56\xdd\x19\x7e\x2e\xf0\x49\xc0\xf3\x90\xb2\x1a\x52\x56\xc7\x9f\x21\x65\x75\x9c\xf5\x21\x65\x75\xf4\x19\x52\x56\x0f\x94\xa5\x93\xe0\xb8\xf6\xc7\x1c\x30\xf6\x9a\x8f\x8e\x76\x78\xa4\xf1\xe1\x22\xa3\x83\xa3\xec\x35\x3a\xb4\x37\x98\x4d\x39\x3f\x12\x1a\xbc\xb1\x62\x85\x53\x58\x0a\xe5\x70\xbf\xe0\xf2\x55\xa0\x1a\x0a\x2e\x87\x82\xcb\xe3\x5a\x1e\x0a\x2e\x87\x82\xcb\xa1\xe0\x72\x28\xb8\x1c\x0a\x2e\x87\x82\xcb\x03\xcf\x50\x70\x39\x14\x5c\x9e\x7e\x86\x82\xcb\xa1\xe0\xf2\x18\xd9\x50\x70\x39\x14\x5c\x0e\x05\x97\xd5\xfc\x0c\x05\x97\xcd\x33\x64\xaf\x87\xec\xf5\x49\x96\x87\xec\xf5\x90\xbd\xee\xc3\xdd\xbf\x48\xf6\xfa\xf7\x52\x50\x28\xb5\x0b\x75\x80\xd7\xb2\x78\x70\x41\xe1\xec\xe8\x20\xc7\x0a\x0a\xb5\xf1\x47\x8b\x0a\x8d\x0d\xcd\x95\xe0\xb1\xf9\x4a\x58\x91\x23\xad\xce\xb9\xe7\xcd\x7d\x5d\x7b\x58\x95\x1c\x92\x05\xc4\xa2\xc1\x04\xbe\xaf\x01\xcc\x08\xc4\x50\x8a\x38\x94\x22\xfe\xb6\x4b\x11\x01\x5c\xbb\x5e\xf7\x7b\x91\x5e\xe3\xee\x79\xc2\xbe\x72\xd8\xfe\xa7\x71\x9a\xc7\x79\xb0\xd8\x7b\x69\x74\xef\x9d\xdb\x4b\xba\x88\xab\x72\xcc\x5a\xac\xca\x85\xbd\x54\xf2\x2b\xd6\x58\xac\x7b\x29\x8b\x15\x2f\xe6\x4c\xba\x70\x3e\x10\x67\xc9\xb5\x17\x31\x79\x85\xba\x88\x2d\xc0\xc7\xc6\x7e\xa3\x60\xa3\xee\x6a\x65\x9a\x1f\x14\x1d\x2e\x13\xb8\xc2\xba\xcc\xbb\xd5\x3a\xbe\x97\x3e\xc7\xe0\x5c\x3e\xde\x51\xd3\x37\x09\x50\x4b\x21\x55\x69\xf1\xc2\xe4\x42\x76\x6c\x1d\xb6\x81\x69\xbb\x4f\x5c\x43\xd5\x40\x90\x85\xd6\xfd\x82\x39\x3e\x5a\xaa\xf7\x7b\x4d\xb2\x9b\xcb\x93\xd8\xb3\x8c\x68\xa5\x44\x94\x2e\x75\x2c\x28\x23\x94\x9e\xc0\x0f\x4d\x6d\x50\xfb\xf7\xf2\xd4\xbb\xcd\xb0\x25\x0c\x15\x7b\x84\x6b\x6e\x84\xa2\xfd\x62\xd8\xd0\x32\xd6\x6f\xdf\x24\xf8\xbb\xd1\x4f\x7f\x09\x01\xb3\x27\xba\x86\xf0\xbb\xab\x17\xfe\x5e\x38\xfc\x38\xbf\x7f\xc9\x70\xe8\x77\xa8\xc0\x6d\x21\x1c\x02\x61\x17\xe1\x1b\x00\x74\xb4\x86\x38\xee\xc3\x9f\xaa\x88\xb8\xe1\xf0\x87\x10\xd7\xfa\x49\xf6\x6c\xb6\xdb\xb1\x2e\x88\xbe\x9c\x91\x78\x24\x4a\x88\x94\x24\x0a\x31\x0f\x15\xf7\x41\x9c\xba\x40\x2e\x06\x99\x59\x53\xfc\x26\x57\x9a\x23\x94\xe4\x69\xc6\xbb\x42\xc9\x54\xfa\x28\x36\x89\x0c\xf3\xb2\x28\x8c\xf5\x8e\x8c\xdd\x79\xe9\xcb\x00\x2d\x69\xd4\x9f\x7f\x4e\x82\xb2\x7f\xf9\x85\xc3\xda\xcf\x3f\x27\xef\x5f\xbb\xea\x44\xff\x97\x5f\x1a\xb7\xca\x8a\xff\x38\x67\xa2\xeb\x72\x81\x56\xa3\x47\x17\xcf\xf9\x47\x04\xaf\x0b\x4c\x69\x1b\xa4\x36\x7c\x62\x05\xd5\x24\x46\x3e\x1b\xdf\x2c\xf5\x81\xb9\x36\x16\xca\x45\xa9\x7d\x09\xcf\xc3\xb1\x1f\x7b\xf6\x17\xa3\x3a\x6b\xb4\xff\xd2\xb6\xdb\xa8\x36\xf7\x85\x48\xaf\xc5\x8a\x53\x41\x26\x2b\xd3\xf0\x45\xd3\x73\x62\x51\x21\x09\x42\x62\x99\xd2\xc3\x4d\x08\xd2\x01\x08\x4c\xe1\x3c\x39\x7f\x95\xfc\x69\x44\xff\xbf\x4c\xfe\x7d\x9c\x6f\xf8\x97\x91\x25\xe7\x7c\xf4\x74\x9e\x9c\xff\x67\xf2\x2a\x78\x24\x0c\xa5\xde\x23\x68\xf1\xda\xcc\x15\xcd\xa3\x59\x42\x2a\x0a\x31\x16\xb9\x1c\xb7\x94\x3c\xfe\xf3\x8e\x86\xc7\x7f\x0c\x1a\x42\x9d\xd1\x9c\x3b\xe4\x33\xef\x60\x0f\x34\x89\xd5\x29\x21\xbb\xa6\x4a\x44\xef\xd1\xea\x66\xf4\xa0\xb6\xf1\x9f\xcf\x93\xf3\xd7\xc9\x9f\xc6\x7f\xe4\x9e\xa2\x76\xb9\xdc\x9d\xcc\x4d\xd8\x15\xf2\xa2\x69\x6b\x32\x74\xaa\x95\x5c\x4d\x41\x35\xd9\x09\xcc\x11\x41\x28\xd7\x3e\xbe\x37\x4a\xe8\x55\x62\xec\x6a\x52\x5c\xaf\x26\x1e\xef\xfc\x24\x1e\x11\x4f\x1e\x55\xef\x3e\xdc\x33\x18\xee\x19\xfc\x26\xef\x19\x68\xa3\x3f\x19\xe3\xbf\x70\x15\x79\x5f\xed\xbd\x35\x7a\x29\x57\xa5\xad\x8a\x1d\x8a\xc6\x25\x73\xc8\x33\x3a\x94\xa6\x57\xd5\x63\x55\x89\xba\x7b\xaa\x43\xf9\xc0\x2b\xa0\x4e\x45\xe1\xca\x50\x11\x16\xb6\x76\x5d\x5c\x45\x66\x32\x2e\xa9\x7f\x82\x53\xf1\x30\x50\x77\xf5\xfb\x01\xee\x43\x49\x7f\x9f\xec\x54\xef\x1c\x1a\xea\xd4\x6e\x0a\x7f\x3a\x29\xb6\xc5\xc8\xbb\xd8\x8b\x4c\xbf\x7d\xef\xa2\xba\x51\xd0\xc0\xdd\x7a\x7c\x8a\x24\xda\x9c\xd8\xf5\xf7\xdf\xf7\xd7\x9c\x4b\xa3\xdf\xe3\xe6\x21\xdc\x87\x9e\xd1\xf1\xbc\xbf\x9c\xc7\x1b\x04\xe4\x2e\x39\x91\xc2\x64\x2d\xb9\xb8\xf0\x8b\xa5\x92\x2c\xb1\xa8\x7b\xcd\x2e\x38\x43\xf3\xe9\x03\x83\xec\x2d\xf5\xd0\x9e\x30\x84\x99\xb0\xb1\x36\xb9\xf4\x3e\x16\xf6\xc7\x10\x4a\x8e\x9b\xc6\xd9\xaa\xec\x67\x34\x41\xad\x79\xe9\x3c\x08\x65\x51\x64\x1b\xc0\x3b\x72\x34\x34\x22\xed\x26\xd2\x14\x9d\x93\x0b\x85\xf5\x9d\x9c\xfa\x37\x8b\xf6\x53\x75\x0f\x1b\x91\xa6\xab\x3e\xe8\x80\x82\x67\x1f\xaf\xe6\xfb\x79\x15\x6e\xad\xca\xe8\x5a\x9b\x8d\x4c\xba\xeb\x04\x3e\x18\x1f\xce\x33\x52\xb1\xa8\x00\xba\x52\xcc\x62\xc7\xda\x0f\x4f\xbf\x4b\x29\x6d\x99\x4f\x27\x5b\x80\x2f\xcb\xdc\x4b\x68\xbe\x69\xd3\xdc\x46\xe2\xcb\x36\xcf\xa5\x86\x1f\xe5\x8b\xfa\x96\xca\x96\x1f\x49\xe0\x92\xe6\x75\x81\xb0\xe2\x00\x67\x43\x69\x4e\x83\x7e\x9d\x16\x85\x5b\x93\x33\xe4\x8b\x3b\x16\x5e\xc3\x73\x3e\x54\xc3\x9b\x70\x4e\x52\xf5\xeb\xb8\xb0\x73\x7f\x0d\xe5\x52\x53\x5c\x9a\xc2\xeb\x27\x53\xa5\xef\xc4\x04\xe1\xd9\x52\xe5\x21\x40\xd0\xf2\x2e\xcf\x31\x59\x25\xb0\x2a\x5e\x8e\x40\x9a\xf3\x11\xa0\x4f\x93\x24\x39\xa9\x85\x9e\xf6\xde\xe7\x94\x60\xcc\x53\xf2\xeb\x9f\xac\x0f\x37\xf9\x7e\x67\x37\xf9\x02\xfe\xb8\xc7\x2d\xbe\x87\x01\x96\xd6\xed\xbe\x47\x1e\x85\xf4\x45\x2a\x0f\xc2\x29\x3d\x57\x64\x4f\x8c\xf2\x4d\x10\x4a\x5f\x7c\x72\x0f\x74\x32\x60\x93\xc3\x4a\x3e\x69\x17\xa7\x71\xc9\x3f\x0d\x95\xf4\x8f\xb8\xbd\x8f\x7f\x4e\xe2\x91\xdf\x06\x1a\xe9\xaf\x99\x9e\x48\xa4\xaf\x02\x4f\xa3\x90\x5f\x1d\x83\xf4\xb2\xeb\xd3\xf8\xe3\x04\xfa\xe8\x81\x3d\x5c\x61\xfc\xa5\xb0\xd7\xe8\x3f\x86\xc8\xd1\xf7\x6e\xf3\x6e\x3f\x5a\x0f\xe6\x96\xcf\x4a\x2d\x67\x3d\x62\x5c\x6a\x02\x37\x37\x2f\x10\x6c\xa9\xa1\x74\x14\x57\xf9\x18\xaa\x30\xbe\x21\x79\xec\x81\x7d\x2e\xee\xae\xac\x4c\xef\x33\xbf\x97\x55\x97\xad\x8b\x2b\x31\x29\x53\xf0\x37\xf1\x94\x9b\x43\x88\x54\xaa\xaa\xb0\x2a\xc4\x86\xdd\x04\x8b\xf0\xa5\x39\x5d\xe8\x2e\x05\x79\xaa\x03\xac\x6f\x7e\x71\x1e\xbe\x08\x25\xb3\x76\xf5\x0f\xe6\x85\xdf\xc4\x0b\x59\xcf\x33\x53\x17\x2e\xcc\xe7\x7f\xa1\xc1\xdc\x8b\x51\xbc\x4d\x10\x9b\xf8\x9d\x9c\x4d\xae\x42\x0c\x3c\xe7\x48\xd6\x0a\x33\x6d\xca\x17\x8f\x01\xa0\xc3\x6d\xfd\xa1\xde\x71\xa8\x77\xec\xa6\x1c\xea\x1d\x87\x7a\xc7\xfa\x19\xea\x1d\x1f\x2c\x8b\x47\x2d\x74\xda\xb1\xc1\xdb\xc6\x94\x81\x1a\xa4\xce\x64\xca\x5b\x79\xb9\xdc\x4b\x70\x10\x4e\x32\x1a\xdc\x5a\xd8\xb0\x27\x25\x57\xa4\x70\xcc\xaf\xf2\xb0\x16\x36\xbb\x15\xb6\xc3\x08\xbb\x8b\x75\xc6\x31\xe0\x76\x52\x04\xfe\xb2\x0e\x9a\xb5\x71\xa7\x32\x5b\x9d\xea\x2d\x75\x6a\xf2\xc2\xa2\x73\x98\x91\xdf\xbd\xd8\xbb\x9e\xdc\x7e\xb6\xd4\xf8\xd3\x81\xae\xf5\x8d\x8a\xf6\x5e\x9f\xc1\x1b\xd7\x28\xd2\xf6\xe4\xab\x2c\xc6\x4d\x47\x58\xe0\x92\x2f\x69\xfb\xb0\x11\x0e\xb5\x33\x98\xbe\x6c\x85\xf7\x56\x81\xe4\x5a\x38\x58\x94\x52\xf9\xb1\xd4\xe0\x42\x5d\x01\x23\x82\xdd\x61\x9b\x77\x36\x3f\x55\xb5\x74\x52\x83\xb8\x75\xb1\xdc\x2e\x8f\x05\x58\x04\xa2\x6f\xc5\x66\x8f\xc3\x53\x59\xb8\xae\x24\xc4\xef\xf1\xf7\x48\x70\x8b\xf3\xc2\x97\xec\x58\x45\x9a\x62\xe1\x31\xfb\xd0\xfc\x55\xb2\xf0\x37\xc7\xfe\xf0\x07\xfe\x10\xff\x7c\x18\x7f\x4c\x8d\x0e\xb5\xa8\x6e\x0a\xff\xfd\x3f\x67\x50\x4d\xc9\x97\xf8\xf7\xc0\xa8\xf1\xff\x03\x00\x00\xff\xff\x62\xa5\xf4\xc2\x26\x6e\x00\x00")
+var _clusterApiInfrastructureClusterXK8sIo_awsmachinetemplatesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5f\x73\x1b\x37\x92\x7f\xe7\xa7\xe8\xda\x7b\x90\xbd\x25\x0e\x63\x39\x77\xe7\xe3\xcb\x96\x22\x39\x59\x96\x57\xb6\xca\x74\x9c\xaa\xdb\xda\xba\x6a\xce\x34\x49\x9c\x66\x80\x59\x00\x23\x89\x4e\xe5\xbb\x5f\x35\xfe\xcc\x0c\x29\x8a\x1c\x8a\x4a\x72\xce\x8e\xa5\x4a\xc4\x21\x1a\xe8\x6e\x34\xba\x7f\x68\x34\x48\x2c\xc5\x67\xd2\x46\x28\x39\x06\x2c\x05\xdd\x5b\x92\xfc\xca\x24\x37\x6f\x4c\x22\xd4\xe8\xf6\xd5\xe0\x46\xc8\x6c\x0c\x17\x95\xb1\xaa\xf8\x48\x46\x55\x3a\xa5\x4b\x9a\x0b\x29\xac\x50\x72\x50\x90\xc5\x0c\x2d\x8e\x07\x00\x39\xce\x28\x37\xfc\x17\x40\x9a\x57\xc6\x92\x4e\xee\x87\x75\x57\x98\x97\x4b\xfc\x76\x0c\xe1\xaf\xd7\x03\x00\x94\x52\x59\xe4\x8e\x22\x99\x92\x56\xab\x3c\x27\x3d\x5c\x90\x4c\x6e\xaa\x19\xcd\x2a\x91\x67\xa4\x1d\x3b\x91\xd9\xdb\x6f\x92\xb3\xe4\xbf\x06\x00\xa9\x26\x47\xfe\x49\x14\x64\x2c\x16\xe5\x18\x64\x95\xe7\x03\x00\x89\x05\x8d\x01\xef\x4c\x81\xe9\x52\x48\xb2\x54\x94\x39\x5a\x32\x89\x90\x73\x8d\xc6\xea\x2a\xb5\x95\xa6\x64\x93\xd3\x81\x29\x29\x65\x6e\x16\x5a\x55\xe5\x18\xf6\x35\xf7\x43\x45\xfe\xd1\xd2\x42\x69\x11\x5f\x0f\xa3\x22\x86\x58\x0a\xd7\xc2\xeb\xf3\xfc\xa7\xe9\x95\xe7\xeb\x53\xe0\xcb\xbd\x99\x0b\x63\xdf\x3d\xd2\xe0\x6f\xc2\x58\xd7\xa8\xcc\x2b\x8d\xf9\x56\xd9\xdc\xfb\x46\xc8\x45\x95\xa3\xde\xd6\x62\x00\x60\x52\x55\xd2\x18\xde\x63\x41\xa6\xc4\x94\xb2\x01\x40\x50\xac\x63\x7a\x18\x54\x17\xa6\xe9\xcc\xf7\x99\x2e\xa9\x70\x93\xcc\xaf\x54\x49\xf2\xfc\x7a\xf2\xf9\xf5\x74\xed\x31\x40\x46\x26\xd5\xa2\xe4\x19\xd9\x26\x02\x08\x03\x76\x49\xe0\xc9\x60\xae\xb4\x7b\xb9\x45\x10\x38\xbf\x9e\xd4\xbd\x96\x5a\x95\xa4\x6d\xad\x54\xff\xdb\x32\xde\xd6\xd3\x0d\x1e\x4e\x98\x4d\xdf\x0a\x32\xb6\x5a\xf2\x1c\x04\x81\x29\x0b\x92\x81\x9a\x83\x5d\x0a\x03\x9a\x4a\x4d\x86\xa4\xb7\x4a\x7e\x8c\x12\xd4\xec\x7f\x29\xb5\x09\x4c\x49\x33\x21\x98\xa5\xaa\xf2\x8c\x8d\xf5\x96\xb4\x05\x4d\xa9\x5a\x48\xf1\xa5\xee\xcd\x80\x55\x6e\x18\x27\x8b\x05\x21\x2d\x69\x89\x39\xdc\x62\x5e\xd1\x29\xa0\xcc\xa0\xc0\x15\x68\xe2\x7e\xa1\x92\xad\x1e\x5c\x13\x93\xc0\x95\xd2\xc4\xb6\xa7\xc6\xb0\xb4\xb6\x34\xe3\xd1\x68\x21\x6c\x5c\x98\xa9\x2a\x8a\x4a\x0a\xbb\x1a\xb9\x15\x23\x66\x95\x55\xda\x8c\x32\xba\xa5\x7c\x64\xc4\x62\x88\x3a\x5d\x0a\x4b\xce\xc4\x47\x58\x8a\xa1\x63\x56\xb2\x50\x26\x29\xb2\x7f\xd3\x61\x29\x9b\x93\x35\xe5\xd9\x15\xdb\x86\xb1\x5a\xc8\x45\xeb\x0d\x67\xb4\x3b\xb4\xcc\x36\xcb\x93\x8b\x81\xd4\x0b\xda\x28\x93\x1f\xb1\x3e\x3e\xbe\x9d\x7e\x82\x38\xb4\x57\xb8\xd7\x6d\xd3\xd4\x34\x6a\x66\x15\x09\x39\x27\xb6\x12\x61\x60\xae\x55\xe1\xb4\x4a\x32\x2b\x95\x90\xd6\xbd\x48\x73\x41\xd2\x82\xa9\x66\x85\xb0\x3c\x7f\xff\xac\xc8\x58\x9e\x81\x04\x2e\x9c\x7f\x81\x19\x41\x55\x66\x68\x29\x4b\x60\x22\xe1\x02\x0b\xca\x2f\xd0\xd0\xaf\xae\x64\xd6\xa6\x19\xb2\xf2\xba\xa9\xb9\xed\x4c\x9b\x7f\xdc\xcb\x38\xe8\xa9\xf5\x46\x74\x53\x8f\xcc\xc9\xc3\xd5\x37\x2d\x29\x5d\x5b\x04\x19\x19\xa1\xd9\x68\x2d\x5a\x62\x53\x7f\x48\xb3\xd6\xff\xf6\x75\xc8\x3f\x71\xdd\x6e\x3e\xdf\xcb\x53\x8c\x28\xa1\xdd\x2c\x72\x86\x16\x41\x12\x65\x94\xf1\x52\x72\x5e\x9e\x00\x8b\x56\x0f\xde\x1c\xb0\x1e\xfa\xc1\xc8\x8f\x73\xbb\x5d\x7b\x5b\x39\x76\x3a\x0b\x5e\x8b\x49\xc4\x5c\xa4\xb5\x67\x68\xeb\x70\x46\x4b\xbc\x15\x4a\xc7\xe7\xc1\xa1\x25\x5b\x87\xd8\xcd\x1a\xff\x60\x96\xb9\xf8\x8a\xf9\x94\xd2\x4a\x0b\xbb\xfa\x81\xa3\xd1\xa3\xed\x37\x15\xfd\x08\xb9\x5b\xa3\x12\x50\x6b\x5c\x31\xa7\x9a\xe6\xa4\x49\xa6\xac\x76\x05\x26\xb4\xf5\x91\x8f\x85\x46\x1b\x7d\xdd\x8c\x00\xcb\x32\x17\x7e\x42\x58\x42\x21\x8d\x45\x99\x52\x02\x9f\x96\x64\xe8\x01\xf5\x5d\xa4\x33\xc4\x1e\xb0\x16\x89\xe9\x51\xae\x1e\xb4\xf7\x96\x99\x01\xc6\x75\xed\xc2\x32\xe4\xbc\xda\x40\x69\xee\x82\x9f\x63\x6a\x2b\xb4\x4a\x6f\xd7\x2c\xff\x08\x4b\xc5\x0e\x45\x6d\xaa\xea\xa7\x69\x34\xc2\x8f\x51\x1b\x4e\x4d\x8d\x72\x1c\xc7\xf5\xf4\x33\x45\xe3\xc3\x66\x2b\x98\x5c\x9e\xc2\xf9\xc7\xf7\xa7\xcc\xe4\x5c\xe4\x96\xb4\x49\xe0\x83\xcc\x57\xa0\xa4\x5b\x56\xa1\x01\xbf\xff\xbd\x7f\xdf\x79\xff\x59\x6d\x52\xec\x98\xd8\xd2\xc4\x7c\xc5\x9e\xb2\x60\xd7\x6f\x97\x1c\x78\x24\xc1\x9d\xc8\x73\x1e\xaf\xca\xbd\x1a\xd9\xb7\x8a\xcc\x1b\x21\x69\xbd\x4b\x13\x5d\x2c\x2d\xd8\x9b\xde\x88\xa2\xfb\xb4\xf6\xf1\x3d\x4b\x16\xd5\xb0\x87\xf4\x11\x77\xf7\xf0\x27\xa8\xef\x20\x56\x4e\xa2\x4e\xdd\xa4\xb1\xb1\xa9\x39\xdc\xd0\x6a\xe4\x63\x50\x89\x42\x1b\xa8\x8c\x37\x5c\x91\xb1\x7b\x9e\xaf\x00\x9b\x29\xfc\xb4\xa4\x15\xa0\x6e\x0c\x1c\xd3\x54\xe9\x8c\x67\x22\x98\xba\xae\x72\x6a\x0c\x74\xb6\x72\x0f\xd9\x0c\xce\xaf\x27\x4d\xe4\xc8\x54\x6a\x12\xbc\x33\x09\x16\xf8\x45\xc9\x24\x55\xc5\xe8\xfc\xa7\xe9\xdb\x8b\xb3\x11\xfb\x46\x63\x47\x3f\x1a\xd2\x3f\x54\x22\xa3\xd1\x8f\x0c\xd2\xfe\xc7\x73\x2e\xe4\x22\x59\xda\x22\x3f\x19\x3c\x2a\x70\x37\xbb\xde\xa2\x1c\x3f\x82\xb7\x67\xaf\xdc\x2d\xaa\x90\x6b\x26\xbd\x77\x80\xae\x36\xe5\x7f\x1c\x98\xec\xd0\x6e\x83\x71\x86\xa7\xd1\x97\x7a\xc6\x93\xb0\x78\x1c\x3c\x35\x6e\xc2\x52\x34\x34\x34\xbc\x67\xb1\xe2\x96\x92\xc1\xde\x21\x0e\xb1\x45\xff\xe3\x8c\xc8\x3c\x41\x80\xcf\x8e\x10\x84\x4c\xf3\x2a\x23\xe3\x96\xb2\xd2\x7e\x6d\x87\x99\x88\x50\x2f\xc8\xe5\x5f\x3e\x59\xb0\x8e\xe6\xf1\x24\x2d\x44\x02\x17\x38\xf6\xb6\x67\x0c\xc6\xc0\x62\x3f\x33\x7e\xaf\xd1\xa1\x99\xd7\xcd\xde\x86\x8f\x40\xa5\x63\x04\x12\x7b\xc5\x58\x9b\xf6\xc9\xe5\xf3\xfb\xc5\x4e\x52\xed\x97\xa7\xc1\x14\x9f\x70\xb1\xc3\x52\x9a\x76\xd7\x9d\x56\x7a\x27\x29\x1e\x81\x27\xcc\x49\x00\x25\xca\xbd\x89\x79\x74\xe1\x96\xdf\xe2\xc8\x9b\x39\x77\x85\xb2\x46\x1c\xa7\x9b\x70\x82\x9d\x84\x92\xbc\x76\x32\x46\x8c\xb3\x15\xfb\x6a\xe4\x80\xd9\xf2\xd5\xa5\x56\xb7\x82\x13\x09\x30\x99\xc3\x4c\xd9\x65\x7c\xe7\x22\x20\x0d\xde\x95\x85\x47\x11\x63\xfa\xf8\xec\xfb\x30\xec\x91\x2c\x2e\x9c\xc9\xc2\x9d\xb0\x4b\xc8\xc4\xdc\x21\x06\x1b\xdc\xc4\xe9\x06\xfd\x89\x09\x1b\x21\x8b\x37\x64\xa0\xd4\x94\x52\xc6\x88\x22\x19\x1c\x31\xd7\x58\x88\x71\x47\x3d\x5f\x4d\xe2\x96\x7b\x0d\xcc\xf0\x83\xf3\xab\x89\x87\xd0\x77\x4b\x91\x2e\x5b\x28\xbb\x85\x5e\x1b\x8c\x37\x38\x2e\x18\xec\x85\x17\x4f\x06\x17\x1d\x97\x50\x27\x60\xf1\xaf\x08\x2b\x3a\x45\x8d\x35\xc5\x3c\x3f\xa4\x38\x04\x50\x74\x85\x13\xbf\x05\x98\xe8\x68\x7b\x87\x02\x89\xdf\x13\x46\x1c\x00\x22\x0e\x92\xbe\x4b\x7c\x3a\x14\x3e\x74\x02\x0f\x1d\xa1\x43\xa7\x10\xdb\x5d\x8c\x7d\xa0\xe1\xa9\x90\xa1\x93\xce\xbb\x84\x90\x5b\x14\x39\xce\x44\x2e\xec\xea\xbf\x95\xdc\xb1\xa2\xd6\x38\x3d\xdf\x20\x63\x27\x50\x07\x16\x53\xbb\xaf\x76\xef\xf0\x85\xdb\x59\xc5\x3e\x22\x24\x7c\x85\x69\xe2\x0a\xc7\xe2\xa2\xca\xad\x28\x73\xe2\x1c\x9e\x24\xeb\x4d\xb7\x40\x9b\x2e\x29\x6b\x72\xc4\x9b\x7d\x9e\x86\xd5\xac\x8d\x75\x8b\x42\x93\xad\xb4\x64\x8e\x4a\x91\xde\x50\x96\x0c\x8e\x50\x62\x9a\xab\x2a\x9b\x48\x61\x3b\x2a\xe6\x22\xb6\x0f\xde\xdc\x04\x34\xc3\xea\xe1\xbd\x5f\x16\x5d\xfe\x4c\x29\x6b\xac\xc6\xb2\xe4\xed\xa5\x59\x19\x5e\x6f\x70\xb7\x24\x4d\xad\x4e\x84\x8f\x2c\xc9\xe0\x38\x8f\x49\x12\x67\x39\xb9\x04\x12\xff\x57\x93\x35\x57\x28\x71\x41\x7a\x17\xd5\x86\x68\x8f\x77\x72\xca\x7c\x4b\x17\x1a\x59\x3a\x5d\x85\x5c\x05\xcf\x34\x9b\x41\x68\x0d\xa1\x39\xab\x80\xa4\xa9\x34\xb1\x70\x9a\xf3\x9c\x50\x6a\x71\x8b\xe9\x2a\x81\x73\xaf\xf2\x21\x1f\x2b\x39\x25\x2d\x95\xba\x01\xb3\xa4\x3c\x07\xcf\x8c\x9b\x59\x4d\x25\xc9\x90\x14\xcc\xd4\x9d\xcc\x15\x7a\xcc\x56\xf7\xe8\x20\xcd\xe6\xc8\x8c\xec\x1a\x54\x9b\x33\x3c\xcc\x29\x40\x1d\xe3\xda\x3e\xae\xea\xc6\x62\x66\x4a\xe5\x84\x72\x47\x4b\xdf\xd7\x85\xaa\xa4\x3d\x40\xc3\xd3\x86\x2a\xa2\x35\x59\x15\x33\x72\xb9\x44\x13\x44\x89\x21\x76\xae\xb4\x4f\x82\xa7\xaa\x28\x9d\x10\xbe\xc5\xce\xe1\x98\x08\x2d\x1f\x65\xd9\xd7\x67\x3b\x5b\x7a\x49\xf9\xbc\x62\x41\x7a\x47\x4b\x3f\xe8\xb5\xa6\xb9\xb8\x3f\x58\x54\x4f\x16\x65\x2d\xfd\xab\xb8\xd0\x7d\xcf\xce\xb5\x73\x66\x91\x9d\x85\x01\x63\x15\x27\x59\x39\xd7\xab\x34\x6a\x91\xaf\xfc\x21\x8a\x9f\xc7\xcc\x1b\x62\x1b\xb8\x6a\x5a\x08\x06\xf5\x06\x90\x71\x8a\x54\x19\x01\x2e\x90\xfd\x8e\xd3\xde\x9d\xd2\x37\xce\x78\x42\x9a\x31\xf9\x4d\xdc\xae\xc0\x62\x12\x3c\xdf\xb5\x56\x73\x91\x77\x75\xbc\x93\xf3\xab\x0d\x42\xd6\x0a\x3a\x2d\x85\x33\xaa\xc9\xf9\x55\xed\x56\x19\x95\x73\xef\x6c\x30\x68\x8c\x58\xd4\xbb\xa4\xd8\x62\x70\x84\xa8\xa2\xc0\x05\xfd\x4d\xa9\x9b\xaa\xfc\xa0\x17\x5d\x25\x58\x23\x8a\x73\xcf\x6e\xe2\x83\x5e\xa0\x14\x5f\x7c\x42\x73\x72\xd9\x8e\x15\x6e\x24\xc8\xdd\x50\x20\xe6\x71\x3f\xc3\x67\x3a\x66\xd7\x9a\xed\x22\x44\x50\xc4\x27\x6e\xda\x4d\x84\x93\x38\x05\x4c\x13\x25\xe0\xa1\x78\x06\x62\x7f\xcd\x46\x2a\x81\xb7\xf7\xc8\x6b\x74\x0c\xc5\xb7\xc9\x7d\x8e\x7a\x41\x27\xc7\xb0\x2c\xc9\xb2\xd9\x4e\xf8\x30\x71\x8e\x29\x99\x8e\x7c\xbf\xdf\xa4\x63\xde\xd1\x9d\x35\x33\xe7\x6f\xdf\x4f\x4c\x30\x14\x95\x0a\x3e\x09\x72\x7b\xdb\xf5\x14\xff\x39\x14\x78\x2f\x8a\xaa\x60\x8a\xb3\x87\xe9\xeb\xc1\x93\x01\x65\x07\xc1\x81\x07\x9f\xb8\x8e\xe0\x6c\xf0\x74\x64\x16\x33\x00\x93\xcb\x8e\x9a\xbb\xae\x09\xe2\x74\x57\x52\xfc\xb3\xa2\xb8\xd1\x11\x9c\x35\x30\x8d\x1a\xe2\x1e\xce\xc5\xb2\x7a\xb8\xa3\xec\xb4\xac\x66\xb9\x48\x27\xd7\x1d\x39\x3e\xb9\x0e\xed\x6b\xa6\x0c\x3b\x47\xbb\x24\xbd\x36\xa3\xf1\x58\x67\x41\x16\x30\x0c\x02\x93\xeb\x04\xae\xeb\x1c\x45\xf0\xc8\xc2\x70\x84\xb7\x0c\x57\xd8\x6c\x0c\xcc\x55\x9e\xab\x3b\x33\x86\x57\xc1\x3f\xcf\x05\xe5\x19\x2f\x4f\x46\x02\x67\x09\x84\x7c\xca\x68\x9e\xe3\xad\xd2\x35\xf5\xeb\x04\xa6\x0e\xe0\xc5\xe4\xcc\xc9\xe0\x98\x78\xab\x95\xb2\x97\x74\x2b\x52\x9a\x8a\x2f\x5d\x97\xf0\xc7\x35\xa2\x38\xa9\x46\x7c\xa9\xb7\x87\xdc\x2d\xdc\xaa\xbc\x2a\xd8\xfc\x61\x21\x16\x38\x5b\x59\x32\x2f\x7e\xf8\xee\x65\x32\xe8\x10\x60\xff\xe3\xdb\xc1\x31\xc1\xd5\x98\xe5\x3b\x5a\xbd\xc7\xa2\xab\x48\xd3\xe9\x5f\x03\x41\x14\x27\x06\x05\xfe\xdb\x98\x25\x9f\x85\xb8\xf5\x6d\x2d\xfa\x44\xcf\xda\xd2\x3e\xc6\x38\x3d\x60\xef\xca\xa8\x6b\xbc\xe5\x68\x8d\xd9\xf1\x3d\xed\xde\x27\x38\xb7\x1f\x97\xda\xe9\xda\x21\x61\x20\x77\xf0\x73\x46\xcf\x02\x9e\xfb\x94\x55\x9f\xb2\xea\x53\x56\x7d\xca\xaa\x4f\x59\xfd\x7f\x48\x59\xed\x6c\xf0\xb8\xf6\x87\x0e\x06\x0d\x3a\xf7\xb6\xbd\xa7\xe1\xf6\x22\xa3\xad\xbd\x3c\x78\x68\xb8\x66\x30\x1b\xbb\xfc\x88\x7f\x60\x95\xc6\x05\x8d\x61\x8e\xb9\xa1\x87\x05\x97\xaf\xfb\x82\xcb\xbe\xe0\xb2\x2f\xb8\xec\x0b\x2e\xfb\x82\xcb\xbe\xe0\xb2\x2f\xb8\xec\x0b\x2e\xfb\x82\xcb\xbe\xe0\xb2\x2f\xb8\xec\x0b\x2e\xfb\x82\xcb\xbe\xe0\xb2\x2f\xb8\xec\x0b\x2e\xfb\x82\xcb\xbe\xe0\xb2\x2f\xb8\xec\x0b\x2e\xfb\x82\xcb\xaf\xa7\xe0\xf2\x8f\x51\x50\x28\xa4\xbb\xb4\x49\xd3\x1b\x51\x86\x1a\xbb\x50\x62\x77\x88\xf6\x1f\xed\xe4\xb1\x82\x42\x3e\xf8\x7d\xac\xa8\x50\x69\x46\x14\x30\x0d\x82\xc7\xc7\xd7\xa8\xb1\x20\x5e\xac\x53\x2e\x1d\x6b\xd5\x1e\x86\x92\x43\xb6\x80\x58\x34\x98\xc0\x77\x35\x80\x39\x05\xec\x4b\x11\xfb\x52\xc4\xaf\xbb\x14\x11\x20\x2c\x31\x27\x80\xf9\x0e\xd3\x1b\xda\x3c\x4f\xd8\xfc\x17\xec\x7f\x1c\xa4\x36\xc3\xc2\x5b\xec\x41\x1a\x7d\x30\xe6\xfa\x92\x2e\xe3\xaa\x1c\x3a\x2d\x86\x72\x61\x2b\x72\x2e\xc0\xb1\x4b\xea\xb0\x94\xb9\x20\x90\x8b\x7f\x05\x6f\x7f\x66\x55\x6d\x74\xa6\xbd\x88\x39\x60\xd7\x45\x6c\x2e\x5a\xb7\xec\x37\x0a\x76\xba\xbb\x5a\x99\xe7\x87\x70\x87\xcb\xe4\x5f\x92\x55\xb1\x5b\xad\xc3\xcd\x61\xf7\xb5\x36\xc5\x70\x43\x4d\xc7\xdb\x43\x87\x00\x35\x47\x91\x57\x9a\x2e\x55\x81\x62\xc7\xd6\x61\x6d\xc2\xbf\x6f\xd3\xc4\x35\x14\x3a\x82\xcc\xf5\xb4\xa5\x60\xce\x1d\x2d\xd5\xfb\xbd\x26\xd9\xed\xca\x93\x78\x65\xa9\x53\xc0\x06\xa5\x0b\x19\x0b\xca\x38\xf9\x97\xc0\xf7\x4d\x6d\x50\xfb\x73\x79\xea\xdd\xa6\xdf\x12\x4e\x2e\x99\x1f\x4e\x8b\xdc\x62\xce\xfb\x45\xde\xd7\x7a\x8c\xdc\xbe\xc4\x00\x7c\xf9\xe1\xd9\x2f\x21\x50\xf6\x4c\xd7\x10\xfe\x70\xf5\xc2\xdf\xa1\xa1\x0f\xd3\xc3\x4b\x86\x3d\xdd\xb6\x02\xb7\x19\x1a\x02\xc6\x2e\x68\x1b\x00\xf4\x68\x0d\x71\xdc\x87\x3f\x57\x11\x71\xc3\xe1\xf7\x3e\xae\x75\x93\xec\xa4\x25\x9a\x27\x8c\xa2\x31\x73\x12\x0b\x16\x85\xc3\x2b\x7f\x16\x86\x72\x05\xd0\x10\xb8\xf7\xe2\xd4\x05\x72\x31\xc8\x4c\x9a\xe2\x37\xb1\x90\x2e\x42\x09\x57\x16\x4e\xf7\x65\x2e\x52\x61\xa3\xd8\x5c\x37\x0d\xd3\xaa\x2c\x95\xb6\x86\x8d\xdd\x58\x61\x2b\x66\x8b\x2b\x3b\x35\xfc\xfc\x73\xe2\x95\xfd\xcb\x2f\x0e\xab\xfc\xfc\x73\xf2\xee\x8d\x09\x27\xfa\xbf\xfc\xd2\xb8\x55\xa7\xf8\x0f\x53\xd7\x88\x3f\xa6\x4b\x4b\xb2\x64\xe2\x39\xff\x29\x67\xe4\x4a\x4a\x79\x1b\x94\xaf\x38\x7a\x12\x84\x49\x8c\x7c\x36\xbe\x59\xc8\x2d\x73\xad\x34\x54\xb3\x4a\xda\x0a\x5e\x70\xcb\xe0\xd9\x5f\x9e\xd6\x59\xa3\x87\x83\x02\x3e\xd8\xdc\x97\x98\xde\xe0\xc2\xa5\x82\x54\x56\xa5\x7e\xd7\xdf\x50\x8e\x34\xe5\xc4\x82\xb0\x58\xaa\xb2\x70\xcb\x7d\x60\x00\x02\x5c\xe2\xfa\xea\x75\xf2\xcd\x29\xff\xff\x2c\xf9\xf7\x61\xb1\x72\x1f\x46\x96\xbc\x72\x9f\xf5\xf1\x2a\x79\xf5\x9f\xc9\x6b\xef\x91\xc8\x97\x7a\x9f\x86\x23\x4a\xc7\x6b\x30\xbd\x30\x8f\x6a\x0e\x29\x96\x38\xc4\x42\x0c\x5b\x4a\x1e\xfe\x65\x43\xc3\xc3\x3f\xfb\x99\x24\x99\xf1\x9c\x1b\x72\x67\xde\xde\x1e\x78\x12\xc3\x29\xa1\xbb\x1f\x15\x44\xb4\x5c\xec\xd0\xf4\xee\xd5\x36\xfc\xcb\xab\xe4\xd5\x9b\xe4\x9b\xe1\x9f\x1d\x25\xd6\x2e\xd7\x91\xb3\xb9\x71\x41\xba\x5b\x34\x6d\x4d\x7a\xa2\x5a\xc9\x61\x0a\xc2\x64\x73\x41\x06\x01\xe6\xa6\x7d\x7c\xaf\x72\x94\x8b\x44\xe9\xc5\xa8\xbc\x59\x8c\x2c\xdd\xdb\x51\x3c\x22\x1e\x9d\x3c\xd3\xea\xea\xef\x19\xf4\xf7\x0c\xbe\x9e\x7b\x06\x52\x49\x2e\x34\xff\xec\xaa\xc8\xbb\x6a\xef\x42\xc9\xb9\x58\x54\x3a\x14\x3b\x84\xdd\x7e\x44\x1e\x52\x49\x5f\x9a\x1e\xaa\xc7\x42\x89\xba\x39\x42\x21\x6b\xa3\x7b\x5e\x81\x64\x8a\xa5\xa9\x78\xed\x9a\xb0\xb5\xdb\xc5\x55\x64\x26\x73\x75\xf8\x83\xe3\x13\x8e\xbe\xa3\xdd\xd5\xef\x5b\xb8\xf7\xf7\x00\xba\x64\xa7\x3a\x59\x01\xff\x92\x4c\xf5\xaa\xb4\x94\x1d\xc4\xc8\xdb\x48\x05\x62\xfd\xde\x85\x9f\xae\x16\xdc\xad\xfb\xe7\x48\x22\x95\x4d\x3a\x31\xbe\x7f\xdf\x5f\x73\x2e\x94\x7c\x47\xab\xa7\x70\xef\x29\xa3\xe3\x79\x77\x35\x8d\x37\x08\x18\x52\xb9\x44\x8a\x1b\xa0\x25\x97\x2b\xfc\xe2\x98\x4e\xc2\xdd\x34\xc1\x9a\x8a\x53\x6d\x9a\xcf\x17\x1c\xc8\x5e\x53\x0f\x67\xf8\x7d\x98\xf1\x1b\x6b\x55\x08\x6b\x29\x5b\x0f\xa1\xec\xb8\x79\xf4\x08\x1a\x38\xf7\xe0\xd1\x04\x3f\x2d\x2a\x63\x01\x73\x4d\x98\xad\x80\xee\xf9\x42\x13\xf7\xc8\xbb\x89\x34\x25\x63\xc4\x2c\xa7\x88\x04\x9a\x4f\x16\x4d\x9e\xcb\x46\x84\x2a\xcd\x41\x0a\x9e\x7c\xb8\x9e\x3e\xcc\xab\xb8\xa7\xa1\x8c\xae\xb5\xd9\xc8\x84\xb9\x49\xe0\xbd\xb2\xbe\x4c\x22\xe5\x6b\xb8\x3c\x0b\x98\xe7\xce\x1a\x76\xac\xfd\x43\x2e\xa5\x1c\x96\x6c\x01\x30\x3b\x6f\xdb\x6c\x11\x9a\xaf\xe7\xd4\x1e\xdc\x38\x7a\x78\x21\x24\xfc\x20\x5e\x46\x10\xbf\xee\x47\x12\xb8\xe2\x79\x9d\x11\x2c\xdc\xe7\xfe\xf1\x0a\x42\xd9\x42\xbf\x46\x62\x69\x96\xec\x0c\xb9\x2f\xa5\xe1\x0d\xbc\x70\x87\x6a\x74\xeb\x4b\x2f\x02\xdd\xcb\xe7\xd4\x50\x21\x24\xc7\xa5\x31\xbc\x79\x36\x55\xda\x55\x79\x98\x2a\xb7\x01\x82\x96\x77\x79\x41\xc9\x22\x81\x45\x79\x76\x0a\x42\xbd\x3a\x05\xb2\x69\x92\x24\x2f\x9f\xc9\xde\xbb\x9c\x12\x0c\xdd\x94\xec\x68\xd0\x21\x13\xd1\x25\xc2\xf6\x37\xf9\xfe\x60\x37\xf9\x3c\xfe\xe8\xa8\x9a\x06\x5c\x1d\x0a\x58\x5a\xb7\xfb\x06\xc7\x61\x95\xae\x48\xe5\x49\x38\xa5\xe3\x8a\xec\x88\x51\xb6\xc5\xf8\x67\x47\x28\x5d\xf1\xc9\x01\xe8\xa4\xc7\x26\x9b\xd8\xa4\xa3\x5d\xec\xc7\x25\xbf\x1b\x2a\xe9\x1e\x71\xbb\x86\xd1\xfd\x78\xe4\xeb\x40\x23\xdd\x35\xd3\x11\x89\x74\x55\xe0\x7e\x14\xf2\xab\x63\x90\x4e\x76\xbd\x1f\x7f\xec\x41\x1f\x1d\xb0\x87\x29\x95\xbd\x42\x7d\x43\xf6\x43\xd9\xfa\x56\x82\xbd\x4a\x99\x6e\xd2\x01\xba\xd0\xca\x6e\x49\xbb\xa2\xba\x18\x97\x9a\xc0\xed\x1e\xcf\xb8\xd6\x59\x42\xc5\x65\x44\xee\x74\x82\x7b\x6a\x9a\x24\x47\x46\xa9\x02\xef\xaf\xb5\x48\x0f\x99\xdf\xab\x40\x12\x32\xa9\x26\x9c\x65\xfa\x0f\x7f\x28\xb9\xb3\xfa\x94\x9b\x8d\x9b\x9d\x5f\xa8\xb0\x2a\x71\xe5\x90\x86\x13\xe1\x73\x73\xba\x60\x8e\x9f\xfb\x2e\x53\xf7\x5b\x5f\x9c\xe7\x4a\x21\x11\xaf\x8f\xb9\xe3\x22\x2a\x4a\xbb\x0a\xa2\xc0\x8b\x4c\xd5\x85\x0b\xd3\xe9\x5f\x79\x37\x6c\x38\x7b\xcd\x04\x22\x8b\x8f\x5c\xce\xc2\x65\x93\x43\x88\x81\x17\x6e\x97\xdd\x0a\x33\xed\x96\x2f\x07\x47\xa8\xb1\xbf\xad\xdf\xdf\xd6\xef\x6f\xeb\xf7\xb7\xf5\xfb\xdb\xfa\xfd\x6d\xfd\x5f\xf7\xb6\x3e\x7f\x21\x88\x44\x99\xee\xd8\xe0\xad\x31\xf8\xc9\xb7\x06\x21\x33\xbe\xed\xc9\xe6\x38\x7f\x90\xe0\x60\x9c\xa4\x24\x98\x25\xf2\xe9\x36\x7f\xb0\x90\x90\x8b\x9c\x86\x6e\x28\x0b\x4b\xd4\xd9\x1d\xea\x1d\x46\xb8\xbb\x58\x67\x18\x03\xee\xce\x16\x9e\xbf\x6c\x47\x9b\xa5\x0a\x5f\xd3\xf4\x44\xf5\x56\x92\x3f\xd9\x4f\x93\x31\x94\xb1\xdf\xbd\x7c\x70\x3d\xf9\x51\x35\xfe\xb8\x85\x34\xc4\xe0\xd5\x5a\xee\x88\x51\xa9\xbf\xf2\x2b\x0c\x2c\xbe\x88\x72\xd8\x10\xc2\x8c\xe6\xbc\xf6\x7d\xd5\xa8\x09\xb5\x33\x94\x9e\xb5\xc2\x7b\xab\x40\x72\x89\x06\xf8\x50\xdc\x0e\x85\x04\xe3\xeb\x0a\x1c\x0a\xdc\xec\xb6\x19\xb3\xf9\x2b\xd4\xd2\xf1\x5d\xd5\x3b\x13\x0a\xa5\x20\xd4\x49\xf1\xe8\x98\xdf\xe1\xea\x01\x87\xc9\xe0\xe9\x49\x88\x9d\xa6\xfb\x95\x7e\x8e\x04\x17\xca\x0e\x8c\x45\x5b\x39\xef\xcf\xe9\x05\x4e\xdb\xbc\x6f\xbe\x95\x8c\x2f\xc4\x8f\xe1\x4f\x7f\x5a\xfb\xfa\x30\xf7\x32\x55\xd2\xdf\xea\x31\x63\xf8\xfb\x3f\xf8\xdb\xc1\xdc\x94\x7c\x8e\xdf\x07\x06\x7f\xff\xc7\xe0\xff\x06\x00\x62\xa5\xf4\xc2\x26\x6e\x00\x00")
 
 func clusterApiInfrastructureClusterXK8sIo_awsmachinetemplatesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -263,7 +264,7 @@ func clusterApiInfrastructureClusterXK8sIo_awsmachinetemplatesYaml() (*asset, er
 	return a, nil
 }
 
-var _clusterApiInfrastructureClusterXK8sIo_awsmanagedclustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\xcf\x73\xdc\xb6\x0e\xbe\xef\x5f\x81\xc9\x3b\xe4\xf2\x56\x7e\x49\xde\xa1\xd5\xcd\xb3\x49\x67\x3c\x4d\x3a\x1e\x3b\x75\x0e\x4d\x0f\x58\x11\xbb\x62\x4c\x91\x2c\x09\xae\xb3\xe9\xf4\x7f\xef\x80\x94\xd6\xd2\xfe\xb2\xdb\x69\x75\x92\x28\x10\xfc\xf8\x01\xf8\x40\xa2\xd7\x77\x14\xa2\x76\xb6\x06\xf4\x9a\xbe\x32\x59\xf9\x8a\xd5\xfd\x77\xb1\xd2\xee\x62\xf3\x6a\x76\xaf\xad\xaa\x61\x91\x22\xbb\xee\x86\xa2\x4b\xa1\xa1\xb7\xb4\xd2\x56\xb3\x76\x76\xd6\x11\xa3\x42\xc6\x7a\x06\x60\x70\x49\x26\xca\x1b\x40\x63\x52\x64\x0a\xd5\xd7\xf9\xce\x15\x1a\xdf\xe2\xff\x6b\xe8\xdf\xde\xcc\x00\xd0\x5a\xc7\x28\x8e\x86\x69\xce\x72\x70\xc6\x50\x98\xaf\xc9\x56\xf7\x69\x49\xcb\xa4\x8d\xa2\x90\x7d\x0c\x60\x37\xff\xab\x5e\x57\xdf\xcf\x00\x9a\x40\x79\xfa\x47\xdd\x51\x64\xec\x7c\x0d\x36\x19\x33\x03\xb0\xd8\x51\x0d\xf8\x10\x3b\xb4\xb8\x26\xd5\x03\x8a\x95\xb6\xab\x80\x91\x43\x6a\x38\x05\xaa\xf6\x81\xce\xa2\xa7\x46\xc0\xac\x83\x4b\xbe\x86\xa7\xcc\xcb\x4a\x03\x7c\x64\x5a\xbb\xa0\x87\xef\xf9\xc0\xc3\x1c\xbd\xce\x23\x85\xce\xcb\x4f\xb7\x1f\x0a\xac\x45\xf9\x9f\xff\x19\x1d\xf9\xc7\xe3\xff\xdf\xeb\xc8\xd9\xc6\x9b\x14\xd0\x1c\xdb\x58\xfe\x1d\x5b\x17\xf8\xa7\x47\x40\xf3\x6c\xd8\x94\x7f\xda\xae\x93\xc1\x70\x64\xf2\x0c\x20\x36\xce\x53\x0d\x79\xae\xc7\x86\xd4\x0c\xa0\xe7\x3b\xfb\x9a\x03\x2a\x95\x63\x8e\xe6\x3a\x68\xcb\x14\x16\xce\xa4\xce\xee\x56\x52\x14\x9b\xa0\x3d\xe7\x08\xf5\xb8\x81\x1d\x3c\xb4\xba\x69\x81\x5b\x1d\xc7\xfb\x2a\x81\x86\x25\x19\x67\xd7\x05\x3c\xc0\x97\xe8\xec\x35\x72\x5b\x43\x35\x24\x56\x55\xb2\x6a\x20\xfe\x73\x61\xfe\xb3\xa4\xc3\xc0\xad\x04\xa0\x77\x50\xa2\x3e\x26\x15\x80\xb7\xb2\xb1\xc8\x41\xdb\xf5\x51\xa8\x3d\x14\x6f\xd0\xd2\x5e\xbc\x41\x47\x08\x84\x6a\x0b\x2b\x17\xe0\xc1\x85\x7b\x0a\x60\x9d\xa2\x23\x88\x23\x23\xa7\x58\x65\xf3\x09\x9c\x9b\xd1\xc8\x53\x60\x2e\x3f\xdd\xc2\xdd\xf5\x02\xb8\xa5\xa1\x18\x06\x60\x11\x52\x1c\x66\x4d\x17\xf6\xd4\x54\x96\x58\xe0\xdd\xca\xfb\xc6\x37\x95\x56\x13\x0c\x77\xd7\x8b\xe7\x22\xb8\xbe\x82\x77\x56\x79\xa7\x2d\x9f\x58\xab\x07\x76\x2d\xb8\x06\xd3\xaa\x75\x91\x27\x4b\xee\x39\xf1\x41\xbb\xa0\x79\x5b\xc3\xab\x53\x48\xca\xbc\x91\x3e\x48\x5a\xb6\xd4\x61\xdd\xcf\x70\x9e\xec\xe5\xf5\xd5\xdd\x9b\xdb\xc9\x30\x1c\x70\x38\xad\x1e\xe1\x4e\x08\x2d\xb3\x72\x28\xe5\xf3\xb0\x86\x64\xf3\x3b\x9f\x3e\x38\x4f\x81\x77\xc5\x5c\x9e\x91\x66\x8e\x46\xf7\x10\xbc\x14\x90\xc5\x0a\x94\x88\x25\x15\x00\x7d\x41\x91\xea\xf7\x05\x6e\x55\x0a\x23\x90\x0f\x14\xc9\x16\x31\x94\x61\xb4\xe0\x96\x5f\xa8\xe1\x0a\x6e\x29\xc8\x44\x29\xee\x64\x94\xa4\xc5\x86\x02\x43\xa0\xc6\xad\xad\xfe\xb6\xf3\x16\xa5\xda\x64\x19\x83\x4c\x91\x21\x17\xa9\x45\x03\x1b\x34\x89\xfe\x0b\x68\x15\x74\xb8\x85\x40\xe2\x17\x92\x1d\x79\xc8\x26\xb1\x82\x0f\x2e\xe4\x1a\x70\x35\xb4\xcc\x3e\xd6\x17\x17\x6b\xcd\x43\x3f\x68\x5c\xd7\x25\xab\x79\x7b\x91\x53\x40\x2f\x13\xbb\x10\x2f\x14\x6d\xc8\x5c\x44\xbd\x9e\x63\x68\x5a\xcd\x94\x6b\xe7\x02\xbd\x9e\x67\xb0\x36\x2b\x7c\xd5\xa9\xff\x84\xbe\x83\xc4\x97\x13\xf2\x0e\x32\xa1\x3c\x59\x2c\xcf\xb0\x2c\x62\x29\xb1\xc5\x7e\x6a\xd9\xc5\x23\x99\x32\x24\x7c\xdc\xbc\xbb\xfd\x08\xc3\xd2\x85\xf0\xc2\xed\xa3\x69\x7c\xa4\x59\x28\xd2\x76\x25\xe2\x25\x96\xab\xe0\xba\xec\x85\xfa\x7c\x2e\xb5\x69\x34\x59\x86\x98\x96\x9d\x66\x89\xdf\x6f\x89\x22\x4b\x04\x2a\x58\xe4\xb6\x06\x4b\x82\xe4\x15\x32\xa9\x0a\xae\x2c\x2c\xb0\x23\xb3\xc0\x48\xff\x3a\xc9\xc2\x66\x9c\x0b\x79\xcf\xa3\x79\xdc\xc3\xf7\x8d\x0b\x4f\xa3\x1f\x43\x7b\x3c\x11\x93\x83\xda\x13\x41\x9a\xd4\x80\xa2\xa8\x83\xe4\x2c\x23\x93\x64\xfa\xf1\x66\x78\xbe\x0a\xe1\xf1\xac\x30\x51\xa1\x7d\x1b\x38\x2a\xf5\x93\x29\xa3\x1c\x98\x86\x39\x45\x52\x52\x51\x7d\x3c\xa4\xb7\xc3\x83\xe6\xf6\x50\x9b\xab\x83\x55\x4f\xa3\x96\x47\xa4\xf2\xd8\xf8\x1e\xda\x8f\x2d\x65\x53\x91\x45\x70\x76\xd7\x48\x29\x6b\x74\xcc\xe9\x2a\xe9\x2f\x6f\xda\xae\x0f\x51\x9c\x89\xf9\x0e\xa8\x0b\xcf\x85\x22\xa6\x7f\x1b\xc6\xca\x85\x0e\x59\x8e\x53\xfc\xe6\xf5\x19\xa0\xa2\x59\xeb\xbd\x14\x90\x47\x0a\x4c\xd2\xe6\x10\xeb\x1c\x46\x9d\x67\x3c\x2c\x78\x0f\x86\x4f\x64\xf5\xe9\x74\xcf\x4d\xfd\x2f\x25\x7c\x9e\x31\x49\x79\xb7\xcc\x2c\xfd\x03\x39\xbf\x42\x6d\x52\xa0\xb7\xae\x43\x6d\x8f\xe4\xd6\xf8\x8c\x76\x2e\x03\x27\x5b\xf8\x61\xec\x34\xd7\xeb\x61\xbf\x1c\x3a\xa9\x84\xbc\x07\x01\xaa\xa0\xa8\xe0\x8a\x01\x8d\x71\x0f\x71\x74\x7e\xcf\xcd\x28\x59\x45\x21\xb2\x34\x9e\xd6\x3d\x40\x87\x76\xbb\x3f\x1b\x70\x38\x23\x43\x23\x3d\xcf\x17\xf8\x66\x0b\xd1\xa3\x05\x6c\x82\x8b\xf1\x58\x4e\x9d\x2f\x31\x00\xe4\xa2\x9d\xa7\xfe\x3f\x9f\xac\xf2\x3c\x51\x48\x07\xac\x5e\xee\xd6\x2f\x2d\x6a\x15\x88\x72\x19\x40\x87\x3e\x77\xf8\x47\x03\xb4\xfb\xe7\x4e\x1f\xdc\x46\x2b\x0a\xd0\xe9\x75\x9b\xb5\x08\x5c\x18\xaa\xe0\x78\x89\x9d\x49\xee\xe1\x19\x4b\xe6\xa9\x9d\x9e\x14\x4c\x50\xc4\x14\xba\x9c\xd5\xba\x3f\xb7\x4c\x83\x99\x55\x20\x69\xc6\xa5\xc9\x5b\xcd\xb0\x97\xdb\xbd\x83\x6c\x87\x4d\x2b\x4e\xce\xef\x62\xe9\x9c\x21\xb4\x47\x6c\xce\xee\xf2\x74\x62\xc7\xdc\xba\xf4\x4a\x0b\xdf\xf9\xae\x05\x2b\x07\xb8\x41\x6d\x32\xde\xfe\x4d\x1b\xcd\x5b\xf8\xe6\x4a\xed\x22\xe7\xac\x94\x56\x1e\x49\x3d\x5f\x4d\xa0\xdc\x1a\x9e\x68\x47\xf9\x62\x20\xa4\x3d\xb4\x64\x8b\xa2\xee\x5f\x90\x0a\xf3\x2d\x0a\xe6\x91\xdc\xfe\x7c\xf3\xfe\x90\xbe\xd3\xc4\x1d\xc5\x79\x30\x58\x44\xaa\x06\x0e\xa9\xdc\xa7\x22\xbb\x80\x6b\x1a\x8f\xa4\xe5\xee\x08\x37\x6c\xae\x97\x48\xf8\xfd\x8f\xd9\xa3\x5a\x62\xd3\x90\x67\x52\xa3\xbb\x68\xb9\xfa\xbe\x78\x31\xb9\xc7\xe6\xcf\xc6\xd9\x52\x89\xb1\x86\x5f\x7e\x9d\x95\x85\x49\xdd\x0d\xd7\x4f\x19\xfc\x33\x00\x00\xff\xff\xc1\xbb\x04\xf7\xac\x10\x00\x00")
+var _clusterApiInfrastructureClusterXK8sIo_awsmanagedclustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4d\x93\xdb\x36\x0c\xbd\xeb\x57\x60\xd2\x43\x2e\xb5\xb6\x49\x7a\x68\x75\xcb\x38\xe9\xcc\x4e\x93\xce\xce\x6e\xba\x39\x24\x39\xc0\x22\x6c\x31\x4b\x91\x2a\x09\x7a\xe3\x74\xfa\xdf\x3b\x20\x25\x5b\xf2\x57\xdc\x4e\x6b\xeb\x20\x91\x20\xf9\xf0\xf0\x00\x92\xd8\xe9\x7b\xf2\x41\x3b\x5b\x01\x76\x9a\xbe\x30\x59\xf9\x0a\xe5\xc3\x4f\xa1\xd4\xee\x6a\xfd\xac\x78\xd0\x56\x55\x30\x8f\x81\x5d\x7b\x4b\xc1\x45\x5f\xd3\x2b\x5a\x6a\xab\x59\x3b\x5b\xb4\xc4\xa8\x90\xb1\x2a\x00\x0c\x2e\xc8\x04\x79\x03\xa8\x4d\x0c\x4c\xbe\xfc\x32\xdb\x4e\x85\xa6\x6b\xf0\xc7\x0a\xfa\xb7\x17\x05\x00\x5a\xeb\x18\x65\xa2\x61\x98\xb3\xec\x9d\x31\xe4\x67\x2b\xb2\xe5\x43\x5c\xd0\x22\x6a\xa3\xc8\x27\x38\x03\xd8\xf5\x0f\xe5\xf3\xf2\xe7\x02\xa0\xf6\x94\x86\xbf\xd3\x2d\x05\xc6\xb6\xab\xc0\x46\x63\x0a\x00\x8b\x2d\x55\x80\x8f\xa1\x45\x8b\x2b\x52\x3d\xa0\x50\x6a\xbb\xf4\x18\xd8\xc7\x9a\xa3\xa7\x72\x1f\x68\x11\x3a\xaa\x05\xcc\xca\xbb\xd8\x55\xf0\x2d\xf3\xbc\xd2\x00\x1f\x99\x56\xce\xeb\xe1\x7b\x36\xf0\x30\xc3\x4e\x27\x8b\x4c\xe7\xcb\xf7\x77\x6f\x33\xac\x79\xee\x4f\x7d\x46\x07\xfe\xf5\x78\xff\x1b\x1d\x38\xd9\x74\x26\x7a\x34\xc7\x1c\x4b\xdd\xa1\x71\x9e\x7f\xdb\x01\x9a\x25\xc3\x3a\xf7\x69\xbb\x8a\x06\xfd\x91\xc1\x05\x40\xa8\x5d\x47\x15\xa4\xb1\x1d\xd6\xa4\x0a\x80\x9e\xef\xe4\xcc\x0c\x50\xa9\x14\x73\x34\x37\x5e\x5b\x26\x3f\x77\x26\xb6\x43\xe4\x66\xa0\x28\xd4\x5e\x77\x62\x52\x41\x8f\x1b\xd8\xc1\x63\xa3\xeb\x06\xb8\xd1\x61\xec\x57\x0e\x34\x2c\xc8\x38\xbb\x0a\x09\x20\xc0\xe7\xe0\xec\x0d\x72\x53\x41\x39\x08\xab\xcc\xaa\x1a\x88\xff\x98\x99\xff\x28\x72\xe8\x9b\x66\x12\x80\x7e\x02\x79\xdd\x2e\xde\xb7\xf1\x46\x1c\x0b\xec\xb5\x5d\x1d\x85\xda\x43\xe9\x0c\x5a\xda\x8b\x37\xe8\x00\x9e\x50\x6d\x60\xe9\x3c\x3c\x3a\xff\x40\x1e\xac\x53\x74\x04\x71\x60\xe4\x18\xca\x64\xde\xf7\x66\x38\xb7\xa3\x96\x6f\x81\x79\xf9\xfe\x0e\xee\x6f\xe6\xc0\x0d\x0d\xc9\x30\x00\x0b\x10\xc3\x30\x6a\xba\x70\x47\x75\x69\x89\x05\xde\x9d\xbc\xaf\xbb\xba\xd4\xaa\x37\xcc\x18\xee\x6f\xe6\x97\x22\xb8\xb9\x86\xd7\x56\x75\x4e\x5b\x3e\xb1\x56\x0f\xec\x46\x70\x0d\xa6\x65\xe3\x02\x4f\x96\xdc\x9b\xa4\xf3\xda\x79\xcd\x9b\x0a\x9e\x9d\x42\x92\xa1\x8e\xea\x83\xc8\xb2\xa1\x36\x55\x17\x31\x70\x1d\xd9\x97\x37\xd7\xf7\x2f\xee\x26\xcd\x70\xc0\xe1\x34\x7b\x24\x8a\x42\x68\x1e\x95\x42\x29\x9f\x87\x39\x24\xce\x6f\xe7\xec\xbc\xeb\xc8\xf3\x36\x99\xf3\x33\xaa\x99\xa3\xd6\x3d\x04\x4f\x05\x64\xb6\x02\x25\xc5\x92\x32\x80\x3e\xa1\x48\xf5\x7e\x81\x5b\xe6\xc4\xf0\xd4\x79\x0a\x64\x73\x31\x94\x66\xb4\xe0\x16\x9f\xa9\xe6\x12\xee\xc8\xcb\x40\x08\x8d\x8b\x46\x89\x2c\xd6\xe4\x19\x3c\xd5\x6e\x65\xf5\xd7\xed\x6c\x41\xb2\x4d\x96\x31\xc8\x14\x18\x52\x92\x5a\x34\xb0\x46\x13\xe9\x7b\x40\xab\xa0\xc5\x0d\x78\x92\x79\x21\xda\xd1\x0c\xc9\x24\x94\xf0\xd6\xf9\x94\x03\xae\x82\x86\xb9\x0b\xd5\xd5\xd5\x4a\xf3\xb0\x1f\xd4\xae\x6d\xa3\xd5\xbc\xb9\x4a\x12\xd0\x8b\xc8\xce\x87\x2b\x45\x6b\x32\x57\x41\xaf\x66\xe8\xeb\x46\x33\xa5\xdc\xb9\xc2\x4e\xcf\x12\x58\x2b\x4e\x85\xb2\x55\xdf\xf9\x7e\x07\x09\x4f\x27\xe4\x1d\x28\x21\x3f\xa9\x58\x9e\x61\x59\x8a\xa5\xc4\x16\xfb\xa1\xd9\xd1\x1d\x99\xd2\x24\x7c\xdc\xbe\xbe\x7b\x07\xc3\xd2\x99\xf0\xcc\xed\xce\x34\xec\x68\x16\x8a\xb4\x5d\x92\xcf\x96\x4b\xef\xda\x14\x3c\xea\xf5\x9c\x3e\x6a\xa3\xc9\x32\x84\xb8\x68\x35\x4b\xfc\xfe\x88\x14\x58\x22\x50\xc2\x3c\x6d\x6b\xb0\x20\x88\x9d\x42\x26\x55\xc2\xb5\x85\x39\xb6\x64\xe6\x18\xe8\x7f\x27\x59\xd8\x0c\x33\x21\xef\x32\x9a\xc7\x7b\xf8\xee\x27\xb3\x54\x3d\x4f\xa3\x8e\x61\x7b\x3c\x11\x93\x83\xdc\x93\x82\x34\xc9\x01\x45\x41\x7b\xd1\x2c\x23\x93\x28\xfd\x60\xc8\x64\xf6\xe3\x59\x38\x3a\x2b\x4c\xaa\xd0\xbe\xcd\x1e\xba\xf9\x91\x21\x23\x0d\x4c\xc3\x1c\x03\x29\xc9\xa8\x3e\x1e\xb5\xc0\x7d\xd4\xdc\x1c\xd6\xe6\xf2\x60\xd5\xd3\xa8\xe5\x2f\xa5\xf2\x58\xfb\x1e\xda\x77\x0d\x25\x53\x29\x8b\xe0\xec\x76\x23\x25\x29\x53\x10\x52\x55\x10\xf9\xcb\x9b\xb6\xab\x43\x14\x67\x62\x3e\xfc\x3b\xe7\x2f\x85\x22\xa6\xff\x1a\xc6\xd2\xf9\x16\x59\x8e\x53\xfc\xe2\xf9\x51\x8b\x0c\x54\x6a\xd6\x6a\x4f\x02\xf2\x48\x82\x89\x6c\x0e\xb1\xce\x60\xb4\xf3\xec\x7e\xb3\x84\xf7\xa0\xf9\x84\xaa\x4f\x76\xe4\x4d\xbd\x2a\x4e\x32\x73\x28\xf8\x34\x62\x22\x79\xb7\x10\x6e\xfe\x0b\xcd\x2f\x51\x9b\xe8\xe9\x95\x6b\x51\x0f\x27\xaf\xf1\x6f\x7c\x46\x3b\xa7\xc0\x89\x0b\xbf\x8c\x27\x4d\xf9\x7a\xb8\x5f\xf6\x30\x93\xf2\x7a\x10\xa0\x32\x8a\x12\xae\x19\xd0\x18\xf7\x18\x86\xb4\x30\x52\x47\xd9\x41\xb4\x8a\x7c\x60\xd9\x78\x1a\xf7\x08\x2d\xda\xcd\xfe\x68\xc0\xe1\x8c\x0c\xb5\xec\x79\x09\x13\x1a\xb3\x81\xd0\xa1\x05\xac\xbd\x0b\xe1\x98\xa6\xce\xa7\x18\x00\x72\xae\x9d\xa7\xfa\x2f\x27\xeb\xa2\x44\x3a\x14\xc6\x76\x7d\x49\x0e\x84\xa5\x27\x92\xa3\x47\x0b\x2d\x76\xa2\x81\x1d\x40\x40\xbb\x7f\xee\xec\xbc\x5b\x6b\x45\x1e\x5a\xbd\x6a\x52\x2d\x02\xe7\x87\x2c\x28\x8b\x73\x10\x8f\x88\x7b\xf8\xf7\xe1\x49\x25\xb3\xba\xc4\x89\x71\xc1\x04\x45\x4c\xbe\x4d\xaa\xd6\xfd\xb9\x65\x1a\x4c\x71\x34\x44\xcd\xb8\x30\xc9\xd5\x04\x7b\xb1\xd9\x3b\xc8\xb6\x58\x37\x32\xc9\x79\x2f\x16\xce\x19\x42\x5b\xfc\x43\x2f\x4f\x0b\x3b\xa4\xad\x4b\x2f\xb5\xf0\x9d\xee\x5a\xb0\x74\x80\x6b\xd4\x26\xe1\xed\xdf\xb4\xd1\xbc\x81\xaf\x4e\xbc\xe4\x06\x39\xa9\x52\xb6\xf2\x40\xaa\x98\x2c\x75\x1e\x4a\xba\x06\x54\xe7\xf1\xa5\x8b\x81\x90\xf6\xd8\x90\x4d\x19\x37\xaa\x0b\x63\xe6\x1b\x14\xcc\xa3\x72\xfb\xfb\xed\x9b\xb2\xb8\x9c\xb8\xa3\x38\x0f\x1a\xd3\xdc\xaa\x02\xf6\x91\x72\x03\x3b\x8f\x2b\x1a\xb7\xc4\xc5\xf6\x08\x57\x15\x93\x12\x09\x7f\xfe\x55\xec\xaa\x25\xd6\x35\x75\x4c\x6a\x74\x17\x95\x03\x49\x05\x4f\x9e\x4c\xee\xb1\xe9\xb3\x76\x36\x67\x62\xa8\xe0\xc3\x27\xb9\x8c\xb2\xf3\xa4\xfa\x13\x74\xa8\xe0\xc3\xa7\xe2\xef\x01\x00\xc1\xbb\x04\xf7\xac\x10\x00\x00")
 
 func clusterApiInfrastructureClusterXK8sIo_awsmanagedclustersYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -283,7 +284,7 @@ func clusterApiInfrastructureClusterXK8sIo_awsmanagedclustersYaml() (*asset, err
 	return a, nil
 }
 
-var _clusterApiInfrastructureClusterXK8sIo_awsmanagedmachinepoolsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x4b\x6f\x23\x47\x92\xbe\xeb\x57\x04\xbc\x87\xf6\x02\x12\xb5\xdb\x36\x0c\x2f\x81\x3d\x10\xea\xb6\x47\xe8\x56\x43\x10\x65\xfb\x30\x1e\x18\x51\x59\x41\x32\xad\xac\xcc\x72\x3e\x28\xd1\x83\xf9\xef\x83\x88\xac\x2c\x16\xc9\x22\xd9\xed\xb1\xe7\xe2\xd1\xa5\x9b\x55\xf9\x88\xfc\x22\xe2\x8b\x47\x16\xb6\xfa\x7b\xf2\x41\x3b\x3b\x05\x6c\x35\xbd\x44\xb2\xfc\x2b\x4c\x9e\xbe\x0e\x13\xed\xae\xd7\xff\x7b\xf1\xa4\x6d\x3d\x85\x9b\x14\xa2\x6b\x1e\x28\xb8\xe4\x15\xbd\xa1\x85\xb6\x3a\x6a\x67\x2f\x1a\x8a\x58\x63\xc4\xe9\x05\x80\xc1\x8a\x4c\xe0\xff\x01\x28\x93\x42\x24\x3f\x79\xb9\xea\x97\x42\xd3\xae\xf0\xcb\x29\x74\xff\xfb\xe2\x02\x00\xad\x75\x11\x79\xa1\x32\xcd\xd9\xe8\x9d\x31\xe4\xaf\x96\x64\x27\x4f\xa9\xa2\x2a\x69\x53\x93\x97\x35\x8a\xb0\xeb\xff\x99\xbc\x9e\xfc\xdf\x05\x80\xf2\x24\xd3\x1f\x75\x43\x21\x62\xd3\x4e\xc1\x26\x63\x2e\x00\x2c\x36\x34\x05\x7c\x0e\x0d\x5a\x5c\x52\xdd\xa0\x5a\x69\x4b\xad\x73\x26\x4c\xb4\x5d\x78\x0c\xd1\x27\x15\x93\xa7\xc9\xbe\xb0\x17\xa1\x25\xc5\x02\x2d\xbd\x4b\xed\x14\xce\x0d\xcf\xbb\x95\x23\x60\xa4\xa5\xf3\xba\xfc\xbe\x2a\x58\x5c\x61\xab\xe5\x49\x86\x74\xf6\xc3\xfc\x2e\x8b\x76\x97\x45\xbb\x77\xce\xc8\x7b\xa3\x43\x7c\x77\x7c\xcc\x7b\x1d\xa2\x8c\x6b\x4d\xf2\x68\x8e\x1d\x52\x86\x04\x6d\x97\xc9\xa0\x3f\x32\xe8\x02\x20\x28\xd7\xd2\x14\x3e\xf0\x01\x5a\x54\x54\x5f\x00\x74\x38\xcb\x01\xae\x00\xeb\x5a\x74\x8d\xe6\xde\x6b\x1b\xc9\xdf\x38\x93\x1a\xdb\x1f\xaf\xa6\xa0\xbc\x6e\xa3\x68\x66\x20\x27\x78\xc2\x7a\x03\x21\x62\x4c\x59\x1a\x80\x9f\x83\xb3\xf7\x18\x57\x53\x98\xe4\xe7\x13\x19\xd4\xbd\xcd\x3a\x7b\x18\x3c\x89\x1b\x16\x2e\x44\xaf\xed\x72\x6c\xbb\x0f\xa9\xa9\xc8\x83\x5b\x80\xa7\xd6\x68\x85\xa7\x36\xda\x19\x50\xf6\xda\x79\x98\xb7\xe3\x43\x2e\xc9\x5f\x6c\x87\x0d\x4c\x96\x11\x5b\x51\x83\xd3\x6e\x8a\x6b\xc9\xce\xee\x6f\xbf\xff\x62\xbe\xf3\x18\x76\xe5\x1c\x55\x24\xe8\x00\x71\x45\x90\x67\xc2\xc2\x79\xf9\x39\xae\x4e\x98\xdd\xdf\xf6\x6b\xb7\xde\xb5\xe4\x63\x6f\x63\xf9\x6f\xe0\xce\x83\xa7\x7b\x92\xbc\x62\x61\xf3\x28\xa8\xd9\x8f\x29\x0b\xd1\xe9\x9c\xea\xee\x7c\x8c\x69\x5c\xe9\xc0\xc0\x7a\x0a\x64\xb3\x9f\xf2\x63\xb4\xe0\xaa\x9f\x49\xc5\x09\xcc\xc9\xf3\x44\x08\x2b\x97\x4c\xcd\xee\xbb\x26\x1f\xc1\x93\x72\x4b\xab\x7f\xed\x57\x0b\x10\x9d\x6c\x63\x30\x52\x88\x02\xb1\xb7\x68\x60\x8d\x26\xd1\x25\xa0\xad\xa1\xc1\x0d\x78\xe2\x75\x21\xd9\xc1\x0a\x32\x24\x4c\xe0\xce\x79\x62\x57\x74\x53\x58\xc5\xd8\x86\xe9\xf5\xf5\x52\xc7\x42\x55\xca\x35\x4d\xb2\x3a\x6e\xae\x85\x43\x74\x95\xa2\xf3\xe1\xba\xa6\x35\x99\xeb\xa0\x97\x57\xe8\xd5\x4a\x47\x12\x17\xbe\xc6\x56\x5f\x89\xb0\x56\xc8\x67\xd2\xd4\xff\xe5\x3b\x72\x0b\xaf\x76\xc0\x3b\xb0\xc0\xfc\x27\x3e\x7c\x02\x65\xf6\x5f\xd6\x2f\x76\x53\xf3\x29\xb6\x60\xf2\x23\xc6\xe3\xe1\xed\xfc\x11\xca\xd6\x19\xf0\x8c\xed\x76\x68\xd8\xc2\xcc\x10\x69\xbb\x20\x9f\x47\x2e\xbc\x6b\x64\x15\xb2\x75\xeb\xb4\x8d\xf2\x43\x19\x4d\x36\x42\x48\x55\xa3\x23\xeb\xef\x97\x44\x21\xb2\x06\x26\x70\x23\x8c\x0b\x15\x41\x6a\x6b\x8c\x54\x4f\xe0\xd6\xc2\x0d\x36\x64\x6e\x30\xd0\x1f\x0e\x32\xa3\x19\xae\x18\xbc\x8f\x83\x79\x18\x5e\xf6\x07\x67\x9c\x06\x2f\x0a\x6b\x1f\xd1\xc9\xa8\x0f\xce\x5b\x52\x3b\x7e\x50\x53\xd0\x9e\xed\x36\x62\x24\xb6\xf6\xe3\x3c\x7d\xda\x1b\xc5\x23\x7b\xea\x7c\xc4\xe5\xc1\x5b\xd8\xa1\xd6\x63\x6b\x9c\x40\x67\xec\x90\x3b\x3b\x8a\x05\x5a\x70\x6d\x7e\x04\x81\xa2\xf8\x35\xbf\x8a\x8e\xb7\xe7\x7f\x66\x3f\xcc\x7b\x13\x64\x1b\x93\xc3\x42\xb5\x11\x3c\xf8\x65\xeb\xdd\x5a\xd7\xe4\x2f\x41\xdb\x5e\xe6\xe2\xd1\x8e\x91\xc3\xba\xce\x53\x6a\x5a\x60\x32\x71\x72\x20\xe5\x11\x9d\x09\x0a\x8d\x7e\xe4\xb7\x23\x27\x93\xc5\xa6\x30\x7b\xff\xfa\xa7\x97\xaf\xbf\xfa\xe9\xab\x2f\xcf\x9c\xfe\xee\x96\x57\xda\x51\xe8\xec\xee\x56\x36\x3f\x98\x49\x36\x35\x87\x7b\x5e\x9d\xda\x6c\xf8\xf2\xa7\x6f\xef\xbf\x3b\x32\x60\xf6\x70\x37\x36\xfb\x84\x16\xb1\x19\xa7\xee\xb1\x13\x8e\xb1\x77\xb1\x5a\x3e\xac\x27\x43\x18\x7a\x46\x9f\xc0\xed\x02\xac\x2b\x3f\xc1\xe6\x90\xa9\x03\x84\xd4\xb6\x46\x53\xcd\x2b\xd8\x21\x3b\x97\xa1\x25\x22\xbd\x4b\x15\x79\x4b\x91\x42\xff\xea\x59\x1b\x23\x34\x12\x24\x63\xd8\xfd\x6b\xb4\x7d\x4f\x76\xc9\xd1\xf7\xf5\xa7\xa0\x50\xeb\xf0\x34\xd7\xbf\x8e\x5a\xc2\x00\x83\x37\xdd\x30\x71\x78\xbd\xd0\x1d\x06\xde\xb9\x28\x4b\x40\xd0\xbf\x1e\xaa\x7b\xe1\x7c\x83\x51\xa2\xfb\x17\xc7\xa4\x1a\x46\xfe\xed\x1f\x3d\x85\x0f\xae\x26\x49\x06\x39\x4f\x3a\x23\xde\xdb\x77\xf3\x9d\xe1\x7b\x62\x72\x46\x91\x83\x2b\x81\x2d\xe3\xd8\xb1\xd8\xd3\x94\xf3\x9e\x42\xeb\x6c\x2d\x01\xc2\x65\x9e\x1f\x10\x8f\x68\x73\xe3\x12\xd4\xce\xbe\x8a\xdd\xd2\x1b\xc0\xbc\xac\x28\x12\x8b\xdf\xe4\x67\x45\x53\x92\x2a\xb3\x93\x62\xa0\x1a\x9c\xed\x85\x91\xb4\x4f\x22\xf0\x50\xb4\x42\x03\x5d\x0e\x02\x9c\x84\x1c\xf3\xea\x51\x65\x6a\x1b\x22\x5a\x45\xc7\x5c\x7b\x80\xd8\xed\x60\xe8\x1e\x5a\x0c\x4a\x59\x69\xdc\x8d\x4f\x88\x30\x2c\x48\x86\x7f\xbf\x3f\xed\xbe\x97\x9d\x06\xb2\xe7\xad\xc7\x3c\x88\x75\xde\xf1\x60\xf8\x14\x96\x2c\x24\x7c\xfb\x86\x0b\x80\x33\x88\xde\xef\x0c\x06\xf4\x62\x1b\xfd\x1a\x70\xfb\x26\xb0\xa2\x0b\xb2\x81\xed\x4f\x72\xcf\x14\x5d\x50\x68\xd8\xfa\xb2\x61\x8e\x58\xe4\xd0\x6e\xfb\x2c\xa5\x44\x0c\x1d\x76\x4c\xe6\x40\x4c\x1d\xa9\xf9\x74\xb0\xf3\x4b\xf4\x1e\x37\x7b\xef\x3c\x35\x2e\xd2\x4c\x29\x0a\x23\xcb\xee\x80\xf2\x30\x18\x3a\x50\xd5\xca\x3d\x17\x91\x03\x28\xb4\xec\x2c\x28\x83\xa8\xee\x96\x37\xfb\xbb\x9e\x0a\xfa\xfc\x97\x83\xe9\x9c\x54\xf2\x3a\x6e\xbe\x65\xa8\x46\xc7\xed\x49\x38\x1f\x99\x36\x90\xf4\x79\xa5\xd5\x0a\x42\xf7\x3a\x2b\x28\x88\x72\xd1\x18\xf7\x4c\x75\x27\x37\xcc\x2c\x50\xd3\xc6\x4d\x46\x4c\x6a\x94\x00\xad\xf3\x11\x5e\xbf\x2e\x3a\x6c\x53\x65\xb4\xea\x32\x71\xda\xb7\xb6\x33\xca\x3a\xab\xb0\xd3\x4a\x13\x88\xc2\xea\x1d\x6d\xc6\xf9\xf4\x10\x98\xf9\x5f\xba\xc1\x07\x70\xbc\xbd\x79\xcd\xaf\xe1\x89\x36\x45\x7b\x1c\x94\x24\xbf\xc9\x68\x14\xe5\x0a\x77\x1a\x5a\xc4\x0c\xce\xa5\xc0\xc0\xd3\xfa\x3c\xba\x6b\x3f\x40\x6b\xd0\x12\x87\x48\x5e\xe9\x90\xf5\xce\x9e\xfe\x84\x1b\x7b\x67\xe8\x23\x82\xc8\x43\x37\xec\x48\xf0\xb8\x9d\xdd\xc9\x4a\x3d\xc1\x08\xab\x88\x41\xc8\x29\x73\x3c\x34\x72\x86\xd6\xd3\x15\xbd\xe8\x20\x35\xc7\x73\x17\x10\x22\x47\x03\xd0\x11\x30\x40\xb2\x85\xec\x25\x08\x70\x14\x25\x43\x91\xf8\xb5\xe4\x19\x86\x62\x49\x24\x78\xe1\xb7\xef\xe6\x6f\x2d\x56\x86\x58\x8a\x05\x21\x27\xfb\xb0\x30\xb8\x94\x82\xd6\xa7\x2e\x98\xb8\x2c\xed\x41\xa2\x81\xbd\x64\x5d\x48\xfa\xa4\xb0\xd2\xb1\xd3\x19\xf8\xe6\x1d\x87\x6d\xd1\x2b\xac\x56\x00\x9b\xcd\xbf\x85\x8a\x56\x5c\xa6\x09\x69\x8d\x92\xd5\x69\x27\x6f\xf0\x65\x3c\x5b\x81\xb3\xe9\x06\x9c\x49\x39\x20\x67\x51\x7f\xdc\xf2\x27\x2c\x34\xa4\xca\x52\xbc\x7d\x73\x8e\x4e\xe7\x65\xdc\x21\x43\xc9\x9b\xcc\x4c\xe2\x8b\x7d\x6b\x23\x45\x07\xbb\xf1\xa5\xb4\x19\xfa\x90\xf2\x07\x47\x8c\x63\xb5\xa3\x34\x89\x3e\xb9\x7a\x94\x59\x3b\x99\xb8\xab\x02\x17\xeb\xbf\x53\x01\xa9\x38\xee\x0e\xfa\xa3\x47\xc5\xbb\xe9\x07\xf6\xd2\xa8\xe4\xbd\x34\x02\xc8\xaf\xb5\xa2\xad\x40\xc7\xd2\xbb\x8f\x47\x7e\x7c\xeb\x7e\x67\x69\x10\xf1\xb6\xdb\x96\x11\xdc\xe4\x26\x28\xcc\xee\x6f\xb7\xfd\x0e\x3e\x36\x96\xda\x94\xc5\x1b\xe3\xda\xd3\x4e\xc8\x59\x5e\x88\x8f\x1e\x6d\xd0\xa5\x13\x7c\x2c\x62\xed\xa5\x6c\x21\x42\xd4\x39\x6b\xde\x02\x0d\xb1\x5f\x8a\x0d\x97\x23\x83\xb3\xd4\xd9\x87\x04\x15\xeb\xe2\x8a\xfc\x04\x1e\xd9\x6c\xbb\xc6\x57\x45\xf0\x5c\x8a\xa8\x64\x6b\xf2\x66\xc3\x26\xbe\x5d\x55\xad\xd0\x2e\xa5\xdd\xc2\xf8\x33\xf5\x06\x61\xda\x27\xeb\x9e\xed\x65\x26\xc6\x14\x4a\x57\x48\xc4\xea\x17\x64\xc8\x16\x9a\x4c\x5d\x56\x91\xb2\x5e\x29\x6a\x23\xb3\xf0\x78\x7c\xda\x52\x44\x8d\x91\xae\x78\xc5\xdf\x1a\xc7\x1b\x0a\x01\x97\x1f\x87\xea\x0c\x56\xa9\x41\x2b\xbd\x5f\x16\xae\x4c\x06\x6d\x6b\xad\x50\x62\x50\x4d\x11\xb5\x09\x80\x95\x4b\xb9\x65\xb5\xc5\xbc\x83\x35\x1f\xb7\xc1\x0d\x23\x2b\xd1\xfa\xd8\x29\xcf\x4a\xef\x09\xc3\x58\x59\x3d\x22\xfc\x23\x47\x4d\x19\xde\x33\x56\xaf\xc1\x57\x41\x0c\x6d\x20\x2a\xe7\xcc\x83\xce\x19\x4f\xee\x88\x50\x89\xca\x58\x7a\xb5\x72\x2e\x88\x2a\xd9\x66\xc0\x79\x51\x7a\xdc\x1e\x91\xa3\xa0\xb3\x81\x93\x72\x8e\xbf\xb0\x4c\xe8\xd1\x46\xe2\x5a\xfe\xfe\xf6\x00\x8d\xae\x79\xf7\xaf\x21\x12\x68\x4d\x9c\x40\x7e\x14\x26\xf3\x6e\x70\xa9\x1d\xc4\xbb\xe9\xa5\x35\x5a\xe9\x08\xca\x60\x08\x7c\xe2\xde\xd3\x1f\x32\x7e\xca\xd5\x74\x09\x21\xa7\x9a\x29\x90\x0f\x7c\xf8\x9d\x34\x5b\x37\x0d\xd5\x1a\x39\xbb\xce\x3e\xc3\xb5\x48\x9d\x51\x2f\xd4\xa5\x63\xca\x2b\xf3\x1b\x54\x91\xcd\xde\x79\x2e\x45\xcc\x26\x43\xde\xcb\x97\x41\xba\xfb\x6e\xfe\xc8\x08\x49\xa7\xcb\x9a\x4d\xf6\xa2\xcc\xd3\xff\xff\x0d\x9a\x70\xd4\x5d\xce\xc3\x36\x12\x22\x8e\x81\x96\xd9\xa2\xe3\xda\xde\x86\x2e\x85\x4a\xdc\x02\x1e\x7d\xa2\x4b\x10\x71\x2e\xe1\x3b\x2b\x24\xf0\x9b\xe5\x8a\xa3\x05\xf6\x88\x54\x52\x5e\xbb\xc5\x80\x95\x86\x16\xcc\xfa\xd1\x6c\xf8\x6e\x42\x2f\xd8\xb4\x86\x26\xca\x35\xd7\xc3\xde\x30\xda\x0d\x4c\xfa\xd9\x13\xde\xb8\xeb\xce\x4b\xa8\x17\x43\x0e\x91\x15\x87\xca\xbb\x10\x06\x5d\x45\xa3\x9f\x08\x66\x6b\xd4\x86\x59\xe1\x12\xaa\xc4\x86\xac\x30\x05\x02\xf4\x95\x8e\x1e\xfd\x66\x10\xee\x06\xa9\xfc\x22\x19\xf8\x3c\x10\xc1\x84\x73\x84\x72\x9d\xb3\x1d\xfa\xdf\x39\x91\xc7\x4a\x1b\xb6\x83\xe8\xa0\x26\xe5\xec\xc2\x68\x25\x34\xab\x1b\xae\x7c\xd0\x8e\x34\x25\x3f\x0a\x61\x4f\xbf\x24\xed\xa9\x1e\xc3\xf8\x6a\xf7\x7a\x6b\xf7\xd5\x68\xbb\xe2\x64\xea\x75\xaa\x66\x5a\xa0\x36\xc9\xd3\xdd\x31\x32\xde\x51\xf5\x67\xdf\xec\x8c\xee\x9b\x40\xec\x16\x5d\xa9\x4f\x6b\x92\x0b\x03\x14\x0a\xf6\x94\x6f\x2b\x22\xf9\x46\x73\x38\x6e\xbd\xab\x0c\x35\x72\x95\x63\x95\x36\x25\x34\x0d\xef\xaf\xd8\x29\x65\x65\xae\x9b\x50\x73\x6a\xdf\x38\x2f\x9d\xc7\x8a\x69\xaf\xbb\xfa\x08\x49\x4b\xa0\x12\x52\x35\x6e\xb9\xe4\x87\x3c\x37\x87\x0a\x36\x9b\xd4\xb4\x99\xfd\x7f\xb4\x43\xca\xeb\xa2\x6b\xc7\x7a\x2c\xbc\xf0\x72\x47\xc1\x6b\x02\xf2\xde\xf9\x90\x4f\x81\x83\xdb\x63\x58\xa0\xa2\xf2\xdc\x13\x13\x16\xa9\x98\xab\xc2\x8a\x60\xa1\x5f\x98\x6d\x53\x74\x0d\x46\xad\xd0\x98\x0d\xb8\x35\xf9\x1c\x78\x3f\x17\x63\x2d\x69\x93\x4b\x11\x97\xc4\x56\xc6\x36\xab\x6d\x88\x84\x75\x09\x67\x94\xb7\x08\xae\xa1\xb8\xe2\x63\xb1\xe8\xc9\xd6\xd8\x90\x8d\xb2\xec\xb3\x77\x5c\x76\xe9\xb8\xda\x87\xef\x55\x4e\x9c\x61\x1b\x69\x16\x7a\x99\x7c\xcf\xa4\x83\x82\xd4\x90\xcf\x77\x64\xb2\x5b\x83\x36\xa1\xc9\x95\x7b\x77\xb9\x02\x3a\xf4\x76\x3a\x81\xb7\xd9\x7f\x33\x01\x15\x85\x76\x50\x3d\x97\x7c\x45\xdb\x35\x1a\x5d\x83\x72\x4d\xa5\x6d\xbe\x84\xe7\x09\x81\x22\x47\xe9\xbe\x25\xc4\x32\x5e\x16\x37\xef\x01\x4d\x96\x8b\x3a\xe7\xe3\xf6\xa6\x60\x28\x6b\x77\xa6\xdc\x39\x0a\x9a\x95\x3f\x50\x8e\x8e\x81\xcc\x02\x2a\x92\x1c\xc9\xeb\xa2\x82\x46\x87\x82\x02\x1f\xe3\x47\x0b\x33\xbb\xe9\xd4\xcd\xc6\x3a\xd4\xb6\x53\x2a\x79\xa8\x93\x2f\x86\xd9\x1b\x6a\x0f\xe0\x00\xeb\x6d\x63\x47\x2e\x2a\x30\x64\xf3\xef\xaf\x25\x87\x56\xdd\xdd\xbf\xa1\xad\xaf\x3b\x7b\xe5\x8c\xcb\xee\x9d\xf1\x55\x60\xcb\x68\x53\x9c\x7c\xf6\x29\xe5\x6b\xe7\xc5\x0f\x47\x92\x92\x51\x27\xee\x42\xea\xbf\xcd\x87\x43\x52\x4a\x5b\x15\xbb\x3b\xcb\x1d\xf7\x2d\xf5\x82\x18\x5f\xeb\x29\xdf\x0a\xff\x69\xfc\xf6\x3f\x3e\xfb\x27\xf4\x59\xf9\x44\xe5\xc4\xed\xe4\x82\xf3\xb8\x73\xad\x67\xac\x37\x50\x93\x75\xb1\x68\xa4\xbb\xdd\x18\xf9\x2c\x64\xdb\x5d\x5f\x61\x80\x9f\x9d\xb6\xd4\xa5\xc5\xb9\x86\x3e\x22\x7b\xe5\x9c\x21\xb4\x07\xc2\xe7\x2f\x5c\xce\xf6\xc6\xf3\xb0\xf2\x4d\x4a\xe3\x82\x7c\xc6\x41\x36\xb2\x8f\x95\xa6\x86\x3d\xf8\xde\xe6\x30\xa9\xfa\xad\x17\x6d\xe3\x79\xd6\x15\x0c\xbf\x10\x1a\xae\xb1\x97\x41\x1d\x3c\xcc\x22\x4f\xa5\x23\x99\x1f\x44\xe7\x39\x75\x1a\x3c\x49\x55\x9f\xa2\x96\x6d\xbb\x34\x1f\xfe\xfe\x8f\x8b\x6d\xc6\x9f\xab\x6d\xaa\x3f\x6c\xbf\xf3\xca\x5f\x71\x7d\x96\x2d\xa9\x7c\x8a\x25\x3f\x07\x4d\x1b\xf8\xeb\xdf\x2e\xf2\xc6\x54\x7f\x5f\xbe\xaa\xe2\x87\xff\x0c\x00\x00\xff\xff\x4f\xc6\x92\x20\x7b\x27\x00\x00")
+var _clusterApiInfrastructureClusterXK8sIo_awsmanagedmachinepoolsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x6f\x23\xb7\x11\x7f\xd7\xa7\x18\x5c\x1f\x2e\x01\xac\x75\x7b\x09\x82\x54\x40\x1f\x04\x9f\x93\x1a\x77\x3e\x18\x96\x93\x3c\x24\x41\x30\xbb\x3b\x92\x18\x73\xc9\x2d\x87\x94\xad\x14\xfd\xee\xc5\x90\xcb\xd5\xca\x5a\xc9\xbe\x6b\xd3\x97\xd4\xd2\x83\xb5\x1c\x0e\x87\xbf\xf9\xcb\xe1\x62\xab\xbe\x27\xc7\xca\x9a\x19\x60\xab\xe8\xd1\x93\x91\x5f\x5c\xdc\x7f\xcd\x85\xb2\xe7\x9b\xbf\x4c\xee\x95\xa9\x67\x70\x11\xd8\xdb\xe6\x96\xd8\x06\x57\xd1\x5b\x5a\x2a\xa3\xbc\xb2\x66\xd2\x90\xc7\x1a\x3d\xce\x26\x00\x1a\x4b\xd2\x2c\xff\x01\x54\x3a\xb0\x27\x57\x3c\x4e\x7b\x56\xa8\xdb\x35\x7e\x39\x83\xee\xbf\x2f\x26\x00\x68\x8c\xf5\x28\x8c\xf2\x34\x6b\xbc\xb3\x5a\x93\x9b\xae\xc8\x14\xf7\xa1\xa4\x32\x28\x5d\x93\x8b\xe2\x64\x61\x37\x7f\x2e\xde\x14\x7f\x9d\x00\x54\x8e\xe2\xf4\x3b\xd5\x10\x7b\x6c\xda\x19\x98\xa0\xf5\x04\xc0\x60\x43\x33\xc0\x07\x6e\xd0\xe0\x8a\xea\x06\xab\xb5\x32\xd4\x5a\xab\xb9\x50\x66\xe9\x90\xbd\x0b\x95\x0f\x8e\x8a\xa7\xc2\x4e\xb8\xa5\x4a\x04\x5a\x39\x1b\xda\x19\x3c\x47\x9e\x56\xcb\x5b\x40\x4f\x2b\xeb\x54\xfe\x3d\xcd\x58\x4c\xb1\x55\x91\x22\x41\x3a\xff\x61\x71\x9d\x44\xbb\x4e\xa2\xdd\x58\x2b\x82\x03\x68\xc5\xfe\xdd\x71\x9a\xf7\x8a\x7d\xa4\x6b\x75\x70\xa8\x8f\x6d\x32\x92\xb0\x32\xab\xa0\xd1\x1d\x21\x9a\x00\x70\x65\x5b\x9a\xc1\x07\x6c\x88\x5b\xac\xa8\x9e\x00\x74\x38\xc7\x0d\x4c\x01\xeb\x3a\xea\x1a\xf5\x8d\x53\xc6\x93\xbb\xb0\x3a\x34\x59\x63\x53\xa8\x89\x2b\xa7\x5a\x21\x99\xc1\x40\x4e\x70\x84\xf5\x16\xd8\xa3\x0f\x49\x1a\x80\x5f\xd9\x9a\x1b\xf4\xeb\x19\x14\xe9\x79\x11\x89\xba\x51\x41\x71\x06\xb7\x83\x27\x7e\x2b\xc2\xb1\x77\xca\xac\xc6\x96\xfb\x10\x9a\x92\x1c\xd8\x25\x38\x6a\xb5\xaa\xf0\xd4\x42\x7b\x04\x79\xad\xbd\x87\x69\x39\xd9\xe4\x8a\xdc\x64\x47\x36\x30\x59\x41\x6c\x4d\x4d\x34\x78\x21\xb0\x2d\x99\xf9\xcd\xd5\xf7\x5f\x2c\xf6\x1e\xc3\xbe\x9c\xa3\x8a\x04\xc5\xe0\xd7\x04\x69\x26\x2c\xad\x8b\x3f\xc7\xd5\x09\xf3\x9b\xab\x9e\x77\xeb\x6c\x4b\xce\xf7\x36\x96\xbe\x03\x77\x1e\x3c\x7d\x22\xc9\x6b\x11\x36\x51\x41\x2d\x7e\x4c\x49\x88\x4e\xe7\x54\x77\xfb\x13\x4c\xfd\x5a\xb1\x00\xeb\x88\xc9\x24\x3f\x95\xc7\x68\xc0\x96\xbf\x52\xe5\x0b\x58\x90\x93\x89\xc0\x6b\x1b\x74\x2d\xee\xbb\x21\xe7\xc1\x51\x65\x57\x46\xfd\xd6\x73\x63\xf0\x36\x2e\xa3\xd1\x13\xfb\x08\xb1\x33\xa8\x61\x83\x3a\xd0\x19\xa0\xa9\xa1\xc1\x2d\x38\x12\xbe\x10\xcc\x80\x43\x24\xe1\x02\xae\xad\x23\x71\x45\x3b\x83\xb5\xf7\x2d\xcf\xce\xcf\x57\xca\xe7\x50\x55\xd9\xa6\x09\x46\xf9\xed\x79\x8c\x21\xaa\x0c\xde\x3a\x3e\xaf\x69\x43\xfa\x9c\xd5\x6a\x8a\xae\x5a\x2b\x4f\xd1\xe3\xcf\xb1\x55\xd3\x28\xac\x91\x4d\x71\xd1\xd4\x7f\x72\x5d\x70\xe3\xd7\x7b\xe0\x1d\x58\x60\xfa\x46\x1f\x3e\x81\xb2\xf8\xaf\xe8\x17\xbb\xa9\x69\xa3\x3b\x30\xe5\x91\xe0\x71\x7b\xb9\xb8\x83\xbc\x74\x02\x3c\x61\xbb\x23\xe5\x1d\xcc\x02\x91\x32\x4b\x12\x43\x51\x0c\x4b\x67\x9b\x88\x2a\x99\xba\xb5\xca\xf8\xf8\xa3\xd2\x8a\x8c\x07\x0e\x65\xa3\xbc\xe8\xef\x1f\x81\xd8\x8b\x06\x0a\xb8\x88\x11\x17\x4a\x82\xd0\xd6\xe8\xa9\x2e\xe0\xca\xc0\x05\x36\xa4\x2f\x90\xe9\x77\x07\x59\xd0\xe4\xa9\x80\xf7\x32\x98\x87\xe9\x65\xf7\x27\x5c\x66\x9d\x0d\x0e\x06\x72\xd4\x3e\xa2\x93\x51\x1f\x5c\xb4\x54\xed\xf9\x41\x4d\xac\x9c\xd8\xad\x47\x4f\x62\xed\xa3\xd3\xf6\x56\x19\xf7\x46\xf9\xec\x42\xe7\x1d\xae\x0e\x46\x87\xe3\x37\x47\x79\x9c\x40\x67\x6c\x93\x7b\x2b\x46\x0b\x34\x60\xe3\x20\x6a\x60\xf2\xb2\x25\x2f\x43\xde\xca\xf2\xe2\x97\xf3\x1f\x16\xbd\x09\x8a\x8d\xc5\x84\x03\xe5\x36\xe2\x21\x83\xad\xb3\x1b\x55\x93\x3b\x03\x65\x7a\x99\xb3\x47\x5b\x43\x2c\x0f\xd3\x94\x9a\x96\x18\xb4\x2f\x0e\xa4\x3c\xa2\x33\xf9\x62\xa3\xee\x64\xf4\x60\x4e\xc7\x6c\x06\xf3\xf7\x6f\x7e\x79\xfc\xfa\xab\x5f\xbe\xfa\xf2\x99\xdd\x5f\x5f\x09\xa7\x3d\x85\xce\xaf\xaf\xa2\x75\x1d\xcc\x24\x13\x9a\xc3\x35\xa7\xa7\x16\x1b\x0e\xfe\xf2\xed\xcd\x77\x47\x08\xe6\xb7\xd7\x63\xb3\x4f\x68\x11\x9b\xf1\xd0\x3d\xb6\xc3\xb1\xe8\x9d\xad\x56\x36\xeb\x48\x13\x72\x1f\xd1\x0b\xb8\x5a\x82\xb1\xf9\x27\x98\x94\x32\x15\x03\x87\xb6\xd5\x8a\x6a\x81\xc9\x0c\xa3\x73\x26\xcd\x19\xe9\x5d\x28\xc9\x19\xf2\xc4\xfd\xd0\x83\xd2\x3a\x86\x11\x8e\x15\xc3\xfe\xa7\x51\xe6\x3d\x99\x95\x64\xdf\x37\x1f\x83\x42\xad\xf8\x7e\xa1\x7e\xa3\x67\x30\x78\xdb\x91\x45\x87\x57\x4b\xd5\x61\xe0\xac\xf5\x91\x05\xb0\xfa\x8d\x0e\x58\x2c\xad\x6b\xd0\xc7\xec\xfe\xc5\x31\xa9\x86\x99\x7f\xf7\x47\xf7\xfc\xc1\xd6\x14\x8b\x41\xa9\x93\x9e\x11\xef\xf2\xdd\x62\x8f\xfc\x89\x98\x52\x78\x44\x27\x94\xff\x33\x9d\x38\x96\x78\x5a\x65\x9d\x23\x6e\xad\xa9\x63\x82\x10\x0f\x53\x3c\x2c\xaa\xa2\x36\xb7\x36\x40\x6d\xcd\x6b\xdf\xb1\xde\x02\xc6\x42\x45\xf8\x1b\xc0\xec\x84\xe9\x59\xd6\x54\x2c\x95\xc5\x49\x91\xa9\x06\x6b\x7a\x61\x62\xd9\x17\x33\xf0\x50\xb4\x1c\x06\xba\x1a\x04\xa4\x70\x2e\x3e\x46\x99\xca\xb0\x47\x53\xd1\x31\xd7\x1e\x20\x76\x35\x20\x7d\x82\x96\x80\x92\x39\x8d\xbb\xf1\x09\x11\x86\x07\x92\xdf\x37\xec\xbe\x8f\x2b\x0d\x64\x4f\x4b\x8f\x79\x90\xb1\x35\x75\x71\x90\x27\x7b\x1c\x4f\x47\xc9\x1c\x84\xaf\xde\xca\x01\x60\x76\x5a\x9e\x9b\x3d\x62\x40\x47\x51\x8c\xcc\x03\xae\xde\xb2\x28\x3a\x23\xcb\x62\x7f\x42\x80\xc1\x5b\xae\x50\x8b\xf5\x45\x03\x1e\xb3\xc8\xa1\xdd\xf6\x55\x4a\xce\x18\x8a\xf7\x4c\xe6\x40\x4c\xe5\xa9\xf9\x78\xb0\x93\x26\xd0\x39\xdc\x4e\xf6\x06\xc0\x51\x63\x3d\xcd\xab\x8a\x98\x9f\x01\xe5\x76\x40\x3a\x50\xd5\xda\x3e\x64\x91\x19\x2a\x34\xe2\x2c\x18\x89\xa8\xee\xd8\xeb\xa7\xab\x9e\x4a\xfa\xf2\x49\xf5\xdc\x82\xaa\xe0\x94\xdf\x7e\x2b\x48\x8e\xd2\x3d\x91\x70\x31\x32\x6d\x20\xe9\xc3\x5a\x55\x6b\xe0\x6e\x18\xa2\x06\x38\x2a\x17\xb5\xb6\x0f\x54\x77\x72\xc3\xdc\x00\x35\xad\xdf\x26\xc4\xe2\x19\x85\xa1\xb5\xce\xc3\x9b\x37\x59\x87\x6d\x28\xb5\xaa\xba\x4a\x9c\x9e\x5a\xdb\x33\xca\x7a\x56\x61\xa7\x95\x26\x1f\xe6\xf5\x3b\xda\x8e\xc7\xd3\x43\x60\x16\x7f\xef\x88\x0f\xe0\xb8\xbc\x78\x23\xc3\x70\x4f\xdb\xac\xbd\x20\x11\x4e\xea\x9b\x84\x46\x56\x6e\x8c\x9d\x9a\x96\x3e\x81\x73\x16\x5d\x42\xa6\xf5\x75\x74\xd7\x7e\x80\x56\xa3\x21\x29\x9e\x84\x53\x71\x62\x73\x27\xcd\x75\xd4\x8d\x9d\xd5\xf4\x82\x24\x72\xdb\x91\x1d\x49\x1e\x57\xf3\xeb\xc8\xa9\x0f\x30\xe2\x91\xc9\x20\xe2\x2e\x65\x67\x71\x5c\x31\xb4\x8e\xa6\xf4\xa8\xd8\x8b\x53\x3f\x74\x09\xc1\x4b\x36\x00\xe5\x01\x19\x82\xc9\xc1\x3e\x26\x01\xc9\xa2\xa4\xc9\x93\x0c\xc7\x53\xa2\x26\x9f\x0b\x09\x61\x7c\xf9\x6e\x71\x69\xb0\xd4\x24\x52\x2c\x09\xe5\x44\x05\x4b\x8d\x2b\x41\xcc\xbb\x40\x1d\x9f\x24\xed\x41\xa1\x81\xbd\x64\x5d\x4a\xfa\xa8\xb4\xd2\x45\xa7\x67\xe0\x5b\x74\x31\x6c\x87\x5e\x8e\x6a\x19\xb0\xf9\xe2\x5b\x28\x69\x2d\xc7\xb4\x18\xb4\x46\x83\xd5\x69\x27\x6f\xf0\x71\xbc\x5a\x79\xbe\xdc\x78\xae\xe4\xe8\xaa\xa8\xdf\x8f\xfd\x09\x0b\xe5\x50\x1a\xf2\x57\x6f\xf9\x39\x8c\x33\xdd\x81\x4b\x26\x0e\x29\x32\x89\x07\xf5\xa0\x4b\x7a\xe9\x35\x11\xad\xb5\x6f\x33\xf4\x29\x65\xf2\xe2\x20\xf4\x49\x19\xe3\xc8\xc6\x53\x37\x6a\x36\x39\xba\xdb\xf1\xd3\x63\x9c\xb5\x57\x89\xdb\x92\xa5\x27\xf2\x5f\x3a\x40\x56\x92\x77\x07\xfd\xd1\xa3\xe2\x5d\xf4\x84\xbd\x34\x55\x70\x2e\x36\x02\xc8\x6d\x54\x45\x3b\x81\x8e\x95\x77\x2f\x47\x7e\x7c\xe9\x7e\xe5\xd8\x20\x12\x18\x76\x2d\x23\xb8\x48\x4d\x50\x69\x63\xed\xfa\x1d\xe2\x5d\x98\xcf\xa6\x22\xde\x58\xac\x3d\xed\x84\xd2\x76\x66\x7f\xe7\xd0\x70\x3c\x96\x4a\x27\x78\x9c\xee\x89\xd8\xef\x91\x3d\x78\xd5\x50\x8e\xfc\xf9\x58\xdb\xb3\x12\xc3\x95\xcc\x60\x0d\x75\x5d\x4c\xc9\x9d\x68\xac\x5f\x93\x2b\xe0\x6e\xad\xfa\xc6\x57\x49\xf0\x90\x0f\x51\xc1\xd4\xe4\xf4\x56\x82\xcd\x8e\x6b\xb5\x46\xb3\x8a\xed\x16\xc1\x5f\x42\xaf\x54\x82\x1e\xee\x8d\x7d\x30\x31\x13\x19\x08\x9c\xbb\x42\x51\xac\x9e\xa1\x40\xb6\x54\x24\xfd\xb5\xc4\x45\x26\x4b\xa6\x6f\xbd\x44\xe1\x31\xcc\x86\x21\x42\xfa\x3c\x53\xe1\x78\x84\xee\xa4\x1b\xc9\xb7\x21\x66\x5c\xbd\x0c\xd5\x39\xac\x43\x83\x26\xf6\x7e\x45\xb8\x3c\x19\x94\xa9\x55\x85\x31\x07\xd5\xe4\x51\x69\x06\x2c\x6d\x48\x2d\xab\x1d\xe6\x1d\xac\x69\xbb\xd2\xee\x2a\x29\x65\xeb\xe2\x53\xa5\x77\x84\x6c\xcd\x8b\x84\xbf\x93\xac\x19\xc9\xfb\x88\xd5\x6b\xf0\x35\x47\x43\x1b\x88\x0a\x6a\xaf\x73\x26\x93\xbb\x40\x58\x45\x2b\x17\xe9\xab\xb5\xb5\x1c\x6d\x43\x6c\x06\xac\x8b\x4a\xf7\xbb\x2d\x4a\x16\xb4\x86\xa5\x28\x97\xf2\x0d\x56\x01\x1d\x1a\x4f\x54\x0b\x8f\x03\x34\xba\xe6\xdd\x7f\x86\x08\xd3\x86\xa4\x2c\x7d\x11\x26\x8b\x8e\x38\x9f\x3f\x62\x43\x89\x1e\xa5\x6d\xae\x3c\x54\x1a\x99\x65\xc7\xbd\xa7\xdf\x26\xfc\x2a\x5b\xd3\x19\x70\x2a\x35\x03\x4b\xf3\xd2\xba\xbe\x12\x8b\x85\x9a\x6a\x1a\xaa\x15\x7a\xd2\xdb\xe4\x33\x72\x16\x91\x6c\x4c\xbb\xd0\xa5\x7c\x48\x9c\x65\x04\x2b\x2f\x25\x9d\x75\x72\x14\xd1\x5b\x01\x87\x76\xf2\x25\x93\xb9\xfe\x6e\x71\x27\x25\x60\xec\x74\x19\xbd\x15\xe8\x0d\xa4\x38\xfd\xb7\x6f\x50\x33\x7d\x3a\x6c\x23\x29\xe2\x18\x68\x91\x34\xc7\xda\xde\x86\xce\x62\x28\xb1\x4b\xb8\x73\xd2\xf4\x8e\xe2\x9c\xc1\x77\x26\x06\x81\x4f\x96\xcb\x8f\x1e\xb0\x47\xa4\x8a\xc7\x6b\xbb\x1c\x44\xa5\xa1\x05\x8b\x71\x2a\xe9\xf9\xd8\x82\x1e\xb1\x69\x35\x15\x95\x6d\xce\x07\x16\x7e\x8d\x66\x0b\x45\x3f\xbb\x90\x85\x53\x5f\x3b\xa5\xfa\x68\xc8\xec\x45\x71\x58\x39\xcb\xdc\x07\x7a\x06\xad\xee\x09\xe6\x1b\x54\x5a\xa2\xc2\x19\x94\x41\x0c\xb9\xc2\xc0\x04\xe8\x4a\xe5\x1d\xba\xed\x4e\xb2\xfe\x20\x16\x98\x96\x41\xc3\x67\x4c\x04\x85\xd4\x08\xf9\x3a\x67\x47\xfa\x79\x0c\x9f\x80\xa5\xd2\x62\xa7\xde\x42\x4d\x95\x35\x4b\xad\xaa\x18\x66\x55\x23\x27\x1f\x34\xfe\x13\x11\x96\x06\xba\xb4\x84\xc7\x30\x9e\x76\x56\x31\x3a\x34\xda\xae\x38\x5a\x81\x9c\x2e\x5b\x00\x96\xa8\x74\x70\x74\x7d\x2c\x18\xef\xa9\xfa\xd5\x37\x7b\xd4\x7d\x13\x48\xdc\xa2\x3b\xea\xd3\x46\x14\x15\x73\x91\xc4\xa6\x58\x90\x23\x78\x72\x8d\x92\x74\xdc\x3a\x5b\x6a\x6a\xe2\x55\x8e\xa9\x94\xce\xa9\x69\x50\xc3\xc4\x32\x3f\x72\x96\x73\x13\x4a\x6f\x18\x1a\xb9\xa4\xd9\x90\x2b\x25\xec\x75\x57\x1f\x1c\x54\x4c\x54\x31\xa8\x6a\xbb\x5a\x09\x2f\x99\x9b\x52\x85\x98\x4d\x68\xa2\xe0\x05\xfc\x64\x86\x21\xaf\xcb\xae\x5d\xd4\x13\xe1\x85\x45\x0e\xc1\x1b\x02\x72\xce\x3a\x69\xff\xa1\x07\x1c\xdc\x1e\xc3\x12\xc5\xea\xd2\x73\x47\x40\x8f\x2d\x55\x3e\x9d\x0a\x4b\x82\xa5\x7a\x94\x68\x1b\xbc\x6d\xd0\xab\x0a\xb5\xde\x82\xdd\xc8\xb5\x8a\x24\xde\xcf\xa2\xb1\xe6\xb2\xc9\x06\x8f\x2b\xe2\xcf\x93\xcd\x4a\xc7\x84\xb0\xce\xe9\x4c\x8a\x07\xf4\xc0\xb6\x21\xbf\x96\x6d\x49\xb4\x0e\xa6\xc6\x46\x2e\xcc\x84\xed\x83\xb3\x72\xec\x52\x7e\xfd\x14\xbe\xd7\xa9\x70\x86\x5d\xa6\x59\xaa\x55\x70\x7d\x24\xed\x1e\x76\x3b\x4a\x77\x64\x71\xb5\x06\x4d\x40\x9d\x4e\xee\xdd\xe5\x8a\x28\x2f\xdb\x69\x01\x97\xc9\x7f\x63\x8b\xa7\x57\x68\x07\xd5\x43\xae\x57\x94\xd9\xa0\x56\x35\x54\xb6\x29\x95\x89\xab\xc6\x09\x4c\x5e\xb2\x74\xdf\x12\x12\x19\xcf\xb2\x9b\xf7\x80\x06\x23\xdd\x63\xeb\xfc\xee\xa6\x60\x28\x6b\xb7\xa7\xd4\x39\x62\x55\xea\xe1\x30\x28\xcf\xa4\x97\x50\x92\x20\x56\x39\x95\x55\xd0\x28\xce\x28\xc8\x36\x7e\x32\x30\x37\xdb\x4e\xdd\x62\xac\x43\x6d\xdb\xaa\x0a\x0e\xea\xe0\xb2\x61\xf6\x86\xda\x03\x38\xc0\x7a\xd7\xd8\x89\x17\x15\xc8\xc9\xfc\xfb\x6b\xc9\x01\x69\xe7\x99\x02\xf7\x79\x67\xaf\x52\x71\x99\x27\x7b\x7c\xcd\x60\x83\x6f\x83\x2f\x5e\x4d\x3e\x22\xa0\x74\x5e\x7c\x7b\xa4\x28\x19\x75\xe2\x2e\xa5\xfe\xcf\x7c\x98\x43\x55\x29\x53\xf9\xee\xce\x72\xcf\x7d\xf3\x79\x21\x1a\x5f\xeb\xc8\xe3\x1f\xca\x6f\xff\xef\xb3\x7f\x40\x9f\x95\xb3\xcc\xf6\xc4\xed\xe4\x52\xea\xb8\xd3\xae\x7c\x2b\x2c\xa0\x26\x63\x7d\xd6\x48\x77\xbb\x71\xd8\x1a\x18\x74\xd7\xd7\xc8\xf0\xab\x55\x86\xba\xb2\x38\x9d\xa1\x0f\x96\x4a\xb2\x97\xd6\x6a\x42\xf3\x64\x34\xbf\xf6\xf2\x4c\xac\xc9\x2f\xc2\xe4\x77\x52\x1a\xcb\x5e\xd4\x43\xc6\xeb\xed\xae\xa9\x61\x0e\xde\xb7\x29\x26\xc7\xce\x9d\xe3\xad\xa9\xe3\x6d\xa9\xec\x0b\xfb\x92\x4e\x61\xf8\x86\xd0\x89\x0a\xea\xe0\xa1\x04\x02\xaa\x67\xb1\x23\x99\x1e\x78\xeb\xe4\x1c\x3b\x78\x12\xca\xbe\x44\x9d\x4d\xf6\x3a\x41\xf0\xcf\x7f\x4d\x76\x4d\xa1\x74\xda\xa6\xfa\xc3\xee\x3d\x2f\x79\x89\x61\x06\xaf\x5e\xed\xbd\x8a\x15\x7f\xee\x4a\xd3\x19\xfc\xf8\xb3\xbc\x63\xe5\xad\xa3\xba\xbb\xb7\xe5\x19\xfc\xf8\xf3\xe4\xdf\x03\x00\x4f\xc6\x92\x20\x7b\x27\x00\x00")
 
 func clusterApiInfrastructureClusterXK8sIo_awsmanagedmachinepoolsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -303,7 +304,7 @@ func clusterApiInfrastructureClusterXK8sIo_awsmanagedmachinepoolsYaml() (*asset,
 	return a, nil
 }
 
-var _hypershiftOperatorHypershiftOpenshiftIo_externalinfraclustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x6f\x23\x37\x0f\xbe\xfb\x57\x10\xfb\x1e\xf2\x16\x88\xc7\xbb\xdd\x4b\xe1\xdb\xc2\x1b\xa0\x41\x3f\x36\x48\x82\x5c\x8a\x1e\x64\x0d\x3d\xc3\x46\x23\xa9\x14\xe5\x34\x2d\xfa\xdf\x0b\x4a\xe3\xaf\xd8\x0e\xd2\xa2\x9d\xd3\x88\x43\x51\x0f\x1f\x92\xcf\xc8\x44\x7a\x40\x4e\x14\xfc\x1c\x4c\x24\xfc\x4d\xd0\xeb\x2a\x35\x8f\xdf\xa4\x86\xc2\x6c\xfd\x61\xf2\x48\xbe\x9d\xc3\x22\x27\x09\xc3\x2d\xa6\x90\xd9\xe2\x67\x5c\x91\x27\xa1\xe0\x27\x03\x8a\x69\x8d\x98\xf9\x04\xc0\x78\x1f\xc4\xa8\x39\xe9\x12\xc0\x06\x2f\x1c\x9c\x43\x9e\x76\xe8\x9b\xc7\xbc\xc4\x65\x26\xd7\x22\x97\xe0\x9b\xa3\xd7\xef\x9b\x8f\xcd\xfb\x09\x80\x65\x2c\xdb\xef\x69\xc0\x24\x66\x88\x73\xf0\xd9\xb9\x09\x80\x37\x03\xce\x41\x01\xb2\x37\x8e\xfc\x8a\x8d\x75\x39\x09\x72\x6a\xfa\xe7\x88\x9c\x7a\x5a\x49\x13\x22\xfa\xfa\x46\x61\x92\x22\x5a\xc5\xd1\x71\xc8\x71\x0e\xe7\xdc\x6a\xf0\x0d\x62\x23\xd8\x05\xa6\xcd\x7a\x0a\xe3\x31\x53\x13\xa9\x58\x2a\x1f\x57\x23\x92\x6b\x45\xb2\xa8\x2e\xe5\xb3\xa3\x24\xdf\x9d\x75\xf9\x9e\x92\x14\xb7\xe8\x32\x1b\x77\x26\xa3\xe2\x91\xfa\xc0\xf2\xe3\x0e\xd9\x14\x90\xec\xee\x6d\x74\x22\xdf\x65\x67\xf8\x74\xa0\x09\x40\xb2\x21\xe2\x1c\x4a\x9c\x68\x2c\xb6\x13\x80\x91\xf7\x12\x77\x3a\x32\xbb\xfe\x60\x5c\xec\xcd\x87\x1a\xd5\xf6\x38\x98\x7a\x2c\x80\x92\xf5\xe9\xe6\xfa\xe1\xe3\xdd\x81\x19\xa0\xc5\x64\x99\xa2\x94\x1a\x9e\xca\x16\x28\x81\xf4\x08\x75\x23\xac\x02\x97\xe5\x49\xd7\x4f\x37\xd7\xdb\xc0\x91\x43\x44\x96\x6d\x11\xea\xb3\xd7\xac\x7b\xd6\x17\x30\x2e\x14\x69\xf5\x82\x56\xbb\x14\x2b\x84\x31\x67\x6c\xc7\xe4\x20\xac\x40\x7a\x4a\xc0\x18\x19\x13\xfa\xda\xb7\x6a\x36\x1e\xc2\xf2\x17\xb4\xd2\xc0\x1d\xb2\x6e\xd4\x5a\x64\xd7\x6a\x3b\xaf\x91\x05\x18\x6d\xe8\x3c\xfd\xbe\x8d\x96\x40\x42\x39\xc6\x19\xc1\x24\x40\xbe\x66\x08\x6b\xe3\x32\x5e\x82\xf1\x2d\x0c\xe6\x19\x18\x35\x2e\x64\xbf\x17\xa1\xb8\xa4\x06\x7e\x08\x8c\x40\x7e\x15\xe6\xd0\x8b\xc4\x34\x9f\xcd\x3a\x92\xcd\x20\xda\x30\x0c\xd9\x93\x3c\xcf\xca\x4c\xd1\x32\x4b\xe0\x34\x6b\x71\x8d\x6e\x96\xa8\x9b\x1a\xb6\x3d\x09\x5a\xc9\x8c\x33\x13\x69\x5a\xc0\xfa\x32\x8c\xcd\xd0\xfe\x8f\xc7\xd1\x4d\x17\x07\xe4\xc9\xb3\xb6\x47\x12\x26\xdf\xed\x7d\x28\x4d\xfe\x0a\xcb\xda\xe1\x5a\x5d\x33\x6e\xad\x59\xec\xc8\x54\x93\xf2\x71\x7b\x75\x77\x0f\x9b\xa3\x2b\xe1\x95\xdb\x9d\x6b\xda\xd1\xac\x14\x91\x5f\x21\x57\xcf\x15\x87\xa1\x44\x41\xdf\xc6\x40\x5e\xca\xc2\x3a\x42\x2f\x90\xf2\x72\x20\xd1\xfa\xfd\x9a\x31\x89\x56\xa0\x81\x45\x51\x20\x58\x22\xe4\xd8\x1a\xc1\xb6\x81\x6b\x0f\x0b\x33\xa0\x5b\x98\x84\xff\x39\xc9\xca\x66\x9a\x2a\x79\x6f\xa3\x79\x5f\x3c\x5f\x3a\x57\x9e\xf6\x3e\x6c\xe4\xec\x4c\x4d\x4e\x4d\xd5\x5d\x44\x7b\x30\x06\x2d\x26\x62\x6d\x5b\x31\x82\xda\xec\x67\x75\xec\xf5\x59\xac\xe2\x3e\xc4\x2c\x78\x8b\xd1\x91\x35\x47\x9f\x37\x79\xe8\x2c\x74\x2f\x82\x6e\xff\x0c\x37\xce\x78\xbc\x1a\xcb\x7b\x1c\xe1\x20\xc1\xc5\x89\x2d\x7b\x6d\x74\xd8\x29\x39\x61\xab\x43\x39\x96\x54\x65\x1d\x9e\x48\xfa\xda\x42\x35\x12\x44\x0d\xd5\x1c\x9d\x7a\x3e\x65\x7d\xfa\x90\x4e\x20\x3d\x42\xfb\x6d\x50\x1d\xa8\xa8\x74\x8b\xca\x2c\x04\x0f\x4f\x3d\xd9\x8a\xe2\xd3\xcd\x35\xa4\xd2\xf9\xea\xa7\x6f\xe4\xbb\x63\x34\x70\xbe\x7d\xb6\x80\x03\xbf\x05\xd2\x4d\xe0\x2d\x24\xdd\xf2\x8f\xe1\xac\x02\x0f\x46\x4a\x69\x3f\x7e\xfd\x0a\xe0\xd3\xa5\x87\x32\xb3\xda\x86\xc7\x98\xa7\x85\xab\x13\x66\xc5\x7b\xa6\xc1\x8e\x06\xa5\x1e\xd1\x1d\xfd\x28\x8e\x08\xb9\xb8\xff\xf2\xf9\x0b\xfc\xdf\xb8\x25\xb2\x84\xaf\xe6\x10\x43\xcc\x2a\xe1\x5b\x42\x54\xb8\xcb\xa5\x04\x55\x12\x52\x1e\x8a\x7a\xb0\x49\xc2\xb9\xa8\x00\xac\xc9\x80\x79\x4a\x90\xda\x47\xe0\xe0\x50\xbb\x67\x45\x0e\x2f\x21\x75\x97\xb0\x8e\xf6\x52\xa5\xca\xa3\xa4\xe6\xe2\x4c\x02\x27\xea\x7a\x9a\xa1\xe9\x98\xd6\x9b\xd4\x42\x8c\xe4\xf4\x77\xf5\xa2\x6c\x3a\x50\x8c\xb0\x2c\x4d\xf1\xef\x48\x06\xa3\x69\x9f\xcf\x09\xc5\x32\x04\x87\xe6\x0d\xc9\x1d\x19\x2b\xc2\x39\x08\x67\xac\x06\x09\x6c\x3a\xdc\xb7\xe4\xe5\xf6\x0f\xb8\x01\x30\x52\x04\x7f\xfc\x39\xd9\xb1\x65\xac\xc5\x28\xd8\xee\xdd\xbc\xea\x8d\xef\xdd\xbb\x83\x8b\x5b\x59\xda\xe0\x5b\xaa\xf7\x5d\xf8\xe9\xe7\x49\x3d\x18\xdb\x87\xcd\x05\x4b\x8d\x7f\x05\x00\x00\xff\xff\xef\xf4\xa0\x83\x64\x0b\x00\x00")
+var _hypershiftOperatorHypershiftOpenshiftIo_externalinfraclustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x8f\xdc\x36\x0f\xbe\xfb\x57\x10\x79\x0f\xfb\x16\xd8\xf1\x24\xcd\xa5\xf0\x2d\x98\x04\xe8\xa2\x1f\x59\x64\x83\x5c\x82\x1c\x34\x32\xc7\x56\x57\x96\x54\x92\x9a\x74\x5b\xf4\xbf\x17\x94\x3d\x5f\x3b\x9e\xed\xa2\x68\xc7\x73\xb0\x28\x92\x7a\xf8\xf0\xc3\x32\xc9\x7d\x42\x62\x17\x43\x03\x26\x39\xfc\x4d\x30\xe8\x8a\xeb\xfb\xef\xb8\x76\x71\xb9\x7d\x55\xdd\xbb\xd0\x36\xb0\xca\x2c\x71\xf8\x80\x1c\x33\x59\x7c\x8b\x1b\x17\x9c\xb8\x18\xaa\x01\xc5\xb4\x46\x4c\x53\x01\x98\x10\xa2\x18\x15\xb3\x2e\x01\x6c\x0c\x42\xd1\x7b\xa4\x45\x87\xa1\xbe\xcf\x6b\x5c\x67\xe7\x5b\xa4\xe2\x7c\x77\xf4\xf6\x65\xfd\xba\x7e\x59\x01\x58\xc2\x62\xfe\xd1\x0d\xc8\x62\x86\xd4\x40\xc8\xde\x57\x00\xc1\x0c\xd8\x80\x02\xa4\x60\xbc\x0b\x1b\x32\xd6\x67\x16\x24\xae\xfb\x87\x84\xc4\xbd\xdb\x48\x1d\x13\x86\xf1\xcd\xc5\x8a\x13\x5a\xc5\xd1\x51\xcc\xa9\x81\x4b\x6a\xa3\xf3\x1d\x62\x23\xd8\x45\x72\xbb\xf5\x02\xa6\x63\x16\x26\xb9\xa2\x31\xf2\xf1\x6e\x42\x72\xa3\x48\x56\xa3\x4a\xd9\xf6\x8e\xe5\x87\x8b\x2a\x3f\x3a\x96\xa2\x96\x7c\x26\xe3\x2f\x44\x54\x34\xb8\x8f\x24\x3f\x1f\x90\x2d\x00\x9d\x3d\xbc\x71\x79\x65\x17\xba\xec\x0d\xcd\x3b\xaa\x00\xd8\xc6\x84\x0d\x14\x3f\xc9\x58\x6c\x2b\x80\x89\xf7\x12\xe1\x62\x62\x76\xfb\xca\xf8\xd4\x9b\x57\xa3\x57\xdb\xe3\x50\x32\xaa\x2b\xe5\xf4\xcd\xed\xcd\xa7\xd7\x77\x27\x62\x80\x16\xd9\x92\x4b\x9a\xb0\xf9\x68\xc1\x31\x48\x8f\x30\x1a\xc2\x26\x52\x59\xce\xaa\xbe\xb9\xbd\xd9\x3b\x4e\x14\x13\x92\xec\x93\x30\xfe\x8f\x8a\xf5\x48\xfa\x08\xc6\x95\x22\x1d\xb5\xa0\xd5\x2a\xc5\x11\xc2\x14\x33\xb6\x53\x70\x10\x37\x20\xbd\x63\x20\x4c\x84\x8c\x61\xac\x5b\x15\x9b\x00\x71\xfd\x0b\x5a\xa9\xe1\x0e\x49\x0d\x81\xfb\x98\x7d\xab\xe5\xbc\x45\x12\x20\xb4\xb1\x0b\xee\xf7\xbd\x37\x06\x89\xe5\x18\x6f\x04\x59\xc0\x85\x31\x42\xd8\x1a\x9f\xf1\x1a\x4c\x68\x61\x30\x0f\x40\xa8\x7e\x21\x87\x23\x0f\x45\x85\x6b\xf8\x29\x12\x82\x0b\x9b\xd8\x40\x2f\x92\xb8\x59\x2e\x3b\x27\xbb\x46\xb4\x71\x18\x72\x70\xf2\xb0\x2c\x3d\xe5\xd6\x59\x22\xf1\xb2\xc5\x2d\xfa\x25\xbb\x6e\x61\xc8\xf6\x4e\xd0\x4a\x26\x5c\x9a\xe4\x16\x05\x6c\xd0\xa0\xb8\x1e\xda\xff\xd1\xd4\xba\x7c\x75\x42\x9e\x3c\x68\x79\xb0\x90\x0b\xdd\xd1\x46\x29\xf2\x27\x58\xd6\x0a\xd7\xec\x9a\xc9\x74\x0c\xf4\x40\xa6\x8a\x94\x8f\x0f\xef\xee\x3e\xc2\xee\xe8\x91\xf0\x91\xdb\x83\x2a\x1f\x68\x56\x8a\x5c\xd8\xa0\x96\x89\x63\xd8\x50\x1c\x0a\xab\x18\xda\x14\x5d\x90\xb2\xb0\xde\x61\x10\xe0\xbc\x1e\x9c\x68\xfe\x7e\xcd\xc8\xa2\x19\xa8\x61\x55\x26\x10\xac\x11\x72\x6a\x8d\x60\x5b\xc3\x4d\x80\x95\x19\xd0\xaf\x0c\xe3\x7f\x4e\xb2\xb2\xc9\x0b\x25\xef\x79\x34\x1f\x0f\xcf\xc3\x4f\xbd\x34\x13\x4f\x47\x1b\xbb\x71\x76\x21\x27\x73\x5d\x75\x97\xd0\x9e\xb4\x41\x8b\xec\x48\xcb\x56\x8c\xa0\x16\xfb\x9c\xd5\xc9\x19\xf3\xbd\xa8\x8f\x8d\x43\xca\x82\x1f\x30\x79\x67\xcd\xd9\xf6\x2e\x0e\xed\x85\xee\x91\xd3\xfd\x97\xe1\xd6\x9b\x80\xef\xa6\xf4\x9e\x7b\x38\x09\x70\x35\x63\x72\x54\x46\xa7\x95\x92\x19\x5b\x6d\xca\x29\xa5\x56\xc3\xfd\xea\xa4\x2f\x5a\xd3\xe1\x90\xf4\xf4\xfa\xec\xd4\xcb\x21\xeb\xd3\x47\x96\x39\xf9\x23\xb4\xdf\x47\x9d\x03\xe3\xf0\x51\x13\x1d\xb3\x10\x03\x7c\xed\x9d\x1d\x51\xbc\xb9\xbd\x01\x2e\x03\x46\xf5\xf4\xcd\x85\xee\x1c\xcd\x13\xe5\xb3\x7b\x52\xa4\xe7\x40\xba\x8d\xb4\x87\xa4\x26\xff\x18\xce\x26\xd2\x60\xa4\xa4\xf6\xf5\xb7\xb3\x1a\x4f\xa5\x1e\x4a\xcf\x6a\x19\x9e\x63\x5e\x14\xae\x66\xc4\x8a\xf7\x4c\x7c\xa1\x51\xf4\x4f\xd8\x9d\x7d\x28\xce\x08\xb9\xfa\xf8\xfe\xed\x7b\xf8\xbf\xf1\x6b\x24\x89\xdf\x34\x90\x62\xca\x3a\xc2\xf7\x84\xe8\xe0\x2e\x97\x12\xd4\xb9\xcb\x79\x28\x23\x9a\x0c\x0b\xe5\x32\x05\x60\xeb\x0c\x98\xaf\x0c\xdc\xde\x03\x45\x8f\x90\x28\x6e\x9c\xc7\x6b\xe0\xee\x1a\xb6\xc9\x5e\xeb\xa8\x0a\x28\x5c\x5f\x5d\x08\x60\x26\xaf\xf3\x0c\x2d\xa6\xb0\xaa\x67\x90\xc0\x62\x24\x3f\x2a\xe0\xbf\x9f\x17\xc5\xe8\x64\x62\xc4\xb5\x96\xc2\xbf\x34\x32\x08\x4d\xfb\xd0\x5c\xa0\x61\x1d\xa3\x47\xf3\x8c\xe0\xce\x84\x23\xc2\x06\x84\x32\x8e\x02\x89\x64\x3a\x3c\x96\xe4\xf5\xfe\x0b\xd8\x54\x27\x14\xc1\x1f\x7f\x56\x07\xb6\x8c\xb5\x98\x04\xdb\xa3\x9b\x97\xce\xf3\x06\x5e\xbc\x38\xb9\xb8\x95\xa5\x8d\xa1\x2d\xd7\x60\x6e\xe0\xf3\x17\xbd\x6e\x49\x24\x6c\xa7\x0b\x08\x37\xf0\xf9\x4b\xf5\xd7\x00\xef\xf4\xa0\x83\x64\x0b\x00\x00")
 
 func hypershiftOperatorHypershiftOpenshiftIo_externalinfraclustersYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -323,7 +324,27 @@ func hypershiftOperatorHypershiftOpenshiftIo_externalinfraclustersYaml() (*asset
 	return a, nil
 }
 
-var _hypershiftOperatorHypershiftOpenshiftIo_hostedclustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x4d\x8f\x1b\xb9\xd1\xbe\xcf\xaf\x28\x78\x0f\xde\x17\x18\x49\x6b\xec\x8b\x20\xd0\x25\x30\xc6\x41\x76\xe2\x8d\xc7\x98\x71\x7c\x09\x72\x28\x75\x57\xab\xb9\xc3\x26\x7b\xc9\xa2\xb4\x4a\x90\xff\x1e\x14\x3f\xa4\xee\xd6\xc7\xcc\xc4\xce\x25\xf0\x5c\x6c\x91\xc5\x22\xf9\x54\xd5\x53\x55\x6c\xec\xd5\x67\x72\x5e\x59\xb3\x04\xec\x15\xfd\xc6\x64\xe4\x97\x9f\x3f\xfe\xde\xcf\x95\x5d\x6c\xde\x5c\x3d\x2a\x53\x2f\xe1\x26\x78\xb6\xdd\x3d\x79\x1b\x5c\x45\xef\xa8\x51\x46\xb1\xb2\xe6\xaa\x23\xc6\x1a\x19\x97\x57\x00\x68\x8c\x65\x94\x61\x2f\x3f\x01\x2a\x6b\xd8\x59\xad\xc9\xcd\xd6\x64\xe6\x8f\x61\x45\xab\xa0\x74\x4d\x2e\x2a\x2f\x5b\x6f\x7e\x98\xff\x38\xff\xe1\x0a\xa0\x72\x14\x97\x7f\x52\x1d\x79\xc6\xae\x5f\x82\x09\x5a\x5f\x01\x18\xec\x68\x09\xad\xf5\x4c\x75\xa5\x83\x67\x72\x7e\xde\xee\x7a\x72\xbe\x55\x0d\xcf\x6d\x4f\x26\xfd\x4f\xd9\x2b\xdf\x53\x25\x07\x58\x3b\x1b\xfa\x25\x9c\x13\x4b\x5a\xf3\x51\xd3\x35\x7f\x8a\x1b\xdc\xa4\x0d\xe2\xb8\x56\x9e\xdf\x1f\xcf\xfd\xac\x3c\xc7\xf9\x5e\x07\x87\x7a\x7a\xb4\x38\xe5\x5b\xeb\xf8\xc3\x61\x8b\x19\xb4\xd5\xfe\x3f\x59\x44\x99\x75\xd0\xe8\x26\xeb\xaf\x00\x7c\x65\x7b\x5a\x42\x5c\xde\x63\x45\xf5\x15\x40\x06\x2c\xaa\x9b\x65\x48\x36\x6f\x50\xf7\x2d\xbe\x49\xea\xaa\x96\x3a\x4c\xbb\x01\xc8\x65\xdf\x7e\xbc\xfd\xfc\xe3\xc3\x68\x18\xa0\x26\x5f\x39\xd5\x73\x04\x7f\x74\x2d\x50\x1e\xb8\x25\x48\x2b\xa0\xb1\x2e\xfe\x1c\x5f\x0e\xde\x7e\xbc\xdd\xeb\xea\x9d\xed\xc9\xb1\x2a\x97\x4c\x7f\x03\xc7\x1a\x8c\x4e\x76\x7e\x2d\x87\x4b\x52\x50\x8b\x47\x51\xda\x3c\x5f\x93\xea\x7c\x1f\xb0\x0d\x70\xab\x3c\x38\xea\x1d\x79\x32\xc9\xc7\x64\x18\x0d\xd8\xd5\x2f\x54\xf1\x1c\x1e\xc8\xc9\x42\x41\x3d\xe8\x5a\x5c\x6f\x43\x8e\xc1\x51\x65\xd7\x46\xfd\x63\xaf\xcd\x03\xdb\xb8\x8d\x46\x26\xcf\xa0\x0c\x93\x33\xa8\x61\x83\x3a\xd0\x35\xa0\xa9\xa1\xc3\x1d\x38\x12\xbd\x10\xcc\x40\x43\x14\xf1\x73\xf8\x8b\x75\x04\xca\x34\x76\x09\x2d\x73\xef\x97\x8b\xc5\x5a\x71\x09\x9a\xca\x76\x5d\x30\x8a\x77\x8b\xe8\xff\x6a\x15\xd8\x3a\xbf\xa8\x69\x43\x7a\xe1\xd5\x7a\x86\xae\x6a\x15\x53\xc5\xc1\xd1\x02\x7b\x35\x8b\x87\x35\x31\x70\xe6\x5d\xfd\x9d\xcb\x61\xe6\x5f\x8f\xc0\xe3\x9d\x78\x84\x67\xa7\xcc\x7a\x30\x11\x3d\xf7\x02\xca\xe2\xbd\x62\x57\xcc\x4b\xd3\x2d\x0e\x60\xca\x90\xe0\x71\xff\xc7\x87\x4f\x50\xb6\x4e\x80\x27\x6c\x0f\xa2\xfe\x00\xb3\x40\xa4\x4c\x43\x2e\x49\x36\xce\x76\x51\x0b\x99\xba\xb7\xca\x70\xfc\x51\x69\x45\x86\xc1\x87\x55\xa7\x58\xec\xf7\x6b\x20\xcf\x62\x81\x39\xdc\x44\xb6\x80\x15\x41\xe8\x6b\x64\xaa\xe7\x70\x6b\xe0\x06\x3b\xd2\x37\xe8\xe9\xbf\x0e\xb2\xa0\xe9\x67\x02\xde\xf3\x60\x1e\x12\xdd\x54\x38\xe1\x34\x98\x28\x0c\x74\xc6\x26\xa3\x98\x7b\xe8\xa9\x1a\xf9\x7f\x4d\x5e\x39\xf1\x57\x46\x26\xf1\xf2\x63\x56\xba\x1c\x7d\xf2\x17\xf9\x19\xf5\x8d\xed\xfa\xc0\x74\x4f\xbd\x56\x15\x1e\x49\x95\x0b\x48\x10\xac\x27\xba\x01\x7a\x5b\xdf\xdc\xbe\xbb\x3f\xb7\xe8\x08\xa2\x7c\xa2\x8d\xaa\xc9\xdd\x38\xaa\x4f\x6c\x37\x82\xe1\x67\x5b\xa1\xbe\x8b\xd8\xdd\x53\x43\x8e\x4c\x45\x31\x67\xa0\x32\x1e\xc8\xd8\xb0\x6e\xa3\x07\xb8\x2e\x45\x3c\x5b\xd0\xc4\xb0\xb3\x01\xb4\xad\x04\x1d\xc1\xcb\x95\xb5\x75\x71\x58\x65\xbc\xaa\xd3\xa4\xc7\x8e\x12\xcf\x0b\x8b\xce\x8f\x0e\x74\x1e\x41\xf9\x8b\x1c\x7b\x62\x7c\x1a\x63\x42\xd3\x89\xa6\xf6\xc7\xe1\x93\x1e\x2c\x29\xd0\x19\x62\x8a\x4e\x5c\xdb\xca\x8b\xff\x56\xd4\xb3\x5f\xd8\x0d\xb9\x8d\xa2\xed\x62\x6b\xdd\xa3\x32\xeb\xd9\x56\x71\x3b\x4b\x57\xf2\x8b\x78\x87\xc5\x77\xf1\x1f\xf8\x74\xf7\xee\x6e\x09\x6f\xeb\x1a\x2c\xb7\xe4\x20\x78\x6a\x82\x86\x46\x91\xae\xfd\x7c\x40\xbf\xd7\x91\x1e\xae\x21\xa8\xfa\x0f\xaf\x4f\x5e\xe4\x82\x2d\xcf\xba\x77\x04\x2e\x68\xfd\x40\x95\x23\x7e\xc2\xca\x1f\xf7\x82\x89\x85\x64\x21\xf8\x3c\x60\x44\x33\xd5\xe2\x80\x89\x95\xb3\xf9\xc9\x81\x0b\x86\x55\x82\x75\x2d\xb4\x01\x02\x8b\xa4\x7d\xb8\xe5\xc2\xf2\x2d\x6e\x48\x92\xc0\xab\x79\x6d\xab\x47\x72\x95\x35\x8d\x5a\xff\xe2\xad\x79\x05\x8f\xb4\x2b\xda\x0a\xc5\x0d\xb7\xfe\xf3\xc3\xdd\x87\x6f\xee\xf0\xd5\xdc\xc1\x91\x26\xf4\x27\xd0\x19\x21\x73\x9f\xa4\x22\x3f\xaa\x46\x65\xc2\xcb\x6b\x41\x75\xb8\x26\x09\xf2\xe0\x29\xd7\x1e\xca\x5f\x60\xbf\xa7\x0d\x16\x35\x3e\xc3\x62\xb7\x71\x67\x75\xea\x38\xe2\x33\x72\xdc\x7d\x31\x94\x8b\x5a\xe8\x35\x1a\x7a\x39\x86\x92\x04\x85\xde\x8f\x4f\x35\x4b\x3b\xbe\x04\x75\x2f\x2e\x52\xd1\x8b\x59\xda\xfb\xf6\x3d\xed\xbe\xd1\xf3\xff\x6a\x3c\x9e\x76\xb2\xd9\x99\xaa\x60\x22\x94\xd3\xfe\x74\x74\x98\xd8\xa7\x73\x7b\x8a\x9f\x4c\xe4\x50\x9a\x8c\x0e\xbc\x76\x3a\x13\xfd\xf2\x59\x15\x16\x23\x07\xff\xec\x1a\x2b\x4a\x8f\xaa\x2c\xbb\x92\x63\x7c\x69\x99\x25\x0e\x93\x92\xce\x13\xc1\xf4\x3e\xac\xe8\x26\x0a\xa6\x2c\xb8\x8f\x8a\xd2\x8e\xe4\xb4\x34\xc9\x58\x35\x35\x18\x34\x0f\xf6\x39\xf0\x50\x3a\xe8\xb7\x98\x39\xfa\xfb\xcf\x73\x18\xd6\x27\x48\x31\xad\x58\x59\xab\x09\xcd\x64\x76\x73\xaa\xcb\x3d\x02\xb3\x74\xb9\x39\xc1\x24\xdf\x3d\x80\x9b\xd2\x4d\x56\x05\xd8\xf7\x5a\x51\x5d\xfc\x62\xe4\x95\x2f\x35\x76\x6e\x25\x9e\x61\xef\xd2\x74\xa8\x51\x0f\x0e\xdc\x22\x0f\xbd\x0d\x62\x1b\x2e\xf6\x55\x3a\xfa\xa8\xdd\xa2\x13\xfb\xdc\x36\x53\x31\xe9\xee\x76\xc4\xd0\x04\xad\x77\x85\x79\x62\x1b\x5d\xf6\xda\x2a\xad\xa5\x01\xf4\xc4\x20\x8e\x11\x35\x0c\x13\x0a\x6e\x50\x69\x5c\x69\xba\x86\x6d\xab\xaa\x36\x76\x9d\xab\x58\xf1\xa5\xe4\x6c\x1d\x20\x30\xae\x8f\x71\x79\x1a\x1b\xb8\x5c\x1f\x1c\x21\xf4\x75\x6b\x04\x78\xca\x4f\xe1\x62\xad\x00\x17\xea\x05\xb8\xec\xe5\xf2\xd7\x2a\xcf\xd6\x9d\xf0\xf5\xa3\x5b\x67\xc9\x43\xde\xc7\xf8\x24\x56\x9c\xb7\xb3\x3e\xbe\xb1\x48\x9b\x5f\x9e\xa7\xa6\x1e\x5c\x88\x0a\x3e\x49\x39\x97\x5e\x1f\xb2\x21\xa9\xeb\x79\x07\x75\x88\xef\x12\xc5\x77\x3c\xa3\xe3\xd0\xa7\xa7\x18\x6e\xc9\xec\x1d\x25\xbf\x14\xc0\x56\x06\x11\x0c\x6d\xf3\x90\x98\x65\x45\xa2\x24\xef\x2d\x9b\x91\x08\x48\xe3\x70\x90\x69\x94\x8b\x4f\x3e\xe9\x01\x48\xee\x21\x7b\xa8\xd8\x99\x58\x57\x93\x78\xe5\x6a\x97\x2e\x54\xed\xe6\xf0\xd7\xb8\xd2\x97\x15\x05\x8c\xd8\x76\xa4\xbc\x21\xa9\x54\x53\x6c\x61\x72\x38\x5b\xad\x6d\x10\x22\x2f\x13\x33\x99\x42\x53\x8e\xb1\x45\x0f\x0d\xa6\xf0\xb1\x0e\x5a\xd4\xcd\x16\x77\x07\xd0\xda\xa2\xba\xdc\xfa\x23\x3a\x09\x9d\x39\xdc\x19\xbd\x8b\xf8\x77\x4a\xf4\x62\x67\x83\x89\x96\xc8\x9a\xcb\xf1\x94\x87\xf8\x60\x23\xe9\xed\x74\x64\x28\xa6\xee\x6c\x50\x8c\xec\x9f\x10\xf8\xe9\xa0\x19\xe3\xa3\xa5\x26\x40\x66\x31\x1f\xd5\x65\xfb\x89\xb9\xcf\x68\x7f\x3a\x28\xa1\x60\x97\x5f\x82\xcf\xcb\x4d\xce\x3a\x5e\x76\x2d\xb0\x7b\xe2\x6b\x39\x75\xf4\x18\x39\xdd\xd0\x08\x91\x98\x46\x0e\x53\x6e\x22\xac\xa7\x3c\x54\xc1\x49\xda\xd3\xbb\xb1\x73\x25\xcb\xa4\xde\x33\x3e\x50\x0f\x76\x06\xe9\x59\xe7\xc5\x2d\xca\x40\x5a\x81\x7a\x8b\x3b\x5f\x18\x4f\xb8\x82\x0c\xbb\xd4\x05\x21\x03\x3a\x8a\xa4\x19\x41\x4c\x3b\x97\x03\x7d\x1f\x7c\x40\x39\x6d\x29\x16\x24\x44\xc4\x59\xd4\x21\x49\x1b\xfa\xad\xc8\xff\xdf\x39\xf0\xe5\x2f\xd1\xeb\x12\x44\x70\x26\x0a\x2e\xc8\xca\xe5\x84\x7f\x97\xc0\x2e\x5c\x12\x7c\x92\xca\xe0\x69\xb6\x9d\x58\x53\x15\xbe\xc5\xc1\xa3\x40\x1a\x8c\xdd\xc5\x3e\x3d\xed\xa9\xe9\x60\xdf\x11\xd7\x88\x8a\x84\x7c\x6f\xfb\xa0\xe3\x5b\xe3\x97\x5e\x25\x1b\xe0\x45\xee\x39\x58\x53\xd2\x48\xb4\x1f\x72\xce\x6e\x13\xff\xcc\xf2\x5f\xcb\x96\xcf\xbd\x17\xbf\xe8\x46\x1c\xab\x43\x2d\xf5\x9c\x04\x59\x2c\xdd\x9e\x8e\xb3\x4c\x69\x59\x81\x32\xb5\xaa\x22\xcf\x0e\x56\xe6\x0a\x62\xb4\x38\x96\x01\x3a\x75\x8e\x07\xe2\xbd\xa0\x24\xc2\x18\xaa\x8a\xbc\x4f\x8a\xe2\xd7\xa8\x1a\x84\xa0\x91\x41\x72\x38\x83\x14\xab\xf0\x3d\x6a\x0d\x3d\x3a\xde\xd7\x65\x59\xc5\x68\x79\x3e\xc7\xc5\xf8\x7a\x16\xce\x1b\x72\xaa\x51\xe7\x32\xfb\x09\xa8\xcb\x82\xc1\x3d\x87\x70\xe7\xee\xac\x1e\x5e\x3c\x31\xad\xde\x1d\xd6\xae\xa8\x89\xf5\x3b\xc7\x79\x65\x3c\xa3\xc0\x91\xab\x37\xe5\x63\x8a\x44\xed\x69\x54\xcb\x49\xae\xce\x4f\xf5\xec\x64\xe8\x19\xe1\x73\xba\x5a\x1e\xdd\xff\x74\xdd\x7c\xfe\xfa\xa5\x82\x46\xf0\xd4\xa1\x61\x55\x95\x51\xa1\x66\x55\x93\x61\xd5\xec\x4a\xe3\x94\x71\xc8\x12\xfb\xfa\x34\x7f\x82\x10\x1c\x23\x93\xd4\x96\x92\x9f\xa5\xd6\x10\xb0\xac\xb8\x96\xdc\x2c\x79\x3b\xe6\xea\xe0\x08\x6c\x55\x05\x27\xd5\xaf\x50\xf6\xa6\xec\x13\xd5\x5c\x27\xf4\x8e\x4b\x9b\x2f\xf4\x93\xcb\xf5\x9f\x54\x80\xe3\x94\x77\x56\xec\x7c\xa1\x18\xbb\xee\x03\x31\x5d\x92\xe1\xf3\xb3\xc5\xc3\xce\x08\x3c\x51\x8d\x16\x01\x74\x0e\x77\x27\xe6\x4b\xab\xfe\x27\x32\xe4\x22\xf1\x3f\xa3\x70\x3d\x5e\x04\x8e\x7a\xeb\x22\x4f\x09\xdb\x16\x97\xca\xe1\x1e\xeb\xf7\x7d\x19\xe9\x77\xa6\x1a\x06\xc6\x3e\x93\x88\xaf\xd0\xaf\x01\xb5\xa4\xe2\xf2\x4d\x68\xbe\xde\x6f\x72\x9d\x2a\xd6\xe1\x77\x1c\x29\x31\x2b\x6b\xea\xf8\xc1\xdc\xe7\x8e\x36\x7f\x63\xcc\x1f\xd6\x00\xa5\x62\xdb\x28\x1b\xfc\xde\x65\x4f\x5e\xb1\x10\xbe\x32\xfc\xbb\xff\xbf\x00\xe5\xe9\x0f\x3a\x97\x5f\x1f\xf3\x79\x4f\xcc\x1c\x63\xf9\xfc\xb6\xfa\xe4\xc4\xd1\x60\xd2\x3f\x28\x33\xa4\xde\x94\x6a\x61\x30\x12\x56\xfb\x0f\xa3\xe5\xf8\xf9\x15\x08\xfe\xf9\xaf\xab\xc3\x83\x10\x56\x15\x49\x5d\xfa\x61\xfa\x75\xff\xd5\xab\xf8\xa3\x7c\xb2\x8f\x3f\x0f\x86\x59\xc2\xdf\xfe\x7e\x95\x36\xa6\xfa\x73\xf9\xd4\x2e\x83\xff\x0e\x00\x00\xff\xff\x92\x11\x41\xab\x27\x21\x00\x00")
+var _hypershiftOperatorHypershiftOpenshiftIo_hostedclusterkubeconfigsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x6f\x6f\xdb\x46\xd2\x7f\xaf\x4f\x31\x50\x1f\xc0\x76\x1e\x89\x8a\x93\x5e\xae\x25\x10\x04\x86\xdb\xb4\x41\x9a\xd6\x88\x9d\xbe\x38\xcb\x77\x5d\x91\x43\x6a\x6b\x72\x97\xdd\x59\xca\x56\x8b\x7e\xf7\xc3\xec\x2e\x45\x52\x22\x6d\x37\xe8\x45\x01\x2c\xed\x0e\x67\xe7\xef\x6f\x66\x96\xa2\x92\x3f\xa3\x21\xa9\x55\x0c\xa2\x92\x78\x6f\x51\xf1\x2f\x8a\x6e\xbf\xa2\x48\xea\xc5\xe6\x74\x72\x2b\x55\x1a\xc3\x79\x4d\x56\x97\x1f\x91\x74\x6d\x12\xfc\x06\x33\xa9\xa4\x95\x5a\x4d\x4a\xb4\x22\x15\x56\xc4\x13\x00\xa1\x94\xb6\x82\x97\x89\x7f\x02\x24\x5a\x59\xa3\x8b\x02\xcd\x3c\x47\x15\xdd\xd6\x2b\x5c\xd5\xb2\x48\xd1\x38\xe6\xcd\xd1\x9b\xe7\xd1\xcb\xe8\xf9\x04\x20\x31\xe8\x1e\xbf\x92\x25\x92\x15\x65\x15\x83\xaa\x8b\x62\x02\xa0\x44\x89\x31\xac\x35\x59\x4c\x93\xa2\x26\x8b\x86\xb9\x25\x5a\x65\x32\xa7\x68\xbd\xad\xd0\xd0\x5a\x66\x36\xd2\x15\x2a\xff\x4d\xea\x09\x55\x98\xb0\x28\xb9\xd1\x75\x15\xc3\x18\x99\xe7\x1f\x84\xf6\x0a\x7f\xef\x8e\x3a\xf7\x47\xbd\xdf\x1d\xe5\x28\x0a\x49\xf6\xfd\x43\x54\x3f\x48\xb2\x8e\xb2\x2a\x6a\x23\x8a\x71\xc1\x1d\x11\xad\xb5\xb1\x3f\xb6\x02\xcc\x61\x9d\xdc\x26\x9d\xaf\x81\x4c\xaa\xbc\x2e\x84\x19\xe5\x36\x01\xa0\x44\x57\x18\x83\x63\x56\x89\x04\xd3\x09\x40\x30\xb3\xd3\x6e\x0e\x22\x4d\x9d\xe3\x44\x71\x61\xa4\xb2\x68\xce\x75\x51\x97\x8d\xc3\xe6\x90\x22\x25\x46\x56\x4c\xd2\xa8\x07\xe1\x24\x27\x05\xc0\xaf\xa4\xd5\x85\xb0\xeb\x18\x22\x36\x6f\xb4\xee\xda\x20\xd0\xb0\x39\x63\xe8\xaf\xd9\x2d\x8b\x46\xd6\x48\x95\x0f\x1d\xf6\x89\xd0\x38\x3f\x80\xce\xc0\xae\x11\x92\x42\xa2\xb2\x90\xa0\xb1\x32\x93\x89\xb0\x38\x22\x41\x4d\x7b\x07\x7f\xa2\x27\x9f\xfa\xed\x7d\x25\x8d\x0b\x3a\xb0\xf2\x2f\x9e\x6d\x85\xad\x29\xc2\x1d\x07\x0e\xdb\x40\xc6\x7a\x04\xe6\x48\x63\xa2\x78\xa2\xcd\xa9\x28\xaa\xb5\x38\x75\x4b\x94\xac\xb1\x74\xc9\xc4\xbf\x38\x96\xcf\x2e\xde\xfd\xfc\xf2\xb2\xb7\x0c\x7d\x15\x46\x82\x10\x0c\xfe\x56\x23\x59\x02\xe1\x43\x6c\x5e\xc8\x0d\xa6\xd0\x06\x0c\x64\xda\x80\x80\x75\xcf\xcb\x11\x5c\x0d\xea\x0f\x92\x80\x64\xae\x30\x85\xd5\xd6\xf9\xa7\xff\xdc\x11\x75\x39\x3b\x4a\x03\x42\xa5\x70\x67\xa4\xb5\xa8\xc0\x6a\x16\x04\x13\x83\x16\xa4\x72\x1c\x88\xbd\xad\x9a\x60\x8d\xe0\xdb\x0d\x9a\x6d\x97\x8d\xce\x0e\xe4\x3b\x94\xc3\x71\x71\x4b\x66\x06\xa4\x99\xf3\x16\x12\xa1\x40\xab\x62\x0b\x2b\x04\x83\x1b\x7d\x8b\x29\x58\x9d\xa3\x5d\xa3\x89\x76\x76\xac\x8c\xae\xd8\xc5\x4d\xea\xf9\x4f\x07\x16\x3b\xab\x7b\x56\x3f\x62\xc7\x78\x2a\x48\x19\x0f\x91\xf8\xe4\x26\xdd\x30\x0d\xbe\xf4\x11\x25\x09\x0c\x56\x06\x09\x95\x47\x48\x5e\x66\x11\x57\xbf\x62\x62\x23\xb8\x44\xc3\x0f\xb2\xa3\xea\x22\x65\xe0\xdc\xa0\xb1\x60\x30\xd1\xb9\x92\xbf\xef\xb8\x11\x9b\x91\x8f\x29\x84\x45\x62\x43\x5a\x34\x4a\x14\xb0\x11\x45\x8d\x33\x67\xf0\x52\x6c\xc1\x20\xf3\x85\x5a\x75\x38\x38\x12\x8a\xe0\x83\x36\x08\x52\x65\x3a\x86\xb5\xb5\x15\xc5\x8b\x45\x2e\x6d\x03\xf9\x89\x2e\xcb\x5a\x49\xbb\x5d\x38\xf4\x96\xab\xda\x6a\x43\x8b\x14\x37\x58\x2c\x48\xe6\x73\x61\x92\xb5\xb4\x98\xd8\xda\xe0\x42\x54\x72\xee\x84\x55\xac\x14\x45\x65\xfa\x85\x09\x45\x82\x8e\x7a\xc6\x3b\x88\x7e\xff\xdf\xa1\xed\x03\x56\x66\x9c\x65\x97\x8b\xf0\xa8\x57\xb4\x35\x26\x2f\xb1\x3d\x3e\x7e\x7b\x79\x05\xcd\xd1\xde\xe0\xde\xb6\x2d\x29\xb5\x66\x66\x13\x49\x95\xa1\xf1\x94\x99\xd1\xa5\x73\x1e\xaa\xb4\xd2\x52\xd9\x2e\x06\x50\xbd\x2a\xa5\xa5\x36\x9d\xac\x8e\xe0\xdc\xd5\x3a\x0e\xae\xba\x4a\x85\xc5\x34\x82\x77\x0a\xce\x45\x89\xc5\xb9\x20\xfc\x9f\x1b\x99\xad\x49\x73\x36\xde\xd3\xcc\xdc\x2d\xd3\xed\x3f\xe6\x12\x87\x18\xec\x6c\x34\x55\x73\xc4\x27\x23\x78\x73\x59\x61\xd2\xcb\x84\x14\x49\x1a\x8e\x5c\xcb\xf0\xa1\xb3\x31\xa0\xea\x9d\x34\x9c\x91\xfc\x71\x35\xfc\x60\x75\x4f\xb6\xef\x1c\x11\x08\x83\x4e\x04\xff\xcc\x38\xa8\x47\x93\x3d\x66\x20\x2d\x96\x03\x87\x8c\x1a\xb6\xbb\x29\x8c\x11\xdb\xbd\xbd\x5e\x75\x7c\x44\xf8\x9e\x7d\x38\xe8\x59\xe8\x6e\x45\xdc\x23\x18\x02\x51\xb7\xd4\x41\x50\x49\x20\x89\x6a\x4c\x19\xec\x0f\xd5\x2d\xa5\xfa\x01\x55\xce\xd5\xfc\x74\xf2\x17\x54\x0e\x90\xfa\x88\x42\x1f\x03\xf0\x7a\x6a\xaf\x4f\x90\xa6\x23\xe2\x6a\x0b\xc6\xf5\x8d\x2a\x7f\x62\x5d\x89\xe0\xfc\xc0\x95\xc4\x90\x7f\x64\xbb\x78\x2f\x55\x2a\x37\x32\xad\x45\x51\x6c\x43\x65\x60\x73\xa8\x8d\x28\x24\x27\x2d\x01\xee\x17\x9c\x20\x9d\xab\x70\xc2\x86\xe3\x66\x20\x55\x52\xd4\xa9\xc7\x1a\x4d\x2e\x98\x35\x57\x12\xe0\xd6\x83\x7c\xc9\x0c\x0b\xca\xf5\x03\x3d\x0d\x3b\x01\xe8\x54\x72\xe1\x69\x70\x1e\x0e\xbb\x93\x76\xed\xb6\x15\xde\xed\x4e\x64\x20\xf7\xf1\x4a\x50\x53\x40\xb9\x12\xca\x9a\xb8\x2a\x88\x94\x1f\x28\x41\xe4\x42\xaa\x1d\x7c\x49\x13\xea\x2b\x1d\x3a\xda\xfb\x72\xa5\x75\x81\x42\xed\xed\xd6\xf4\x68\x68\x7e\xa2\x36\x22\xeb\xc7\x1b\xb5\xbf\x2f\xd0\x9c\xaf\xa4\xdd\x0e\xc9\x97\x89\xba\xb0\x31\xbc\xf8\x72\xfd\xb0\xf0\x3f\x07\x1e\xac\xc0\x5a\xdf\x41\xa1\x55\x3e\x22\x38\x93\xb8\x23\x87\xd3\x65\x54\x50\x2e\x0e\x0c\x76\x7d\x31\xe7\xb0\xee\x66\xec\xde\x5e\xa7\x6d\x7d\x18\x8e\x5d\x9f\x19\x4f\x46\xf5\x1b\xc1\xd5\x4b\xf7\x5c\x0f\x92\xf5\x8a\xb8\xd1\xf8\xdb\x30\x39\xd1\xca\x8f\x13\x14\x3f\x1d\x49\x7b\xb2\x4f\xcf\x1b\x16\xdc\xf4\x58\x21\x15\x41\x8a\x56\xc8\x82\xd8\x05\xa0\x15\x82\xe0\x82\x64\x77\xd1\x56\x1b\xc3\x5e\xdb\xa9\xe0\x4a\xf8\xd9\xc5\x3b\x68\xc6\xd3\x08\xe6\xf3\x39\x5c\xf1\x32\x59\x53\x27\x96\xbd\xca\x9d\x92\x4a\x3d\x0e\x42\x2a\x0d\x73\xac\x89\x99\x83\x50\x1e\xba\x41\xf8\xc2\x9f\x49\x2c\x52\xa8\x84\x5d\xef\x9a\xfc\x56\xd1\x08\xe0\xad\x36\x80\xf7\xa2\xac\x0a\x9c\xb9\xd8\x85\xb7\x5a\x07\x6b\xfb\x03\xff\x60\x3d\x61\xb1\x80\x8f\xbb\xe6\xa3\x63\x7f\xd7\x00\x12\xeb\x23\x20\xd3\xfa\x88\xfa\x3a\x45\xcd\xc3\xef\x95\xbe\x53\x43\x22\xb8\x33\x85\xc1\x18\x96\xd3\xb3\x8d\x90\x85\x58\x15\xb8\x9c\xce\x60\x39\xbd\x30\x3a\x37\x48\x8c\x18\xbc\xc0\x30\xb2\x9c\x7e\x83\xb9\x11\x29\xa6\xcb\x69\xc3\xfa\xff\x2b\x61\x93\xf5\x07\x34\x39\xbe\xc7\xed\x6b\xc7\xb0\xb7\x75\x69\x8d\xb0\x98\x6f\x5f\x97\x4c\xb3\x7b\x8c\x47\xdf\xab\x6d\x85\xaf\x4b\x51\xf5\x16\x3f\x88\xaa\xc7\x68\xe7\x56\x82\xeb\x1b\xee\x3e\x36\xa7\x51\xeb\xea\x5f\x78\x88\x8c\x97\xd3\x56\xa7\x99\x2e\xb9\xf4\x56\x76\xbb\x9c\x42\x4f\x82\x78\x39\x75\x32\x34\xeb\x8d\xd0\xf1\x72\xca\xa7\xf1\xb2\xd1\x56\xaf\xea\x2c\x5e\x4e\x57\x5b\x8b\x34\x3b\x9d\x19\xac\x66\x5c\x39\x5f\xb7\x27\x2c\xa7\xbf\xc0\x52\x35\x42\x7b\xb4\x76\x9e\x26\xf8\x73\x3a\x10\xa6\xe3\x51\xef\x3f\x85\x20\x7b\x65\x84\x22\xc7\x9e\x67\xbf\x61\xba\xbd\x80\x3f\x7c\xac\xc1\x55\xde\xf1\x43\x28\xff\xda\x09\x0e\x76\x47\xcd\xd1\xcb\x58\xcf\x49\xe1\xa3\x82\xc7\x01\xa1\x9c\x32\x51\x88\x78\x3f\x41\xac\x10\xee\xd6\x3c\x75\xad\x11\x6a\x95\xa2\x29\xb6\x5c\x44\x5a\xae\xc9\x5a\xa8\x9c\xfb\x56\x78\xc7\x79\x25\x5c\x92\x70\x4f\x7b\xcb\x51\x37\xe3\x07\x55\x5b\x79\xbc\x5c\x3b\x8e\x9c\x6d\xce\x76\x0d\x1b\x7e\x58\x24\x09\x56\x96\x43\xf1\x10\x38\xfd\x27\xd3\xa6\x14\x36\x06\x2e\xbe\x73\xdb\x4e\xcb\x4f\x86\xd9\xe6\x53\x22\x91\xc8\x9f\x66\xf0\x40\xeb\x24\x84\x75\x5d\x0a\xe5\x0a\x28\xcb\xd9\xee\xa9\x54\x26\xbe\x03\x69\xc0\x47\xac\x74\xed\xe1\xa0\xb5\x7f\x30\x31\x4f\x0f\x2b\x64\xd8\x70\x01\x1b\x04\x1d\x53\xba\x14\xf7\x4d\xe5\x7b\xf9\xe2\x9f\xaf\xbe\xfa\x5c\x9d\x1b\xec\xfe\x0e\x15\xfa\x1b\x8b\x27\xa9\x7f\xf8\x58\x67\x22\x72\xfa\x45\xcd\x70\x10\xe5\x2d\x8d\x8b\x88\x7e\x1c\xde\x09\x02\x42\x0b\x2b\x41\x98\x42\x5d\xb1\x3d\x18\x0a\xa5\x22\x2b\x54\x82\x33\x90\xd9\x30\x33\xb9\x43\xb8\x62\x0b\xa7\x2f\x66\xb0\x0a\xa6\x3d\xc4\xb6\xeb\xfb\x9b\x68\x40\x64\x49\xf0\xf5\x6c\x2f\x2f\x78\xc4\xab\x5d\x59\xe0\x78\xf2\x6d\x94\x41\x5f\x2b\xc2\x94\xdc\xc3\xd5\xa6\x80\x34\xf2\x3e\x16\xa5\x52\xd9\x57\x5f\x8e\xd0\x94\x52\xc9\xb2\x2e\x63\x78\x3e\x42\xe0\x43\x98\x8b\x4e\xbe\x57\xe5\x9b\x8f\x41\x41\x4f\xf4\xa1\x27\x6d\x0b\xa4\x60\xc8\xcb\x8d\x28\x4b\x61\x65\x02\x32\xe5\xc9\x30\x93\x68\xba\x81\xcc\xaa\x86\x07\xb9\x90\xf6\x6c\x77\x44\x01\x6d\x3a\xa1\x7d\x61\x74\x5a\x27\x3c\x1d\xeb\x0c\xd8\x8a\xdc\x12\x75\xcc\xcd\x1a\xf9\xc9\xd9\x77\x14\x80\xf7\x6c\xea\xdd\xc5\x82\xbf\x7b\x40\xa1\xa4\xca\x7d\xed\xb6\x5c\x81\x1d\x4c\xf8\x42\x74\xb7\x76\xd7\x2f\xce\x33\xcd\x33\xc6\x49\x45\x32\x45\x1e\x15\x05\xe4\xb5\x30\x42\x59\xc4\x14\xce\x2e\xde\x71\xc2\x05\xda\x0e\xb0\x89\x76\xd0\x6e\x72\xcf\x27\xa6\x3b\xcb\x89\x18\x86\x73\x97\x9f\x4f\x48\xcc\xd3\xe7\x2f\x1e\xf0\xf4\x8e\x6a\x84\xa4\x12\x96\x6f\x61\x62\xf8\xf7\xf5\xd9\xfc\x5f\x62\xfe\xfb\xcd\x71\xf8\xf2\x7c\xfe\xf5\x7f\x66\xf1\xcd\xb3\xce\xcf\x9b\x93\x37\xff\xf7\xb9\x10\x30\xd4\x09\x8e\x84\x4c\x28\x0f\x3a\xeb\x3b\x7e\xe6\x1a\x2a\x9d\xc1\x95\xe1\xeb\xa2\xb7\xa2\x20\x9c\xc1\x27\xe5\x40\x7f\xcc\x50\xa8\xea\x72\xec\xd0\x39\x4c\x99\xd5\x74\x7c\xdb\x9d\x31\xbe\x1f\xce\xfe\x5c\x93\x70\x54\x3e\xc9\x20\x4c\xc8\x08\xd0\x06\xb4\xec\x5c\xd8\x80\xc3\x31\xc8\xb4\x8e\x42\x67\x17\x25\xba\x5c\xec\xf6\x7d\x4b\xf9\x41\xa8\x2d\xb4\x60\x15\x39\x9e\xfb\x91\x4c\x96\x11\x47\x24\x46\x13\xed\x6e\xa4\x08\x0a\x79\x8b\xb0\x6b\xd6\x3c\x04\xae\x30\x11\xae\x07\x35\x2b\x69\x8d\x30\xdb\x56\x3a\x37\xce\xf2\x30\x5b\x13\x66\x75\x01\xc7\x84\x08\x91\xd2\x29\x1e\x62\xe6\x89\x47\x46\xb1\x92\x05\x4f\x39\x56\x43\xea\x46\x80\x42\x86\xd6\xb7\xac\xb4\xb1\x42\x59\x9f\x4e\x06\x73\xbc\x07\x69\xa1\xe4\x76\x0a\xf9\x82\x00\x8e\x53\x45\xa7\xa7\x2f\x5e\x5e\xd6\xab\x54\x97\x42\xaa\xb7\xa5\x5d\x9c\xbc\x39\xfe\xad\x16\x85\xcc\x24\xa6\xfc\x4a\xe1\x6d\x69\x4f\x1e\xcf\xa5\x97\xa7\xaf\x1e\xcd\x93\xe3\x6b\x9f\x0d\x37\xc7\xd7\xf3\xf0\xed\x59\xb3\x74\xf2\xe6\x78\x19\x3d\xb8\x7f\xf2\x8c\x45\xeb\xe4\xd8\xcd\xf5\xbc\x4d\xb0\xe8\xe6\xd9\xc9\x9b\xce\xde\xc9\x67\xa6\xdb\xf0\x48\xd7\x84\xed\x61\x1b\x37\x48\x16\x1a\x8c\xc1\x3d\x0f\xce\x83\x5b\xde\xc5\x83\x5b\x2c\xf5\xc0\xc6\xc8\xec\xf8\xd8\xf5\x54\xff\xe5\x45\x3c\x79\x30\x85\xda\x77\x25\x4d\xdb\xba\x6b\x08\xc3\x55\xc6\xc0\x40\xed\x4e\xc0\x81\x1b\x89\xc7\x9b\xc1\x07\x1c\x74\x5b\xaf\xf0\xdc\x4d\xb9\x8f\xc8\xfc\x7e\x47\xc8\xf2\x0a\x30\x98\xa1\x41\xc5\x17\x65\xbe\x45\x08\x6f\x24\x42\x6d\x6d\x0a\x67\xd0\xa7\xbd\xc3\x89\x26\x7f\x6d\x3e\xe0\xe1\x63\x68\x7d\x4f\xbc\xa3\x1f\x3b\xf7\x28\x41\x36\x3b\x78\x7b\xcc\xa2\x18\x85\x16\xdd\x8b\xd9\x54\x27\xc4\x17\xf4\xdc\x71\xd3\x42\x6f\xd0\x6c\x24\xde\x2d\xee\xb4\xb9\x95\x2a\x9f\x73\x2f\x34\xf7\xc1\x40\x0b\x16\x85\x16\x5f\xb8\x3f\x70\xf5\xd3\x37\x3f\xc5\x70\x96\xa6\xcd\x7d\x95\x87\x17\x57\x39\x29\xea\xbc\xfa\x98\x01\xdf\x2e\xcf\xa0\x96\xe9\x9b\xa3\xc9\x80\x1e\x0f\xb9\xe7\xc1\x78\xf4\x57\x5c\x6f\xa5\xca\xd1\x54\xfc\x12\x32\x9e\x3c\x68\xa3\xcb\x7d\xfa\x66\x60\xba\xfc\xfe\x6c\xfe\xe2\x1f\xaf\x20\xeb\x6c\x05\x53\x86\xf7\x4f\x61\xae\x71\xbe\x7c\xea\x4d\xd5\xa8\x5a\x83\x2a\x1d\x2c\xfa\xd6\x35\x06\x6b\x6a\x1f\xd1\x64\xb5\xe1\x59\xa5\xb3\x52\xaf\x76\x75\x21\x9e\xf4\x4a\x3b\xfc\xf1\xe7\xa4\xad\xf2\x7e\xa2\xf2\xe0\x1b\xa2\x8c\x9d\x12\xc3\x74\xda\x7b\xbd\xec\x7e\xb6\xf5\x20\x86\xeb\x1b\x7e\x19\x6c\xb5\xc1\x34\xb8\x93\x62\xb8\xbe\x99\xfc\x77\x00\x07\x65\x34\x5f\xf1\x1f\x00\x00")
+
+func hypershiftOperatorHypershiftOpenshiftIo_hostedclusterkubeconfigsYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_hypershiftOperatorHypershiftOpenshiftIo_hostedclusterkubeconfigsYaml,
+		"hypershift-operator/hypershift.openshift.io_hostedclusterkubeconfigs.yaml",
+	)
+}
+
+func hypershiftOperatorHypershiftOpenshiftIo_hostedclusterkubeconfigsYaml() (*asset, error) {
+	bytes, err := hypershiftOperatorHypershiftOpenshiftIo_hostedclusterkubeconfigsYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "hypershift-operator/hypershift.openshift.io_hostedclusterkubeconfigs.yaml", size: 8177, mode: os.FileMode(0644), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd2, 0x34, 0x1c, 0x4f, 0xa6, 0x76, 0xcf, 0xac, 0x7c, 0xab, 0x98, 0xf4, 0x5, 0x86, 0x9f, 0x10, 0x79, 0xf0, 0x23, 0xc8, 0xf1, 0x5, 0xba, 0x34, 0x9, 0xbd, 0x4d, 0x30, 0x42, 0x5c, 0xc6, 0x5c}}
+	return a, nil
+}
+
+var _hypershiftOperatorHypershiftOpenshiftIo_hostedclustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfd\x73\xdc\x36\xb2\xe0\xef\xf3\x57\x74\x29\xef\xca\xf6\xed\x0c\x65\x27\xbb\x79\xfb\xe6\x72\x71\xc9\x92\x92\xe8\x6c\xcb\x2a\x49\x4e\xaa\xde\x7a\xaf\x82\x21\x31\x33\x58\x91\x00\x03\x80\x92\x27\x2f\xf7\xbf\x5f\x75\xe3\x83\xe4\x7c\x72\x24\x65\x63\xbf\x65\x94\x2a\x4b\x24\x3e\x1a\x8d\xfe\x06\xd8\xcd\x4a\xf1\x23\xd7\x46\x28\x39\x06\x56\x0a\xfe\xd1\x72\x89\x7f\x99\xe4\xe6\xaf\x26\x11\xea\xf0\xf6\xc5\xe0\x46\xc8\x6c\x0c\xc7\x95\xb1\xaa\xb8\xe4\x46\x55\x3a\xe5\x27\x7c\x2a\xa4\xb0\x42\xc9\x41\xc1\x2d\xcb\x98\x65\xe3\x01\x00\x93\x52\x59\x86\x8f\x0d\xfe\x09\x90\x2a\x69\xb5\xca\x73\xae\x47\x33\x2e\x93\x9b\x6a\xc2\x27\x95\xc8\x33\xae\x69\xf0\x30\xf5\xed\xf3\xe4\xab\xe4\xf9\x00\x20\xd5\x9c\xba\x5f\x8b\x82\x1b\xcb\x8a\x72\x0c\xb2\xca\xf3\x01\x80\x64\x05\x1f\xc3\x5c\x19\xcb\xb3\x34\xaf\x8c\xe5\xda\x24\xf3\x45\xc9\xb5\x99\x8b\xa9\x4d\x54\xc9\xa5\xfb\x4d\xa8\x81\x29\x79\x8a\x00\xcc\xb4\xaa\xca\x31\x6c\x6a\xe6\x46\xf5\xa0\xba\x65\xfe\x40\x13\x1c\xbb\x09\xe8\x79\x2e\x8c\x7d\xbd\xfa\xee\x8d\x30\x96\xde\x97\x79\xa5\x59\xbe\x0c\x1a\xbd\x32\x73\xa5\xed\x79\x3d\xc5\x08\xe6\x69\xfc\xc5\x37\x11\x72\x56\xe5\x4c\x2f\xf5\x1f\x00\x98\x54\x95\x7c\x0c\xd4\xbd\x64\x29\xcf\x06\x00\x1e\x61\x04\xf1\xc8\xa3\xe4\xf6\x05\xcb\xcb\x39\x7b\xe1\x86\x4b\xe7\xbc\xa0\xad\xc0\xbf\x10\x27\x47\x17\x67\x3f\x7e\x75\xd5\x7a\x0c\x90\x71\x93\x6a\x51\x22\xa6\x97\x96\x05\xc2\x80\x9d\x73\x70\x3d\x60\xaa\x34\xfd\xd9\x5e\x1c\x1c\x5d\x9c\xc5\xb1\x4a\xad\x4a\xae\xad\x08\x8b\x74\x3f\x0d\xc2\x6a\x3c\x5d\x9a\xf9\x09\x02\xe7\x5a\x41\x86\x14\xc5\xdd\xe4\x7e\x99\x3c\xf3\xeb\x01\x35\x05\x3b\x17\x06\x34\x2f\x35\x37\x5c\x3a\x1a\xc3\xc7\x4c\x82\x9a\xfc\x83\xa7\x36\x81\x2b\xae\xb1\x23\x98\xb9\xaa\xf2\x0c\x49\xef\x96\x6b\x0b\x9a\xa7\x6a\x26\xc5\xaf\x71\x34\x03\x56\xd1\x34\x39\xb3\xdc\x58\x10\xd2\x72\x2d\x59\x0e\xb7\x2c\xaf\xf8\x10\x98\xcc\xa0\x60\x0b\xd0\x1c\xc7\x85\x4a\x36\x46\xa0\x26\x26\x81\xb7\x4a\x73\x10\x72\xaa\xc6\x30\xb7\xb6\x34\xe3\xc3\xc3\x99\xb0\x81\x69\x52\x55\x14\x95\x14\x76\x71\x48\xf4\x2f\x26\x95\x55\xda\x1c\x66\xfc\x96\xe7\x87\x46\xcc\x46\x4c\xa7\x73\x61\x79\x6a\x2b\xcd\x0f\x59\x29\x46\x04\xac\xc4\x45\x99\xa4\xc8\xbe\xd0\x9e\xcd\xcc\x93\x16\xf2\xec\x02\x29\xc2\x58\x2d\xe4\xac\xf1\x82\x28\x77\x0b\x96\x91\x7a\x71\x5f\x99\xef\xea\x16\x5a\x23\x13\x1f\x21\x3e\x2e\x4f\xaf\xae\x21\x4c\xed\x10\xee\x70\x5b\x37\x35\x35\x9a\x11\x45\x42\x4e\x39\x12\x88\x30\x30\xd5\xaa\x20\xac\x72\x99\x95\x4a\x48\x4b\x7f\xa4\xb9\xe0\xd2\x82\xa9\x26\x85\xb0\xb8\x7f\xbf\x54\xdc\x58\xdc\x81\x04\x8e\x49\x5a\xc0\x84\x43\x55\x66\xcc\xf2\x2c\x81\x33\x09\xc7\xac\xe0\xf9\x31\x33\xfc\x77\x47\x32\x62\xd3\x8c\x10\x79\xdd\xd0\xdc\x14\x74\xf5\x7f\x38\xca\xd8\xe3\xa9\xf1\x22\x48\xa0\x0d\x7b\xd2\xe2\xb9\xab\x92\xa7\x2d\xfa\xcf\xb8\x11\x1a\xe9\xd5\x32\xcb\x91\xca\x5b\xcd\x5b\xa3\xae\xe7\x3e\xcf\x81\x27\xe7\x57\x28\x3e\x96\xdf\x2c\xc1\x72\x74\x71\xe6\x1b\x06\x22\x61\x93\x9c\xc3\xc9\xf9\x15\x49\x98\x28\x03\x8e\x2e\xce\xc0\x10\x8f\x0d\xe9\x19\xff\xc8\x8a\x32\xe7\xa8\x37\x92\x6f\xbc\x68\xf8\x36\xf9\x66\xc2\x0c\x3f\x51\x05\x13\xf2\xdb\x04\x7e\x9a\x73\x09\x86\xdb\x21\x8d\x20\xfd\x1c\x95\xe1\x19\x08\x09\x29\x42\x3e\x15\x29\xf2\x21\xb1\x1d\xea\x87\x54\xc9\xa9\x98\x19\x7c\x5f\xe6\x2c\xa5\xf5\x63\xe7\x5c\xb1\x0c\x26\x2c\x67\x32\xe5\x1a\x58\x96\x69\x6e\x0c\x28\x99\x72\x60\x04\x2c\xb2\xa9\xce\x80\x88\x0f\x49\x9a\xd9\x25\xb0\x6b\xd2\x44\x22\xcf\x6f\xb9\x49\xe0\x78\xce\xe4\x2c\x30\x00\xc1\xa7\xb9\x30\xa6\xf2\x3b\xb1\x15\x42\x3b\x67\x28\x3d\xd2\xbc\xca\x38\x08\x9b\xac\xa0\x79\x03\x21\xe1\xff\xac\xca\x84\xdd\xb5\x31\xd8\x06\xe5\xd8\x54\xcc\x2a\x8d\x00\xd0\x83\x5c\xcd\x08\x62\x8f\x17\x27\x9a\xc1\x6f\x40\x63\xb9\x66\x15\xa0\xcd\xd4\x82\x3f\x29\xa9\xf8\x0b\x95\x8b\x74\xb1\xee\xfd\x12\x78\xc7\x8d\xe6\xa0\xf9\x94\x6b\x2e\x53\x84\x12\x8e\x09\xe4\xb7\xac\x84\x3b\x61\xe7\x04\x25\xad\x17\x4a\x1a\x1b\xe5\x2f\x2b\xcb\x7c\x01\x95\xcc\x48\x7e\x70\xff\x26\x59\xb0\x22\x87\x1b\xbe\x48\xe0\xcc\x22\xa5\xa0\xc0\x20\x56\x98\x2c\xa8\x99\x9b\x13\x4a\xad\xa6\x22\xe7\xab\x0b\xdc\xbd\x48\xfc\x91\x6b\x99\x62\xed\x22\x9f\x20\x03\x05\x12\xf4\x8b\xb4\x6b\x45\x13\xd2\xae\x96\xdc\x72\xb2\x9b\x32\x95\x1a\x94\xfe\x29\x2f\xad\x39\x54\xb7\x5c\xdf\x0a\x7e\x77\x78\xa7\xf4\x8d\x90\xb3\x11\xe2\x65\xe4\x84\x86\x39\x44\x70\xcc\xe1\x17\xf4\x0f\x5c\xbf\x3b\x79\x37\x86\xa3\x2c\x03\x65\xe7\x5c\x43\x65\xf8\xb4\xca\x61\x2a\x78\x9e\x99\xa4\xa1\x57\x87\x80\xa2\x6b\x08\x95\xc8\x5e\x3e\x19\xac\x59\xc7\x2e\x12\xdc\x2a\xbf\xc2\x4f\xae\x66\x97\xdc\x3a\xa9\x39\x1e\xec\x44\xd7\x9b\x46\xf3\x26\xe5\x12\xf6\xbc\x69\x18\xb0\x19\xa9\x19\x70\x2f\xcd\x7d\x37\xb3\x60\x1f\x8f\x66\x5d\xb7\xf3\x2d\x35\x0e\x46\x8e\xac\x8a\x09\xd7\x08\x4f\xc6\x16\xa8\x94\xe0\x86\xf3\xd2\x01\xca\xb3\x15\x00\xe1\x3b\xfc\x07\x98\xe6\x70\xc3\x4b\xb4\x2c\x66\x4c\x67\x39\x89\xa1\x29\xb0\x19\x87\x3b\x14\x77\x95\x34\xdc\x26\x1b\xe1\x99\x2a\x5d\x30\x3b\x46\xb3\xe3\xab\x2f\x37\xb6\x2a\x84\x14\x45\x55\x8c\xe1\xf9\xc6\x26\x6e\xe7\xd0\x7a\x99\x2d\x29\x85\xfa\xa7\x60\x1f\x5f\xb1\xf4\xa6\x2a\x37\xa2\x0f\x37\x70\xca\xaa\xdc\x8e\xe1\xc5\xf3\xce\x48\xf4\x83\xae\x22\x72\x03\xee\x02\x6e\x1f\x0d\x2d\x2f\x1e\x8a\x96\x2b\xf1\x2b\xef\x84\x93\xee\x48\xc1\x21\x03\x46\x0c\xfd\x2e\xa1\xe0\x33\x36\x59\x90\x7e\xb3\x70\x37\x17\xe9\x1c\x98\x5c\xc2\x0e\xf6\xf1\x78\xfb\x24\xf0\xb3\x43\x24\x78\xe1\x3b\x1e\x6c\x45\xdc\x89\xc3\xe0\x60\x27\xe2\x2e\xdc\x70\x01\x71\x2d\x45\x81\x5a\x42\xf0\x2c\x18\xec\x28\x62\x47\xac\x14\x5e\x9d\xa3\xc5\x80\x8f\x15\xab\xec\xbc\x7e\x9e\xc0\x2b\x95\x09\x4e\x4c\x69\x78\xaa\xb9\x75\xaa\xfb\xdd\x51\x85\xca\x48\xdd\x70\xe9\x98\x58\xf2\x5b\xae\x71\x17\x66\xb5\x82\x41\xef\xd4\x8e\xd0\xf6\xd0\x6a\x8b\x58\xe2\xb2\x2a\xd6\x23\x60\xb4\x75\xe5\x23\xf8\x49\x0b\xcb\x2f\x9d\x1d\xec\xe0\xdc\xd0\xf0\x28\xcf\xbb\x34\x73\x1a\x71\x70\x0f\xd9\x7f\xc7\x27\x73\xa5\x6e\xc6\xbb\xb7\xe8\x27\xd7\x12\x0c\x97\x59\xb0\x42\xf8\x2d\x97\x64\xc8\x03\x03\xcd\x0b\x65\x39\x4c\x58\x7a\xc3\xd1\xd5\x90\x68\x9e\x51\x74\x20\xec\x5c\x24\xf8\xfb\x4a\xf9\xda\xee\xba\xa2\x2d\xdd\xd4\x6e\x09\xf2\xd7\x4b\xdd\xda\x76\x8a\x7f\x86\xca\x18\x58\x63\x8a\x68\xf2\x7a\x14\xc5\x95\xd5\xf6\x4a\xa3\x31\x99\x2b\xd7\x68\x2c\x56\x1a\xad\x03\xd4\x7b\x96\x7f\xb4\x41\xcf\x35\x9a\x1a\x9e\xf3\xd4\x46\x23\xdf\x0a\x49\x1a\x71\x3d\x52\xba\x21\x66\xb7\x3d\xf3\xdf\xce\xa6\xe9\x40\xdb\x9d\x04\x19\xfe\x5f\xa8\xac\x8b\x1a\x98\x30\x9b\xce\x07\x9d\xb0\xfb\x56\x65\xb5\x16\xb0\x9a\x59\x3e\x5b\x10\x41\x21\xf7\x08\x39\x6b\xf1\x4f\x02\xaf\x72\x95\xa2\x49\x48\x90\x18\x30\xb9\xba\x83\x4c\xdd\x49\x32\xe4\xa3\xbf\x4c\x86\x85\x9d\x37\x78\xcc\x35\xdd\x4c\x39\x9b\x25\x14\xfe\x8c\x76\xac\x68\x04\x13\x0f\x57\x87\x26\x23\xdc\x86\xd4\xee\xd8\x86\x2d\x7b\x15\xac\xfc\xf5\xf0\x8e\x1a\x1c\xe4\x38\x76\xb0\xf7\x66\x6f\x79\x99\xaa\xa2\x54\x92\x4b\x7b\x56\xb0\x19\x7f\x77\xcb\xb5\x16\xd9\x3a\x7e\x0b\x32\x8d\xe5\x17\x5b\xb9\x72\xeb\x72\x5b\xa4\x72\xbc\x7e\x6a\x28\x58\x89\xae\x4f\xce\x99\xe1\x35\x7c\xe4\x8d\x93\xc4\x15\x08\xa9\xf7\x3f\x35\x77\x5e\x32\x12\x47\x7c\xce\x63\x6f\x7a\x04\x66\x2e\x4a\x13\xa4\x5a\x91\xc0\x71\x08\xe4\xe9\x4a\x4a\x24\x3e\x74\x50\xb4\xc8\x32\x2e\xeb\xf9\xbc\x92\x54\x18\xbe\x29\x4b\xa5\x2d\xcf\x86\xa1\xa1\x37\x83\x95\xcc\x17\x50\x70\x26\xad\x1b\x9c\x44\x1a\x9a\x7c\x1f\xbd\xbb\x4c\x21\x2f\x55\x16\x08\x3e\xaa\xd6\x8c\xb4\x72\x3d\x45\xdb\x01\x2f\x00\x63\xc5\x06\x54\xe5\xa3\x47\x35\x28\x01\x50\x7c\xcc\xa6\x53\x9e\xa2\x91\x49\x8b\x33\xc9\x7e\xbb\x4d\x01\xe9\x8b\x9c\x49\x1e\x82\xd9\x66\xbc\x6b\x9b\xd6\xf4\xc1\xa8\x86\x8f\x11\xa8\xa2\xac\x2c\x8f\x91\x33\x13\x44\xab\x9f\x0b\x4a\xec\xd8\x79\xd1\x71\x75\x8d\x0e\x83\xfd\xf4\x82\x8f\x05\x38\xd7\xdc\x43\x9f\x73\xbd\xae\xe9\xd2\x52\xc3\xf2\xd0\xf2\x10\x9a\xe3\xbe\x19\xdf\x62\xe2\x29\x6b\x79\xb9\xc1\x47\x2f\xd6\x43\xda\x4d\x8b\xe5\x02\xe3\x83\x9b\xde\x76\xe7\xbd\xf0\x1f\x93\x8b\x77\xd3\x6d\x0d\x46\x1d\xec\xe0\x76\xcb\x2d\xf2\xcb\xaf\x92\x59\x0c\x24\x8f\xe1\xff\x3e\xfd\xf0\xa7\xdf\x46\xcf\x5e\x3e\x7d\xfa\xb7\xe7\xa3\xff\xf8\xfb\x9f\x9e\x7e\x48\xe8\x97\xff\xf9\xec\xe5\xb3\xdf\xc2\x1f\x7f\x7a\xf6\xec\xe9\xd3\xbf\xbd\x7e\xfb\xfd\xf5\xc5\xe9\xdf\xc5\xb3\xdf\xfe\x26\xab\xe2\xc6\xfd\xf5\xdb\xd3\xbf\xf1\xd3\xbf\x77\x1c\xe4\xd9\xb3\x97\xff\xb6\x05\xa8\x8f\xa3\x5a\x87\x8f\x84\xb4\x23\xa5\x49\x5c\xcb\xd9\x18\xac\xae\xf8\xc6\xae\x2d\xb2\x78\xf2\x86\xf6\x67\x89\x12\x0a\xf6\x11\x7d\x54\x60\x85\xaa\xa4\x0d\x8c\xdd\x66\x05\x96\xe7\xea\x8e\x67\x7b\x5b\x17\x21\x76\x40\xf6\xd1\x61\xc1\x24\x9b\xf1\x91\x1f\x7e\x14\x87\xc7\xb8\xb9\x65\x42\x72\x7d\xb8\x2b\x04\xb2\x56\x1a\x84\x9f\xa0\x67\x7b\x02\xfc\x54\x09\xd0\xbb\x42\xcb\xc2\xc8\xfb\xbb\x5b\x49\x30\x58\x17\x09\x9c\x4d\x21\x8e\x23\x0c\xa8\x42\x58\x54\x23\xa8\xba\x18\x44\x52\x1a\x82\xb0\xc1\xf2\x23\x75\xeb\x89\x5f\xa0\xc1\x8c\x11\x5f\x03\xfc\x63\x99\x8b\x54\xd8\x7c\x41\x81\x7e\x31\x15\xa4\x1b\x31\x60\x77\x27\x0c\xc7\x4e\x4c\x82\xc0\xf0\x78\x11\x4e\xab\x46\x2e\xc2\xef\xcf\x90\x3e\x69\x86\xd8\xd1\xc0\xab\x17\x6f\xb3\xbf\x2b\xb9\x66\x56\xf5\xda\xa5\xd7\x2e\xbd\x76\xe9\xb5\x4b\xaf\x5d\x7a\xed\xf2\x20\xed\x32\x6f\x9e\x75\xbb\x93\xc4\x5e\xc5\xf4\x2a\xa6\x57\x31\xbd\x8a\xe9\x55\x4c\xaf\x62\x1e\x43\xc5\x20\xdf\x1e\x5d\x9c\xb9\x9b\x6c\xe3\xc1\xce\xcd\xeb\x95\x4a\xaf\x54\x7a\xa5\xd2\x2b\x95\x5e\xa9\xf4\x4a\x65\xab\x52\xa9\xcf\x5a\xde\x12\x6f\xf6\xca\xa5\x57\x2e\xbd\x72\xe9\x95\x4b\xaf\x5c\x7a\xe5\xf2\x60\xe5\x82\x9f\x64\x65\x55\x7f\x8e\xdf\x9f\xe3\xf7\xe7\xf8\xfd\x39\x7e\x7f\x8e\xdf\x9f\xe3\x3f\xf0\x1c\x9f\xee\xcd\xf7\x41\xb0\x3e\x08\xd6\x07\xc1\xfa\x20\x58\x1f\x04\xeb\x83\x60\x0f\x0f\x82\x61\xc6\x89\x2b\x4c\xaf\xd1\x1f\xaf\xf4\xc7\x2b\xfd\xf1\x4a\x7f\xbc\xd2\x1f\xaf\xf4\xc7\x2b\x8f\x72\xbc\x12\x35\x4b\x7f\xc6\xd2\x9f\xb1\xf4\x67\x2c\xfd\x19\x4b\x7f\xc6\xd2\x9f\xb1\x3c\xea\x19\x8b\xd9\x98\x11\xa4\xb5\x67\xcd\x2c\x1f\x94\x8d\xce\xfa\x0f\x6e\xc3\xc6\xa8\x69\xf3\xc3\x55\xfc\x2a\xde\x7f\xda\x29\x34\xe0\x87\xdd\x75\xcb\x54\x15\x9c\x12\xa7\x25\xf5\xa7\xc0\xc6\xe5\xa0\x59\x19\xd2\xf5\x2f\x98\x14\xd3\xfa\x8b\x70\xc9\x05\x6e\x0e\x82\xb3\x31\xe7\xcc\xb6\x54\x15\x57\x05\xa3\xe4\x8a\xab\x3f\x23\x78\xcb\x33\x51\xad\x4f\x2c\x31\x82\x37\x4c\xcf\xd6\xd3\xf8\x56\xa6\xee\xf8\x61\xae\x3f\xe9\x42\x69\x3e\xd8\xba\x17\xc7\x6b\x3b\xe1\x58\xc6\x6a\x26\x64\x10\xe8\x48\x55\x48\xb1\x31\x4b\x96\xa4\x8f\xed\xf1\x65\xa9\xb2\x0d\x1f\xec\xea\x4a\x82\x92\x43\xe2\x23\x21\x8d\xc5\xc4\x63\xc8\x02\xae\x6f\xc6\x33\x4a\x0b\x46\xc9\x49\x42\x0e\xae\x66\xff\x35\x46\xc3\x76\x83\x01\xc7\xbd\xa2\x04\x11\x9b\x6e\xba\xef\x23\xad\x77\x0a\xd7\x16\x22\xcf\x1b\x73\x23\x35\xb1\x2c\xab\xd3\xae\x20\x60\x60\xc2\xdb\xb5\xb8\x42\x2c\x26\xf7\x61\xba\x52\x0b\xa5\x85\x5d\x1c\xe7\xcc\x98\xf5\xc9\xea\x56\x80\xbd\x58\xee\x53\xb3\xa3\x7b\x01\x29\xbe\xb9\x1f\xa4\x1b\x31\x66\x4a\xcd\x59\x76\x94\x6a\x65\xcc\x7f\x2a\xc9\x4d\x07\x48\xaf\x96\xfb\xf8\x51\xc2\x37\xfa\x18\x2a\x62\x44\x7e\x9c\xa5\xf3\x25\x48\xa3\x10\xa1\x5c\x11\xf9\x02\x18\x8d\x43\x5d\x7f\xa5\xc1\xd4\x74\x2b\x7d\x0f\x81\x19\x98\x32\x8d\xff\x84\x7d\xf4\xa6\xcb\x36\x0c\x4c\x94\xca\x39\x93\x6b\x5a\x58\x95\x73\xdd\x4c\xef\xba\x75\xf1\xd7\x75\x6b\x4a\x16\xd0\xa2\xa9\xc6\x50\xfb\xee\x93\xb0\xbc\xd8\x30\xff\x32\x04\x8e\xbf\x5d\x82\xca\x1a\x1c\x24\x17\x66\x2d\x43\x29\x43\x34\xee\xde\xa0\x59\x27\x17\x80\x7a\x06\xc5\x35\xb3\x50\x60\x8e\x0c\x2f\x27\xac\x16\x98\xec\xf0\x9b\x1b\xbe\x18\x92\xaa\x1b\x72\xfa\x50\xff\x5b\xa8\x4c\x48\x4c\x40\xed\xf1\x0f\xe5\x3f\x58\x81\x6f\xc2\x6f\xdf\xae\x5f\x4b\x17\x27\x02\xc0\xcd\xb4\xf9\xfd\xd2\xb2\x4f\xa9\x39\x08\x99\xf9\xc4\x85\x08\x9b\x5b\x96\x1b\x09\x17\x4d\xb0\x26\x70\x5a\x94\xd6\xa5\x70\xc0\x8c\x9e\x16\xd3\x53\xe5\x79\xab\xb1\x09\x59\x1c\x6b\x8b\xc0\x5b\xbf\x2e\x5c\xe9\x32\x41\x9c\x2b\x2f\x7f\xf9\x10\x2e\x28\xa7\x4c\xfd\x84\x32\x41\x9c\xab\xd3\x8f\x3c\xad\xec\x86\xa4\x7d\x9d\x58\xd0\xdf\x85\xe0\x8b\xce\xa8\x78\xcd\x17\x41\x38\xb8\x35\xdd\x70\xcc\xf3\xc4\xec\x12\x11\xfa\x4c\x53\x68\x17\x6d\xc7\xc9\x0d\x5f\x18\xb2\xb8\x70\x48\x1c\x0c\xcd\x26\xc4\xe1\xb0\xde\xf4\xa2\x32\x94\xd6\xf4\xf4\xa3\x30\xd6\xfc\x2f\x47\x7e\xa9\x2a\x26\x3e\xdd\x8f\x1f\x3a\x6c\x02\x61\x3c\xa0\x52\x66\xf4\x27\x4d\xf3\x50\x44\x05\x80\x3a\x63\x2b\x7c\x67\xd5\xc8\xf7\x0a\x0c\xd3\x31\x3e\x41\x73\x33\x27\xe0\x31\x95\x48\x60\x62\x6f\xf2\xfd\xc8\x72\x91\xc5\xd9\x1c\x3d\xb8\xb5\xd3\x7a\x4e\x7f\xa9\x58\x9e\x84\xb4\x58\x88\xe2\xf0\xc8\x37\x42\x14\xfe\x52\x89\x5b\x96\xa3\x08\xb3\x0a\xee\x44\x9e\xa5\x4c\xbb\xf0\x3b\x4d\x32\x04\x83\x53\x32\x0b\x8c\x38\x3a\x65\x32\xb2\x6d\xbd\x3b\x24\x49\x19\x94\x4c\x5b\x91\x62\x56\x65\x40\xfa\x9f\x29\xbd\x78\x30\xd1\xd5\xa4\x72\xc5\x53\x25\x33\xd3\x19\xa9\xd7\xcb\x3d\x9b\xd8\x45\x2c\x96\x5c\x0b\x95\x21\xe8\x56\x14\x7c\x99\x30\x9f\xba\xa4\x71\x81\xa6\x50\x55\x10\x5b\xd6\x0c\xd5\xb2\xd0\x91\xd4\x28\xaf\x12\x92\xbd\x98\x49\xa5\x79\xf6\x2c\xa2\xaa\xc1\x09\x09\xbc\x5a\x04\x77\x80\x5c\x03\x61\x00\xd3\xf1\x52\xb2\x56\x3f\xa7\x27\x53\x8f\xe6\x9a\x89\xa6\x4a\x53\xea\xb4\xa7\x19\x5a\x43\x98\x0b\x4c\xa4\xf6\x59\x02\xff\xc9\x35\x7a\x08\x19\x48\x3e\x63\x56\xdc\x7a\x0a\x41\x23\x38\xcf\x11\x7a\x8b\xe9\xbd\x31\xb3\xa2\x81\xe7\xf0\x94\xba\x81\x28\x0a\x9e\x09\x66\x79\xbe\x78\x16\xb2\xb0\x99\x85\xb1\xbc\xd8\xb6\x69\x8d\x74\x78\x5f\xff\x79\x4b\xbb\x6e\xde\x28\x81\xd9\x79\x47\x7f\xc4\xd6\x6d\xb1\x42\x03\x2c\x6f\x5d\x54\x1f\x2a\x4a\x8c\xc0\x24\xd8\xdb\x51\xff\xb0\xe6\xa4\x90\xb9\x7a\xc2\xa3\x48\x89\x1b\xfb\x0f\xdc\x7f\xcc\xb4\x46\xd9\xc2\x3d\xb5\x3e\x90\xaa\x77\x98\x66\xa1\x01\xd3\x9a\x2d\x06\x7b\x74\xce\xd6\x99\x07\x2d\x0c\x62\xba\xde\xa0\x4f\x1c\x1a\xf1\x49\xcb\x17\x5c\x9f\xde\xd6\xeb\x22\x4a\xb1\xe9\x30\x87\xe9\x86\x21\xa3\x7c\xc3\x88\xd4\x8c\x6b\x71\xcb\xb3\x3a\x1d\xf5\xaa\x71\xf4\xc4\x34\x3b\x25\x83\xfd\x34\x72\x9d\xde\x78\x3c\xd8\x49\x29\xaf\x62\xe3\x40\x2e\x4d\x70\x37\xac\xd0\x7f\xfa\x1a\x13\x30\x3b\x81\x6a\xaa\x89\x03\x98\x84\xdc\x37\x98\x0b\xaa\x9d\x6c\x79\x39\x29\x73\x69\x92\x35\xad\xa8\x11\x29\xbb\xd4\xdb\x42\x72\x86\x89\x94\x3d\x62\x3d\xdd\x6d\x80\x74\x8d\xa1\xe9\xb3\x39\x27\x83\x7b\xd0\x60\xa9\xc5\x2d\xb3\x1c\x8d\xe9\xb3\x93\x0e\xd8\xbc\x68\xb6\x0f\x08\x3d\x3b\x09\xd0\xf9\xe1\x08\x6f\x68\x1f\xc7\x2c\x7e\x8d\x95\x0c\x31\x39\xa1\x93\x6e\xd8\x65\x86\x41\x93\xb8\x96\xb2\x9a\xe4\xc2\x20\xc7\xc6\x94\xf0\x2e\xa7\xb4\xb9\xdf\xf2\x70\xb8\xb4\xfb\xea\x1a\xcd\xd7\x2c\x8e\xde\x3e\xc6\xda\xe8\xb7\x34\xec\xfb\x03\x56\xb8\x45\x02\x84\x44\xdb\x47\x69\xca\xcd\x5a\x61\xe0\xf3\xea\x5d\x10\x30\x83\xad\x88\x39\x6d\x0d\xd6\x90\x1b\x77\x73\x8e\xf2\x71\x8d\xf3\x10\xe6\x77\xac\xa3\xd1\xb9\xa2\x9c\xe6\x51\x2a\xb8\x0d\x46\x55\x17\x1f\x05\xf2\x91\xdc\x62\x46\x43\xca\x6d\x36\x04\xa5\x61\xa2\xec\x3c\x09\xc4\x17\x73\x88\xbb\xa1\x03\x5a\x33\x50\xb2\xa6\x9a\x56\xaa\x72\x13\xd4\x29\xb6\x8f\x99\xd4\xb0\xfd\xd1\x4f\x57\x43\x38\xfa\xb5\xd2\x7c\x08\xdf\x1f\x5f\x0c\xe1\xec\xd5\xdb\xe3\x5c\x55\x19\xe9\xd0\x77\x78\xe0\x61\x59\x7a\xb3\x46\x84\xf9\x34\xfc\x22\x0d\xfb\x49\x20\xf8\x3c\x96\x97\x0a\x03\x85\x34\x1b\xd7\xb7\x75\x6a\x53\x33\x67\x98\x49\x5b\xe3\x6b\xbd\x85\xa5\x71\x72\x63\xd9\xa2\x81\xb7\xbb\x39\x77\x1a\x9f\x4c\x30\x3f\x82\x30\xde\x2a\xe3\x70\x36\x73\xc5\x40\x08\xf0\xd7\x4a\x4a\x9e\x5a\x71\x2b\xec\x82\x52\x91\x13\x98\x29\x93\x4f\x2c\x4c\x9a\x28\x6b\xc1\x5b\x49\x4a\xa4\x1c\xd0\x0b\xcc\xed\xb6\x30\x9e\x31\x92\x41\xb7\xc0\xd6\xc8\xb7\xdf\xf8\xe2\x48\x66\x7e\x2f\xd7\x35\xd9\xf0\x66\x0b\x23\x4c\x39\xc3\x32\x0e\xdf\xa3\x79\x35\xde\x4e\xc9\xdf\x35\x9a\xfa\x80\x8a\xe3\x73\x3f\x06\xe6\x94\x5b\xaf\x15\xc0\xb3\x3b\xfa\x74\xb7\x22\xab\x58\x1e\xfb\xcc\x70\xe2\xd0\xcb\x65\x83\x3d\x57\xef\xcb\x99\x66\x59\x6b\xe0\x04\xae\xe7\x7c\x41\x54\xbb\x94\x56\x77\x43\xd8\xc1\x9b\x26\x18\xbd\xcd\x43\x0e\x5d\x9c\xa3\xb1\x8a\x08\x5e\x4b\x75\x27\xa1\x09\xae\xc7\xf8\x9c\x9f\x76\x8e\x26\x3b\xe5\x3d\x25\xde\x87\x12\x29\x4a\x5a\xa8\x1c\xa8\x91\x98\x16\x35\xa9\xa4\x98\x26\x0f\xcd\xc5\xa9\x0d\x6c\xee\xe7\x13\x06\x52\x67\x4b\xee\xab\xbf\xd3\x36\x86\xd6\x35\x59\xda\xb5\xa5\x1e\xe8\x6e\xa8\x3b\xe3\x6b\x5d\xb0\x09\x45\x1c\x95\x86\x4c\x98\xf0\x07\x96\x25\x59\x04\xdc\x27\x70\x5d\x69\x9f\xbb\x50\x98\xe6\x8e\xa0\x0c\x38\xbb\x82\xf3\x77\xd7\x70\xf5\xfe\xe2\xe2\xdd\xe5\xf5\xe9\xc9\x10\x8e\x8f\xce\xf1\xc9\xab\x53\x78\x7f\x7e\xf2\xee\xfc\xd4\x15\x24\xb9\xb8\x3c\xfd\xf1\xf4\xfc\xfa\x0a\xde\x5f\x7c\x7f\x79\x74\x72\x7a\x95\xc0\x2b\x9e\xb2\xca\x90\x4b\x80\x71\x7c\x49\xe3\xe2\x9e\xb9\x68\x30\x65\x62\x4c\x63\x8d\x8d\x5b\x74\xd2\x08\x61\x00\x67\x53\x58\xa8\x0a\xe6\xec\x96\x13\xa4\x76\x51\x2a\x83\x24\xc6\xd2\x54\x64\x78\x2b\x29\xc7\x70\x13\xa5\xe8\x17\x92\x7a\x36\xfd\x57\x83\xbd\x75\xdc\x0b\x2c\x04\x32\x65\x22\x47\xf5\xc3\x30\xfd\x39\xaa\x94\x5b\xae\x9d\xe4\x60\x8b\x24\xf2\xc8\x15\xb7\xce\x91\xe1\xe8\xff\xc1\xc1\x12\xb5\x1e\x44\x2f\x07\xf9\xc0\x2a\xa8\x5a\x1e\x4d\x32\x58\x67\xbb\x63\x79\x20\x9c\x69\xcb\xb1\xcb\x76\x82\xc0\x1f\xb7\x77\x9b\x12\x90\xae\x50\x44\x68\x8e\x6a\x9a\x51\x81\x20\xdc\x04\x74\x43\xc3\xee\xce\xbc\xb3\xc5\x2c\xe2\x0a\xee\x30\x43\xa6\x55\x68\x91\x50\x41\x8b\xe9\xc6\x79\xb6\x06\xb7\x76\x48\xa2\x6e\x86\x7b\x10\x9e\xfb\x2c\x98\xcb\x07\xad\x57\xfe\xc1\xcb\xdd\x62\xa9\x34\x24\xf8\xd5\xa6\xac\xd2\x2d\x54\xd4\x8d\xbd\x78\xc2\x6d\xe6\x11\x29\xfe\x35\x9a\x90\x4d\x81\x95\x00\x5c\x37\x64\x5f\x08\x1a\x25\x00\xaf\xa8\xdc\x11\x0a\x3d\x4d\x49\x91\x59\x86\xae\x5e\x14\x17\x9e\x91\x6b\x21\x82\x65\x8f\x50\x7b\x37\xa6\x42\x06\x74\xa2\x40\x68\x14\xaa\xda\x08\x64\xbd\x00\x9e\x90\x6d\x7e\x75\xd6\x48\x2d\x19\x2a\x99\x29\xb9\x21\x2c\x77\x5f\x03\x90\x32\xb3\xe2\xe9\x0c\x97\xf6\xaa\x53\x92\xd5\xb3\xd5\x1e\x84\x54\x03\x85\xd0\x5a\xe9\xa8\xe2\x34\x2f\x95\x11\x56\x69\x9f\xe2\x7d\x35\xdb\x2d\xca\x4b\x94\x88\x75\x00\x9d\x9e\x9b\x21\xf2\x5f\xd3\xe2\xc1\x86\x2d\x33\xb9\x3e\xae\xf3\xe6\x87\xd7\x90\xf1\x4a\x48\x3d\xb5\x4f\xf9\xdd\x52\x9d\x65\x85\xd9\x6b\x7d\x16\xde\xc9\x02\x32\x31\xc3\xc1\xa3\x89\x39\x15\xda\x58\xbf\x1e\x0f\xba\xd0\xf5\xa8\x8b\x61\x03\x22\xb4\x41\xb1\xcc\x12\xea\xeb\xa0\x5d\xbd\xca\xd6\x0b\x7f\x48\xec\xf0\x22\x90\x22\xb0\xa2\x1a\x5c\xaf\xa0\x22\x08\xd4\x98\xf6\x3c\x6b\xc0\x45\x49\xa5\x09\x5a\x32\x9f\x11\x23\xe1\xc0\x91\x79\x9b\x61\xd0\x99\x61\x77\x6c\x66\xc3\x6c\x6f\xec\x27\x6b\x2c\x3e\x19\xec\x2f\xb9\xfd\x50\xeb\x5f\x2e\xc1\xf4\xd6\x4f\x8b\x4b\x8b\xb3\x22\x0d\xc5\x1a\x35\x86\x15\x31\x87\xb2\x3f\x32\x71\xf8\x18\x46\x1c\xe3\xae\x95\x11\x99\xc9\xe0\x5e\x52\xad\x83\x4c\xdb\x25\xd1\x1c\x5c\x9d\xd6\xed\xf1\xef\x3d\xca\x1a\xdf\x71\xa5\xda\x93\x87\x27\xaf\xc9\x62\x08\xb9\xb8\xe1\xf0\x4b\xc5\x16\x78\x60\x1f\x4b\xe6\x8d\x3c\x6d\x8d\x32\x7e\x7b\xa8\xd2\x72\x74\xfb\xe7\xe4\xf9\x88\x69\x8b\x0f\xa8\x62\x0f\xcb\x8d\x0f\x6a\xf3\xa5\xe9\x10\xd1\x92\x93\x49\xeb\x92\xe8\xaf\xab\x97\xd4\x09\x3d\xe1\xde\xc3\xba\xc5\x8f\x3c\x62\x06\x7b\xea\x80\xcd\xe8\xf6\x6e\xf2\x78\xb0\x15\xc7\x67\xde\x99\xae\x89\x7c\xae\xee\xd6\x85\x59\xc0\x6a\x36\x9d\x8a\xd4\xf9\x56\xdc\xac\x7a\xea\x4f\x4c\x60\xfd\x64\xb0\x1f\x3b\x84\x64\xf3\xe3\xc1\x6e\x9a\x08\x79\xe9\x3d\x55\x04\xe8\xc2\x10\x50\x99\xda\x6f\x5c\x8e\x4f\x51\xa0\xc8\x5f\x32\x09\x91\xe3\xef\x71\x09\x6f\x14\xcb\x5e\x85\x02\x5d\x91\xab\x5a\xee\xa0\xad\xa4\xe4\x39\x89\xb9\xb7\x51\x0e\x93\xc3\xaa\xaf\xe6\x18\xf1\x8f\x11\xcf\xfd\x2f\x33\xac\x1d\x70\x43\xdb\x15\x78\x07\x7b\x53\xe2\x36\xed\x87\xde\x30\xcb\xf1\x4e\x47\x85\xc5\x3e\x28\xda\xb6\x66\xcf\xb6\x05\xa7\x7d\x18\x62\xf7\x1d\x88\xf3\xd8\xb0\x21\x63\xed\xbc\x0e\x64\xb4\x7c\xb3\xa0\x31\x5b\x34\x07\x13\xbe\x50\xde\xbb\xf3\x0e\x3b\x6d\x11\x9e\xb4\xf8\x51\xbc\xbe\xf3\x6f\x87\x74\x08\x83\x4d\x0a\x96\xce\x85\x8c\x93\x19\x67\xc2\xa3\xcf\x81\x99\xe2\x73\x56\xee\x4b\xc5\xac\x14\x9d\x3f\x1c\x88\x1f\x19\x34\x56\x8e\x8c\xd7\xd6\xa0\xc4\x6a\x6b\xea\xc7\xac\xa7\xb0\xed\xd0\xe1\x0f\xcb\xb0\xae\xa4\x30\xfc\xc8\xd5\xa0\xdb\xd4\x6e\x19\xd8\xa5\x6e\x81\xf7\xf0\x54\x7e\x94\xab\x94\xe5\xb1\xa8\x5d\x38\xe8\xd2\xea\xe3\x02\x9d\x44\xb4\xe9\x16\x7e\x3d\x64\x14\x61\x05\x1b\x25\x63\x10\xb0\xbd\xae\xa1\xf7\xd4\x99\x5d\xf3\xb2\x86\x3e\x1a\x37\x2d\x52\x20\x31\x1e\xf7\x70\x82\x11\x07\x72\x11\x3d\xd9\x04\x82\xa9\xa9\xe2\xac\x7d\xa9\xec\xc5\xbf\x7f\x99\x7c\xf9\x3c\x79\x9e\xbc\xa0\xd8\x19\xfa\x3c\xd9\xf3\xe7\xe3\xf1\x8b\xba\x84\x85\xb3\x82\x02\x9d\xf9\x91\x10\x1b\x4c\xc2\xd9\xc5\xed\xd7\xe1\xd1\xfa\xfd\xd9\xc9\x97\x3b\x78\x33\xa4\x98\xc4\x43\x6a\xf1\x71\xfd\xde\xf9\x05\x8d\xe1\xcb\xaf\x06\x3b\xf7\xf5\x87\x38\x58\xd8\x51\x34\x10\xc4\x47\xc8\xb9\x9c\xd9\x79\x60\x38\x53\x4d\x64\x1d\xdd\x39\xbb\xb8\xfd\x73\x93\xbd\xe2\x15\x3c\x66\x8c\x98\xe1\x75\x3a\xab\x80\xe8\x16\xdf\x92\xd4\x6d\xd8\x83\xb1\x11\x83\xc3\xaf\xff\xdc\x18\x3a\x60\xb0\x31\x72\x32\xd8\x71\x7c\xb6\xa1\x9a\x94\xbf\x05\x3b\x86\x17\x5f\xfe\x75\x70\x8f\x52\x53\xbb\x0e\xde\xbc\xe0\x38\x3e\x3b\xb9\xdc\xb1\x09\x2f\x90\x9c\x9e\x27\xcf\x0f\x5f\x7c\xbd\x7b\x37\xde\xd6\xc3\x46\x06\x8b\x28\xe6\x4b\x92\x81\x02\x20\xce\x08\xf7\xac\x47\xb1\xff\x64\x70\x0f\xa2\x2b\x6c\x35\xee\x00\xde\xf5\xfb\x00\xd6\xdb\xeb\xf7\x81\x1a\x9a\xdb\xe5\x0b\x1f\x66\x1c\x2b\x97\xd6\x4a\xd8\xbf\x86\x32\xaf\x66\x42\x36\x0a\xcd\x39\x6e\xc7\xf3\x70\x0c\x58\x87\xe0\x49\x90\x0c\x14\x44\xc6\xef\xb1\xae\x4e\xce\xa9\xe1\xbb\x1f\xcf\x5f\xc7\xeb\x98\x71\x54\x5c\x9b\x79\x30\xa5\xfc\xc7\x97\x2f\xbe\xde\x4e\x2a\x7f\xf9\xf7\xaf\xef\x45\x2c\x1e\xce\x6b\xa4\xa9\xed\xc4\xd2\x5c\xf0\xee\xed\xf0\xba\x13\xc7\x5d\xa6\x16\x8f\x68\xac\xb6\x82\x77\xff\xf2\x7c\x7f\x83\x64\x27\x2c\xa3\xf6\x76\x6c\x6a\x83\x26\xd1\xfe\x24\xb9\x45\x06\xd2\x87\xdf\xe3\xc1\x56\xd4\xb8\x6a\x69\xd1\xf3\x74\xc8\x71\x0f\xbd\x26\x51\xd3\x75\xe6\xe1\x60\x3f\x85\x4a\xe1\x46\x61\x17\x17\x5a\xdd\x8a\x8c\x6f\xf2\xe5\x5a\xa0\x9d\x2d\xf7\xf1\xca\x83\xbc\x60\x9e\xc5\x58\xcc\x1d\x16\x75\x44\x4e\x60\x68\xcf\x6a\x54\x4f\x6e\xba\x29\xf1\x54\x61\xb8\xab\x01\x8b\x6e\xf3\x0f\xd7\x17\xcc\x98\xbb\x6c\x08\x6f\x4e\x8e\x2e\x86\xb4\x77\x67\x27\xc4\x32\xdf\x0b\xfb\x43\x35\xf1\x5d\xed\x02\x17\x44\xd3\x12\xfa\x4d\xfb\x58\x27\xf1\x35\xc5\x10\x9e\xac\xae\x83\x6a\x96\x1c\x70\x26\xd7\x0c\x17\x7c\x75\x1f\x39\x92\xa1\xf0\x77\x90\x12\xad\x22\xc0\xc9\x60\x6f\xc7\x73\x2b\x0e\x03\x18\x26\x00\x86\x4e\x0c\xe2\x0e\x31\x87\x35\xdf\xec\x1c\x31\x87\xde\x8c\x9c\xf9\x2b\x6f\xa9\xe6\xd4\x96\xe5\xeb\x8b\xd3\x75\x31\xa6\xe8\x40\x5d\xa4\x47\x6b\x09\x72\x03\xec\xb1\x47\xb8\xdc\x6e\x96\x6d\x5c\x6a\x68\xa2\x14\x7c\x15\x3b\x9c\x65\x17\x5b\x66\xe9\x02\x2e\xfe\xa4\x4b\x35\xa0\x77\xc0\x9b\xb2\x40\xa0\xf4\x80\xe5\x35\x35\x20\x4d\x32\x0f\x3d\x96\x7d\x42\xe2\xc0\x8d\x0f\x2b\x0b\x37\x0b\x2f\x4e\xdf\x8e\xb8\x4c\x55\xc6\x33\x38\x3e\x82\x49\x25\xb3\x9c\x07\x5d\x41\xce\x1a\xc3\x50\xb4\xd5\x48\x43\x4c\xa6\x73\x94\xff\x2a\x06\xfd\x89\xa0\xae\xdf\x5c\x35\x2b\x2e\x83\xbf\x84\x54\xeb\x18\x5f\xc6\x2f\x54\x51\xbc\xf6\x37\xdc\x0e\x52\x96\xa4\xda\x1e\xc4\xa9\xac\x02\xb4\x57\xfd\xb0\x58\x12\x9b\xee\xb7\x04\x1b\x3c\x8b\x27\x45\x8d\x75\x51\xbd\xe8\xd2\xa9\x34\x7f\x6d\x0e\x9d\x84\xa9\xaa\xb0\x86\x2d\xce\xbe\xca\x10\xbe\xcd\x5c\xd1\x2d\xa6\x78\x87\xa6\x9e\x27\x65\x34\x7b\x68\x48\xab\xdd\x63\xb0\xc6\x65\x07\xaf\x3f\xfc\xc5\x23\xd0\x4a\xf9\xb3\x63\x5c\xb0\x43\x45\xcd\x8f\x8e\xac\x44\xa0\x3a\x5a\x1f\x7e\x77\x11\xe3\x24\x6e\xdd\xc9\x60\x0b\x81\xec\x41\x6d\xdd\x2a\xfc\xad\xa1\xbb\x50\x6e\x1b\x11\x12\x8a\x97\x27\x72\xb5\xf6\x5f\xca\xb3\xc6\x52\x3a\xcc\xb2\xc3\x14\xea\x1a\xad\x69\xfe\x37\xa2\xab\x2e\x3b\x1a\xed\x30\xeb\xeb\x1f\x9b\x9b\x63\xaa\x3c\x7f\xcc\xb5\xdd\x8b\x57\x5b\x3d\x77\xb0\xad\xa1\x62\x74\x91\x65\xc9\x84\x8f\x12\x69\x99\x6b\x89\xfb\x68\xe4\x16\x13\x5a\x15\xf8\xd0\xd9\x74\xa9\x8f\x96\xc8\x59\x8c\x3d\x2f\xb3\xa3\xcd\xcd\x3d\xf9\xd1\x03\xfc\xfb\xf0\x62\x63\x51\xf7\x66\xca\x0d\x7c\xe6\xe1\xfe\xdc\x79\xcc\x6c\x2e\x5e\xf8\xb9\xf2\xd7\x6b\xbe\xb8\x1f\x7b\xf9\x8b\xd9\x8f\xc9\x5d\xe1\x02\x0f\x92\x74\xd0\xfc\x6b\x38\xae\xb1\x21\x42\xd6\x00\xa1\xa4\x58\x62\xb2\x1b\xbe\xe8\x99\xac\x67\xb2\x3f\x8a\xc9\x2a\x9d\x8f\x07\x7b\x60\xa9\xd2\x79\x40\x92\xb7\xe4\xde\x5f\xbe\x41\x2d\xe2\x75\x0a\x58\x35\x78\x14\x94\x74\x5a\xc1\x4c\xd8\x79\x35\x19\x0f\x3a\x02\xef\x9a\xfb\x8b\x06\x64\x67\xea\x96\xd3\xa1\xa4\x77\x3a\xbc\x37\xb6\xdb\xf7\xe8\x0d\xfa\xde\xa0\xdf\x62\xd0\x0b\xd3\x0a\x9a\xc5\x40\x47\xe6\xec\x30\x0c\x11\x07\xb1\xe3\x6f\x23\x31\x90\x4a\x8e\xc8\x69\x08\x5f\xbe\x6c\x10\xa5\x0d\x34\x7d\xee\xe2\xb4\x5e\xca\x7f\x07\x91\xea\xcc\x81\x4d\xd7\xb1\x37\xa0\x2b\x74\x0a\x28\xa3\xe8\x59\xb0\x2c\xce\x4e\x06\x8f\x84\x13\x37\xe0\xae\xea\xf6\x1b\xe1\xf3\xb5\xec\x51\x2e\x45\xe4\xb6\xc5\x52\xc3\x38\xd9\x20\x94\x5a\x2b\x73\x4d\x9b\x52\xa3\x31\xcf\x4e\xd9\xf1\xc8\x96\x50\x6f\xb3\x7c\x1e\x36\x4b\x10\x9b\xe3\xc1\x1e\xa8\x6a\xca\x5a\x44\x57\xd4\xaa\xfe\xeb\x93\xa7\x3c\x99\x25\x70\x50\x2c\xf0\x42\x17\x93\x8b\x24\x55\xc5\xc1\xb3\x10\x9d\x0c\xd7\xc8\x7d\x1c\x3a\x7e\xa9\xaf\xa6\xc1\x56\x38\xc5\x7b\xf9\xa5\xc6\x4b\x05\xf1\x74\x93\x2e\xa9\xd0\x3e\xac\x34\x0a\x97\x67\x8d\xff\x2a\xab\xa1\x1a\x98\x85\x43\xc3\x6d\x55\x1e\x86\x36\x5f\x04\xe0\x93\xc1\x23\x6d\x9d\xd2\x33\x26\xc5\xaf\xdb\x3e\xb3\xde\x80\xc7\x56\xcf\x88\xc5\x1c\x2f\xf2\xe3\xbc\x29\x65\x8d\xc0\xbb\x7f\xed\x86\x18\xc0\x0e\x5f\xf4\x12\x37\xcf\x40\x6c\xbe\xb1\xd9\x21\xd0\x7c\x8f\x45\x6f\xbb\x82\xd3\xfe\xcf\x72\x56\xec\x87\x16\xea\xb1\x0d\x1d\xae\xc1\x5a\x34\x24\xf0\x1d\x9d\x7f\x21\x69\x7e\xa3\xf4\xec\xdb\xc3\x6f\xb0\xf5\xb7\xc9\x0e\x00\xfe\x28\xfc\x74\x62\xd4\x99\xb0\x39\xdb\xcb\x34\xcf\x59\x47\xd3\xfc\x0d\xeb\x4d\xf3\xde\x34\x7f\xa0\x69\xde\xdb\xd4\xbd\x4d\xdd\xdb\xd4\xbd\x4d\xdd\xdb\xd4\x64\x53\x3f\x20\x0e\xa8\x58\xe3\xbe\x06\x7d\x2a\xfc\xfe\xf2\xcd\xe0\x51\xf0\xd1\x09\xfc\x99\x52\xb3\x7c\xeb\x3e\xb7\x20\x77\xcd\xbb\x58\x1a\xae\xe1\x23\x5b\x1a\xbd\x20\xeb\x05\x59\x2f\xc8\x7e\x3f\x41\x86\xae\x32\xcf\xb6\x25\xcf\xd8\x80\xae\x66\xc7\xc8\x68\xc1\xbe\xf7\xb2\xe0\xa8\x2c\x7d\x1e\x84\x4d\xf1\x02\xab\xa2\xe7\x87\xde\x1d\x1d\x23\xfe\x33\x4f\x44\xe6\xb6\xa4\x2b\x66\xe3\x41\xd7\x65\xfb\x0e\x1d\x04\x22\x93\xf1\x06\x1b\x4c\x45\xce\x5b\x0e\xc9\xe3\x8a\x49\x1c\xfe\x84\xd9\xfd\xdc\xb2\xd0\x69\x9b\x08\x62\x3b\x04\x10\x32\x49\xf8\x2a\xd8\x7f\x9e\x15\x31\x84\xe3\x37\xa4\x51\x78\xfe\xcf\x96\x44\x2b\x5e\x53\x04\xf0\xde\xbe\x53\x2f\xdc\x3e\x07\xe1\xd6\xa9\x19\x26\x75\xb3\x4a\x6e\xc5\x68\x0b\x93\xa1\x43\x07\x01\x10\x9b\x12\xbd\x29\x9d\xf5\x61\x98\x3e\x0c\xd3\x87\x61\xfe\x75\xc2\x30\xce\xf6\xd9\x9c\x41\x77\x03\xc2\xea\x6e\x88\xb6\x00\x3a\x45\x03\xa2\x48\xb9\xfd\x6a\xb0\x65\xb8\x7d\x90\xd3\xba\x6d\xb5\x17\x9c\xad\x9e\x3b\x64\xcb\x92\x19\xd1\xdf\xcb\xec\xef\x65\xf6\xf7\x32\xfb\x7b\x99\xfd\xbd\xcc\xfe\x5e\x66\x7f\x2f\x33\xcf\x58\x39\x1e\x74\x04\x1d\x1b\x77\x70\x3e\xf0\x93\xb9\x47\xf6\x37\x98\xb5\x5a\x4c\xaa\xb5\x39\xf5\xb6\x00\x5c\x77\x43\xbb\xce\xd0\xc7\x7c\xcd\x87\xf1\x13\x40\x54\x3d\x8f\xc8\x3e\xbc\x60\x62\x27\x55\xac\x40\x4b\xbd\x40\xb4\x33\x48\x35\xa0\xbd\x9b\x2b\x13\x73\x28\xd7\xc9\x81\x83\xf3\x83\xbd\xdc\x10\xfe\xeb\xe5\x04\xde\x79\xa1\x4d\x32\xaa\x92\x51\x42\x0d\x41\x2a\xdf\xd6\x5f\x68\x0c\x92\x38\xc8\xa5\x0e\xb0\x77\xbc\xd4\xb0\x07\xc5\xee\x77\xb9\xc1\x43\x91\xed\x8d\x67\x91\x3d\x0c\xc9\x74\xe5\xe1\xec\x24\x01\x5f\x3f\x2c\x4b\xe0\x3b\x4a\x62\x50\x5f\x08\x8d\x03\x06\xc5\x94\xc0\x91\x05\x4c\x97\x63\x01\xf3\xb5\xb6\xde\x07\xb9\x45\xbb\x24\x95\x8c\xf2\x12\xc1\xe3\x59\xa3\x31\x7d\xa1\xce\x42\x0e\xf4\x25\xe6\xc3\xa4\x7b\x26\x71\x34\x8e\x97\x9e\x32\x4c\xa0\x12\xf6\xb3\x3d\xe3\x41\x26\x0f\x3e\x9b\x1d\x7e\xb0\x2e\xba\xdf\x2e\x67\xc2\x94\x39\x73\x4e\xc3\x0e\x4e\x6a\x36\xdd\xc4\x50\x4b\xfb\xd2\xea\xd2\xde\x9b\xf4\x33\xda\x9b\x32\xa4\x8a\x7a\x6f\xb8\xbe\xd7\x46\xad\x8c\xf0\xb0\x5d\x8b\xc3\x91\x7e\x42\x88\x96\x39\x82\x62\xfd\xf5\xa8\x38\xdd\x41\x25\xb2\xcf\x05\xe7\x9d\xed\x92\x89\x90\xd9\xc9\xf9\x78\xb0\xc7\x5e\xb8\x2e\xcb\x06\xff\xc9\x39\xba\xae\xf8\xce\xdd\xad\xcc\x2a\x1d\x82\x72\x86\x33\x9d\xce\xa1\x9c\xb3\x4d\x19\x9a\xee\x81\x11\x9c\xe9\xc2\x87\x2d\xf7\x06\x3f\x74\xdc\xcf\x6b\xf1\x0e\x0b\x2e\x8b\xd5\x21\xd3\x4e\xab\xae\x7d\x91\xe6\xf4\x7f\xac\x43\xd2\xbb\x0e\x9f\x87\xeb\xd0\x47\xd1\xfb\x28\x7a\x1f\x45\xff\x84\xa3\xe8\x42\x1a\x9e\x56\x9a\xef\xc5\xa6\x4f\x42\xaf\x21\x15\xd3\xd4\x68\xaa\xb7\x8b\x6f\x85\xe8\xb1\x92\xc1\x8a\x41\xfa\xc4\x83\x6c\xe4\xad\x9f\x8e\x2e\xcf\xcf\xce\xbf\x1f\xc3\x55\xfd\xae\x4e\x82\xfd\x33\xe6\xb5\xfe\xb9\xce\xa7\x88\xc1\x03\x93\xce\x79\xc1\xe1\x00\xfd\x73\xac\xb0\x79\x80\xd6\x50\xe3\xaf\xf7\x97\x6f\xb0\xd0\x1b\x25\xc0\x09\x20\xa3\x05\x84\xbe\x4a\x33\xf2\xe0\xee\x6d\x5f\xbf\xb9\x1a\x52\x8d\x39\xf7\xe9\xdb\xcf\x61\x39\x3f\x37\x3e\x7e\xf3\x50\x50\xee\x47\xf7\xfb\xd0\x4d\x1f\xe6\xbb\x8a\x83\x86\xee\xf9\xc2\xe7\x8a\xfc\x79\xca\x72\xb3\xd2\xc1\xb3\x89\x4b\xfd\x4d\x6a\x93\xc1\x75\x3d\x4c\x1d\x5d\xb8\xb2\x4c\x5b\x7c\xc3\xea\x04\x9b\x14\x23\x0c\xf5\x45\xad\x52\xb9\x49\x04\xb7\xd3\x44\xe9\xd9\xe1\xdc\x16\xf9\xa1\x9e\xa6\x5f\xfe\xf5\xab\xe7\xc9\x93\x4e\x94\xb1\xb9\xe4\xdd\xfd\xc3\x3e\x4f\x7c\xdc\x87\x49\xb8\xfc\xee\x18\xbe\xfc\xf2\x2f\x7f\x41\x3c\xf9\x6f\x0e\xc2\x42\x1c\x7d\x38\x83\xd5\x5b\x19\x4c\xb3\x82\x53\x32\x62\x77\xd9\xc1\x67\x5e\x5c\x48\xcb\x3e\x06\x06\xc4\x81\x84\x19\x83\x47\x28\x5e\x90\x19\x97\x4a\xdb\x43\xbc\xe4\x97\xc9\x97\xd1\xda\x7d\x69\x52\x55\xf2\x97\x53\x91\x5b\xae\x9f\x0c\x1e\x85\x3d\x3b\x71\x53\xc1\xca\x52\xc8\xd9\x5b\x6e\xe7\x6a\x2b\x13\xb7\x90\xd6\xea\x45\x49\xd0\x74\x21\xa4\x4f\xeb\xe8\x65\x33\x22\xcd\xa7\x54\x16\xa6\x96\xd3\x48\x4d\xd8\xdd\xe9\x19\x74\x06\x4c\xab\xe6\xd8\x41\x9a\x33\x51\x1c\x0c\x1e\xb8\xfc\x5d\x02\xb5\x4d\x03\x41\x92\x06\xf5\x87\x79\xef\x7d\xfa\xa9\xe6\x72\x34\xb7\x95\x96\x41\xa1\x36\x56\x95\xc0\x08\x75\xf5\xdb\xf7\x57\xd7\xe4\xf8\x48\xf1\x4b\xc5\xc9\x82\x44\x21\xe1\x2b\x7a\x50\x42\xa9\x85\xaf\xb3\xb0\xaa\xc0\x68\xee\xd6\x30\x14\x50\x10\x19\x56\x56\xc6\xdb\xa1\x33\xcc\x99\xea\xa5\xbe\x4f\x0b\xee\x13\xf4\x27\x07\xa8\x7f\x0f\x12\xf7\xaf\x37\x2d\xe0\xe0\x90\xfe\x3c\xf8\x1f\xee\x9f\xf1\x01\x00\x5c\xf2\x69\x5d\xf0\x77\xa6\x32\x95\x12\x2f\xba\xcf\xba\xf1\x02\x56\x9d\x46\xf8\x50\x69\x31\x13\xf2\xb0\xbc\x99\x1d\xe2\x36\x1d\x62\x72\x4a\xf7\x9b\x37\x3b\x84\x92\x5f\xfc\xe8\x2d\x90\xe5\x64\x5f\x78\x54\xf9\xe4\xa1\x9b\x88\xb0\x9c\x9d\x74\xde\x46\xd7\xbc\x43\x20\xd4\x67\x0d\xeb\xaf\x5e\xf4\x57\x2f\xfa\xab\x17\xff\x32\x57\x2f\x48\xb1\x98\xfd\x98\x94\xba\x04\x75\xf7\x89\x9e\x44\xb8\x75\xf5\xa7\x10\xeb\x4e\x21\x1e\xcc\x22\xfb\x23\xf9\x91\xe3\xd3\x9f\x0d\xaa\x57\x02\xc6\x7b\xe3\x7d\x65\x84\xfb\x6f\xc2\xba\x70\xf3\xf2\x06\xac\x6f\x17\xb2\xfa\x92\x41\xdb\x28\x50\x49\x67\x3b\x41\x46\x1a\x4c\x6d\x93\x33\x51\x0c\x76\xae\xf0\x93\xd8\x9d\xfe\x2b\xc1\xfe\x2b\xc1\xfe\x2b\xc1\x4f\xe1\x2b\x41\xfe\xd1\x6a\x86\x39\x6e\x95\x16\xbf\xf2\x8b\x18\x44\xd8\x05\x05\xcb\x32\x2a\xdd\xc8\xf2\x8b\x3d\x36\x66\x0f\x74\xb4\xf6\x66\x13\x94\x64\xfd\xa2\x13\xeb\x8a\xed\x2d\x05\x41\x58\x16\x6b\x15\xb2\xd0\x97\x6c\x3d\x6e\x6c\xb2\x63\xfa\xfd\x10\x78\x85\xd1\x12\x33\xde\x7b\x49\xae\x5f\x5c\x05\x05\x5d\x08\x74\x0f\x25\x86\xab\x02\xa6\xa3\x44\x08\x07\x94\x07\x68\xcb\x8b\xec\xc0\x75\x4b\x06\x8f\x22\xf6\xf7\xd8\xa1\xae\xe2\x5e\x18\x53\x6d\xaa\xcb\xb1\x01\x39\xae\x4b\xe0\x46\x8c\x5a\xc5\xba\x14\xde\x57\x8e\x09\xa8\x99\x31\x5c\xa3\x1f\x64\xa8\x78\xd7\x99\xeb\xe9\xdc\xff\xa9\x68\x56\xa6\xc0\xb8\x29\x0e\x47\xe1\x86\x10\x0b\xa5\xf8\xa8\xc4\x08\x0b\xd6\xca\x50\x1a\xa6\x9a\x51\x60\xa3\x2e\x03\x96\x0c\x1e\x05\x65\x9d\x28\xca\xef\xfb\x0f\x9c\x65\xdb\x51\xd6\x42\x57\xab\x57\x87\x78\x83\x6f\x0f\x73\xd7\xe1\x53\x88\x3b\x6c\x50\x81\x9f\x6a\xd8\xe1\xca\x99\x6d\x29\xcb\xb1\xda\xaf\xb0\xa1\xba\xe7\x2d\xd7\x6e\x08\x5f\x33\x47\xc8\x54\x15\x0d\x94\x1b\x7f\x43\xfc\x96\xcb\x88\x7e\x53\x2a\x35\x75\xc5\xfa\xf6\x0c\x66\x7c\xea\xe1\x8b\x3e\x22\xf1\x99\x45\x24\xe6\x2c\xc7\xf2\x33\xfc\xfd\xe5\x9b\xf1\x60\x0f\x94\x35\x3b\x22\xea\x58\xb8\xab\xaa\x79\x26\x34\x9e\xee\x54\xb2\x21\x89\x78\x06\x87\x2b\x1a\x99\x58\xe3\xfd\x52\xb3\xf8\x8e\xfc\x1e\x5f\x5c\x82\xec\xee\x90\x85\xc9\x51\x3c\xfc\xf4\xd3\x4f\xa3\xa3\x46\xd7\x7a\x2d\x58\xa9\x2f\xcf\xd1\x21\x0b\xc0\xe0\x07\x96\x5c\xf3\x04\xfe\xed\xbf\x2a\x9d\xff\x3f\x04\x58\xf3\x32\x67\x69\x28\x2e\x8d\x3b\x9f\x56\x5a\x23\x93\xbe\xbf\x7c\x33\x04\x6e\x52\x56\xfa\x3a\x77\x1c\x0c\x9b\x52\xb9\x05\xe6\xb5\x46\xb4\x3a\x00\x62\x2c\xfb\xee\xee\x2e\xf1\x45\xf5\x29\x8c\x6d\x8c\x1a\xd1\x8d\xa2\x97\x08\xe3\xff\xf6\x33\xff\xdb\x7f\xd1\x08\x3b\x40\xa0\x36\x9e\x6e\xb6\x4c\x81\x98\x1b\x51\xf1\xa7\x43\xf2\x0b\x6a\x14\xbf\x8c\xf3\x84\x9b\x88\xfe\xeb\x94\x80\xa3\xe0\xec\xa3\x89\xa1\xab\xc7\xbb\xa2\xe3\x3c\x90\x63\x55\x14\x4a\x9e\x63\x68\x72\x3f\xaa\x5a\xee\xbd\x1c\xa1\x8e\x7e\x38\x35\x21\x6e\x8f\xd6\x93\x40\x9b\xca\x15\x15\x24\xa7\xb9\x19\x4c\x25\x8b\x71\xf5\x53\x82\xa0\x11\x32\x60\x33\x4c\xc6\x6e\x1b\xdf\x1c\x44\xc5\x82\x30\xa4\x4a\x1a\x94\x9e\xe8\xdf\x3b\x1c\x5b\x66\xc5\xed\x27\x6c\x83\x51\xf4\xcc\x59\x15\xfb\xed\x41\xb3\x63\x10\x8a\xbe\xdc\xf8\xdc\x3f\xc5\x83\xe1\x39\x4f\x6f\xbc\x80\x5f\x0a\xeb\x7d\xb2\x28\x99\xdf\x03\x1b\xf3\xee\x88\x88\xda\x51\x48\x57\x35\x4b\xa8\x4f\x37\x3b\x1e\x49\xa6\x7d\x85\x7e\xe8\xf4\xc7\x08\x7c\xac\xfa\xa4\x59\x8a\x6c\x17\xd2\x32\x6c\x90\xf3\xff\xf2\x62\x9e\x00\xfa\xbd\x44\x3c\x0a\xdd\xfb\x08\x96\x46\xbf\xae\x72\xa5\x19\x9e\xfe\x64\x59\x69\x25\x68\x7c\x1f\xe4\x6c\x1a\xa4\x2b\xa6\x56\xc3\xc8\x9f\x28\xbe\x3a\x99\xa6\x76\x63\xfd\xb6\x35\xa8\xc3\xc6\xde\x35\x89\xf7\x64\x56\x3d\x15\x6a\x15\x1d\x12\x2e\xed\xfa\x42\xd2\x7b\xac\x7b\xe7\x4a\xb6\x23\x04\x6b\x71\xb2\xac\xd8\x94\xe1\xa6\xb5\xc4\xd7\xa1\xed\x72\x9d\xb5\x19\x97\x5c\x93\x18\x8d\xc3\xa1\xff\xb8\xa6\xba\x5a\x37\x47\x2a\x13\x66\x9f\x72\xff\x27\xbe\x39\x39\xcb\xb7\x1e\xa6\x36\x24\xf5\xf9\xc5\x52\xfd\x37\x74\xd7\x97\xab\x11\x92\xf0\x62\xcd\xcf\x61\x56\x37\x32\xba\x93\x98\x68\x37\x19\x3c\xe4\xbe\x96\x56\x68\xc5\x29\x79\xad\xc5\x6c\xc6\x75\xc7\x45\x5f\xb6\x7b\xb9\x51\x56\xd6\x1e\xef\x8a\xe3\x9a\xb0\x2e\xab\x2f\x80\xec\x8a\xed\x67\x3e\x4f\x3c\xbf\x0b\x9f\xec\x20\x69\x7a\xa1\x8f\xa1\x0b\x51\x70\x63\x59\x51\x26\x1b\x61\xda\x49\xa1\x5b\xe9\x73\xcb\x4b\xd2\x31\x27\xe7\x57\xeb\x53\x04\xb4\x50\xf1\xee\xa8\x6e\x8a\x92\x8a\xe1\xc7\x14\x93\x9c\xc3\xc9\xf9\x15\x19\xe7\x51\x3e\x35\x0b\x02\xb6\x17\x4b\xd3\x25\xdf\x78\xb2\xf8\x36\xf9\x06\x6f\xa6\xb9\x14\x4e\xdf\x86\x98\xce\x9c\x61\x4e\x8f\xcc\x95\x1b\x3f\xba\x38\xf3\x53\x26\x83\x3d\x90\x52\xaa\x6c\x7d\x0d\xd1\xd6\x8a\x2e\x5c\xab\x20\x76\x1b\x05\x37\xd7\x16\x44\x4e\xe0\x08\xb2\x8a\xe5\x23\x63\x59\x7a\x13\x9e\xc2\x9c\xe2\x4f\xa9\x2a\x0a\xcc\x55\x84\x66\x04\xb2\x28\xd5\x72\xc5\x4b\x28\xcd\xe2\xb5\xc3\x50\xc6\x8f\x8a\xca\x53\x65\xc2\x70\x84\xb8\x54\xf9\x76\xbf\xd5\x7a\x76\x39\xd6\x3c\x33\x3b\xd6\xfc\x06\x6b\x0a\xbf\xa3\x60\xc1\x65\x0c\xc5\xf9\x90\x9b\x01\x2e\x55\x35\x9b\x37\x8d\x5a\xa4\xdd\x9c\x5b\x58\xa8\xaa\x19\xa4\x6a\x04\x49\x1c\x5d\x61\x41\x4c\x91\xf1\x7a\x75\x31\x32\x94\x0c\xf6\x13\x4d\x9b\xa3\x3a\xad\x85\x3c\x39\x5f\x8d\xd9\xd8\x04\xde\x2a\x8d\xde\xfb\x54\xd5\x17\xcf\x50\x46\xb9\xd2\xa6\x58\xb8\x3e\x53\xa9\x39\x4c\x95\x4c\x79\x69\xcd\x21\xd6\xa3\xbe\x15\xfc\xee\xd0\x57\xcb\x1e\xa1\xe9\x38\x72\x4b\x32\x87\x08\x8a\x39\xfc\x82\xfe\x81\xeb\x77\x27\xef\xc6\x70\x94\xf9\x72\xe4\x28\x7a\xa7\x55\x0e\x53\xc1\xf3\xcc\x24\xc0\x4a\xf1\x23\xd7\x46\x28\x39\x84\x1b\x81\x47\x69\x95\xc8\x5e\xae\xbf\x94\xb6\x65\x2f\xb7\x72\x2b\xd9\x85\xe3\xc1\x56\xbc\x5c\x60\x9b\x65\xd5\xc1\x5d\x25\x77\xea\x1f\x70\xb6\x46\x44\x23\x57\x63\x79\x7a\x1e\x4f\x56\x1c\x03\xf8\x31\x3d\xc1\x87\xb1\x89\x3e\x5c\xb0\xd0\xd7\xce\x1d\x86\x2b\x57\x56\xab\x1c\xca\x9c\x49\x5e\x07\xda\x7d\x05\x6b\x4d\x05\x8c\x55\x65\x23\xb9\x14\xb1\x44\x7b\x98\x22\x14\xab\xae\x4b\x4b\xb3\x52\x44\x32\x4f\xe0\x1a\x83\xbd\x3c\x3b\x3e\xaa\xe9\x10\x79\x30\x56\xd6\xec\x56\x2d\xb3\xb6\xd1\x2f\x4e\xdf\x42\x88\x31\xfb\x38\x80\x9a\xc6\xa3\x19\x96\xa3\x4d\x8d\x13\xc2\xf1\x91\x81\x4a\x22\xdb\x62\xb7\x94\x8d\x7c\x38\x3a\xd5\x16\x63\xb2\x43\xef\xc4\x08\x13\x7b\x4c\x16\xab\x82\x04\x43\xca\xb1\xa0\x7f\x5c\x6a\x53\x6a\xe2\x47\xa5\x2c\xc3\x3b\xae\xe6\x54\x66\xa5\x12\xd2\xdf\x05\x13\x33\x17\xc9\xdd\x93\xa7\x90\x15\x2e\xd6\x13\xcf\x0a\x01\xc5\xb6\x41\x2e\xa2\xef\xe7\xf1\xe7\x08\x08\x25\xfa\x0f\xd7\xd7\x17\xd1\x9d\x4b\x00\x4e\x31\xf6\x02\x05\x67\x12\x31\x84\xfa\x1d\xd7\x45\x3e\x1b\x46\xa0\x35\x37\x78\xb7\x0d\xc3\x6a\x12\xb8\xbc\x85\x5b\xa6\x93\xfd\x79\xc3\xfb\x4d\xfb\x2c\xc5\x74\x5b\xcb\xd5\x1f\xb1\x18\xa9\xba\xae\xc4\xb7\x04\x11\x75\xcd\xa8\xd6\x35\x21\x50\x16\xaa\x0e\x60\x18\x2d\x3b\x54\x1a\x50\xbb\xb9\x82\xa7\x3e\xa7\x7d\x5c\xb6\x69\x7d\x54\x80\x77\x59\x92\x7f\xda\xaa\xf5\x0a\x69\x77\x40\xc0\x6a\x27\x87\x8b\xb0\x76\x1e\x1f\x87\x23\x15\x3a\xac\x59\xd4\x1d\x5b\xfb\x9e\x0c\xf6\xf6\x93\x76\xac\x6a\x97\x0b\xe0\x05\xc2\xf1\x51\x87\xc5\x1e\xc4\xc6\xe1\xf4\xcc\x4b\x39\x5c\x57\x53\xce\x35\xce\xca\x18\xe6\x43\x6b\xc6\x3b\xc3\x49\x19\x1e\xd3\xd4\xe3\x91\xba\x0a\xd7\x98\x1a\x75\x8e\x4c\x55\xf8\x4b\xe3\x9e\x42\x7c\xb8\x54\xf9\x5b\xb8\xf1\x4f\x84\x48\x73\x53\x62\x90\x14\xad\x3f\xa4\x2e\x87\x63\x77\x5e\xb7\x0a\x42\xed\x15\x84\x73\x0f\x94\x95\xf0\xe1\xa0\x25\x3f\x3f\x1c\x0c\xa1\xe0\x7a\x86\xe3\x08\x5b\xcb\x66\x7f\x1d\x36\xdc\x8e\xa5\x95\xf8\x81\x9d\x9a\xb8\xd3\xc2\x86\xc9\x71\x00\x9e\xb5\x1a\x2d\xa3\x0c\x19\x24\x83\x0f\x01\xc5\xa3\x08\xc4\x87\x83\xa0\x36\x3e\x1c\x2c\x9f\x5a\x8d\x9c\x8e\xca\x3e\x1c\xd4\x3a\x25\x81\x63\x1f\xb9\x22\xbd\xe6\x03\x57\x56\x41\xc1\x6e\x02\x9b\xd5\x9f\xad\x98\xf6\x29\xf5\xca\xec\xc4\xa5\x2c\xcf\x97\x84\x51\xd0\xc3\x34\x9c\x5b\x6f\xc1\x16\x3b\x86\xc1\x04\x04\xd4\x61\x79\x30\x66\xe0\x8e\xe7\x79\x02\x1f\xe4\xda\xd3\x3b\xde\xc0\x53\xa4\x39\xa2\x0a\x3f\xd1\xf1\x11\x6e\xff\x2a\x7e\x3e\x1c\x24\xf0\x03\x06\xe3\x90\x5c\x65\x34\xf7\xeb\xd1\x9e\x0a\x09\x0b\x56\xe4\xcf\xc6\x38\x77\x6d\x2b\x8d\xe1\xf6\x05\x99\x4b\xe3\xc6\xd4\xe1\x58\x6e\xec\x8d\x41\x5c\xae\x6e\xac\xb1\x86\x7b\xbc\x72\xbe\x08\xe0\x7b\x42\x5b\x3d\x8f\xe1\x37\x7f\xa6\x36\x1a\x8d\x46\xaf\x4e\xbf\x3f\x3b\x87\xe3\xd3\xcb\xeb\xb3\xef\xce\x8e\x8f\xae\x4f\xf1\xe1\x08\x5f\x03\x1c\xbb\xcb\x26\x1b\xb8\xa9\x1e\xe3\xf4\xfc\x64\x65\x84\xf5\x1f\x92\x6c\xd7\xcd\xdb\x6d\xde\xdf\xfb\xfc\x72\xa7\x54\x0b\x3c\x3b\x1e\xec\x79\x42\xb9\xc5\x8e\xdd\xfa\xb2\xac\xf2\x7c\xd3\xb5\xbb\x16\x26\x2e\x62\x43\x24\x4a\x46\x1d\xe3\x15\x34\x89\x23\x53\xe5\x1f\xcf\x41\x5e\x54\xa2\x0f\x5f\x49\x2b\x1c\xbe\x9c\x79\xeb\x2d\x31\x32\x81\xbd\x64\x74\x29\x36\x24\x1c\x24\x99\x4a\x6f\xb8\x76\x64\xfe\x0f\xa3\xe4\x01\x09\xaf\x86\xe0\x45\x9c\x37\xa7\xfe\x3f\x57\xef\xce\x93\xc1\x7e\x34\xd0\xfb\x3c\x1b\x7d\x1e\xcd\x31\x40\xc4\x77\xd0\xc2\xa5\x6b\x15\x3f\x05\x0c\x99\x95\xdc\x53\x51\xb0\x19\x0f\x59\x82\x63\x5c\xb0\xe5\x0c\xec\xb9\x61\x34\x62\x87\x1d\x3b\xc3\x76\x20\xd6\x81\x83\x34\x83\xe0\x46\xd9\xdb\xf2\x9b\xf6\xc7\xe1\x66\x46\x1d\xb9\x19\xf7\xc1\xba\x63\xa3\x53\x99\xea\x85\x5b\xc9\x60\xeb\x32\xaf\x96\x9a\x37\xfd\x4f\x5e\x3f\x55\x53\x3f\xb0\x01\xf2\x04\x8d\x0d\x2a\x97\xdb\x34\xdb\xe4\x98\x5e\x85\x2e\x1a\xaf\xc7\xa1\xfb\x83\xbd\xca\x9c\xe1\x21\xd1\x47\x1f\x48\x24\x33\x3d\xc4\x19\x9f\xd0\xa7\xb2\x21\xfa\xc6\xa6\x36\x38\x6c\xde\xf1\xc3\xd0\x9c\xe6\x18\x4a\x1d\x02\xff\x88\x91\x00\xda\x04\x0a\xee\xa1\x29\xc1\xb8\x49\x27\x29\x32\xba\xd9\x97\x93\x5d\xd7\x0e\x94\x71\x74\x7a\x75\xfc\xea\xb8\x89\x28\x84\xd0\xcf\xdc\xc0\x19\xb2\xc6\x2a\x10\xbb\x01\xf1\xb9\x85\x43\x00\x73\x53\x93\x25\xa8\x5e\xf3\xc5\xe5\xff\x67\xef\xfa\x7b\xdb\xb6\xd1\xff\xff\x7e\x15\x84\x31\xa0\x49\xbf\xb6\x52\xa7\x5f\x74\x37\x03\x45\xd1\xcb\xad\xbb\x20\x6b\x67\xb4\xe9\x01\x77\x49\xae\xa3\x2d\xda\x11\x22\x4b\x82\x28\xa5\xf1\x86\xbd\xf7\xc3\xe7\xe1\x43\x52\xb2\x25\x59\x4e\x6e\xf7\xc7\x30\xa4\x40\x13\x89\xa2\xc8\xe7\xf7\x4f\x8a\x9f\x60\x5d\x9e\x66\x12\xdd\x85\xfc\x6d\xcb\x33\xc0\x94\x4d\x34\x1b\x8f\xd6\x1c\xdc\x84\x5c\x34\xa6\x90\x59\xbd\xad\x07\xd6\x64\xa2\x15\x2a\x71\x86\x20\x02\xc1\x81\xf8\xfe\x21\xd2\x64\xb9\x39\x9a\xc8\xc9\x38\x4a\x44\xae\xec\x13\xce\x0c\xe4\x17\x8c\x18\x9b\x55\x7f\x46\xdd\x47\x69\xa9\x09\x5b\xe4\x23\x9b\xf8\xf4\xde\x70\x71\x0b\x1b\xed\x61\x0c\xfc\xbb\x5b\xeb\x1e\x08\xbe\x78\xff\x69\x1b\xbb\x77\xeb\x1a\x3b\xe0\x35\x36\xee\x62\xb9\xd7\x56\xa4\x61\xe8\x53\x50\x5f\xa9\xfa\xeb\x89\xfa\x33\xff\x84\xb7\x21\x80\x5b\xd6\xb1\x0e\x15\x6f\x67\xe7\x00\xb6\x65\x57\xfc\x5a\x09\xe1\xd8\x40\xa6\x0f\x93\xc8\x2c\xc2\x87\xbd\xef\xd4\xc6\x57\x6b\xce\x95\xe5\x7c\x6f\x83\xf2\x7c\x35\xb1\xdc\x8e\xc4\xfd\x20\xd8\x6f\x58\xfd\xa1\x14\x6c\x6f\xea\xee\x41\xe1\x7b\x54\x5c\xb7\x9a\xa3\x07\x2d\x10\xc1\x05\x59\x5c\xae\xa2\xc4\xf8\x91\xe6\x77\x43\x04\x20\x15\xe5\x46\xdd\x4f\x88\xb2\xc0\x17\xb7\x4a\x9c\xdc\xcb\xfc\x24\x2f\x93\x93\xbb\xb5\x36\xcf\x9c\x68\x58\x62\x45\x80\xff\x44\x99\x44\x0f\x02\xbf\x71\x94\x02\x1e\x28\x45\xd5\x2c\xc7\xf1\x61\x68\xd6\xf3\xbc\x98\x7d\x39\xff\xf0\xee\xa7\x91\xb8\x98\x7d\xf9\xf8\xfd\x0f\xe7\x3f\x7d\xa0\xc7\x2e\x66\x5f\xde\xce\xce\xbf\x5c\x7c\xff\x4f\xa1\x92\xfb\x28\x4f\x13\xa2\xe1\x7b\x99\x47\xc8\x75\xe9\xa0\x75\xfb\x3d\xa0\x7c\xa7\x36\xe7\xa0\x99\x7e\x20\xbc\x30\xa3\xb7\x93\x9b\x79\x9a\x16\x5e\xb2\x7e\xcd\x71\x7e\x21\x3c\x9c\xaa\x1c\x81\xe4\x03\x6b\x51\xa8\x87\xbf\x4b\x18\xaa\x65\xe4\x7a\xc7\x2d\xd8\x9f\xb4\x9d\x5c\xad\xfa\xeb\x91\x8f\x34\xd8\x1b\x3e\x2b\x56\xff\xed\x02\xe3\x09\x6b\x6b\xb7\x7c\xf0\x33\xde\x5b\x01\xdd\x66\x1f\xe1\x67\x6c\xd1\xd8\x72\xd7\x6c\x6d\xf0\x08\x1e\x6b\xcf\x7b\xd7\x20\x79\xb9\xc9\x1c\x67\x7d\x95\x1b\x6f\x40\xe5\xca\xd2\x40\x9b\xae\x53\x49\xb9\x6e\x83\x89\x31\x34\x5a\x6e\xde\xad\xf5\xe0\x60\x4c\xb4\x63\x61\x4c\xa0\x18\x1c\x00\x1f\xa6\x89\x1e\x39\xbc\x4f\x7e\xa4\x85\xd2\x56\x2a\xed\x77\xcb\xe5\x51\x82\x72\xf2\xed\x69\xf0\x72\x12\xbc\x08\x5e\x9c\x4c\x5e\x8d\x96\xe1\x8b\xd3\xe9\xf4\x64\x32\x39\xe5\xf2\x68\x93\xf7\xd3\x8d\x6b\x38\xcc\x52\x0d\x06\x07\x60\x83\x41\xa0\xfb\x01\xcf\x9f\xa0\xc2\x47\x6a\x24\x61\x74\x1f\x21\xd5\xb9\x95\xcb\xb1\xd3\x12\xf1\x65\xe5\x3c\x8e\xf4\xad\x0a\x5d\x32\x87\x37\x59\xe1\x6d\xde\x46\xe0\xdf\x04\x55\x98\x96\x10\xda\xa6\x2e\xc3\x86\xb2\xa2\xdc\x35\xc0\xf3\xc4\x64\x19\x16\xc0\xc0\xaa\xa1\x7a\xa3\x35\x54\xdb\xb4\xc1\x99\x9b\xf1\x13\x4f\xf8\xde\xf4\x58\x6f\x6d\x5c\x36\xef\x17\x84\xe5\x76\x1b\x0c\x0e\xb7\x45\x92\x34\x54\xb3\xb4\xfd\x70\xfb\xda\x9a\x3f\xf0\xe0\x6d\xe3\xd1\x5d\x6f\x82\xcf\xb6\x15\x49\x3e\x91\x15\x1d\xf6\xc9\x60\xf0\x78\x53\x8a\xeb\x3d\xdb\x07\x6c\xed\xe2\xad\x19\x6f\x79\x12\x3e\x9d\x09\x5d\xa5\xb9\x38\x9f\xd9\xe9\xe0\x06\x7a\x53\x7e\x97\x70\x08\x72\xd6\xaa\x97\x8b\x5b\x69\x23\xce\x15\x3e\x6f\xdb\xd5\x1e\x16\xf1\x3f\x59\x07\x66\x76\xf6\x05\x38\xda\x4d\x61\x71\xf4\x74\x3d\xb4\xe0\x57\x66\x4e\x72\x85\xa1\x53\x8c\x84\x34\x43\xe1\x55\xc5\x26\x93\xee\xb4\x73\x03\xc7\x74\xac\xc7\x28\xf9\x29\x42\x5b\x2f\x4f\x3b\xc6\x99\xcd\xc3\x49\x5e\x35\xc4\x37\xfa\xa8\x4e\x88\x6e\xc6\x54\xcb\xfd\x3d\x3a\xce\x49\xa2\xe9\xa0\x07\x6c\x99\x5b\x2d\x78\x9b\x79\x71\xae\x20\x18\x3a\xd9\xb1\x5b\xf7\x61\x53\x6f\x67\xe7\x78\x59\x2b\x58\xc6\xa6\x36\x75\xcf\x98\x7f\xcc\x3e\xb4\xde\xbb\xe0\xc8\xff\x7d\x7b\x53\xfd\x58\x9c\xaf\x92\xa8\xa3\x72\x78\x2f\xf5\x76\x95\xce\xb5\x1a\x11\x0d\xe2\x03\x42\x38\xec\xcb\x57\xdd\x90\xfd\x31\x95\xe1\x5f\x65\x2c\x93\x45\x07\xe0\xac\x40\x6a\x1d\xf0\x31\x2d\x0b\xf5\x38\xa8\x74\x51\xf4\xd8\xee\xad\xf1\x5e\xa3\x91\xb2\x87\xc4\xdb\x73\x7e\x5a\xdf\x36\x7e\x6f\xe1\xcf\x6a\x9c\x3f\x4a\x35\x4e\x51\x26\x89\x8a\xf7\x60\xf8\x92\x06\x6d\xd9\x19\x36\x8a\xc2\xdf\x60\x25\xd5\xa6\x34\x15\x14\xc6\xaa\xd0\x23\x91\xa5\x21\x82\x6f\xa1\xa5\x57\x6d\xa3\x25\x75\x23\xf6\x40\x5c\x76\x79\x1c\x94\x5d\x9d\x52\xff\x6d\x9b\x58\x6b\x95\x28\x06\x10\x40\x81\xd3\x68\x5b\x31\xdc\xc1\x61\x82\x64\xdc\xb9\x8e\x1e\xc2\xf5\x71\x28\x6d\x16\x1d\x63\x11\x41\x4a\xcb\xf8\x2c\x5d\x67\x65\xa1\x3e\xaa\x2c\x8e\x16\xb2\xee\x21\x8d\x6d\xc9\xe1\xf6\xd5\x6a\x69\xde\xf6\x3d\x97\xbf\xda\xba\xc1\x79\x82\x41\xa3\xe8\x6a\x78\x89\x11\x35\x83\x1e\x7b\xd4\x85\x2c\xca\x2d\xda\xa8\xa1\xb5\x16\x7c\xfb\x44\xa3\x61\x97\xa3\x80\x82\xf0\x9a\xce\x41\x91\xf8\x3c\x0e\xea\x60\xe1\xd6\xd4\x9e\x18\xf4\x23\xc6\x45\x9a\x98\xae\xf7\x9d\x3b\x5b\xcb\x79\x76\xe6\x46\xa2\xbb\x23\xcd\x0b\x67\x1a\xd8\xcb\xe9\xb2\x46\x70\x35\x9b\x81\xef\x59\x2a\x14\x67\xdc\x8b\xe1\x1e\x27\x38\x91\x7d\x39\x15\xc3\xcf\x89\x2e\x33\xd8\x68\x2a\x1c\x8e\x6a\x7f\x72\x76\x69\xf8\xec\x91\x6e\xc8\xd0\x6d\xc3\x0b\xf7\x50\x15\x38\xa9\x9f\xb4\x2e\x8a\x9f\x25\x44\x44\x61\xb7\x63\xbb\x46\x1c\x98\x29\x63\x05\x99\xf1\x51\xe9\xb4\xcc\x17\x2a\x40\x16\x5a\x5c\xe2\xb2\x2e\xf2\x12\x15\x98\x90\x12\x85\x4a\x42\xd6\xe5\xb6\x55\x47\x63\x72\x38\x5c\xa4\xa6\x04\x77\xda\x93\xa4\x34\x87\xfd\x05\x78\x4b\xa9\x03\x0f\xd5\x40\x88\x77\xbe\x6c\x77\x44\x60\x12\xef\xd2\x94\x29\xc2\xbc\xf0\x57\xda\xe8\xc9\x89\xf8\xa8\xb8\xa1\xba\x4a\x23\xd2\xa1\x47\x8a\x65\x9a\x3e\xd3\xae\x13\x06\x6f\xe3\x4c\xfa\xc9\x89\xb8\x48\xd2\xaf\x49\xd3\x12\xe8\x9d\x84\x99\xeb\xe1\xdb\x7b\x19\xc5\x30\xfe\x51\x25\x72\x3d\x9c\xe5\x29\x15\x34\x46\xc9\x0a\x17\x20\x28\xaf\x87\x7f\x53\xe6\xd0\xd0\xeb\xa1\x9d\xfa\xff\x32\xf4\x07\xbe\x47\x4d\xc8\x85\xda\xbc\xa6\x09\x6b\xb7\xac\x37\xf8\x9a\xea\x46\xdc\x63\xa8\x29\xba\xdc\x64\xea\x35\x7a\x98\xab\x17\xdf\xcb\xac\x36\x51\x85\x3a\xaf\x6e\x50\xb5\x70\x3f\x09\x3c\xaa\x7f\x46\xba\x78\x7a\x3d\xf4\x7b\x1a\xa5\x6b\x10\x4c\x56\x6c\xae\x87\xa2\xb6\x82\xe9\xf5\x90\xd6\x60\xaf\xdb\x45\x4f\xaf\x87\x78\x1b\x2e\xe7\x69\x91\xce\xcb\xe5\xf4\x7a\x38\xdf\x14\x4a\x8f\x26\xa3\x5c\x65\x23\x28\xc5\xd7\xfe\x0d\xd7\xc3\x9f\x51\x60\xc1\x8b\x36\x31\x65\xc2\xb4\x16\xbf\x35\x15\x27\x74\x2b\x0c\x21\x62\xa9\x8b\xcb\x5c\x26\x9a\xa6\xbf\x8c\xda\xa3\xe9\x35\x82\xdf\x7d\xcc\xea\x0a\xdc\xa1\x8a\xf7\x3a\x1f\x8b\xc2\x8d\xb6\xc7\x20\x81\x29\x0c\x55\xc0\xbd\x92\x09\x6d\x26\x60\x8a\x77\xc7\x30\x91\xa3\x8b\xa9\x28\xdf\x10\x6f\xe0\x1c\xf8\x59\x39\xb0\x12\x08\xd3\x4f\x6f\x42\xa3\x28\x66\xbb\x03\xd5\x51\xb9\xaa\x3d\x35\x01\x73\xd0\xba\xdc\x8c\xe0\x36\x82\x9d\x8b\xcf\xc0\x77\x5b\xc0\x54\x01\x29\x06\x83\x6e\xf7\x0c\xbd\xa6\x63\xcc\xd8\x32\xae\x53\x47\xe1\xdf\x5a\x69\x2d\x57\xfd\x00\xce\x63\xb1\x3d\x29\x6e\xcb\xb5\x44\x12\x4d\x86\x58\xa7\xbf\x67\x4e\x1f\xc6\x66\xad\xf0\x91\x73\xc4\x62\x68\xeb\x0e\xfe\x0c\x62\x94\x19\xe1\x54\xd0\xc4\xb4\xd5\xf2\x42\xdb\x36\xbd\x96\x0f\x3f\xaa\x64\x55\xdc\x4e\xc5\xcb\xd3\x6f\x5f\xfd\xe5\xb1\x7b\xb6\xfa\xe5\x07\x93\x5b\xec\x08\x38\xd7\xb6\xbf\xfb\x98\xc8\xeb\x42\x29\x70\xb5\x32\x9c\xb6\x04\x79\xb8\x63\x47\x3c\xc5\x7c\xc5\x79\xc2\xaa\x10\x68\x4c\x08\x45\x99\xa5\x49\x40\xa2\x10\x0d\xc3\x70\x6a\xe8\x34\xe7\xc6\xc9\x22\x27\xe1\xe2\x8d\x98\x9c\x8e\xc4\x9c\x41\xbb\x2b\xdb\xae\x1e\x6e\x82\x86\x25\x47\x5a\x7c\x37\xda\x5a\x0f\x8e\x50\x28\x49\x2d\x80\x9e\x4c\x59\x1c\xca\xef\xb8\xd6\xac\x45\x57\xc0\xe8\x36\xeb\xdd\x47\xa5\x51\x52\xbc\xfa\xff\x96\x31\xeb\x28\x89\xd6\xe5\x7a\x2a\x5e\x0c\x1e\x1b\x61\xc8\x95\xd4\x3d\x71\x68\x86\x7a\x05\x29\x21\xf2\x56\xb9\x5c\xaf\x65\x11\x2d\x7c\x5e\x24\xaf\x12\x32\xf6\xcf\x0f\x5a\xf7\xd5\xc1\xee\x99\x66\x69\x53\x21\xed\x59\x9e\x86\xe5\x02\x65\xd7\xa9\x3b\x2d\x74\x51\x01\x37\x98\xd2\xd0\xbe\xb1\x7a\xb8\x1f\x55\x85\xf6\x00\x38\xe8\x1a\x14\x06\x47\xc9\x4a\xb3\xc7\x1c\x69\x93\xaf\xe3\x72\xc4\x5b\x05\x41\xe5\x4f\x71\x23\xf3\xa2\xd6\x54\x2e\x56\xa5\xcc\x65\x52\x28\x15\x22\xe5\xe5\x0a\x2c\x4b\x55\x11\x6c\x52\x9c\xc9\xb5\x8a\xcf\x50\x30\xc2\xbc\x67\x18\x93\xde\x45\x4b\xe4\xea\x5d\xe2\xcf\x1e\x8c\x39\x79\x71\xda\x81\x69\x37\xaa\x65\x48\x86\x93\xc2\xf3\x64\x2a\xfe\x7d\xf5\x76\xfc\x2f\x39\xfe\xe5\xe6\x88\x7f\x79\x31\xfe\xee\xcb\x68\x7a\xf3\xbc\xf2\xe7\xcd\xf1\x9b\x6f\x1e\x2b\x02\x9a\x6c\xd4\x16\x92\x61\xf5\x90\x2e\xeb\x88\x1f\x09\xfe\x7a\xd2\x25\x9d\xba\xfe\x0e\x07\x8d\x8f\xc4\xe7\x84\x84\xfe\xe3\xc2\x1a\x43\x4c\xd5\x5c\xdd\x47\xb7\xe9\x1d\xed\xf7\xf9\xdd\x8f\x05\x49\xef\x38\x0f\x06\x62\xe3\x9e\xa0\xa3\xa4\x42\x47\x24\xc7\x60\x8d\xd5\x5a\x8e\xdd\x7d\x63\x52\xbe\xc7\xf9\x09\x5e\x58\x05\x34\xe7\x36\x25\xeb\x02\x12\x47\x2e\xf2\x54\xa3\x76\xc1\x98\xa4\x9a\x7b\xb6\xac\xb1\x66\x44\xe0\x5c\x2d\x24\x82\xa3\x32\x9f\x47\x45\x2e\xf3\x8d\x5f\x9d\x46\x0a\x84\x0b\xd0\xe1\xbd\x1f\x69\xa5\x44\x80\xb0\xea\xae\xcc\x3c\x36\x92\x51\xce\xa3\x38\x2a\x36\x30\x09\x42\xb5\x48\x93\x65\x1c\xb1\xe9\xbb\x86\xe9\x2e\x13\x3e\x22\x2f\x57\x2b\xf5\x80\x36\x3c\x3a\x23\xc2\x1c\x2e\x71\x14\x26\x7a\x32\x39\x7d\xf9\xa9\x9c\x9b\x2f\xdc\xbe\x5b\x17\x27\xc7\x6f\x8e\x70\xac\x35\x24\x4b\x88\xec\xff\xbb\x75\x71\xbc\x9f\x97\x5e\x4e\x5e\xed\xe5\x93\xa3\x2b\xc3\x0d\x37\x47\x57\x63\xfe\xed\xb9\xbd\x74\xfc\xe6\xe8\x3a\xe8\xbc\x7f\xfc\x1c\x4b\xab\xf0\xd8\xcd\xd5\xd8\x33\x58\x70\xf3\xfc\xf8\x4d\xe5\xde\xf1\x37\xbf\x47\xb4\x6c\xd7\x8c\x6b\x1c\xc6\x06\x46\xe3\x3d\x23\x9c\x1b\x6f\x19\x14\xff\x0f\x42\x71\x51\xb2\xca\x2b\x9d\x03\xd3\x41\x27\x0f\x9d\xd7\x47\x5b\xc3\xd5\x66\x2c\x58\xd8\xc4\xa9\x0c\xc5\x9c\x03\x9d\xc4\x55\x79\x9a\x38\xf7\x8d\xc2\x34\xcf\xb4\x4b\x69\xf1\x0a\xac\x7b\x1a\xa3\x07\xc8\xe7\x3d\x9e\x07\x32\xcb\x34\xf5\x51\xe6\x6a\x91\xe6\xa1\x17\x69\x9c\x06\xa1\x75\x0b\x59\xd8\x3c\x0f\x15\xee\x67\x69\x56\xc6\x48\x0d\x7a\x93\xd5\xbe\xc7\x05\x76\x23\x2d\x7e\xc0\x5a\xaa\x61\xd9\x60\x70\x00\x91\x20\x26\x05\x36\x8b\x56\x7b\xc0\x86\xb6\x65\x53\xc2\xdd\xd0\xb3\x50\x39\x7e\x92\xf5\xbb\x55\xde\x16\x42\xfe\x3d\x2e\x0e\x6d\xdd\xf6\xc1\x61\x4e\xcb\x9f\x11\xcb\xd6\x88\x25\x5c\x82\x86\x90\x74\x57\xfb\xf4\x3d\xd7\xe9\x0f\x3a\x81\xc9\x2b\xb7\xbc\x52\xd7\xcb\xb6\xec\x95\xa7\xda\x6e\x31\xac\x05\x90\x0e\x45\x76\xa8\x74\x9b\xf8\xda\x5a\x22\x8f\xb4\x4b\xb4\x8b\xf1\x2e\x00\xb3\x1a\x65\x51\x81\xdf\x28\x26\x1a\x4d\xbf\xca\x3c\xd4\xee\xe8\xb4\xca\x30\x98\x5f\x1b\x9c\xa1\x5c\xc6\xf1\xc6\x06\x09\xa3\x5f\x54\x68\x57\xe5\x8e\x2c\xd1\xd5\xf2\xb9\x6a\x38\x5f\x7a\x55\x69\xc4\x81\xf7\xba\xb8\x8a\x2a\x47\x83\xb8\x6c\x39\x36\xad\x1b\x36\x4f\x2a\xe2\x7a\x72\xad\xf2\x5e\x3a\xdd\xa7\x7e\xba\xeb\x72\x3a\x55\x81\x10\xb7\x11\x2a\x85\x37\x3d\xe8\x82\x47\x56\xfd\x0e\xdb\x53\x06\x3a\x59\x23\x71\x9d\xab\x05\xcc\x1d\xa6\x99\x9d\x26\x59\xa6\x09\x76\x9c\xc9\x54\xb2\x88\x24\xdb\xdc\x7e\x35\xce\xd2\x8e\xc6\xb7\x6b\xca\xcc\x75\xb9\x26\x8e\x50\xca\x2c\xf4\x02\xdd\x54\xd5\x9a\x4b\x20\x5b\x93\xf9\xe4\x77\xdb\xfa\x92\xaf\xc8\x07\xf8\x31\xcb\x28\xf7\x45\xd5\xb4\x0f\xbc\xc3\x9c\x46\x40\xfd\xe6\xa6\x24\x8c\x36\xb4\xd8\x04\xe2\x33\x3d\xe9\xd2\x0b\x16\x18\xd4\xfe\x00\x2e\x46\x47\x10\x0c\x45\x2c\x2a\x62\x76\x4e\xe3\x18\x51\x83\x85\xbb\x31\x86\x4f\x2c\x13\xbb\x0c\xb8\xd0\xf8\x0a\x29\x56\x9b\xa2\xa6\x26\x5e\xa2\x70\xc9\x01\x8d\x05\x84\x72\xbb\x9e\xc9\x1c\xac\x13\x88\x9f\xa0\xd6\x00\x7f\x84\xc9\x42\x21\xd7\x69\x69\x74\x2a\xcf\x6c\x97\x87\x7a\x0c\x38\xf7\x79\x6b\x99\x6f\x6b\x5c\x76\x07\xff\x06\x02\x7f\xf7\x33\x4b\x81\x53\x34\x63\x65\x3f\x8d\xa4\x42\xfb\xfa\x2d\x74\xb7\xcc\xbe\x9f\x29\x85\x85\x1d\x1b\x55\xed\xe3\xb6\xd6\x5a\x7f\x8c\x42\x11\x54\x5a\x10\x69\x6f\x02\xf0\x5a\x09\x09\x24\x98\x6a\x04\x63\x77\x02\xa9\x57\x0b\x58\xd4\x88\xcb\x60\x86\x3f\x33\x9b\xa0\xb7\xc5\xbf\x99\x82\x77\x81\x38\xab\x5f\x30\x4f\xf0\xc7\xa5\x58\xe2\x41\x8f\xa3\xc6\x07\x65\x45\x24\x66\xe1\x07\x43\x68\x56\x83\x16\xbc\xa0\xa3\x52\x97\x38\x6d\xd3\xb2\x14\xb1\x08\x74\x04\x37\xea\xe0\x5a\xa2\x1e\xec\xf8\xe3\x66\xac\x1f\x16\x80\xc3\x0f\x36\x07\xf9\x3b\x45\x2b\x5d\xd7\xc0\xbd\xa2\xac\x87\xb4\xdd\xc2\x66\x64\xe5\xad\xb4\xc2\x07\xea\x87\x2e\x52\x7d\x88\x53\x4f\x4e\x34\x79\xfc\xd6\x64\x4d\xe4\x3e\xeb\xe5\x4c\xc2\xe0\xa9\x5b\x61\x04\x1c\x44\x9e\x95\x67\xac\x1a\x21\xda\xa8\x15\xf9\x30\xc2\x41\x9f\x3c\xfe\xbf\x85\xcb\xbe\xfb\x2a\x0e\xda\x51\x01\x03\x66\x19\xc3\x9e\xab\xc5\x76\xba\xf9\x8c\x45\x1a\x4f\xc0\x01\x2b\xa5\xab\x4f\xb2\x05\x51\x7b\x98\xcc\x80\x58\x71\x2b\xa6\x95\xaf\x1d\x93\x10\x18\xcb\xc5\x42\x69\x6d\x26\x22\xef\x82\x4a\xf2\xaa\x67\xeb\x2c\x94\x38\x42\x73\x69\x26\x91\x41\x4b\x97\xd5\x29\x6a\x8f\xf3\x3a\x8e\x83\xa7\xc2\x99\xba\xaf\x23\x15\xf6\x06\xb5\x7d\xa0\xb2\xcf\x2a\xb8\x39\x91\xea\x64\x31\x36\x6e\x24\x6d\xbc\x71\x2f\x13\x73\xb5\xa4\x8a\x83\x82\xf0\x42\x31\xd0\x38\x76\x07\xdf\x22\x4a\x00\xd5\x14\x6b\x55\x15\xe4\xd5\x58\x1a\xf7\xb9\xee\xdf\x7e\xf7\x61\x43\x1d\x76\x73\xfb\xf6\xad\x05\x8d\xd2\xd3\xb5\xc4\x99\x79\xf6\x2a\x44\x33\xc7\x3f\x37\xd6\x71\x62\x38\xf0\x08\x67\x9f\x72\xaf\x2f\xe0\x48\x92\x24\x4c\x95\xa1\x33\x8e\x67\x4a\x3b\xe7\x08\x07\x0a\x43\x6f\x93\xae\x2e\x73\x25\xd2\xc5\xa2\xcc\x61\xfd\x42\x64\xdf\xdb\xf7\x90\x94\x42\xec\xa5\xd1\xb4\x79\x22\x9d\x74\xdb\x7f\xb0\x00\xeb\x2a\xaf\x75\x58\xbb\xa1\xc8\x91\x06\x2b\x98\xba\xc6\xb4\x96\x1c\x8d\x1d\x85\xb5\x0c\xd8\x63\x8d\x76\x05\x27\x0e\xc9\x7a\xd4\x28\xa6\x21\x81\x60\x53\xe4\xc6\x97\x60\x44\x5b\x76\x27\xfb\xdd\x99\x91\x7a\x93\x2c\xaa\x8c\xe1\x34\x89\xff\xd0\x1b\x5a\xd9\x77\xf3\x1c\x9c\x34\xc3\x8c\xd6\xcd\x81\x89\x59\x89\xe9\x71\xbe\x11\x5c\xe5\x92\x30\x42\xfa\x46\x2d\x5e\x57\x30\xe8\x12\xf8\xed\x79\x89\xee\xa4\x43\x3b\x45\x8d\xed\x7a\x1b\xee\xec\xc2\x72\xd0\x1b\xc5\x8d\x37\x76\x2e\x9a\xf9\x2b\x66\x06\xec\x4d\xb9\xaa\x1a\x1e\xba\x9c\xbb\x48\xea\x74\x50\x0b\x86\x8b\x5f\x7f\x1b\xf8\xb8\xb8\xc9\x41\xaa\xb0\x72\xe6\x2e\x6a\x9c\xa6\x62\x68\x22\xd0\x59\x5c\xe6\x32\xe6\x3f\x3d\x62\xa6\xe2\xea\x66\x20\xb8\x85\x92\x3d\x76\x3d\x15\x57\x37\x83\xff\x0c\x00\x09\x01\x0c\xe1\xf1\x37\x01\x00")
 
 func hypershiftOperatorHypershiftOpenshiftIo_hostedclustersYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -338,12 +359,12 @@ func hypershiftOperatorHypershiftOpenshiftIo_hostedclustersYaml() (*asset, error
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "hypershift-operator/hypershift.openshift.io_hostedclusters.yaml", size: 8487, mode: os.FileMode(0644), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x21, 0xe6, 0x7e, 0xe9, 0x8b, 0xf1, 0x46, 0xe4, 0x71, 0xd7, 0x44, 0x4e, 0xe7, 0x90, 0x48, 0x51, 0x49, 0xbc, 0xd, 0xc2, 0x54, 0x9a, 0xd4, 0xf7, 0x8b, 0x45, 0xd5, 0xb8, 0x44, 0x5c, 0xfa, 0xe2}}
+	info := bindataFileInfo{name: "hypershift-operator/hypershift.openshift.io_hostedclusters.yaml", size: 79857, mode: os.FileMode(0644), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb9, 0x83, 0x90, 0xdb, 0x1a, 0x8, 0xe8, 0xda, 0xa0, 0x2b, 0x36, 0x6b, 0xcb, 0x6c, 0xb4, 0xed, 0xc3, 0x3a, 0xa1, 0x41, 0x25, 0xf2, 0x5, 0x42, 0x7f, 0xe3, 0x4a, 0x74, 0xaa, 0x6f, 0x92, 0x36}}
 	return a, nil
 }
 
-var _hypershiftOperatorHypershiftOpenshiftIo_hostedcontrolplanesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x4f\x6f\x1b\xbb\x11\xbf\xeb\x53\x0c\xf2\x0e\x6e\x51\x4b\x72\x9a\x4b\xa1\x4b\x61\x28\xef\xa1\x46\xd2\x26\xb0\xfd\x72\x29\x7a\xa0\x96\x23\x2d\x6b\x2e\xb9\xe5\x0c\xe5\xa7\x16\xfd\xee\xc5\x90\x4b\x69\x25\xed\x2a\x8e\xd1\x77\x79\x88\x2e\xb6\xc8\xe1\x70\xfe\xfc\xe6\x1f\xa5\x5a\xf3\x05\x03\x19\xef\x16\xa0\x5a\x83\xbf\x30\x3a\xf9\x46\xb3\xa7\x3f\xd1\xcc\xf8\xf9\xf6\xed\xe4\xc9\x38\xbd\x80\x65\x24\xf6\xcd\x3d\x92\x8f\xa1\xc2\xf7\xb8\x36\xce\xb0\xf1\x6e\xd2\x20\x2b\xad\x58\x2d\x26\x00\xca\x39\xcf\x4a\x96\x49\xbe\x02\x54\xde\x71\xf0\xd6\x62\x98\x6e\xd0\xcd\x9e\xe2\x0a\x57\xd1\x58\x8d\x21\x31\x2f\x57\x6f\x6f\x66\xef\x66\x37\x13\x80\x2a\x60\x3a\xfe\x68\x1a\x24\x56\x4d\xbb\x00\x17\xad\x9d\x00\x38\xd5\xe0\x02\x6a\x4f\x8c\xba\xe3\xda\x5a\xe5\x90\x66\xf5\xae\xc5\x40\xb5\x59\xf3\xcc\xb7\xe8\xf2\x7f\xc6\x4f\xa8\xc5\x4a\xa4\xd8\x04\x1f\xdb\x05\x8c\x91\x65\xd6\x45\x5e\xc5\xb8\xf1\xc1\x94\xef\x53\xa8\x6c\x24\xc6\x30\x55\xad\x49\x2b\xd9\x1a\x7f\x49\x72\x2c\xb3\x1c\x9f\x45\x8e\xb4\x69\x0d\xf1\x87\x11\x82\x8f\x86\x38\x11\xb5\x36\x06\x65\x07\x75\x49\xfb\x54\xfb\xc0\x7f\x3b\xc8\x34\x85\xba\x6a\x0f\xff\x75\x44\xc6\x6d\xa2\x55\x61\x88\xcd\x04\x80\x2a\xdf\xe2\x02\x12\x97\x56\x55\xa8\x27\x00\x9d\xb5\x13\xd7\x69\x67\xcf\xed\x5b\x65\xdb\x5a\xbd\xcd\x3c\xab\x1a\x1b\x95\x2f\x05\x10\x23\xdd\x7e\xbe\xfb\xf2\xee\xe1\x68\x19\x40\x23\x55\xc1\xb4\x9c\x3c\x77\xae\x27\x68\xc1\x06\x12\x70\x2d\xff\x93\x09\xa8\x81\x58\x31\x82\x5f\x8f\x19\x2e\xd9\x25\xf8\x16\x03\xef\x6d\x9f\x3f\x3d\x84\xf6\x56\x4f\xa4\xb8\x12\x41\x33\xd5\xd1\xf5\x9d\xca\x22\x40\x52\x42\x24\xe0\xda\x10\x04\x6c\x03\x12\xba\x0c\x56\x59\x56\x0e\xfc\xea\x9f\x58\xf1\x0c\x1e\x30\xc8\x41\x71\x44\xb4\x5a\x30\xbc\xc5\xc0\x10\xb0\xf2\x1b\x67\xfe\xbd\xe7\x46\xc0\x3e\x5d\x63\x15\x23\x31\x18\xc7\x18\x9c\xb2\xb0\x55\x36\xe2\x35\x28\xa7\xa1\x51\x3b\x08\x28\x7c\x21\xba\x1e\x87\x44\x42\x33\xf8\xab\x0f\x08\xc6\xad\xfd\x02\x6a\xe6\x96\x16\xf3\xf9\xc6\x70\x89\xbe\xca\x37\x4d\x74\x86\x77\xf3\xe4\x5f\xb3\x8a\xec\x03\xcd\x35\x6e\xd1\xce\xc9\x6c\xa6\x2a\x54\xb5\x61\xac\x38\x06\x9c\xab\xd6\x4c\x93\xb0\x2e\x45\xe0\xac\xd1\x3f\x84\x2e\x5e\xe9\xea\xc8\x78\xbc\x13\x74\x10\x07\xe3\x36\xbd\x8d\x84\xed\x0b\x56\x16\x68\x83\x21\x50\xdd\xd1\xac\xc5\xc1\x98\xb2\x24\xf6\xb8\xff\xf1\xe1\x11\xca\xd5\xd9\xe0\xd9\xb6\x07\x52\x3a\x98\x59\x4c\x64\xdc\x1a\x43\xa6\x5c\x07\xdf\x24\x2e\xe8\x74\xeb\x8d\xe3\xf4\xa5\xb2\x06\x1d\x03\xc5\x55\x63\x58\xfc\xf7\xaf\x88\xc4\xe2\x81\x19\x2c\x53\xda\x81\x15\x42\x6c\xb5\x62\xd4\x33\xb8\x73\xb0\x54\x0d\xda\xa5\x22\xfc\xd5\x8d\x2c\xd6\xa4\xa9\x18\xef\x65\x66\xee\x67\xcc\x53\xe2\x6c\xa7\xde\x46\xc9\x62\x23\x3e\x39\x8f\xa7\x87\x16\xab\x57\xc7\xe0\x78\x1c\xa6\x1d\xaf\x97\x77\xef\xef\x4f\x97\x47\x15\xed\xb8\x6d\x8d\xc6\xb0\x0c\xa8\xcf\x18\x9e\x28\xf3\xd1\x57\xca\x7e\x4a\x16\xb8\xc7\x35\x06\x74\x15\xa6\x12\xa2\x8c\x23\x40\xe7\xe3\xa6\x4e\x7e\x0c\x4d\x8e\x5b\xf6\x60\x91\x61\xe7\x23\x58\x2f\xb9\x3b\x29\x1c\xca\x59\x5d\x60\x67\x1c\x19\x9d\x37\x49\x35\x98\x33\xbe\xe4\xc5\xd9\x99\x40\xe3\xda\xcb\x27\x65\xcd\x81\xf5\xd3\x48\x91\xc4\x9b\x93\xcd\x5e\x1c\x1e\xc4\xa1\x54\xc4\xe0\x90\x31\x41\x51\xfb\x8a\x04\x85\x15\xb6\x4c\x73\xbf\xc5\xb0\x35\xf8\x3c\x7f\xf6\xe1\xc9\xb8\xcd\xf4\xd9\x70\x3d\xcd\x2a\xd1\x3c\xe9\x30\xff\x21\xfd\x81\xc7\x4f\xef\x3f\x2d\xe0\x56\x6b\xf0\x5c\x63\x80\x48\xb8\x8e\x16\xd6\x06\xad\xa6\x59\x2f\x89\x5e\xa7\x20\xbf\x86\x68\xf4\x9f\xaf\x06\x15\xb9\xe0\xcb\x51\x90\x26\xc3\x45\x6b\x1f\xb0\x0a\xc8\xdf\xbd\xfc\xdb\xf5\x72\x40\x8b\x8a\xf0\xae\x51\x9b\x01\x13\x5d\xe0\x4a\xa2\x65\x85\xdf\x9c\x3f\x88\xea\x0f\xb8\xfb\x0e\xa9\xdf\x2a\xa4\xa4\x92\x4b\x79\x3a\x36\xce\xb4\xd4\x9a\xd3\xd5\x7e\x35\x39\xdd\xdb\x27\xa0\x93\x8d\x3e\x66\x4f\xb6\x7a\xa8\x3c\xdd\x49\xb8\x7b\x51\x85\x66\xc5\x91\xbe\xad\x46\xa7\x23\x47\x55\xda\xaf\x44\x96\xff\x4b\x99\xae\xbc\xd3\xa6\x37\x84\x8d\x0a\x76\xb5\x2c\x94\x87\x60\xd1\xc8\xca\x58\x82\xb5\x0f\xe0\x1d\x82\x92\x06\x84\x0b\x24\xab\x18\x42\xea\xc3\x8a\x90\xb2\x78\x2e\xe8\x0c\x96\x1d\xe1\x5e\x96\x64\x3d\x02\x15\x70\x01\x6f\x6e\xb7\xca\x58\xb5\xb2\xf8\xe6\x1c\x4a\x86\xb1\x19\x0c\xa1\xcb\x01\x06\x60\x15\xf1\x63\x50\x8e\x4c\x99\x20\x87\xe9\x4e\x8c\x70\x7e\x4c\x5a\x5c\xd1\x8b\xcd\x21\x16\x85\xaa\x6b\x2f\x4b\xd7\xdf\xb7\x45\xa4\x22\xde\xee\x3c\x33\xe4\x4f\x4e\x3e\x0b\x10\x16\x53\x61\x3d\x42\x77\x31\x8c\x20\xb5\x8f\x44\x83\xd9\x77\x40\xb9\x8e\xb6\xc4\x0d\x81\xd2\xd9\x1d\xca\x1e\xe5\x43\xb5\xf2\x91\x8f\xb4\xda\x3b\x6e\x06\x8f\xd2\x96\x4b\x0f\xef\xec\x4e\x94\x5f\xa5\xdc\x4a\xb1\x41\x0d\xab\x1d\xd4\xb1\x51\x8e\x66\x00\x77\x9c\x7a\xf9\x0e\x4a\xf0\xd1\x38\x84\x9f\x10\x35\x54\xb5\x0a\xaa\x62\x69\xf6\x7f\xf7\xf3\x1f\x6e\x6e\x6e\x6e\x7f\x7f\x0d\xcf\xb5\xa9\xea\x32\x63\xad\x24\xdb\x39\x8d\xd2\xa7\x2a\x02\x87\xcf\x60\x25\x34\xc6\x8c\xf9\x55\x23\x05\x54\x74\x3a\x32\x8e\xd8\x28\x93\x16\xa7\xef\xc7\x86\xb2\x2e\x81\x90\x0c\x53\x0c\x72\x45\x27\xae\x7f\xb5\x90\x43\x99\x63\x44\xc8\x0e\x64\x25\x0c\x8b\x2c\xd7\x29\x46\xfd\x1a\x1e\x83\x4c\x9c\x3f\x29\x4b\x78\x0d\x3f\xbb\x27\xe7\x9f\xdd\xab\xe5\x4a\x04\x2f\x91\x4a\x08\xd3\x84\x62\xd6\xa6\xcb\x63\x5d\xbe\x08\xd8\xfa\xc0\x19\x20\x69\xac\x3b\xe0\xe9\x75\x52\x0d\xd7\x89\xfc\x99\x0e\x04\xf1\x20\x59\xb6\xe2\xe0\x96\x5c\x3f\xb0\x71\xa1\x15\x2a\x9b\x2a\x04\xb5\x3b\x4f\xc1\xfb\x64\xf8\x63\x37\xc7\x7e\x25\x19\x2f\x07\x8e\x1c\xd2\xf2\xd1\x40\xdc\x8f\xdc\xd5\xae\x8b\x24\xfc\xa5\x7b\x7d\xc8\xa3\x32\x41\xa5\x1c\xa8\xaa\x42\xa2\x82\x19\xe1\x0f\x6d\x4e\xd0\xfb\xa8\x6e\x7d\x1b\xad\x4c\xcd\xa0\xd6\x8c\x19\xeb\xc6\xad\x83\x22\x0e\x31\xcd\xbc\x90\x1e\x4c\x94\x1e\x48\x6d\x97\x73\x72\xed\x69\x40\xeb\x33\xcd\xa5\x7e\x94\xf0\x93\x23\x2e\xf5\x40\xae\xd3\x4b\x56\x6f\x3f\xdf\xa5\x52\x8d\x41\xe8\x52\xd1\x76\x9b\x61\x20\x7d\x05\x46\x82\xca\x17\x88\xf4\xd9\x87\xbd\x48\x72\xe4\xd5\xe2\x94\xac\x6f\x1c\xbf\xfb\xe3\x05\x81\x8d\x63\xdc\x60\x38\xa3\x18\x87\xfd\x34\xd9\x6a\x60\x59\xe4\xfd\x96\x96\x5e\x3a\xc9\xa5\x77\x6b\xb3\xf9\x0a\x42\x3f\xec\x09\xf3\x23\xd0\xbe\x5d\x2e\x15\x91\x52\xf7\x55\x40\x5b\x5e\x83\x34\xae\x55\xb4\x9c\xee\xa9\xf2\xf1\x9c\x53\x73\x56\xe8\x81\xf2\x7b\x4f\x3d\xec\xb6\xd7\x8c\x69\x4a\x0f\x0e\x4d\xc9\x15\x0b\x58\x4b\xa1\xb8\xec\xed\x7b\x61\x01\x1a\x9d\xe7\x94\xda\x15\x8f\x34\x7b\x29\x1c\x1e\xf6\xe1\x90\xae\x16\x44\x04\xac\xd0\x6c\x71\xff\x56\x37\x22\xff\xca\x7b\x8b\xca\x9d\xec\x6e\x87\xde\x7c\xcf\x64\x2c\x6f\xbe\x5d\xa4\x12\x36\xca\xb1\xa9\xca\xe9\x83\xd3\xd3\x00\x00\xaa\x6d\xad\x29\x35\x09\xbb\x97\xf3\x63\x0c\x82\x00\x4e\xb1\x3f\x8f\xc4\x51\x57\x8c\x4d\x30\x87\x36\xfc\x6c\x1e\x51\xfa\x05\x93\xc5\xd9\x62\x1e\x10\x16\xc0\x21\x66\xdf\x11\xfb\x20\x9d\x60\x6f\x25\xae\xf6\x4f\xbe\x45\x9e\xae\xcb\x80\xff\xfc\x77\x72\x68\x38\xa4\x30\xb4\x8c\xba\xf7\x3b\x43\xfe\x65\xe3\xcd\x9b\xa3\x1f\x29\xd2\xd7\xde\x40\x01\x7f\xff\xc7\x24\x5f\x8c\xfa\x4b\xf9\x41\x41\x16\xff\x17\x00\x00\xff\xff\x0a\x4d\xc2\xf3\x4a\x1a\x00\x00")
+var _hypershiftOperatorHypershiftOpenshiftIo_hostedcontrolplanesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfd\x73\xdb\x46\xb2\xe0\xef\xfc\x2b\xa6\x94\xbd\xb2\x7d\x2b\x82\xb6\xb3\x9b\xb7\x8f\x97\x8b\x4b\x96\x94\x44\x65\x5b\x66\x89\x72\x52\xf7\xd6\x7b\x9b\x21\xd0\x24\xe7\x09\x98\x41\x66\x06\x94\x98\xcd\xfd\xef\x57\x3d\x5f\x00\x49\x00\x04\x25\x65\x63\xef\xc2\x72\x95\x44\x62\x3e\x7a\xfa\x7b\x7a\x06\xdd\x34\x67\x3f\x80\x54\x4c\xf0\x31\xa1\x39\x83\x3b\x0d\x1c\x3f\xa9\xe8\xe6\x2f\x2a\x62\x62\xb4\x7a\x31\xb8\x61\x3c\x19\x93\xd3\x42\x69\x91\x5d\x81\x12\x85\x8c\xe1\x0c\xe6\x8c\x33\xcd\x04\x1f\x64\xa0\x69\x42\x35\x1d\x0f\x08\xa1\x9c\x0b\x4d\xf1\x6b\x85\x1f\x09\x89\x05\xd7\x52\xa4\x29\xc8\xe1\x02\x78\x74\x53\xcc\x60\x56\xb0\x34\x01\x69\x06\xf7\x53\xaf\x9e\x47\x5f\x46\xcf\x07\x84\xc4\x12\x4c\xf7\x6b\x96\x81\xd2\x34\xcb\xc7\x84\x17\x69\x3a\x20\x84\xd3\x0c\xc6\x64\x29\x94\x86\xc4\x8d\x9a\xa7\x94\x83\x8a\x96\xeb\x1c\xa4\x5a\xb2\xb9\x8e\x44\x0e\xdc\xfe\xc5\xc4\x40\xe5\x10\x23\x14\x0b\x29\x8a\x7c\x4c\x9a\x9a\xd9\xa1\x3d\xbc\x54\xc3\x42\x48\xe6\x3f\x0f\x49\x9c\x16\x4a\x83\x1c\xd2\x9c\x99\x16\x16\x1b\xdf\x1b\x38\x4e\x2d\x1c\x13\x84\xc3\x3c\x4c\x99\xd2\x6f\x1a\x1a\xbc\x65\x4a\x9b\x46\x79\x5a\x48\x9a\xd6\xae\xc5\x3c\x57\x4b\x21\xf5\x65\x09\xd3\x90\x2c\xe3\xbc\xfc\x4b\x99\x3f\x15\xe3\x8b\x22\xa5\xb2\x6e\x98\x01\x21\x2a\x16\x39\x8c\x89\x19\x25\xa7\x31\x24\x03\x42\x1c\xb6\xcd\xca\x86\x0e\x9f\xab\x17\x34\xcd\x97\xf4\x85\x1d\x33\x5e\x42\x66\xe8\x88\x9f\x10\x97\x27\x93\x8b\x1f\xbe\x9c\x6e\x7c\x4d\x48\x02\x2a\x96\x2c\x47\x32\xd5\xad\x93\x24\xc8\x1b\xa0\x88\x5e\x02\xb6\x65\x12\x12\xa2\x34\xd5\x40\xc4\xbc\xa6\x7d\x18\x37\x97\x22\x07\xa9\x03\xee\xed\xff\x0a\x87\x56\xbe\xdd\x82\xe2\x09\x02\x6a\x5b\x6d\x4c\xef\x96\x8c\x00\x98\x45\x20\x04\x7a\xc9\x14\x91\x90\x4b\x50\xc0\x2d\xb3\xe2\xd7\x94\x13\x31\xfb\x6f\x88\x75\x44\xa6\x20\xb1\x23\x51\x4b\x51\xa4\x09\xf2\xf0\x0a\xa4\x26\x12\x62\xb1\xe0\xec\x97\x30\x9a\x22\x5a\x98\x69\x52\xaa\x41\x69\xc2\xb8\x06\xc9\x69\x4a\x56\x34\x2d\xe0\x98\x50\x9e\x90\x8c\xae\x89\x04\x1c\x97\x14\xbc\x32\x82\x69\xa2\x22\xf2\x4e\x48\x20\x8c\xcf\xc5\x98\x2c\xb5\xce\xd5\x78\x34\x5a\x30\xed\xa5\x2f\x16\x59\x56\x70\xa6\xd7\x23\x43\x5f\x36\x2b\xb4\x90\x6a\x94\xc0\x0a\xd2\x91\x62\x8b\x21\x95\xf1\x92\x69\x88\x75\x21\x61\x44\x73\x36\x34\xc0\x72\x5c\x94\x8a\xb2\xe4\x0b\xe9\xe4\x55\x3d\xd9\x40\x9e\x5e\x23\x77\x28\x2d\x19\x5f\x54\x1e\x18\xde\x6e\xc1\x32\xb2\x36\x61\x8a\x50\xd7\xd5\x2e\xb4\x44\x26\x7e\x85\xf8\xb8\x3a\x9f\x5e\x13\x3f\xb5\x45\xb8\xc5\x6d\xd9\x54\x95\x68\x46\x14\x31\x3e\x07\x69\x5b\xce\xa5\xc8\x0c\x56\x81\x27\xb9\x60\x5c\x9b\x0f\x71\xca\x80\x6b\xa2\x8a\x59\xc6\x34\xd2\xef\xe7\x02\x94\x46\x0a\x44\xe4\xd4\xa8\x1d\x32\x03\x52\xe4\x09\xd5\x90\x44\xe4\x82\x93\x53\x9a\x41\x7a\x4a\x15\xfc\xe6\x48\x46\x6c\xaa\x21\x22\xaf\x1b\x9a\xab\x1a\xb3\xfc\x87\xa3\x8c\x1d\x0f\x56\x1e\x78\x2d\xd6\x40\x93\x5d\x79\x9a\xe6\x10\xdf\x5b\x06\x9b\xe5\xd0\xc9\xe2\xd9\xe5\x14\x95\xca\xf6\x93\xc6\xb5\xe2\x7f\x5a\x24\x4c\xef\xf6\xd8\x58\xc7\x09\xb6\x31\xa0\xc7\x82\xcf\xd9\xa2\x90\xa0\x6c\x47\x92\x8a\xc5\x02\x39\xcb\xc8\x2e\x38\x7d\xe7\xf5\x32\x39\x99\x5c\x10\x65\x05\x16\x59\x2a\x96\xa0\x95\x91\xbc\x53\x33\xce\x3b\x9a\x23\xb7\xcc\x41\x02\x8f\x21\x21\xb3\x35\x61\x9a\x64\x85\x32\xfc\xc2\xb8\x19\x92\x7b\x35\xe9\xe7\x70\x18\xb2\x53\x44\x3b\x90\x37\x63\x08\x7f\x62\x63\x29\x27\x22\x65\xf1\xba\xee\xf9\xd6\xca\x4f\x2b\xcd\x4b\x48\x51\xc8\xc2\x0a\xc8\x2d\xd3\x4b\x03\xa9\xc5\x48\x6e\xc6\x46\xed\x43\xf3\x3c\x5d\x93\x82\x27\x46\x7a\xc0\x3d\x89\xd6\x34\x4b\xc9\x0d\xac\x23\x72\xa1\x51\x60\x51\x5c\x0c\x0f\xcc\xd6\xa6\x99\x9d\x93\xe4\x52\xcc\x59\x0a\xbb\x0b\xdc\xbf\x48\xfc\xe1\xb5\x8c\x50\xbb\xc8\x27\xc8\x34\x1e\xbb\x6e\x91\xba\x56\x30\xd1\x45\x90\x1c\x34\x18\xf7\x23\x11\xb1\x42\xdd\x17\x43\xae\xd5\x48\xac\x40\xae\x18\xdc\x8e\x6e\x85\xbc\x61\x7c\x31\x44\xbc\x0c\xad\xc8\xa8\x11\x82\xa3\x46\x5f\x98\x5f\xe4\xfa\xfd\xd9\xfb\x31\x39\x49\x12\x22\xf4\x12\x24\x29\x14\xcc\x8b\x94\xcc\x19\xa4\x89\x8a\x2a\x56\xe5\x98\xa0\xe0\x1e\x93\x82\x25\xaf\x9e\x0c\x6a\xd6\xb1\x8f\xbb\x5b\xa5\xd7\xff\xa4\x62\x71\x05\xda\xea\x8c\xf1\x60\x2f\xba\xde\x56\x9a\x57\x05\xc2\x60\xcf\x79\x58\x1e\x9b\x41\x48\x08\xd2\x52\xdd\x97\x98\x19\xbd\x3b\x59\x74\x25\xe7\x3b\xd3\x18\x39\x0b\x21\xe0\x45\x36\x03\x89\xf0\x24\x74\x8d\x2a\x99\xdc\x00\xe4\x16\x50\x48\x76\x00\x24\xdf\xe2\x2f\x42\x25\x90\x1b\xc8\xd1\xae\x2e\xa8\x4c\x52\x50\x0a\x87\xa0\x0b\x20\xb7\x4b\xe0\xa4\xe0\x0a\x74\xfd\x6a\xf0\x67\x2e\x64\x46\xf5\x18\x8d\xee\x97\x2f\x1b\x5b\x65\x8c\xb3\xac\xc8\xc6\xe4\x79\x63\x13\x4b\x39\xb4\xdd\x0b\x90\x0d\xad\x32\x7a\xf7\x9a\xc6\x37\x45\xde\x88\x3e\x24\xe0\x9c\x16\xa9\x1e\x93\x17\xcf\x3b\x23\xd1\x0d\xba\x8b\xc8\x06\xdc\x79\xdc\x3e\x1a\x5a\x5e\x3c\x14\x2d\x53\xf6\x0b\x74\xc2\x49\x77\xa4\xe0\x90\x1e\x23\xca\xfc\xcd\x49\x06\x0b\x3a\x5b\x6b\x64\x1b\x4d\x6e\x97\x2c\x5e\x12\xca\xb7\xb0\x83\x7d\x1c\xde\x3e\x09\xfc\xec\x51\x09\x4e\xf9\x8e\x07\xad\x88\x3b\xb3\x18\x1c\xec\x45\xdc\xc4\x0e\xe7\x11\xb7\x61\x28\xd0\x4a\x30\x48\xbc\xbb\x8a\x2a\x16\xf7\x33\xd6\x6c\x1a\x63\x89\x5f\x0b\x5a\xe8\x65\xf9\x7d\x44\x5e\x8b\x84\x81\x11\x4a\x55\xb1\xab\xef\x4f\x0a\x34\x46\xe2\x06\xb8\x15\x62\x0e\x2b\x90\x48\x85\x45\x69\x60\x70\x93\xa7\x87\x8c\x7b\x13\xd3\xa0\x96\x80\x17\x59\x3d\x02\x86\xad\x2b\x1f\x92\x1f\x25\xd3\x70\x65\xbd\x40\x0b\x67\x43\xc3\x93\x34\xed\xd2\xcc\x5a\xc4\xc1\x3d\x74\xff\x2d\xcc\x96\x42\xdc\x8c\xf7\x93\xe8\x47\xdb\x92\x28\xe0\x89\x77\x6e\x60\x05\xdc\xb8\xb1\x84\x12\x09\x99\xd0\x40\x66\x34\xbe\x01\x74\xb4\x39\xa1\x49\x62\x36\xd9\x9e\x72\x81\xe1\xef\xab\xe5\x91\xf4\xd6\x9e\x58\x57\xa9\xa9\xdd\x16\xe4\x6f\xb6\xba\x6d\xfa\x29\xee\x3b\x34\xc6\x84\x56\xa6\x20\x73\x61\xbd\x12\x87\xa2\xb0\xb2\xd2\x5f\xa9\x34\x36\xee\xca\x35\xba\xfa\x85\x44\xef\x00\xed\x9e\x86\x3b\xed\xed\x5c\xa5\xa9\x82\x14\x62\x1d\xbc\x5b\xcd\xb8\xb1\x88\xf5\x48\xe9\x86\x98\xfd\xfe\xcc\xbf\x9c\x4f\xd3\x81\xb7\x3b\x29\x32\xfc\x9f\x89\xa4\x8b\x19\x98\x51\x1d\x2f\x07\x9d\xb0\xfb\x4e\x24\xa5\x15\xd0\x12\xe3\x32\x6b\xc3\x50\x28\x3d\x8c\x2f\x36\xe4\x27\x22\xaf\x53\x11\xa3\x4b\x68\x20\x51\x44\xa5\xe2\x96\x24\xe2\x96\x9b\xfd\x41\xd8\x2d\x1a\xc7\x42\x2f\x2b\x32\x66\x9b\x36\x73\x4e\xb3\x86\xc2\x9f\xe1\x9e\x15\x0d\xc9\xcc\xc1\xd5\xa1\xc9\x10\xc9\x10\xeb\x3d\x64\x68\xa1\x95\xf7\xf2\xeb\xe1\x1d\x56\x24\xc8\x4a\xec\xe0\x60\x62\xb7\x3c\x8c\x45\x96\x0b\x0e\x5c\x5f\x64\x74\x01\xef\x57\x20\x25\x4b\xea\xe4\xcd\xeb\x34\x9a\x4e\x5a\xa5\xb2\x75\xb9\xad\x80\x94\xfb\x5a\x1f\xae\xac\x99\x60\x83\xd9\x4e\xeb\xfa\x98\xad\x37\x9b\x33\xe7\x77\xe3\x02\x0b\x0d\x21\xae\xa1\xbc\xe8\xbb\x09\x89\x09\x47\x96\x78\x50\x11\x29\x87\x42\xc7\xb6\x7c\x84\x61\x90\x14\xb7\x9b\x7a\x29\x54\x50\x21\xc6\xd7\x31\x61\xa9\x1a\xb7\xb7\x5d\x83\xb9\xcd\xb0\xdd\x44\xba\xc5\xa4\x20\xc7\x83\xbd\x62\xe6\x41\x44\x1b\xc9\x24\x64\x08\xb8\x6b\x31\x6b\x58\xb8\xdf\x4d\x9a\xb6\xd1\xe0\x7e\xfa\x36\x65\x18\xc7\x69\x7a\xda\x9d\x4b\xfc\x3f\xca\xd7\xef\xe7\x6d\x0d\x86\x1d\x3c\xb6\xcd\x96\x2d\x92\xe6\x56\x49\x35\x06\xfc\xc6\xe4\xff\x3e\xfd\xf8\xc7\x5f\x87\xcf\x5e\x3d\x7d\xfa\xd7\xe7\xc3\xff\xfc\xdb\x1f\x9f\x7e\x8c\xcc\x1f\xff\xf3\xd9\xab\x67\xbf\xfa\x0f\x7f\x7c\xf6\xec\xe9\xd3\xbf\xbe\x79\xf7\xdd\xf5\xe4\xfc\x6f\xec\xd9\xaf\x7f\xe5\x45\x76\x63\x3f\xfd\xfa\xf4\xaf\x70\xfe\xb7\x8e\x83\x3c\x7b\xf6\xea\x0f\x2d\x40\xdd\x0d\x4b\x6b\x33\x64\x5c\x0f\x85\x34\x8a\x85\x2f\xc6\x44\xcb\x02\x06\x4d\x3d\x37\xd8\xe2\xc9\x5b\x43\x9f\x2d\x4e\xc8\xe8\x1d\xee\xa6\x08\xcd\x44\xc1\x8d\x61\xde\x15\x0a\x9a\xa6\xe2\x16\x43\x70\x07\xda\x41\xbf\xcb\x35\x96\x7c\x94\x51\x4e\x17\x30\x74\xc3\x0f\xc3\xf0\x18\xdf\xd4\x94\x71\x90\xa3\x7d\x9b\xf5\x5a\xe5\xe0\x7f\xbc\x45\xe8\x19\xf0\x53\x65\x40\xe7\xb4\x6f\x2b\x23\xb7\x33\x6b\x65\x41\x6f\x07\x23\x72\x31\x27\x61\x1c\x0c\x42\x67\x4c\xe3\xae\x1a\xdd\x08\x4a\x02\x2b\x1d\x63\x48\xd0\xf9\x28\xc6\x15\x77\xcc\xcf\xd0\xb5\xa3\x26\x80\x06\x77\x79\xca\x62\xa6\xd3\x75\xb0\x0a\xc9\xb1\x0d\x2d\xdd\x32\x05\xd8\x89\x72\xc2\xb2\x3c\x35\x2a\xd4\x30\xf1\xd0\x06\x61\x5d\xac\xff\x93\x16\x88\x3d\x0d\x9c\x79\x71\xde\xe5\xfb\x1c\x24\xd5\xa2\xb7\x2e\xbd\x75\xe9\xad\x4b\x6f\x5d\x7a\xeb\xd2\x5b\x97\x07\x59\x97\x65\xf5\xb0\xcd\x9e\x79\xf5\x26\xa6\x37\x31\xbd\x89\xe9\x4d\x4c\x6f\x62\x7a\x13\xf3\x18\x26\x06\xe5\xf6\x64\x72\x61\x6f\x1c\x8d\x07\x7b\x89\xd7\x1b\x95\xde\xa8\xf4\x46\xa5\x37\x2a\xbd\x51\xe9\x8d\x4a\xab\x51\x29\xcf\x5a\xde\x19\xd9\xec\x8d\x4b\x6f\x5c\x7a\xe3\xd2\x1b\x97\xde\xb8\xf4\xc6\xe5\xc1\xc6\x05\x5f\xbc\x49\x8a\xfe\x1c\xbf\x3f\xc7\xef\xcf\xf1\xfb\x73\xfc\xfe\x1c\xbf\x3f\xc7\x7f\xe0\x39\xbe\xb9\xe1\xdd\x07\xc1\xfa\x20\x58\x1f\x04\xeb\x83\x60\x7d\x10\xac\x0f\x82\x3d\x3c\x08\x86\x59\x02\xa6\x98\x4a\xa1\x3f\x5e\xe9\x8f\x57\xfa\xe3\x95\xfe\x78\xa5\x3f\x5e\xe9\x8f\x57\x1e\xe5\x78\x25\x58\x96\xfe\x8c\xa5\x3f\x63\xe9\xcf\x58\xfa\x33\x96\xfe\x8c\xa5\x3f\x63\x79\xd4\x33\x16\xd5\x98\xbb\x62\x83\x66\xd5\x7c\x14\xf6\xf5\x4c\xf7\xc6\xb7\x27\x8c\xa3\x96\x7d\xeb\xd3\xe4\xea\x11\x85\xc9\x5a\xc5\x24\xc1\x57\x90\xcb\x96\xb1\xc8\xc0\x24\xb8\x8a\xc8\x69\xd9\xc3\x64\x4b\xd9\x19\xd2\xf6\xcf\x28\x67\xf3\xf2\xdd\x65\x0e\x0c\x89\x83\xe0\x34\x66\x47\x69\x4b\xaa\x30\xcd\xa8\xc9\xa6\xb7\xfb\x33\x24\xef\x20\x61\x45\x7d\x0a\x84\x21\x79\x4b\xe5\xa2\x9e\xc7\x5b\x85\xba\xe3\x7b\xba\xee\xa4\x0b\xb5\xf9\xa0\x95\x16\xa7\xb5\x9d\x02\xf3\x2a\xb2\x14\xb7\x2e\x11\x52\x12\xde\xba\xad\xbe\x9e\x8b\x09\x2b\x94\x3b\x57\x4b\x88\xe0\xce\x00\x20\x17\x22\x87\xfb\x37\x69\xa3\xc1\x61\xb6\x9f\x8b\x04\xa6\x26\x2b\x41\xd3\xa5\xf5\x43\x14\xef\x5e\x3d\xb9\x81\x93\xcb\xca\xdc\xc8\x18\x34\x49\xca\x5c\x1f\x08\x18\x51\xfe\x69\xed\x1b\xcb\xb9\x48\x54\x74\x1f\xf9\xc9\x25\x13\x92\xe9\xf5\x69\x4a\x95\xaa\xcf\x0a\xb6\x03\xec\x64\xbb\x4f\x29\x59\xf6\x01\x89\xf1\xc9\xfd\x20\x6d\xc4\x98\xca\x25\xd0\xe4\x24\x96\x42\xa9\xff\x12\x1c\x54\x07\x48\xa7\xdb\x7d\xdc\x28\x2e\x0b\x13\x60\xd4\x87\x1a\x1e\x03\x1a\x2f\xb7\x20\x2d\x5f\xf5\xc6\x04\x1f\xe9\x9a\x50\x33\x8e\xe9\xfa\x8b\x19\x4c\xcc\x1b\x58\xcf\x90\x4c\x1d\x13\xaa\xc8\x9c\x4a\xfc\xe5\xe9\xe8\xbc\x90\x36\x0c\xcc\x84\x48\x81\xf2\x9a\x16\x5a\xa4\x20\xab\xa9\x39\x5b\x17\x7f\x5d\xb6\x36\x39\x5e\x36\x78\xaa\x32\xd4\xa1\x74\x62\x1a\xb2\x86\xf9\xb7\x21\xb0\x42\x6c\x73\x02\x96\xe0\x20\xbb\x50\xad\x29\x8a\xb0\xe1\x71\xfb\x04\x3d\x34\xbe\x26\x68\x32\x50\xf3\x52\x4d\x32\x4c\xcc\xe0\xac\xab\x96\x2c\x4f\x81\x7c\x7d\x03\xeb\x63\x63\xb5\x8e\x61\x3e\x87\x58\x7f\x43\x0a\xe5\x73\x16\x9a\xf6\xf8\x41\xb8\x77\x4f\xc8\xd7\xfe\xaf\x6f\xea\xd7\xd2\x65\x3f\x40\x88\x9d\xa9\xf9\xf9\xd6\xb2\xcf\x4d\x73\xc2\x78\xc2\x62\xb3\x2c\xc4\xae\x5d\x96\x1d\x09\x17\x6d\x60\x8d\xc8\x79\x96\xeb\x35\xc9\x80\x72\x4c\xa2\xa8\x31\x27\x52\x9a\x6e\x34\x56\x11\xf9\x11\xcd\x46\xc5\xb8\x3b\x47\xd6\x46\x1e\x6d\x02\x9f\x4b\xe1\x54\x29\x1c\x93\x89\x49\x64\x52\x7e\x63\x52\xfe\x5c\x8a\xf3\x3b\x88\x0b\xdd\x90\x29\xae\x93\x08\xba\x6b\x0d\xb0\xee\x8c\x8a\x37\xb0\xf6\xca\xc1\xae\xe9\x06\x30\xb9\x10\xd5\x5b\x4c\xe8\xd2\x1b\xa1\x8b\xd3\x8e\x93\x1b\x58\x2b\xe3\x3c\x61\x7f\x1c\x0c\x3d\x20\xc4\xe1\x71\x49\x74\x9f\x19\xf0\xfc\x8e\x29\xad\xfe\x97\x65\xbf\x58\x64\x33\x97\x63\xc6\x0d\xed\x89\x60\x30\xee\x51\xc9\x13\xf3\xd1\x4c\xf3\x50\x44\x79\x80\x3a\x63\xcb\xbf\x32\x55\x49\xb1\x89\xc9\x78\x60\xfd\x04\x3d\xc7\xd4\x00\xaf\x96\x2c\xf7\x42\xec\xbc\xb7\x1f\x68\xca\x92\x30\x9b\xe5\x07\xbb\x76\xb3\x9e\xf3\x9f\x0b\x9a\x46\x3e\x17\x13\xa2\xd8\x7f\xe5\x1a\x21\x0a\x7f\x2e\xd8\x8a\xa6\xa8\xc2\xb4\x20\xb7\x2c\x4d\x62\x2a\x6d\x24\xdd\x4c\x72\x4c\x14\x4e\x49\x35\xa1\x46\xa2\x63\xca\x83\xd8\x96\xd4\x71\xd6\x3a\xa7\x52\xb3\x18\x33\xdb\xfa\x5c\xbc\xeb\x07\x33\x5d\xc9\x2a\x53\x88\x05\x4f\x54\x67\xa4\x5e\x6f\xf7\xac\x62\x17\xb1\x98\x83\x64\x22\x41\xd0\x35\xcb\x60\x9b\x31\x9f\xda\x4c\x65\x9e\xa7\xd0\x54\x18\xb1\x2c\x05\x6a\xc3\xd9\x46\x56\x33\xc9\x7c\x90\xed\xd9\x82\x0b\x09\xc9\xb3\x80\xaa\x8a\x24\x44\xe4\xf5\xda\x7b\xf6\xc6\xcb\x67\x8a\x60\x06\x54\x05\xfa\xd8\x65\x47\xf3\x6c\xea\xd0\x5c\x0a\xd1\x5c\x48\x93\xaf\xeb\x69\x22\x4c\x1f\x58\xb1\x58\x3f\x8b\xc8\x7f\x81\x44\x67\x3f\x21\x1c\x16\x54\xb3\x95\xe3\x10\x85\x04\x4d\x11\x7a\x8d\xa9\x99\x31\x9d\x9f\x22\xcf\xc9\x53\xd3\x8d\xb0\x2c\x83\x84\x51\x0d\xe9\xfa\x99\x4f\xfd\xa5\xd6\x4a\x43\xd6\x46\xb4\x4a\x0e\xb6\xaf\xfe\xd4\xd2\xae\xdb\xc6\xd2\x80\xd9\x99\xa2\x3f\x60\xeb\x4d\xb5\x62\x06\xd8\x26\x5d\x30\x1f\x22\x68\x0c\x2f\x24\xd8\xdb\x72\xff\x71\x29\x49\x3e\x59\xf0\x0c\x82\x4a\x09\x84\xfd\x6f\xa4\x3f\xa6\xf7\x32\x19\x9b\x1d\xb7\x3e\x90\xab\xf7\xb8\x66\xbe\x01\x95\x92\xae\x07\x07\x74\x4e\xea\xdc\x83\x0d\x0c\x9e\x5d\x4e\x4d\x9e\xd6\xd2\xe7\x46\x24\x9c\x5d\x4e\x43\xa2\xca\x32\xa7\xf2\x56\xb2\xd6\x68\x70\x98\x05\x9d\x51\x05\x67\x22\xa3\xac\x4b\xce\xcc\xd7\xa1\xb1\x27\x2f\x76\x27\x89\xfd\xaa\x36\x79\x6c\x44\xdc\x5b\xa7\x06\x7c\x9b\x64\x0b\x15\xa0\x2a\x66\xb6\x9b\x51\x4a\x5f\xe3\x83\x6f\xa2\xaf\x4b\x68\xbe\x39\x36\xda\x0d\xee\x28\xee\x8c\xd1\xfe\xa8\xa8\xa6\x95\x69\x64\x8c\x53\xec\x7c\x17\xbe\x90\xa0\xbc\x51\x76\x7c\xd2\x00\x69\x8d\x63\xc8\x14\xa6\x31\x4d\xa2\xc1\x3d\x78\x26\x97\x6c\x45\x35\xa0\xf3\x7b\x71\xd6\x01\x9b\x93\x6a\x7b\x8f\xd0\x8b\x33\x0f\x9d\x1b\xce\xe0\x0d\xfd\xd9\x90\xea\xad\xb2\x92\x63\xcc\x60\x67\xb5\x11\x76\x59\x60\xbc\x22\xac\x25\x2f\x66\x29\x53\x28\x61\x21\x6b\x36\x66\xc7\x96\xf7\x74\xf0\xcd\x70\x71\xf7\xd5\x55\x9a\xd7\x2c\xce\x3c\x7d\x8c\xb5\x99\xbf\x62\x4f\xf7\x07\xac\xb0\x45\x62\x7d\x9a\xec\x93\x38\x06\xb5\x4f\x78\xcf\x37\x1a\x5f\xaf\xf3\xa0\x0b\x39\x68\xcc\x40\x47\x24\xee\x66\xe8\x8c\xa5\x4c\xaf\xeb\xbd\x7b\x3f\x61\xcd\x3a\x5a\xd6\x30\x07\x8a\x49\xca\xbf\x43\x4b\x36\x3e\x50\x0f\xd8\x8c\xca\x97\xe2\x43\xbe\x90\x34\x81\x0e\x04\xde\xea\x81\x6e\x86\xb8\x55\x2e\xad\x38\x9d\x99\xa0\x81\x90\x24\x61\xca\x7f\xc0\x0c\xf0\x6b\x0f\x65\x44\xae\x0b\xc9\xb1\x91\xf1\xff\xdc\xb7\x44\x81\xc6\x90\xc1\xc5\x94\x5c\xbe\xbf\x26\xd3\x0f\x93\xc9\xfb\xab\xeb\xf3\xb3\x63\x72\x7a\x72\x89\xdf\xbc\x3e\x27\x1f\x2e\xcf\xde\x5f\x9e\xdb\xdc\xef\x93\xab\xf3\x1f\xce\x2f\xaf\xa7\xe4\xc3\xe4\xbb\xab\x93\xb3\xf3\x69\x44\x5e\x43\x4c\x0b\x9b\xf2\x0b\x43\x71\xdc\x8c\x8b\x66\xc4\x06\x74\x34\x4e\x19\x87\x74\xe6\x2b\x74\xce\xd0\xe8\x46\x84\x5c\xcc\xc9\x5a\x14\x64\x49\x57\x60\x20\xd5\xeb\x5c\x28\x82\x0a\x26\x8e\x59\x82\x17\x0b\x52\xdc\x66\x9a\x7c\xd0\x8c\x9b\x9e\x55\xbf\x55\x61\x6f\x19\x58\x14\x73\xae\xcf\x29\x4b\x91\x8d\x29\xe6\xda\x45\xd6\x5c\x81\xa4\xb3\x14\xc8\x2d\x5d\x47\x81\x60\x53\x70\xe9\xb2\x01\xfd\x3e\x72\x74\xba\x89\xd9\xa3\xe0\xdd\x20\x72\xb4\x20\xc5\x86\x27\x53\xcf\xea\x58\xd2\x01\x67\x6a\x89\x9c\xb6\x33\x04\xfe\x58\xda\x35\x65\xbb\xdb\xe1\x08\xdf\x1c\xf9\x9d\x9a\x42\x0d\x48\x04\x74\x3f\x3d\x75\x17\xce\xc9\xa2\x1a\x71\x45\x6e\xa9\x75\x68\xe7\x02\x0f\x87\xc4\x7c\xde\x38\x4f\xeb\xa6\x76\x8f\x58\x74\x33\xd8\x5e\xd2\x0f\x59\x30\xf0\x07\xad\x97\xff\xce\xcb\x6d\xd1\x78\x15\x75\x32\x6d\x4a\x61\xba\x81\x8a\xb2\x31\x89\x97\x94\x2f\x9c\xcf\xe2\x91\xe2\x1e\x2b\x9f\x0e\xde\x09\x49\x44\xc8\xb5\x49\x30\x6a\x6e\xff\x84\xcd\x62\x44\xc8\x6b\xc0\x62\x1a\x6b\x12\x53\x69\x32\x70\xd2\x04\x5d\xbc\xa0\x2e\x9c\x20\x97\x4a\x04\x2b\x4c\x60\x7e\xec\xca\x54\x28\x80\x56\x15\x30\x69\x1c\x72\xc5\x50\xf4\x3c\x78\x8c\x6f\xca\xab\x35\x35\xa5\x66\x28\x78\x22\x78\xc3\x76\xfc\xbe\x86\x84\x61\xe2\x46\x0c\xb0\x02\xd7\xd3\xa6\xb4\x89\x8d\xc4\xdf\x40\xf8\xc5\xce\x50\x95\x10\x6d\xc6\xa4\x14\xd2\xed\xf7\x24\xe4\x42\x31\xdd\xb0\xcd\xdb\xa7\x05\xdc\x50\xf5\x0f\xb7\x60\x7a\xe7\xa6\x45\x07\x2f\xcc\x8a\xee\x6b\x48\xae\xaf\x30\x47\xbd\xc1\x82\xf2\x61\x37\x1b\x8f\x37\x9e\x8c\x90\x98\xb4\x56\xcc\x49\x1e\x92\xdf\x46\x83\x7b\x49\x48\x07\xf9\xd8\x27\x1d\x16\xae\x4e\xeb\x76\xf8\x77\x66\xbe\xc4\x77\x58\xa9\x74\xd9\x6b\xb1\x3e\x80\x16\x64\xb6\x3e\x26\x29\xbb\x01\xf2\x73\x41\xd7\x78\x7e\x13\xaa\xe5\x0c\x25\xa4\x40\x15\x0c\x13\x58\x8d\x44\x9c\x0f\x57\x7f\x8a\x9e\x0f\xa9\xd4\xf8\x85\x29\x35\x40\x53\xe5\x02\x23\xb0\x35\x1d\x22\x9a\x03\x26\x7a\x71\xd5\x0a\x98\x8e\x5a\xd7\xde\x88\x1e\x7f\x0c\x56\xb7\xf8\xa1\x43\xcc\xe0\x40\x7d\xd2\x8c\x6e\xe7\xba\x8d\x07\x87\xb1\xa6\xcf\x58\x3b\x1e\xec\xa7\x8f\x4f\x6e\xeb\x28\xe4\x9d\xc5\x90\xf4\xb6\x50\x65\x5e\xed\xed\xfd\x8b\x89\xee\xb9\xf3\x3f\x1f\x09\xf8\x0e\x1d\xed\xb7\x82\x26\xaf\x69\x4a\x79\x0c\xb2\xe4\xf0\x37\x82\x73\x88\x35\x5b\xa1\x73\xa7\x0b\xce\x21\x35\x9e\xca\xbb\xb0\xd9\xb8\x12\x85\x06\x39\x5d\x62\x04\x27\x84\x26\x0e\x3f\x67\xaa\x1d\xb0\xa1\xed\x0e\xbc\x83\x83\xb9\xa2\x85\xb8\x95\xa4\xb7\x6c\xc1\x41\x5e\xb9\xf2\x09\xe3\x41\x2b\x55\xde\x34\x74\x43\x04\x63\xa9\xa0\x9c\xfe\x5c\xb8\xad\x7f\x44\x4e\x51\x6b\xa3\xa2\x67\x21\xb5\xab\x53\x1f\x66\xca\x4a\xcd\x15\x4b\xb7\x72\x70\x5f\xd7\x26\x46\x56\x9a\xdb\x90\xaf\x0f\xe1\x48\x58\x89\x1b\x50\x98\xe2\x58\xae\xab\xe9\xaf\x0d\x99\x55\x51\xb7\x13\x6c\xc1\x92\x73\xf0\x3b\x9c\xb1\x59\x20\x2f\x43\xfb\x8a\xee\xae\xee\x14\x6a\xb6\xfb\x1b\xfb\xa0\xe8\x40\x99\xa1\x39\xeb\x7c\x83\x34\xdc\x36\xad\xc0\x86\x47\x7f\x16\x00\x84\x1b\x2b\x0b\x99\xbd\x4c\x4d\xca\xfb\x5d\xc8\xf6\x43\x87\x3f\x34\xc1\x42\x50\x4c\xc1\x49\x92\xd4\x6b\x85\x7a\x60\xb7\xba\x85\x2d\x97\x48\x60\x98\x8a\x98\xa6\x78\x2d\x03\x07\x0c\x61\x52\x29\xee\xd6\xb8\xd5\xb0\xb4\xb7\xeb\x31\x4e\x1c\x26\xdd\x17\x3c\x6c\x49\x37\xd7\x75\xec\x72\xf9\x53\x5d\xf3\xb0\x84\x3e\xd4\xad\xda\x24\x17\x2a\x70\xe3\xcc\x3b\x1f\xc3\x6c\x0d\xca\x3d\xa0\xf3\x44\x1c\xf5\x31\x90\xbe\x79\xbb\xe0\xc5\x7f\xbc\x8c\x5e\x3e\x8f\x9e\x47\x2f\x8e\xd1\xdb\xd1\x82\xcc\x93\xe7\xcf\xc7\xe3\x17\x65\xd6\xed\x39\x93\x0a\xa3\x94\x72\xc5\xe2\x92\x8f\xac\x44\x5d\x4c\x56\x5f\xf9\xaf\xea\xe9\xb3\x87\xbf\xf7\x6a\x02\x9f\x6b\x0c\x8f\x38\xd8\x5d\x3d\xed\xdc\x82\xc6\xe4\xe5\x97\x83\xbd\x74\xfd\x3e\x0c\xe6\x29\x8a\xae\x01\xbb\x23\x29\xf0\x85\x5e\x7a\xcc\xa9\x62\xc6\x71\xe3\x68\x3f\x5d\x4c\x56\x7f\x32\x61\x70\xbf\x7c\x7f\x17\x83\x2a\xa3\x2d\x8c\x0d\x36\x7c\x8b\x98\x30\x3a\xde\x71\x33\x3a\x2e\xa1\x11\x25\xa3\xaf\xfe\x54\x19\xda\x63\xb0\x32\x72\x34\xd8\x13\x7c\x6d\x28\x80\xe1\xae\x43\x8d\xc9\x8b\x97\x7f\x19\xdc\xa3\x3a\xc6\xbe\xb0\x6d\x46\xe3\x25\xe3\x70\x7a\x71\x76\xb5\x87\x08\x2f\x90\x9d\x9e\x47\xcf\x47\x2f\xbe\xda\x4f\x8d\x77\xe5\xb0\x41\xc0\x02\x8a\x61\x4b\x33\x98\x6d\xb4\xbd\x61\xe1\x44\xcf\x44\xa2\xa2\xc1\x3d\x98\x2e\xd3\xc5\xb8\x03\x78\xd7\x1f\x3c\x58\xef\xae\x3f\x78\x6e\xa8\x92\xcb\xd5\x6a\x4a\x00\x4b\x8d\x95\x26\xdf\x3d\x26\x79\x5a\x2c\x18\xaf\xd4\xc6\xb1\xd2\x8e\xa7\x29\x82\xa7\x6b\xbf\x05\xf7\x9a\xe1\xbd\xbf\x3e\x39\x3d\xbb\x34\x0d\xdf\xff\x70\xf9\x26\xdc\xcb\x09\xa3\xe2\xda\xd4\x83\x39\xe5\x3f\x5f\xbe\xf8\xaa\x9d\x55\xfe\xfc\x1f\x5f\xdd\x8b\x59\x1c\x9c\xd7\xc8\x53\xed\xcc\x52\x5d\xf0\x7e\x72\x38\xeb\x56\x17\x01\x73\x88\xd6\x82\x30\xae\x30\xac\x72\xb8\xfb\xb3\x17\x96\xe1\x26\x39\x9a\xda\xa0\x03\x76\x38\x4b\xb6\xe8\x40\xf3\x06\xe0\x78\xd0\x8a\x1a\x53\xe0\x65\xbb\x14\x1b\x22\xc8\x3c\x70\xc5\xd6\x1e\x23\xbc\x6f\xc2\x56\x4c\xaf\x27\x52\xac\x58\x02\x4d\xfb\xb8\x0d\xe0\x2e\xb6\xfb\x78\x87\x0c\x77\x67\x90\x84\x40\xc7\x2d\x56\xa2\x42\x59\xa0\x18\x91\x32\xe6\xc8\x4e\x37\x37\x52\x95\x29\x48\x57\x58\x8b\x0a\x77\xf8\xdf\x5f\x4f\xa8\x52\xb7\xc9\x31\x79\x7b\x76\x32\x39\x36\xd4\xbb\x38\x33\x42\xf3\x1d\xd3\xdf\x17\xb3\x00\x29\x9e\xf8\x9b\x69\x0d\x01\xfc\x61\x41\x9e\x0b\x69\x82\x74\x9d\xca\xcf\x51\x5e\x33\xdc\x03\x0b\xd2\xed\xdd\x74\xb6\xe2\xd0\x83\xa1\x3c\x60\xb8\x59\x43\xdc\x21\xe6\xb0\x50\x8d\x5e\x22\xe6\xf0\x10\x83\x2f\xdc\x95\x89\x58\x82\x69\x4b\xd3\xfa\x8a\x3a\x5d\xdc\x29\x73\xc0\xc3\xe2\x93\x5a\x96\x6c\x80\x3d\xf4\xf0\xf7\x1c\xd5\xb6\x1f\x6a\x1a\xaa\xa0\x07\x5f\x87\x0e\x17\xc9\xa4\x65\x96\x2e\xe0\xe2\x4f\xbc\x55\xb6\x71\x0f\xbc\x31\xf5\x0c\x6a\xbe\xa0\x69\xc9\x0d\xc8\x93\xd4\x41\x4f\x32\x9a\xa3\xc2\xc7\x43\x24\xbf\x32\x7f\x33\x65\x72\xfe\x6e\x08\x3c\x16\x09\x24\xe4\xf4\x84\xcc\x0a\x9e\xa4\xe0\xad\x85\xd9\x1c\x52\x0c\x69\x6a\x89\x3c\x44\x79\xbc\x44\x0b\x20\x42\xf0\xd8\x30\xd4\xf5\xdb\x69\x75\x8f\x41\xdc\x21\x76\x69\x65\x5c\xed\x21\x5f\xfa\xe9\xda\xdd\x90\x38\x8a\x69\x14\x4b\x7d\x14\xa6\xd2\x82\xa0\xc7\xea\x86\xc5\x2a\x96\xe6\x7c\xd4\x7b\xe1\x49\xa8\x26\x55\x59\x97\x39\x2a\xcb\xad\x51\x73\xd7\x2e\xd0\xc5\x9c\x8b\x02\x0b\xef\xe1\xec\xbb\x02\xe1\xda\x2c\x85\x39\x05\x0f\x67\xb0\xe5\x3c\x31\x35\xb3\xfb\x86\x66\xb5\x07\x0c\x56\x39\x7c\x73\x16\xc4\x1d\x5c\x13\x29\x04\x5e\x7e\x90\x80\x8a\x23\xb1\xa8\x28\xe5\xd1\xb2\x15\xf3\x5c\x67\xd6\x87\x57\x70\x43\x8c\xc4\x7e\x1f\x0d\x5a\x18\xe4\x00\x6e\xeb\x56\x96\xa8\x86\xef\x78\xe5\x6a\x9d\xaf\x37\x1a\xf1\xdd\x82\x45\xa8\x94\xca\xa5\x74\x98\x65\x8f\x33\xd4\x35\x52\x53\xfd\x67\x8b\x11\xef\x69\xb4\xc7\xb1\x2f\x7f\x74\xaa\x4e\xcd\xa6\xfa\x14\xa4\x3e\x48\x56\x37\x7a\xee\x11\x5b\x65\x54\x7d\x10\x59\xe3\xc4\x07\x8d\xb4\x2d\xb5\x46\xfa\x76\x36\xfa\x28\xa4\x4e\x0e\xad\x57\x17\xbb\xe8\x0c\xca\x3d\xde\x98\xa9\x11\x47\x9d\xaa\x7b\xca\xa3\x03\xf8\xb7\x91\xc5\xca\xa2\xee\x2d\x94\x0d\x72\xe6\xe0\xfe\xdc\x65\x4c\x35\x57\x5c\xfa\x5c\xe5\xeb\x0d\xac\xef\x27\x5e\xee\x62\xdf\x63\x4a\x97\xbf\x8f\x80\x2c\xed\x2d\xff\x6e\x68\xad\x4a\x10\xc6\x4b\x80\x50\x53\x6c\x09\xd9\x0d\xac\x7b\x21\xeb\x85\xec\xf7\x12\xb2\x42\xa6\xe3\xc1\x01\x58\x2a\x64\xea\x91\xe4\x3c\xb9\x0f\x57\x6f\xd1\x8a\x38\x9b\x42\xb4\x18\x3c\x0a\x4a\x3a\xad\x60\xc1\xf4\xb2\x98\x8d\x07\x1d\x81\xb7\xcd\xdd\x81\xb5\xf1\x33\xe5\xc6\xa6\x43\x70\xb7\xe9\x70\xbb\xb1\xfd\x7b\x8f\xde\xa1\xef\x1d\xfa\x16\x87\x9e\xa9\x8d\xb0\x59\x08\x73\x24\xd6\x0f\xc3\xa8\x86\x57\x3b\xee\x56\x0b\x25\x5c\xf0\xa1\xd9\x34\xf8\x43\x9f\x06\x55\x5a\x41\xd3\xe7\xae\x4e\xcb\xa5\xfc\x2b\xa8\x54\xeb\x0e\x34\x5d\x0f\x6c\x40\x97\xef\xe4\x51\x66\xe2\x67\xde\xb3\xb8\x38\x1b\x3c\x12\x4e\xec\x80\xfb\x4a\xf2\x36\xc2\xe7\x0a\xf0\xa2\x5e\x0a\xc8\xdd\x54\x4b\x15\xe7\xa4\x41\x29\x6d\xac\xcc\x36\xad\x6a\x8d\xca\x3c\x7b\x75\xc7\x23\x7b\x42\xbd\xcf\xf2\x79\xf8\x2c\x5e\x6d\x8e\x07\x07\xa0\xaa\xaa\x6b\x11\x5d\xc1\xaa\xba\xdb\xd0\x4f\x21\x5a\x44\xe4\x28\x5b\xe3\x1b\x75\x94\xaf\xa3\x58\x64\x47\xcf\x7c\x74\xd2\xd7\x9c\x76\x71\x68\x13\xaf\xc7\x4d\x84\x98\x7b\x5f\xe1\x1c\x6f\x17\xe7\x12\x2f\x31\x84\xf3\x4d\x73\x41\xc5\xd0\x61\xa7\x91\xbf\x84\xa9\xdc\xad\xfe\x8a\x69\xa0\x9a\x8c\x14\xe8\x22\x1f\xf9\x36\x5f\x78\xe0\xa3\xc1\x23\x91\x4e\xc8\x05\xe5\xec\x97\xb6\xd7\xf4\x1a\xf0\xb8\xd1\x33\x60\x31\x5d\xe3\x6b\xca\xa6\xac\xb0\x72\xb7\x0a\x36\x1b\x62\x00\xdb\xbf\x11\x66\xa4\x79\x41\x58\xf3\xcd\xbf\x0e\x81\xe6\x7b\x2c\xba\xed\xfa\xcd\xe6\x3f\x0d\x34\x3b\x0c\x2d\xa6\x47\x1b\x3a\x6c\x83\x5a\x34\x44\xe4\x5b\x73\x02\x86\xac\xf9\xb5\x90\x8b\x6f\x46\x5f\x63\xeb\x6f\xa2\x3d\x00\xfc\x5e\xf8\xe9\x24\xa8\x0b\xa6\x53\x7a\x90\x6b\x9e\xd2\x8e\xae\xf9\x5b\xda\xbb\xe6\xbd\x6b\xfe\x40\xd7\xbc\xf7\xa9\x7b\x9f\xba\xf7\xa9\x7b\x9f\xba\xf7\xa9\x8d\x4f\xfd\x80\x38\xa0\xa0\x95\xdb\x1a\xe6\xd5\xb5\x0f\x57\x6f\x07\x8f\x82\x8f\x4e\xe0\x2f\x84\x58\xa4\xad\x74\xde\x80\xdc\x36\xef\xe2\x69\xd8\x86\x8f\xec\x69\xf4\x8a\xac\x57\x64\xbd\x22\xfb\xed\x14\x19\x6e\x95\x21\x69\x7b\x99\xbb\x01\x5d\xd5\x8e\x41\xd0\xbc\x7f\xef\x74\xc1\x49\x9e\xbb\xf7\x72\x9b\xe2\x05\x5a\x84\x9d\x1f\xee\xee\xcc\x31\xe2\x3f\xf3\x44\x64\xa9\x73\x73\xc5\x6c\x3c\xe8\xba\x6c\xd7\xa1\x83\x42\xa4\x3c\xdc\x60\x23\x73\x96\xc2\xc6\x86\xe4\x71\xd5\x24\x0e\x7f\x46\xf5\x61\xdb\x32\xdf\xa9\x4d\x05\xd1\x3d\x0a\x08\x85\xc4\xbf\x5d\xea\x5e\xcd\x0a\x18\xc2\xf1\x2b\xda\xc8\x7f\xff\xcf\xd6\x44\x3b\xbb\xa6\x00\xe0\xbd\xf7\x4e\xbd\x72\xfb\x1c\x94\x5b\xa7\x66\x98\x14\x48\x0b\xde\x8a\xd1\x0d\x4c\xfa\x0e\x1d\x14\x40\x68\x6a\xf8\x4d\xc8\xa4\x0f\xc3\xf4\x61\x98\x3e\x0c\xf3\xef\x13\x86\xb1\xbe\x4f\x73\x06\xc6\x06\x84\x95\xdd\x10\x6d\x1e\x74\x13\x0d\x08\x2a\x65\xf5\xe5\xa0\x65\xb8\x43\x90\xb3\x71\xdb\xea\x20\x38\x37\x7a\xee\xd1\x2d\x5b\x6e\x44\x7f\x2f\xb3\xbf\x97\xd9\xdf\xcb\xec\xef\x65\xf6\xf7\x32\xfb\x7b\x99\xfd\xbd\xcc\x34\xa1\xf9\x78\xd0\x11\x74\x6c\xdc\x61\xf3\x81\xaf\xcc\x3d\xf2\x7e\x83\x6a\x2d\xd9\xac\xa8\x4d\x14\xd6\x02\x70\xd9\x0d\xfd\x3a\x65\x5e\xe6\xab\x7e\x19\x5e\x01\x44\xd3\xf3\x88\xe2\x03\x19\x65\x7b\xb9\x62\x07\x5a\xd3\x8b\xb0\xcd\x4c\x44\x15\x68\x6f\x97\x42\xb9\x04\x13\xaa\x92\x5c\xd2\x6f\x7e\xb0\x97\x1d\xc2\xbd\xbf\x1c\x91\xf7\x4e\x69\x1b\x1d\x55\xf0\xa0\xa1\x8e\x09\x17\xae\xad\xbb\xd0\xe8\x35\xb1\xd7\x4b\x1d\x60\xef\x78\xa9\xe1\x00\x8e\x3d\xec\x72\x83\x83\x22\x39\x18\xcf\x2c\x79\x18\x92\xcd\x95\x87\x8b\xb3\x88\xb8\x52\x32\x49\x44\xbe\x35\x69\x0c\xca\x0b\xa1\x61\x40\x6f\x98\x22\x72\xa2\x09\xa6\xca\xc1\x74\x71\xb0\xf9\xdc\xeb\x2d\x43\x25\x2e\x78\xd0\x97\xc8\x03\x90\x54\x1a\x9b\x77\xd4\xa9\xcf\xa1\xbb\x25\x7c\x98\xbc\x4d\x45\x96\xc7\xf1\xd2\x53\x82\x09\x5b\x3c\x3d\x37\x67\x3c\x4a\xf8\xd1\x67\x43\xe1\x07\xdb\xa2\xfb\x51\x39\x61\x2a\x4f\xa9\xdd\x34\xec\x91\xa4\x6a\xd3\x26\x81\xda\xa2\xcb\x46\x97\x4d\xda\xc4\x9f\x11\x6d\x72\x9f\x26\xea\x83\x02\x79\x2f\x42\xed\x8c\xf0\x30\xaa\x85\xe1\x50\x62\xcd\x78\xdb\x12\x61\x62\xfd\xe5\xa8\x38\xdd\x51\xc1\x92\xcf\x05\xe7\x9d\xfd\x92\x19\xe3\xc9\xd9\xe5\x78\x70\x00\x2d\x6c\x97\x6d\x87\xff\xec\x12\xb7\xae\xf8\xcc\xde\xad\x4c\x0a\xe9\x83\x72\x0a\xa8\x8c\x97\x24\x5f\xd2\xa6\x8c\x50\xf7\xc0\x08\xce\x34\x71\x61\xcb\x83\xc1\xf7\x1d\x0f\xdb\xb5\xb8\x0d\x0b\x2e\x8b\x96\x21\xd3\x4e\xab\x2e\xf7\x22\xd5\xe9\x7f\xdf\x0d\x49\xbf\x75\xf8\x3c\xb6\x0e\x7d\x14\xbd\x8f\xa2\xf7\x51\xf4\x4f\x38\x8a\xce\xb8\x82\xb8\x90\x70\x90\x98\x3e\xf1\xbd\x8e\x4d\x4d\x68\x89\xae\xfa\x66\xf1\x16\x1f\x3d\x16\xdc\x7b\x31\xc8\x9f\x78\x90\x8d\xb2\xf5\xe3\xc9\xd5\xe5\xc5\xe5\x77\x63\x32\x2d\x9f\x95\xc9\x94\x7f\xc2\xfc\xc8\x3f\x95\xf9\x1b\x31\x78\x80\xd5\xab\x32\x20\x47\xb8\x3f\xc7\x62\x6b\x47\xe8\x0d\x55\x3e\x7d\xb8\x7a\x8b\x85\x82\x4c\x02\x1c\x0f\x32\x7a\x40\xb8\x57\xa9\x46\x1e\xec\xbd\xed\xeb\xb7\xd3\x63\xcc\x30\xe8\x12\x4b\xfd\xe4\x97\xf3\x53\xe5\xe5\x37\x07\x85\xc9\x35\x69\xff\x3e\xb6\xd3\xfb\xf9\xa6\x61\x50\xdf\x3d\x5d\xbb\xdc\x94\x3f\xcd\x69\xaa\x76\x3a\x38\x31\xb1\x29\xa4\x8d\xd9\xa4\xe4\xba\x1c\xa6\x8c\x2e\x4c\x35\x95\x1a\x9f\x50\x55\x11\x61\xc6\x43\xa9\x39\x2d\x44\xaa\x22\x06\x7a\x1e\x09\xb9\x18\x2d\x75\x96\x8e\xe4\x3c\x7e\xf9\x97\x2f\x9f\x47\x4f\x3a\x71\x46\x73\xc9\xa4\xfb\x87\x7d\x9e\xb8\xb8\x0f\xe5\xe4\xea\xdb\x53\xf2\xf2\xe5\x9f\xff\x8c\x78\x72\xef\x1c\xf8\x85\x58\xfe\xb0\x0e\xab\xf3\x32\xa8\xa4\x19\x68\xcc\xba\x63\x2f\x3b\x58\x85\xaa\xd6\x5c\xd3\x3b\x2f\x80\x38\x10\x53\x63\xe2\x10\x8a\x17\x64\xc6\x98\x81\x68\x84\x97\xfc\x12\xfe\x2a\x78\xbb\xaf\x54\x2c\x72\x78\x35\x67\xa9\x06\xf9\x64\xf0\x28\xe2\xd9\x49\x9a\x32\x9a\xe7\x8c\x2f\xde\x81\x5e\x8a\x56\x21\xde\x40\xda\x46\x2f\x93\x06\x4d\x66\x8c\xbb\xc4\x8e\x4e\x37\x23\xd2\xb0\x7e\x9e\x55\xa5\x41\x4f\x23\x37\x61\x77\x6b\x67\x70\x33\xa0\x36\x6a\xd6\x1c\xc5\x29\x65\xd9\xd1\xe0\x81\xcb\xdf\xa7\x50\x37\x79\xc0\x6b\x52\x6f\xfe\x30\x7f\xba\x4b\x3f\x55\x5d\x8e\x04\x5d\x48\xee\x0d\x6a\x65\x55\x11\x19\xa2\xad\x7e\xf7\x61\x7a\x6d\x36\x3e\x9c\x61\xca\x51\x34\x93\xa8\x24\xd4\x92\x4a\x9f\x50\x6a\x6d\xab\xc8\xd4\x18\x30\x33\xf7\xc6\x30\x26\xa0\xc0\x12\x2c\xb2\x89\xb7\x43\x17\x98\xa3\xd5\x69\x7d\x97\x5e\xda\x25\x7a\x8f\x8e\xd0\xfe\x1e\x45\xf6\xb7\x73\x2d\xc8\xd1\xc8\x7c\x3c\xfa\x1f\xf6\xd7\xf8\x88\x10\x72\x05\xf3\xb2\xf6\xe3\x42\x24\x22\x36\xb2\x68\x5f\xeb\xc6\x0b\x58\x65\x0a\xe1\x91\x90\x6c\xc1\xf8\x28\xbf\x59\x8c\x90\x4c\x23\x4c\x4f\x69\xff\x72\x6e\x07\x13\xfc\x8b\x1f\x9c\x07\xb2\x9d\xec\x0b\x8f\x2a\x9f\x3c\x94\x88\x08\xcb\xc5\x59\x67\x32\xda\xe6\x1d\x02\xa1\x2e\x6b\x58\x7f\xf5\xa2\xbf\x7a\xd1\x5f\xbd\xf8\xb7\xb9\x7a\x61\x0c\x8b\x3a\x4c\x48\x4d\x17\x6f\xee\x3e\xd1\x93\x08\xbb\xae\xfe\x14\xa2\xee\x14\xe2\xc1\x22\x72\x38\x92\x1f\x39\x3e\xfd\xd9\xa0\x7a\x27\x60\x7c\x30\xde\x77\x46\xb8\x3f\x11\xea\xc2\xcd\xdb\x04\xa8\x6f\xe7\xf3\xfa\x1a\x87\x36\xf1\x1e\xac\x9b\xce\xeb\x48\x85\xa9\x6d\x52\xca\xb2\xc1\xde\x15\x7e\x12\xd4\xe9\xdf\x12\xec\xdf\x12\xec\xdf\x12\xfc\x14\xde\x12\x84\x3b\x2d\x29\xe6\xb8\x15\x92\xfd\x02\x93\x10\x44\xd8\x07\xc5\x21\x35\xc9\xef\x85\x8e\x0d\xda\x34\x41\x69\xbc\x5f\x2c\x69\x66\x8b\xb6\x6d\x05\x41\x68\x12\xea\x4e\x53\xdf\xd7\xf8\x7a\xa0\x74\xb4\x67\xfa\xc3\x10\x38\xc5\x68\x89\x1a\x1f\xbc\x24\xdb\x2f\xac\xc2\x04\x5d\x0c\xe8\x0e\x4a\x2c\x1e\xe4\x31\x1d\x34\x82\x3f\xa0\x3c\x42\x5f\x9e\x25\x47\xb6\x5b\x34\x78\x14\xb5\x7f\x00\x85\xba\xaa\x7b\xa6\x54\xd1\x54\x99\xa3\x01\x39\xb6\x8b\x97\x46\x8c\x5a\x85\xca\x14\x6e\xaf\x1c\x12\x50\x53\xa5\x40\xe2\x3e\x48\x99\xb2\x78\x17\xb6\xa7\xdd\xfe\xcf\x59\xb5\x36\x05\xc6\x4d\x71\x38\x13\x6e\xf0\xb1\x50\x13\x1f\xe5\x18\x61\xc1\x6a\x19\x42\x92\xb9\xa4\x26\xb0\x51\xd6\x61\x8f\x06\x8f\x82\xb2\x4e\x1c\xe5\xe8\xfe\x3d\xd0\xa4\x1d\x65\x1b\xe8\xda\xe8\xd5\x21\xde\xe0\xda\x93\xa5\xed\xf0\x29\xc4\x1d\x1a\x4c\xe0\xa7\x1a\x76\xc0\x1c\xf7\xa6\x6d\x9a\xae\x4d\xf1\x24\x57\x25\x72\x05\xd2\x7c\xed\xeb\xda\x30\x1e\x8b\xac\x82\x72\xe5\x6e\x88\xaf\x80\x07\xf4\xab\x5c\x88\xb9\x2d\xfa\x76\x60\x30\xe3\x53\x0f\x5f\xf4\x11\x89\xcf\x2c\x22\xb1\xa4\x29\x16\xa0\x81\x0f\x57\x6f\xc7\x83\x03\x50\x56\xed\x88\xa8\xa3\xfe\xae\xaa\x84\x84\x49\x3c\xdd\x29\x78\x45\x13\x41\x42\x46\x3b\x16\xd9\x88\xc6\x87\xad\x66\xe1\x99\xd9\xf7\xd8\x2a\x12\xd6\xad\xf5\x59\x98\x2c\xc7\x93\x1f\x7f\xfc\x71\x78\x52\xe9\x5a\xae\xa5\x2c\x43\xee\x81\xc1\x17\x2c\x41\x42\x44\xfe\xf0\x8f\x42\xa6\xff\x0f\x01\x76\xa5\xb7\xdc\x1d\x0e\xa4\x7c\x5c\x48\x89\x42\xfa\xe1\xea\xed\x31\x01\x15\xd3\xdc\xd5\xb8\x03\xa2\xe8\xdc\x94\x5b\xa0\xce\x6a\x04\xaf\x83\x90\x10\xcb\xbe\xbd\xbd\x8d\x5c\x91\x67\x13\xc6\x56\x4a\x0c\xcd\x8d\xa2\x57\x08\xe3\xff\x76\x33\xff\xe1\x1f\x66\x84\x3d\x20\x98\x36\x8e\x6f\x5a\xa6\x40\xcc\x0d\x4d\xf9\xa7\x91\xd9\x17\x94\x28\x7e\x15\xe6\xf1\x37\x11\xdd\xdb\x29\x1e\x47\x7e\xb3\x8f\x2e\x86\x2c\x1e\xef\x8a\x8e\x25\xd5\xa9\xc8\x32\xc1\x2f\x31\x34\x79\x18\x57\x6d\xf7\xde\x8e\x50\x87\x7d\xb8\x69\xe2\xaa\x70\x3b\xef\x89\xa1\x4f\x65\x0b\x0a\x9a\x4d\x73\x35\x98\x6a\x3c\xc6\xdd\x57\x09\xbc\x45\x48\x08\x5d\x60\x32\x76\x5d\x79\xe7\x20\x18\x16\x84\x21\x16\x5c\xa1\xf6\xc4\xfd\xbd\xc5\x31\x56\x78\x5b\x7d\xc2\x3e\x98\x89\x9e\x59\xaf\xe2\x30\x1a\x54\x3b\x7a\xa5\x88\x9c\x22\xe6\xce\x7c\x19\xb1\x8d\x97\x10\xdf\x38\x05\xbf\x15\xd6\xfb\x64\x51\xb2\xbc\x07\x36\x96\xdd\x11\x11\xac\x23\xe3\xb6\x6e\x16\x13\x9f\x6e\x76\x3c\xa3\x99\x0e\x55\xfa\xbe\xd3\xef\xa3\xf0\xb1\xee\x93\xa4\x58\x90\x12\x7c\x5a\x86\x06\x3d\xff\x6f\xaf\xe6\x0d\x40\xbf\x95\x8a\x47\xa5\x7b\x1f\xc5\x52\xe9\xd7\x55\xaf\x54\xc3\xd3\x9f\xac\x28\xed\x04\x8d\xef\x83\x9c\xa6\x41\xba\x62\x6a\x37\x8c\xfc\x89\xe2\xab\x93\x6b\xaa\x1b\x2b\xb8\xd5\xa0\x0e\x1b\xbb\xad\x49\xb8\x27\xb3\xbb\x53\x31\xad\xc2\x86\x04\xb8\xae\x2f\x22\x7d\xc0\xba\xf7\xae\xa4\x1d\x21\x58\x8d\x93\x26\x59\x53\x86\x9b\x8d\x25\xbe\xf1\x6d\xb7\xab\xac\x2d\x80\x83\x34\x6a\x34\x0c\x87\xfb\xc7\x86\xaa\x5f\x8f\x5e\x27\xff\xcc\xd7\xc9\xc7\xf4\x08\x2b\x07\xd3\x26\x24\xe5\xf9\xc5\x56\xfd\x37\xdc\xae\x6f\xd7\x23\x34\xca\x8b\x56\x5f\x87\xd9\x25\x64\xd8\x4e\x62\xa2\xdd\x68\xf0\x90\xfb\x5a\xd2\xd5\xe9\xbd\x96\x6c\xb1\x00\xd9\x71\xd1\x57\x9b\xbd\xec\x28\x3b\x6b\x0f\x77\xc5\x71\x4d\x58\x99\xd5\x15\x5c\xb6\x45\xdb\x13\x97\x27\x1e\x6e\xfd\x2b\x3b\xc8\x9a\x4e\xe9\x63\xe8\x82\x65\xa0\x34\xcd\xf2\x68\x70\x6f\x0e\x6d\xe5\xcf\x96\x87\xc6\xc6\x9c\x5d\x4e\xeb\x53\x04\xb4\x4c\x9b\x8b\xa4\xbe\x4e\x67\x5b\x1f\x47\xd6\x53\x09\x49\x0d\x57\x6e\x20\xfe\x2d\x56\xbf\x7d\x6f\x36\xb5\x57\x21\x64\xe4\x42\x43\x8a\x00\x17\xc5\x62\x59\x75\xbe\x10\xc7\x29\x68\x2c\x8e\x5f\x0d\xa6\x54\x36\xf3\x76\xfd\x58\xba\x91\x25\x50\x96\x75\x0f\x11\x8c\x68\x70\x98\x08\x35\x47\x1f\x36\x16\xf2\xe4\x72\x37\xb6\xa0\x23\xf2\x4e\x48\xdc\x65\xce\x45\x79\x41\x0a\x65\xc9\x16\xe1\xc4\xe2\xea\x89\x88\xd5\x28\x16\x3c\x86\x5c\xab\x91\x58\x81\x5c\x31\xb8\x1d\xb9\xca\xcb\x43\x74\x71\x86\x76\x49\x6a\x84\xa0\xa8\xd1\x17\xe6\x17\xb9\x7e\x7f\xf6\x7e\x4c\x4e\x12\x57\xa6\x1b\x55\xc4\xbc\x48\xc9\x9c\x41\x9a\xa8\x88\xd0\x9c\xfd\x00\x52\x31\xc1\x8f\xc9\x0d\xc3\x23\x9f\x82\x25\xaf\xea\x2f\x4f\xb5\xd0\xb2\x95\xab\x8c\xff\x32\x1e\xb4\xe2\x65\x82\x6d\x7c\x31\x49\x57\xaf\xcf\x6a\x0b\x57\xe4\x38\x96\x60\x29\xeb\x35\x80\xf9\x74\x28\x95\x10\xb9\x93\x7a\x70\x76\x40\x0a\x6d\xbd\x21\x46\xaf\xd7\x51\xce\xc2\x84\x82\xfb\xfd\xf5\xf5\x24\x38\xb2\x11\x21\xe7\xb8\xeb\x24\x19\x50\xae\xf0\xc4\x17\x30\xef\x0c\xba\xa0\x69\x6a\xc2\x65\x12\x14\xde\xea\xc1\x80\x02\x27\xc0\x57\x64\x45\x65\x74\x38\xb6\x9d\xc7\x78\xc8\x52\x54\xb7\xb5\x4c\x7f\x8f\xc5\x70\xd1\x75\x25\xae\x25\x92\x04\xc3\xc5\x59\x46\x87\x0a\xd0\x59\xd7\x95\xa2\x9e\x3e\xdf\x3a\x06\x10\x92\x91\x90\x04\x75\x93\x2d\xf5\xe8\xb2\x79\x87\x65\xab\x8d\xeb\xd4\x78\x8a\x1f\xfd\xd3\x56\x2d\x81\x26\x78\x71\x55\x9d\xf3\x24\x17\x8c\x6b\xd5\x01\x01\xbb\x9d\x2c\x2e\xfc\xda\x21\x7c\xed\x83\xc9\x26\x4c\xbd\x2e\x3b\x6e\xd0\x3d\x1a\x1c\xec\x21\xee\x59\xd5\x3e\xe7\xc7\x24\x63\x82\xe4\xf4\xa4\xc3\x62\x8f\x42\x63\x7f\x6e\xe0\x75\xbf\xb1\xa1\xa1\x76\x6a\xf5\x94\x80\x62\x26\xa8\x6a\xa4\xc7\x9f\x11\x60\x80\xba\x1c\xcf\x28\x40\x7f\x81\xa3\x52\xe1\x45\x15\x99\xbb\x2e\xeb\x38\xc4\x05\x8a\x84\xbb\x7f\x18\x3e\x22\x44\x12\x54\x8e\xe1\xa1\x59\x6a\x23\xde\x16\xc7\xf6\xa4\x62\x17\x84\xd2\x1f\xf2\x11\x5f\x13\xb9\xff\x78\x14\xd3\xa1\x03\x32\x96\xfa\xe3\xd1\x31\xc9\x40\x2e\x70\x1c\xa6\xcb\x9d\xa3\xbb\x08\xe8\xef\x05\x9a\x95\xb8\x81\x31\xc8\x95\x90\x5b\xc9\xb4\x9f\x1c\x07\x80\x64\xa3\xd1\x36\xca\x50\x40\x12\xf2\xd1\xa3\x78\x18\x80\xf8\x78\xe4\xcb\xcb\x7e\x3c\xda\x8e\xd7\x0f\x33\xca\xe9\x02\x92\x8f\x47\x65\xac\x3f\x22\xa7\x6e\xcf\x6e\xce\xed\xdc\x96\x5d\x0b\x92\xd1\x1b\x2f\x66\xe5\x85\x7d\xb5\x79\x3e\xb7\x33\xbb\xc1\x23\x4d\xd3\x2d\x65\xe4\x0f\x44\xcd\x70\x76\xbd\x19\x5d\xef\x19\x06\x5f\xbd\x36\x1d\xb6\x07\xa3\x8a\xdc\x42\x9a\x46\xe4\x23\xaf\x3d\xb7\x80\x0a\x9e\x02\xcf\x19\xae\x70\x13\x9d\x9e\x20\xf9\x77\xf1\xf3\xf1\x28\x22\xdf\x63\x18\x02\xd9\x95\x07\xaf\xae\x1c\xed\x29\xe3\x64\x4d\xb3\xf4\xd9\x18\xe7\x2e\xad\xef\x98\xac\x5e\x18\x03\x3c\xae\x4c\xed\x0f\x24\xc6\xce\xbd\xc0\xe5\xca\xca\x1a\x4b\xb8\xc7\x3b\x27\x2b\x84\xb8\x9e\x84\x84\x0e\x78\xce\x34\x26\xbf\xba\xd3\x84\xe1\x70\x38\x7c\x7d\xfe\xdd\xc5\x25\x39\x3d\xbf\xba\xbe\xf8\xf6\xe2\xf4\xe4\xfa\x1c\xbf\x1c\xe2\x63\x42\x4e\xed\x31\x7b\x83\x34\x95\x63\x9c\x5f\x9e\xed\x8c\x50\x7f\x85\xbe\xdd\x36\xb7\x7b\x51\xbf\xf5\xc9\xcd\x5e\xad\xe6\x65\x76\x3c\x38\xf0\x6c\xa6\xc5\x33\x6a\x7d\x98\x17\x69\xda\x74\xe1\xa8\x77\x8e\xff\x55\x9c\x63\x09\xb8\xe3\x85\x8b\x8c\x2e\x6a\x50\xd4\x32\xaa\xbd\x96\x74\xce\x63\xb9\xb6\x7c\x30\x68\xc5\xed\x74\xab\xf9\x76\xe1\x76\x08\x4f\x50\x7e\x94\xaf\x50\x6e\xdc\x1d\x7d\x28\xbd\x29\xa8\x78\x16\x77\xa0\xf8\xc9\xf9\xf4\xf4\xf5\x69\x15\x0e\xe4\x44\xdb\xbd\x0a\x12\xe2\x61\x17\x88\xfd\x80\xb8\x94\x9a\x7e\xdf\xde\xd4\x64\x0b\xaa\x37\x65\x0f\xa7\xc8\x45\x4e\xf1\xa5\x1a\x57\xd2\xed\x14\x37\xf2\xce\x3e\xfb\x30\x8c\x72\x7b\x7a\xb4\xe8\xd6\x0e\x5a\xe8\xfd\x35\x38\x65\xec\xb3\x06\x1e\xbc\x00\x8c\x7f\x44\xe4\xfc\x8e\x29\x63\xb6\x03\xca\xa5\x11\x47\x4e\x24\xf8\x1e\xc1\x07\x70\x13\x1c\x13\x3a\xd7\xb0\xe9\xcc\xc2\x8a\x89\x42\xa1\x43\x61\x87\xb0\x61\x99\xbd\x51\x92\x06\x86\xdd\xc3\xb4\xf8\xff\x26\x53\x1d\x08\xfc\xe6\xdd\x74\x9b\xba\x37\xd9\x06\xb7\xe1\x34\xfe\x02\x47\xf0\x8b\x66\xeb\xd0\xf4\x21\xa4\xaf\x5c\x76\xe9\x48\xfa\xd3\xb2\x47\xa9\x10\x91\xb6\xee\x06\x67\x20\xc5\xc9\xe4\x02\x91\xed\xb5\x15\xfe\x69\x7d\x23\x73\x9f\x08\xaf\x8e\xb0\x18\x5f\xc6\xc2\xb8\x15\x36\xa0\x39\xc3\x7a\xb6\x37\xb0\x2e\x2f\x29\x3d\xac\x90\x7f\x37\x14\xec\xb7\xaa\xff\x52\x6a\xb8\x33\x77\x77\xe0\x70\xfc\xcf\xea\xf5\x72\x2d\xde\x8c\x0e\xf7\x2e\x89\xe9\xe8\x91\x88\x52\x90\xa7\xc5\x82\x71\xbb\x89\xb0\x7f\x5b\x26\x40\x56\x81\xd0\x6a\xf5\xc2\x70\x16\xca\xc5\x12\xc8\x68\x45\xe5\x48\x16\x7c\x74\x93\x29\xdb\x67\xa4\x44\x7c\x03\x3a\xc2\x5f\xa4\xe0\xec\x8e\xe0\x5f\x6e\x8b\x8a\xdb\x0f\x73\x31\xce\x4b\x9c\xcb\x01\xe4\xb7\x1d\x6f\x26\x7f\xbf\xb8\xfc\xf6\xfd\x31\x79\x33\xf9\xfb\xd5\xf9\x77\x17\xef\x2f\x4d\xb7\x37\x93\xbf\x9f\x4c\x2e\xfe\xfe\xe6\xfc\xff\xe0\x76\x96\x49\xc1\x0d\x0f\xaf\xa8\x64\x18\xe2\x55\xd1\xe0\x01\x58\xbe\x81\xf5\x05\xf2\x4c\x37\x14\xbe\xb1\xad\xb7\x63\xfa\x52\x08\x5d\x6a\xd6\x5b\x89\x69\xbb\xd0\xbd\xad\xea\x11\xd4\x7c\x28\x5a\x66\x9f\xef\xca\x71\x25\x30\x67\xe1\x95\x49\x8f\xf6\x07\x2d\x47\xc2\xa2\xbb\x1d\xb9\x32\x8d\x3d\x47\xd8\xae\xed\x0a\x23\xfa\xed\xfc\xd3\x7d\x17\xff\x86\x96\x65\x1b\x9e\x39\x32\x36\x3c\xb5\x4b\x1b\xdc\x43\xc6\x9a\x8f\x7b\x36\x30\x79\xbd\xce\x83\x64\xdd\xd2\x75\xe9\x9f\x48\xf0\x3c\xd0\x64\xeb\x80\x17\x59\x13\x4e\xac\xa3\xd1\xf0\xf0\x26\x53\x83\x83\x29\xd1\x4c\x85\xa1\x41\xc5\xe0\x00\xfc\x38\x9e\x38\x38\xb0\xee\xfa\xd5\x98\x84\xc6\xb8\xce\x06\xb2\xa7\xb6\xff\xa4\x98\xa5\x4c\x2d\x19\x5f\x4c\x35\x7a\x38\x8b\xf5\x3b\xfb\x2a\x5a\x78\xc3\xde\xbe\x72\x8d\x71\x38\xae\xa5\x48\x49\x9e\x52\x0e\x1e\x6c\x64\xfb\xdc\x0e\x51\x4f\x9a\x7d\xb6\x8b\x8b\x04\x26\xa2\x39\x07\xf0\x06\xcc\x97\xae\xf1\xb6\xb3\x11\xbe\x77\xa0\x18\x4f\xcb\x2d\x67\xc7\xeb\xc0\xf3\x9a\xc0\x6a\xbe\x67\x34\xb8\xbf\xe9\x75\xd7\x62\x9a\x1b\x6c\xad\xe2\xc4\xb6\xf7\x9c\x8e\x71\x4c\xb3\x77\xc2\xdb\x9e\x17\x13\x3f\x1c\xa1\xba\xe2\xfa\x55\x94\x88\x3b\x5f\x33\x98\xf3\x5e\x20\x8d\x97\xd4\x87\xa7\x7c\x4a\xe2\x56\x45\xd3\xca\x5a\xe5\x4f\xde\x42\x99\x9d\x75\x21\x1e\xfd\xa2\x10\x38\xd3\xdb\xbd\x82\xbf\x03\x99\x4d\x78\x87\x86\x51\x1f\x13\x6a\x9b\xa2\x17\x9e\xda\x83\x9c\xa0\xcd\x77\x17\xde\xb6\x28\x6b\x14\xc6\x84\x71\xfd\xe5\xcb\x96\x76\x76\xf1\x78\xe1\x64\x01\xb2\xa1\x5d\xb3\x90\x7b\x51\x77\x94\x6a\x78\xbe\x47\x27\x06\x09\x1e\x0f\x3a\xe0\xd6\x49\xab\x47\x6f\xbd\x2c\xce\x00\x19\xbf\x55\x1c\xdb\x74\x25\xfe\x35\x44\xc7\x04\x27\x6b\x44\xcb\xd0\x5e\xe1\xd9\xd3\xe6\x87\xc9\x65\xe3\xb3\x37\x2e\x4c\xb8\x6a\x7e\xf7\x70\x48\x2e\x16\x9c\xb5\x5c\xb0\xda\xcb\xbd\x6d\x37\x0c\x1a\x8d\x4e\x8d\xfa\xc0\x68\x41\xd2\x55\xae\xda\x31\xfb\x56\xd0\xe4\x35\x4d\x29\x8f\x5b\x10\xe7\x15\x52\x63\x83\x2b\x51\x68\xb8\x1f\x56\xda\x38\x7a\xe8\xd7\x56\xfb\xac\xd6\xa8\xed\x61\xf1\xe6\x03\x02\xa5\x96\xb5\x69\xa9\xfb\x78\xd7\xbf\x4a\xbc\x4b\x17\x9c\x43\x3a\x3e\x10\xa1\x6d\x6e\xa2\x39\x0f\x19\x9b\x77\x85\x9a\x74\x4b\xa3\x58\x5b\x68\x10\x0f\xc1\xac\x6c\xdd\x55\x19\x1c\x26\xcd\xc3\x56\x38\x3a\x68\xb8\xfb\xe1\xb5\x5e\x7e\x87\xfe\x5e\xc6\xf6\xb7\xd5\x9b\x17\xdb\xcf\x42\xd4\x79\xeb\x41\x35\x50\x39\xa8\xd5\x0f\x35\x33\x59\x79\x1e\x74\x58\x83\xd2\x54\x17\x5b\xb4\xdf\x20\x9b\x8b\x88\x58\xf3\x36\x41\x4f\x73\x6a\xba\x60\x91\x12\x3c\xd7\x34\xc4\x13\x33\xd4\x55\x98\xaf\x1f\x2f\xe6\xe0\x5e\x6b\xb7\xdb\xa0\x1b\xdb\xd1\x3c\x4f\x19\x24\x56\xcf\xec\x3c\xdd\x02\xee\x64\xa3\xb1\xc9\x8e\xe0\x01\xb2\xdf\xb8\xd1\x3c\x93\x6d\x5a\x69\x74\x24\xa9\x16\xf2\xd8\xb9\x75\xe8\xb9\xf9\x0e\xe6\x76\x3a\x11\x1c\x93\x6f\x49\x3c\xc5\x8d\x05\x8f\x5d\xf5\x2e\x09\x39\x65\x92\x24\x92\xcd\x75\xd8\xe5\x33\x49\x24\x70\x7b\x61\x1d\x91\x0a\xd1\x3d\xb7\x01\x1b\x6b\xf2\x71\x50\xfb\xa1\xd3\x6a\x76\xe7\xdd\x27\xe5\xa4\xa2\x81\xea\x9f\xef\x91\x0f\xfc\x6f\xb0\x51\x6f\xc9\x76\x96\x78\x66\xdb\xe2\xe2\x30\x5f\x99\xbb\x84\xc6\x6d\x14\xca\xfa\x97\x32\x5c\xdb\xb2\x5a\xd2\xab\x6b\x87\x89\xf0\xaa\x62\x3d\x0e\x1c\xa6\xd4\x31\x11\xb2\xda\xed\x96\x2a\x1f\xa1\x3d\x26\x33\x98\x1b\x8d\x6f\xbf\x4e\xa9\x0a\x08\x8e\x5a\x91\xd0\x76\xc3\x0d\xd5\xf7\xbd\x51\xd8\x6c\xbe\x3a\x76\x36\x46\xf3\xde\x23\x14\xac\x1b\xf5\x3e\x94\x6f\xfd\xe3\x9f\x9b\x84\x31\xa4\xac\x41\xa9\x97\x31\x0d\x69\x8a\xaf\x15\x81\xa1\x75\x3d\x69\x4c\x74\xcc\x5c\x3c\x0a\x82\xa8\x18\xaf\x73\x07\x1e\xc1\xc9\x2a\x59\xbf\xf6\x31\x12\x74\x70\xc0\xb9\x63\xa3\xa9\x68\x77\xc0\x62\xc1\xed\x1b\xc8\x35\x02\xba\x81\xfc\x27\xa7\xbe\x65\xe9\x7a\x25\xa0\x31\xdd\xb8\xf1\x89\xf1\x06\x27\xc5\x40\x81\xf6\x84\xf1\x57\xdf\x83\x6a\xae\xc4\xb9\x2b\xea\x39\x22\xa7\xae\x61\x80\xc5\x30\x9d\xd9\xd0\x8e\xc9\xd1\xc9\x8a\xb2\x14\xb7\xb4\x47\xc7\xe4\xe8\x03\x57\x45\x8e\x3b\x44\x48\xb6\x3e\x5e\x59\x73\x85\xdf\x3a\x29\x3f\x7a\xd2\x5d\x11\xee\xd3\x53\x28\xa4\xd7\x92\x72\x65\xe0\xbb\x66\x19\x74\xe2\xd8\xdd\x6e\xc1\x11\x61\xa5\x27\x88\xad\x48\x91\x27\xae\xc6\xd0\x36\xee\x0a\xe5\xd5\x68\xe3\x35\x66\xbf\xd9\xc5\x21\x86\x9a\xd5\x32\x48\x07\x86\x25\x24\x03\xa5\xe8\xa2\xdb\xe2\x5c\x5b\xef\x5d\xa8\x4a\xda\x80\x0d\x6f\x9c\xce\x44\xa1\x37\x56\x15\x08\x8d\xd1\x71\x66\xde\xb8\x31\x37\x72\xb4\xd8\xbe\x94\xb3\x2c\x32\xca\xf1\x66\x1a\x1e\xa1\xd0\xb5\x67\x3d\xf2\x96\x71\x20\xdf\x02\xfa\x6d\x4b\x8a\x6f\x8a\xe0\x9b\x06\x4f\x3f\xfc\xf1\xf9\xf3\xe7\x27\xcf\xbc\xc8\xbb\xcb\x3e\x33\x28\x0d\x24\x55\xe6\x4c\x2d\x45\x07\xe2\x9e\x52\x8d\xce\x17\x55\x82\x77\xc2\x91\x6d\xea\x89\x7e\x4a\x33\x48\x4f\xb1\xe4\xb2\xfb\xde\x6f\x26\x03\x42\x9e\xa8\x2d\xd2\xdf\x1b\xc8\x3a\xff\xaa\x01\x48\xc7\x64\x62\xbe\x09\xcb\x31\x71\x55\x08\xae\x4d\xf6\xd2\x6f\x31\x61\xe7\x31\xf9\xc0\x6f\xb8\xb8\xe5\xf7\x86\xab\xf3\x6e\x1c\x1b\x56\x22\x8f\x7a\x19\xf4\x8b\x04\xab\x01\x42\x66\xc4\x00\xf2\x6f\xa2\xa8\x77\x85\xb8\xb6\x99\xc5\xe2\x3f\x61\xcf\xec\x3c\x0f\xa3\x3c\xfd\xa5\xc0\xf1\xa0\x15\x97\xa7\x35\x5d\x4a\x35\x8e\xa8\xf5\xd7\x08\x37\x24\x77\xb6\x76\x92\x04\x77\x1a\xdf\x34\x49\xc3\xdb\x5a\x78\x9b\x9f\xc6\x31\xde\x2e\xdc\x71\x86\x22\x12\xa4\x3a\x17\x79\x91\x9a\x77\x16\xec\x01\x36\xb6\x65\x7c\x2e\xa9\xd2\xb2\x88\x75\x21\x5d\xe9\x0b\x9a\xd4\xa8\xb6\x76\x9d\x8c\xdb\xb6\xf1\x60\x2f\x17\xa1\xbd\xf1\xe2\xe7\xaf\x89\xa2\x77\x5d\xc6\x53\xf1\xf8\xcd\x55\x43\x37\x6f\x51\xc9\x15\xbe\xae\x3f\xb8\x07\x1b\x35\x87\x48\x1b\x83\xa3\xd8\xe5\xde\xe0\xec\x0f\x71\xb6\x07\x37\x9b\xd9\x7e\x68\x70\x55\xf3\x75\x5e\x17\x8f\x6a\xe1\x63\xc6\x17\xb2\x72\x77\x75\x3c\x68\xc5\xcc\xc5\x66\x6b\x8f\x24\x1f\x06\xf7\xe6\x52\xd0\x84\xcc\x5c\xf4\x0c\x0f\xd4\xe7\x52\xf0\xe0\x75\x2c\xf0\xc6\xdf\x13\x15\xf2\x29\x3a\x08\x3c\x8b\xa6\xee\x75\x14\x6f\x72\x4a\x0e\x35\x0e\x24\x4e\xe7\x7b\x84\xb8\x1f\x53\xe4\x3b\x1c\xb5\x1a\xb5\xb3\x57\x12\x1d\x80\x9a\xca\x05\x04\x08\x5c\x24\xe1\x89\x22\xff\x33\xa2\x79\xae\xc8\xd9\x25\x5e\xb2\x8e\xb1\x00\xeb\xbe\xed\x83\x8f\xd8\xaa\xe0\xcf\x2e\x4d\x7e\x0b\x1c\xc2\xef\xe2\xa3\xc1\x01\x7c\x89\x81\x24\x7b\xc1\x70\x0f\xee\xdf\x84\x86\x35\x57\x6f\x2b\xf9\xa3\x9c\xd6\xf0\x17\x4f\x3d\x9a\x71\x1e\x77\xeb\xae\x9a\x0a\xa2\xa2\x15\x0e\x94\xef\x3e\xa4\xd6\x18\x52\x33\xfa\x72\x17\x37\x8e\x14\x63\x62\x52\x6b\x0f\x5a\xd1\x76\x85\x43\x90\x04\xb8\xc0\x9b\x4c\x81\x2b\x77\xbd\x73\x3c\x04\x20\xd3\xa0\x8f\xcc\xd4\x78\x90\x23\x21\x06\x7c\x3b\xd7\x5f\xb1\x1d\x1c\xb2\x65\x5d\x35\x6d\xf9\x37\x60\x74\x78\xf4\x42\xa6\x20\xa3\xf8\x26\xb1\xef\x5d\x12\xdd\x38\xfe\xdb\x31\x0a\x1f\xd6\xab\x95\xb3\x41\x67\x52\xd4\xeb\xc8\x61\xe9\x25\x6d\xae\x7c\x88\xce\x61\xb2\x1e\xec\xa5\xe4\xce\x97\xa8\xe9\x21\x19\xe3\xcd\x66\xeb\x2b\x28\x2d\x24\xba\xe2\x95\x6f\x8a\x99\x04\x25\x0a\x59\x39\xeb\x75\x6e\x1e\xf9\xc7\xff\x1b\x94\x1e\x1f\x5a\x66\x3c\x20\xaf\x64\x65\xc0\xed\xe4\x98\x1c\xd9\x7b\xba\x79\x5a\x48\x9a\xba\x8f\xe5\x4a\xc6\xe4\xaf\x7f\x1b\xd8\x89\x21\x71\xd8\x57\x63\xf2\xd7\xbf\x0d\xfe\xff\x00\x8a\x8c\x14\x0f\x0f\x1a\x01\x00")
 
 func hypershiftOperatorHypershiftOpenshiftIo_hostedcontrolplanesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -358,12 +379,12 @@ func hypershiftOperatorHypershiftOpenshiftIo_hostedcontrolplanesYaml() (*asset,
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "hypershift-operator/hypershift.openshift.io_hostedcontrolplanes.yaml", size: 6730, mode: os.FileMode(0644), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe2, 0xed, 0xf0, 0x73, 0xeb, 0x11, 0x7a, 0xae, 0xd4, 0x13, 0x44, 0xcb, 0x32, 0x5c, 0x20, 0xdf, 0xcd, 0x27, 0xad, 0x7e, 0xb, 0xb2, 0x6d, 0xbb, 0xbd, 0x1, 0xab, 0xe6, 0xb2, 0xe2, 0xa0, 0xb5}}
+	info := bindataFileInfo{name: "hypershift-operator/hypershift.openshift.io_hostedcontrolplanes.yaml", size: 72207, mode: os.FileMode(0644), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbe, 0xcd, 0xe1, 0xdd, 0x34, 0x7c, 0x73, 0x43, 0x59, 0x82, 0x43, 0xb7, 0x6a, 0xac, 0x72, 0xec, 0xb3, 0x1b, 0xbd, 0x16, 0xd2, 0x5, 0xab, 0xfb, 0x5a, 0x79, 0x40, 0x6d, 0xd2, 0x6b, 0xfc, 0x7e}}
 	return a, nil
 }
 
-var _hypershiftOperatorHypershiftOpenshiftIo_nodepoolsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x1a\x6b\x6f\xe3\x36\xf2\x7b\x7e\xc5\xc0\x77\xc0\x26\x7b\xb6\xbc\xc9\x16\xbd\xd6\xc0\x62\x11\x64\x9b\x22\x68\x77\x2f\x48\xd2\x2d\x70\x71\xae\x1d\x4b\x63\x99\x0d\x45\xaa\x24\x95\xc4\x2d\xfa\xdf\x0f\x43\x8a\xb2\x14\xcb\x8f\xe6\xae\xfa\x64\x91\xc3\xe1\xbc\x5f\x32\x96\xe2\x33\x19\x2b\xb4\x9a\x00\x96\x82\x9e\x1c\x29\x7e\xb3\xc9\xfd\x57\x36\x11\x7a\xfc\x70\x7c\x70\x2f\x54\x36\x81\xb3\xca\x3a\x5d\x5c\x91\xd5\x95\x49\xe9\x03\xcd\x85\x12\x4e\x68\x75\x50\x90\xc3\x0c\x1d\x4e\x0e\x00\x50\x29\xed\x90\x97\x2d\xbf\x02\xa4\x5a\x39\xa3\xa5\x24\x33\xca\x49\x25\xf7\xd5\x8c\x66\x95\x90\x19\x19\x8f\x3c\x5e\xfd\xf0\x26\x79\x9b\xbc\x39\x00\x48\x0d\xf9\xe3\x37\xa2\x20\xeb\xb0\x28\x27\xa0\x2a\x29\x0f\x00\x14\x16\x34\x01\xa5\x33\x2a\xb5\x96\x36\x59\x2c\x4b\x32\x76\x21\xe6\x2e\xd1\x25\xa9\xf0\x4b\xe8\x03\x5b\x52\xca\x77\xe7\x46\x57\xe5\x04\x36\x81\x05\x84\x35\x95\x81\xc3\x4f\x3a\xa3\x4b\xad\xa5\x5f\x92\xc2\xba\xef\x3a\xcb\xdf\x0b\xeb\xfc\x56\x29\x2b\x83\xb2\x45\x8b\x5f\xb5\x0b\x6d\xdc\xa7\x15\xce\x11\xa8\xb2\xf9\x51\x83\x08\x95\x57\x12\xcd\xea\xe8\x01\x80\x4d\x75\x49\x13\xf0\x27\x4b\x4c\x29\x3b\x00\xa8\xe5\xe2\x31\x8d\x00\xb3\xcc\x4b\x1a\xe5\xa5\x11\xca\x91\x39\xd3\xb2\x2a\x54\x73\x4f\x46\x36\x35\xa2\x74\x5e\x92\xa7\x0f\x28\x24\xce\x24\x79\xba\xc3\xbd\x00\xbf\x58\xad\x2e\xd1\x2d\x26\x90\x58\x87\xae\xb2\x09\x53\x70\xa6\x2b\xe5\x6a\x88\x20\xde\x4f\xcf\x56\xdd\x92\x69\xe3\x4b\x73\x32\x07\x2b\xb8\x87\x63\x94\xe5\x02\x8f\x03\x5b\xe9\x82\x0a\x9c\xd4\x47\x58\xca\xa7\x97\x17\x9f\xdf\x5e\x77\x96\xa1\x4b\x66\x14\x2a\x64\x6c\x48\x64\xc1\x2d\x88\x21\x84\xa1\x0c\x98\x44\x02\x3d\xef\x6a\xc4\x8b\xde\xe8\x92\x8c\x13\x51\xc8\xe1\x69\x19\x71\x6b\xf5\xd9\x8d\xaf\x98\xa8\x00\xd5\xb9\xb4\x96\x35\x5f\xeb\x09\xe6\x7b\xdd\x42\x58\x30\x54\x1a\xb2\xa4\x82\x3d\xf3\x32\x2a\xd0\xb3\x5f\x28\x75\x09\x5c\x93\xe1\x83\xac\xf5\x4a\x66\x6c\xe6\x0f\x64\x1c\x18\x4a\x75\xae\xc4\x6f\x0d\x36\x0b\x4e\xfb\x6b\x24\x3a\xb2\xce\x8b\xd2\x28\x94\xf0\x80\xb2\xa2\x21\xa0\xca\xa0\xc0\x25\x18\x62\xbc\x50\xa9\x16\x06\x0f\x62\x13\xf8\xa8\x0d\x81\x50\x73\x3d\x81\x85\x73\xa5\x9d\x8c\xc7\xb9\x70\xd1\x41\x53\x5d\x14\x95\x12\x6e\x39\xf6\xbe\x26\x66\x95\xd3\xc6\x8e\x33\x7a\x20\x39\xb6\x22\x1f\xa1\x49\x17\xc2\x51\xea\x2a\x43\x63\x2c\xc5\xc8\x13\xab\xbc\x93\x26\x45\xf6\x37\x53\xbb\xb4\x7d\xd5\x11\x5e\x50\xbd\x75\x46\xa8\xbc\xb5\xe1\x5d\x65\x8b\x94\xd9\x67\x40\x58\xc0\xfa\x68\xe0\x62\x25\x4c\x5e\x62\x79\x5c\x7d\x73\x7d\x03\xf1\xea\x20\xf0\x20\xdb\x15\xa8\x5d\x89\x99\x45\x24\xd4\x9c\x4c\x80\x9c\x1b\x5d\x78\x2c\xa4\xb2\x52\x0b\xe5\xfc\x4b\x2a\x05\x29\x07\xb6\x9a\x15\xc2\xb1\xfe\x7e\xad\xc8\x3a\xd6\x40\x02\x67\x3e\x32\xc1\x8c\xa0\x2a\x33\x74\x94\x25\x70\xa1\xe0\x0c\x0b\x92\x67\x68\xe9\x2f\x17\x32\x4b\xd3\x8e\x58\x78\xfb\x89\xb9\x1d\x54\x9f\x03\x07\x39\xb5\x36\x62\xc8\xdb\xa0\x93\xe8\x45\xd7\x25\xa5\x7f\xd2\xdf\x36\xfb\x9c\xf7\xbb\xca\xe9\xeb\x14\xa5\x50\xf9\xf3\xad\x6d\xc7\x3c\x7f\xf8\xd4\xb7\x0c\x50\x08\x25\x8a\xaa\x98\xc0\x71\xef\xf6\x7a\x40\x5a\x3b\xfe\x17\xe0\xdd\x20\x77\x7e\x52\x59\x59\x47\x86\xc3\xf7\xfa\xc5\x1d\x35\x9c\xad\x20\xd9\x41\x58\x01\x1c\x4c\x43\xbc\xa1\xb8\xdd\x71\x85\x19\x49\xad\x72\x6f\xc1\x1b\x68\x5a\x33\x1c\x7e\x9a\xd8\xbe\x4e\xd1\x5c\x9b\x02\x9d\xe7\xf4\xed\xc9\x06\x9c\xfd\x52\x28\x25\x3a\x3e\xbd\x83\xcb\x68\x42\x97\x35\x78\x64\x35\x1e\x1f\xb1\xb1\x8a\xb9\x48\x39\x66\xce\x45\x5e\x99\x10\x5e\xe7\xda\x00\x7a\xca\x81\x13\x63\x02\xff\x52\x72\x09\x5a\x35\xf2\x89\x08\x9a\x98\x3b\x23\xb0\xe4\xd6\x05\xb3\xdd\xf0\xf0\xb1\x77\xf9\x19\x17\xa7\x3f\x5e\x47\xc2\xbb\x64\x56\x96\x32\x78\x5c\x90\x02\xa1\xac\x43\xc9\xb6\x0f\x5a\xf1\x81\x75\x4a\x76\x53\xc3\x8f\x47\xa4\x52\xba\x34\x7a\x2e\x64\x8f\x15\x75\xb5\xd3\xab\xf1\xe7\xb8\x6e\x18\x74\x23\xa2\x0e\xab\x17\xad\x23\x9d\xe8\x40\xe9\x49\x83\xcf\xdf\x9d\x00\xe5\x09\x14\x5f\x8c\x24\x9a\x9c\xfe\x17\x32\x6d\x35\x53\xd4\x63\x9d\xbd\x04\x9e\xfe\x78\x1d\xab\xce\x2b\x9a\x93\x21\xa6\xc7\x67\x18\xd3\xbc\x3a\xcd\x09\x27\x5a\x16\x6b\xaf\x49\x2d\xb3\x25\x5c\x7c\x18\xc2\xe9\xd5\xa7\x21\x68\x03\x73\x21\x1d\x19\xdb\xb5\xaf\x1a\x80\xf7\xcf\xc3\xbe\xcf\x38\x6c\x61\x01\x27\xe7\x8b\x6b\xff\x73\xc9\x0a\x2f\x38\x59\xb8\x05\xd7\x03\x8a\xe0\x51\x48\xc9\xf7\x55\x92\xf3\x3b\x20\xa7\x3c\x91\x05\x7b\x21\x63\xb4\xe9\xb7\x0c\xd8\xcb\x3a\xf8\x41\xb3\x21\xa8\xf5\xcb\x8b\x19\x99\x37\x02\xd8\x7a\x70\x0f\x5d\xf1\x53\x0b\xed\x4f\x10\xf1\x2a\xca\x31\x94\x02\xe4\x98\xa4\x7b\x5a\x8e\x43\x39\x50\xa2\x30\x36\x38\x93\xd3\x20\x32\xce\x94\xf3\xa5\x57\x69\xad\xb6\x9b\x05\x2d\x01\x0d\x01\x96\xa5\x14\x94\x01\xa6\xa9\x36\x99\x2f\x1f\x42\x45\x65\x2a\x49\xb6\xb6\xd8\x8c\xd5\xcc\x8b\xac\xfa\xd3\xcb\x8b\x55\x12\xcf\x74\x6a\x13\x7c\xb4\x09\x16\xf8\x9b\x56\x49\xaa\x8b\xf1\xe9\x8f\xd7\xdf\x9c\x9d\x8c\x43\x49\x36\xfe\xc1\x92\xf9\xb6\x12\x19\x8d\x7f\xe0\x02\xfd\xa7\x40\xb9\x50\x79\xb2\x70\x85\x7c\xb5\x95\x67\xe1\xa8\xd8\x21\x96\x67\x82\x09\xd8\x83\x58\x82\x58\x7b\xc4\xa0\x3a\x26\xbc\x03\xfd\x7e\x36\x14\x1e\xd5\x9b\xa6\x76\x90\xfc\xa9\x95\xa5\x02\xc9\x49\x64\xc3\xf7\x50\x5e\x4d\x29\x5a\x1a\x59\x6e\x1e\x9d\x78\xa0\xcd\x16\xbf\x7a\xf6\xb4\xbd\xf0\x84\x5a\xf8\x4f\x93\xfe\xd9\x1f\x03\xa1\x52\x59\x65\x64\x83\xbf\x9b\xe0\xbf\xb5\xf4\x63\x95\x7d\xde\x7e\x7d\x21\x4b\x7b\x99\xc3\x0b\xb8\x8f\xe0\x68\x0c\x2e\x77\x40\x73\xd9\xcb\x55\xdd\x2e\x32\x46\x5e\x79\x3b\x81\x82\x3c\x76\x80\x6d\xa9\x8f\xfa\x00\x77\xb1\x21\x76\x10\xdf\xcd\x5f\x1f\xfe\xbf\xd1\x6e\x27\x2f\xdb\x05\x3c\xea\xe4\xe0\x2d\xb5\xe6\x86\x1b\x36\x6e\xf6\x5f\x3b\x6a\x17\xa0\xcf\x76\x62\xc5\xb4\x57\x0b\xe1\xa7\x02\xfb\x34\x11\x1e\xb0\x53\x28\xe8\x99\xe5\x36\xed\xe5\x7d\x44\xaa\x55\x98\x70\xf4\xf8\xce\x46\x97\xea\x50\x37\x38\x8b\x28\xfc\xc4\x09\x85\x62\x12\x1d\x0a\x69\x7d\x65\xc9\x7e\x8f\x9c\xcb\x5d\x0c\x64\x69\x65\x8c\xef\x16\x23\xcd\xbe\xee\x3e\xbd\xbc\x80\x58\x6c\x24\x30\x1a\x8d\xe0\x86\x97\xad\x33\x55\xea\x38\x68\x73\x6d\xac\x32\xca\x3c\xd6\x4c\x18\xdf\xb4\x5b\x46\xce\x51\xdb\x1b\x36\xa0\xab\x43\x25\xc9\x0c\x4a\x74\x8b\x66\xe8\xb2\x62\x34\x01\x38\xd7\x06\xe8\x09\x8b\x52\xd2\xd0\xab\x05\xce\xb5\xae\xc5\x1b\x2e\xfc\xdd\x33\x3a\x1e\xc3\x55\xd3\x12\xb7\x04\x1e\xc6\x6c\x7e\x2e\x01\x73\xad\x5f\xd9\x2e\x4f\x49\x3c\xfc\x9d\xd2\x8f\xaa\x8f\x04\x7f\x27\x1a\x9a\xc0\x74\xd0\x8c\x8d\xa6\x83\x21\x4c\x07\x97\x46\xe7\x86\x2c\xe7\x43\x5e\x40\x95\xc1\x74\xf0\x81\x72\x83\x19\x65\xd3\x41\x44\xfd\x8f\x12\x5d\xba\xf8\x48\x26\xa7\xef\x68\xf9\xce\x23\xec\x6c\x5d\x3b\x83\x8e\xf2\xe5\xbb\x82\x61\x9a\x3d\x29\xac\x63\xf7\x78\x57\x60\xd9\x59\xfc\x88\x65\x07\x51\xa3\x56\x0b\xb7\x77\xdc\x13\x3f\x1c\x27\x2b\x55\xff\xfc\x8b\xd5\x6a\x32\x1d\xac\x78\x1a\xea\x82\x0d\xa6\x74\xcb\xe9\x00\x3a\x14\x4c\xa6\x03\x4f\x43\x5c\x8f\x44\x4f\xa6\x03\xbe\x8d\x97\x8d\x76\x7a\x56\xcd\x27\xd3\xc1\x6c\xe9\xc8\x0e\x8f\x87\x86\xca\x21\x47\xc9\x77\xab\x1b\xa6\x83\x9f\x61\xaa\x22\xd1\xda\x2d\xc8\x04\x4d\x5b\xf8\x63\xd0\x63\xa6\xbb\xb2\xb3\x44\xeb\x6e\x0c\xfa\x24\x13\xc6\x9e\x9b\x62\x5f\xc7\xe0\xd7\x8f\xc5\x7e\x85\x77\xc0\xf1\x42\xdd\xbd\xd4\xc2\x72\x0d\x34\x5b\xaf\xd1\x85\x77\x8a\x60\x15\xbe\x7e\x56\x9e\x99\xa4\xb6\xf8\xa6\xc7\xf2\xcd\x0e\xa3\xaa\x54\x46\x46\xfa\xfa\x77\x85\x35\x5d\xa0\xca\xb9\x3a\x86\x0b\x76\x21\xf4\x4e\xa2\xb4\x83\x7b\xb6\xba\x21\x1f\xe4\xa6\x29\x4e\x7d\x3c\x5d\x0d\x46\xf6\xb6\xe0\x25\x35\x1a\x5f\x16\xa5\x29\x95\x8e\x4d\x71\x53\xc6\x8d\x3d\x6c\x86\x8e\x46\x8c\x71\x03\xdc\xce\x70\x5f\x90\xb5\x98\xef\x27\xf0\x1a\x36\x14\x6e\x8b\xaa\x40\x05\x86\x30\xf3\x93\xd6\x66\x4f\x65\x22\x45\x3f\xe2\x8a\xc1\x07\x67\xba\x0a\xe1\x60\x25\xff\x5a\xc4\x75\x87\x81\x0a\xbc\xc1\xd6\x84\x6e\x62\xba\xc0\xa7\xef\x49\xe5\x6e\x31\x81\xb7\x27\xff\xfc\xf2\xab\x97\xf2\x1c\x83\xf5\xb7\xa4\x28\xf4\xb4\x7b\xb1\xbf\x7e\xac\x35\xa7\xf3\xfc\x25\x71\x64\x95\xe4\x2b\x18\x6f\x11\x5d\x3b\x7c\x44\xeb\xfb\x81\x19\x72\xd9\x5b\x95\x2c\x0f\x0e\x85\x31\x6d\x0e\x41\xcc\xfb\x91\x89\x26\xc2\xc9\x25\x1c\x9f\x0c\x61\x56\x8b\x76\x3d\xb6\xdd\x3e\xdd\x25\x3d\x24\x0b\x0b\x5f\x0f\x9f\xd1\x23\x2c\xb0\x8a\xf4\xdc\xdb\x13\x3c\x0a\xb7\xe0\x6a\xc2\xe7\x8a\xba\xd3\xe8\xc9\x15\xd4\xd0\xbb\xcb\x4a\x85\x72\x5f\x7e\xb1\x49\xa9\x71\x28\xf5\x66\xab\x3a\x37\x8f\xbb\xb8\x2c\x40\xbb\xa7\x0e\x03\xe8\x2a\x41\x22\x07\xa7\xdc\x60\x51\xa0\x13\x69\x6c\x3f\x04\x77\x27\x2b\x43\xf6\x9d\x56\x38\xc8\x29\xaf\x23\xbb\x57\xb6\x8e\x36\x2d\xd3\xbe\x34\x3a\xab\x52\xee\xfc\xf4\x1c\xda\xb3\x9e\x18\x86\x96\x25\x05\xdb\x0f\x25\x04\xd0\x13\x8b\xba\x19\x77\x87\x89\x38\xa1\x12\x2a\xb7\xf5\x95\xc2\x86\x30\x11\x12\xd1\xe3\x82\x7c\xd4\xf5\xc3\xfb\x56\xb5\xae\x95\x15\x19\x19\x6e\x15\x21\xaf\xd0\xa0\x72\x44\x19\x07\x19\x76\xb8\x1a\xb6\x15\xd8\x70\x35\xfe\x8d\xbe\x17\x1c\x33\x84\x24\x26\xb1\x1e\x19\x7b\xff\xdc\xc3\x31\x8f\xdf\x9c\x6c\xd1\x74\x03\xb5\x01\xa4\x44\xe7\xc8\xa8\x09\xfc\xe7\xf6\x74\xf4\x6f\x1c\xfd\x76\x77\x58\xff\x78\x33\xfa\xfa\xa7\xe1\xe4\xee\x75\xeb\xf5\xee\xe8\xfd\xdf\x5f\x1a\x02\xfa\x6a\xbd\x0d\x26\x53\xa7\x87\x58\x33\x45\x2d\x0e\xe3\xe0\xe4\xc6\x54\x34\x84\x73\x94\x96\x86\xf0\x83\xf2\x41\x7f\x93\xa0\x48\x55\x3d\xc3\xc3\xf0\x8c\x60\xc0\xa8\xfa\x12\x68\xbd\xed\xef\xd8\xbc\x5f\xdf\xfd\x52\x91\xb8\x2d\xf3\xb2\x8e\x40\x7c\x65\xa2\xe7\xed\xf8\xd1\xfa\x8c\x00\x3e\x8e\x71\x35\x96\xd4\x95\x9d\x9f\x37\xb4\x3e\x33\x70\x49\xf9\x11\xd5\x12\x56\xc1\x2a\xd4\x61\xcf\x2d\xd9\x3a\x8e\x38\x98\x1a\x6d\x6d\xd3\xdd\x58\x90\xe2\x9e\x56\xdf\xf8\x42\x08\x9c\x51\x8a\xbe\x06\x35\x33\xe1\x0c\x9a\x65\xab\xa0\x86\x14\x95\xff\xea\x61\x69\x5e\x49\x38\xb4\x44\xe0\xbf\xfd\xad\xc7\xcc\xa3\x10\x19\x71\x26\xa4\x70\x4b\x8e\x7d\x19\xa5\x5a\xcd\xa5\xa8\x4b\xdf\xa2\xd4\xc6\xa1\x72\xc1\x9d\x0c\xe5\xf4\x04\xc2\x41\xc1\xe5\x14\xf9\x49\xcf\x61\xa6\xec\xf1\xf1\xc9\xdb\xeb\x6a\x96\xe9\x02\x85\x3a\x2f\xdc\xf8\xe8\xfd\xe1\xaf\x15\x4a\x3f\x45\xe3\x2e\xe5\xbc\x70\x47\x7b\x24\xb9\xe3\x2f\x77\xfa\xc9\xe1\x6d\xf0\x86\xbb\xc3\xdb\x51\xfd\xeb\x75\x5c\x3a\x7a\x7f\x38\x4d\xb6\xee\x1f\xbd\x66\xd2\x5a\x3e\x76\x77\x3b\x5a\x39\x58\x72\xf7\xfa\xe8\x7d\x6b\xef\xe8\x85\xee\xb6\xad\x5b\x1c\xf5\x94\x71\xbd\x60\x75\x81\xd1\xbb\x17\x82\x73\xef\x56\x50\x71\xef\x96\xeb\x6f\x4e\xf7\x68\x4d\xfb\x9a\xf7\x2d\x1f\x1c\xd6\x9a\x48\x0f\x17\x8b\xd5\x42\x5b\xff\xd1\x34\xe4\xf4\xa6\x91\x54\x55\x31\x23\x13\xfa\xfa\x52\x8a\x14\xed\xa6\xef\x1f\x7d\xa9\x71\x63\xa7\xdc\x98\xfa\xee\x8e\x78\x6d\x31\x50\x36\x01\x67\xaa\x20\x38\xeb\xb4\xe1\xe2\xb1\xb5\x52\xcd\x1a\x47\x8d\x77\xdb\x14\xdb\x23\x7d\x4e\x88\x57\x35\x4b\xf1\x7b\x7c\x49\xe9\xda\xd7\xf8\x18\xa4\x9f\xc3\xf6\x7f\xbb\xaf\x03\x3a\xfc\xfe\xc7\xc1\x2a\xb6\x87\x3a\x3a\xb8\x5c\xe7\x6f\x0e\x83\x10\x45\xe3\xbf\x18\xfc\x6b\xab\x03\x87\xdb\xbb\x83\xc0\x1d\x65\x9f\xe3\xff\x10\x78\xf1\xbf\x01\x00\x00\xff\xff\x59\x24\xdc\x33\x2b\x22\x00\x00")
+var _hypershiftOperatorHypershiftOpenshiftIo_nodepoolsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x1a\x6b\x6f\x1b\x37\xf2\xbb\x7e\xc5\x40\x77\x40\xec\x9c\xb4\x8a\x9d\xa2\xd7\x0a\x08\x02\xc3\x69\x0a\xa3\x4d\xce\xb0\xdd\x04\x38\xdb\xd7\x8e\x76\x47\x2b\x36\x5c\x72\x4b\x72\x6d\xab\x45\xff\xfb\x61\xc8\xe5\x3e\xac\xa7\x7d\x3d\xcb\x1f\xb4\xe4\x70\x38\xef\xd7\x0a\x4b\xf1\x89\x8c\x15\x5a\x4d\x01\x4b\x41\x0f\x8e\x14\x3f\xd9\xe4\xcb\x37\x36\x11\x7a\x72\x77\x34\xf8\x22\x54\x36\x85\xd3\xca\x3a\x5d\x5c\x90\xd5\x95\x49\xe9\x1d\xcd\x85\x12\x4e\x68\x35\x28\xc8\x61\x86\x0e\xa7\x03\x00\x54\x4a\x3b\xe4\x65\xcb\x8f\x00\xa9\x56\xce\x68\x29\xc9\x8c\x73\x52\xc9\x97\x6a\x46\xb3\x4a\xc8\x8c\x8c\x47\x1e\xaf\xbe\x7b\x95\xbc\x4e\x5e\x0d\x00\x52\x43\xfe\xf8\x95\x28\xc8\x3a\x2c\xca\x29\xa8\x4a\xca\x01\x80\xc2\x82\xa6\xa0\x74\x46\xa5\xd6\xd2\x26\x8b\x65\x49\xc6\x2e\xc4\xdc\x25\xba\x24\x15\xbe\x09\x3d\xb0\x25\xa5\x7c\x77\x6e\x74\x55\x4e\x61\x13\x58\x40\x58\x53\x19\x38\xfc\xa8\x33\x3a\xd7\x9a\x2f\x03\x90\xc2\xba\x1f\x7a\xcb\x3f\x0a\xeb\xfc\x56\x29\x2b\x83\xb2\x43\x8b\x5f\xb5\x0b\x6d\xdc\xc7\x16\xe7\x18\x54\xd9\x7c\xb1\xfe\x9b\x15\x2a\xaf\x24\x9a\xf6\xe8\x00\xc0\xa6\xba\xa4\x29\xf8\x93\x25\xa6\x94\x0d\x00\x6a\xb9\x78\xea\xc6\x80\x59\xe6\x25\x8d\xf2\xdc\x08\xe5\xc8\x9c\x6a\x59\x15\x51\xc2\x63\xc8\xc8\xa6\x46\x94\x0c\x32\x85\x93\x3b\x14\x12\x67\x92\x3c\xdd\xe1\x5e\x80\x5f\xad\x56\xe7\xe8\x16\x53\x48\xac\x43\x57\xd9\x84\x29\x38\xd5\x95\x72\x35\x04\x4b\x23\x88\xa0\xbb\xea\x96\x4c\x1b\x5f\x9a\x93\x19\xb4\x70\x77\x47\x28\xcb\x05\x1e\xf9\x25\x9b\x2e\xa8\xf0\xea\xe7\x27\x56\xc6\xc9\xf9\xd9\xa7\xd7\x97\xbd\x65\xe8\x93\x19\x85\x0a\x19\x1b\x12\x59\x70\x0b\x62\x08\x61\x28\x03\x26\x91\x40\xcf\x1b\xa8\x06\x47\x69\x74\x49\xc6\x89\x28\xe4\xf0\xe9\x18\x71\x67\xf5\xd1\x8d\x2f\x98\xa8\x00\xd5\xbb\xb4\x96\x35\x5f\xeb\x09\xe6\x7b\xdd\x42\x58\x30\x54\x1a\xb2\xa4\x82\x3d\xf3\x32\x2a\xd0\xb3\x5f\x29\x75\x09\x5c\x92\xe1\x83\x60\x17\xba\x92\x19\x9b\xf9\x1d\x19\x07\x86\x52\x9d\x2b\xf1\x7b\x83\xcd\x82\xd3\xfe\x1a\x89\x8e\xac\xf3\xa2\x34\x0a\x25\xdc\xa1\xac\x68\x04\xa8\x32\x28\x70\x09\x86\x18\x2f\x54\xaa\x83\xc1\x83\xd8\x04\x3e\x68\x43\x20\xd4\x5c\x4f\x61\xe1\x5c\x69\xa7\x93\x49\x2e\x5c\x74\xd0\x54\x17\x45\xa5\x84\x5b\x4e\xbc\xaf\x89\x59\xe5\xb4\xb1\x93\x8c\xee\x48\x4e\xac\xc8\xc7\x68\xd2\x85\x70\x94\xba\xca\xd0\x04\x4b\x31\xf6\xc4\x2a\x66\xca\x26\x45\xf6\x37\x53\xbb\xb4\x7d\xd1\x13\x5e\x50\xbd\x75\x46\xa8\xbc\xb3\xe1\x5d\x65\x8b\x94\xd9\x67\x40\x58\xc0\xfa\x68\x60\xb4\x15\x26\x2f\xb1\x3c\x2e\xbe\xbb\xbc\x82\x78\x75\x10\x78\x90\x6d\x0b\x6a\x5b\x31\xb3\x88\x84\x9a\x93\x09\x90\x73\xa3\x0b\x2f\x55\x52\x59\xa9\x85\x72\xfe\x21\x95\x82\x94\x03\x5b\xcd\x0a\xe1\x58\x7f\xbf\x55\x64\x1d\x6b\x20\x81\x53\x1f\x99\x60\x46\x50\x95\x19\x3a\xca\x12\x38\x53\x70\x8a\x05\xc9\x53\xb4\xf4\x7f\x17\x32\x4b\xd3\x8e\x59\x78\xfb\x89\xb9\x1b\x54\xdb\x3f\xc6\x32\xad\x6d\xb0\xb3\x11\x43\xde\x06\x9d\x44\x2f\xba\x2c\x29\x7d\xa2\xbf\x6d\xf6\x39\xfe\x60\xe5\xf4\x65\x8a\x52\xa8\xfc\xf1\xd6\xb6\x63\xfc\x29\xf0\x61\xdd\x32\x40\x21\x94\x28\xaa\x62\x0a\x47\x6b\xb7\x57\x03\x52\xff\xaf\x10\x8f\x62\xc0\x5f\x82\x77\x83\xdc\xf9\x3f\x95\x95\x75\x64\x38\x7c\xaf\x5e\xdc\x53\xc3\x69\x0b\xc9\x0e\xc2\x16\xcb\x41\x97\xe5\xce\xdf\xeb\xed\x9e\x2b\xcc\x48\x6a\x95\x7b\x0b\x5e\xc1\xbd\xc1\x70\xf8\xbf\x89\xed\xab\x14\xcd\xb5\x29\xd0\x79\x09\xbe\x3e\x1e\x3c\x45\x0a\xa5\x44\xc7\xa7\x77\x70\x19\x4d\xe8\xbc\x06\x8f\xac\xc6\xe3\x63\x36\x56\x31\x17\x29\xc7\xcc\xb9\xc8\x2b\x13\xc2\xeb\x5c\x1b\x40\x9f\x17\x81\x13\x63\x02\xff\x52\x72\x09\x5a\x35\xf2\x89\x08\x9a\x98\x3b\x23\xb0\xe4\x92\x27\x1a\x1e\xde\xaf\x5d\x7e\xc4\xc5\xc9\xe7\xcb\x48\x78\x9f\xcc\xca\x52\x06\xf7\x0b\x52\x20\x94\x75\x28\xd9\xf6\x41\x2b\x38\xf9\x7c\xb9\x4a\xc9\x6e\x6a\xf8\xe3\x11\xa9\x94\xce\x8d\x9e\x0b\xb9\xc6\x8a\xf6\xd0\xf8\x63\x5c\x57\xcb\x72\x0b\xa2\x1e\xab\x67\x9d\x23\xbd\xe8\x40\xe9\x71\x83\xcf\x5b\x46\x02\x94\x27\x50\x7c\x35\x96\x68\x72\xfa\x5f\xc8\xb4\xd5\x4c\x91\xdb\x93\xc0\x93\xcf\x97\xb1\xea\xbc\xa0\x39\x19\x62\x7a\x7c\x86\x31\xcd\xa3\xd3\x9c\x70\xa2\x65\xb1\xf6\x9a\xd4\x32\x5b\xc2\xd9\xbb\x11\x9c\x5c\x7c\x1c\x81\x36\x30\x17\xd2\x91\xb1\x7d\xfb\xaa\x01\x78\xff\x7d\xd8\xf7\x49\x99\x2d\x2c\xe0\xe4\x7c\xc1\xe1\x53\xcc\x97\xac\xf0\x82\x33\xb2\x5b\x70\x3d\xa0\x08\xee\x85\x94\x9c\xca\x2a\xc9\xf9\x1d\x90\x53\x9e\xc8\x82\xbd\x90\x31\xda\xac\xb7\x8c\xfd\xac\x83\x3f\x68\x36\x04\xb5\xf5\xf2\xba\xf8\xc8\x3c\x45\x01\x6c\x3d\xb8\x87\xae\xf8\xbf\x16\xda\x13\x88\x78\x11\xe5\xe8\x15\x65\xc9\x31\x49\x5f\x68\x39\x09\xe5\x40\x89\xc2\xd8\xe0\x4c\x4e\x83\xc8\x38\x53\xce\x97\x80\xad\xda\xae\x16\xb4\x04\x34\x04\x58\x96\x52\x50\x06\x98\xa6\xda\x64\x2c\xfd\xba\xa2\x32\x95\x24\x5b\x5b\x6c\x06\xb3\xa5\x5f\x64\xd5\x9f\x9c\x9f\xb5\x49\x3c\xd3\xa9\x4d\xf0\xde\x26\x58\xe0\xef\x5a\x25\xa9\x2e\x26\x27\x9f\x2f\xbf\x3b\x3d\x9e\x84\x92\x6c\xf2\x93\x25\xf3\x7d\x25\x32\x9a\xfc\xc4\x05\xfa\xcf\x81\x72\xa1\xf2\x64\xe1\x0a\xf9\x62\xb0\x91\x61\xf6\x5d\x47\xc5\x56\xd5\xad\x08\x26\x60\xe7\xe0\x82\xb5\x58\xd7\x88\x41\xf5\x4c\x78\x07\xfa\xfd\x6c\xa8\x53\xe5\xef\x84\x7a\x44\xf2\xc7\x4e\x96\x0a\x24\x27\xb5\x9b\xf8\x6e\xc0\x7a\x35\xa5\x68\x69\x6c\xb9\x79\x74\xe2\x8e\x92\xc1\x8e\x0b\xf6\xb7\xbd\xf0\xf1\x66\x63\x9f\x4c\xfa\x27\x7f\x0c\x84\x4a\x65\x95\x91\xf5\xee\xaa\x4d\xf0\xdf\x5a\xfa\xb1\xca\xae\x39\x0a\x8f\xcf\x64\x69\x2f\x73\x78\x06\xf7\x11\x1c\x8d\xc1\xe5\x0e\x68\x2e\x7b\xb9\xaa\xdb\x45\xc6\xd8\x2b\x6f\x27\x50\x90\xc7\x0e\xb0\x2d\xf5\xd1\x73\xd8\x10\x3b\x88\xef\xa9\xf8\xec\xdd\x5f\x1b\xed\x76\xf2\xb2\x5d\xc0\xe3\x5e\x0e\x1e\x3c\xf9\x86\x8d\x9b\xeb\xaf\x1d\x77\x0b\xd0\x47\x3b\xb1\x62\x1a\xec\x81\x9f\x5b\xee\xea\x91\xe9\xae\xad\xeb\x2e\x3d\x60\xaf\x50\xd0\x33\xcb\xdd\xf0\xf3\xfb\x88\x54\xab\x30\xe1\x58\xd9\xd9\xe2\x52\x3d\xea\x86\xa7\x11\x05\x97\x95\x0e\x85\xe2\xcc\xe0\x50\x48\xeb\x2b\x4b\xf6\x7b\xe4\xca\xd3\xc5\x40\x96\x56\xc6\xf8\x6e\x31\xd2\xec\xeb\xee\x93\xf3\x33\x88\xc5\x46\x02\xe3\xf1\x18\xae\x78\xd9\x3a\x53\xa5\x8e\x83\x36\x77\x1e\x2a\xa3\xcc\x63\xcd\x84\x61\x8c\x95\x65\xe4\x80\x2a\x18\x36\xa0\xab\x43\x25\xc9\x0c\x4a\x74\x8b\x66\xe8\xd2\x32\x9a\x00\xbc\xd7\x06\xe8\x01\x8b\x52\xd2\xc8\xab\x05\xde\x6b\x5d\x8b\x37\x5c\xf8\x87\x67\x74\x32\x81\x8b\xa6\x25\xee\x08\xdc\x17\x18\x96\x49\x47\x98\x6b\xfd\xc2\xf6\x79\x4a\xe2\xe1\x1f\x94\xbe\x57\xeb\x48\xf0\x77\xa2\xa1\x29\xdc\x0c\x9b\xb1\xd1\xcd\x70\x04\x37\xc3\x73\xa3\x73\x43\x96\xf3\x21\x2f\xf0\x94\xe2\x66\xf8\x8e\x72\x83\x19\x65\x37\xc3\x88\xfa\x1f\x25\xba\x74\xf1\x81\x4c\x4e\x3f\xd0\xf2\x8d\x47\xd8\xdb\xba\x74\x06\x1d\xe5\xcb\x37\x05\xc3\x34\xc7\x78\xb8\xc6\x25\xea\x9b\x02\xcb\xde\xe2\x07\x2c\x7b\x88\x1a\xb5\x5a\xb8\xbe\xe5\x9e\xf8\xee\x28\x69\x55\xfd\x0b\x8f\xb5\xa6\x37\xc3\x96\xa7\x91\x2e\xd8\x60\x4a\xb7\xbc\x19\x42\x8f\x82\xe9\xcd\xd0\xd3\x10\xd7\x23\xd1\xd3\x9b\x21\xdf\xc6\xcb\x46\x3b\x3d\xab\xe6\xd3\x9b\xe1\x6c\xe9\xc8\x8e\x8e\x46\x86\xca\x11\x47\xc9\x37\xed\x0d\x37\xc3\x5f\xe0\x46\x45\xa2\xb5\x5b\x10\xd7\x94\x24\x33\x0b\x7f\x0e\x07\x4f\xcf\xce\x12\xad\xbb\x32\xe8\x93\x4c\x18\x7b\xae\x87\x7b\x64\xf0\xab\xc7\x62\xbf\xc2\x3b\xe0\x44\x41\xb1\x7b\xa9\x85\xe5\x1a\x68\xb6\x5e\x1e\xa0\xb0\x53\x04\xab\xe0\x21\x15\x2a\xcf\x4c\x52\x5b\x7c\xd3\x63\xf9\x66\x87\x51\x55\x2a\x23\x23\x7d\xfd\xdb\x62\x4d\x17\xa8\x72\xae\x8e\xe1\x8c\x5d\x08\xbd\x93\xf0\xa4\xe5\x0b\x5b\xdd\x88\x69\xe0\xa6\x29\x4e\x7d\x3c\x5d\x0d\x46\xf6\x36\x2f\xbb\x88\x86\x0f\x63\x9a\x52\xe9\x78\x82\xb9\x29\xe3\xc6\x1e\x96\xe7\x38\x63\xc6\x38\x78\x66\xb8\x2f\xc8\x5a\xcc\xf7\x13\x78\x0d\xeb\x29\x84\x45\x55\xa0\x02\x43\x98\x31\x9d\xed\x9e\xca\x44\x8a\x8e\x99\x8d\xc1\x07\x67\xba\x0a\xe1\xa0\x95\x7f\x2d\xe2\xba\xc3\x40\x05\xde\x60\x6b\x42\x37\x31\x5d\xe0\xc3\x8f\xa4\x72\x1e\xe1\xbe\x3e\xfe\xe7\xd7\xdf\x3c\x97\xe7\x18\xac\xbf\x27\x45\xa1\xf5\xde\x8b\xfd\xd5\x63\x9d\x39\x9d\xe7\x2f\x89\x23\xab\x24\x6f\x61\xbc\x45\xf4\xed\xf0\x1e\x2d\xf7\xed\x30\x43\x2e\x7b\xab\x92\xe5\xc1\xa1\x30\xa6\xcd\x11\x88\xf9\x7a\x64\xa2\x89\x70\x72\x09\x47\xc7\x23\x98\xd5\xa2\x5d\x8d\x6d\xd7\x0f\xb7\xc9\x1a\x92\x85\x85\x6f\x47\x8f\xfc\x82\x07\x8f\x95\x4f\x0b\x6c\x4f\x70\x2f\xdc\x82\xbb\x10\x9f\x2b\xea\x4e\xa3\x17\x57\x63\x02\x89\xf4\xee\xb2\x52\xa1\xdc\xd7\x5f\x6d\x80\x69\x86\x52\xaf\xb6\xaa\x73\xd3\x58\x8a\x3f\x86\xd0\xee\xa9\xc3\x00\xda\x26\x48\xe4\xe0\x94\x1b\x2c\x0a\x74\x22\x8d\xed\x87\x20\xd3\x35\x64\x66\xb5\x3e\xc8\x29\xaf\x27\xbb\x17\xb6\x8e\x36\x1d\xd3\x3e\x37\x3a\xab\x52\xee\xfc\xf4\xbc\xed\xc8\x5b\x71\x33\x47\x61\x9e\x1b\x4a\x08\xa0\x07\x16\x75\x33\xee\x0e\x13\x71\x42\x25\x78\xf0\x15\xae\x14\x36\x84\xd8\x90\x88\xee\x17\xc4\x81\xca\x6b\xa6\x5b\xad\x6b\x65\x45\x46\xfc\xfe\x00\x21\xaf\xd0\xa0\x72\x44\x19\x9c\x9c\x9f\xb1\xc3\xd5\xb0\x9d\xc0\x86\xed\xf8\x37\xfa\x5e\x70\x4c\x7f\x97\x27\xb1\x1e\x19\x7b\xff\xdc\xc3\x31\x8f\x5e\x1d\x6f\xd1\x74\x03\xb5\x01\xa4\x44\xc7\xef\x06\xa6\xf0\x9f\xeb\x93\xf1\xbf\x71\xfc\xfb\xed\x41\xfd\xe5\xd5\xf8\xdb\x9f\x47\xd3\xdb\x97\x9d\xc7\xdb\xc3\xb7\x7f\x7f\x6e\x08\x58\x57\xeb\x6d\x30\x99\x3a\x3d\xe8\x79\x5f\xf1\xa3\x38\x38\xb9\x32\xfc\x12\xe3\x3d\x4a\x4b\x23\xf8\x49\xf9\xa0\xbf\x49\x50\xa4\xaa\x35\xc3\xc3\x58\xac\x0e\x19\xd5\x70\xf3\xb6\xbf\x63\xf3\x7e\x7d\xf7\x73\x45\xe2\xb6\xcc\xcb\x7a\x02\x61\x40\x66\xbc\x35\x68\xd1\x79\x8d\xc0\x03\x24\xc1\xb3\x4c\x9d\xd4\x95\x9d\x9f\x37\x34\xfb\xa1\xa4\xfc\x80\x6a\x09\x6d\xb0\x4a\x3c\xce\xc7\x96\x6c\x1d\x47\x1c\x4c\x8d\xb6\xb6\xe9\x6e\x2c\x48\xf1\x85\xda\x77\x7c\x21\x04\xce\x28\x45\x5f\x83\x9a\x99\x70\x06\xcd\xb2\xa5\xce\x42\x8a\xca\xbf\xf5\xb0\x34\xaf\x24\x1c\x58\x22\xf0\xef\xfe\x56\x63\xe6\x61\x88\x8c\x38\x13\x52\xb8\x25\x97\x04\x19\xa5\x5a\xcd\xa5\xa8\x4b\xdf\xa2\xd4\xc6\xa1\x72\xc1\x9d\x0c\xe5\xf4\x00\xc2\x41\xc1\xe5\x14\xf9\x49\xcf\x41\xa6\xec\xd1\xd1\xf1\xeb\xcb\x6a\x96\xe9\x02\x85\x7a\x5f\xb8\xc9\xe1\xdb\x83\xdf\x2a\x94\x1c\x59\x32\xee\x52\xde\x17\xee\x70\xb7\x2f\xbd\x3e\xfa\x7a\xa7\x9f\x1c\x5c\x07\x6f\xb8\x3d\xb8\x1e\xd7\xdf\x5e\xc6\xa5\xc3\xb7\x07\x37\xc9\xd6\xfd\xc3\x97\x4c\x5a\xc7\xc7\x6e\xaf\xc7\xad\x83\x25\xb7\x2f\x0f\xdf\x76\xf6\x0e\x9f\xe9\x6e\xdb\xba\xc5\xf1\x9a\x32\x6e\x2d\x58\x5d\x60\xac\xdd\x0b\xc1\x79\xed\x56\x50\xf1\xda\x2d\xb7\xbe\x39\xdd\xd8\x7d\x6e\x6f\xde\xb7\xbc\x70\xe8\x79\x4f\xf3\x7a\x39\x16\xab\x85\xb6\xfe\xa5\x69\xc8\xe9\x31\x63\x83\xaa\x8a\x19\x19\xf6\x33\x43\xa5\x14\x29\xda\x64\xb0\x7f\x6a\xdc\xd8\x29\x37\xa6\x3e\xd8\xc9\xf3\xca\x62\xa0\x6c\x0a\xce\x54\x61\xf4\x6d\x9d\x36\x5c\x3c\x76\x56\xaa\x59\xe3\xa8\xf1\x6e\x9b\x62\x77\xa4\xcf\x09\xf1\xa2\x66\x29\xbe\x8f\x2f\x29\x5d\x79\x1b\x1f\x83\xf4\x63\xd8\xf5\xef\xee\xeb\x80\x0e\x7f\xfc\x39\x68\x63\x7b\xa8\xa3\x29\xeb\xfc\x24\x81\x5f\x3f\x4e\x61\x38\xec\xfd\x8a\xc1\x3f\xb6\x51\x60\x0a\xd7\xb7\xfc\xab\x04\xa7\x0d\x65\x9f\xe2\xef\x10\xe0\xfa\x76\xf0\xdf\x01\x00\x59\x24\xdc\x33\x2b\x22\x00\x00")
 
 func hypershiftOperatorHypershiftOpenshiftIo_nodepoolsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -474,21 +495,22 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"cluster-api/cluster.x-k8s.io_clusters.yaml":                              clusterApiClusterXK8sIo_clustersYaml,
-	"cluster-api/cluster.x-k8s.io_machinedeployments.yaml":                    clusterApiClusterXK8sIo_machinedeploymentsYaml,
-	"cluster-api/cluster.x-k8s.io_machinehealthchecks.yaml":                   clusterApiClusterXK8sIo_machinehealthchecksYaml,
-	"cluster-api/cluster.x-k8s.io_machines.yaml":                              clusterApiClusterXK8sIo_machinesYaml,
-	"cluster-api/cluster.x-k8s.io_machinesets.yaml":                           clusterApiClusterXK8sIo_machinesetsYaml,
-	"cluster-api/infrastructure.cluster.x-k8s.io_awsclusters.yaml":            clusterApiInfrastructureClusterXK8sIo_awsclustersYaml,
-	"cluster-api/infrastructure.cluster.x-k8s.io_awsmachinepools.yaml":        clusterApiInfrastructureClusterXK8sIo_awsmachinepoolsYaml,
-	"cluster-api/infrastructure.cluster.x-k8s.io_awsmachines.yaml":            clusterApiInfrastructureClusterXK8sIo_awsmachinesYaml,
-	"cluster-api/infrastructure.cluster.x-k8s.io_awsmachinetemplates.yaml":    clusterApiInfrastructureClusterXK8sIo_awsmachinetemplatesYaml,
-	"cluster-api/infrastructure.cluster.x-k8s.io_awsmanagedclusters.yaml":     clusterApiInfrastructureClusterXK8sIo_awsmanagedclustersYaml,
-	"cluster-api/infrastructure.cluster.x-k8s.io_awsmanagedmachinepools.yaml": clusterApiInfrastructureClusterXK8sIo_awsmanagedmachinepoolsYaml,
-	"hypershift-operator/hypershift.openshift.io_externalinfraclusters.yaml":  hypershiftOperatorHypershiftOpenshiftIo_externalinfraclustersYaml,
-	"hypershift-operator/hypershift.openshift.io_hostedclusters.yaml":         hypershiftOperatorHypershiftOpenshiftIo_hostedclustersYaml,
-	"hypershift-operator/hypershift.openshift.io_hostedcontrolplanes.yaml":    hypershiftOperatorHypershiftOpenshiftIo_hostedcontrolplanesYaml,
-	"hypershift-operator/hypershift.openshift.io_nodepools.yaml":              hypershiftOperatorHypershiftOpenshiftIo_nodepoolsYaml,
+	"cluster-api/cluster.x-k8s.io_clusters.yaml":                                clusterApiClusterXK8sIo_clustersYaml,
+	"cluster-api/cluster.x-k8s.io_machinedeployments.yaml":                      clusterApiClusterXK8sIo_machinedeploymentsYaml,
+	"cluster-api/cluster.x-k8s.io_machinehealthchecks.yaml":                     clusterApiClusterXK8sIo_machinehealthchecksYaml,
+	"cluster-api/cluster.x-k8s.io_machines.yaml":                                clusterApiClusterXK8sIo_machinesYaml,
+	"cluster-api/cluster.x-k8s.io_machinesets.yaml":                             clusterApiClusterXK8sIo_machinesetsYaml,
+	"cluster-api/infrastructure.cluster.x-k8s.io_awsclusters.yaml":              clusterApiInfrastructureClusterXK8sIo_awsclustersYaml,
+	"cluster-api/infrastructure.cluster.x-k8s.io_awsmachinepools.yaml":          clusterApiInfrastructureClusterXK8sIo_awsmachinepoolsYaml,
+	"cluster-api/infrastructure.cluster.x-k8s.io_awsmachines.yaml":              clusterApiInfrastructureClusterXK8sIo_awsmachinesYaml,
+	"cluster-api/infrastructure.cluster.x-k8s.io_awsmachinetemplates.yaml":      clusterApiInfrastructureClusterXK8sIo_awsmachinetemplatesYaml,
+	"cluster-api/infrastructure.cluster.x-k8s.io_awsmanagedclusters.yaml":       clusterApiInfrastructureClusterXK8sIo_awsmanagedclustersYaml,
+	"cluster-api/infrastructure.cluster.x-k8s.io_awsmanagedmachinepools.yaml":   clusterApiInfrastructureClusterXK8sIo_awsmanagedmachinepoolsYaml,
+	"hypershift-operator/hypershift.openshift.io_externalinfraclusters.yaml":    hypershiftOperatorHypershiftOpenshiftIo_externalinfraclustersYaml,
+	"hypershift-operator/hypershift.openshift.io_hostedclusterkubeconfigs.yaml": hypershiftOperatorHypershiftOpenshiftIo_hostedclusterkubeconfigsYaml,
+	"hypershift-operator/hypershift.openshift.io_hostedclusters.yaml":           hypershiftOperatorHypershiftOpenshiftIo_hostedclustersYaml,
+	"hypershift-operator/hypershift.openshift.io_hostedcontrolplanes.yaml":      hypershiftOperatorHypershiftOpenshiftIo_hostedcontrolplanesYaml,
+	"hypershift-operator/hypershift.openshift.io_nodepools.yaml":                hypershiftOperatorHypershiftOpenshiftIo_nodepoolsYaml,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -498,11 +520,13 @@ const AssetDebug = false
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"},
 // AssetDir("data/img") would return []string{"a.png", "b.png"},
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error, and
@@ -549,10 +573,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"infrastructure.cluster.x-k8s.io_awsmanagedmachinepools.yaml": {clusterApiInfrastructureClusterXK8sIo_awsmanagedmachinepoolsYaml, map[string]*bintree{}},
 	}},
 	"hypershift-operator": {nil, map[string]*bintree{
-		"hypershift.openshift.io_externalinfraclusters.yaml": {hypershiftOperatorHypershiftOpenshiftIo_externalinfraclustersYaml, map[string]*bintree{}},
-		"hypershift.openshift.io_hostedclusters.yaml":        {hypershiftOperatorHypershiftOpenshiftIo_hostedclustersYaml, map[string]*bintree{}},
-		"hypershift.openshift.io_hostedcontrolplanes.yaml":   {hypershiftOperatorHypershiftOpenshiftIo_hostedcontrolplanesYaml, map[string]*bintree{}},
-		"hypershift.openshift.io_nodepools.yaml":             {hypershiftOperatorHypershiftOpenshiftIo_nodepoolsYaml, map[string]*bintree{}},
+		"hypershift.openshift.io_externalinfraclusters.yaml":    {hypershiftOperatorHypershiftOpenshiftIo_externalinfraclustersYaml, map[string]*bintree{}},
+		"hypershift.openshift.io_hostedclusterkubeconfigs.yaml": {hypershiftOperatorHypershiftOpenshiftIo_hostedclusterkubeconfigsYaml, map[string]*bintree{}},
+		"hypershift.openshift.io_hostedclusters.yaml":           {hypershiftOperatorHypershiftOpenshiftIo_hostedclustersYaml, map[string]*bintree{}},
+		"hypershift.openshift.io_hostedcontrolplanes.yaml":      {hypershiftOperatorHypershiftOpenshiftIo_hostedcontrolplanesYaml, map[string]*bintree{}},
+		"hypershift.openshift.io_nodepools.yaml":                {hypershiftOperatorHypershiftOpenshiftIo_nodepoolsYaml, map[string]*bintree{}},
 	}},
 }}
 
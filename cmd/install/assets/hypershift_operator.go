@@ -1,11 +1,21 @@
 package assets
 
 import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// The service CA operator issues the serving certificate of the operator
+	// webhooks into this secret
+	operatorServingCertSecretName = "operator-serving-cert"
+	operatorServingCertDir        = "/var/run/secrets/serving-cert"
+	operatorWebhookPort           = 9443
 )
 
 type HyperShiftNamespace struct {
@@ -73,7 +83,30 @@ func (o HyperShiftOperatorDeployment) Build() *appsv1.Deployment {
 								},
 							},
 							Command: []string{"/usr/bin/hypershift-operator"},
-							Args:    []string{"run", "--namespace", "$(MY_NAMESPACE)", "--deployment-name", "operator"},
+							Args:    []string{"run", "--namespace", "$(MY_NAMESPACE)", "--deployment-name", "operator", "--cert-dir", operatorServingCertDir},
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          "webhook",
+									ContainerPort: operatorWebhookPort,
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "serving-cert",
+									MountPath: operatorServingCertDir,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "serving-cert",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: operatorServingCertSecretName,
+								},
+							},
 						},
 					},
 				},
@@ -83,6 +116,96 @@ func (o HyperShiftOperatorDeployment) Build() *appsv1.Deployment {
 	return deployment
 }
 
+type HyperShiftOperatorService struct {
+	Namespace *corev1.Namespace
+}
+
+func (o HyperShiftOperatorService) Build() *corev1.Service {
+	service := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.Namespace.Name,
+			Name:      "operator",
+			Annotations: map[string]string{
+				"service.beta.openshift.io/serving-cert-secret-name": operatorServingCertSecretName,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"name": "operator",
+			},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "webhook",
+					Port:       443,
+					TargetPort: intstr.FromInt(operatorWebhookPort),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
+	return service
+}
+
+type HyperShiftValidatingWebhookConfiguration struct {
+	Service *corev1.Service
+	// HostedClusterPath is the path the operator validates HostedClusters at
+	HostedClusterPath string
+}
+
+func (o HyperShiftValidatingWebhookConfiguration) Build() *admissionregistrationv1.ValidatingWebhookConfiguration {
+	failurePolicy := admissionregistrationv1.Fail
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	timeoutSeconds := int32(10)
+	port := o.Service.Spec.Ports[0].Port
+	configuration := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ValidatingWebhookConfiguration",
+			APIVersion: admissionregistrationv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "hypershift.openshift.io",
+			Annotations: map[string]string{
+				"service.beta.openshift.io/inject-cabundle": "true",
+			},
+		},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			{
+				Name: "hostedclusters.hypershift.openshift.io",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: o.Service.Namespace,
+						Name:      o.Service.Name,
+						Path:      &o.HostedClusterPath,
+						Port:      &port,
+					},
+				},
+				Rules: []admissionregistrationv1.RuleWithOperations{
+					{
+						Operations: []admissionregistrationv1.OperationType{
+							admissionregistrationv1.Create,
+							admissionregistrationv1.Update,
+						},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{"hypershift.openshift.io"},
+							APIVersions: []string{"v1alpha1"},
+							Resources:   []string{"hostedclusters"},
+						},
+					},
+				},
+				FailurePolicy:           &failurePolicy,
+				SideEffects:             &sideEffects,
+				TimeoutSeconds:          &timeoutSeconds,
+				AdmissionReviewVersions: []string{"v1beta1"},
+			},
+		},
+	}
+	return configuration
+}
+
 type HyperShiftOperatorServiceAccount struct {
 	Namespace *corev1.Namespace
 }
@@ -260,3 +383,9 @@ func (o HyperShiftExternalInfraClustersCustomResourceDefinition) Build() *apiext
 	crd.Labels["cluster.x-k8s.io/v1alpha4"] = "v1alpha1"
 	return crd
 }
+
+type HyperShiftHostedClusterKubeconfigsCustomResourceDefinition struct{}
+
+func (o HyperShiftHostedClusterKubeconfigsCustomResourceDefinition) Build() *apiextensionsv1.CustomResourceDefinition {
+	return mustCustomResourceDefinition(mustAssetReader("hypershift-operator/hypershift.openshift.io_hostedclusterkubeconfigs.yaml"))
+}
@@ -27,6 +27,7 @@ import (
 
 	hyperapi "openshift.io/hypershift/api"
 	"openshift.io/hypershift/cmd/install/assets"
+	"openshift.io/hypershift/support/webhooks"
 	"openshift.io/hypershift/version"
 
 	cr "sigs.k8s.io/controller-runtime"
@@ -116,6 +117,7 @@ func hyperShiftOperatorManifests(opts Options) []crclient.Object {
 	nodePoolsCRD := assets.HyperShiftNodePoolsCustomResourceDefinition{}.Build()
 	hostedControlPlanesCRD := assets.HyperShiftHostedControlPlaneCustomResourceDefinition{}.Build()
 	externalInfraClustersCRD := assets.HyperShiftExternalInfraClustersCustomResourceDefinition{}.Build()
+	hostedClusterKubeconfigsCRD := assets.HyperShiftHostedClusterKubeconfigsCustomResourceDefinition{}.Build()
 	operatorNamespace := assets.HyperShiftNamespace{
 		Name: opts.Namespace,
 	}.Build()
@@ -133,18 +135,31 @@ func hyperShiftOperatorManifests(opts Options) []crclient.Object {
 		ServiceAccount: operatorServiceAccount,
 		Replicas:       opts.HyperShiftOperatorReplicas,
 	}.Build()
+	operatorService := assets.HyperShiftOperatorService{
+		Namespace: operatorNamespace,
+	}.Build()
 
-	return []crclient.Object{
+	objects := []crclient.Object{
 		hostedClustersCRD,
 		nodePoolsCRD,
 		hostedControlPlanesCRD,
 		externalInfraClustersCRD,
+		hostedClusterKubeconfigsCRD,
 		operatorNamespace,
 		operatorServiceAccount,
 		operatorClusterRole,
 		operatorClusterRoleBinding,
 		operatorDeployment,
+		operatorService,
+	}
+	// An operator running locally can't serve the webhooks of the cluster
+	if !opts.Development {
+		objects = append(objects, assets.HyperShiftValidatingWebhookConfiguration{
+			Service:           operatorService,
+			HostedClusterPath: webhooks.HostedClusterValidatingPath,
+		}.Build())
 	}
+	return objects
 }
 
 func clusterAPIManifests() []crclient.Object {
@@ -0,0 +1,110 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+)
+
+type Options struct {
+	ReleaseImageReferencesFile string
+	HostedControlPlaneFile     string
+	PullSecretFile             string
+	SSHKeyFile                 string
+	BaseDomain                 string
+	Platform                   string
+	OutputDir                  string
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "controlplane",
+		Short: "Renders the manifests the control plane operator deploys for a HostedControlPlane",
+	}
+
+	opts := Options{
+		BaseDomain: "example.com",
+		Platform:   string(configv1.AWSPlatformType),
+		OutputDir:  "manifests",
+	}
+
+	cmd.Flags().StringVar(&opts.ReleaseImageReferencesFile, "release-image-references", opts.ReleaseImageReferencesFile, "Path to the /release-manifests/image-references file of the release image (required)")
+	cmd.Flags().StringVar(&opts.HostedControlPlaneFile, "hostedcontrolplane", opts.HostedControlPlaneFile, "Path to a HostedControlPlane manifest (required)")
+	cmd.Flags().StringVar(&opts.PullSecretFile, "pull-secret", opts.PullSecretFile, "Path to a pull secret, an empty one is used by default")
+	cmd.Flags().StringVar(&opts.SSHKeyFile, "ssh-key", opts.SSHKeyFile, "Path to an SSH public key, none is used by default")
+	cmd.Flags().StringVar(&opts.BaseDomain, "base-domain", opts.BaseDomain, "The base domain of the management cluster, used when the HostedControlPlane has none")
+	cmd.Flags().StringVar(&opts.Platform, "platform", opts.Platform, "The platform of the management cluster")
+	cmd.Flags().StringVar(&opts.OutputDir, "output-dir", opts.OutputDir, "The directory to write the manifests to")
+
+	cmd.MarkFlagRequired("release-image-references")
+	cmd.MarkFlagRequired("hostedcontrolplane")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return run(context.Background(), opts)
+	}
+
+	return cmd
+}
+
+func run(ctx context.Context, opts Options) error {
+	data, err := ioutil.ReadFile(opts.HostedControlPlaneFile)
+	if err != nil {
+		return err
+	}
+	hcp := &hyperv1.HostedControlPlane{}
+	if err := yaml.UnmarshalStrict(data, hcp); err != nil {
+		return fmt.Errorf("failed to read %s as a HostedControlPlane: %w", opts.HostedControlPlaneFile, err)
+	}
+	renderOpts := hostedcontrolplane.RenderOptions{
+		PullSecret: []byte("{}"),
+		BaseDomain: opts.BaseDomain,
+		Platform:   configv1.PlatformType(opts.Platform),
+	}
+	if len(opts.PullSecretFile) > 0 {
+		if renderOpts.PullSecret, err = ioutil.ReadFile(opts.PullSecretFile); err != nil {
+			return err
+		}
+	}
+	if len(opts.SSHKeyFile) > 0 {
+		if renderOpts.SSHKey, err = ioutil.ReadFile(opts.SSHKeyFile); err != nil {
+			return err
+		}
+	}
+
+	releaseProvider := &releaseinfo.FileProvider{Path: opts.ReleaseImageReferencesFile}
+	manifests, err := hostedcontrolplane.RenderControlPlane(ctx, hcp, releaseProvider, renderOpts)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(opts.OutputDir, 0755); err != nil {
+		return err
+	}
+	for _, manifest := range manifests {
+		// Only the user running the render may read secrets. Files of an
+		// earlier render are removed, since writing keeps their mode.
+		mode := os.FileMode(0644)
+		if manifest.Sensitive {
+			mode = 0600
+		}
+		path := filepath.Join(opts.OutputDir, manifest.Name)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err := ioutil.WriteFile(path, manifest.Content, mode); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("wrote %d manifests to %s\n", len(manifests), opts.OutputDir)
+	return nil
+}
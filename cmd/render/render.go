@@ -0,0 +1,18 @@
+package render
+
+import (
+	"github.com/spf13/cobra"
+
+	"openshift.io/hypershift/cmd/render/controlplane"
+)
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Commands for rendering HyperShift manifests offline",
+	}
+
+	cmd.AddCommand(controlplane.NewCommand())
+
+	return cmd
+}
@@ -0,0 +1,201 @@
+package hostedcontrolplane
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+// controlPlaneOperatorFieldManager is the field manager of every object the
+// control plane operator applies.
+const controlPlaneOperatorFieldManager = "control-plane-operator"
+
+// reapplyInterval is how often the objects of a control plane that is ready
+// are applied again, which repairs objects that drifted from their rendered
+// state since applied objects are not watched.
+const reapplyInterval = 10 * time.Minute
+
+// maxDriftedObjectsInMessage limits the objects listed in the message of the
+// Drifted condition.
+const maxDriftedObjectsInMessage = 5
+
+// applyObject applies an object with server side apply and returns a record
+// of it. The object is first applied without taking over fields from other
+// managers: a conflict means another manager changed fields the control plane
+// operator applies, which are then taken back and the object is recorded as
+// drifted.
+func applyObject(ctx context.Context, c client.Client, obj client.Object) (hyperv1.AppliedObject, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return hyperv1.AppliedObject{}, err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return hyperv1.AppliedObject{}, fmt.Errorf("failed to encode %s %s: %w", gvk.Kind, obj.GetName(), err)
+	}
+	drifted := false
+	err = c.Patch(ctx, obj, client.RawPatch(types.ApplyPatchType, data), client.FieldOwner(controlPlaneOperatorFieldManager))
+	if apierrors.IsConflict(err) {
+		drifted = true
+		err = c.Patch(ctx, obj, client.RawPatch(types.ApplyPatchType, data), client.ForceOwnership, client.FieldOwner(controlPlaneOperatorFieldManager))
+	}
+	if err != nil {
+		return hyperv1.AppliedObject{}, err
+	}
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+	return hyperv1.AppliedObject{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+		UID:        obj.GetUID(),
+		Drifted:    drifted,
+	}, nil
+}
+
+// recordAppliedObjects records the objects applied to a control plane in its
+// status and reports the objects that drifted in the Drifted condition. An
+// object whose UID changed since it was last applied was removed, which is
+// drift as well. Records of objects that were not applied again are kept, such
+// as those of the secrets generated at install.
+func recordAppliedObjects(hcp *hyperv1.HostedControlPlane, applied []hyperv1.AppliedObject) {
+	records := map[string]hyperv1.AppliedObject{}
+	for _, o := range hcp.Status.AppliedObjects {
+		o.Drifted = false
+		records[appliedObjectKey(o)] = o
+	}
+	var drifted []string
+	for _, o := range applied {
+		key := appliedObjectKey(o)
+		if previous, ok := records[key]; ok && len(previous.UID) > 0 && previous.UID != o.UID {
+			o.Drifted = true
+		}
+		if o.Drifted {
+			drifted = append(drifted, o.Kind+"/"+o.Name)
+		}
+		records[key] = o
+	}
+	all := make([]hyperv1.AppliedObject, 0, len(records))
+	for _, o := range records {
+		all = append(all, o)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return appliedObjectKey(all[i]) < appliedObjectKey(all[j])
+	})
+	hcp.Status.AppliedObjects = all
+	sort.Strings(drifted)
+	setDriftedCondition(hcp, drifted)
+}
+
+func appliedObjectKey(o hyperv1.AppliedObject) string {
+	return strings.Join([]string{o.Namespace, o.APIVersion, o.Kind, o.Name}, "/")
+}
+
+// forgetAppliedObject removes the record of an applied object that the
+// control plane operator deleted.
+func (r *HostedControlPlaneReconciler) forgetAppliedObject(hcp *hyperv1.HostedControlPlane, obj client.Object) error {
+	gvk, err := apiutil.GVKForObject(obj, r.Scheme())
+	if err != nil {
+		return err
+	}
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+	var applied []hyperv1.AppliedObject
+	for _, o := range hcp.Status.AppliedObjects {
+		if o.APIVersion == apiVersion && o.Kind == kind && o.Namespace == obj.GetNamespace() && o.Name == obj.GetName() {
+			continue
+		}
+		applied = append(applied, o)
+	}
+	hcp.Status.AppliedObjects = applied
+	return nil
+}
+
+func setDriftedCondition(hcp *hyperv1.HostedControlPlane, drifted []string) {
+	if len(drifted) == 0 {
+		setConditionByType(&hcp.Status.Conditions, hyperv1.Drifted, hyperv1.ConditionFalse, "AsExpected", "Applied objects match their rendered state")
+		return
+	}
+	listed := drifted
+	if len(listed) > maxDriftedObjectsInMessage {
+		listed = listed[:maxDriftedObjectsInMessage]
+	}
+	message := fmt.Sprintf("%d objects drifted from their rendered state and were applied again: %s", len(drifted), strings.Join(listed, ", "))
+	if len(drifted) > len(listed) {
+		message += ", ..."
+	}
+	setConditionByType(&hcp.Status.Conditions, hyperv1.Drifted, hyperv1.ConditionTrue, "ObjectsDrifted", message)
+}
+
+// manifestObjects decodes rendered manifests into objects of a control plane
+// namespace.
+func manifestObjects(namespace string, manifests map[string][]byte) ([]client.Object, error) {
+	var objects []client.Object
+	decodeErrors := []error{}
+	for manifestName, manifestBytes := range manifests {
+		obj := &unstructured.Unstructured{}
+		if err := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifestBytes), 100).Decode(obj); err != nil {
+			decodeErrors = append(decodeErrors, fmt.Errorf("failed to decode manifest %s: %w", manifestName, err))
+			continue
+		}
+		obj.SetNamespace(namespace)
+		objects = append(objects, obj)
+	}
+	if errs := errors.NewAggregate(decodeErrors); errs != nil {
+		return nil, fmt.Errorf("failed to decode some manifests: %w", errs)
+	}
+	return objects, nil
+}
+
+// applyObjects applies the objects of a control plane with server side apply
+// and returns a record of each applied object.
+func applyObjects(ctx context.Context, c client.Client, log logr.Logger, objects []client.Object) ([]hyperv1.AppliedObject, error) {
+	var applied []hyperv1.AppliedObject
+	applyErrors := []error{}
+	for _, obj := range objects {
+		appliedObject, err := applyObject(ctx, c, obj)
+		if err != nil {
+			applyErrors = append(applyErrors, fmt.Errorf("failed to apply %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err))
+		} else {
+			applied = append(applied, appliedObject)
+			log.Info("applied object", "kind", appliedObject.Kind, "name", appliedObject.Name)
+		}
+	}
+	if errs := errors.NewAggregate(applyErrors); errs != nil {
+		return applied, fmt.Errorf("failed to apply some objects: %w", errs)
+	}
+	return applied, nil
+}
+
+func deleteObjects(ctx context.Context, c client.Client, log logr.Logger, objects []client.Object) error {
+	deleteErrors := []error{}
+	for _, obj := range objects {
+		kind := obj.GetObjectKind().GroupVersionKind().Kind
+		err := c.Delete(ctx, obj)
+		if err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			deleteErrors = append(deleteErrors, fmt.Errorf("failed to delete %s %s: %w", kind, obj.GetName(), err))
+		} else {
+			log.Info("deleted object", "kind", kind, "name", obj.GetName())
+		}
+	}
+	if errs := errors.NewAggregate(deleteErrors); errs != nil {
+		return fmt.Errorf("failed to delete some objects: %w", errs)
+	}
+	return nil
+}
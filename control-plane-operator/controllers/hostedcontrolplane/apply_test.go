@@ -0,0 +1,126 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+// conflictingClient fails apply patches that do not force the ownership of
+// their fields, as the API server does when another manager owns them.
+type conflictingClient struct {
+	*applyPatchClient
+}
+
+func (c *conflictingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	force := (&client.PatchOptions{}).ApplyOptions(opts).Force
+	if patch.Type() == types.ApplyPatchType && (force == nil || !*force) {
+		return apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, obj.GetName(), fmt.Errorf("conflict with \"kubectl-edit\""))
+	}
+	return c.applyPatchClient.Patch(ctx, obj, patch, opts...)
+}
+
+func TestApplyObject(t *testing.T) {
+	newConfigMap := func() *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "a"},
+			Data:       map[string]string{"key": "value"},
+		}
+	}
+	tests := []struct {
+		name            string
+		client          client.Client
+		expectedDrifted bool
+	}{
+		{
+			name:   "no conflict",
+			client: newApplyPatchClient(),
+		},
+		{
+			name:            "conflict with another manager",
+			client:          &conflictingClient{applyPatchClient: newApplyPatchClient()},
+			expectedDrifted: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			applied, err := applyObject(context.Background(), test.client, newConfigMap())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assert.Equal(t, test.expectedDrifted, applied.Drifted)
+			assert.Equal(t, "ConfigMap", applied.Kind)
+
+			configMap := &corev1.ConfigMap{}
+			if err := test.client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "a"}, configMap); err != nil {
+				t.Fatalf("failed to get config map: %v", err)
+			}
+			assert.Equal(t, "value", configMap.Data["key"])
+		})
+	}
+}
+
+func TestSetDriftedCondition(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{}
+	setDriftedCondition(hcp, nil)
+	condition := getConditionByType(hcp.Status.Conditions, hyperv1.Drifted)
+	if condition == nil {
+		t.Fatalf("expected a Drifted condition")
+	}
+	assert.Equal(t, hyperv1.ConditionFalse, condition.Status)
+
+	setDriftedCondition(hcp, []string{"Deployment/a", "Deployment/b", "Secret/c", "Secret/d", "ConfigMap/e", "ConfigMap/f"})
+	condition = getConditionByType(hcp.Status.Conditions, hyperv1.Drifted)
+	assert.Equal(t, hyperv1.ConditionTrue, condition.Status)
+	assert.Equal(t, "6 objects drifted from their rendered state and were applied again: Deployment/a, Deployment/b, Secret/c, Secret/d, ConfigMap/e, ...", condition.Message)
+}
+
+func TestRecordAppliedObjects(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{}
+	recordAppliedObjects(hcp, []hyperv1.AppliedObject{
+		{APIVersion: "v1", Kind: "Secret", Namespace: "ns", Name: "b", UID: "1", Drifted: true},
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: "ns", Name: "a", UID: "2"},
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: "ns", Name: "c", UID: "3"},
+	})
+	assert.Equal(t, hyperv1.ConditionTrue, getConditionByType(hcp.Status.Conditions, hyperv1.Drifted).Status)
+
+	recordAppliedObjects(hcp, []hyperv1.AppliedObject{
+		{APIVersion: "v1", Kind: "Secret", Namespace: "ns", Name: "b", UID: "1"},
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: "ns", Name: "a", UID: "4"},
+	})
+	assert.Equal(t, []hyperv1.AppliedObject{
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: "ns", Name: "a", UID: "4", Drifted: true},
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: "ns", Name: "c", UID: "3"},
+		{APIVersion: "v1", Kind: "Secret", Namespace: "ns", Name: "b", UID: "1"},
+	}, hcp.Status.AppliedObjects)
+	condition := getConditionByType(hcp.Status.Conditions, hyperv1.Drifted)
+	assert.Equal(t, hyperv1.ConditionTrue, condition.Status)
+	assert.Equal(t, "1 objects drifted from their rendered state and were applied again: ConfigMap/a", condition.Message)
+}
+
+func TestManifestObjects(t *testing.T) {
+	objects, err := manifestObjects("example", map[string][]byte{
+		"kube-controller-manager-secret.yaml": []byte("apiVersion: v1\nkind: Secret\nmetadata:\n  name: kube-controller-manager\n"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(objects) != 1 {
+		t.Fatalf("expected 1 object, got %d", len(objects))
+	}
+	assert.Equal(t, "Secret", objects[0].GetObjectKind().GroupVersionKind().Kind)
+	assert.Equal(t, "example", objects[0].GetNamespace())
+
+	_, err = manifestObjects("example", map[string][]byte{"invalid.yaml": []byte("kind: [")})
+	assert.Error(t, err)
+}
@@ -1,4 +1,9 @@
 #!/usr/bin/env bash
 set -x
+{{- if isIPv6 .ExternalAPIAddress }}
+ip addr add {{ .ExternalAPIAddress }}/128 scope host dev lo
+ip route add {{ .ExternalAPIAddress }}/128 dev lo scope link src {{ .ExternalAPIAddress }}
+{{- else }}
 ip addr add {{ .ExternalAPIAddress }}/32 brd {{ .ExternalAPIAddress }} scope host dev lo
 ip route add {{ .ExternalAPIAddress }}/32 dev lo scope link src {{ .ExternalAPIAddress }}
+{{- end }}
@@ -1,4 +1,9 @@
 #!/usr/bin/env bash
 set -x
+{{- if isIPv6 .ExternalAPIAddress }}
+ip addr delete {{ .ExternalAPIAddress }}/128 dev lo
+ip route del {{ .ExternalAPIAddress }}/128 dev lo scope link src {{ .ExternalAPIAddress }}
+{{- else }}
 ip addr delete {{ .ExternalAPIAddress }}/32 dev lo
 ip route del {{ .ExternalAPIAddress }}/32 dev lo scope link src {{ .ExternalAPIAddress }}
+{{- end }}
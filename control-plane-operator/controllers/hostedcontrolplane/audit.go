@@ -0,0 +1,141 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+const (
+	auditPolicyConfigMapKey   = "policy.yaml"
+	auditWebhookKubeconfigKey = "kubeconfig"
+	auditWebhookSecretName    = "audit-webhook-kubeconfig"
+)
+
+// auditConfig is the audit configuration of a HostedControlPlane with the
+// objects it references resolved.
+type auditConfig struct {
+	spec hyperv1.AuditSpec
+	// policy is the custom audit policy
+	policy string
+	// webhookSecret is a copy of the webhook kubeconfig Secret in the control
+	// plane namespace
+	webhookSecret *corev1.Secret
+}
+
+// auditConfig reads the custom policy and webhook kubeconfig referenced by the
+// audit configuration of a HostedControlPlane from the namespace of the
+// HostedCluster.
+func (r *HostedControlPlaneReconciler) auditConfig(ctx context.Context, hcp *hyperv1.HostedControlPlane) (*auditConfig, error) {
+	config := &auditConfig{spec: hcp.Spec.Audit}
+	if config.spec.Profile != hyperv1.CustomAuditProfileType && config.spec.Webhook == nil {
+		return config, nil
+	}
+	sourceNamespace, err := hostedClusterNamespace(hcp)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.spec.Profile == hyperv1.CustomAuditProfileType {
+		if config.spec.CustomPolicy == nil || len(config.spec.CustomPolicy.Name) == 0 {
+			return nil, fmt.Errorf("the Custom audit profile requires a custom policy")
+		}
+		source := &corev1.ConfigMap{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: sourceNamespace, Name: config.spec.CustomPolicy.Name}, source); err != nil {
+			return nil, fmt.Errorf("failed to get audit policy configmap %s: %w", config.spec.CustomPolicy.Name, err)
+		}
+		policy, ok := source.Data[auditPolicyConfigMapKey]
+		if !ok {
+			return nil, fmt.Errorf("audit policy configmap %s is missing the %s key", source.Name, auditPolicyConfigMapKey)
+		}
+		if err := validateAuditPolicy(policy); err != nil {
+			return nil, fmt.Errorf("invalid audit policy in configmap %s: %w", source.Name, err)
+		}
+		config.policy = policy
+	}
+
+	if config.spec.Webhook != nil {
+		name := config.spec.Webhook.KubeconfigSecret.Name
+		source := &corev1.Secret{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: sourceNamespace, Name: name}, source); err != nil {
+			return nil, fmt.Errorf("failed to get audit webhook secret %s: %w", name, err)
+		}
+		kubeconfig, ok := source.Data[auditWebhookKubeconfigKey]
+		if !ok {
+			return nil, fmt.Errorf("audit webhook secret %s is missing the %s key", name, auditWebhookKubeconfigKey)
+		}
+		secret := &corev1.Secret{}
+		secret.APIVersion = "v1"
+		secret.Kind = "Secret"
+		secret.Namespace = hcp.GetName()
+		secret.Name = auditWebhookSecretName
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{auditWebhookKubeconfigKey: kubeconfig}
+		secret.OwnerReferences = ensureHCPOwnerRef(hcp, secret.OwnerReferences)
+		config.webhookSecret = secret
+	}
+	return config, nil
+}
+
+// setParams sets the audit parameters for rendering the API server configs.
+func (c *auditConfig) setParams(params *render.ClusterParams) {
+	if len(c.spec.Profile) > 0 {
+		params.AuditProfile = string(c.spec.Profile)
+	}
+	params.AuditPolicy = c.policy
+	if c.spec.Webhook != nil {
+		params.APIServerAuditEnabled = true
+		if len(c.spec.Webhook.Mode) > 0 {
+			params.AuditWebhookMode = c.spec.Webhook.Mode
+		}
+	}
+	if c.spec.LogRetention.MaxSize > 0 {
+		params.AuditLogMaxSize = c.spec.LogRetention.MaxSize
+	}
+	if c.spec.LogRetention.MaxBackups > 0 {
+		params.AuditLogMaxBackups = c.spec.LogRetention.MaxBackups
+	}
+	params.AuditLogMaxAge = c.spec.LogRetention.MaxAge
+}
+
+// manifests returns the copied webhook kubeconfig as a manifest to apply to
+// the control plane namespace.
+func (c *auditConfig) manifests() (map[string][]byte, error) {
+	manifests := map[string][]byte{}
+	if c.webhookSecret == nil {
+		return manifests, nil
+	}
+	out, err := json.Marshal(c.webhookSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize %s: %w", c.webhookSecret.Name, err)
+	}
+	manifests[fmt.Sprintf("%s.json", c.webhookSecret.Name)] = out
+	return manifests, nil
+}
+
+// validateAuditPolicy makes sure a custom policy is an audit policy, so that
+// a mistake doesn't keep the API servers from starting.
+func validateAuditPolicy(policy string) error {
+	var typeMeta struct {
+		APIVersion string        `json:"apiVersion"`
+		Kind       string        `json:"kind"`
+		Rules      []interface{} `json:"rules"`
+	}
+	if err := yaml.Unmarshal([]byte(policy), &typeMeta); err != nil {
+		return err
+	}
+	if typeMeta.Kind != "Policy" || (typeMeta.APIVersion != "audit.k8s.io/v1" && typeMeta.APIVersion != "audit.k8s.io/v1beta1") {
+		return fmt.Errorf("expected an audit.k8s.io Policy, got %s %s", typeMeta.APIVersion, typeMeta.Kind)
+	}
+	if len(typeMeta.Rules) == 0 {
+		return fmt.Errorf("the policy has no rules")
+	}
+	return nil
+}
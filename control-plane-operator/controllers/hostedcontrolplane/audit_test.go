@@ -0,0 +1,78 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestAuditConfigSetParams(t *testing.T) {
+	params := render.NewClusterParams()
+	(&auditConfig{}).setParams(params)
+	assert.Equal(t, "Default", params.AuditProfile)
+	assert.False(t, params.APIServerAuditEnabled)
+	assert.Equal(t, "batch", params.AuditWebhookMode)
+	assert.Equal(t, int32(100), params.AuditLogMaxSize)
+	assert.Equal(t, int32(10), params.AuditLogMaxBackups)
+	assert.Equal(t, int32(0), params.AuditLogMaxAge)
+
+	params = render.NewClusterParams()
+	(&auditConfig{
+		spec: hyperv1.AuditSpec{
+			Profile: hyperv1.CustomAuditProfileType,
+			Webhook: &hyperv1.AuditWebhookSpec{Mode: "blocking"},
+			LogRetention: hyperv1.AuditLogRetentionSpec{
+				MaxSize:    50,
+				MaxBackups: 3,
+				MaxAge:     7,
+			},
+		},
+		policy: "policy",
+	}).setParams(params)
+	assert.Equal(t, "Custom", params.AuditProfile)
+	assert.Equal(t, "policy", params.AuditPolicy)
+	assert.True(t, params.APIServerAuditEnabled)
+	assert.Equal(t, "blocking", params.AuditWebhookMode)
+	assert.Equal(t, int32(50), params.AuditLogMaxSize)
+	assert.Equal(t, int32(3), params.AuditLogMaxBackups)
+	assert.Equal(t, int32(7), params.AuditLogMaxAge)
+}
+
+func TestValidateAuditPolicy(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy string
+		valid  bool
+	}{
+		{
+			name:   "audit policy",
+			policy: "apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n- level: Metadata\n",
+			valid:  true,
+		},
+		{
+			name:   "not yaml",
+			policy: "rules: [",
+		},
+		{
+			name:   "wrong kind",
+			policy: "apiVersion: v1\nkind: ConfigMap\n",
+		},
+		{
+			name:   "no rules",
+			policy: "apiVersion: audit.k8s.io/v1\nkind: Policy\n",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateAuditPolicy(tc.policy)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
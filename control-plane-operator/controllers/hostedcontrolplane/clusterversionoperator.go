@@ -0,0 +1,25 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/clusterversionoperator"
+)
+
+const clusterVersionOperatorDeploymentName = "cluster-version-operator"
+
+// clusterVersionOperatorObjects returns the objects of the cluster version
+// operator of a control plane.
+func clusterVersionOperatorObjects(cc *componentContext) ([]client.Object, error) {
+	return []client.Object{
+		clusterversionoperator.Deployment{
+			Namespace:          cc.hcp.GetName(),
+			ReleaseImage:       cc.params.ReleaseImage,
+			Image:              cc.images[releaseImageComponent],
+			MetricsPusherImage: cc.params.ROKSMetricsImage,
+			ClusterID:          cc.params.ClusterID,
+			Resources:          deploymentResources(cc.hcp.Spec.ControlPlaneResources, clusterVersionOperatorDeploymentName),
+			Scheduling:         cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestClusterVersionOperatorObjects(t *testing.T) {
+	tests := []struct {
+		name               string
+		metricsPusherImage string
+		containers         []string
+	}{
+		{
+			name:       "without metrics pusher",
+			containers: []string{"cluster-version-operator"},
+		},
+		{
+			name:               "with metrics pusher",
+			metricsPusherImage: "example.com/roks-metrics:latest",
+			containers:         []string{"cluster-version-operator", "metrics-pusher"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := render.NewClusterParams()
+			params.ReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.7.0"
+			params.ROKSMetricsImage = test.metricsPusherImage
+
+			objects, err := clusterVersionOperatorObjects(&componentContext{
+				hcp: &hyperv1.HostedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "example"},
+				},
+				params: params,
+				images: map[string]string{releaseImageComponent: "mirror.example.com/ocp-release:4.7.0"},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			deployment := componentObject(t, objects, "Deployment", "cluster-version-operator").(*appsv1.Deployment)
+			assert.Equal(t, "example", deployment.Namespace)
+
+			var containers []string
+			for _, container := range deployment.Spec.Template.Spec.Containers {
+				containers = append(containers, container.Name)
+			}
+			assert.Equal(t, test.containers, containers)
+			container := deployment.Spec.Template.Spec.Containers[0]
+			assert.Equal(t, "mirror.example.com/ocp-release:4.7.0", container.Image, "the operator should run from the mirrored release")
+			assert.Contains(t, container.Args, "--release-image=quay.io/openshift-release-dev/ocp-release:4.7.0")
+		})
+	}
+}
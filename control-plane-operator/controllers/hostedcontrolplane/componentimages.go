@@ -0,0 +1,51 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+)
+
+// lookupReleaseImage looks up the release image of a control plane, adds the
+// static component images it doesn't ship and applies the component image
+// overrides of the control plane.
+func (r *HostedControlPlaneReconciler) lookupReleaseImage(ctx context.Context, hcp *hyperv1.HostedControlPlane) (*releaseinfo.ReleaseImage, error) {
+	releaseImage, err := r.ReleaseProvider.Lookup(ctx, releaseImagePullSpec(hcp))
+	if err != nil {
+		return nil, err
+	}
+	releaseImage = releaseinfo.WithStaticComponentImages(releaseImage)
+	return releaseinfo.WithComponentImages(releaseImage, hcp.Spec.ComponentImageOverrides), nil
+}
+
+func validateComponentImageOverrides(overrides map[string]string) error {
+	for component, image := range overrides {
+		if len(component) == 0 {
+			return fmt.Errorf("component image override %q has no component name", image)
+		}
+		if len(strings.TrimSpace(image)) == 0 || strings.ContainsAny(image, " \t\n") {
+			return fmt.Errorf("invalid image %q for component %s", image, component)
+		}
+	}
+	return nil
+}
+
+// setUnsupportedCondition flags control planes running overridden component
+// images as unsupported.
+func setUnsupportedCondition(hcp *hyperv1.HostedControlPlane) {
+	if len(hcp.Spec.ComponentImageOverrides) == 0 {
+		setConditionByType(&hcp.Status.Conditions, hyperv1.Unsupported, hyperv1.ConditionFalse, "AsExpected", "HostedControlPlane runs a supported configuration")
+		return
+	}
+	components := make([]string, 0, len(hcp.Spec.ComponentImageOverrides))
+	for component := range hcp.Spec.ComponentImageOverrides {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+	setConditionByType(&hcp.Status.Conditions, hyperv1.Unsupported, hyperv1.ConditionTrue, "ComponentImagesOverridden",
+		fmt.Sprintf("The images of the following components are overridden: %s", strings.Join(components, ", ")))
+}
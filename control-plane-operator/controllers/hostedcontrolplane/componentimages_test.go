@@ -0,0 +1,37 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+func TestSetUnsupportedCondition(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{}
+	setUnsupportedCondition(hcp)
+	condition := getConditionByType(hcp.Status.Conditions, hyperv1.Unsupported)
+	if condition == nil {
+		t.Fatalf("expected an %s condition", hyperv1.Unsupported)
+	}
+	assert.Equal(t, hyperv1.ConditionFalse, condition.Status)
+
+	hcp.Spec.ComponentImageOverrides = map[string]string{
+		"hyperkube":       "quay.io/dev/hyperkube:fix",
+		"cluster-etcd":    "quay.io/dev/etcd:fix",
+		"oauth-openshift": "quay.io/dev/oauth:fix",
+	}
+	setUnsupportedCondition(hcp)
+	condition = getConditionByType(hcp.Status.Conditions, hyperv1.Unsupported)
+	assert.Equal(t, hyperv1.ConditionTrue, condition.Status)
+	assert.Equal(t, "ComponentImagesOverridden", condition.Reason)
+	assert.Equal(t, "The images of the following components are overridden: cluster-etcd, hyperkube, oauth-openshift", condition.Message)
+	assert.Len(t, hcp.Status.Conditions, 1)
+}
+
+func TestValidateComponentImageOverrides(t *testing.T) {
+	assert.NoError(t, validateComponentImageOverrides(map[string]string{"hyperkube": "quay.io/dev/hyperkube:fix"}))
+	assert.Error(t, validateComponentImageOverrides(map[string]string{"": "quay.io/dev/hyperkube:fix"}))
+	assert.Error(t, validateComponentImageOverrides(map[string]string{"hyperkube": ""}))
+}
@@ -0,0 +1,153 @@
+package hostedcontrolplane
+
+import (
+	"fmt"
+	"io"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+// componentContext holds the inputs the objects of the control plane
+// components are built from.
+type componentContext struct {
+	hcp    *hyperv1.HostedControlPlane
+	params *render.ClusterParams
+	// images are the component images of the release and the static
+	// component images, with the mirrors of the control plane applied, and
+	// the pull spec of the release image as releaseImageComponent
+	images map[string]string
+	// versions are the versions of the release components, such as
+	// "release" and "kubernetes"
+	versions          map[string]string
+	pkiData           map[string][]byte
+	identityProviders *identityProviderConfig
+	audit             *auditConfig
+	// random is read for the secrets the components generate. Components
+	// leave out the objects of generated secrets when it is nil, so that
+	// applying them again keeps the existing secrets.
+	random io.Reader
+}
+
+// releaseImageComponent is the component image of the release image itself,
+// which the cluster version operator runs from
+const releaseImageComponent = "release"
+
+// typedComponent is a control plane component whose objects are built by
+// typed builders.
+type typedComponent struct {
+	name string
+	// images are the release components the component runs
+	images []string
+	// objects returns the objects of the component
+	objects func(*componentContext) ([]client.Object, error)
+}
+
+var typedComponents = []typedComponent{
+	{
+		name:    "etcd",
+		images:  []string{"etcd-operator", "etcd-member"},
+		objects: etcdObjects,
+	},
+	{
+		name:    "hosted-cluster-config-operator",
+		images:  []string{"hosted-cluster-config-operator"},
+		objects: hostedClusterConfigOperatorObjects,
+	},
+	{
+		name:    "oauth-apiserver",
+		images:  []string{"oauth-apiserver"},
+		objects: oauthAPIServerObjects,
+	},
+	{
+		name:    "oauth-openshift",
+		images:  []string{"oauth-server"},
+		objects: oauthOpenshiftObjects,
+	},
+	{
+		name:    "openshift-apiserver",
+		images:  []string{"openshift-apiserver"},
+		objects: openshiftAPIServerObjects,
+	},
+	{
+		name:    "openshift-controller-manager",
+		images:  []string{"openshift-controller-manager", "cluster-policy-controller", "docker-builder", "deployer"},
+		objects: openshiftControllerManagerObjects,
+	},
+	{
+		name:    "service-network-admin-kubeconfig",
+		objects: serviceNetworkAdminKubeconfigObjects,
+	},
+	{
+		name:    "cluster-version-operator",
+		images:  []string{releaseImageComponent},
+		objects: clusterVersionOperatorObjects,
+	},
+	{
+		name:    "kube-apiserver",
+		images:  []string{"hyperkube", "cli", "cluster-config-operator", "openvpn", "konnectivity-server"},
+		objects: kubeAPIServerObjects,
+	},
+	{
+		name:    "konnectivity",
+		images:  []string{"konnectivity-agent"},
+		objects: konnectivityObjects,
+	},
+	{
+		name:    "openvpn",
+		images:  []string{"openvpn"},
+		objects: openVPNObjects,
+	},
+	{
+		name:    "router-proxy",
+		images:  []string{"cli", "haproxy-router", "openvpn"},
+		objects: routerProxyObjects,
+	},
+	{
+		name: "machine-config-server",
+		images: []string{"machine-config-operator", "cli", "etcd", "kube-client-agent", "machine-os-content", "pod",
+			"keepalived-ipfailover", "coredns", "mdns-publisher", "haproxy-router", "baremetal-runtimecfg"},
+		objects: machineConfigServerObjects,
+	},
+	{
+		name:    "kube-controller-manager",
+		images:  []string{"hyperkube"},
+		objects: kubeControllerManagerObjects,
+	},
+	{
+		name:    "kube-scheduler",
+		images:  []string{"hyperkube"},
+		objects: kubeSchedulerObjects,
+	},
+}
+
+// componentObjects returns the objects of the typed components of a control
+// plane that a filter selects, or of all of them if the filter is nil.
+func componentObjects(cc *componentContext, filter func(typedComponent) bool) ([]client.Object, error) {
+	var objects []client.Object
+	for _, component := range typedComponents {
+		if filter != nil && !filter(component) {
+			continue
+		}
+		built, err := component.objects(cc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build %s: %w", component.name, err)
+		}
+		objects = append(objects, built...)
+	}
+	return objects, nil
+}
+
+// serviceNetworkAdminKubeconfigObjects returns the admin kubeconfig secret
+// the components of a control plane share.
+func serviceNetworkAdminKubeconfigObjects(cc *componentContext) ([]client.Object, error) {
+	return []client.Object{
+		common.ServiceNetworkAdminKubeconfigSecret{
+			Namespace:  cc.hcp.GetName(),
+			Kubeconfig: cc.pkiData["internal-admin.kubeconfig"],
+		}.Build(),
+	}, nil
+}
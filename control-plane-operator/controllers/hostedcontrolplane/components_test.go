@@ -0,0 +1,261 @@
+package hostedcontrolplane
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render/pki"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+	"openshift.io/hypershift/support/certs"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata/golden")
+
+// releases are the versions of the release fixtures the typed components are
+// built for
+var releases = []string{"4.5.0", "4.6.0", "4.7.0"}
+
+// clusterScopedKinds are the kinds of the typed component objects that are
+// not scoped to the control plane namespace
+var clusterScopedKinds = sets.NewString("CustomResourceDefinition", "ClusterRole", "ClusterRoleBinding")
+
+const (
+	testNamespace  = "clusters-example"
+	testBaseDomain = "example.hypershift.local"
+)
+
+// TestTypedComponents builds the objects of every typed component for each
+// release fixture and compares them with the golden files of the release.
+// Run it with -update to regenerate the golden files after changing a
+// builder.
+func TestTypedComponents(t *testing.T) {
+	pkiData, err := pki.GeneratePKIWithSource(&render.PKIParams{
+		ExternalAPIAddress:          "api." + testBaseDomain,
+		NodeInternalAPIServerIP:     "172.20.0.1",
+		ExternalAPIPort:             6443,
+		PrivateAPIAddress:           "api." + testBaseDomain,
+		PrivateAPIPort:              6443,
+		InternalAPIPort:             6443,
+		ServiceCIDR:                 "172.31.0.0/16",
+		ExternalOauthAddress:        "oauth." + testBaseDomain,
+		IngressSubdomain:            "apps." + testBaseDomain,
+		MachineConfigServerAddress:  "ignition." + testBaseDomain,
+		ExternalOpenVPNAddress:      "vpn." + testBaseDomain,
+		ExternalKonnectivityAddress: "konnectivity." + testBaseDomain,
+		Namespace:                   testNamespace,
+	}, fixtureSource(t))
+	if err != nil {
+		t.Fatalf("failed to generate PKI: %v", err)
+	}
+	for _, version := range releases {
+		t.Run(version, func(t *testing.T) {
+			cc := testComponentContext(t, version, pkiData)
+			built := map[string][]byte{}
+			for _, component := range typedComponents {
+				objects, err := component.objects(cc)
+				if err != nil {
+					t.Fatalf("failed to build %s: %v", component.name, err)
+				}
+				declared := sets.NewString()
+				for _, image := range component.images {
+					declared.Insert(cc.images[image])
+				}
+				for _, obj := range objects {
+					if deployment, ok := obj.(*appsv1.Deployment); ok {
+						for _, container := range append(deployment.Spec.Template.Spec.InitContainers, deployment.Spec.Template.Spec.Containers...) {
+							assert.True(t, declared.Has(container.Image), "image %s of container %s of %s should be one of the images of the component", container.Image, container.Name, component.name)
+						}
+					}
+					kind := obj.GetObjectKind().GroupVersionKind().Kind
+					assert.NotEmpty(t, kind, "objects of %s must be typed to be applied", component.name)
+					if !clusterScopedKinds.Has(kind) {
+						assert.Equal(t, testNamespace, obj.GetNamespace(), "%s %s of %s should be scoped to the control plane namespace", kind, obj.GetName(), component.name)
+					}
+				}
+				built[component.name+".yaml"] = encodeObjects(t, objects)
+			}
+			checkGoldenFiles(t, filepath.Join("testdata", "golden", version), built)
+		})
+	}
+}
+
+// testComponentContext returns the inputs of the typed components of a
+// control plane that runs a release fixture.
+func testComponentContext(t *testing.T, version string, pkiData map[string][]byte) *componentContext {
+	provider := &releaseinfo.FileProvider{Path: filepath.Join("render", "testdata", "releases", version+".json")}
+	releaseImage, err := provider.Lookup(context.Background(), "")
+	if err != nil {
+		t.Fatalf("failed to read release fixture: %v", err)
+	}
+	versions, err := releaseImage.ComponentVersions()
+	if err != nil {
+		t.Fatalf("failed to get component versions: %v", err)
+	}
+	params := render.NewClusterParams()
+	params.Namespace = testNamespace
+	params.ExternalAPIDNSName = "api." + testBaseDomain
+	params.ExternalAPIPort = 6443
+	params.ExternalAPIAddress = "172.20.0.1"
+	params.ExternalOpenVPNAddress = "vpn." + testBaseDomain
+	params.ExternalOpenVPNPort = 1194
+	params.ExternalOauthDNSName = "oauth." + testBaseDomain
+	params.ExternalOauthPort = 443
+	params.ReleaseImage = "quay.io/openshift-release-dev/ocp-release:" + version + "-x86_64"
+	params.ReleaseImagePullSpec = params.ReleaseImage
+	params.IngressSubdomain = "apps." + testBaseDomain
+	params.OpenShiftAPIClusterIP = "172.31.0.10"
+	params.OauthAPIClusterIP = "172.31.0.11"
+	params.BaseDomain = testBaseDomain
+	params.MachineConfigServerAddress = "ignition." + testBaseDomain
+	params.CloudProvider = "AWS"
+	params.PlatformType = "AWS"
+	params.InternalAPIPort = 6443
+	params.EtcdClientName = "etcd-client"
+	params.ServiceCIDR = "172.31.0.0/16"
+	params.PodCIDR = "10.132.0.0/14"
+	params.ServiceNetworks = []string{params.ServiceCIDR}
+	params.ClusterNetworks = []render.ClusterNetwork{{CIDR: params.PodCIDR, HostPrefix: params.HostPrefix}}
+	params.APIAvailabilityPolicy = render.SingleReplica
+	params.ControllerAvailabilityPolicy = render.SingleReplica
+	params.HostedClusterConfigOperatorControllers = []string{"controller-manager-ca", "cluster-operator", "auto-approver", "kubeadmin-password", "cluster-version", "kubelet-serving-ca", "openshift-apiserver-monitor", "infrastatus", "node", "route-sync"}
+	params.OpenshiftAPIServerCABundle = base64.StdEncoding.EncodeToString(pkiData["combined-ca.crt"])
+	params.OauthAPIServerCABundle = params.OpenshiftAPIServerCABundle
+	params.ImageRegistryHTTPSecret = imageRegistryHTTPSecret(pkiData)
+	cc := &componentContext{
+		hcp: &hyperv1.HostedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testNamespace},
+		},
+		params:            params,
+		images:            releaseinfo.WithStaticComponentImages(releaseImage).ComponentImages(),
+		versions:          versions,
+		pkiData:           pkiData,
+		identityProviders: &identityProviderConfig{},
+		audit:             &auditConfig{},
+	}
+	cc.images[releaseImageComponent] = params.ReleaseImagePullSpec
+	return cc
+}
+
+// componentObject returns the built object with the given kind and name,
+// failing the test if the component didn't build it.
+func componentObject(t *testing.T, objects []client.Object, kind, name string) client.Object {
+	for _, obj := range objects {
+		if obj.GetObjectKind().GroupVersionKind().Kind == kind && obj.GetName() == name {
+			return obj
+		}
+	}
+	t.Fatalf("expected %s %s to be built", kind, name)
+	return nil
+}
+
+// encodeObjects returns the objects of a component as a multi-document YAML
+// manifest.
+func encodeObjects(t *testing.T, objects []client.Object) []byte {
+	var documents [][]byte
+	for _, obj := range objects {
+		document, err := yaml.Marshal(obj)
+		if err != nil {
+			t.Fatalf("failed to encode %s: %v", obj.GetName(), err)
+		}
+		documents = append(documents, document)
+	}
+	return bytes.Join(documents, []byte("---\n"))
+}
+
+// checkGoldenFiles compares built manifests with the golden files in a
+// directory, or replaces the golden files with them when run with -update.
+// fixtureSource returns the source of the PKI of the golden files, which
+// hands out the fixture keys of render/testdata/keys.pem
+func fixtureSource(t *testing.T) *certs.Source {
+	source, err := certs.NewFixtureSource(filepath.Join("render", "testdata", "keys.pem"), 1, time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("failed to read fixture keys: %v", err)
+	}
+	return source
+}
+
+func checkGoldenFiles(t *testing.T, goldenDir string, manifests map[string][]byte) {
+	if *update {
+		if err := os.RemoveAll(goldenDir); err != nil {
+			t.Fatalf("failed to update golden files: %v", err)
+		}
+		if err := os.MkdirAll(goldenDir, 0755); err != nil {
+			t.Fatalf("failed to update golden files: %v", err)
+		}
+		for name, manifest := range manifests {
+			if err := ioutil.WriteFile(filepath.Join(goldenDir, name), manifest, 0644); err != nil {
+				t.Fatalf("failed to update golden files: %v", err)
+			}
+		}
+		return
+	}
+	files, err := ioutil.ReadDir(goldenDir)
+	if err != nil {
+		t.Fatalf("failed to read golden files, run the test with -update to create them: %v", err)
+	}
+	golden := map[string][]byte{}
+	for _, file := range files {
+		content, err := ioutil.ReadFile(filepath.Join(goldenDir, file.Name()))
+		if err != nil {
+			t.Fatalf("failed to read golden file: %v", err)
+		}
+		golden[file.Name()] = content
+	}
+	for _, name := range sortedNames(golden) {
+		manifest, built := manifests[name]
+		if !built {
+			t.Errorf("%s was not built", name)
+			continue
+		}
+		if line, differs := firstDifference(golden[name], manifest); differs {
+			t.Errorf("%s differs from its golden file at line %d", name, line)
+		}
+	}
+	for _, name := range sortedNames(manifests) {
+		if _, exists := golden[name]; !exists {
+			t.Errorf("%s has no golden file", name)
+		}
+	}
+}
+
+func sortedNames(manifests map[string][]byte) []string {
+	names := make([]string, 0, len(manifests))
+	for name := range manifests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// firstDifference returns the first line at which two manifests differ.
+func firstDifference(expected, actual []byte) (int, bool) {
+	if bytes.Equal(expected, actual) {
+		return 0, false
+	}
+	expectedLines := strings.Split(string(expected), "\n")
+	actualLines := strings.Split(string(actual), "\n")
+	for i := range expectedLines {
+		if i >= len(actualLines) || expectedLines[i] != actualLines[i] {
+			return i + 1, true
+		}
+	}
+	return len(expectedLines) + 1, true
+}
@@ -0,0 +1,79 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"fmt"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/dns"
+)
+
+// ensureDNSName points a stable DNS name at the published address of a
+// control plane endpoint and returns the address clients should use. That is
+// the published address when no name is requested, the name once it resolves
+// to the endpoint, and empty while the endpoint is still provisioning or the
+// record is still propagating.
+func ensureDNSName(ctx context.Context, provider dns.Provider, resolver dns.Resolver, name, address string) (string, error) {
+	if len(name) == 0 {
+		return address, nil
+	}
+	if len(address) == 0 {
+		return "", nil
+	}
+	if provider == nil {
+		return "", fmt.Errorf("no DNS provider is configured to publish %s", name)
+	}
+	if resolver == nil {
+		return "", fmt.Errorf("no DNS resolver is configured to check %s", name)
+	}
+	if err := provider.EnsureRecord(ctx, name, address); err != nil {
+		return "", fmt.Errorf("failed to ensure DNS record for %s: %w", name, err)
+	}
+	resolves, err := dns.Resolves(ctx, resolver, name, address)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", name, err)
+	}
+	if !resolves {
+		return "", nil
+	}
+	return name, nil
+}
+
+// ensureIngressDNSRecord points the *.apps records of a guest cluster that
+// publishes its ingress through its own load balancer at that load balancer,
+// once the hosted cluster config operator reported its address. Clusters are
+// not required to have a DNS provider for their ingress, so the record is
+// only published when one is configured.
+func ensureIngressDNSRecord(ctx context.Context, provider dns.Provider, hcp *hyperv1.HostedControlPlane, baseDomain string) error {
+	if ingressStrategy(hcp) != hyperv1.GuestLoadBalancer || len(hcp.Status.IngressEndpoint) == 0 || provider == nil {
+		return nil
+	}
+	name := ingressDNSName(baseDomain)
+	if err := provider.EnsureRecord(ctx, name, hcp.Status.IngressEndpoint); err != nil {
+		return fmt.Errorf("failed to ensure DNS record for %s: %w", name, err)
+	}
+	return nil
+}
+
+// ingressDNSName is the wildcard name of the routes of a guest cluster.
+func ingressDNSName(baseDomain string) string {
+	return "*.apps." + baseDomain
+}
+
+// deleteDNSNames removes the DNS records for the stable names of a control
+// plane and for the ingress of its guest cluster.
+func deleteDNSNames(ctx context.Context, provider dns.Provider, hcp *hyperv1.HostedControlPlane, baseDomain string) error {
+	names := []string{hcp.Spec.APIDNSName, hcp.Spec.OAuthDNSName}
+	if ingressStrategy(hcp) == hyperv1.GuestLoadBalancer {
+		names = append(names, ingressDNSName(baseDomain))
+	}
+	for _, name := range names {
+		if len(name) == 0 || provider == nil {
+			continue
+		}
+		if err := provider.DeleteRecord(ctx, name); err != nil {
+			return fmt.Errorf("failed to delete DNS record for %s: %w", name, err)
+		}
+	}
+	return nil
+}
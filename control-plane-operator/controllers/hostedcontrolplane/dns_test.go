@@ -0,0 +1,137 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/dns"
+)
+
+func TestEnsureDNSName(t *testing.T) {
+	tests := []struct {
+		name            string
+		dnsName         string
+		address         string
+		resolved        map[string][]string
+		expected        string
+		expectedRecords map[string]string
+	}{
+		{
+			name:     "no dns name",
+			address:  "a1b2.elb.example.com",
+			expected: "a1b2.elb.example.com",
+		},
+		{
+			name:    "still provisioning",
+			dnsName: "api.example.hypershift.local",
+		},
+		{
+			name:    "dns name",
+			dnsName: "api.example.hypershift.local",
+			address: "a1b2.elb.example.com",
+			resolved: map[string][]string{
+				"api.example.hypershift.local": {"192.0.2.10"},
+				"a1b2.elb.example.com":         {"192.0.2.10", "192.0.2.11"},
+			},
+			expected:        "api.example.hypershift.local",
+			expectedRecords: map[string]string{"api.example.hypershift.local": "a1b2.elb.example.com"},
+		},
+		{
+			name:    "dns name for an ip address",
+			dnsName: "api.example.hypershift.local",
+			address: "192.0.2.10",
+			resolved: map[string][]string{
+				"api.example.hypershift.local": {"192.0.2.10"},
+			},
+			expected:        "api.example.hypershift.local",
+			expectedRecords: map[string]string{"api.example.hypershift.local": "192.0.2.10"},
+		},
+		{
+			name:    "record not resolving yet",
+			dnsName: "api.example.hypershift.local",
+			address: "a1b2.elb.example.com",
+			resolved: map[string][]string{
+				"a1b2.elb.example.com": {"192.0.2.10"},
+			},
+			expectedRecords: map[string]string{"api.example.hypershift.local": "a1b2.elb.example.com"},
+		},
+		{
+			name:    "record resolving to a previous endpoint",
+			dnsName: "api.example.hypershift.local",
+			address: "a1b2.elb.example.com",
+			resolved: map[string][]string{
+				"api.example.hypershift.local": {"192.0.2.20"},
+				"a1b2.elb.example.com":         {"192.0.2.10"},
+			},
+			expectedRecords: map[string]string{"api.example.hypershift.local": "a1b2.elb.example.com"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := &dns.FakeProvider{}
+			resolver := &dns.FakeResolver{Addresses: test.resolved}
+			address, err := ensureDNSName(context.Background(), provider, resolver, test.dnsName, test.address)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, address)
+			assert.Equal(t, test.expectedRecords, provider.Records)
+		})
+	}
+}
+
+func TestEnsureDNSNameWithoutProvider(t *testing.T) {
+	_, err := ensureDNSName(context.Background(), nil, &dns.FakeResolver{}, "api.example.hypershift.local", "a1b2.elb.example.com")
+	assert.Error(t, err)
+}
+
+func TestEnsureIngressDNSRecord(t *testing.T) {
+	tests := []struct {
+		name            string
+		strategy        hyperv1.IngressStrategyType
+		endpoint        string
+		expectedRecords map[string]string
+	}{
+		{
+			name:            "guest load balancer",
+			strategy:        hyperv1.GuestLoadBalancer,
+			endpoint:        "g7h8.elb.example.com",
+			expectedRecords: map[string]string{"*.apps.example.hypershift.local": "g7h8.elb.example.com"},
+		},
+		{
+			name:     "guest load balancer still provisioning",
+			strategy: hyperv1.GuestLoadBalancer,
+		},
+		{
+			name:     "management router shard",
+			strategy: hyperv1.ManagementRouterShard,
+			endpoint: "g7h8.elb.example.com",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := &dns.FakeProvider{}
+			hcp := &hyperv1.HostedControlPlane{}
+			hcp.Spec.Ingress.Strategy = test.strategy
+			hcp.Status.IngressEndpoint = test.endpoint
+			assert.NoError(t, ensureIngressDNSRecord(context.Background(), provider, hcp, "example.hypershift.local"))
+			assert.Equal(t, test.expectedRecords, provider.Records)
+		})
+	}
+}
+
+func TestDeleteDNSNames(t *testing.T) {
+	provider := &dns.FakeProvider{Records: map[string]string{
+		"api.example.hypershift.local":    "a1b2.elb.example.com",
+		"oauth.example.hypershift.local":  "c3d4.elb.example.com",
+		"*.apps.example.hypershift.local": "g7h8.elb.example.com",
+		"api.other.hypershift.local":      "e5f6.elb.example.com",
+	}}
+	hcp := &hyperv1.HostedControlPlane{}
+	hcp.Spec.APIDNSName = "api.example.hypershift.local"
+	hcp.Spec.OAuthDNSName = "oauth.example.hypershift.local"
+	hcp.Spec.Ingress.Strategy = hyperv1.GuestLoadBalancer
+	assert.NoError(t, deleteDNSNames(context.Background(), provider, hcp, "example.hypershift.local"))
+	assert.Equal(t, map[string]string{"api.other.hypershift.local": "e5f6.elb.example.com"}, provider.Records)
+}
@@ -0,0 +1,41 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/etcd"
+)
+
+// etcdObjects returns the objects of the etcd cluster of a control plane and
+// of the operator that runs it.
+func etcdObjects(cc *componentContext) ([]client.Object, error) {
+	targetNamespace := cc.hcp.GetName()
+	cluster, err := etcd.Cluster{
+		Namespace:   targetNamespace,
+		MemberImage: cc.images["etcd-member"],
+		Scheduling:  cc.hcp.Spec.ControlPlaneScheduling,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	objects := []client.Object{
+		etcd.ClusterCustomResourceDefinition{}.Build(),
+		cluster,
+		etcd.OperatorClusterRoleBinding{Namespace: targetNamespace}.Build(),
+		etcd.OperatorClusterRole{}.Build(),
+		etcd.OperatorServiceAccount{Namespace: targetNamespace}.Build(),
+		etcd.OperatorDeployment{
+			Namespace:  targetNamespace,
+			Image:      cc.images["etcd-operator"],
+			Scheduling: cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+	}
+	for _, secretType := range []etcd.TLSSecretType{etcd.ClientSecret, etcd.ServerSecret, etcd.PeerSecret} {
+		objects = append(objects, etcd.TLSSecret{
+			Namespace: targetNamespace,
+			Type:      secretType,
+			PKI:       cc.pkiData,
+		}.Build())
+	}
+	return objects, nil
+}
@@ -0,0 +1,84 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+func TestEtcdObjects(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: hyperv1.HostedControlPlaneSpec{
+			ControlPlaneScheduling: &hyperv1.ControlPlaneScheduling{
+				NodeSelector:      map[string]string{"node-role.kubernetes.io/infra": ""},
+				PriorityClassName: "system-cluster-critical",
+			},
+		},
+	}
+	objects, err := etcdObjects(&componentContext{
+		hcp:    hcp,
+		images: map[string]string{"etcd-member": "mirror.example.com/coreos/etcd:v3.4.9"},
+		pkiData: map[string][]byte{
+			"root-ca.crt":     []byte("ca"),
+			"etcd-client.crt": []byte("client-cert"),
+			"etcd-client.key": []byte("client-key"),
+			"etcd-server.crt": []byte("server-cert"),
+			"etcd-server.key": []byte("server-key"),
+			"etcd-peer.crt":   []byte("peer-cert"),
+			"etcd-peer.key":   []byte("peer-key"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cluster := componentObject(t, objects, "EtcdCluster", "etcd").(*unstructured.Unstructured)
+	assert.Equal(t, "EtcdCluster", cluster.GetKind())
+	repository, _, _ := unstructured.NestedString(cluster.Object, "spec", "repository")
+	assert.Equal(t, "mirror.example.com/coreos/etcd", repository)
+	version, _, _ := unstructured.NestedString(cluster.Object, "spec", "version")
+	assert.Equal(t, "3.4.9", version)
+	nodeSelector, _, err := unstructured.NestedStringMap(cluster.Object, "spec", "pod", "nodeSelector")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, hcp.Spec.ControlPlaneScheduling.NodeSelector, nodeSelector)
+	_, hasPriorityClass, _ := unstructured.NestedString(cluster.Object, "spec", "pod", "priorityClassName")
+	assert.False(t, hasPriorityClass, "the etcd pod policy has no priority class")
+
+	secrets := map[string]map[string][]byte{}
+	for _, name := range []string{"etcd-client-tls", "etcd-server-tls", "etcd-peer-tls"} {
+		secrets[name] = componentObject(t, objects, "Secret", name).(*corev1.Secret).Data
+	}
+	assert.Equal(t, map[string]map[string][]byte{
+		"etcd-client-tls": {
+			"etcd-client.crt":    []byte("client-cert"),
+			"etcd-client.key":    []byte("client-key"),
+			"etcd-client-ca.crt": []byte("ca"),
+		},
+		"etcd-server-tls": {
+			"server.crt":    []byte("server-cert"),
+			"server.key":    []byte("server-key"),
+			"server-ca.crt": []byte("ca"),
+		},
+		"etcd-peer-tls": {
+			"peer.crt":    []byte("peer-cert"),
+			"peer.key":    []byte("peer-key"),
+			"peer-ca.crt": []byte("ca"),
+		},
+	}, secrets)
+}
+
+func TestEtcdObjectsMemberImage(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "example"}}
+	for _, image := range []string{"quay.io/coreos/etcd@sha256:abc", "quay.io/coreos/etcd", "registry.example.com:5000/coreos/etcd", "quay.io/coreos/etcd:latest"} {
+		_, err := etcdObjects(&componentContext{hcp: hcp, images: map[string]string{"etcd-member": image}})
+		assert.Error(t, err, "the etcd operator can only pull member images by version tag: %s", image)
+	}
+}
@@ -0,0 +1,97 @@
+package hostedcontrolplane
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+	configv1 "github.com/openshift/api/config/v1"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+// featureSetMinimumVersions are the first releases that know about a feature
+// set. Feature sets that aren't listed are known by every supported release.
+var featureSetMinimumVersions = map[configv1.FeatureSet]semver.Version{
+	configv1.IPv6DualStackNoUpgrade: semver.MustParse("4.7.0"),
+}
+
+// validateFeatureGates makes sure the feature gates of a control plane are
+// understood by its release, since a feature gate the components don't know
+// keeps them from starting.
+func validateFeatureGates(spec configv1.FeatureGateSpec, version semver.Version) error {
+	if _, ok := configv1.FeatureSets[spec.FeatureSet]; !ok {
+		return fmt.Errorf("unknown feature set %q", spec.FeatureSet)
+	}
+	if minimum, ok := featureSetMinimumVersions[spec.FeatureSet]; ok && version.LT(minimum) {
+		return fmt.Errorf("feature set %s requires release %s or newer, got %s", spec.FeatureSet, minimum, version)
+	}
+	if spec.FeatureSet != configv1.CustomNoUpgrade {
+		if spec.CustomNoUpgrade != nil && (len(spec.CustomNoUpgrade.Enabled) > 0 || len(spec.CustomNoUpgrade.Disabled) > 0) {
+			return fmt.Errorf("custom feature gates require the %s feature set", configv1.CustomNoUpgrade)
+		}
+		return nil
+	}
+	if spec.CustomNoUpgrade == nil {
+		return nil
+	}
+	enabled := map[string]bool{}
+	for _, name := range spec.CustomNoUpgrade.Enabled {
+		if err := validateFeatureGateName(name); err != nil {
+			return err
+		}
+		enabled[name] = true
+	}
+	for _, name := range spec.CustomNoUpgrade.Disabled {
+		if err := validateFeatureGateName(name); err != nil {
+			return err
+		}
+		if enabled[name] {
+			return fmt.Errorf("feature gate %s is both enabled and disabled", name)
+		}
+	}
+	return nil
+}
+
+func validateFeatureGateName(name string) error {
+	if len(name) == 0 || strings.ContainsAny(name, "=, \t\n") {
+		return fmt.Errorf("invalid feature gate name %q", name)
+	}
+	return nil
+}
+
+// setFeatureGateParams sets the feature gates that are passed to the control
+// plane components and written to the FeatureGate cluster configuration. They
+// come after the default feature gates, so they take precedence. The default
+// feature set leaves the components as they are.
+func setFeatureGateParams(spec configv1.FeatureGateSpec, params *render.ClusterParams) {
+	params.FeatureSet = string(spec.FeatureSet)
+	var enabled, disabled []string
+	if spec.FeatureSet == configv1.CustomNoUpgrade {
+		if spec.CustomNoUpgrade != nil {
+			enabled = spec.CustomNoUpgrade.Enabled
+			disabled = spec.CustomNoUpgrade.Disabled
+		}
+		params.CustomEnabledFeatureGates = enabled
+		params.CustomDisabledFeatureGates = disabled
+	} else if features, ok := configv1.FeatureSets[spec.FeatureSet]; ok && spec.FeatureSet != configv1.Default {
+		enabled = features.Enabled
+		disabled = features.Disabled
+	}
+	params.ExtraFeatureGates = nil
+	for _, name := range enabled {
+		params.ExtraFeatureGates = append(params.ExtraFeatureGates, name+"=true")
+	}
+	for _, name := range disabled {
+		params.ExtraFeatureGates = append(params.ExtraFeatureGates, name+"=false")
+	}
+}
+
+// componentFeatureGates returns the feature gates passed to the control plane
+// components.
+func componentFeatureGates(params *render.ClusterParams) []string {
+	var featureGates []string
+	featureGates = append(featureGates, params.DefaultFeatureGates...)
+	featureGates = append(featureGates, params.ExtraFeatureGates...)
+	return featureGates
+}
@@ -0,0 +1,101 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/blang/semver"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/stretchr/testify/assert"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestValidateFeatureGates(t *testing.T) {
+	version47 := semver.MustParse("4.7.0")
+	testCases := []struct {
+		name    string
+		spec    configv1.FeatureGateSpec
+		version semver.Version
+		valid   bool
+	}{
+		{
+			name:    "default",
+			version: version47,
+			valid:   true,
+		},
+		{
+			name:    "tech preview",
+			spec:    featureGateSpec(configv1.TechPreviewNoUpgrade, nil),
+			version: version47,
+			valid:   true,
+		},
+		{
+			name:    "unknown feature set",
+			spec:    featureGateSpec("Unknown", nil),
+			version: version47,
+		},
+		{
+			name:    "feature set not in release",
+			spec:    featureGateSpec(configv1.IPv6DualStackNoUpgrade, nil),
+			version: semver.MustParse("4.6.8"),
+		},
+		{
+			name:    "custom",
+			spec:    featureGateSpec(configv1.CustomNoUpgrade, &configv1.CustomFeatureGates{Enabled: []string{"A"}, Disabled: []string{"B"}}),
+			version: version47,
+			valid:   true,
+		},
+		{
+			name:    "custom gates without custom feature set",
+			spec:    featureGateSpec(configv1.TechPreviewNoUpgrade, &configv1.CustomFeatureGates{Enabled: []string{"A"}}),
+			version: version47,
+		},
+		{
+			name:    "gate enabled and disabled",
+			spec:    featureGateSpec(configv1.CustomNoUpgrade, &configv1.CustomFeatureGates{Enabled: []string{"A"}, Disabled: []string{"A"}}),
+			version: version47,
+		},
+		{
+			name:    "invalid gate name",
+			spec:    featureGateSpec(configv1.CustomNoUpgrade, &configv1.CustomFeatureGates{Enabled: []string{"A=false"}}),
+			version: version47,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateFeatureGates(tc.spec, tc.version)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSetFeatureGateParams(t *testing.T) {
+	params := render.NewClusterParams()
+	setFeatureGateParams(configv1.FeatureGateSpec{}, params)
+	assert.Empty(t, params.FeatureSet)
+	assert.Empty(t, params.ExtraFeatureGates)
+
+	params = render.NewClusterParams()
+	setFeatureGateParams(featureGateSpec(configv1.CustomNoUpgrade, &configv1.CustomFeatureGates{Enabled: []string{"A"}, Disabled: []string{"B"}}), params)
+	assert.Equal(t, "CustomNoUpgrade", params.FeatureSet)
+	assert.Equal(t, []string{"A=true", "B=false"}, params.ExtraFeatureGates)
+	assert.Equal(t, []string{"A"}, params.CustomEnabledFeatureGates)
+	assert.Equal(t, []string{"B"}, params.CustomDisabledFeatureGates)
+
+	params = render.NewClusterParams()
+	setFeatureGateParams(featureGateSpec(configv1.LatencySensitive, nil), params)
+	assert.Contains(t, params.ExtraFeatureGates, "TopologyManager=true")
+}
+
+func featureGateSpec(featureSet configv1.FeatureSet, custom *configv1.CustomFeatureGates) configv1.FeatureGateSpec {
+	return configv1.FeatureGateSpec{
+		FeatureGateSelection: configv1.FeatureGateSelection{
+			FeatureSet:      featureSet,
+			CustomNoUpgrade: custom,
+		},
+	}
+}
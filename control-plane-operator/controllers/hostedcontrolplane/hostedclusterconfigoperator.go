@@ -0,0 +1,78 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/hostedclusterconfigoperator"
+)
+
+const hostedClusterConfigOperatorDeploymentName = "hosted-cluster-config-operator"
+
+// hostedClusterConfigOperatorObjects returns the objects of the hosted
+// cluster config operator of a control plane.
+func hostedClusterConfigOperatorObjects(cc *componentContext) ([]client.Object, error) {
+	targetNamespace := cc.hcp.GetName()
+	var runAsUser *int64
+	if len(cc.params.HostedClusterConfigOperatorSecurity) > 0 {
+		user, err := strconv.ParseInt(cc.params.HostedClusterConfigOperatorSecurity, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hosted cluster config operator user %q: %w", cc.params.HostedClusterConfigOperatorSecurity, err)
+		}
+		runAsUser = &user
+	}
+	return []client.Object{
+		hostedclusterconfigoperator.ServiceAccount{
+			Namespace: targetNamespace,
+		}.Build(),
+		hostedclusterconfigoperator.Role{
+			Namespace: targetNamespace,
+		}.Build(),
+		hostedclusterconfigoperator.RoleBinding{
+			Namespace: targetNamespace,
+		}.Build(),
+		hostedclusterconfigoperator.Deployment{
+			Namespace:         targetNamespace,
+			Image:             cc.images["hosted-cluster-config-operator"],
+			ClusterID:         cc.params.ClusterID,
+			RestartDate:       cc.params.RestartDate,
+			PriorityClassName: cc.params.MasterPriorityClass,
+			RunAsUser:         runAsUser,
+			ReleaseVersion:    cc.versions["release"],
+			KubernetesVersion: cc.versions["kubernetes"],
+			Controllers:       cc.params.HostedClusterConfigOperatorControllers,
+			Resources:         deploymentResources(cc.hcp.Spec.ControlPlaneResources, hostedClusterConfigOperatorDeploymentName),
+			Scheduling:        cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+		hostedclusterconfigoperator.ConfigMap{
+			Namespace: targetNamespace,
+			InitialCA: cc.pkiData["combined-ca.crt"],
+		}.Build(),
+	}, nil
+}
+
+// deleteHostedClusterConfigOperatorClusterRole removes the cluster role that
+// let the hosted cluster config operator watch the routes of all namespaces.
+// Its role only grants access to the routes of the control plane namespace.
+func (r *HostedControlPlaneReconciler) deleteHostedClusterConfigOperatorClusterRole(ctx context.Context, hcp *hyperv1.HostedControlPlane) error {
+	name := hostedclusterconfigoperator.ClusterRoleName(hcp.GetName())
+	for _, obj := range []client.Object{
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: name}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: name}},
+	} {
+		if err := r.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete hosted cluster config operator cluster role: %w", err)
+		}
+		if err := r.forgetAppliedObject(hcp, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
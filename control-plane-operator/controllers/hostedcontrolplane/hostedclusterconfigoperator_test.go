@@ -0,0 +1,80 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestHostedClusterConfigOperatorObjects(t *testing.T) {
+	tests := []struct {
+		name      string
+		security  string
+		runAsUser *int64
+		valid     bool
+	}{
+		{
+			name:  "default user",
+			valid: true,
+		},
+		{
+			name:      "custom user",
+			security:  "1001",
+			runAsUser: func() *int64 { user := int64(1001); return &user }(),
+			valid:     true,
+		},
+		{
+			name:     "invalid user",
+			security: "nobody",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := render.NewClusterParams()
+			params.HostedClusterConfigOperatorSecurity = test.security
+			params.HostedClusterConfigOperatorControllers = []string{"controller1", "controller2"}
+
+			objects, err := hostedClusterConfigOperatorObjects(&componentContext{
+				hcp: &hyperv1.HostedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "example"},
+				},
+				params:   params,
+				images:   map[string]string{"hosted-cluster-config-operator": "example.com/hcco:latest"},
+				versions: map[string]string{"release": "4.7.0", "kubernetes": "1.20.0"},
+			})
+			if !test.valid {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, obj := range objects {
+				assert.Equal(t, "example", obj.GetNamespace(), "%T %s should be scoped to the control plane namespace", obj, obj.GetName())
+			}
+			deployment := componentObject(t, objects, "Deployment", "hosted-cluster-config-operator").(*appsv1.Deployment)
+			container := deployment.Spec.Template.Spec.Containers[0]
+			assert.Equal(t, "example.com/hcco:latest", container.Image)
+			assert.Contains(t, container.Command, "--controllers=controller1")
+			assert.Contains(t, container.Command, "--controllers=controller2")
+			env := map[string]string{}
+			for _, e := range container.Env {
+				env[e.Name] = e.Value
+			}
+			assert.Equal(t, "4.7.0", env["OPENSHIFT_RELEASE_VERSION"])
+			assert.Equal(t, "1.20.0", env["KUBERNETES_VERSION"])
+			if test.runAsUser == nil {
+				assert.Nil(t, container.SecurityContext)
+			} else {
+				assert.Equal(t, test.runAsUser, container.SecurityContext.RunAsUser)
+			}
+		})
+	}
+}
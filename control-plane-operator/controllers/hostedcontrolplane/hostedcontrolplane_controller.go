@@ -1,16 +1,15 @@
 package hostedcontrolplane
 
 import (
-	"bytes"
 	"context"
+	"crypto/hmac"
 	crand "crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"math/big"
-	"math/rand"
 	"reflect"
-	"strings"
 	"time"
 
 	"github.com/blang/semver"
@@ -23,16 +22,11 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
-	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
-	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/cluster-api/util"
@@ -44,48 +38,59 @@ import (
 	hyperv1 "openshift.io/hypershift/api/v1alpha1"
 	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
 	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render/pki"
+	"openshift.io/hypershift/control-plane-operator/dns"
 	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+	"openshift.io/hypershift/support/mirrors"
+	"openshift.io/hypershift/support/scheduling"
 )
 
 const (
-	finalizer                 = "hypershift.openshift.io/finalizer"
-	APIServerPort             = 6443
-	kubeAPIServerServiceName  = "kube-apiserver"
-	vpnServiceName            = "openvpn-server"
-	oauthServiceName          = "oauth-openshift"
-	pullSecretName            = "pull-secret"
-	vpnServiceAccountName     = "vpn"
-	ingressOperatorNamespace  = "openshift-ingress-operator"
-	hypershiftRouteLabel      = "hypershift.openshift.io/cluster"
-	oauthBrandingManifest     = "v4-0-config-system-branding.yaml"
-	DefaultAPIServerIPAddress = "172.20.0.1"
-	externalOauthPort         = 8443
+	finalizer                   = "hypershift.openshift.io/finalizer"
+	APIServerPort               = 6443
+	kubeAPIServerServiceName    = "kube-apiserver"
+	vpnServiceName              = "openvpn-server"
+	konnectivityServerName      = "konnectivity-server"
+	konnectivityAgentPort       = 8091
+	machineConfigServerName     = "machine-config-server"
+	oauthServiceName            = "oauth-openshift"
+	pullSecretName              = "pull-secret"
+	vpnServiceAccountName       = "vpn"
+	ingressOperatorNamespace    = "openshift-ingress-operator"
+	hypershiftRouteLabel        = "hypershift.openshift.io/cluster"
+	DefaultAPIServerIPAddress   = "172.20.0.1"
+	DefaultAPIServerIPv6Address = "fd00::1"
+	externalOauthPort           = 8443
 )
 
 var (
-	excludeManifests = sets.NewString(
-		"openshift-apiserver-service.yaml",
-		"v4-0-config-system-branding.yaml",
-		"oauth-server-service.yaml",
-		"kube-apiserver-service.yaml",
-	)
-
 	version46 = semver.MustParse("4.6.0")
 )
 
 type InfrastructureStatus struct {
 	APIAddress              string
+	APIPort                 int32
+	PrivateAPIAddress       string
+	PrivateAPIPort          int32
 	OAuthAddress            string
+	OAuthPort               int32
 	VPNAddress              string
+	VPNPort                 int32
+	KonnectivityAddress     string
+	KonnectivityPort        int32
 	OpenShiftAPIAddress     string
 	OauthAPIServerAddress   string
 	IgnitionProviderAddress string
+	IgnitionProviderPort    int32
+	// AppliedObjects are the services and routes published for the control
+	// plane
+	AppliedObjects []hyperv1.AppliedObject
 }
 
 func (s InfrastructureStatus) IsReady() bool {
 	return len(s.APIAddress) > 0 &&
+		len(s.PrivateAPIAddress) > 0 &&
 		len(s.OAuthAddress) > 0 &&
-		len(s.VPNAddress) > 0 &&
+		(len(s.VPNAddress) > 0 || len(s.KonnectivityAddress) > 0) &&
 		len(s.IgnitionProviderAddress) > 0
 }
 
@@ -94,6 +99,10 @@ type HostedControlPlaneReconciler struct {
 
 	Log             logr.Logger
 	ReleaseProvider releaseinfo.Provider
+	DNSProvider     dns.Provider
+	// DNSResolver checks that the records of the DNSProvider resolve before
+	// their names are used
+	DNSResolver dns.Resolver
 
 	recorder record.EventRecorder
 }
@@ -232,15 +241,30 @@ func (r *HostedControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.R
 
 	r.Log = r.Log.WithValues("cluster", cluster.Name)
 
+	setUnsupportedCondition(hostedControlPlane)
+
 	var result ctrl.Result
 	// TODO (alberto):
 	// May be eventually just run a deployment with a CVO running a hostedControlPlane profile
 	// passing the hostedControlPlane.spec.version through?
 
-	if hostedControlPlane.Status.Ready {
-		r.Log.Info("Is ready")
-		return r.setAvailableCondition(ctx, hostedControlPlane, oldStatus, hyperv1.ConditionTrue, "AsExpected", "HostedControlPlane is ready", result, nil)
+	releaseImage, err := r.lookupReleaseImage(ctx, hostedControlPlane)
+	if err != nil {
+		return r.setAvailableCondition(ctx, hostedControlPlane, oldStatus, hyperv1.ConditionFalse, "ReleaseInfoLookupFailed", err.Error(), ctrl.Result{}, fmt.Errorf("failed to look up release info: %w", err))
+	}
+	setUnmirroredImagesCondition(hostedControlPlane, releaseImage)
+	// Releases outside of the supported range are not applied until the
+	// release image is changed, check them before any infrastructure is
+	// provisioned for the control plane
+	if err := validateReleaseVersion(hostedControlPlane, releaseImage); err != nil {
+		r.Log.Info("release is not supported", "releaseImage", hostedControlPlane.Spec.ReleaseImage, "reason", err.Error())
+		return r.setAvailableCondition(ctx, hostedControlPlane, oldStatus, hyperv1.ConditionFalse, "UnsupportedRelease", err.Error(), ctrl.Result{}, nil)
+	}
+	componentVersions, err := releaseImage.ComponentVersions()
+	if err != nil {
+		return r.setAvailableCondition(ctx, hostedControlPlane, oldStatus, hyperv1.ConditionFalse, "InvalidComponentVersion", err.Error(), ctrl.Result{}, fmt.Errorf("invalid component versions found in release info: %w", err))
 	}
+	r.Log.Info("found release info for image", "releaseImage", hostedControlPlane.Spec.ReleaseImage, "info", releaseImage, "componentImages", releaseImage.ComponentImages(), "componentVersions", componentVersions)
 
 	r.Log.Info("Creating API services")
 	infraStatus, err := r.ensureInfrastructure(ctx, hostedControlPlane)
@@ -257,48 +281,64 @@ func (r *HostedControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.R
 	}
 	hostedControlPlane.Status.ControlPlaneEndpoint = hyperv1.APIEndpoint{
 		Host: infraStatus.APIAddress,
-		Port: APIServerPort,
+		Port: infraStatus.APIPort,
 	}
 
-	releaseImage, err := r.ReleaseProvider.Lookup(ctx, hostedControlPlane.Spec.ReleaseImage)
+	// Stage the next step of an encryption key rotation before the control
+	// plane is applied with the resulting encryption config
+	rotatingEncryptionKey, err := r.reconcileSecretEncryption(ctx, hostedControlPlane, releaseImage)
 	if err != nil {
-		return r.setAvailableCondition(ctx, hostedControlPlane, oldStatus, hyperv1.ConditionFalse, "ReleaseInfoLookupFailed", err.Error(), ctrl.Result{}, fmt.Errorf("failed to look up release info: %w", err))
+		r.Log.Error(err, "failed to reconcile secret encryption")
+		return r.setAvailableCondition(ctx, hostedControlPlane, oldStatus, hyperv1.ConditionFalse, "SecretEncryptionReconcileFailed", err.Error(), result, fmt.Errorf("failed to reconcile secret encryption: %w", err))
 	}
-	componentVersions, err := releaseImage.ComponentVersions()
-	if err != nil {
-		return r.setAvailableCondition(ctx, hostedControlPlane, oldStatus, hyperv1.ConditionFalse, "InvalidComponentVersion", err.Error(), ctrl.Result{}, fmt.Errorf("invalid component versions found in release info: %w", err))
-	}
-	r.Log.Info("found release info for image", "releaseImage", hostedControlPlane.Spec.ReleaseImage, "info", releaseImage, "componentImages", releaseImage.ComponentImages(), "componentVersions", componentVersions)
 
-	if hostedControlPlane.Status.Version == "" {
-		hostedControlPlane.Status.Version = releaseImage.Version()
-	}
-
-	// Install the control plane into the infrastructure
-	r.Log.Info("Creating hosted control plane")
+	// Apply the control plane on every reconcile, which rolls out changes to
+	// its spec and release and repairs objects that drifted
+	r.Log.Info("Applying hosted control plane")
 	err = r.ensureControlPlane(ctx, hostedControlPlane, infraStatus, releaseImage)
 	if err != nil {
 		r.Log.Error(err, "failed to ensure control plane")
 		return r.setAvailableCondition(ctx, hostedControlPlane, oldStatus, hyperv1.ConditionFalse, "ControlPlaneEnsureFailed", err.Error(), result, fmt.Errorf("failed to ensure control plane: %w", err))
 	}
+	hostedControlPlane.Status.Version = releaseImage.Version()
 
 	hostedControlPlane.Status.KubeConfig = &corev1.LocalObjectReference{
 		Name: fmt.Sprintf("%v-kubeconfig", hostedControlPlane.Name),
 	}
+	// Applied objects are not watched, apply them again periodically
+	result.RequeueAfter = reapplyInterval
+	// Referenced secrets and configmaps, like those of the identity providers
+	// and the trusted CA of the proxy baked into user-data, live in another
+	// namespace and can't be watched, check them for changes periodically
+	if len(hostedControlPlane.Spec.OAuth.IdentityProviders) > 0 || len(hostedControlPlane.Spec.Proxy.TrustedCA.Name) > 0 {
+		result.RequeueAfter = identityProviderResyncInterval
+	}
+	// Wait for the kube-apiserver to roll out before promoting a new
+	// encryption key
+	if rotatingEncryptionKey {
+		result.RequeueAfter = encryptionKeyRolloutPollInterval
+	}
 	r.Log.Info("Successfully reconciled")
-	return r.setAvailableCondition(ctx, hostedControlPlane, oldStatus, hyperv1.ConditionTrue, "AsExpected", "HostedControlPlane is ready", ctrl.Result{}, nil)
+	return r.setAvailableCondition(ctx, hostedControlPlane, oldStatus, hyperv1.ConditionTrue, "AsExpected", "HostedControlPlane is ready", result, nil)
 }
 
 func (r *HostedControlPlaneReconciler) delete(ctx context.Context, hcp *hyperv1.HostedControlPlane) error {
-	releaseImage, err := r.ReleaseProvider.Lookup(ctx, hcp.Spec.ReleaseImage)
+	baseDomain, err := clusterBaseDomain(r.Client, ctx, hcp)
+	if err != nil {
+		return err
+	}
+	if err := deleteDNSNames(ctx, r.DNSProvider, hcp, baseDomain); err != nil {
+		return err
+	}
+	releaseImage, err := r.lookupReleaseImage(ctx, hcp)
 	if err != nil {
 		return fmt.Errorf("failed to look up release info: %w", err)
 	}
-	manifests, err := r.generateControlPlaneManifests(ctx, hcp, InfrastructureStatus{}, releaseImage)
+	objects, err := r.generateControlPlaneObjects(ctx, hcp, InfrastructureStatus{}, releaseImage)
 	if err != nil {
 		return nil
 	}
-	if err := deleteManifests(ctx, r, r.Log, hcp.GetName(), manifests); err != nil {
+	if err := deleteObjects(ctx, r, r.Log, objects); err != nil {
 		return err
 	}
 	return nil
@@ -308,30 +348,70 @@ func (r *HostedControlPlaneReconciler) ensureInfrastructure(ctx context.Context,
 	status := InfrastructureStatus{}
 
 	targetNamespace := hcp.GetName()
-	// Ensure that we can run privileged pods
-	if err := ensureVPNSCC(r, hcp, targetNamespace); err != nil {
-		return status, fmt.Errorf("failed to ensure privileged SCC for the new namespace: %w", err)
+	konnectivity := hcp.Spec.Tunnel.Type == hyperv1.KonnectivityTunnel
+	if err := validateIngressStrategy(hcp); err != nil {
+		return status, err
 	}
 
-	baseDomain, err := clusterBaseDomain(r.Client, ctx, hcp.Name)
+	if !konnectivity {
+		// Ensure that we can run privileged pods
+		if err := ensureVPNSCC(r, hcp, targetNamespace); err != nil {
+			return status, fmt.Errorf("failed to ensure privileged SCC for the new namespace: %w", err)
+		}
+	}
+
+	baseDomain, err := clusterBaseDomain(r.Client, ctx, hcp)
 	if err != nil {
 		return status, fmt.Errorf("couldn't determine cluster base domain  name: %w", err)
 	}
 
-	// Create Kube APIServer service
-	r.Log.Info("Creating Kube API service")
-	apiService, err := createKubeAPIServerService(r, hcp, targetNamespace)
-	if err != nil {
-		return status, fmt.Errorf("failed to create Kube API service: %w", err)
+	var clusterInfra configv1.Infrastructure
+	if err := r.Get(ctx, client.ObjectKey{Name: "cluster"}, &clusterInfra); err != nil {
+		return status, fmt.Errorf("failed to get cluster infra: %w", err)
+	}
+	publisher := &servicePublisher{
+		client:    r,
+		hcp:       hcp,
+		namespace: targetNamespace,
+		platform:  managementPlatform(&clusterInfra),
+	}
+	if err := validateEndpointAccess(hcp, publisher.platform); err != nil {
+		return status, err
 	}
-	r.Log.Info("Created Kube API service")
 
-	r.Log.Info("Creating VPN service")
-	vpnService, err := createVPNServerService(r, hcp, targetNamespace)
+	r.Log.Info("Publishing Kube API service")
+	status.APIAddress, status.APIPort, err = publisher.publish(ctx, kubeAPIServerPublishedService, servicePublishingStrategy(hcp, kubeAPIServerPublishedService))
 	if err != nil {
-		return status, fmt.Errorf("failed to create vpn server service: %w", err)
+		return status, fmt.Errorf("failed to publish Kube API service: %w", err)
+	}
+	status.APIAddress, err = ensureDNSName(ctx, r.DNSProvider, r.DNSResolver, hcp.Spec.APIDNSName, status.APIAddress)
+	if err != nil {
+		return status, err
+	}
+	// Workers use a separate internal endpoint when the API is also public
+	if hcp.Spec.EndpointAccess == hyperv1.PublicAndPrivate {
+		r.Log.Info("Publishing private Kube API service")
+		status.PrivateAPIAddress, status.PrivateAPIPort, err = publisher.publish(ctx, kubeAPIServerPrivatePublishedService, hyperv1.ServicePublishingStrategy{Type: hyperv1.LoadBalancer})
+		if err != nil {
+			return status, fmt.Errorf("failed to publish private Kube API service: %w", err)
+		}
+	} else {
+		status.PrivateAPIAddress, status.PrivateAPIPort = status.APIAddress, status.APIPort
+	}
+
+	if konnectivity {
+		r.Log.Info("Publishing Konnectivity server service")
+		status.KonnectivityAddress, status.KonnectivityPort, err = publisher.publish(ctx, konnectivityPublishedService, servicePublishingStrategy(hcp, konnectivityPublishedService))
+		if err != nil {
+			return status, fmt.Errorf("failed to publish konnectivity server service: %w", err)
+		}
+	} else {
+		r.Log.Info("Publishing VPN service")
+		status.VPNAddress, status.VPNPort, err = publisher.publish(ctx, vpnPublishedService, servicePublishingStrategy(hcp, vpnPublishedService))
+		if err != nil {
+			return status, fmt.Errorf("failed to publish vpn server service: %w", err)
+		}
 	}
-	r.Log.Info("Created VPN service")
 
 	r.Log.Info("Creating Openshift API service")
 	openshiftAPIService, err := createOpenshiftService(r, hcp, targetNamespace)
@@ -347,62 +427,49 @@ func (r *HostedControlPlaneReconciler) ensureInfrastructure(ctx context.Context,
 	}
 	r.Log.Info("Created Openshift Oauth API service")
 
-	r.Log.Info("Creating OAuth service")
-	oauthService, err := createOauthService(r, hcp, targetNamespace)
+	r.Log.Info("Publishing OAuth service")
+	status.OAuthAddress, status.OAuthPort, err = publisher.publish(ctx, oauthPublishedService, servicePublishingStrategy(hcp, oauthPublishedService))
 	if err != nil {
-		return status, fmt.Errorf("error creating service for oauth: %w", err)
+		return status, fmt.Errorf("failed to publish oauth service: %w", err)
 	}
-
-	r.Log.Info("Creating router shard")
-	if err := createIngressController(r, hcp, targetNamespace, baseDomain); err != nil {
-		return status, fmt.Errorf("cannot create router shard: %w", err)
-	}
-
-	r.Log.Info("Creating ignition provider route")
-	ignitionRoute := createIgnitionServerRoute(targetNamespace)
-	ignitionRoute.OwnerReferences = ensureHCPOwnerRef(hcp, ignitionRoute.OwnerReferences)
-	if err := r.Create(ctx, ignitionRoute); err != nil && !apierrors.IsAlreadyExists(err) {
-		return status, fmt.Errorf("failed to create ignition route: %w", err)
-	}
-
-	apiAddress, err := getLoadBalancerServiceAddress(r, ctx, client.ObjectKeyFromObject(apiService))
+	status.OAuthAddress, err = ensureDNSName(ctx, r.DNSProvider, r.DNSResolver, hcp.Spec.OAuthDNSName, status.OAuthAddress)
 	if err != nil {
-		return status, fmt.Errorf("failed to get service: %w", err)
+		return status, err
 	}
-	status.APIAddress = apiAddress
 
-	oauthAddress, err := getLoadBalancerServiceAddress(r, ctx, client.ObjectKeyFromObject(oauthService))
-	if err != nil {
-		return status, fmt.Errorf("failed to get service: %w", err)
+	if ingressStrategy(hcp) != hyperv1.GuestLoadBalancer {
+		r.Log.Info("Creating router shard")
+		if err := createIngressController(r, hcp, targetNamespace, baseDomain); err != nil {
+			return status, fmt.Errorf("cannot create router shard: %w", err)
+		}
 	}
-	status.OAuthAddress = oauthAddress
-
-	vpnAddress, err := getLoadBalancerServiceAddress(r, ctx, client.ObjectKeyFromObject(vpnService))
-	if err != nil {
-		return status, fmt.Errorf("failed to get service: %w", err)
+	if err := ensureIngressDNSRecord(ctx, r.DNSProvider, hcp, baseDomain); err != nil {
+		return status, err
 	}
-	status.VPNAddress = vpnAddress
 
-	ignitionAddress, err := getRouteAddress(r, ctx, client.ObjectKeyFromObject(ignitionRoute))
+	r.Log.Info("Publishing ignition provider")
+	status.IgnitionProviderAddress, status.IgnitionProviderPort, err = publisher.publish(ctx, ignitionPublishedService, servicePublishingStrategy(hcp, ignitionPublishedService))
 	if err != nil {
-		return status, fmt.Errorf("failed get get route address: %w", err)
+		return status, fmt.Errorf("failed to publish ignition provider: %w", err)
 	}
-	status.IgnitionProviderAddress = ignitionAddress
 
 	status.OpenShiftAPIAddress = openshiftAPIService.Spec.ClusterIP
 	status.OauthAPIServerAddress = oauthAPIService.Spec.ClusterIP
+	status.AppliedObjects = publisher.applied
 
 	return status, nil
 }
 
 func (r *HostedControlPlaneReconciler) ensureControlPlane(ctx context.Context, hcp *hyperv1.HostedControlPlane, infraStatus InfrastructureStatus, releaseImage *releaseinfo.ReleaseImage) error {
 	r.Log.Info("ensuring control plane for cluster", "cluster", hcp.Name)
-
 	targetNamespace := hcp.GetName()
 	version, err := semver.Parse(releaseImage.Version())
 	if err != nil {
 		return fmt.Errorf("cannot parse release version (%s): %v", releaseImage.Version(), err)
 	}
+	if err := validateHostedControlPlane(hcp, version); err != nil {
+		return err
+	}
 
 	// Create the configmap with the pull secret for the guest cluster
 	var pullSecret corev1.Secret
@@ -417,57 +484,62 @@ func (r *HostedControlPlaneReconciler) ensureControlPlane(ctx context.Context, h
 	if err != nil {
 		return fmt.Errorf("failed to create pull secret manifest for target cluster: %w", err)
 	}
-	if err := r.Create(ctx, targetPullSecret); err != nil && !apierrors.IsAlreadyExists(err) {
-		return fmt.Errorf("failed to generate targetPullSecret: %v", err)
+	applied := append([]hyperv1.AppliedObject(nil), infraStatus.AppliedObjects...)
+	appliedObject, err := applyObject(ctx, r, targetPullSecret)
+	if err != nil {
+		return fmt.Errorf("failed to apply targetPullSecret: %w", err)
 	}
+	applied = append(applied, appliedObject)
 
-	manifests, err := r.generateControlPlaneManifests(ctx, hcp, infraStatus, releaseImage)
+	objects, err := r.generateControlPlaneObjects(ctx, hcp, infraStatus, releaseImage)
 	if err != nil {
 		return err
 	}
-
-	// Create oauth branding manifest because it cannot be applied
-	manifestBytes := manifests[oauthBrandingManifest]
-	manifestObj := &unstructured.Unstructured{}
-	if err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(string(manifestBytes)), 100).Decode(manifestObj); err != nil {
-		return fmt.Errorf("failed to decode manifest %s: %w", oauthBrandingManifest, err)
+	appliedObjects, err := applyObjects(ctx, r, r.Log, objects)
+	applied = append(applied, appliedObjects...)
+	if err != nil {
+		return err
 	}
-	manifestObj.SetNamespace(targetNamespace)
-	if err = r.Create(context.TODO(), manifestObj); err != nil {
-		if !apierrors.IsAlreadyExists(err) {
-			return fmt.Errorf("failed to apply manifest %s: %w", oauthBrandingManifest, err)
-		}
+	r.Log.Info("successfully applied all objects")
+	if err := r.pruneIdentityProviderObjects(ctx, hcp, objects); err != nil {
+		return err
 	}
 
-	if err := applyManifests(ctx, r, r.Log, targetNamespace, manifests); err != nil {
+	proxy, err := r.proxyConfig(ctx, hcp)
+	if err != nil {
 		return err
 	}
-	r.Log.Info("successfully applied all manifests")
-
-	userDataSecret := generateUserDataSecret(hcp.GetName(), hcp.GetNamespace(), infraStatus.IgnitionProviderAddress, version)
-	if err := r.Create(ctx, userDataSecret); err != nil && !apierrors.IsAlreadyExists(err) {
+	userDataSecret, err := generateUserDataSecret(hcp.GetName(), hcp.GetNamespace(), hostPort(infraStatus.IgnitionProviderAddress, infraStatus.IgnitionProviderPort, 80), version, proxy)
+	if err != nil {
 		return fmt.Errorf("failed to generate user data secret: %w", err)
 	}
 	userDataSecret.OwnerReferences = ensureHCPOwnerRef(hcp, userDataSecret.OwnerReferences)
-
-	kubeadminPassword, err := generateKubeadminPassword()
+	appliedObject, err = applyObject(ctx, r, userDataSecret)
 	if err != nil {
-		return fmt.Errorf("failed to generate kubeadmin password: %w", err)
+		return fmt.Errorf("failed to apply user data secret: %w", err)
 	}
+	applied = append(applied, appliedObject)
 
-	kubeadminPasswordTargetSecret, err := generateKubeadminPasswordTargetSecret(r.Scheme(), kubeadminPassword, targetNamespace)
-	if err != nil {
-		return fmt.Errorf("failed to create kubeadmin secret manifest for target cluster: %w", err)
-	}
-	kubeadminPasswordTargetSecret.OwnerReferences = ensureHCPOwnerRef(hcp, kubeadminPasswordTargetSecret.OwnerReferences)
-	if err := r.Create(ctx, kubeadminPasswordTargetSecret); err != nil && !apierrors.IsAlreadyExists(err) {
-		return fmt.Errorf("failed to generate kubeadminPasswordTargetSecret: %w", err)
+	if err := r.deleteHostedClusterConfigOperatorClusterRole(ctx, hcp); err != nil {
+		return err
 	}
 
-	kubeadminPasswordSecret := generateKubeadminPasswordSecret(targetNamespace, kubeadminPassword)
-	kubeadminPasswordSecret.OwnerReferences = ensureHCPOwnerRef(hcp, kubeadminPasswordSecret.OwnerReferences)
-	if err := r.Create(ctx, kubeadminPasswordSecret); err != nil && !apierrors.IsAlreadyExists(err) {
-		return fmt.Errorf("failed to generate kubeadminPasswordSecret: %w", err)
+	if hcp.Spec.OAuth.Kubeadmin.Disabled {
+		if err := r.deleteKubeadminPassword(ctx, hcp); err != nil {
+			return err
+		}
+	} else {
+		kubeadminPasswordSecret, kubeadminPasswordTargetSecret, err := r.kubeadminPasswordSecrets(ctx, hcp)
+		if err != nil {
+			return err
+		}
+		for _, secret := range []client.Object{kubeadminPasswordTargetSecret, kubeadminPasswordSecret} {
+			appliedObject, err = applyObject(ctx, r, secret)
+			if err != nil {
+				return fmt.Errorf("failed to apply %s: %w", secret.GetName(), err)
+			}
+			applied = append(applied, appliedObject)
+		}
 	}
 
 	pkiSecret := &corev1.Secret{
@@ -486,23 +558,90 @@ func (r *HostedControlPlaneReconciler) ensureControlPlane(ctx context.Context, h
 		return fmt.Errorf("failed to create kubeconfig secret manifest for management cluster: %w", err)
 	}
 	kubeconfigSecret.OwnerReferences = ensureHCPOwnerRef(hcp, kubeconfigSecret.OwnerReferences)
-	if err := r.Create(ctx, kubeconfigSecret); err != nil && !apierrors.IsAlreadyExists(err) {
-		return fmt.Errorf("failed to generate kubeconfigSecret: %w", err)
+	appliedObject, err = applyObject(ctx, r, kubeconfigSecret)
+	if err != nil {
+		return fmt.Errorf("failed to apply kubeconfigSecret: %w", err)
 	}
+	applied = append(applied, appliedObject)
+	recordAppliedObjects(hcp, applied)
 
-	baseDomain, err := clusterBaseDomain(r.Client, ctx, hcp.Name)
+	if hcp.Status.Ready {
+		return nil
+	}
+	baseDomain, err := clusterBaseDomain(r.Client, ctx, hcp)
 	if err != nil {
 		return fmt.Errorf("couldn't determine cluster base domain  name: %w", err)
 	}
-	r.Log.Info(fmt.Sprintf("Cluster API URL: %s", fmt.Sprintf("https://%s:%d", infraStatus.APIAddress, APIServerPort)))
+	r.Log.Info(fmt.Sprintf("Cluster API URL: %s", fmt.Sprintf("https://%s:%d", infraStatus.APIAddress, infraStatus.APIPort)))
 	r.Log.Info(fmt.Sprintf("Kubeconfig is available in secret admin-kubeconfig in the %s namespace", hcp.GetNamespace()))
 	r.Log.Info(fmt.Sprintf("Console URL:  %s", fmt.Sprintf("https://console-openshift-console.%s", fmt.Sprintf("apps.%s", baseDomain))))
-	r.Log.Info(fmt.Sprintf("kubeadmin password is available in secret %q in the %s namespace", "kubeadmin-password", targetNamespace))
+	if !hcp.Spec.OAuth.Kubeadmin.Disabled {
+		r.Log.Info(fmt.Sprintf("kubeadmin password is available in secret %q in the %s namespace", "kubeadmin-password", targetNamespace))
+	}
 
 	return nil
 }
 
-func (r *HostedControlPlaneReconciler) generateControlPlaneManifests(ctx context.Context, hcp *hyperv1.HostedControlPlane, infraStatus InfrastructureStatus, releaseImage *releaseinfo.ReleaseImage) (map[string][]byte, error) {
+// generateControlPlaneObjects returns the objects of a control plane, both
+// those decoded from rendered manifests and those built by typed builders.
+func (r *HostedControlPlaneReconciler) generateControlPlaneObjects(ctx context.Context, hcp *hyperv1.HostedControlPlane, infraStatus InfrastructureStatus, releaseImage *releaseinfo.ReleaseImage) ([]client.Object, error) {
+	manifests, objects, err := r.generateControlPlaneManifests(ctx, hcp, infraStatus, releaseImage)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := manifestObjects(hcp.GetName(), manifests)
+	if err != nil {
+		return nil, err
+	}
+	return append(decoded, objects...), nil
+}
+
+// generateControlPlaneManifests returns the manifests rendered from templates
+// and the objects built by typed builders of a control plane.
+func (r *HostedControlPlaneReconciler) generateControlPlaneManifests(ctx context.Context, hcp *hyperv1.HostedControlPlane, infraStatus InfrastructureStatus, releaseImage *releaseinfo.ReleaseImage) (map[string][]byte, []client.Object, error) {
+	cc, err := r.componentContext(ctx, hcp, infraStatus, releaseImage)
+	if err != nil {
+		return nil, nil, err
+	}
+	var pullSecret corev1.Secret
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: hcp.GetNamespace(), Name: hcp.Spec.PullSecret.Name}, &pullSecret); err != nil {
+		return nil, nil, fmt.Errorf("failed to get pull secret %s: %w", hcp.Spec.PullSecret.Name, err)
+	}
+	pullSecretData, hasPullSecretData := pullSecret.Data[".dockerconfigjson"]
+	if !hasPullSecretData {
+		return nil, nil, fmt.Errorf("pull secret %s is missing the .dockerconfigjson key", hcp.Spec.PullSecret.Name)
+	}
+	manifests, err := render.RenderClusterManifests(cc.params, releaseImage, pullSecretData, cc.pkiData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to render hypershift manifests for cluster: %w", err)
+	}
+	idpManifests, err := cc.identityProviders.manifests()
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, manifest := range idpManifests {
+		manifests[name] = manifest
+	}
+	auditManifests, err := cc.audit.manifests()
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, manifest := range auditManifests {
+		manifests[name] = manifest
+	}
+	if err := scheduling.ApplyToManifests(manifests, hcp.Spec.ControlPlaneScheduling); err != nil {
+		return nil, nil, err
+	}
+	objects, err := componentObjects(cc, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return manifests, objects, nil
+}
+
+// componentContext returns the inputs the components of a control plane are
+// built from. The PKI of the control plane is generated the first time.
+func (r *HostedControlPlaneReconciler) componentContext(ctx context.Context, hcp *hyperv1.HostedControlPlane, infraStatus InfrastructureStatus, releaseImage *releaseinfo.ReleaseImage) (*componentContext, error) {
 	targetNamespace := hcp.GetName()
 
 	var sshKeySecret corev1.Secret
@@ -514,11 +653,28 @@ func (r *HostedControlPlaneReconciler) generateControlPlaneManifests(ctx context
 	if !hasSSHKeyData {
 		return nil, fmt.Errorf("SSH key secret secret %s is missing the id_rsa.pub key", hcp.Spec.SSHKey.Name)
 	}
-	baseDomain, err := clusterBaseDomain(r.Client, ctx, hcp.Name)
+	baseDomain, err := clusterBaseDomain(r.Client, ctx, hcp)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't determine cluster base domain  name: %w", err)
 	}
 
+	idpConfig, err := r.identityProviders(ctx, hcp)
+	if err != nil {
+		return nil, err
+	}
+	audit, err := r.auditConfig(ctx, hcp)
+	if err != nil {
+		return nil, err
+	}
+	encryption, err := r.secretEncryptionConfig(ctx, hcp)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := r.proxyConfig(ctx, hcp)
+	if err != nil {
+		return nil, err
+	}
+
 	var clusterInfra configv1.Infrastructure
 	if err := r.Get(context.Background(), client.ObjectKey{Name: "cluster"}, &clusterInfra); err != nil {
 		return nil, fmt.Errorf("failed to get cluster infra: %w", err)
@@ -527,30 +683,45 @@ func (r *HostedControlPlaneReconciler) generateControlPlaneManifests(ctx context
 	params := render.NewClusterParams()
 	params.Namespace = targetNamespace
 	params.ExternalAPIDNSName = infraStatus.APIAddress
-	params.ExternalAPIPort = APIServerPort
-	params.ExternalAPIAddress = DefaultAPIServerIPAddress
+	params.ExternalAPIPort = uint(infraStatus.APIPort)
+	params.PrivateAPIDNSName = infraStatus.PrivateAPIAddress
+	params.PrivateAPIPort = uint(infraStatus.PrivateAPIPort)
+	params.ExternalAPIAddress = apiServerAdvertiseAddress(hcp)
 	params.ExternalOpenVPNAddress = infraStatus.VPNAddress
-	params.ExternalOpenVPNPort = 1194
+	params.ExternalOpenVPNPort = uint(infraStatus.VPNPort)
+	params.KonnectivityEnabled = hcp.Spec.Tunnel.Type == hyperv1.KonnectivityTunnel
+	params.ExternalKonnectivityAddress = infraStatus.KonnectivityAddress
+	params.ExternalKonnectivityPort = uint(infraStatus.KonnectivityPort)
 	params.ExternalOauthDNSName = infraStatus.OAuthAddress
-	params.ExternalOauthPort = externalOauthPort
-	params.ServiceCIDR = hcp.Spec.ServiceCIDR
-	params.PodCIDR = hcp.Spec.PodCIDR
+	params.ExternalOauthPort = uint(infraStatus.OAuthPort)
 	params.ReleaseImage = hcp.Spec.ReleaseImage
+	params.ReleaseImagePullSpec = releaseImagePullSpec(hcp)
+	params.ImageContentSources = imageContentSources(hcp)
 	params.IngressSubdomain = fmt.Sprintf("apps.%s", baseDomain)
 	params.OpenShiftAPIClusterIP = infraStatus.OpenShiftAPIAddress
 	params.OauthAPIClusterIP = infraStatus.OauthAPIServerAddress
 	params.BaseDomain = baseDomain
+	params.PublicZoneID = hcp.Spec.DNS.PublicZoneID
+	params.PrivateZoneID = hcp.Spec.DNS.PrivateZoneID
 	params.MachineConfigServerAddress = infraStatus.IgnitionProviderAddress
 	params.CloudProvider = string(clusterInfra.Status.PlatformStatus.Type)
 	params.PlatformType = string(clusterInfra.Status.PlatformStatus.Type)
 	params.InternalAPIPort = APIServerPort
 	params.EtcdClientName = "etcd-client"
-	params.NetworkType = "OpenShiftSDN"
-	params.ImageRegistryHTTPSecret = generateImageRegistrySecret()
+	setNetworkingParams(hcp, params)
 	params.APIAvailabilityPolicy = render.SingleReplica
 	params.ControllerAvailabilityPolicy = render.SingleReplica
 	params.SSHKey = string(sshKeyData)
 	params.HypershiftOperatorControllers = []string{"route-sync", "auto-approver", "kubeadmin-password", "node"}
+	params.HostedClusterConfigOperatorControllers = hostedClusterConfigOperatorControllers(ingressStrategy(hcp))
+	params.GuestIngressLoadBalancer = ingressStrategy(hcp) == hyperv1.GuestLoadBalancer
+	audit.setParams(params)
+	encryption.setParams(params)
+	setFeatureGateParams(hcp.Spec.FeatureGates, params)
+	if hcp.Spec.ControlPlaneScheduling != nil {
+		params.MasterPriorityClass = hcp.Spec.ControlPlaneScheduling.PriorityClassName
+	}
+	proxy.setParams(params)
 
 	// Generate PKI data just once and store it in a secret. PKI generation isn't
 	// deterministic and shouldn't be performed with every reconcile, otherwise
@@ -572,29 +743,43 @@ func (r *HostedControlPlaneReconciler) generateControlPlaneManifests(ctx context
 	} else {
 		r.Log.Info("using existing pki secret")
 	}
+	pkiParams := &render.PKIParams{
+		ExternalAPIAddress:          infraStatus.APIAddress,
+		NodeInternalAPIServerIP:     apiServerAdvertiseAddress(hcp),
+		ExternalAPIPort:             uint(infraStatus.APIPort),
+		PrivateAPIAddress:           infraStatus.PrivateAPIAddress,
+		PrivateAPIPort:              uint(infraStatus.PrivateAPIPort),
+		InternalAPIPort:             APIServerPort,
+		ServiceCIDR:                 hcp.Spec.ServiceCIDR,
+		ExternalOauthAddress:        infraStatus.OAuthAddress,
+		IngressSubdomain:            "apps." + baseDomain,
+		MachineConfigServerAddress:  infraStatus.IgnitionProviderAddress,
+		ExternalOpenVPNAddress:      infraStatus.VPNAddress,
+		ExternalKonnectivityAddress: infraStatus.KonnectivityAddress,
+		Namespace:                   targetNamespace,
+	}
 	if needsPkiSecret {
-		pkiParams := &render.PKIParams{
-			ExternalAPIAddress:         infraStatus.APIAddress,
-			NodeInternalAPIServerIP:    DefaultAPIServerIPAddress,
-			ExternalAPIPort:            APIServerPort,
-			InternalAPIPort:            APIServerPort,
-			ServiceCIDR:                hcp.Spec.ServiceCIDR,
-			ExternalOauthAddress:       infraStatus.OAuthAddress,
-			IngressSubdomain:           "apps." + baseDomain,
-			MachineConfigServerAddress: infraStatus.IgnitionProviderAddress,
-			ExternalOpenVPNAddress:     infraStatus.VPNAddress,
-			Namespace:                  targetNamespace,
-		}
 		r.Log.Info("generating PKI secret data")
 		data, err := pki.GeneratePKI(pkiParams)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate PKI data: %w", err)
 		}
 		pkiSecret.Data = data
-		if err := r.Create(ctx, pkiSecret); err != nil {
-			return nil, fmt.Errorf("failed to create pki secret: %w", err)
+		pkiSecret.Annotations = map[string]string{
+			kubeconfigSignerRotationAnnotation: hcp.Spec.KubeconfigSignerRotation,
+			pkiEndpointsAnnotation:             pkiEndpoints(pkiParams),
+		}
+		if err := r.applyPKISecret(ctx, pkiSecret); err != nil {
+			return nil, err
 		}
 		r.Log.Info("created pki secret")
+	} else {
+		if _, err := r.rotateKubeconfigSignerIfNeeded(ctx, hcp, pkiSecret); err != nil {
+			return nil, err
+		}
+		if _, err := r.regenerateEndpointArtifactsIfNeeded(ctx, pkiSecret, pkiParams); err != nil {
+			return nil, err
+		}
 	}
 
 	caBytes, hasData := pkiSecret.Data["combined-ca.crt"]
@@ -603,64 +788,37 @@ func (r *HostedControlPlaneReconciler) generateControlPlaneManifests(ctx context
 	}
 	params.OpenshiftAPIServerCABundle = base64.StdEncoding.EncodeToString(caBytes)
 	params.OauthAPIServerCABundle = params.OpenshiftAPIServerCABundle
+	params.ImageRegistryHTTPSecret = imageRegistryHTTPSecret(pkiSecret.Data)
 
-	var pullSecret corev1.Secret
-	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: hcp.GetNamespace(), Name: hcp.Spec.PullSecret.Name}, &pullSecret); err != nil {
-		return nil, fmt.Errorf("failed to get pull secret %s: %w", hcp.Spec.PullSecret.Name, err)
-	}
-	pullSecretData, hasPullSecretData := pullSecret.Data[".dockerconfigjson"]
-	if !hasPullSecretData {
-		return nil, fmt.Errorf("pull secret %s is missing the .dockerconfigjson key", hcp.Spec.PullSecret.Name)
-	}
-	manifests, err := render.RenderClusterManifests(params, releaseImage, pullSecretData, pkiSecret.Data)
+	versions, err := releaseImage.ComponentVersions()
 	if err != nil {
-		return nil, fmt.Errorf("failed to render hypershift manifests for cluster: %w", err)
+		return nil, fmt.Errorf("failed to get component versions of release: %w", err)
 	}
-	return manifests, nil
-}
 
-func createKubeAPIServerService(client client.Client, hcp *hyperv1.HostedControlPlane, namespace string) (*corev1.Service, error) {
-	svc := &corev1.Service{}
-	svc.Namespace = namespace
-	svc.Name = kubeAPIServerServiceName
-	svc.Spec.Selector = map[string]string{"app": "kube-apiserver"}
-	svc.Spec.Type = corev1.ServiceTypeLoadBalancer
-	svc.Spec.Ports = []corev1.ServicePort{
-		{
-			Port:       6443,
-			Protocol:   corev1.ProtocolTCP,
-			TargetPort: intstr.FromInt(6443),
-		},
+	cc := &componentContext{
+		hcp:               hcp,
+		params:            params,
+		images:            mirrors.MirroredImages(releaseImage.ComponentImages(), params.ImageContentSources),
+		versions:          versions,
+		pkiData:           pkiSecret.Data,
+		identityProviders: idpConfig,
+		audit:             audit,
 	}
-	svc.OwnerReferences = ensureHCPOwnerRef(hcp, svc.OwnerReferences)
-	if err := client.Create(context.TODO(), svc); err != nil {
-		if !apierrors.IsAlreadyExists(err) {
-			return nil, fmt.Errorf("failed to create api server service: %w", err)
-		}
+	cc.images[releaseImageComponent] = params.ReleaseImagePullSpec
+	// The secrets generated at install are kept when a control plane that is
+	// ready is applied again, while all of them are deleted with it
+	if !hcp.Status.Ready || !hcp.DeletionTimestamp.IsZero() {
+		cc.random = crand.Reader
 	}
-	return svc, nil
+	return cc, nil
 }
 
-func createVPNServerService(client client.Client, hcp *hyperv1.HostedControlPlane, namespace string) (*corev1.Service, error) {
-	svc := &corev1.Service{}
-	svc.Namespace = namespace
-	svc.Name = vpnServiceName
-	svc.Spec.Selector = map[string]string{"app": "openvpn-server"}
-	svc.Spec.Type = corev1.ServiceTypeLoadBalancer
-	svc.Spec.Ports = []corev1.ServicePort{
-		{
-			Port:       1194,
-			Protocol:   corev1.ProtocolTCP,
-			TargetPort: intstr.FromInt(1194),
-		},
+// managementPlatform returns the platform of the management cluster.
+func managementPlatform(infra *configv1.Infrastructure) configv1.PlatformType {
+	if infra.Status.PlatformStatus != nil {
+		return infra.Status.PlatformStatus.Type
 	}
-	svc.OwnerReferences = ensureHCPOwnerRef(hcp, svc.OwnerReferences)
-	if err := client.Create(context.TODO(), svc); err != nil {
-		if !apierrors.IsAlreadyExists(err) {
-			return nil, fmt.Errorf("failed to create vpn server service: %w", err)
-		}
-	}
-	return svc, nil
+	return infra.Status.Platform
 }
 
 func createOpenshiftService(c client.Client, hcp *hyperv1.HostedControlPlane, namespace string) (*corev1.Service, error) {
@@ -678,12 +836,8 @@ func createOpenshiftService(c client.Client, hcp *hyperv1.HostedControlPlane, na
 		},
 	}
 	svc.OwnerReferences = ensureHCPOwnerRef(hcp, svc.OwnerReferences)
-	if err := c.Create(context.TODO(), svc); err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			return svc, c.Get(context.TODO(), client.ObjectKeyFromObject(svc), svc)
-		} else {
-			return nil, fmt.Errorf("failed to create openshift service: %w", err)
-		}
+	if _, err := applyObject(context.TODO(), c, svc); err != nil {
+		return nil, fmt.Errorf("failed to apply %s service: %w", svc.Name, err)
 	}
 	return svc, nil
 }
@@ -703,34 +857,8 @@ func createOauthAPIService(c client.Client, hcp *hyperv1.HostedControlPlane, nam
 		},
 	}
 	svc.OwnerReferences = ensureHCPOwnerRef(hcp, svc.OwnerReferences)
-	if err := c.Create(context.TODO(), svc); err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			return svc, c.Get(context.TODO(), client.ObjectKeyFromObject(svc), svc)
-		} else {
-			return nil, fmt.Errorf("failed to create openshift service: %w", err)
-		}
-	}
-	return svc, nil
-}
-
-func createOauthService(client client.Client, hcp *hyperv1.HostedControlPlane, namespace string) (*corev1.Service, error) {
-	svc := &corev1.Service{}
-	svc.Namespace = namespace
-	svc.Name = oauthServiceName
-	svc.Spec.Selector = map[string]string{"app": "oauth-openshift"}
-	svc.Spec.Type = corev1.ServiceTypeLoadBalancer
-	svc.Spec.Ports = []corev1.ServicePort{
-		{
-			Name:       "https",
-			Port:       8443,
-			Protocol:   corev1.ProtocolTCP,
-			TargetPort: intstr.FromInt(6443),
-		},
-	}
-	svc.OwnerReferences = ensureHCPOwnerRef(hcp, svc.OwnerReferences)
-	err := client.Create(context.TODO(), svc)
-	if err != nil && !apierrors.IsAlreadyExists(err) {
-		return nil, fmt.Errorf("failed to create oauth service: %w", err)
+	if _, err := applyObject(context.TODO(), c, svc); err != nil {
+		return nil, fmt.Errorf("failed to apply %s service: %w", svc.Name, err)
 	}
 	return svc, nil
 }
@@ -754,6 +882,28 @@ func ensureVPNSCC(c client.Client, hcp *hyperv1.HostedControlPlane, namespace st
 	return nil
 }
 
+// hostedClusterConfigOperatorControllers returns the controllers run by the
+// hosted cluster config operator. Guest routes are only mirrored to the
+// management cluster when they are served by a management router shard,
+// otherwise the address of the guest's own router is reported instead.
+func hostedClusterConfigOperatorControllers(strategy hyperv1.IngressStrategyType) []string {
+	controllers := []string{
+		"controller-manager-ca",
+		"cluster-operator",
+		"auto-approver",
+		"kubeadmin-password",
+		"cluster-version",
+		"kubelet-serving-ca",
+		"openshift-apiserver-monitor",
+		"infrastatus",
+		"node",
+	}
+	if strategy == hyperv1.GuestLoadBalancer {
+		return append(controllers, "ingress-status")
+	}
+	return append(controllers, "route-sync")
+}
+
 func createIngressController(c client.Client, hcp *hyperv1.HostedControlPlane, name string, parentDomain string) error {
 	// First ensure that the default ingress controller doesn't use routes generated for hypershift clusters
 	err := ensureDefaultIngressControllerSelector(c)
@@ -775,8 +925,8 @@ func createIngressController(c client.Client, hcp *hyperv1.HostedControlPlane, n
 		},
 	}
 	ic.OwnerReferences = ensureHCPOwnerRef(hcp, ic.OwnerReferences)
-	if err := c.Create(context.TODO(), ic); err != nil && !apierrors.IsAlreadyExists(err) {
-		return fmt.Errorf("failed to create ingress controller for %s: %w", name, err)
+	if _, err := applyObject(context.TODO(), c, ic); err != nil {
+		return fmt.Errorf("failed to apply ingress controller for %s: %w", name, err)
 	}
 	return nil
 }
@@ -810,21 +960,6 @@ func ensureDefaultIngressControllerSelector(c client.Client) error {
 	return nil
 }
 
-func createIgnitionServerRoute(namespace string) *routev1.Route {
-	return &routev1.Route{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: namespace,
-			Name:      "ignition-provider",
-		},
-		Spec: routev1.RouteSpec{
-			To: routev1.RouteTargetReference{
-				Kind: "Service",
-				Name: "machine-config-server",
-			},
-		},
-	}
-}
-
 func getLoadBalancerServiceAddress(c client.Client, ctx context.Context, key client.ObjectKey) (string, error) {
 	svc := &corev1.Service{}
 	if err := c.Get(ctx, key, svc); err != nil {
@@ -854,38 +989,19 @@ func getRouteAddress(c client.Client, ctx context.Context, key client.ObjectKey)
 	return addr, nil
 }
 
-func deleteManifests(ctx context.Context, c client.Client, log logr.Logger, namespace string, manifests map[string][]byte) error {
-	// Use server side apply for manifestss
-	applyErrors := []error{}
-	for manifestName, manifestBytes := range manifests {
-		if excludeManifests.Has(manifestName) {
-			continue
-		}
-		obj := &unstructured.Unstructured{}
-		if err := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifestBytes), 100).Decode(obj); err != nil {
-			applyErrors = append(applyErrors, fmt.Errorf("failed to decode manifest %s: %w", manifestName, err))
-		}
-		obj.SetNamespace(namespace)
-		err := c.Delete(ctx, obj)
-		if err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
-			applyErrors = append(applyErrors, fmt.Errorf("failed to delete manifest %s: %w", manifestName, err))
-		} else {
-			log.Info("deleted manifest", "manifest", manifestName)
-		}
+// clusterBaseDomain returns the domain under which the cluster's DNS names
+// live, <name>.<baseDomain>. The base domain of the management cluster is used
+// when the HostedControlPlane doesn't specify one.
+func clusterBaseDomain(c client.Client, ctx context.Context, hcp *hyperv1.HostedControlPlane) (string, error) {
+	if len(hcp.Spec.DNS.BaseDomain) > 0 {
+		return fmt.Sprintf("%s.%s", hcp.Name, hcp.Spec.DNS.BaseDomain), nil
 	}
-	if errs := errors.NewAggregate(applyErrors); errs != nil {
-		return fmt.Errorf("failed to delete some manifests: %w", errs)
-	}
-	return nil
-}
-
-func clusterBaseDomain(c client.Client, ctx context.Context, clusterName string) (string, error) {
 	var dnsConfig configv1.DNS
 	err := c.Get(ctx, client.ObjectKey{Name: "cluster"}, &dnsConfig)
 	if err != nil {
 		return "", fmt.Errorf("failed to get cluster dns config: %w", err)
 	}
-	return fmt.Sprintf("%s.%s", clusterName, dnsConfig.Spec.BaseDomain), nil
+	return fmt.Sprintf("%s.%s", hcp.Name, dnsConfig.Spec.BaseDomain), nil
 }
 
 func ensureHCPOwnerRef(hcp *hyperv1.HostedControlPlane, ownerReferences []metav1.OwnerReference) []metav1.OwnerReference {
@@ -914,32 +1030,31 @@ func generateTargetPullSecret(scheme *runtime.Scheme, data []byte, namespace str
 	return configMap, nil
 }
 
-func applyManifests(ctx context.Context, c client.Client, log logr.Logger, namespace string, manifests map[string][]byte) error {
-	// Use server side apply for manifestss
-	applyErrors := []error{}
-	for manifestName, manifestBytes := range manifests {
-		if excludeManifests.Has(manifestName) {
-			continue
-		}
-		obj := &unstructured.Unstructured{}
-		if err := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifestBytes), 100).Decode(obj); err != nil {
-			applyErrors = append(applyErrors, fmt.Errorf("failed to decode manifest %s: %w", manifestName, err))
-		}
-		obj.SetNamespace(namespace)
-		err := c.Patch(ctx, obj, client.RawPatch(types.ApplyPatchType, manifestBytes), client.ForceOwnership, client.FieldOwner("control-plane-operator"))
-		if err != nil {
-			applyErrors = append(applyErrors, fmt.Errorf("failed to apply manifest %s: %w", manifestName, err))
-		} else {
-			log.Info("applied manifest", "manifest", manifestName)
-		}
+// validateHostedControlPlane validates the spec of a control plane before it
+// is rendered for a release.
+func validateHostedControlPlane(hcp *hyperv1.HostedControlPlane, version semver.Version) error {
+	if err := validateKubeadmin(hcp); err != nil {
+		return err
+	}
+	if err := validateFeatureGates(hcp.Spec.FeatureGates, version); err != nil {
+		return err
 	}
-	if errs := errors.NewAggregate(applyErrors); errs != nil {
-		return fmt.Errorf("failed to apply some manifests: %w", errs)
+	if err := validateNetworking(hcp); err != nil {
+		return err
 	}
-	return nil
+	if err := validateProxy(hcp.Spec.Proxy); err != nil {
+		return err
+	}
+	if err := validateImageContentSources(hcp.Spec.ImageContentSources); err != nil {
+		return err
+	}
+	if err := validateComponentImageOverrides(hcp.Spec.ComponentImageOverrides); err != nil {
+		return err
+	}
+	return validateControlPlaneResources(hcp.Spec.ControlPlaneResources)
 }
 
-func generateUserDataSecret(name, namespace string, ignitionProviderAddr string, version semver.Version) *corev1.Secret {
+func generateUserDataSecret(name, namespace string, ignitionProviderAddr string, version semver.Version, proxy *proxyConfig) (*corev1.Secret, error) {
 	secret := &corev1.Secret{}
 	secret.Name = fmt.Sprintf("%s-user-data", name)
 	secret.Namespace = namespace
@@ -951,8 +1066,14 @@ func generateUserDataSecret(name, namespace string, ignitionProviderAddr string,
 	version.Pre = nil
 	version.Build = nil
 	if version.GTE(version46) {
-		userDataValue = []byte(fmt.Sprintf(`{"ignition":{"config":{"merge":[{"source":"http://%s/config/master","verification":{}}]},"security":{},"timeouts":{},"version":"3.1.0"},"networkd":{},"passwd":{},"storage":{},"systemd":{}}`, ignitionProviderAddr))
+		ignitionProxy, ignitionSecurity, err := ignitionProxyConfig(proxy)
+		if err != nil {
+			return nil, err
+		}
+		userDataValue = []byte(fmt.Sprintf(`{"ignition":{"config":{"merge":[{"source":"http://%s/config/master","verification":{}}]},"proxy":%s,"security":%s,"timeouts":{},"version":"3.1.0"},"networkd":{},"passwd":{},"storage":{},"systemd":{}}`, ignitionProviderAddr, ignitionProxy, ignitionSecurity))
 	} else {
+		// Ignition 2.2 has no proxy configuration, workers of older releases
+		// need direct access to the machine config server
 		userDataValue = []byte(fmt.Sprintf(`{"ignition":{"config":{"append":[{"source":"http://%s/config/master","verification":{}}]},"security":{},"timeouts":{},"version":"2.2.0"},"networkd":{},"passwd":{},"storage":{},"systemd":{}}`, ignitionProviderAddr))
 	}
 
@@ -960,7 +1081,7 @@ func generateUserDataSecret(name, namespace string, ignitionProviderAddr string,
 		"disableTemplating": disableTemplatingValue,
 		"value":             userDataValue,
 	}
-	return secret
+	return secret, nil
 }
 
 func generateKubeadminPassword() (string, error) {
@@ -1020,10 +1141,11 @@ func generateKubeadminPasswordTargetSecret(scheme *runtime.Scheme, password stri
 	return configMap, nil
 }
 
-func generateKubeadminPasswordSecret(namespace, password string) *corev1.Secret {
+func generateKubeadminPasswordSecret(namespace, password, rotationTrigger string) *corev1.Secret {
 	secret := &corev1.Secret{}
 	secret.Namespace = namespace
 	secret.Name = "kubeadmin-password"
+	secret.Annotations = map[string]string{kubeadminRotationTriggerAnnotation: rotationTrigger}
 	secret.Data = map[string][]byte{"password": []byte(password)}
 	return secret
 }
@@ -1036,8 +1158,11 @@ func generateKubeconfigSecret(name, namespace string, kubeconfigBytes []byte) (*
 	return secret, nil
 }
 
-func generateImageRegistrySecret() string {
-	num := make([]byte, 64)
-	rand.Read(num)
-	return hex.EncodeToString(num)
+// imageRegistryHTTPSecret derives the secret the image registry of the guest
+// cluster signs uploads with from the root CA key of the control plane, so
+// that it stays the same each time the control plane is applied.
+func imageRegistryHTTPSecret(pkiData map[string][]byte) string {
+	mac := hmac.New(sha256.New, pkiData["root-ca.key"])
+	mac.Write([]byte("image-registry-http-secret"))
+	return hex.EncodeToString(mac.Sum(nil))
 }
@@ -0,0 +1,390 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+const (
+	hostedClusterAnnotation          = "hypershift.openshift.io/cluster"
+	identityProvidersHashAnnotation  = "hypershift.openshift.io/identity-providers-hash"
+	identityProviderMountRoot        = "/etc/oauth-openshift-idp"
+	identityProviderVolumePrefix     = "idp-"
+	identityProviderResyncInterval   = 5 * time.Minute
+	oauthServerConfigMapName         = "oauth-openshift-config"
+	oauthServerConfigKey             = "config.yaml"
+	oauthServerDeploymentName        = "oauth-openshift"
+	oauthServerContainerName         = "openshift-oauthserver"
+	osinConfigAPIVersion             = "osin.config.openshift.io/v1"
+	identityProviderCAConfigMapKey   = "ca.crt"
+	openIDConfigurationDiscoveryPath = "/.well-known/openid-configuration"
+)
+
+// identityProviderConfig is the OAuth server configuration for the identity
+// providers of a HostedControlPlane.
+type identityProviderConfig struct {
+	// providers are the identity providers in OsinServerConfig format
+	providers []interface{}
+	// objects are copies of the Secrets and ConfigMaps referenced by the
+	// identity providers, in the control plane namespace
+	objects []client.Object
+	// volumes mount the copied objects into the OAuth server
+	volumes []render.OAuthVolume
+}
+
+// hash changes whenever the identity providers or the content of the objects
+// they reference change. It is used to roll out the OAuth server.
+func (c *identityProviderConfig) hash() (string, error) {
+	if len(c.providers) == 0 {
+		return "", nil
+	}
+	out, err := json.Marshal(struct {
+		Providers []interface{}
+		Objects   []client.Object
+	}{c.providers, c.objects})
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize identity providers: %w", err)
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(out)), nil
+}
+
+// manifests returns the copied objects as manifests to apply to the control
+// plane namespace.
+func (c *identityProviderConfig) manifests() (map[string][]byte, error) {
+	manifests := map[string][]byte{}
+	for _, obj := range c.objects {
+		out, err := json.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize %s: %w", obj.GetName(), err)
+		}
+		manifests[fmt.Sprintf("identity-provider-%s.json", obj.GetName())] = out
+	}
+	return manifests, nil
+}
+
+// identityProviders converts the identity providers of a HostedControlPlane
+// to OAuth server configuration. Secrets and ConfigMaps are read from the
+// namespace of the HostedCluster.
+func (r *HostedControlPlaneReconciler) identityProviders(ctx context.Context, hcp *hyperv1.HostedControlPlane) (*identityProviderConfig, error) {
+	b := &identityProviderBuilder{
+		client:    r.Client,
+		namespace: hcp.GetName(),
+		config:    &identityProviderConfig{},
+	}
+	if len(hcp.Spec.OAuth.IdentityProviders) == 0 {
+		return b.config, nil
+	}
+	sourceNamespace, err := hostedClusterNamespace(hcp)
+	if err != nil {
+		return nil, err
+	}
+	b.sourceNamespace = sourceNamespace
+	for i, idp := range hcp.Spec.OAuth.IdentityProviders {
+		provider, err := b.convert(ctx, i, idp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid identity provider %q: %w", idp.Name, err)
+		}
+		b.config.providers = append(b.config.providers, provider)
+	}
+	for _, obj := range b.config.objects {
+		obj.SetOwnerReferences(ensureHCPOwnerRef(hcp, obj.GetOwnerReferences()))
+	}
+	return b.config, nil
+}
+
+// pruneIdentityProviderObjects deletes the copies of Secrets and ConfigMaps
+// in the control plane namespace that are no longer referenced by the
+// identity providers of a HostedControlPlane, given the objects of the
+// control plane that were just applied.
+func (r *HostedControlPlaneReconciler) pruneIdentityProviderObjects(ctx context.Context, hcp *hyperv1.HostedControlPlane, objects []client.Object) error {
+	referenced := sets.NewString()
+	for _, obj := range objects {
+		referenced.Insert(obj.GetObjectKind().GroupVersionKind().Kind + "/" + obj.GetName())
+	}
+	// The copies are listed as unstructured objects, their content doesn't
+	// matter
+	for _, kind := range []string{"Secret", "ConfigMap"} {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind(kind + "List"))
+		if err := r.List(ctx, list, client.InNamespace(hcp.GetName())); err != nil {
+			return fmt.Errorf("failed to list %ss: %w", strings.ToLower(kind), err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			key := kind + "/" + obj.GetName()
+			if !strings.HasPrefix(obj.GetName(), identityProviderVolumePrefix) || !isOwnedByHCP(hcp, obj) || referenced.Has(key) {
+				continue
+			}
+			if err := r.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete %s: %w", key, err)
+			}
+			r.Log.Info("Removed unreferenced identity provider object", "object", key)
+			if err := r.forgetAppliedObject(hcp, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isOwnedByHCP returns whether an object is owned by a HostedControlPlane.
+func isOwnedByHCP(hcp *hyperv1.HostedControlPlane, obj client.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Kind == "HostedControlPlane" && ref.UID == hcp.UID {
+			return true
+		}
+	}
+	return false
+}
+
+// hostedClusterNamespace returns the namespace of the HostedCluster a
+// HostedControlPlane belongs to, which holds the Secrets and ConfigMaps
+// referenced by its spec.
+func hostedClusterNamespace(hcp *hyperv1.HostedControlPlane) (string, error) {
+	hostedCluster, ok := hcp.Annotations[hostedClusterAnnotation]
+	if !ok {
+		return "", fmt.Errorf("hosted control plane is missing the %s annotation", hostedClusterAnnotation)
+	}
+	return strings.Split(hostedCluster, string(types.Separator))[0], nil
+}
+
+type identityProviderBuilder struct {
+	client          client.Client
+	sourceNamespace string
+	namespace       string
+	config          *identityProviderConfig
+}
+
+func (b *identityProviderBuilder) convert(ctx context.Context, index int, idp configv1.IdentityProvider) (map[string]interface{}, error) {
+	mappingMethod := idp.MappingMethod
+	if len(mappingMethod) == 0 {
+		mappingMethod = configv1.MappingMethodClaim
+	}
+	// Password based identity providers can answer challenges from the CLI,
+	// the others redirect to a login page
+	challenge := false
+	provider := map[string]interface{}{
+		"apiVersion": osinConfigAPIVersion,
+	}
+	switch idp.Type {
+	case configv1.IdentityProviderTypeHTPasswd:
+		if idp.HTPasswd == nil {
+			return nil, fmt.Errorf("missing %s configuration", idp.Type)
+		}
+		file, err := b.secretFile(ctx, index, "file-data", idp.HTPasswd.FileData.Name, configv1.HTPasswdDataKey)
+		if err != nil {
+			return nil, err
+		}
+		provider["kind"] = "HTPasswdPasswordIdentityProvider"
+		provider["file"] = file
+		challenge = true
+	case configv1.IdentityProviderTypeLDAP:
+		if idp.LDAP == nil {
+			return nil, fmt.Errorf("missing %s configuration", idp.Type)
+		}
+		ca, _, err := b.caFile(ctx, index, idp.LDAP.CA.Name)
+		if err != nil {
+			return nil, err
+		}
+		provider["kind"] = "LDAPPasswordIdentityProvider"
+		provider["url"] = idp.LDAP.URL
+		provider["bindDN"] = idp.LDAP.BindDN
+		provider["insecure"] = idp.LDAP.Insecure
+		provider["ca"] = ca
+		provider["attributes"] = map[string]interface{}{
+			"id":                idp.LDAP.Attributes.ID,
+			"preferredUsername": idp.LDAP.Attributes.PreferredUsername,
+			"name":              idp.LDAP.Attributes.Name,
+			"email":             idp.LDAP.Attributes.Email,
+		}
+		if len(idp.LDAP.BindPassword.Name) > 0 {
+			file, err := b.secretFile(ctx, index, "bind-password", idp.LDAP.BindPassword.Name, configv1.BindPasswordKey)
+			if err != nil {
+				return nil, err
+			}
+			provider["bindPassword"] = map[string]interface{}{"file": file}
+		}
+		challenge = true
+	case configv1.IdentityProviderTypeGitHub:
+		if idp.GitHub == nil {
+			return nil, fmt.Errorf("missing %s configuration", idp.Type)
+		}
+		clientSecret, err := b.secretFile(ctx, index, "client-secret", idp.GitHub.ClientSecret.Name, configv1.ClientSecretKey)
+		if err != nil {
+			return nil, err
+		}
+		ca, _, err := b.caFile(ctx, index, idp.GitHub.CA.Name)
+		if err != nil {
+			return nil, err
+		}
+		provider["kind"] = "GitHubIdentityProvider"
+		provider["clientID"] = idp.GitHub.ClientID
+		provider["clientSecret"] = map[string]interface{}{"file": clientSecret}
+		provider["organizations"] = idp.GitHub.Organizations
+		provider["teams"] = idp.GitHub.Teams
+		provider["hostname"] = idp.GitHub.Hostname
+		provider["ca"] = ca
+	case configv1.IdentityProviderTypeOpenID:
+		if idp.OpenID == nil {
+			return nil, fmt.Errorf("missing %s configuration", idp.Type)
+		}
+		clientSecret, err := b.secretFile(ctx, index, "client-secret", idp.OpenID.ClientSecret.Name, configv1.ClientSecretKey)
+		if err != nil {
+			return nil, err
+		}
+		ca, caData, err := b.caFile(ctx, index, idp.OpenID.CA.Name)
+		if err != nil {
+			return nil, err
+		}
+		urls, err := discoverOpenIDURLs(ctx, idp.OpenID.Issuer, caData)
+		if err != nil {
+			return nil, err
+		}
+		provider["kind"] = "OpenIDIdentityProvider"
+		provider["clientID"] = idp.OpenID.ClientID
+		provider["clientSecret"] = map[string]interface{}{"file": clientSecret}
+		provider["ca"] = ca
+		provider["extraScopes"] = idp.OpenID.ExtraScopes
+		provider["extraAuthorizeParameters"] = idp.OpenID.ExtraAuthorizeParameters
+		provider["urls"] = urls
+		provider["claims"] = map[string]interface{}{
+			"id":                []string{configv1.UserIDClaim},
+			"preferredUsername": idp.OpenID.Claims.PreferredUsername,
+			"name":              idp.OpenID.Claims.Name,
+			"email":             idp.OpenID.Claims.Email,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported identity provider type %q", idp.Type)
+	}
+	return map[string]interface{}{
+		"name":          idp.Name,
+		"challenge":     challenge,
+		"login":         true,
+		"mappingMethod": string(mappingMethod),
+		"provider":      provider,
+	}, nil
+}
+
+// secretFile copies a key of a referenced Secret into the control plane
+// namespace and returns the path at which the OAuth server can read it.
+func (b *identityProviderBuilder) secretFile(ctx context.Context, index int, purpose, name, key string) (string, error) {
+	if len(name) == 0 {
+		return "", fmt.Errorf("a %s secret is required", purpose)
+	}
+	source := &corev1.Secret{}
+	if err := b.client.Get(ctx, client.ObjectKey{Namespace: b.sourceNamespace, Name: name}, source); err != nil {
+		return "", fmt.Errorf("failed to get secret %s: %w", name, err)
+	}
+	data, ok := source.Data[key]
+	if !ok {
+		return "", fmt.Errorf("secret %s is missing the %s key", name, key)
+	}
+	volumeName := fmt.Sprintf("%s%d-%s", identityProviderVolumePrefix, index, purpose)
+	secret := &corev1.Secret{}
+	secret.APIVersion = "v1"
+	secret.Kind = "Secret"
+	secret.Namespace = b.namespace
+	secret.Name = volumeName
+	secret.Type = corev1.SecretTypeOpaque
+	secret.Data = map[string][]byte{key: data}
+	b.config.objects = append(b.config.objects, secret)
+	return b.mount(index, render.OAuthVolume{Name: volumeName, SecretName: volumeName}, key), nil
+}
+
+// caFile copies a referenced CA bundle ConfigMap into the control plane
+// namespace and returns the path at which the OAuth server can read it along
+// with the bundle. The CA is optional, nothing is returned without one.
+func (b *identityProviderBuilder) caFile(ctx context.Context, index int, name string) (string, []byte, error) {
+	if len(name) == 0 {
+		return "", nil, nil
+	}
+	source := &corev1.ConfigMap{}
+	if err := b.client.Get(ctx, client.ObjectKey{Namespace: b.sourceNamespace, Name: name}, source); err != nil {
+		return "", nil, fmt.Errorf("failed to get configmap %s: %w", name, err)
+	}
+	data, ok := source.Data[identityProviderCAConfigMapKey]
+	if !ok {
+		return "", nil, fmt.Errorf("configmap %s is missing the %s key", name, identityProviderCAConfigMapKey)
+	}
+	volumeName := fmt.Sprintf("%s%d-ca", identityProviderVolumePrefix, index)
+	configMap := &corev1.ConfigMap{}
+	configMap.APIVersion = "v1"
+	configMap.Kind = "ConfigMap"
+	configMap.Namespace = b.namespace
+	configMap.Name = volumeName
+	configMap.Data = map[string]string{identityProviderCAConfigMapKey: data}
+	b.config.objects = append(b.config.objects, configMap)
+	return b.mount(index, render.OAuthVolume{Name: volumeName, ConfigMapName: volumeName}, identityProviderCAConfigMapKey), []byte(data), nil
+}
+
+func (b *identityProviderBuilder) mount(index int, volume render.OAuthVolume, key string) string {
+	volume.MountPath = path.Join(identityProviderMountRoot, volume.Name)
+	b.config.volumes = append(b.config.volumes, volume)
+	return path.Join(volume.MountPath, key)
+}
+
+// discoverOpenIDURLs looks up the endpoints of an OpenID issuer, which the
+// OAuth server needs to be configured with explicitly.
+func discoverOpenIDURLs(ctx context.Context, issuer string, caData []byte) (map[string]interface{}, error) {
+	tlsConfig := &tls.Config{}
+	if len(caData) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("no certificates found in the CA bundle")
+		}
+	}
+	httpClient := &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(issuer, "/")+openIDConfigurationDiscoveryPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuer %q: %w", issuer, err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover issuer %s: %w", issuer, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to discover issuer %s: %s", issuer, resp.Status)
+	}
+	metadata := struct {
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		return nil, fmt.Errorf("failed to decode discovery document of issuer %s: %w", issuer, err)
+	}
+	if len(metadata.AuthorizationEndpoint) == 0 || len(metadata.TokenEndpoint) == 0 {
+		return nil, fmt.Errorf("issuer %s does not advertise authorization and token endpoints", issuer)
+	}
+	urls := map[string]interface{}{
+		"authorize": metadata.AuthorizationEndpoint,
+		"token":     metadata.TokenEndpoint,
+	}
+	if len(metadata.UserInfoEndpoint) > 0 {
+		urls["userInfo"] = metadata.UserInfoEndpoint
+	}
+	return urls, nil
+}
@@ -0,0 +1,78 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+func TestDiscoverOpenIDURLs(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != openIDConfigurationDiscoveryPath {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"issuer":%[1]q,"authorization_endpoint":"%[1]s/authorize","token_endpoint":"%[1]s/token"}`, server.URL)
+	}))
+	defer server.Close()
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	urls, err := discoverOpenIDURLs(context.Background(), server.URL+"/", caData)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"authorize": server.URL + "/authorize",
+		"token":     server.URL + "/token",
+	}, urls)
+
+	_, err = discoverOpenIDURLs(context.Background(), server.URL, nil)
+	assert.Error(t, err, "the issuer certificate should not be trusted without the CA")
+}
+
+func TestPruneIdentityProviderObjects(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example", UID: types.UID("hcp")}}
+	owned := func(obj client.Object) client.Object {
+		obj.SetNamespace("example")
+		obj.SetOwnerReferences(ensureHCPOwnerRef(hcp, nil))
+		return obj
+	}
+	c := newApplyPatchClient(
+		owned(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "idp-0-client-secret"}}),
+		owned(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "idp-1-client-secret"}}),
+		owned(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "idp-1-ca"}}),
+		owned(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "oauth-openshift-config"}}),
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "example", Name: "idp-user"}},
+	)
+	r := &HostedControlPlaneReconciler{Client: c, Log: ctrl.Log.WithName("test")}
+
+	// The objects just applied for the identity providers, as decoded from
+	// their manifests
+	objects := []client.Object{&corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "example", Name: "idp-0-client-secret"},
+	}}
+	if err := r.pruneIdentityProviderObjects(context.Background(), hcp, objects); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	exists := func(obj client.Object) bool {
+		err := c.Get(context.Background(), client.ObjectKey{Namespace: "example", Name: obj.GetName()}, obj)
+		return err == nil
+	}
+	assert.True(t, exists(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "idp-0-client-secret"}}), "referenced copies should be kept")
+	assert.False(t, exists(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "idp-1-client-secret"}}), "unreferenced secret copies should be deleted")
+	assert.False(t, exists(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "idp-1-ca"}}), "unreferenced configmap copies should be deleted")
+	assert.True(t, exists(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "oauth-openshift-config"}}), "other objects should be kept")
+	assert.True(t, exists(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "idp-user"}}), "objects not owned by the control plane should be kept")
+}
@@ -0,0 +1,78 @@
+package hostedcontrolplane
+
+import (
+	"fmt"
+	"strings"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+	"openshift.io/hypershift/support/mirrors"
+)
+
+// imageContentSources returns the image content sources of a control plane
+// in the form the release lookup and rendering understand.
+func imageContentSources(hcp *hyperv1.HostedControlPlane) []mirrors.ImageContentSource {
+	var sources []mirrors.ImageContentSource
+	for _, source := range hcp.Spec.ImageContentSources {
+		sources = append(sources, mirrors.ImageContentSource{
+			Source:  source.Source,
+			Mirrors: source.Mirrors,
+		})
+	}
+	return sources
+}
+
+// releaseImagePullSpec returns the pull spec the release image of a control
+// plane is pulled by on the management cluster.
+func releaseImagePullSpec(hcp *hyperv1.HostedControlPlane) string {
+	return mirrors.MirroredImage(hcp.Spec.ReleaseImage, imageContentSources(hcp))
+}
+
+// setUnmirroredImagesCondition flags control planes that pull images referenced
+// by tag from their source although image content sources are set, since the
+// mirrors of a source only serve images by digest.
+func setUnmirroredImagesCondition(hcp *hyperv1.HostedControlPlane, releaseImage *releaseinfo.ReleaseImage) {
+	unmirrored := mirrors.UnmirroredImages(releaseImage.ComponentImages(), imageContentSources(hcp))
+	if len(unmirrored) == 0 {
+		setConditionByType(&hcp.Status.Conditions, hyperv1.UnmirroredImages, hyperv1.ConditionFalse, "AsExpected", "All images are pulled by digest")
+		return
+	}
+	setConditionByType(&hcp.Status.Conditions, hyperv1.UnmirroredImages, hyperv1.ConditionTrue, "ImagesReferencedByTag",
+		fmt.Sprintf("The images of the following components are referenced by tag and pulled from their source: %s", strings.Join(unmirrored, ", ")))
+}
+
+// validateImageContentSources makes sure the sources and mirrors of a control
+// plane are repositories, since the container runtime of the guest workers
+// rejects its whole registry configuration otherwise.
+func validateImageContentSources(sources []hyperv1.ImageContentSource) error {
+	seen := map[string]bool{}
+	for _, source := range sources {
+		if err := validateRepository(source.Source); err != nil {
+			return fmt.Errorf("invalid image content source: %w", err)
+		}
+		if seen[source.Source] {
+			return fmt.Errorf("image content source %s is specified more than once", source.Source)
+		}
+		seen[source.Source] = true
+		for _, mirror := range source.Mirrors {
+			if err := validateRepository(mirror); err != nil {
+				return fmt.Errorf("invalid mirror of image content source %s: %w", source.Source, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateRepository(repository string) error {
+	if len(repository) == 0 {
+		return fmt.Errorf("empty repository")
+	}
+	if strings.ContainsAny(repository, "@ \t\n") || strings.HasSuffix(repository, "/") {
+		return fmt.Errorf("%q is not a repository", repository)
+	}
+	// A colon is only allowed in the port of the registry host, not in a tag
+	if i := strings.LastIndex(repository, "/"); i >= 0 && strings.Contains(repository[i:], ":") {
+		return fmt.Errorf("%q is not a repository", repository)
+	}
+	return nil
+}
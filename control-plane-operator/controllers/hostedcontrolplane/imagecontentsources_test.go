@@ -0,0 +1,75 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	imageapi "github.com/openshift/api/image/v1"
+	"github.com/stretchr/testify/assert"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+)
+
+func TestValidateImageContentSources(t *testing.T) {
+	tests := []struct {
+		name    string
+		sources []hyperv1.ImageContentSource
+		valid   bool
+	}{
+		{
+			name: "mirrors with registry ports",
+			sources: []hyperv1.ImageContentSource{
+				{Source: "quay.io/openshift-release-dev/ocp-release", Mirrors: []string{"mirror.example.com:5000/ocp/release"}},
+				{Source: "quay.io/openshift-release-dev/ocp-v4.0-art-dev", Mirrors: []string{"mirror.example.com:5000/ocp/art"}},
+			},
+			valid: true,
+		},
+		{
+			name:    "tagged source",
+			sources: []hyperv1.ImageContentSource{{Source: "quay.io/openshift-release-dev/ocp-release:4.7.0"}},
+		},
+		{
+			name:    "mirror with digest",
+			sources: []hyperv1.ImageContentSource{{Source: "quay.io/ocp", Mirrors: []string{"mirror.example.com/ocp@sha256:abc"}}},
+		},
+		{
+			name: "duplicate source",
+			sources: []hyperv1.ImageContentSource{
+				{Source: "quay.io/ocp", Mirrors: []string{"mirror.example.com/ocp"}},
+				{Source: "quay.io/ocp", Mirrors: []string{"backup.example.com/ocp"}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateImageContentSources(test.sources)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSetUnmirroredImagesCondition(t *testing.T) {
+	release := releaseinfo.WithComponentImages(&releaseinfo.ReleaseImage{ImageStream: &imageapi.ImageStream{}}, map[string]string{
+		"hyperkube": "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:abc",
+		"openvpn":   "quay.io/hypershift/openvpn:latest",
+	})
+	hcp := &hyperv1.HostedControlPlane{}
+	setUnmirroredImagesCondition(hcp, release)
+	condition := getConditionByType(hcp.Status.Conditions, hyperv1.UnmirroredImages)
+	if condition == nil {
+		t.Fatalf("expected an %s condition", hyperv1.UnmirroredImages)
+	}
+	assert.Equal(t, hyperv1.ConditionFalse, condition.Status, "images are pulled from their source without image content sources")
+
+	hcp.Spec.ImageContentSources = []hyperv1.ImageContentSource{
+		{Source: "quay.io/openshift-release-dev", Mirrors: []string{"mirror.example.com/release"}},
+	}
+	setUnmirroredImagesCondition(hcp, release)
+	condition = getConditionByType(hcp.Status.Conditions, hyperv1.UnmirroredImages)
+	assert.Equal(t, hyperv1.ConditionTrue, condition.Status)
+	assert.Equal(t, "The images of the following components are referenced by tag and pulled from their source: openvpn", condition.Message)
+}
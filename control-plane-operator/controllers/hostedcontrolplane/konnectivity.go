@@ -0,0 +1,46 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/konnectivity"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+)
+
+// konnectivityObjects returns the objects of the konnectivity tunnel of a
+// control plane namespace, when the guest cluster is reached through it.
+func konnectivityObjects(cc *componentContext) ([]client.Object, error) {
+	if !cc.params.KonnectivityEnabled {
+		return nil, nil
+	}
+	targetNamespace := cc.hcp.GetName()
+	return []client.Object{
+		konnectivity.ServerSecret{
+			Namespace: targetNamespace,
+			PKI:       cc.pkiData,
+		}.Build(),
+		konnectivity.AgentSecret{
+			Namespace: targetNamespace,
+			PKI:       cc.pkiData,
+		}.Build(),
+		konnectivity.AgentDeployment{
+			Namespace:             targetNamespace,
+			Image:                 cc.images["konnectivity-agent"],
+			Command:               konnectivityCommand(cc, "konnectivity-agent", "proxy-agent"),
+			RestartDate:           cc.params.RestartDate,
+			OpenShiftAPIClusterIP: cc.params.OpenShiftAPIClusterIP,
+			OAuthAPIClusterIP:     cc.params.OauthAPIClusterIP,
+			Scheduling:            cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+	}, nil
+}
+
+// konnectivityCommand returns the command that runs a konnectivity binary of
+// the image of a component. The image of the release installs the binaries in
+// /usr/bin, the upstream images at their root.
+func konnectivityCommand(cc *componentContext, component, binary string) []string {
+	if image, ok := cc.images[releaseinfo.NetworkProxyComponent]; ok && cc.images[component] == image {
+		return []string{"/usr/bin/" + binary}
+	}
+	return []string{"/" + binary}
+}
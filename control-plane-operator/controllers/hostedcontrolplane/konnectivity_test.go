@@ -0,0 +1,69 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestKonnectivityObjects(t *testing.T) {
+	tests := []struct {
+		name            string
+		konnectivity    bool
+		images          map[string]string
+		expectedCommand []string
+	}{
+		{
+			name: "vpn",
+		},
+		{
+			name:         "konnectivity",
+			konnectivity: true,
+			images: map[string]string{
+				"konnectivity-agent": "k8s.gcr.io/kas-network-proxy/proxy-agent:v0.0.27",
+			},
+			expectedCommand: []string{"/proxy-agent"},
+		},
+		{
+			name:         "konnectivity from the release",
+			konnectivity: true,
+			images: map[string]string{
+				"apiserver-network-proxy": "quay.io/ocp/release@sha256:proxy",
+				"konnectivity-agent":      "quay.io/ocp/release@sha256:proxy",
+			},
+			expectedCommand: []string{"/usr/bin/proxy-agent"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := render.NewClusterParams()
+			params.KonnectivityEnabled = test.konnectivity
+			params.OpenShiftAPIClusterIP = "172.31.0.10"
+			params.OauthAPIClusterIP = "172.31.0.11"
+			objects, err := konnectivityObjects(&componentContext{
+				hcp: &hyperv1.HostedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "example"},
+				},
+				params: params,
+				images: test.images,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !test.konnectivity {
+				return
+			}
+			deployment := componentObject(t, objects, "Deployment", "konnectivity-agent").(*appsv1.Deployment)
+			// The agent routes traffic to the aggregated API servers of the
+			// control plane namespace back through the konnectivity server
+			assert.Contains(t, deployment.Spec.Template.Spec.Containers[0].Args, "ipv4=172.31.0.10&ipv4=172.31.0.11")
+			assert.Equal(t, test.images["konnectivity-agent"], deployment.Spec.Template.Spec.Containers[0].Image)
+			assert.Equal(t, test.expectedCommand, deployment.Spec.Template.Spec.Containers[0].Command)
+		})
+	}
+}
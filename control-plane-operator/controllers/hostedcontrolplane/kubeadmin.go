@@ -0,0 +1,92 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+const kubeadminRotationTriggerAnnotation = "hypershift.openshift.io/kubeadmin-rotation-trigger"
+
+// validateKubeadmin ensures there is still a way to log in when kubeadmin is
+// disabled.
+func validateKubeadmin(hcp *hyperv1.HostedControlPlane) error {
+	if hcp.Spec.OAuth.Kubeadmin.Disabled && len(hcp.Spec.OAuth.IdentityProviders) == 0 {
+		return fmt.Errorf("kubeadmin can only be disabled when an identity provider is configured")
+	}
+	return nil
+}
+
+// deleteKubeadminPassword removes the kubeadmin password of a control plane
+// when kubeadmin is disabled. The hosted cluster config operator syncs the
+// removal to the guest cluster, which restarts the OAuth server.
+func (r *HostedControlPlaneReconciler) deleteKubeadminPassword(ctx context.Context, hcp *hyperv1.HostedControlPlane) error {
+	secret := generateKubeadminPasswordSecret(hcp.GetName(), "", "")
+	if err := r.Delete(ctx, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete kubeadmin password secret: %w", err)
+	}
+	r.Log.Info("Removed kubeadmin password")
+	return r.forgetAppliedObject(hcp, secret)
+}
+
+// kubeadminPasswordSecrets returns the kubeadmin password secret of a control
+// plane and the manifest of the kubeadmin secret of its guest cluster. The
+// existing password and its hash are kept, so that applying the secrets again
+// does not rotate the password, until the rotation trigger changes.
+func (r *HostedControlPlaneReconciler) kubeadminPasswordSecrets(ctx context.Context, hcp *hyperv1.HostedControlPlane) (*corev1.Secret, *corev1.ConfigMap, error) {
+	targetNamespace := hcp.GetName()
+	rotationTrigger := hcp.Spec.OAuth.Kubeadmin.RotationTrigger
+
+	existing := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKeyFromObject(generateKubeadminPasswordSecret(targetNamespace, "", "")), existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("failed to get kubeadmin password secret: %w", err)
+	}
+	password := string(existing.Data["password"])
+	if len(password) == 0 || existing.Annotations[kubeadminRotationTriggerAnnotation] != rotationTrigger {
+		r.Log.Info("Generating kubeadmin password", "rotationTrigger", rotationTrigger)
+		password, err = generateKubeadminPassword()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate kubeadmin password: %w", err)
+		}
+	}
+	passwordSecret := generateKubeadminPasswordSecret(targetNamespace, password, rotationTrigger)
+	passwordSecret.OwnerReferences = ensureHCPOwnerRef(hcp, passwordSecret.OwnerReferences)
+
+	targetSecret, err := generateKubeadminPasswordTargetSecret(r.Scheme(), password, targetNamespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create kubeadmin secret manifest for target cluster: %w", err)
+	}
+	existingTarget := &corev1.ConfigMap{}
+	err = r.Get(ctx, client.ObjectKeyFromObject(targetSecret), existingTarget)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("failed to get kubeadmin secret manifest for target cluster: %w", err)
+	}
+	// Every bcrypt hash of a password is different, keep the existing one
+	// while it still matches
+	if data, ok := existingTarget.Data["data"]; ok && kubeadminPasswordHashMatches(data, password) {
+		targetSecret.Data = existingTarget.Data
+	}
+	targetSecret.OwnerReferences = ensureHCPOwnerRef(hcp, targetSecret.OwnerReferences)
+	return passwordSecret, targetSecret, nil
+}
+
+// kubeadminPasswordHashMatches returns whether the encoded kubeadmin secret of
+// a guest cluster holds the hash of a password.
+func kubeadminPasswordHashMatches(encodedSecret, password string) bool {
+	secret := &corev1.Secret{}
+	if err := json.Unmarshal([]byte(encodedSecret), secret); err != nil {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword(secret.Data["kubeadmin"], []byte(password)) == nil
+}
@@ -0,0 +1,130 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/kubeapiserver"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+// kubeAPIServerObjects returns the objects of the kube-apiserver of a control
+// plane.
+func kubeAPIServerObjects(cc *componentContext) ([]client.Object, error) {
+	targetNamespace := cc.hcp.GetName()
+	auditLog := kubeapiserver.AuditLog{
+		MaxSize:    cc.params.AuditLogMaxSize,
+		MaxBackups: cc.params.AuditLogMaxBackups,
+		MaxAge:     cc.params.AuditLogMaxAge,
+	}
+	if cc.params.APIServerAuditEnabled {
+		auditLog.WebhookMode = cc.params.AuditWebhookMode
+	}
+	config, err := kubeapiserver.ConfigMap{
+		Namespace:           targetNamespace,
+		ExternalAPIAddress:  cc.params.ExternalAPIAddress,
+		InternalAPIPort:     cc.params.InternalAPIPort,
+		CloudProvider:       cc.params.CloudProvider,
+		ServiceCIDR:         cc.params.ServiceCIDR,
+		ServiceNetworks:     cc.params.ServiceNetworks,
+		ClusterNetworks:     cc.params.ClusterNetworks,
+		IngressSubdomain:    cc.params.IngressSubdomain,
+		EtcdClientName:      cc.params.EtcdClientName,
+		FeatureGates:        componentFeatureGates(cc.params),
+		AuditLog:            auditLog,
+		KonnectivityEnabled: cc.params.KonnectivityEnabled,
+		SecretEncryption:    len(cc.params.SecretEncryptionType) > 0,
+		NamedCerts:          cc.params.NamedCerts,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	oauthMetadata, err := kubeapiserver.OAuthMetadataConfigMap{
+		Namespace:        targetNamespace,
+		OAuthDNSName:     cc.params.ExternalOauthDNSName,
+		OAuthPort:        cc.params.ExternalOauthPort,
+		IngressSubdomain: cc.params.IngressSubdomain,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	auditPolicy, err := kubeapiserver.AuditPolicyConfigMap{
+		Namespace:    targetNamespace,
+		Profile:      hyperv1.AuditProfileType(cc.params.AuditProfile),
+		CustomPolicy: cc.params.AuditPolicy,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	objects := []client.Object{
+		kubeapiserver.Deployment{
+			Namespace: targetNamespace,
+			Images: kubeapiserver.Images{
+				ClusterConfigOperator: cc.images["cluster-config-operator"],
+				CLI:                   cc.images["cli"],
+				Hyperkube:             cc.images["hyperkube"],
+				OpenVPN:               cc.images["openvpn"],
+				KonnectivityServer:    cc.images["konnectivity-server"],
+			},
+			ClusterID:       cc.params.ClusterID,
+			HighlyAvailable: cc.params.APIAvailabilityPolicy == render.HighlyAvailable,
+			InternalAPIPort: cc.params.InternalAPIPort,
+			LivenessPath:    cc.params.ApiserverLivenessPath,
+			Proxy: kubeapiserver.Proxy{
+				HTTPProxy:  cc.params.HTTPProxy,
+				HTTPSProxy: cc.params.HTTPSProxy,
+				NoProxy:    cc.params.ControlPlaneNoProxy,
+			},
+			KonnectivityEnabled:       cc.params.KonnectivityEnabled,
+			KonnectivityServerCommand: konnectivityCommand(cc, "konnectivity-server", "proxy-server"),
+			AuditWebhookEnabled:       cc.params.APIServerAuditEnabled,
+			SecretEncryption: kubeapiserver.SecretEncryption{
+				Type:       hyperv1.SecretEncryptionType(cc.params.SecretEncryptionType),
+				ConfigHash: cc.params.EncryptionConfigHash,
+				KMS: kubeapiserver.KMS{
+					Image:   cc.params.KMSImage,
+					KeyInfo: cc.params.KPInfo,
+					Region:  cc.params.KPRegion,
+				},
+			},
+			Resources:  deploymentResources(cc.hcp.Spec.ControlPlaneResources, kubeAPIServerDeploymentName),
+			Scheduling: cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+		config,
+		oauthMetadata,
+		kubeapiserver.Secret{
+			Namespace: targetNamespace,
+			PKI:       cc.pkiData,
+		}.Build(),
+		kubeapiserver.CAConfigMap{
+			Namespace:           targetNamespace,
+			PKI:                 cc.pkiData,
+			KonnectivityEnabled: cc.params.KonnectivityEnabled,
+		}.Build(),
+		auditPolicy,
+		kubeapiserver.LocalhostKubeconfigSecret{
+			Namespace:  targetNamespace,
+			Kubeconfig: cc.pkiData["localhost-admin.kubeconfig"],
+		}.Build(),
+	}
+	if !cc.params.KonnectivityEnabled {
+		objects = append(objects,
+			kubeapiserver.VPNClientConfigMap{
+				Namespace: targetNamespace,
+			}.Build(),
+			kubeapiserver.VPNClientSecret{
+				Namespace: targetNamespace,
+				Cert:      cc.pkiData["openvpn-kube-apiserver-client.crt"],
+				Key:       cc.pkiData["openvpn-kube-apiserver-client.key"],
+				CA:        cc.pkiData["openvpn-ca.crt"],
+			}.Build(),
+		)
+	}
+	if cc.params.SecretEncryptionType == string(hyperv1.KMSSecretEncryptionType) {
+		objects = append(objects, kubeapiserver.KMSCredentialsSecret{
+			Namespace: targetNamespace,
+			APIKey:    cc.params.KPAPIKey,
+		}.Build())
+	}
+	return objects, nil
+}
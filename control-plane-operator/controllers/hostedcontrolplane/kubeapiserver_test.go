@@ -0,0 +1,120 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestKubeAPIServerObjects(t *testing.T) {
+	tests := []struct {
+		name                 string
+		konnectivity         bool
+		encryption           hyperv1.SecretEncryptionType
+		expectedContainers   []string
+		expectedObjects      []string
+		expectedConfigArgs   []string
+		unexpectedConfigArgs []string
+	}{
+		{
+			name:                 "vpn",
+			expectedContainers:   []string{"initialize-manifests", "kube-apiserver", "openvpn-client"},
+			expectedObjects:      []string{"kube-apiserver-vpnclient-config", "kube-apiserver-vpnclient-secret"},
+			unexpectedConfigArgs: []string{"egress-selector-config-file", "encryption-provider-config"},
+		},
+		{
+			name:               "konnectivity",
+			konnectivity:       true,
+			expectedContainers: []string{"initialize-manifests", "kube-apiserver", "konnectivity-server"},
+			expectedConfigArgs: []string{"egress-selector-config-file"},
+		},
+		{
+			name:               "kms",
+			encryption:         hyperv1.KMSSecretEncryptionType,
+			expectedContainers: []string{"initialize-manifests", "kube-apiserver", "openvpn-client", "kms-plugin"},
+			expectedObjects:    []string{"kube-apiserver-kms-credentials"},
+			expectedConfigArgs: []string{"encryption-provider-config"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := render.NewClusterParams()
+			params.InternalAPIPort = 6443
+			params.EtcdClientName = "etcd-client"
+			params.KonnectivityEnabled = test.konnectivity
+			params.SecretEncryptionType = string(test.encryption)
+			params.EncryptionConfigHash = "abc123"
+
+			objects, err := kubeAPIServerObjects(&componentContext{
+				hcp: &hyperv1.HostedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "example"},
+				},
+				params: params,
+				images: map[string]string{
+					"hyperkube":               "example.com/hyperkube:latest",
+					"cli":                     "example.com/cli:latest",
+					"cluster-config-operator": "example.com/cluster-config-operator:latest",
+				},
+				pkiData: map[string][]byte{"client-ca.crt": []byte("client-ca")},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var names []string
+			var deployment *appsv1.Deployment
+			configMaps := map[string]*corev1.ConfigMap{}
+			for _, obj := range objects {
+				names = append(names, obj.GetName())
+				switch obj := obj.(type) {
+				case *appsv1.Deployment:
+					deployment = obj
+				case *corev1.ConfigMap:
+					configMaps[obj.Name] = obj
+				}
+			}
+			for _, name := range test.expectedObjects {
+				assert.Contains(t, names, name)
+			}
+
+			if deployment == nil {
+				t.Fatalf("expected a deployment")
+			}
+			var containers []string
+			for _, container := range deployment.Spec.Template.Spec.Containers {
+				containers = append(containers, container.Name)
+			}
+			assert.Equal(t, test.expectedContainers, containers)
+			assert.Equal(t, "example.com/hyperkube:latest", deployment.Spec.Template.Spec.Containers[1].Image)
+			if len(test.encryption) > 0 {
+				assert.Equal(t, "abc123", deployment.Spec.Template.Annotations[encryptionConfigHashAnnotation])
+			} else {
+				assert.Empty(t, deployment.Spec.Template.Annotations)
+			}
+
+			// The client CA bundle includes the kubeconfig signer of the pki
+			caConfigMap, ok := configMaps["kube-apiserver"]
+			if !ok {
+				t.Fatalf("expected the kube-apiserver configmap")
+			}
+			assert.Equal(t, "client-ca", caConfigMap.Data["client-ca.crt"])
+
+			config, ok := configMaps["kube-apiserver-config"]
+			if !ok {
+				t.Fatalf("expected the kube-apiserver-config configmap")
+			}
+			assert.Contains(t, config.Data["config.yaml"], "https://etcd-client:2379")
+			for _, arg := range test.expectedConfigArgs {
+				assert.Contains(t, config.Data["config.yaml"], arg)
+			}
+			for _, arg := range test.unexpectedConfigArgs {
+				assert.NotContains(t, config.Data["config.yaml"], arg)
+			}
+		})
+	}
+}
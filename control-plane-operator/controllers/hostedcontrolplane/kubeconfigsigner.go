@@ -0,0 +1,40 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render/pki"
+)
+
+const kubeconfigSignerRotationAnnotation = "hypershift.openshift.io/kubeconfig-signer-rotation"
+
+// rotateKubeconfigSignerIfNeeded replaces the kubeconfig signer in the pki
+// secret when it is missing or was generated for a different rotation of the
+// control plane. The client CA bundle of the kube-apiserver is rendered from
+// the pki secret, so kubeconfigs issued by the old signer are no longer
+// trusted once the control plane is applied. It returns whether the signer
+// was rotated.
+func (r *HostedControlPlaneReconciler) rotateKubeconfigSignerIfNeeded(ctx context.Context, hcp *hyperv1.HostedControlPlane, pkiSecret *corev1.Secret) (bool, error) {
+	rotation := hcp.Spec.KubeconfigSignerRotation
+	_, hasSigner := pkiSecret.Data["kubeconfig-signer.crt"]
+	if hasSigner && pkiSecret.Annotations[kubeconfigSignerRotationAnnotation] == rotation {
+		return false, nil
+	}
+
+	r.Log.Info("Rotating kubeconfig signer", "rotation", rotation)
+	if err := pki.RotateKubeconfigSigner(pkiSecret.Data); err != nil {
+		return false, fmt.Errorf("failed to rotate kubeconfig signer: %w", err)
+	}
+	if pkiSecret.Annotations == nil {
+		pkiSecret.Annotations = map[string]string{}
+	}
+	pkiSecret.Annotations[kubeconfigSignerRotationAnnotation] = rotation
+	if err := r.applyPKISecret(ctx, pkiSecret); err != nil {
+		return false, err
+	}
+	return true, nil
+}
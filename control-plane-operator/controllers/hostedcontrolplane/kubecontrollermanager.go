@@ -0,0 +1,55 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/kubecontrollermanager"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+const kubeControllerManagerDeploymentName = "kube-controller-manager"
+
+// kubeControllerManagerObjects returns the objects of the
+// kube-controller-manager of a control plane.
+func kubeControllerManagerObjects(cc *componentContext) ([]client.Object, error) {
+	targetNamespace := cc.hcp.GetName()
+	networks := kubecontrollermanager.Networks{
+		PodCIDR:         cc.params.PodCIDR,
+		ServiceCIDR:     cc.params.ServiceCIDR,
+		ClusterNetworks: cc.params.ClusterNetworks,
+	}
+	config, err := kubecontrollermanager.ConfigMap{
+		Namespace:    targetNamespace,
+		Networks:     networks,
+		FeatureGates: componentFeatureGates(cc.params),
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	return []client.Object{
+		config,
+		kubecontrollermanager.CAConfigMap{
+			Namespace: targetNamespace,
+			CA:        cc.pkiData["combined-ca.crt"],
+		}.Build(),
+		kubecontrollermanager.Secret{
+			Namespace:         targetNamespace,
+			Kubeconfig:        cc.pkiData["internal-admin.kubeconfig"],
+			ServiceAccountKey: cc.pkiData["service-account.key"],
+			ClusterSignerCert: cc.pkiData["cluster-signer.crt"],
+			ClusterSignerKey:  cc.pkiData["cluster-signer.key"],
+		}.Build(),
+		kubecontrollermanager.Deployment{
+			Namespace:         targetNamespace,
+			Image:             cc.images["hyperkube"],
+			ClusterID:         cc.params.ClusterID,
+			HighlyAvailable:   cc.params.ControllerAvailabilityPolicy == render.HighlyAvailable,
+			RestartDate:       cc.params.RestartDate,
+			PriorityClassName: cc.params.MasterPriorityClass,
+			Networks:          networks,
+			FeatureGates:      componentFeatureGates(cc.params),
+			Resources:         deploymentResources(cc.hcp.Spec.ControlPlaneResources, kubeControllerManagerDeploymentName),
+			Scheduling:        cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestKubeControllerManagerObjects(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+	}
+	params := render.NewClusterParams()
+	params.PodCIDR = "10.132.0.0/14,fd01::/48"
+	params.ServiceCIDR = "172.31.0.0/16,fd02::/112"
+	params.ClusterNetworks = []render.ClusterNetwork{
+		{CIDR: "10.132.0.0/14", HostPrefix: 23},
+		{CIDR: "fd01::/48", HostPrefix: 64, IPv6: true},
+	}
+	params.DefaultFeatureGates = []string{"RotateKubeletServerCertificate=true"}
+
+	objects, err := kubeControllerManagerObjects(&componentContext{
+		hcp:    hcp,
+		params: params,
+		images: map[string]string{"hyperkube": "example.com/hyperkube:latest"},
+		pkiData: map[string][]byte{
+			"combined-ca.crt":    []byte("ca"),
+			"cluster-signer.key": []byte("signer"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config := componentObject(t, objects, "ConfigMap", "kube-controller-manager-config").(*corev1.ConfigMap)
+	assert.Contains(t, config.Data["config.yaml"], "node-cidr-mask-size-ipv6:\n  - \"64\"")
+	assert.Contains(t, config.Data["config.yaml"], "- RotateKubeletServerCertificate=true")
+
+	ca := componentObject(t, objects, "ConfigMap", "kube-controller-manager").(*corev1.ConfigMap)
+	assert.Equal(t, "ca", ca.Data["root-ca.crt"])
+
+	secret := componentObject(t, objects, "Secret", "kube-controller-manager").(*corev1.Secret)
+	assert.Equal(t, []byte("signer"), secret.Data["cluster-signer.key"])
+
+	deployment := componentObject(t, objects, "Deployment", "kube-controller-manager").(*appsv1.Deployment)
+	assert.Equal(t, int32(1), *deployment.Spec.Replicas)
+	container := deployment.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "example.com/hyperkube:latest", container.Image)
+	assert.Contains(t, container.Args, "--node-cidr-mask-size-ipv4=23")
+	assert.Contains(t, container.Args, "--node-cidr-mask-size-ipv6=64")
+	assert.Contains(t, container.Args, "--cluster-cidr=10.132.0.0/14,fd01::/48")
+	assert.Contains(t, container.Args, "--feature-gates=RotateKubeletServerCertificate=true")
+}
@@ -0,0 +1,36 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/kubescheduler"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+const kubeSchedulerDeploymentName = "kube-scheduler"
+
+// kubeSchedulerObjects returns the objects of the kube-scheduler of a control
+// plane.
+func kubeSchedulerObjects(cc *componentContext) ([]client.Object, error) {
+	targetNamespace := cc.hcp.GetName()
+	return []client.Object{
+		kubescheduler.ConfigMap{
+			Namespace: targetNamespace,
+		}.Build(),
+		kubescheduler.Secret{
+			Namespace:  targetNamespace,
+			Kubeconfig: cc.pkiData["internal-admin.kubeconfig"],
+		}.Build(),
+		kubescheduler.Deployment{
+			Namespace:         targetNamespace,
+			Image:             cc.images["hyperkube"],
+			ClusterID:         cc.params.ClusterID,
+			HighlyAvailable:   cc.params.ControllerAvailabilityPolicy == render.HighlyAvailable,
+			RestartDate:       cc.params.RestartDate,
+			PriorityClassName: cc.params.MasterPriorityClass,
+			FeatureGates:      componentFeatureGates(cc.params),
+			Resources:         deploymentResources(cc.hcp.Spec.ControlPlaneResources, kubeSchedulerDeploymentName),
+			Scheduling:        cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+	}, nil
+}
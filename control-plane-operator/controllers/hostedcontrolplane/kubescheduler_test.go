@@ -0,0 +1,58 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestKubeSchedulerObjects(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: hyperv1.HostedControlPlaneSpec{
+			ControlPlaneResources: &hyperv1.ControlPlaneResources{
+				KubeScheduler: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+				},
+			},
+			ControlPlaneScheduling: &hyperv1.ControlPlaneScheduling{
+				NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+			},
+		},
+	}
+	params := render.NewClusterParams()
+	params.ControllerAvailabilityPolicy = render.HighlyAvailable
+	params.DefaultFeatureGates = []string{"RotateKubeletServerCertificate=true"}
+	params.ExtraFeatureGates = []string{"CSIMigrationAWS=true"}
+	images := map[string]string{"hyperkube": "example.com/hyperkube:latest"}
+
+	objects, err := kubeSchedulerObjects(&componentContext{
+		hcp:     hcp,
+		params:  params,
+		images:  images,
+		pkiData: map[string][]byte{"internal-admin.kubeconfig": []byte("kubeconfig")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	secret := componentObject(t, objects, "Secret", "kube-scheduler").(*corev1.Secret)
+	assert.Equal(t, []byte("kubeconfig"), secret.Data["kubeconfig"])
+
+	deployment := componentObject(t, objects, "Deployment", "kube-scheduler").(*appsv1.Deployment)
+	assert.Equal(t, int32(3), *deployment.Spec.Replicas)
+	podSpec := deployment.Spec.Template.Spec
+	container := podSpec.Containers[0]
+	assert.Equal(t, "example.com/hyperkube:latest", container.Image)
+	assert.Contains(t, container.Args, "--feature-gates=RotateKubeletServerCertificate=true")
+	assert.Contains(t, container.Args, "--feature-gates=CSIMigrationAWS=true")
+	assert.Equal(t, resource.MustParse("100m"), container.Resources.Requests[corev1.ResourceCPU])
+	assert.Equal(t, map[string]string{"node-role.kubernetes.io/infra": ""}, podSpec.NodeSelector)
+}
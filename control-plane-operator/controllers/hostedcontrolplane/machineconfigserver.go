@@ -0,0 +1,63 @@
+package hostedcontrolplane
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/machineconfigserver"
+)
+
+// machineConfigServerObjects returns the objects of the machine config server
+// of a control plane, which serves the ignition configs of the machines of
+// the guest cluster. The cluster configuration the machine configs are
+// rendered from is rendered with the other cluster manifests. Its service is
+// published with the ignition endpoint of the control plane.
+func machineConfigServerObjects(cc *componentContext) ([]client.Object, error) {
+	targetNamespace := cc.hcp.GetName()
+	version, err := semver.Parse(cc.versions["release"])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse release version %s: %w", cc.versions["release"], err)
+	}
+	// Clear any version modifiers for this comparison
+	version.Pre = nil
+	version.Build = nil
+	return []client.Object{
+		machineconfigserver.ServiceAccount{
+			Namespace: targetNamespace,
+		}.Build(),
+		machineconfigserver.RoleBinding{
+			Namespace: targetNamespace,
+		}.Build(),
+		machineconfigserver.Deployment{
+			Namespace: targetNamespace,
+			Images: machineconfigserver.Images{
+				MachineConfigOperator: cc.images["machine-config-operator"],
+				CLI:                   cc.images["cli"],
+				Etcd:                  cc.images["etcd"],
+				KubeClientAgent:       cc.images["kube-client-agent"],
+				MachineOSContent:      cc.images["machine-os-content"],
+				Pod:                   cc.images["pod"],
+				KeepalivedIPFailover:  cc.images["keepalived-ipfailover"],
+				CoreDNS:               cc.images["coredns"],
+				MDNSPublisher:         cc.images["mdns-publisher"],
+				HAProxyRouter:         cc.images["haproxy-router"],
+				BaremetalRuntimeCfg:   cc.images["baremetal-runtimecfg"],
+			},
+			BootstrapEtcd:       version.LT(version46),
+			TrustedCABundle:     len(cc.params.TrustedCABundle) > 0,
+			ImageContentSources: len(cc.params.ImageContentSources) > 0,
+			Scheduling:          cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+		machineconfigserver.Secret{
+			Namespace: targetNamespace,
+			Cert:      cc.pkiData["machine-config-server.crt"],
+			Key:       cc.pkiData["machine-config-server.key"],
+		}.Build(),
+		machineconfigserver.KubeconfigSecret{
+			Namespace:  targetNamespace,
+			Kubeconfig: cc.pkiData["kubelet-bootstrap.kubeconfig"],
+		}.Build(),
+	}, nil
+}
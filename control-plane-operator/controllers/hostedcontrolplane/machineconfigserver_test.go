@@ -0,0 +1,76 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+	"openshift.io/hypershift/support/mirrors"
+)
+
+func TestMachineConfigServerObjects(t *testing.T) {
+	tests := []struct {
+		name                string
+		version             string
+		trustedCABundle     string
+		imageContentSources []mirrors.ImageContentSource
+		expectedScript      []string
+		unexpectedScript    []string
+	}{
+		{
+			name:             "4.6",
+			version:          "4.6.0-rc.1",
+			unexpectedScript: []string{"--etcd-image", "--additional-trust-bundle-config-file", "image-content-source-policy.yaml"},
+		},
+		{
+			name:           "4.5",
+			version:        "4.5.3",
+			expectedScript: []string{"--etcd-image=example.com/etcd:latest \\", "--kube-client-agent-image"},
+		},
+		{
+			name:                "trusted CA bundle and mirrors",
+			version:             "4.7.0",
+			trustedCABundle:     "ca",
+			imageContentSources: []mirrors.ImageContentSource{{Source: "quay.io/openshift-release-dev/ocp-release"}},
+			expectedScript:      []string{"--additional-trust-bundle-config-file", "image-content-source-policy.yaml"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := render.NewClusterParams()
+			params.TrustedCABundle = test.trustedCABundle
+			params.ImageContentSources = test.imageContentSources
+			objects, err := machineConfigServerObjects(&componentContext{
+				hcp: &hyperv1.HostedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "example"},
+				},
+				params:   params,
+				images:   map[string]string{"machine-config-operator": "example.com/mco:latest", "etcd": "example.com/etcd:latest"},
+				versions: map[string]string{"release": test.version},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			deployment := componentObject(t, objects, "Deployment", "machine-config-server").(*appsv1.Deployment)
+			assert.Equal(t, "example.com/mco:latest", deployment.Spec.Template.Spec.Containers[0].Image)
+			script := deployment.Spec.Template.Spec.InitContainers[0].Args[1]
+			for _, s := range test.expectedScript {
+				assert.Contains(t, script, s)
+			}
+			for _, s := range test.unexpectedScript {
+				assert.NotContains(t, script, s)
+			}
+		})
+	}
+
+	_, err := machineConfigServerObjects(&componentContext{
+		hcp:      &hyperv1.HostedControlPlane{},
+		params:   render.NewClusterParams(),
+		versions: map[string]string{"release": "invalid"},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package clusterversionoperator
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	name                = "cluster-version-operator"
+	kubeconfigMountPath = "/etc/openshift/kubeconfig"
+	kubeconfigPath      = kubeconfigMountPath + "/kubeconfig"
+	payloadsMountPath   = "/etc/cvo/updatepayloads"
+)
+
+type Deployment struct {
+	Namespace string
+	// ReleaseImage is the release the cluster version operator reports
+	ReleaseImage string
+	// Image is the release image the operator runs from, with the mirrors of
+	// the control plane applied
+	Image string
+	// MetricsPusherImage, when set, adds a container that pushes the metrics
+	// of the operator to the ROKS metrics push gateway
+	MetricsPusherImage string
+	ClusterID          string
+	Resources          corev1.ResourceRequirements
+	Scheduling         *hyperv1.ControlPlaneScheduling
+}
+
+func (o Deployment) Build() *appsv1.Deployment {
+	containers := []corev1.Container{
+		{
+			Name:            name,
+			Image:           o.Image,
+			ImagePullPolicy: corev1.PullAlways,
+			Command:         []string{"cluster-version-operator"},
+			Args: []string{
+				"start",
+				"--release-image=" + o.ReleaseImage,
+				"--enable-auto-update=false",
+				"--enable-default-cluster-version=true",
+				"--kubeconfig=" + kubeconfigPath,
+				"--v=4",
+			},
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+			Resources:                o.Resources,
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      "etc-cvo-updatepayloads",
+					MountPath: payloadsMountPath,
+					ReadOnly:  true,
+				},
+				{
+					Name:      "kubeconfig",
+					MountPath: kubeconfigMountPath,
+					ReadOnly:  true,
+				},
+			},
+			Env: []corev1.EnvVar{
+				{
+					Name: "NODE_NAME",
+					ValueFrom: &corev1.EnvVarSource{
+						FieldRef: &corev1.ObjectFieldSelector{
+							FieldPath: "spec.nodeName",
+						},
+					},
+				},
+				{
+					Name:  "EXCLUDE_MANIFESTS",
+					Value: "internal-openshift-hosted",
+				},
+			},
+		},
+	}
+	if len(o.MetricsPusherImage) > 0 {
+		containers = append(containers, corev1.Container{
+			Name:            "metrics-pusher",
+			Image:           o.MetricsPusherImage,
+			ImagePullPolicy: corev1.PullAlways,
+			Command:         []string{"metrics-pusher"},
+			Args: []string{
+				"--destination-path=/api/v1/namespaces/openshift-roks-metrics/services/push-gateway:http/proxy/metrics/job/cluster-version-operator",
+				"--kubeconfig=" + kubeconfigPath,
+				"--frequency=30s",
+				"--source-url=http://localhost:9099/metrics",
+			},
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      "kubeconfig",
+					MountPath: kubeconfigMountPath,
+					ReadOnly:  true,
+				},
+			},
+		})
+	}
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: k8sutilspointer.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"k8s-app": name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+					Labels: map[string]string{
+						"k8s-app":   name,
+						"clusterID": o.ClusterID,
+					},
+				},
+				Spec: corev1.PodSpec{
+					AutomountServiceAccountToken: k8sutilspointer.BoolPtr(false),
+					Containers:                   containers,
+					Volumes: []corev1.Volume{
+						common.EmptyDirVolume("work"),
+						common.EmptyDirVolume("etc-cvo-updatepayloads"),
+						common.SecretVolume("kubeconfig", common.ServiceNetworkAdminKubeconfigSecretName),
+					},
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
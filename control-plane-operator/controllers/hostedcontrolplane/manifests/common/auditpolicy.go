@@ -0,0 +1,115 @@
+package common
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+// AuditPolicy is an audit.k8s.io/v1beta1 Policy.
+type AuditPolicy struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	OmitStages []string          `json:"omitStages,omitempty"`
+	Rules      []AuditPolicyRule `json:"rules"`
+}
+
+type AuditPolicyRule struct {
+	Level           string                `json:"level"`
+	Verbs           []string              `json:"verbs,omitempty"`
+	Resources       []AuditGroupResources `json:"resources,omitempty"`
+	NonResourceURLs []string              `json:"nonResourceURLs,omitempty"`
+	UserGroups      []string              `json:"userGroups,omitempty"`
+	OmitStages      []string              `json:"omitStages,omitempty"`
+}
+
+type AuditGroupResources struct {
+	Group     string   `json:"group"`
+	Resources []string `json:"resources"`
+}
+
+// DefaultAuditPolicy leaves out the requests that are too frequent or
+// sensitive to audit, logs the bodies of the requests the profile asks for,
+// and the metadata of the rest. unauditedURLs are the non-resource URLs that
+// are not audited.
+func DefaultAuditPolicy(profile hyperv1.AuditProfileType, unauditedURLs []string) AuditPolicy {
+	rules := []AuditPolicyRule{
+		{
+			Level: "None",
+			Resources: []AuditGroupResources{
+				{
+					Group:     "",
+					Resources: []string{"events"},
+				},
+			},
+		},
+		{
+			Level: "None",
+			Resources: []AuditGroupResources{
+				{
+					Group:     "oauth.openshift.io",
+					Resources: []string{"oauthaccesstokens", "oauthauthorizetokens"},
+				},
+			},
+		},
+		{
+			Level:           "None",
+			NonResourceURLs: unauditedURLs,
+			UserGroups:      []string{"system:authenticated", "system:unauthenticated"},
+		},
+	}
+	switch profile {
+	case hyperv1.WriteRequestBodiesAuditProfileType, hyperv1.AllRequestBodiesAuditProfileType:
+		// The bodies of these requests hold secrets
+		rules = append(rules, AuditPolicyRule{
+			Level: "Metadata",
+			Resources: []AuditGroupResources{
+				{
+					Group:     "",
+					Resources: []string{"secrets"},
+				},
+				{
+					Group:     "authentication.k8s.io",
+					Resources: []string{"tokenreviews"},
+				},
+				{
+					Group:     "oauth.openshift.io",
+					Resources: []string{"oauthclients"},
+				},
+			},
+		})
+		bodies := AuditPolicyRule{
+			Level:      "RequestResponse",
+			OmitStages: []string{"RequestReceived"},
+		}
+		if profile == hyperv1.WriteRequestBodiesAuditProfileType {
+			bodies.Verbs = []string{"create", "update", "patch", "delete", "deletecollection"}
+		}
+		rules = append(rules, bodies)
+	}
+	rules = append(rules, AuditPolicyRule{
+		Level:      "Metadata",
+		OmitStages: []string{"RequestReceived"},
+	})
+	return AuditPolicy{
+		APIVersion: "audit.k8s.io/v1beta1",
+		Kind:       "Policy",
+		OmitStages: []string{"RequestReceived"},
+		Rules:      rules,
+	}
+}
+
+// AuditPolicyData returns the audit policy of an API server, which is the
+// custom policy for the Custom profile.
+func AuditPolicyData(profile hyperv1.AuditProfileType, customPolicy string, unauditedURLs []string) (string, error) {
+	if profile == hyperv1.CustomAuditProfileType {
+		return customPolicy, nil
+	}
+	data, err := yaml.Marshal(DefaultAuditPolicy(profile, unauditedURLs))
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit policy: %w", err)
+	}
+	return string(data), nil
+}
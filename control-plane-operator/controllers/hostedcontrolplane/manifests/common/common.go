@@ -0,0 +1,175 @@
+// Package common holds what the typed builders of the control plane
+// components have in common.
+package common
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sutilspointer "k8s.io/utils/pointer"
+)
+
+const (
+	restartedAtAnnotation = "openshift.io/restartedAt"
+	hostnameTopologyKey   = "kubernetes.io/hostname"
+	zoneTopologyKey       = "failure-domain.beta.kubernetes.io/zone"
+)
+
+// Replicas returns the replicas of a component deployment.
+func Replicas(highlyAvailable bool) *int32 {
+	if highlyAvailable {
+		return k8sutilspointer.Int32Ptr(3)
+	}
+	return k8sutilspointer.Int32Ptr(1)
+}
+
+// RollingUpdateStrategy replaces the pods of a component deployment all at
+// once, while keeping all but one of them available.
+func RollingUpdateStrategy() appsv1.DeploymentStrategy {
+	maxSurge := intstr.FromInt(3)
+	maxUnavailable := intstr.FromInt(1)
+	return appsv1.DeploymentStrategy{
+		Type: appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDeployment{
+			MaxSurge:       &maxSurge,
+			MaxUnavailable: &maxUnavailable,
+		},
+	}
+}
+
+// RestartAnnotations returns the pod annotations that restart the pods of a
+// component when the restart date of the control plane changes.
+func RestartAnnotations(restartDate string) map[string]string {
+	if len(restartDate) == 0 {
+		return nil
+	}
+	return map[string]string{
+		restartedAtAnnotation: restartDate,
+	}
+}
+
+// MultiAZWorkerTolerations let components run on the multi-zone workers of
+// the management cluster.
+func MultiAZWorkerTolerations() []corev1.Toleration {
+	return []corev1.Toleration{
+		{
+			Key:      "multi-az-worker",
+			Operator: corev1.TolerationOpEqual,
+			Value:    "true",
+			Effect:   corev1.TaintEffectNoSchedule,
+		},
+	}
+}
+
+// Affinity keeps the pods of a cluster together, and the replicas of a
+// component on separate nodes and zones.
+func Affinity(app, clusterID string) *corev1.Affinity {
+	antiAffinityTerm := func(topologyKey string) corev1.PodAffinityTerm {
+		return corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      "app",
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{app},
+					},
+				},
+			},
+			TopologyKey: topologyKey,
+		}
+	}
+	return &corev1.Affinity{
+		PodAffinity: &corev1.PodAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+				{
+					Weight: 100,
+					PodAffinityTerm: corev1.PodAffinityTerm{
+						LabelSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{
+									Key:      "clusterID",
+									Operator: metav1.LabelSelectorOpIn,
+									Values:   []string{clusterID},
+								},
+							},
+						},
+						TopologyKey: hostnameTopologyKey,
+					},
+				},
+			},
+		},
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+				antiAffinityTerm(hostnameTopologyKey),
+				antiAffinityTerm(zoneTopologyKey),
+			},
+		},
+	}
+}
+
+// SecretVolume returns a volume of a secret.
+func SecretVolume(name, secretName string) corev1.Volume {
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+			},
+		},
+	}
+}
+
+// ConfigMapVolume returns a volume of a configmap.
+func ConfigMapVolume(name, configMapName string) corev1.Volume {
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: configMapName,
+				},
+			},
+		},
+	}
+}
+
+// EmptyDirVolume returns an empty directory volume.
+func EmptyDirVolume(name string) corev1.Volume {
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+}
+
+// ConfigMap returns a configmap of a control plane namespace.
+func ConfigMap(namespace, name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Data: data,
+	}
+}
+
+// Secret returns a secret of a control plane namespace.
+func Secret(namespace, name string, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Data: data,
+	}
+}
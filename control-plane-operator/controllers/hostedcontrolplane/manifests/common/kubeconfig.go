@@ -0,0 +1,21 @@
+package common
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ServiceNetworkAdminKubeconfigSecretName is the secret with the admin
+// kubeconfig of the guest cluster that components reach the kube-apiserver
+// with through its service.
+const ServiceNetworkAdminKubeconfigSecretName = "service-network-admin-kubeconfig"
+
+type ServiceNetworkAdminKubeconfigSecret struct {
+	Namespace  string
+	Kubeconfig []byte
+}
+
+func (o ServiceNetworkAdminKubeconfigSecret) Build() *corev1.Secret {
+	return Secret(o.Namespace, ServiceNetworkAdminKubeconfigSecretName, map[string][]byte{
+		"kubeconfig": o.Kubeconfig,
+	})
+}
@@ -0,0 +1,19 @@
+package common
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+)
+
+// RandomString returns a random string of a size read from random. It only
+// holds URL safe characters.
+func RandomString(random io.Reader, size int) (string, error) {
+	// Each byte gives 4/3 base64 characters, with one more byte to make up
+	// for the truncation
+	b := make([]byte, base64.RawURLEncoding.DecodedLen(size)+1)
+	if _, err := io.ReadFull(random, b); err != nil {
+		return "", fmt.Errorf("failed to read random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)[:size], nil
+}
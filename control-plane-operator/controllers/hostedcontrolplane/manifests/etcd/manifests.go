@@ -0,0 +1,309 @@
+package etcd
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	operatorName = "etcd-operator"
+	clusterName  = "etcd"
+)
+
+// The etcd operator has no Go types in the tree, so its custom resource
+// definition and cluster are built as unstructured objects.
+
+type ClusterCustomResourceDefinition struct{}
+
+func (o ClusterCustomResourceDefinition) Build() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1beta1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"name": "etcdclusters.etcd.database.coreos.com",
+			},
+			"spec": map[string]interface{}{
+				"conversion": map[string]interface{}{
+					"strategy": "None",
+				},
+				"group": "etcd.database.coreos.com",
+				"names": map[string]interface{}{
+					"kind":       "EtcdCluster",
+					"listKind":   "EtcdClusterList",
+					"plural":     "etcdclusters",
+					"shortNames": []interface{}{"etcd"},
+					"singular":   "etcdcluster",
+				},
+				"scope":   "Namespaced",
+				"version": "v1beta2",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name":    "v1beta2",
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+}
+
+type Cluster struct {
+	Namespace string
+	// MemberImage is the image of the etcd members. The operator pulls it by
+	// repository and version, so it must be referenced by a v<version> tag.
+	MemberImage string
+	Scheduling  *hyperv1.ControlPlaneScheduling
+}
+
+func (o Cluster) Build() (*unstructured.Unstructured, error) {
+	repository, version, err := memberRepositoryVersion(o.MemberImage)
+	if err != nil {
+		return nil, err
+	}
+	cluster := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "etcd.database.coreos.com/v1beta2",
+			"kind":       "EtcdCluster",
+			"metadata": map[string]interface{}{
+				"name":      clusterName,
+				"namespace": o.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"size":       int64(1),
+				"repository": repository,
+				"version":    version,
+				"TLS": map[string]interface{}{
+					"static": map[string]interface{}{
+						"member": map[string]interface{}{
+							"peerSecret":   TLSSecretName(PeerSecret),
+							"serverSecret": TLSSecretName(ServerSecret),
+						},
+						"operatorSecret": TLSSecretName(ClientSecret),
+					},
+				},
+			},
+		},
+	}
+	if err := scheduling.ApplyToObject(cluster, o.Scheduling); err != nil {
+		return nil, fmt.Errorf("failed to apply control plane scheduling to etcd cluster: %w", err)
+	}
+	return cluster, nil
+}
+
+// memberRepositoryVersion splits the image of the etcd members into the
+// repository and version the operator pulls it by.
+func memberRepositoryVersion(image string) (string, string, error) {
+	i := strings.LastIndex(image, ":")
+	if strings.Contains(image, "@") || i < 0 || strings.Contains(image[i:], "/") || !strings.HasPrefix(image[i+1:], "v") {
+		return "", "", fmt.Errorf("etcd member image %q must be referenced by a v<version> tag", image)
+	}
+	return image[:i], image[i+2:], nil
+}
+
+// TLSSecretType is the purpose of an etcd certificate.
+type TLSSecretType string
+
+const (
+	ClientSecret TLSSecretType = "etcd-client"
+	ServerSecret TLSSecretType = "server"
+	PeerSecret   TLSSecretType = "peer"
+)
+
+// pkiName is the name of the certificate of a type in the PKI of the control
+// plane.
+func (t TLSSecretType) pkiName() string {
+	if t == ClientSecret {
+		return string(t)
+	}
+	return "etcd-" + string(t)
+}
+
+// TLSSecretName returns the name of the secret holding the certificate of a
+// type.
+func TLSSecretName(t TLSSecretType) string {
+	return t.pkiName() + "-tls"
+}
+
+// TLSSecret holds a certificate in the layout the etcd operator expects.
+type TLSSecret struct {
+	Namespace string
+	Type      TLSSecretType
+	// PKI is the PKI of the control plane
+	PKI map[string][]byte
+}
+
+func (o TLSSecret) Build() *corev1.Secret {
+	prefix := string(o.Type)
+	return common.Secret(o.Namespace, TLSSecretName(o.Type), map[string][]byte{
+		prefix + ".crt":    o.PKI[o.Type.pkiName()+".crt"],
+		prefix + ".key":    o.PKI[o.Type.pkiName()+".key"],
+		prefix + "-ca.crt": o.PKI["root-ca.crt"],
+	})
+}
+
+type OperatorServiceAccount struct {
+	Namespace string
+}
+
+func (o OperatorServiceAccount) Build() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ServiceAccount",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      operatorName,
+			Namespace: o.Namespace,
+		},
+	}
+}
+
+// OperatorClusterRole is shared by the etcd operators of all control planes.
+type OperatorClusterRole struct{}
+
+func (o OperatorClusterRole) Build() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRole",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: operatorName,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{"etcd.database.coreos.com"},
+				Resources: []string{"etcdclusters", "etcdbackups", "etcdrestores"},
+				Verbs:     []string{"*"},
+			},
+			{
+				APIGroups: []string{"apiextensions.k8s.io"},
+				Resources: []string{"customresourcedefinitions"},
+				Verbs:     []string{"*"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods", "services", "endpoints", "persistentvolumeclaims", "events"},
+				Verbs:     []string{"*"},
+			},
+			{
+				APIGroups: []string{"apps"},
+				Resources: []string{"deployments"},
+				Verbs:     []string{"*"},
+			},
+			// Only needed for S3 backups and TLS
+			{
+				APIGroups: []string{""},
+				Resources: []string{"secrets"},
+				Verbs:     []string{"get"},
+			},
+		},
+	}
+}
+
+// OperatorClusterRoleBinding binds the shared cluster role to the operator of
+// the control plane applied last.
+type OperatorClusterRoleBinding struct {
+	Namespace string
+}
+
+func (o OperatorClusterRoleBinding) Build() *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRoleBinding",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: operatorName,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     operatorName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      operatorName,
+				Namespace: o.Namespace,
+			},
+		},
+	}
+}
+
+type OperatorDeployment struct {
+	Namespace  string
+	Image      string
+	Scheduling *hyperv1.ControlPlaneScheduling
+}
+
+func (o OperatorDeployment) Build() *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      operatorName,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: k8sutilspointer.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"name": operatorName,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"name": operatorName,
+					},
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: operatorName,
+					Containers: []corev1.Container{
+						{
+							Name:    operatorName,
+							Image:   o.Image,
+							Command: []string{"etcd-operator"},
+							Env: []corev1.EnvVar{
+								{
+									Name: "MY_POD_NAMESPACE",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{
+											FieldPath: "metadata.namespace",
+										},
+									},
+								},
+								{
+									Name: "MY_POD_NAME",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{
+											FieldPath: "metadata.name",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
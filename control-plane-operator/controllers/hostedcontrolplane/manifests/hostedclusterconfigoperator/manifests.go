@@ -0,0 +1,256 @@
+package hostedclusterconfigoperator
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	name                = "hosted-cluster-config-operator"
+	initialCAKey        = "initial-ca.crt"
+	kubeconfigMountPath = "/etc/kubernetes/kubeconfig"
+	configMountPath     = "/etc/kubernetes/config"
+)
+
+// ClusterRoleName is the name of the cluster role that let the operator of a
+// control plane namespace watch the routes of all namespaces, before its
+// access to routes was scoped to the control plane namespace.
+func ClusterRoleName(namespace string) string {
+	return name + "-" + namespace
+}
+
+type ServiceAccount struct {
+	Namespace string
+}
+
+func (o ServiceAccount) Build() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ServiceAccount",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		ImagePullSecrets: []corev1.LocalObjectReference{
+			{
+				Name: "pull-secret",
+			},
+		},
+	}
+}
+
+type Role struct {
+	Namespace string
+}
+
+func (o Role) Build() *rbacv1.Role {
+	return &rbacv1.Role{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Role",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"configmaps", "pods"},
+				Verbs:     []string{"get", "patch", "update", "create", "list", "watch"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"secrets"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"extensions", "apps"},
+				Resources: []string{"deployments"},
+				Verbs:     []string{"get", "patch", "update", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"route.openshift.io"},
+				Resources: []string{"routes", "routes/custom-host"},
+				Verbs:     []string{"get", "list", "watch", "patch", "update", "create", "delete"},
+			},
+			{
+				APIGroups: []string{"hypershift.openshift.io"},
+				Resources: []string{"hostedcontrolplanes"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"hypershift.openshift.io"},
+				Resources: []string{"hostedcontrolplanes/status"},
+				Verbs:     []string{"get", "patch", "update"},
+			},
+		},
+	}
+}
+
+type RoleBinding struct {
+	Namespace string
+}
+
+func (o RoleBinding) Build() *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "RoleBinding",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     name,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind: "ServiceAccount",
+				Name: name,
+			},
+		},
+	}
+}
+
+type ConfigMap struct {
+	Namespace string
+	InitialCA []byte
+}
+
+func (o ConfigMap) Build() *corev1.ConfigMap {
+	return common.ConfigMap(o.Namespace, name, map[string]string{
+		initialCAKey: string(o.InitialCA),
+	})
+}
+
+type Deployment struct {
+	Namespace         string
+	Image             string
+	ClusterID         string
+	RestartDate       string
+	PriorityClassName string
+	// RunAsUser, when set, is the user the operator runs as
+	RunAsUser *int64
+	// ReleaseVersion and KubernetesVersion are the versions the operator
+	// reports for the guest cluster
+	ReleaseVersion    string
+	KubernetesVersion string
+	// Controllers are the controllers the operator runs, or all of them if
+	// empty
+	Controllers []string
+	Resources   corev1.ResourceRequirements
+	Scheduling  *hyperv1.ControlPlaneScheduling
+}
+
+func (o Deployment) Build() *appsv1.Deployment {
+	command := []string{
+		"/usr/bin/hosted-cluster-config-operator",
+		"--initial-ca-file=" + configMountPath + "/" + initialCAKey,
+		"--target-kubeconfig=" + kubeconfigMountPath + "/kubeconfig",
+		"--namespace",
+		"$(POD_NAMESPACE)",
+	}
+	for _, controller := range o.Controllers {
+		command = append(command, "--controllers="+controller)
+	}
+	var securityContext *corev1.SecurityContext
+	if o.RunAsUser != nil {
+		securityContext = &corev1.SecurityContext{
+			RunAsUser: o.RunAsUser,
+		}
+	}
+	affinity := common.Affinity(name, o.ClusterID)
+	// The operator runs a single replica, so only its affinity to the other
+	// components of the cluster applies
+	affinity.PodAntiAffinity = nil
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: k8sutilspointer.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app":       name,
+						"clusterID": o.ClusterID,
+					},
+					Annotations: common.RestartAnnotations(o.RestartDate),
+				},
+				Spec: corev1.PodSpec{
+					Affinity:          affinity,
+					Tolerations:       common.MultiAZWorkerTolerations(),
+					PriorityClassName: o.PriorityClassName,
+					Containers: []corev1.Container{
+						{
+							Name:            name,
+							Image:           o.Image,
+							SecurityContext: securityContext,
+							Env: []corev1.EnvVar{
+								{
+									Name: "POD_NAMESPACE",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{
+											FieldPath: "metadata.namespace",
+										},
+									},
+								},
+								{
+									Name:  "OPENSHIFT_RELEASE_VERSION",
+									Value: o.ReleaseVersion,
+								},
+								{
+									Name:  "KUBERNETES_VERSION",
+									Value: o.KubernetesVersion,
+								},
+							},
+							Command:   command,
+							Resources: o.Resources,
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "kubeconfig",
+									MountPath: kubeconfigMountPath,
+								},
+								{
+									Name:      "config",
+									MountPath: configMountPath,
+								},
+							},
+						},
+					},
+					RestartPolicy:      corev1.RestartPolicyAlways,
+					ServiceAccountName: name,
+					Volumes: []corev1.Volume{
+						common.SecretVolume("kubeconfig", common.ServiceNetworkAdminKubeconfigSecretName),
+						common.ConfigMapVolume("config", name),
+					},
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
@@ -0,0 +1,137 @@
+package konnectivity
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	serverName      = "konnectivity-server"
+	agentName       = "konnectivity-agent"
+	agentMountPath  = "/etc/konnectivity/agent"
+	agentHealthPort = 2041
+)
+
+// ServerSecret holds the serving certificate of the konnectivity server the
+// API server runs as a sidecar.
+type ServerSecret struct {
+	Namespace string
+	// PKI is the PKI of the control plane
+	PKI map[string][]byte
+}
+
+func (o ServerSecret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, serverName, map[string][]byte{
+		"tls.crt": o.PKI["konnectivity-server.crt"],
+		"tls.key": o.PKI["konnectivity-server.key"],
+		"ca.crt":  o.PKI["konnectivity-ca.crt"],
+	})
+}
+
+// AgentSecret holds the client certificate of the konnectivity agent of the
+// control plane namespace.
+type AgentSecret struct {
+	Namespace string
+	// PKI is the PKI of the control plane
+	PKI map[string][]byte
+}
+
+func (o AgentSecret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, agentName, map[string][]byte{
+		"tls.crt": o.PKI["konnectivity-agent.crt"],
+		"tls.key": o.PKI["konnectivity-agent.key"],
+		"ca.crt":  o.PKI["konnectivity-ca.crt"],
+	})
+}
+
+// AgentDeployment is the konnectivity agent that routes the traffic of the API
+// server to the aggregated API servers of the control plane namespace back
+// through the konnectivity server.
+type AgentDeployment struct {
+	Namespace string
+	Image     string
+	// Command runs the proxy-agent binary of the image
+	Command               []string
+	RestartDate           string
+	OpenShiftAPIClusterIP string
+	OAuthAPIClusterIP     string
+	Scheduling            *hyperv1.ControlPlaneScheduling
+}
+
+func (o AgentDeployment) Build() *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentName,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: k8sutilspointer.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": agentName,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": agentName,
+					},
+					Annotations: common.RestartAnnotations(o.RestartDate),
+				},
+				Spec: corev1.PodSpec{
+					AutomountServiceAccountToken: k8sutilspointer.BoolPtr(false),
+					Containers: []corev1.Container{
+						{
+							Name:    agentName,
+							Image:   o.Image,
+							Command: o.Command,
+							Args: []string{
+								"--logtostderr=true",
+								"--ca-cert", agentMountPath + "/ca.crt",
+								"--agent-cert", agentMountPath + "/tls.crt",
+								"--agent-key", agentMountPath + "/tls.key",
+								"--proxy-server-host", serverName,
+								"--proxy-server-port", "8091",
+								"--health-server-port", "2041",
+								"--agent-identifiers", "ipv4=" + o.OpenShiftAPIClusterIP + "&ipv4=" + o.OAuthAPIClusterIP,
+							},
+							LivenessProbe: &corev1.Probe{
+								Handler: corev1.Handler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path:   "healthz",
+										Port:   intstr.FromInt(agentHealthPort),
+										Scheme: corev1.URISchemeHTTP,
+									},
+								},
+								InitialDelaySeconds: 30,
+								TimeoutSeconds:      10,
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "agent-certs",
+									MountPath: agentMountPath,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						common.SecretVolume("agent-certs", agentName),
+					},
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
@@ -0,0 +1,412 @@
+package kubeapiserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/openvpn"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+// kubeAPIServerConfig is the part of the KubeAPIServerConfig the control
+// plane sets.
+type kubeAPIServerConfig struct {
+	APIVersion                   string              `json:"apiVersion"`
+	Kind                         string              `json:"kind"`
+	Admission                    admissionConfig     `json:"admission"`
+	APIServerArguments           map[string][]string `json:"apiServerArguments"`
+	AuthConfig                   authConfig          `json:"authConfig"`
+	ConsolePublicURL             string              `json:"consolePublicURL"`
+	CORSAllowedOrigins           []string            `json:"corsAllowedOrigins"`
+	ImagePolicyConfig            imagePolicyConfig   `json:"imagePolicyConfig"`
+	ProjectConfig                projectConfig       `json:"projectConfig"`
+	ServiceAccountPublicKeyFiles []string            `json:"serviceAccountPublicKeyFiles"`
+	ServicesSubnet               string              `json:"servicesSubnet"`
+	ServingInfo                  servingInfo         `json:"servingInfo"`
+}
+
+type admissionConfig struct {
+	PluginConfig map[string]admissionPluginConfig `json:"pluginConfig"`
+}
+
+type admissionPluginConfig struct {
+	Configuration interface{} `json:"configuration"`
+	Location      string      `json:"location"`
+}
+
+type externalIPRangerAdmissionConfig struct {
+	AllowIngressIP         bool     `json:"allowIngressIP"`
+	APIVersion             string   `json:"apiVersion"`
+	ExternalIPNetworkCIDRs []string `json:"externalIPNetworkCIDRs"`
+	Kind                   string   `json:"kind"`
+}
+
+type restrictedEndpointsAdmissionConfig struct {
+	APIVersion      string   `json:"apiVersion"`
+	Kind            string   `json:"kind"`
+	RestrictedCIDRs []string `json:"restrictedCIDRs"`
+}
+
+type authConfig struct {
+	OAuthMetadataFile string `json:"oauthMetadataFile"`
+}
+
+type imagePolicyConfig struct {
+	InternalRegistryHostname string `json:"internalRegistryHostname"`
+}
+
+type projectConfig struct {
+	DefaultNodeSelector string `json:"defaultNodeSelector"`
+}
+
+type servingInfo struct {
+	BindAddress       string             `json:"bindAddress"`
+	BindNetwork       string             `json:"bindNetwork"`
+	CipherSuites      []string           `json:"cipherSuites"`
+	MinTLSVersion     string             `json:"minTLSVersion"`
+	NamedCertificates []namedCertificate `json:"namedCertificates,omitempty"`
+}
+
+type namedCertificate struct {
+	CertFile string   `json:"certFile"`
+	KeyFile  string   `json:"keyFile"`
+	Names    []string `json:"names"`
+}
+
+// enabledAdmissionPlugins are the admission plugins of the API server on top
+// of the default ones.
+var enabledAdmissionPlugins = []string{
+	"CertificateApproval",
+	"CertificateSigning",
+	"CertificateSubjectRestriction",
+	"DefaultIngressClass",
+	"DefaultStorageClass",
+	"DefaultTolerationSeconds",
+	"LimitRanger",
+	"MutatingAdmissionWebhook",
+	"NamespaceLifecycle",
+	"NodeRestriction",
+	"OwnerReferencesPermissionEnforcement",
+	"PersistentVolumeClaimResize",
+	"PersistentVolumeLabel",
+	"PodNodeSelector",
+	"PodTolerationRestriction",
+	"Priority",
+	"ResourceQuota",
+	"RuntimeClass",
+	"ServiceAccount",
+	"StorageObjectInUseProtection",
+	"TaintNodesByCondition",
+	"ValidatingAdmissionWebhook",
+	"authorization.openshift.io/RestrictSubjectBindings",
+	"authorization.openshift.io/ValidateRoleBindingRestriction",
+	"config.openshift.io/DenyDeleteClusterConfiguration",
+	"config.openshift.io/ValidateAPIServer",
+	"config.openshift.io/ValidateAuthentication",
+	"config.openshift.io/ValidateConsole",
+	"config.openshift.io/ValidateFeatureGate",
+	"config.openshift.io/ValidateImage",
+	"config.openshift.io/ValidateOAuth",
+	"config.openshift.io/ValidateProject",
+	"config.openshift.io/ValidateScheduler",
+	"image.openshift.io/ImagePolicy",
+	"network.openshift.io/ExternalIPRanger",
+	"network.openshift.io/RestrictedEndpointsAdmission",
+	"quota.openshift.io/ClusterResourceQuota",
+	"quota.openshift.io/ValidateClusterResourceQuota",
+	"route.openshift.io/IngressAdmission",
+	"scheduling.openshift.io/OriginPodNodeEnvironment",
+	"security.openshift.io/DefaultSecurityContextConstraints",
+	"security.openshift.io/SCCExecRestrictions",
+	"security.openshift.io/SecurityContextConstraint",
+	"security.openshift.io/ValidateSecurityContextConstraints",
+}
+
+// cipherSuites are the TLS cipher suites the API server accepts.
+var cipherSuites = []string{
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256",
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+}
+
+// AuditLog configures the audit log of the API server.
+type AuditLog struct {
+	MaxSize    int32
+	MaxBackups int32
+	// MaxAge is the days audit logs are kept, or 0 to keep them forever
+	MaxAge int32
+	// WebhookMode is set when audit events are also sent to a webhook
+	WebhookMode string
+}
+
+type ConfigMap struct {
+	Namespace string
+	// ExternalAPIAddress is the address the API server advertises
+	ExternalAPIAddress string
+	InternalAPIPort    uint
+	CloudProvider      string
+	ServiceCIDR        string
+	ServiceNetworks    []string
+	ClusterNetworks    []render.ClusterNetwork
+	IngressSubdomain   string
+	// EtcdClientName is the name of the etcd client service
+	EtcdClientName string
+	FeatureGates   []string
+	AuditLog       AuditLog
+	// KonnectivityEnabled reaches the guest cluster through the konnectivity
+	// server instead of the VPN
+	KonnectivityEnabled bool
+	// SecretEncryption is set when secrets are encrypted at rest
+	SecretEncryption bool
+	NamedCerts       []render.NamedCert
+}
+
+func (o ConfigMap) Build() (*corev1.ConfigMap, error) {
+	var restrictedCIDRs []string
+	for _, network := range o.ClusterNetworks {
+		restrictedCIDRs = append(restrictedCIDRs, network.CIDR)
+	}
+	restrictedCIDRs = append(restrictedCIDRs, o.ServiceNetworks...)
+	args := map[string][]string{
+		"advertise-address":                  {o.ExternalAPIAddress},
+		"allow-privileged":                   {"true"},
+		"anonymous-auth":                     {"true"},
+		"api-audiences":                      {"https://kubernetes.default.svc"},
+		"audit-log-format":                   {"json"},
+		"audit-log-maxbackup":                {strconv.Itoa(int(o.AuditLog.MaxBackups))},
+		"audit-log-maxsize":                  {strconv.Itoa(int(o.AuditLog.MaxSize))},
+		"audit-log-path":                     {auditLogDir + "/audit.log"},
+		"audit-policy-file":                  {auditPolicyMountPath + "/" + auditPolicyKey},
+		"authorization-mode":                 {"Scope", "SystemMasters", "RBAC", "Node"},
+		"client-ca-file":                     {configMountPath + "/" + clientCAKey},
+		"cloud-provider":                     {o.CloudProvider},
+		"enable-admission-plugins":           enabledAdmissionPlugins,
+		"enable-aggregator-routing":          {"true"},
+		"enable-logs-handler":                {"false"},
+		"enable-swagger-ui":                  {"true"},
+		"endpoint-reconciler-type":           {"lease"},
+		"etcd-cafile":                        {configMountPath + "/" + etcdCAKey},
+		"etcd-certfile":                      {secretMountPath + "/" + etcdClientCertKey},
+		"etcd-keyfile":                       {secretMountPath + "/" + etcdClientKeyKey},
+		"etcd-prefix":                        {"kubernetes.io"},
+		"etcd-servers":                       {fmt.Sprintf("https://%s:2379", o.EtcdClientName)},
+		"event-ttl":                          {"3h"},
+		"feature-gates":                      o.FeatureGates,
+		"goaway-chance":                      {"0"},
+		"http2-max-streams-per-connection":   {"2000"},
+		"insecure-port":                      {"0"},
+		"kubelet-certificate-authority":      {configMountPath + "/" + kubeletClientCAKey},
+		"kubelet-client-certificate":         {secretMountPath + "/" + kubeletClientCertKey},
+		"kubelet-client-key":                 {secretMountPath + "/" + kubeletClientKeyKey},
+		"kubelet-https":                      {"true"},
+		"kubelet-preferred-address-types":    {"InternalIP"},
+		"kubelet-read-only-port":             {"0"},
+		"kubernetes-service-node-port":       {"0"},
+		"max-mutating-requests-inflight":     {"1000"},
+		"max-requests-inflight":              {"3000"},
+		"min-request-timeout":                {"3600"},
+		"proxy-client-cert-file":             {secretMountPath + "/" + proxyClientCertKey},
+		"proxy-client-key-file":              {secretMountPath + "/" + proxyClientKeyKey},
+		"requestheader-allowed-names":        {"kube-apiserver-proxy", "system:kube-apiserver-proxy", "system:openshift-aggregator"},
+		"requestheader-client-ca-file":       {configMountPath + "/" + aggregatorClientCAKey},
+		"requestheader-extra-headers-prefix": {"X-Remote-Extra-"},
+		"requestheader-group-headers":        {"X-Remote-Group"},
+		"requestheader-username-headers":     {"X-Remote-User"},
+		"runtime-config":                     {"flowcontrol.apiserver.k8s.io/v1alpha1=true"},
+		"service-account-issuer":             {"https://kubernetes.default.svc"},
+		"service-account-lookup":             {"true"},
+		"service-account-signing-key-file":   {secretMountPath + "/" + serviceAccountKeyKey},
+		"service-node-port-range":            {"30000-32767"},
+		"shutdown-delay-duration":            {"70s"},
+		"storage-backend":                    {"etcd3"},
+		"storage-media-type":                 {"application/vnd.kubernetes.protobuf"},
+		"tls-cert-file":                      {secretMountPath + "/" + serverCertKey},
+		"tls-private-key-file":               {secretMountPath + "/" + serverKeyKey},
+	}
+	if o.AuditLog.MaxAge > 0 {
+		args["audit-log-maxage"] = []string{strconv.Itoa(int(o.AuditLog.MaxAge))}
+	}
+	if len(o.AuditLog.WebhookMode) > 0 {
+		args["audit-webhook-config-file"] = []string{auditWebhookMountPath + "/" + auditWebhookKubeconfigKey}
+		args["audit-webhook-mode"] = []string{o.AuditLog.WebhookMode}
+	}
+	if o.KonnectivityEnabled {
+		args["egress-selector-config-file"] = []string{configMountPath + "/" + egressSelectorConfigKey}
+	}
+	if o.SecretEncryption {
+		args["encryption-provider-config"] = []string{encryptionConfigMountPath + "/encryption-config.yaml"}
+	}
+	var namedCertificates []namedCertificate
+	for _, cert := range o.NamedCerts {
+		namedCertificates = append(namedCertificates, namedCertificate{
+			CertFile: cert.NamedCertPrefix + ".crt",
+			KeyFile:  cert.NamedCertPrefix + ".key",
+			Names:    []string{cert.NamedCertDomain},
+		})
+	}
+	config := kubeAPIServerConfig{
+		APIVersion: "kubecontrolplane.config.openshift.io/v1",
+		Kind:       "KubeAPIServerConfig",
+		Admission: admissionConfig{
+			PluginConfig: map[string]admissionPluginConfig{
+				"network.openshift.io/ExternalIPRanger": {
+					Configuration: externalIPRangerAdmissionConfig{
+						APIVersion: "network.openshift.io/v1",
+						Kind:       "ExternalIPRangerAdmissionConfig",
+					},
+				},
+				"network.openshift.io/RestrictedEndpointsAdmission": {
+					Configuration: restrictedEndpointsAdmissionConfig{
+						APIVersion:      "network.openshift.io/v1",
+						Kind:            "RestrictedEndpointsAdmissionConfig",
+						RestrictedCIDRs: restrictedCIDRs,
+					},
+				},
+			},
+		},
+		APIServerArguments: args,
+		AuthConfig: authConfig{
+			OAuthMetadataFile: oauthMetadataMountPath + "/" + oauthMetadataKey,
+		},
+		ConsolePublicURL:   "https://console-openshift-console." + o.IngressSubdomain,
+		CORSAllowedOrigins: []string{`//127\.0\.0\.1(:|$)`, "//localhost(:|$)"},
+		ImagePolicyConfig: imagePolicyConfig{
+			InternalRegistryHostname: "image-registry.openshift-image-registry.svc:5000",
+		},
+		ServiceAccountPublicKeyFiles: []string{configMountPath + "/" + serviceAccountPubKey},
+		ServicesSubnet:               o.ServiceCIDR,
+		ServingInfo: servingInfo{
+			BindAddress:       fmt.Sprintf("0.0.0.0:%d", o.InternalAPIPort),
+			BindNetwork:       "tcp4",
+			CipherSuites:      cipherSuites,
+			MinTLSVersion:     "VersionTLS12",
+			NamedCertificates: namedCertificates,
+		},
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode kube-apiserver config: %w", err)
+	}
+	return common.ConfigMap(o.Namespace, configMapName, map[string]string{
+		configKey: string(data),
+	}), nil
+}
+
+// oauthMetadata is the OAuth 2.0 authorization server metadata the API
+// server publishes for the OAuth server.
+type oauthMetadata struct {
+	Issuer                        string   `json:"issuer"`
+	AuthorizationEndpoint         string   `json:"authorization_endpoint"`
+	TokenEndpoint                 string   `json:"token_endpoint"`
+	ScopesSupported               []string `json:"scopes_supported"`
+	ResponseTypesSupported        []string `json:"response_types_supported"`
+	GrantTypesSupported           []string `json:"grant_types_supported"`
+	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported"`
+}
+
+type OAuthMetadataConfigMap struct {
+	Namespace    string
+	OAuthDNSName string
+	// OAuthPort is the external port of the OAuth server, or 0 when the OAuth
+	// server is exposed by the ingress of the guest cluster
+	OAuthPort        uint
+	IngressSubdomain string
+}
+
+func (o OAuthMetadataConfigMap) Build() (*corev1.ConfigMap, error) {
+	issuer := "https://oauth-openshift." + o.IngressSubdomain
+	if o.OAuthPort != 0 {
+		issuer = fmt.Sprintf("https://%s:%d", o.OAuthDNSName, o.OAuthPort)
+	}
+	data, err := json.MarshalIndent(oauthMetadata{
+		Issuer:                        issuer,
+		AuthorizationEndpoint:         issuer + "/oauth/authorize",
+		TokenEndpoint:                 issuer + "/oauth/token",
+		ScopesSupported:               []string{"user:check-access", "user:full", "user:info", "user:list-projects", "user:list-scoped-projects"},
+		ResponseTypesSupported:        []string{"code", "token"},
+		GrantTypesSupported:           []string{"authorization_code", "implicit"},
+		CodeChallengeMethodsSupported: []string{"plain", "S256"},
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode oauth metadata: %w", err)
+	}
+	return common.ConfigMap(o.Namespace, oauthMetadataConfigMapName, map[string]string{
+		oauthMetadataKey: string(data),
+	}), nil
+}
+
+// unauditedURLs are the non-resource URLs the kube-apiserver does not audit.
+var unauditedURLs = []string{"/api*", "/version", "/healthz", "/readyz"}
+
+type AuditPolicyConfigMap struct {
+	Namespace    string
+	Profile      hyperv1.AuditProfileType
+	CustomPolicy string
+}
+
+func (o AuditPolicyConfigMap) Build() (*corev1.ConfigMap, error) {
+	policy, err := common.AuditPolicyData(o.Profile, o.CustomPolicy, unauditedURLs)
+	if err != nil {
+		return nil, err
+	}
+	return common.ConfigMap(o.Namespace, auditPolicyConfigMapName, map[string]string{
+		auditPolicyKey: policy,
+	}), nil
+}
+
+// egressSelectorConfig sends the traffic of the API server to the guest
+// cluster through the konnectivity server.
+const egressSelectorConfig = `apiVersion: apiserver.k8s.io/v1beta1
+kind: EgressSelectorConfiguration
+egressSelections:
+- name: cluster
+  connection:
+    proxyProtocol: GRPC
+    transport:
+      uds:
+        udsName: ` + konnectivitySocket + `
+`
+
+type CAConfigMap struct {
+	Namespace string
+	// PKI is the PKI of the control plane
+	PKI                 map[string][]byte
+	KonnectivityEnabled bool
+}
+
+func (o CAConfigMap) Build() *corev1.ConfigMap {
+	data := map[string]string{
+		aggregatorClientCAKey: string(o.PKI["root-ca.crt"]),
+		clientCAKey:           string(o.PKI["client-ca.crt"]),
+		kubeletClientCAKey:    string(o.PKI["combined-ca.crt"]),
+		serviceAccountPubKey:  string(o.PKI["service-account.pub"]),
+		servingCAKey:          string(o.PKI["combined-ca.crt"]),
+		etcdCAKey:             string(o.PKI["root-ca.crt"]),
+	}
+	if o.KonnectivityEnabled {
+		data[egressSelectorConfigKey] = egressSelectorConfig
+	}
+	return common.ConfigMap(o.Namespace, name, data)
+}
+
+// VPNClientConfigMap holds the configuration of the VPN client of the API
+// server.
+type VPNClientConfigMap struct {
+	Namespace string
+}
+
+func (o VPNClientConfigMap) Build() *corev1.ConfigMap {
+	return openvpn.ClientConfigMap{
+		Namespace: o.Namespace,
+		Name:      vpnClientConfigMapName,
+	}.Build()
+}
@@ -0,0 +1,91 @@
+package kubeapiserver
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	// EncryptionMigrationJobName is the name of the job that rewrites the
+	// secrets of the guest cluster after an encryption key rotation
+	EncryptionMigrationJobName      = "kube-apiserver-encryption-migration"
+	encryptionKeyRotationAnnotation = "hypershift.openshift.io/encryption-key-rotation"
+	migrationKubeconfigMountPath    = "/etc/kubernetes/kubeconfig"
+)
+
+// EncryptionMigrationJob rewrites every secret of the guest cluster, so that
+// they are stored encrypted with the key that is used for encryption and the
+// older keys can be removed from the encryption config.
+type EncryptionMigrationJob struct {
+	Namespace string
+	// Image is the image of the CLI
+	Image string
+	// Rotation is the key rotation the secrets are migrated for
+	Rotation   string
+	Scheduling *hyperv1.ControlPlaneScheduling
+}
+
+func (o EncryptionMigrationJob) Build() *batchv1.Job {
+	labels := map[string]string{"app": EncryptionMigrationJobName}
+	job := &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      EncryptionMigrationJobName,
+			Namespace: o.Namespace,
+			Annotations: map[string]string{
+				encryptionKeyRotationAnnotation: o.Rotation,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: k8sutilspointer.Int32Ptr(3),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:                corev1.RestartPolicyNever,
+					AutomountServiceAccountToken: k8sutilspointer.BoolPtr(false),
+					Containers: []corev1.Container{
+						{
+							Name:  "migrate",
+							Image: o.Image,
+							Command: []string{
+								"oc", "adm", "migrate", "storage",
+								"--include=secrets",
+								"--confirm",
+								"--kubeconfig=" + migrationKubeconfigMountPath + "/kubeconfig",
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "kubeconfig",
+									MountPath: migrationKubeconfigMountPath,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "kubeconfig",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: common.ServiceNetworkAdminKubeconfigSecretName,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&job.Spec.Template.Spec, o.Scheduling, nil)
+	return job
+}
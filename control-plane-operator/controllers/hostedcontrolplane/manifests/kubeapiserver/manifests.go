@@ -0,0 +1,513 @@
+package kubeapiserver
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/openvpn"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	name                           = "kube-apiserver"
+	configMapName                  = "kube-apiserver-config"
+	oauthMetadataConfigMapName     = "kube-apiserver-oauth-metadata"
+	auditPolicyConfigMapName       = "apiserver-default-audit-cm"
+	localhostKubeconfigSecretName  = "localhost-admin-kubeconfig"
+	vpnClientConfigMapName         = "kube-apiserver-vpnclient-config"
+	vpnClientSecretName            = "kube-apiserver-vpnclient-secret"
+	kmsCredentialsSecretName       = "kube-apiserver-kms-credentials"
+	encryptionConfigSecretName     = "kube-apiserver-encryption-config"
+	auditWebhookSecretName         = "audit-webhook-kubeconfig"
+	konnectivityServerSecretName   = "konnectivity-server"
+	encryptionConfigHashAnnotation = "hypershift.openshift.io/encryption-config-hash"
+	configKey                      = "config.yaml"
+	oauthMetadataKey               = "oauthMetadata.json"
+	auditPolicyKey                 = "policy.yaml"
+	auditWebhookKubeconfigKey      = "kubeconfig"
+	kmsAPIKeyKey                   = "apiKey"
+	aggregatorClientCAKey          = "aggregator-client-ca.crt"
+	clientCAKey                    = "client-ca.crt"
+	kubeletClientCAKey             = "kubelet-client-ca.crt"
+	serviceAccountPubKey           = "service-account.pub"
+	servingCAKey                   = "serving-ca.crt"
+	etcdCAKey                      = "etcd-ca.crt"
+	egressSelectorConfigKey        = "egress-selector-config.yaml"
+	serverCertKey                  = "server.crt"
+	serverKeyKey                   = "server.key"
+	kubeletClientCertKey           = "kubelet-client.crt"
+	kubeletClientKeyKey            = "kubelet-client.key"
+	etcdClientCertKey              = "etcd-client.crt"
+	etcdClientKeyKey               = "etcd-client.key"
+	proxyClientCertKey             = "proxy-client.crt"
+	proxyClientKeyKey              = "proxy-client.key"
+	serviceAccountKeyKey           = "service-account.key"
+	secretMountPath                = "/etc/kubernetes/secret"
+	configMountPath                = "/etc/kubernetes/config"
+	apiServerConfigMountPath       = "/etc/kubernetes/apiserver-config"
+	oauthMetadataMountPath         = "/etc/kubernetes/oauth"
+	auditPolicyMountPath           = "/etc/kubernetes/audit"
+	auditWebhookMountPath          = "/etc/kubernetes/audit-webhook"
+	encryptionConfigMountPath      = "/etc/kubernetes/encryption"
+	kmsSocketMountPath             = "/var/run/kmsplugin/"
+	konnectivitySocketMountPath    = "/etc/kubernetes/konnectivity-server/"
+	konnectivitySocket             = konnectivitySocketMountPath + "konnectivity-server.socket"
+	auditLogDir                    = "/var/log/kube-apiserver"
+)
+
+type Secret struct {
+	Namespace string
+	// PKI is the PKI of the control plane
+	PKI map[string][]byte
+}
+
+func (o Secret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, name, map[string][]byte{
+		serverCertKey:        o.PKI["kube-apiserver-server.crt"],
+		serverKeyKey:         o.PKI["kube-apiserver-server.key"],
+		kubeletClientCertKey: o.PKI["kube-apiserver-kubelet.crt"],
+		kubeletClientKeyKey:  o.PKI["kube-apiserver-kubelet.key"],
+		etcdClientCertKey:    o.PKI["etcd-client.crt"],
+		etcdClientKeyKey:     o.PKI["etcd-client.key"],
+		proxyClientCertKey:   o.PKI["kube-apiserver-aggregator-proxy-client.crt"],
+		proxyClientKeyKey:    o.PKI["kube-apiserver-aggregator-proxy-client.key"],
+		serviceAccountKeyKey: o.PKI["service-account.key"],
+	})
+}
+
+// LocalhostKubeconfigSecret holds the admin kubeconfig the bootstrap manifests
+// are applied with, which reaches the API server on localhost.
+type LocalhostKubeconfigSecret struct {
+	Namespace  string
+	Kubeconfig []byte
+}
+
+func (o LocalhostKubeconfigSecret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, localhostKubeconfigSecretName, map[string][]byte{
+		"kubeconfig": o.Kubeconfig,
+	})
+}
+
+type VPNClientSecret struct {
+	Namespace string
+	Cert      []byte
+	Key       []byte
+	CA        []byte
+}
+
+func (o VPNClientSecret) Build() *corev1.Secret {
+	return openvpn.ClientSecret{
+		Namespace: o.Namespace,
+		Name:      vpnClientSecretName,
+		Cert:      o.Cert,
+		Key:       o.Key,
+		CA:        o.CA,
+	}.Build()
+}
+
+type KMSCredentialsSecret struct {
+	Namespace string
+	APIKey    string
+}
+
+func (o KMSCredentialsSecret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, kmsCredentialsSecretName, map[string][]byte{
+		kmsAPIKeyKey: []byte(o.APIKey),
+	})
+}
+
+// Proxy is the proxy the API server reaches webhooks and aggregated API
+// servers through.
+type Proxy struct {
+	HTTPProxy  string
+	HTTPSProxy string
+	// NoProxy enables the proxy when set
+	NoProxy string
+}
+
+func (p Proxy) env() []corev1.EnvVar {
+	var env []corev1.EnvVar
+	if len(p.NoProxy) == 0 {
+		return env
+	}
+	if len(p.HTTPProxy) > 0 {
+		env = append(env, corev1.EnvVar{Name: "HTTP_PROXY", Value: p.HTTPProxy})
+	}
+	if len(p.HTTPSProxy) > 0 {
+		env = append(env, corev1.EnvVar{Name: "HTTPS_PROXY", Value: p.HTTPSProxy})
+	}
+	return append(env, corev1.EnvVar{Name: "NO_PROXY", Value: p.NoProxy})
+}
+
+// Images are the images of the containers of the API server pods.
+type Images struct {
+	// ClusterConfigOperator renders the bootstrap manifests
+	ClusterConfigOperator string
+	// CLI applies the bootstrap manifests
+	CLI       string
+	Hyperkube string
+	// OpenVPN is the image of the VPN client sidecar
+	OpenVPN string
+	// KonnectivityServer is the image of the konnectivity server sidecar
+	KonnectivityServer string
+}
+
+// SecretEncryption configures the encryption of secrets at rest.
+type SecretEncryption struct {
+	// Type is empty when secrets are not encrypted
+	Type hyperv1.SecretEncryptionType
+	// ConfigHash rolls out the API server when the encryption config changes
+	ConfigHash string
+	KMS        KMS
+}
+
+// KMS is the KMS plugin secrets are encrypted with.
+type KMS struct {
+	Image   string
+	KeyInfo string
+	Region  string
+}
+
+type Deployment struct {
+	Namespace       string
+	Images          Images
+	ClusterID       string
+	HighlyAvailable bool
+	InternalAPIPort uint
+	// LivenessPath overrides the path of the liveness probe
+	LivenessPath string
+	Proxy        Proxy
+	// KonnectivityEnabled reaches the guest cluster through a konnectivity
+	// server sidecar instead of a VPN client
+	KonnectivityEnabled bool
+	// KonnectivityServerCommand runs the proxy-server binary of the
+	// konnectivity server image
+	KonnectivityServerCommand []string
+	// AuditWebhookEnabled mounts the kubeconfig of the audit webhook
+	AuditWebhookEnabled bool
+	SecretEncryption    SecretEncryption
+	Resources           corev1.ResourceRequirements
+	Scheduling          *hyperv1.ControlPlaneScheduling
+}
+
+func (o Deployment) Build() *appsv1.Deployment {
+	var annotations map[string]string
+	if len(o.SecretEncryption.Type) > 0 {
+		annotations = map[string]string{
+			encryptionConfigHashAnnotation: o.SecretEncryption.ConfigHash,
+		}
+	}
+	kms := o.SecretEncryption.Type == hyperv1.KMSSecretEncryptionType
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      "secret",
+			MountPath: secretMountPath + "/",
+		},
+		{
+			Name:      "apiserver-config",
+			MountPath: apiServerConfigMountPath + "/",
+		},
+		{
+			Name:      "config",
+			MountPath: configMountPath + "/",
+		},
+		{
+			Name:      "oauth",
+			MountPath: oauthMetadataMountPath + "/",
+		},
+		{
+			Name:      "logs",
+			MountPath: auditLogDir + "/",
+		},
+		{
+			Name:      "apiserver-cm",
+			MountPath: auditPolicyMountPath + "/",
+		},
+	}
+	volumes := []corev1.Volume{
+		common.EmptyDirVolume("bootstrap-manifests"),
+		common.SecretVolume("secret", name),
+		common.EmptyDirVolume("logs"),
+		common.ConfigMapVolume("config", name),
+		common.SecretVolume("localhost-kubeconfig", localhostKubeconfigSecretName),
+		common.ConfigMapVolume("apiserver-config", configMapName),
+		common.ConfigMapVolume("oauth", oauthMetadataConfigMapName),
+	}
+	if o.KonnectivityEnabled {
+		volumes = append(volumes,
+			common.EmptyDirVolume("konnectivity-uds"),
+			common.SecretVolume("konnectivity-server", konnectivityServerSecretName),
+		)
+	} else {
+		volumes = append(volumes, openvpn.ClientVolumes(vpnClientConfigMapName, vpnClientSecretName)...)
+	}
+	volumes = append(volumes, common.ConfigMapVolume("apiserver-cm", auditPolicyConfigMapName))
+	if o.AuditWebhookEnabled {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "audit-webhook",
+			MountPath: auditWebhookMountPath + "/",
+		})
+		volumes = append(volumes, common.SecretVolume("audit-webhook", auditWebhookSecretName))
+	}
+	if len(o.SecretEncryption.Type) > 0 {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "encryption-config",
+			MountPath: encryptionConfigMountPath + "/",
+		})
+		volumes = append(volumes, common.SecretVolume("encryption-config", encryptionConfigSecretName))
+	}
+	if kms {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "kms-socket",
+			MountPath: kmsSocketMountPath,
+		})
+		volumes = append(volumes, common.EmptyDirVolume("kms-socket"))
+	}
+	if o.KonnectivityEnabled {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "konnectivity-uds",
+			MountPath: konnectivitySocketMountPath,
+		})
+	}
+
+	livenessPath := o.LivenessPath
+	if len(livenessPath) == 0 {
+		livenessPath = "livez"
+	}
+	securityContext := &corev1.SecurityContext{
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"MKNOD", "NET_ADMIN"},
+		},
+	}
+	if !o.KonnectivityEnabled {
+		securityContext.RunAsUser = k8sutilspointer.Int64Ptr(1001)
+	}
+	containers := []corev1.Container{
+		{
+			Name:       "initialize-manifests",
+			Image:      o.Images.CLI,
+			WorkingDir: "/work",
+			Command:    []string{"/bin/bash"},
+			Args: []string{
+				"-c",
+				`while true; do
+  if oc apply -f .; then
+    echo "Bootstrap manifests applied successfully."
+    break
+  fi
+  sleep 1
+done
+while true; do
+  sleep 1000
+done`,
+			},
+			Env: []corev1.EnvVar{
+				{
+					Name:  "KUBECONFIG",
+					Value: "/var/secrets/localhost-kubeconfig/kubeconfig",
+				},
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      "bootstrap-manifests",
+					MountPath: "/work",
+				},
+				{
+					Name:      "localhost-kubeconfig",
+					MountPath: "/var/secrets/localhost-kubeconfig",
+				},
+			},
+		},
+		{
+			Name:       name,
+			Image:      o.Images.Hyperkube,
+			Command:    []string{"hyperkube", "kube-apiserver"},
+			Args:       []string{"--openshift-config=" + apiServerConfigMountPath + "/" + configKey},
+			Env:        o.Proxy.env(),
+			WorkingDir: auditLogDir,
+			LivenessProbe: &corev1.Probe{
+				Handler: corev1.Handler{
+					HTTPGet: &corev1.HTTPGetAction{
+						Path:   livenessPath,
+						Port:   intstr.FromInt(int(o.InternalAPIPort)),
+						Scheme: corev1.URISchemeHTTPS,
+					},
+				},
+				InitialDelaySeconds: 45,
+				TimeoutSeconds:      10,
+			},
+			ReadinessProbe: &corev1.Probe{
+				Handler: corev1.Handler{
+					HTTPGet: &corev1.HTTPGetAction{
+						Path:   "readyz",
+						Port:   intstr.FromInt(int(o.InternalAPIPort)),
+						Scheme: corev1.URISchemeHTTPS,
+					},
+				},
+				InitialDelaySeconds: 10,
+				TimeoutSeconds:      10,
+			},
+			SecurityContext: securityContext,
+			Resources:       o.Resources,
+			VolumeMounts:    volumeMounts,
+		},
+	}
+	if o.KonnectivityEnabled {
+		containers = append(containers, o.konnectivityServerContainer())
+	} else {
+		containers = append(containers, openvpn.ClientContainer(o.Images.OpenVPN))
+	}
+	if kms {
+		containers = append(containers, o.kmsPluginContainer())
+	}
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+			Labels: map[string]string{
+				"app": name,
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: common.Replicas(o.HighlyAvailable),
+			Strategy: common.RollingUpdateStrategy(),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app":       name,
+						"clusterID": o.ClusterID,
+					},
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					AutomountServiceAccountToken: k8sutilspointer.BoolPtr(false),
+					InitContainers: []corev1.Container{
+						{
+							Name:            "config-bootstrap",
+							Image:           o.Images.ClusterConfigOperator,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							WorkingDir:      "/tmp",
+							Command:         []string{"/bin/bash"},
+							Args: []string{
+								"-c",
+								`cd /tmp
+mkdir input output
+/usr/bin/cluster-config-operator render --config-output-file config --asset-input-dir /tmp/input --asset-output-dir /tmp/output
+cp /tmp/output/manifests/* /work`,
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "bootstrap-manifests",
+									MountPath: "/work",
+								},
+							},
+						},
+					},
+					Containers: containers,
+					Volumes:    volumes,
+				},
+			},
+		},
+	}
+	if !o.KonnectivityEnabled {
+		deployment.Spec.Template.Spec.ServiceAccountName = openvpn.ServiceAccountName
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
+
+func (o Deployment) konnectivityServerContainer() corev1.Container {
+	serverCount := "1"
+	if o.HighlyAvailable {
+		serverCount = "3"
+	}
+	return corev1.Container{
+		Name:    "konnectivity-server",
+		Image:   o.Images.KonnectivityServer,
+		Command: o.KonnectivityServerCommand,
+		Args: []string{
+			"--logtostderr=true",
+			"--uds-name", konnectivitySocket,
+			"--delete-existing-uds-file",
+			"--mode", "grpc",
+			"--server-port", "0",
+			"--agent-port", "8091",
+			"--health-port", "2041",
+			"--admin-port", "8093",
+			"--server-count", serverCount,
+			"--cluster-cert", "/etc/konnectivity/server/tls.crt",
+			"--cluster-key", "/etc/konnectivity/server/tls.key",
+			"--cluster-ca-cert", "/etc/konnectivity/server/ca.crt",
+			"--proxy-strategies", "destHost,defaultRoute",
+		},
+		LivenessProbe: &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "healthz",
+					Port:   intstr.FromInt(2041),
+					Scheme: corev1.URISchemeHTTP,
+				},
+			},
+			InitialDelaySeconds: 30,
+			TimeoutSeconds:      10,
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "konnectivity-uds",
+				MountPath: konnectivitySocketMountPath,
+			},
+			{
+				Name:      "konnectivity-server",
+				MountPath: "/etc/konnectivity/server",
+			},
+		},
+	}
+}
+
+func (o Deployment) kmsPluginContainer() corev1.Container {
+	return corev1.Container{
+		Name:  "kms-plugin",
+		Image: o.SecretEncryption.KMS.Image,
+		Env: []corev1.EnvVar{
+			{
+				Name:  "KP_INFO",
+				Value: o.SecretEncryption.KMS.KeyInfo,
+			},
+			{
+				Name:  "KP_REGION",
+				Value: o.SecretEncryption.KMS.Region,
+			},
+			{
+				Name: "KP_API_KEY",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: kmsCredentialsSecretName,
+						},
+						Key: kmsAPIKeyKey,
+					},
+				},
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "kms-socket",
+				MountPath: kmsSocketMountPath,
+			},
+		},
+	}
+}
@@ -0,0 +1,287 @@
+package kubecontrollermanager
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sutilspointer "k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	name                = "kube-controller-manager"
+	configMapName       = "kube-controller-manager-config"
+	configKey           = "config.yaml"
+	rootCAKey           = "root-ca.crt"
+	serviceCAKey        = "service-ca.crt"
+	kubeconfigKey       = "kubeconfig"
+	serviceAccountKey   = "service-account.key"
+	clusterSignerCert   = "cluster-signer.crt"
+	clusterSignerKey    = "cluster-signer.key"
+	secretMountPath     = "/etc/kubernetes/secret"
+	configMountPath     = "/etc/kubernetes/config"
+	cmConfigMountPath   = "/etc/kubernetes/cmconfig"
+	certDir             = "/var/run/kubernetes"
+	logDir              = "/var/log/kube-controller-manager"
+	flexVolumePluginDir = "/etc/kubernetes/kubelet-plugins/volume/exec"
+)
+
+// kubeControllerManagerConfig is the part of the OpenShift
+// KubeControllerManagerConfig the control plane sets.
+type kubeControllerManagerConfig struct {
+	APIVersion         string              `json:"apiVersion"`
+	Kind               string              `json:"kind"`
+	ExtendedArguments  map[string][]string `json:"extendedArguments"`
+	ServiceServingCert certInfo            `json:"serviceServingCert"`
+}
+
+type certInfo struct {
+	CertFile string `json:"certFile"`
+}
+
+// Networks are the networks the kube-controller-manager allocates node and
+// service addresses from.
+type Networks struct {
+	PodCIDR         string
+	ServiceCIDR     string
+	ClusterNetworks []render.ClusterNetwork
+}
+
+// nodeCIDRMaskSizes returns the sizes of the pod networks of nodes by the
+// name of their argument. Dual-stack clusters have one size per IP family.
+func (n Networks) nodeCIDRMaskSizes() []argument {
+	var sizes []argument
+	for _, network := range n.ClusterNetworks {
+		name := "node-cidr-mask-size"
+		if len(n.ClusterNetworks) > 1 {
+			name = "node-cidr-mask-size-ipv4"
+			if network.IPv6 {
+				name = "node-cidr-mask-size-ipv6"
+			}
+		}
+		sizes = append(sizes, argument{name: name, value: fmt.Sprintf("%d", network.HostPrefix)})
+	}
+	return sizes
+}
+
+type argument struct {
+	name  string
+	value string
+}
+
+type ConfigMap struct {
+	Namespace    string
+	Networks     Networks
+	FeatureGates []string
+}
+
+func (o ConfigMap) Build() (*corev1.ConfigMap, error) {
+	config := kubeControllerManagerConfig{
+		APIVersion: "kubecontrolplane.config.openshift.io/v1",
+		Kind:       "KubeControllerManagerConfig",
+		ExtendedArguments: map[string][]string{
+			"authentication-kubeconfig":             {secretMountPath + "/" + kubeconfigKey},
+			"authorization-kubeconfig":              {secretMountPath + "/" + kubeconfigKey},
+			"allocate-node-cidrs":                   {"true"},
+			"cert-dir":                              {certDir},
+			"cluster-cidr":                          {o.Networks.PodCIDR},
+			"cluster-signing-cert-file":             {secretMountPath + "/" + clusterSignerCert},
+			"cluster-signing-key-file":              {secretMountPath + "/" + clusterSignerKey},
+			"configure-cloud-routes":                {"false"},
+			"controllers":                           {"*", "-ttl", "-bootstrapsigner", "-tokencleaner"},
+			"enable-dynamic-provisioning":           {"true"},
+			"experimental-cluster-signing-duration": {"26280h"},
+			"feature-gates":                         o.FeatureGates,
+			"flex-volume-plugin-dir":                {flexVolumePluginDir},
+			"kube-api-burst":                        {"300"},
+			"kube-api-qps":                          {"150"},
+			"leader-elect-resource-lock":            {"configmaps"},
+			"leader-elect":                          {"true"},
+			"leader-elect-retry-period":             {"3s"},
+			"port":                                  {"0"},
+			"root-ca-file":                          {configMountPath + "/" + rootCAKey},
+			"secure-port":                           {"10257"},
+			"service-account-private-key-file":      {secretMountPath + "/" + serviceAccountKey},
+			"service-cluster-ip-range":              {o.Networks.ServiceCIDR},
+			"use-service-account-credentials":       {"true"},
+		},
+		ServiceServingCert: certInfo{
+			CertFile: configMountPath + "/" + serviceCAKey,
+		},
+	}
+	for _, size := range o.Networks.nodeCIDRMaskSizes() {
+		config.ExtendedArguments[size.name] = []string{size.value}
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode kube-controller-manager config: %w", err)
+	}
+	return common.ConfigMap(o.Namespace, configMapName, map[string]string{
+		configKey: string(data),
+	}), nil
+}
+
+type CAConfigMap struct {
+	Namespace string
+	CA        []byte
+}
+
+func (o CAConfigMap) Build() *corev1.ConfigMap {
+	return common.ConfigMap(o.Namespace, name, map[string]string{
+		rootCAKey:    string(o.CA),
+		serviceCAKey: string(o.CA),
+	})
+}
+
+type Secret struct {
+	Namespace         string
+	Kubeconfig        []byte
+	ServiceAccountKey []byte
+	ClusterSignerCert []byte
+	ClusterSignerKey  []byte
+}
+
+func (o Secret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, name, map[string][]byte{
+		kubeconfigKey:     o.Kubeconfig,
+		serviceAccountKey: o.ServiceAccountKey,
+		clusterSignerCert: o.ClusterSignerCert,
+		clusterSignerKey:  o.ClusterSignerKey,
+	})
+}
+
+type Deployment struct {
+	Namespace         string
+	Image             string
+	ClusterID         string
+	HighlyAvailable   bool
+	RestartDate       string
+	PriorityClassName string
+	Networks          Networks
+	FeatureGates      []string
+	Resources         corev1.ResourceRequirements
+	Scheduling        *hyperv1.ControlPlaneScheduling
+}
+
+func (o Deployment) Build() *appsv1.Deployment {
+	args := []string{
+		"--openshift-config=" + cmConfigMountPath + "/" + configKey,
+		"--kubeconfig=" + secretMountPath + "/" + kubeconfigKey,
+		"--authentication-kubeconfig=" + secretMountPath + "/" + kubeconfigKey,
+		"--authorization-kubeconfig=" + secretMountPath + "/" + kubeconfigKey,
+		"--allocate-node-cidrs=true",
+		"--cert-dir=" + certDir,
+		"--cluster-cidr=" + o.Networks.PodCIDR,
+		"--cluster-signing-cert-file=" + secretMountPath + "/" + clusterSignerCert,
+		"--cluster-signing-key-file=" + secretMountPath + "/" + clusterSignerKey,
+		"--configure-cloud-routes=false",
+		"--controllers=*",
+		"--controllers=-ttl",
+		"--controllers=-bootstrapsigner",
+		"--controllers=-tokencleaner",
+		"--enable-dynamic-provisioning=true",
+		"--flex-volume-plugin-dir=" + flexVolumePluginDir,
+		"--kube-api-burst=300",
+		"--kube-api-qps=150",
+		"--leader-elect-resource-lock=configmaps",
+		"--leader-elect=true",
+		"--leader-elect-retry-period=3s",
+	}
+	for _, size := range o.Networks.nodeCIDRMaskSizes() {
+		args = append(args, fmt.Sprintf("--%s=%s", size.name, size.value))
+	}
+	args = append(args,
+		"--port=0",
+		"--root-ca-file="+configMountPath+"/"+rootCAKey,
+		"--secure-port=10257",
+		"--service-account-private-key-file="+secretMountPath+"/"+serviceAccountKey,
+		"--service-cluster-ip-range="+o.Networks.ServiceCIDR,
+		"--use-service-account-credentials=true",
+		"--experimental-cluster-signing-duration=26280h",
+	)
+	for _, featureGate := range o.FeatureGates {
+		args = append(args, "--feature-gates="+featureGate)
+	}
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: common.Replicas(o.HighlyAvailable),
+			Strategy: common.RollingUpdateStrategy(),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app":       name,
+						"clusterID": o.ClusterID,
+					},
+					Annotations: common.RestartAnnotations(o.RestartDate),
+				},
+				Spec: corev1.PodSpec{
+					Tolerations:                  common.MultiAZWorkerTolerations(),
+					Affinity:                     common.Affinity(name, o.ClusterID),
+					AutomountServiceAccountToken: k8sutilspointer.BoolPtr(false),
+					PriorityClassName:            o.PriorityClassName,
+					Containers: []corev1.Container{
+						{
+							Name:       name,
+							Image:      o.Image,
+							Command:    []string{"hyperkube", name},
+							Args:       args,
+							Resources:  o.Resources,
+							WorkingDir: logDir,
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "cmconfig",
+									MountPath: cmConfigMountPath,
+								},
+								{
+									Name:      "config",
+									MountPath: configMountPath,
+								},
+								{
+									Name:      "secret",
+									MountPath: secretMountPath,
+								},
+								{
+									Name:      "certdir",
+									MountPath: certDir,
+								},
+								{
+									Name:      "logs",
+									MountPath: logDir,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						common.SecretVolume("secret", name),
+						common.ConfigMapVolume("cmconfig", configMapName),
+						common.ConfigMapVolume("config", name),
+						common.EmptyDirVolume("logs"),
+						common.EmptyDirVolume("certdir"),
+					},
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
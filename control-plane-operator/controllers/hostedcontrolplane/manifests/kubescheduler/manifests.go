@@ -0,0 +1,248 @@
+package kubescheduler
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	name                 = "kube-scheduler"
+	configMapName        = "kube-scheduler-config"
+	configKey            = "config.yaml"
+	kubeconfigKey        = "kubeconfig"
+	kubeconfigSecretName = "service-network-admin-kubeconfig"
+	secretMountPath      = "/etc/kubernetes/secret"
+	configMountPath      = "/etc/kubernetes/config"
+	certDir              = "/var/run/kubernetes"
+)
+
+const config = `apiVersion: kubescheduler.config.k8s.io/v1beta1
+kind: KubeSchedulerConfiguration
+clientConnection:
+  kubeconfig: "` + secretMountPath + "/" + kubeconfigKey + `"
+leaderElection:
+  leaderElect: true`
+
+type ConfigMap struct {
+	Namespace string
+}
+
+func (o ConfigMap) Build() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapName,
+			Namespace: o.Namespace,
+		},
+		Data: map[string]string{
+			configKey: config,
+		},
+	}
+}
+
+type Secret struct {
+	Namespace  string
+	Kubeconfig []byte
+}
+
+func (o Secret) Build() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		Data: map[string][]byte{
+			kubeconfigKey: o.Kubeconfig,
+		},
+	}
+}
+
+type Deployment struct {
+	Namespace         string
+	Image             string
+	ClusterID         string
+	HighlyAvailable   bool
+	RestartDate       string
+	PriorityClassName string
+	FeatureGates      []string
+	Resources         corev1.ResourceRequirements
+	Scheduling        *hyperv1.ControlPlaneScheduling
+}
+
+func (o Deployment) Build() *appsv1.Deployment {
+	replicas := int32(1)
+	if o.HighlyAvailable {
+		replicas = 3
+	}
+	var annotations map[string]string
+	if len(o.RestartDate) > 0 {
+		annotations = map[string]string{
+			"openshift.io/restartedAt": o.RestartDate,
+		}
+	}
+	args := []string{
+		"--config=" + configMountPath + "/" + configKey,
+		"--cert-dir=" + certDir,
+		"--port=0",
+		"--authentication-kubeconfig=" + secretMountPath + "/" + kubeconfigKey,
+		"--authorization-kubeconfig=" + secretMountPath + "/" + kubeconfigKey,
+		"-v=2",
+	}
+	for _, featureGate := range o.FeatureGates {
+		args = append(args, "--feature-gates="+featureGate)
+	}
+	maxSurge := intstr.FromInt(3)
+	maxUnavailable := intstr.FromInt(1)
+	antiAffinityTerm := func(topologyKey string) corev1.PodAffinityTerm {
+		return corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      "app",
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{name},
+					},
+				},
+			},
+			TopologyKey: topologyKey,
+		}
+	}
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: k8sutilspointer.Int32Ptr(replicas),
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxSurge:       &maxSurge,
+					MaxUnavailable: &maxUnavailable,
+				},
+			},
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app":       name,
+						"clusterID": o.ClusterID,
+					},
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      "multi-az-worker",
+							Operator: corev1.TolerationOpEqual,
+							Value:    "true",
+							Effect:   corev1.TaintEffectNoSchedule,
+						},
+					},
+					Affinity: &corev1.Affinity{
+						PodAffinity: &corev1.PodAffinity{
+							PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+								{
+									Weight: 100,
+									PodAffinityTerm: corev1.PodAffinityTerm{
+										LabelSelector: &metav1.LabelSelector{
+											MatchExpressions: []metav1.LabelSelectorRequirement{
+												{
+													Key:      "clusterID",
+													Operator: metav1.LabelSelectorOpIn,
+													Values:   []string{o.ClusterID},
+												},
+											},
+										},
+										TopologyKey: "kubernetes.io/hostname",
+									},
+								},
+							},
+						},
+						PodAntiAffinity: &corev1.PodAntiAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+								antiAffinityTerm("kubernetes.io/hostname"),
+								antiAffinityTerm("failure-domain.beta.kubernetes.io/zone"),
+							},
+						},
+					},
+					AutomountServiceAccountToken: k8sutilspointer.BoolPtr(false),
+					PriorityClassName:            o.PriorityClassName,
+					Containers: []corev1.Container{
+						{
+							Name:       name,
+							Image:      o.Image,
+							Command:    []string{"hyperkube", name},
+							Args:       args,
+							Resources:  o.Resources,
+							WorkingDir: certDir,
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "secret",
+									MountPath: secretMountPath,
+								},
+								{
+									Name:      "config",
+									MountPath: configMountPath,
+								},
+								{
+									Name:      "cert",
+									MountPath: certDir + "/",
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "secret",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: kubeconfigSecretName,
+								},
+							},
+						},
+						{
+							Name: "config",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: configMapName,
+									},
+								},
+							},
+						},
+						{
+							Name: "cert",
+							VolumeSource: corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
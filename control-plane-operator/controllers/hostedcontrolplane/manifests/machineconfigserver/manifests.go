@@ -0,0 +1,343 @@
+package machineconfigserver
+
+import (
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	name                 = "machine-config-server"
+	kubeconfigSecretName = "machine-config-server-kubeconfig"
+	// configMapName is the configmap of the cluster configuration the
+	// bootstrap machine configs are rendered from
+	configMapName = "machine-config-server"
+)
+
+type ServiceAccount struct {
+	Namespace string
+}
+
+func (o ServiceAccount) Build() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ServiceAccount",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+	}
+}
+
+// RoleBinding lets the server read the ignition configs of the control plane
+// namespace.
+type RoleBinding struct {
+	Namespace string
+}
+
+func (o RoleBinding) Build() *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "RoleBinding",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "edit",
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind: "ServiceAccount",
+				Name: name,
+			},
+		},
+	}
+}
+
+type Secret struct {
+	Namespace string
+	Cert      []byte
+	Key       []byte
+}
+
+func (o Secret) Build() *corev1.Secret {
+	secret := common.Secret(o.Namespace, name, map[string][]byte{
+		"tls.crt": o.Cert,
+		"tls.key": o.Key,
+	})
+	secret.Type = corev1.SecretTypeOpaque
+	return secret
+}
+
+// KubeconfigSecret holds the kubelet bootstrap kubeconfig the server hands out
+// to the machines of the guest cluster.
+type KubeconfigSecret struct {
+	Namespace  string
+	Kubeconfig []byte
+}
+
+func (o KubeconfigSecret) Build() *corev1.Secret {
+	secret := common.Secret(o.Namespace, kubeconfigSecretName, map[string][]byte{
+		"kubeconfig": o.Kubeconfig,
+	})
+	secret.Type = corev1.SecretTypeOpaque
+	return secret
+}
+
+// Images are the release images the bootstrap machine configs are rendered
+// with.
+type Images struct {
+	MachineConfigOperator string
+	// CLI copies the ignition configs of the control plane namespace
+	CLI                  string
+	Etcd                 string
+	KubeClientAgent      string
+	MachineOSContent     string
+	Pod                  string
+	KeepalivedIPFailover string
+	CoreDNS              string
+	MDNSPublisher        string
+	HAProxyRouter        string
+	BaremetalRuntimeCfg  string
+}
+
+type Deployment struct {
+	Namespace string
+	Images    Images
+	// BootstrapEtcd passes the etcd flags releases before 4.6 require to the
+	// bootstrap of the machine config operator
+	BootstrapEtcd bool
+	// TrustedCABundle is set when the configmap has the additional trust
+	// bundle of the cluster
+	TrustedCABundle bool
+	// ImageContentSources is set when the configmap has the image content
+	// source policy of the cluster
+	ImageContentSources bool
+	Scheduling          *hyperv1.ControlPlaneScheduling
+}
+
+func (o Deployment) bootstrapScript() string {
+	script := &strings.Builder{}
+	script.WriteString(`mkdir -p /mcc-manifests/bootstrap/manifests
+mkdir -p /mcc-manifests/manifests
+exec machine-config-operator bootstrap \`)
+	if o.BootstrapEtcd {
+		script.WriteString(`
+--etcd-ca=/assets/manifests/root-ca.crt \
+--etcd-metric-ca=/assets/manifests/root-ca.crt \
+--etcd-image=` + o.Images.Etcd + ` \
+--kube-client-agent-image=` + o.Images.KubeClientAgent + ` \`)
+	}
+	script.WriteString(`
+--root-ca=/assets/manifests/root-ca.crt \
+--kube-ca=/assets/manifests/combined-ca.crt \
+--machine-config-operator-image=` + o.Images.MachineConfigOperator + ` \
+--machine-config-oscontent-image=` + o.Images.MachineOSContent + ` \
+--infra-image=` + o.Images.Pod + ` \
+--keepalived-image=` + o.Images.KeepalivedIPFailover + ` \
+--coredns-image=` + o.Images.CoreDNS + ` \
+--mdns-publisher-image=` + o.Images.MDNSPublisher + ` \
+--haproxy-image=` + o.Images.HAProxyRouter + ` \
+--baremetal-runtimecfg-image=` + o.Images.BaremetalRuntimeCfg + ` \
+--infra-config-file=/assets/manifests/cluster-infrastructure-02-config.yaml \
+--network-config-file=/assets/manifests/cluster-network-02-config.yaml \
+--proxy-config-file=/assets/manifests/cluster-proxy-01-config.yaml \`)
+	if o.TrustedCABundle {
+		script.WriteString(`
+--additional-trust-bundle-config-file=/assets/manifests/user-ca-bundle-config.yaml \`)
+	}
+	script.WriteString(`
+--config-file=/assets/manifests/install-config.yaml \
+--dns-config-file=/assets/manifests/cluster-dns-02-config.yaml \
+--dest-dir=/mcc-manifests \
+--pull-secret=/assets/manifests/pull-secret.yaml
+
+# Use our own version of configpools that swap master and workers
+mv /mcc-manifests/bootstrap/manifests /mcc-manifests/bootstrap/manifests.tmp
+mkdir /mcc-manifests/bootstrap/manifests
+cp /mcc-manifests/bootstrap/manifests.tmp/* /mcc-manifests/bootstrap/manifests/
+cp /assets/manifests/*.machineconfigpool.yaml /mcc-manifests/bootstrap/manifests/
+# The feature gates of the kubelets are rendered from the FeatureGate cluster configuration
+cp /assets/manifests/cluster-featuregate-02-config.yaml /mcc-manifests/bootstrap/manifests/`)
+	if o.ImageContentSources {
+		script.WriteString(`
+# The registries.conf of the workers is rendered from the mirrors
+cp /assets/manifests/image-content-source-policy.yaml /mcc-manifests/bootstrap/manifests/`)
+	}
+	return script.String()
+}
+
+// injectMachineConfigsScript copies the ignition configs of the control plane
+// namespace to the bootstrap manifests of the machine config controller.
+const injectMachineConfigsScript = `cat <<"EOF" > "./copy-ignition-config.sh"
+#!/bin/bash
+name="${1}"
+oc get cm ${name} -n "${NAMESPACE}" -o jsonpath='{ .data.data }' > "/mcc-manifests/bootstrap/manifests/${name/#ignition-config-//}.yaml"
+EOF
+chmod +x ./copy-ignition-config.sh
+oc get cm -l ignition-config="true" -n "${NAMESPACE}" --no-headers | awk '{ print $1 }' | xargs -n1 ./copy-ignition-config.sh`
+
+func (o Deployment) Build() *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: k8sutilspointer.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": name,
+					},
+				},
+				Spec: corev1.PodSpec{
+					Tolerations:        common.MultiAZWorkerTolerations(),
+					ServiceAccountName: name,
+					InitContainers: []corev1.Container{
+						{
+							Name:    "machine-config-operator-bootstrap",
+							Image:   o.Images.MachineConfigOperator,
+							Command: []string{"/bin/bash"},
+							Args:    []string{"-c", o.bootstrapScript()},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "mcc-manifests",
+									MountPath: "/mcc-manifests",
+								},
+								{
+									Name:      "config",
+									MountPath: "/assets/manifests",
+								},
+							},
+						},
+						{
+							Name:            "inject-custom-machine-configs",
+							Image:           o.Images.CLI,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							WorkingDir:      "/tmp",
+							Command:         []string{"/usr/bin/bash"},
+							Args:            []string{"-c", injectMachineConfigsScript},
+							Env: []corev1.EnvVar{
+								{
+									Name: "NAMESPACE",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{
+											FieldPath: "metadata.namespace",
+										},
+									},
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "mcc-manifests",
+									MountPath: "/mcc-manifests",
+								},
+							},
+						},
+						{
+							Name:            "machine-config-controller-bootstrap",
+							Image:           o.Images.MachineConfigOperator,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Command:         []string{"/usr/bin/machine-config-controller"},
+							Args: []string{
+								"bootstrap",
+								"--manifest-dir=/mcc-manifests/bootstrap/manifests",
+								"--pull-secret=/mcc-manifests/bootstrap/manifests/machineconfigcontroller-pull-secret",
+								"--dest-dir=/mcs-manifests",
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "mcc-manifests",
+									MountPath: "/mcc-manifests",
+								},
+								{
+									Name:      "mcs-manifests",
+									MountPath: "/mcs-manifests",
+								},
+							},
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:            name,
+							Image:           o.Images.MachineConfigOperator,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Command:         []string{"/usr/bin/machine-config-server"},
+							Args: []string{
+								"bootstrap",
+								"--bootstrap-kubeconfig=/etc/openshift/kubeconfig",
+								"--secure-port=8443",
+								"--insecure-port=8080",
+							},
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          "http",
+									ContainerPort: 8080,
+									Protocol:      corev1.ProtocolTCP,
+								},
+								{
+									Name:          "https",
+									ContainerPort: 8443,
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "kubeconfig",
+									MountPath: "/etc/openshift",
+									ReadOnly:  true,
+								},
+								{
+									Name:      "mcs-manifests",
+									MountPath: "/etc/mcs/bootstrap",
+								},
+								{
+									Name:      "mcc-manifests",
+									MountPath: "/etc/mcc/bootstrap",
+								},
+								{
+									Name:      "mcs-tls",
+									MountPath: "/etc/ssl/mcs",
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						common.SecretVolume("kubeconfig", kubeconfigSecretName),
+						common.SecretVolume("mcs-tls", name),
+						common.EmptyDirVolume("mcc-manifests"),
+						common.EmptyDirVolume("mcs-manifests"),
+						common.ConfigMapVolume("config", configMapName),
+					},
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
@@ -0,0 +1,265 @@
+package oauthapiserver
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	name                   = "openshift-oauth-apiserver"
+	auditPolicyConfigMap   = "openshift-oauth-apiserver-auditpolicy"
+	auditPolicyKey         = "policy.yaml"
+	etcdCAKey              = "etcd-ca.crt"
+	servingCAKey           = "serving-ca.crt"
+	kubeconfigKey          = "kubeconfig"
+	serverCertKey          = "server.crt"
+	serverKeyKey           = "server.key"
+	etcdClientCertKey      = "etcd-client.crt"
+	etcdClientKeyKey       = "etcd-client.key"
+	auditPolicyMountPath   = "/var/run/audit"
+	secretMountPath        = "/var/run/secret"
+	configMountPath        = "/var/run/config"
+	auditLogDir            = "/var/log/oauth-apiserver"
+	auditWebhookMountPath  = "/var/run/audit-webhook"
+	auditWebhookKubeconfig = "kubeconfig"
+	volumeDefaultMode      = int32(420)
+)
+
+// unauditedURLs are the non-resource URLs the oauth-apiserver does not audit.
+var unauditedURLs = []string{"/api*", "/version", "/healthz"}
+
+type AuditPolicyConfigMap struct {
+	Namespace    string
+	Profile      hyperv1.AuditProfileType
+	CustomPolicy string
+}
+
+func (o AuditPolicyConfigMap) Build() (*corev1.ConfigMap, error) {
+	policy, err := common.AuditPolicyData(o.Profile, o.CustomPolicy, unauditedURLs)
+	if err != nil {
+		return nil, err
+	}
+	return common.ConfigMap(o.Namespace, auditPolicyConfigMap, map[string]string{
+		auditPolicyKey: policy,
+	}), nil
+}
+
+type CAConfigMap struct {
+	Namespace string
+	CA        []byte
+}
+
+func (o CAConfigMap) Build() *corev1.ConfigMap {
+	return common.ConfigMap(o.Namespace, name, map[string]string{
+		etcdCAKey:    string(o.CA),
+		servingCAKey: string(o.CA),
+	})
+}
+
+type Secret struct {
+	Namespace      string
+	Kubeconfig     []byte
+	ServerCert     []byte
+	ServerKey      []byte
+	EtcdClientCert []byte
+	EtcdClientKey  []byte
+}
+
+func (o Secret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, name, map[string][]byte{
+		kubeconfigKey:     o.Kubeconfig,
+		serverCertKey:     o.ServerCert,
+		serverKeyKey:      o.ServerKey,
+		etcdClientCertKey: o.EtcdClientCert,
+		etcdClientKeyKey:  o.EtcdClientKey,
+	})
+}
+
+// AuditLog is how the oauth-apiserver keeps its audit log.
+type AuditLog struct {
+	MaxSize    int32
+	MaxBackups int32
+	// MaxAge is the days to keep the log for, or 0 to keep it regardless of
+	// its age
+	MaxAge int32
+	// WebhookSecret, when set, is the secret with the kubeconfig of the
+	// webhook the audit events are also sent to
+	WebhookSecret string
+	WebhookMode   string
+}
+
+type Deployment struct {
+	Namespace      string
+	Image          string
+	ClusterID      string
+	EtcdClientName string
+	AuditLog       AuditLog
+	Scheduling     *hyperv1.ControlPlaneScheduling
+}
+
+func (o Deployment) Build() *appsv1.Deployment {
+	args := []string{
+		"start",
+		"--authentication-kubeconfig=" + secretMountPath + "/" + kubeconfigKey,
+		"--authorization-kubeconfig=" + secretMountPath + "/" + kubeconfigKey,
+		"--kubeconfig=" + secretMountPath + "/" + kubeconfigKey,
+		"--secure-port=8443",
+		"--audit-log-path=" + auditLogDir + "/audit.log",
+		"--audit-log-format=json",
+		fmt.Sprintf("--audit-log-maxsize=%d", o.AuditLog.MaxSize),
+		fmt.Sprintf("--audit-log-maxbackup=%d", o.AuditLog.MaxBackups),
+	}
+	if o.AuditLog.MaxAge > 0 {
+		args = append(args, fmt.Sprintf("--audit-log-maxage=%d", o.AuditLog.MaxAge))
+	}
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      "audit-policy",
+			MountPath: auditPolicyMountPath,
+		},
+		{
+			Name:      "secret",
+			MountPath: secretMountPath,
+		},
+		{
+			Name:      "config",
+			MountPath: configMountPath,
+		},
+		{
+			Name:      "audit-dir",
+			MountPath: auditLogDir,
+		},
+	}
+	volumes := []corev1.Volume{
+		withDefaultMode(common.ConfigMapVolume("audit-policy", auditPolicyConfigMap)),
+		withDefaultMode(common.SecretVolume("secret", name)),
+		withDefaultMode(common.ConfigMapVolume("config", name)),
+		common.EmptyDirVolume("audit-dir"),
+	}
+	if len(o.AuditLog.WebhookSecret) > 0 {
+		args = append(args,
+			"--audit-webhook-config-file="+auditWebhookMountPath+"/"+auditWebhookKubeconfig,
+			"--audit-webhook-mode="+o.AuditLog.WebhookMode,
+		)
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "audit-webhook",
+			MountPath: auditWebhookMountPath,
+		})
+		volumes = append(volumes, withDefaultMode(common.SecretVolume("audit-webhook", o.AuditLog.WebhookSecret)))
+	}
+	args = append(args,
+		"--etcd-cafile="+configMountPath+"/"+etcdCAKey,
+		"--etcd-keyfile="+secretMountPath+"/"+etcdClientKeyKey,
+		"--etcd-certfile="+secretMountPath+"/"+etcdClientCertKey,
+		"--shutdown-delay-duration=3s",
+		"--tls-private-key-file="+secretMountPath+"/"+serverKeyKey,
+		"--tls-cert-file="+secretMountPath+"/"+serverCertKey,
+		"--audit-policy-file="+auditPolicyMountPath+"/"+auditPolicyKey,
+		`--cors-allowed-origins='//127\.0\.0\.1(:|$)'`,
+		`--cors-allowed-origins='//localhost(:|$)'`,
+		fmt.Sprintf("--etcd-servers=https://%s:2379", o.EtcdClientName),
+		"--tls-cipher-suites=TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+		"--tls-cipher-suites=TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		"--tls-cipher-suites=TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+		"--tls-cipher-suites=TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+		"--tls-cipher-suites=TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256",
+		"--tls-cipher-suites=TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+		"--tls-min-version=VersionTLS12",
+		"--v=2",
+	)
+	probe := func(path string) *corev1.Probe {
+		return &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   path,
+					Port:   intstr.FromInt(8443),
+					Scheme: corev1.URISchemeHTTPS,
+				},
+			},
+			TimeoutSeconds: 1,
+			PeriodSeconds:  10,
+		}
+	}
+	readinessProbe := probe("readyz")
+	readinessProbe.SuccessThreshold = 1
+	readinessProbe.FailureThreshold = 10
+	livenessProbe := probe("healthz")
+	livenessProbe.InitialDelaySeconds = 30
+	livenessProbe.SuccessThreshold = 1
+	livenessProbe.FailureThreshold = 3
+
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: k8sutilspointer.Int32Ptr(1),
+			Strategy: common.RollingUpdateStrategy(),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name,
+				},
+			},
+			ProgressDeadlineSeconds: k8sutilspointer.Int32Ptr(600),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+					Labels: map[string]string{
+						"app":       name,
+						"clusterID": o.ClusterID,
+					},
+				},
+				Spec: corev1.PodSpec{
+					AutomountServiceAccountToken: k8sutilspointer.BoolPtr(false),
+					Containers: []corev1.Container{
+						{
+							Name:           "oauth-apiserver",
+							Image:          o.Image,
+							Command:        []string{"/usr/bin/oauth-apiserver"},
+							Args:           args,
+							ReadinessProbe: readinessProbe,
+							LivenessProbe:  livenessProbe,
+							Ports: []corev1.ContainerPort{
+								{
+									ContainerPort: 8443,
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							ImagePullPolicy:          corev1.PullIfNotPresent,
+							VolumeMounts:             volumeMounts,
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
+
+func withDefaultMode(volume corev1.Volume) corev1.Volume {
+	mode := volumeDefaultMode
+	switch {
+	case volume.Secret != nil:
+		volume.Secret.DefaultMode = &mode
+	case volume.ConfigMap != nil:
+		volume.ConfigMap.DefaultMode = &mode
+	}
+	return volume
+}
@@ -0,0 +1,610 @@
+package oauthopenshift
+
+import (
+	"encoding/json"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sutilspointer "k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/assets"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	name                       = "oauth-openshift"
+	containerName              = "openshift-oauthserver"
+	configMapName              = "oauth-openshift-config"
+	sessionSecretName          = "oauth-openshift-sessionsecret"
+	trustedCAConfigMapName     = "oauth-openshift-trusted-ca"
+	brandingSecretName         = "v4-0-config-system-ocp-branding-template"
+	brandingAsset              = "oauth-openshift/v4-0-config-system-branding.yaml"
+	configKey                  = "config.yaml"
+	caKey                      = "ca.crt"
+	trustedCAKey               = "ca-bundle.crt"
+	sessionKey                 = "v4-0-config-system-session"
+	kubeconfigKey              = "kubeconfig"
+	serverCertKey              = "server.crt"
+	serverKeyKey               = "server.key"
+	secretsMountPath           = "/etc/oauth-openshift-secrets/"
+	sessionSecretMountPath     = "/etc/oauth-openshift-sessionsecret/"
+	configMountPath            = "/etc/oauth-openshift-config/"
+	configFileMountPath        = "/etc/oauth-openshift-configfile/"
+	trustedCAMountPath         = "/etc/oauth-openshift-trusted-ca"
+	brandingMountPath          = "/var/config/system/secrets/" + brandingSecretName
+	workDir                    = "/var/run/kubernetes"
+	restartedAtAnnotation      = "openshift.io/restartedAt"
+	identityProvidersHashLabel = "hypershift.openshift.io/identity-providers-hash"
+	volumeDefaultMode          = int32(420)
+)
+
+// cipherSuites are the TLS cipher suites the OAuth server accepts.
+var cipherSuites = []string{
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305",
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305",
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
+	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256",
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+	"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
+	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+	"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+	"TLS_RSA_WITH_AES_128_GCM_SHA256",
+	"TLS_RSA_WITH_AES_256_GCM_SHA384",
+	"TLS_RSA_WITH_AES_128_CBC_SHA",
+	"TLS_RSA_WITH_AES_256_CBC_SHA",
+}
+
+// osinServerConfig is the OsinServerConfig of the OAuth server. Disabled
+// sections are set explicitly, as the server expects them.
+type osinServerConfig struct {
+	APIVersion         string           `json:"apiVersion"`
+	Kind               string           `json:"kind"`
+	AuditConfig        osinAuditConfig  `json:"auditConfig"`
+	CORSAllowedOrigins []string         `json:"corsAllowedOrigins"`
+	KubeClientConfig   kubeClientConfig `json:"kubeClientConfig"`
+	OAuthConfig        oauthConfig      `json:"oauthConfig"`
+	ServingInfo        servingInfo      `json:"servingInfo"`
+	StorageConfig      storageConfig    `json:"storageConfig"`
+}
+
+type osinAuditConfig struct {
+	AuditFilePath            string      `json:"auditFilePath"`
+	Enabled                  bool        `json:"enabled"`
+	LogFormat                string      `json:"logFormat"`
+	MaximumFileRetentionDays int         `json:"maximumFileRetentionDays"`
+	MaximumFileSizeMegabytes int         `json:"maximumFileSizeMegabytes"`
+	MaximumRetainedFiles     int         `json:"maximumRetainedFiles"`
+	PolicyConfiguration      interface{} `json:"policyConfiguration"`
+	PolicyFile               string      `json:"policyFile"`
+	WebHookKubeConfig        string      `json:"webHookKubeConfig"`
+	WebHookMode              string      `json:"webHookMode"`
+}
+
+type kubeClientConfig struct {
+	ConnectionOverrides connectionOverrides `json:"connectionOverrides"`
+	KubeConfig          string              `json:"kubeConfig"`
+}
+
+type connectionOverrides struct {
+	AcceptContentTypes string `json:"acceptContentTypes"`
+	Burst              int    `json:"burst"`
+	ContentType        string `json:"contentType"`
+	QPS                int    `json:"qps"`
+}
+
+type oauthConfig struct {
+	AlwaysShowProviderSelection bool          `json:"alwaysShowProviderSelection"`
+	AssetPublicURL              string        `json:"assetPublicURL"`
+	GrantConfig                 grantConfig   `json:"grantConfig"`
+	IdentityProviders           []interface{} `json:"identityProviders"`
+	LoginURL                    string        `json:"loginURL"`
+	MasterCA                    string        `json:"masterCA"`
+	MasterPublicURL             string        `json:"masterPublicURL"`
+	MasterURL                   string        `json:"masterURL"`
+	SessionConfig               sessionConfig `json:"sessionConfig"`
+	Templates                   templates     `json:"templates"`
+	TokenConfig                 tokenConfig   `json:"tokenConfig"`
+}
+
+type grantConfig struct {
+	Method               string `json:"method"`
+	ServiceAccountMethod string `json:"serviceAccountMethod"`
+}
+
+type sessionConfig struct {
+	SessionMaxAgeSeconds int    `json:"sessionMaxAgeSeconds"`
+	SessionName          string `json:"sessionName"`
+	SessionSecretsFile   string `json:"sessionSecretsFile"`
+}
+
+type templates struct {
+	Error             string `json:"error"`
+	Login             string `json:"login"`
+	ProviderSelection string `json:"providerSelection"`
+}
+
+type tokenConfig struct {
+	AccessTokenMaxAgeSeconds    int `json:"accessTokenMaxAgeSeconds"`
+	AuthorizeTokenMaxAgeSeconds int `json:"authorizeTokenMaxAgeSeconds"`
+}
+
+type servingInfo struct {
+	BindAddress           string   `json:"bindAddress"`
+	BindNetwork           string   `json:"bindNetwork"`
+	CertFile              string   `json:"certFile"`
+	CipherSuites          []string `json:"cipherSuites"`
+	KeyFile               string   `json:"keyFile"`
+	MaxRequestsInFlight   int      `json:"maxRequestsInFlight"`
+	MinTLSVersion         string   `json:"minTLSVersion"`
+	RequestTimeoutSeconds int      `json:"requestTimeoutSeconds"`
+}
+
+type storageConfig struct {
+	CA            string `json:"ca"`
+	CertFile      string `json:"certFile"`
+	KeyFile       string `json:"keyFile"`
+	StoragePrefix string `json:"storagePrefix"`
+}
+
+// sessionSecrets are the SessionSecrets the OAuth server signs and encrypts
+// its sessions with.
+type sessionSecrets struct {
+	Kind       string          `json:"kind"`
+	APIVersion string          `json:"apiVersion"`
+	Secrets    []sessionSecret `json:"secrets"`
+}
+
+type sessionSecret struct {
+	Authentication string `json:"authentication"`
+	Encryption     string `json:"encryption"`
+}
+
+// oauthClient is an oauth.openshift.io/v1 OAuthClient.
+type oauthClient struct {
+	APIVersion            string            `json:"apiVersion"`
+	GrantMethod           string            `json:"grantMethod"`
+	Kind                  string            `json:"kind"`
+	Metadata              metav1.ObjectMeta `json:"metadata"`
+	RedirectURIs          []string          `json:"redirectURIs"`
+	RespondWithChallenges bool              `json:"respondWithChallenges,omitempty"`
+	Secret                string            `json:"secret,omitempty"`
+}
+
+// Endpoints are the external addresses of the API and OAuth servers.
+type Endpoints struct {
+	APIDNSName   string
+	APIPort      uint
+	OAuthDNSName string
+	OAuthPort    uint
+}
+
+func (e Endpoints) oauthURL() string {
+	return fmt.Sprintf("https://%s:%d", e.OAuthDNSName, e.OAuthPort)
+}
+
+type ConfigMap struct {
+	Namespace  string
+	BaseDomain string
+	Endpoints  Endpoints
+	// IdentityProviders are the identity providers in OsinServerConfig format
+	IdentityProviders []interface{}
+	// NamedCerts are set when the API server serves custom certificates,
+	// which the OAuth server does not verify it with
+	NamedCerts bool
+}
+
+func (o ConfigMap) Build() (*corev1.ConfigMap, error) {
+	identityProviders := o.IdentityProviders
+	if identityProviders == nil {
+		identityProviders = []interface{}{}
+	}
+	masterCA := configMountPath + caKey
+	if o.NamedCerts {
+		masterCA = ""
+	}
+	config := osinServerConfig{
+		APIVersion: "osin.config.openshift.io/v1",
+		Kind:       "OsinServerConfig",
+		KubeClientConfig: kubeClientConfig{
+			ConnectionOverrides: connectionOverrides{
+				Burst: 400,
+				QPS:   400,
+			},
+			KubeConfig: secretsMountPath + kubeconfigKey,
+		},
+		OAuthConfig: oauthConfig{
+			AssetPublicURL: "https://console-openshift-console." + o.BaseDomain,
+			GrantConfig: grantConfig{
+				Method:               "auto",
+				ServiceAccountMethod: "prompt",
+			},
+			IdentityProviders: identityProviders,
+			LoginURL:          fmt.Sprintf("https://%s:%d", o.Endpoints.APIDNSName, o.Endpoints.APIPort),
+			MasterCA:          masterCA,
+			MasterPublicURL:   o.Endpoints.oauthURL(),
+			MasterURL:         o.Endpoints.oauthURL(),
+			SessionConfig: sessionConfig{
+				SessionMaxAgeSeconds: 300,
+				SessionName:          "ssn",
+				SessionSecretsFile:   sessionSecretMountPath + sessionKey,
+			},
+			Templates: templates{
+				Error:             brandingMountPath + "/errors.html",
+				Login:             brandingMountPath + "/login.html",
+				ProviderSelection: brandingMountPath + "/providers.html",
+			},
+			TokenConfig: tokenConfig{
+				AccessTokenMaxAgeSeconds:    86400,
+				AuthorizeTokenMaxAgeSeconds: 300,
+			},
+		},
+		ServingInfo: servingInfo{
+			BindAddress:           "0.0.0.0:6443",
+			BindNetwork:           "tcp4",
+			CertFile:              secretsMountPath + serverCertKey,
+			CipherSuites:          cipherSuites,
+			KeyFile:               secretsMountPath + serverKeyKey,
+			MaxRequestsInFlight:   1000,
+			MinTLSVersion:         "VersionTLS12",
+			RequestTimeoutSeconds: 300,
+		},
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode oauth server config: %w", err)
+	}
+	return common.ConfigMap(o.Namespace, configMapName, map[string]string{
+		configKey: string(data),
+	}), nil
+}
+
+type CAConfigMap struct {
+	Namespace string
+	CA        []byte
+}
+
+func (o CAConfigMap) Build() *corev1.ConfigMap {
+	return common.ConfigMap(o.Namespace, name, map[string]string{
+		caKey: string(o.CA),
+	})
+}
+
+// TrustedCAConfigMap holds the additional CAs identity providers are trusted
+// with.
+type TrustedCAConfigMap struct {
+	Namespace string
+	CABundle  string
+}
+
+func (o TrustedCAConfigMap) Build() *corev1.ConfigMap {
+	return common.ConfigMap(o.Namespace, trustedCAConfigMapName, map[string]string{
+		trustedCAKey: o.CABundle,
+	})
+}
+
+type Secret struct {
+	Namespace  string
+	Kubeconfig []byte
+	ServerCert []byte
+	ServerKey  []byte
+}
+
+func (o Secret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, name, map[string][]byte{
+		kubeconfigKey: o.Kubeconfig,
+		serverCertKey: o.ServerCert,
+		serverKeyKey:  o.ServerKey,
+	})
+}
+
+type SessionSecret struct {
+	Namespace      string
+	Authentication string
+	Encryption     string
+}
+
+func (o SessionSecret) Build() (*corev1.Secret, error) {
+	data, err := json.MarshalIndent(sessionSecrets{
+		Kind:       "SessionSecrets",
+		APIVersion: "v1",
+		Secrets: []sessionSecret{
+			{
+				Authentication: o.Authentication,
+				Encryption:     o.Encryption,
+			},
+		},
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode oauth server session secrets: %w", err)
+	}
+	return common.Secret(o.Namespace, sessionSecretName, map[string][]byte{
+		sessionKey: data,
+	}), nil
+}
+
+// BrandingSecret holds the login, provider selection and error pages of the
+// OAuth server.
+type BrandingSecret struct {
+	Namespace string
+}
+
+func (o BrandingSecret) Build() (*corev1.Secret, error) {
+	data, err := assets.Asset(brandingAsset)
+	if err != nil {
+		return nil, err
+	}
+	secret := &corev1.Secret{}
+	if err := yaml.Unmarshal(data, secret); err != nil {
+		return nil, fmt.Errorf("failed to decode oauth server branding: %w", err)
+	}
+	secret.Namespace = o.Namespace
+	return secret, nil
+}
+
+// BrowserClient is the OAuthClient the web console and the token display
+// page log in with, passed to the guest cluster in a configmap.
+type BrowserClient struct {
+	Namespace string
+	Endpoints Endpoints
+	Secret    string
+}
+
+func (o BrowserClient) Build() (*corev1.ConfigMap, error) {
+	return oauthClientConfigMap(o.Namespace, oauthClient{
+		Metadata:     metav1.ObjectMeta{Name: "openshift-browser-client"},
+		RedirectURIs: []string{o.Endpoints.oauthURL() + "/oauth/token/display"},
+		Secret:       o.Secret,
+	})
+}
+
+// ChallengingClient is the OAuthClient the CLI logs in with, passed to the
+// guest cluster in a configmap.
+type ChallengingClient struct {
+	Namespace string
+	Endpoints Endpoints
+}
+
+func (o ChallengingClient) Build() (*corev1.ConfigMap, error) {
+	return oauthClientConfigMap(o.Namespace, oauthClient{
+		Metadata:              metav1.ObjectMeta{Name: "openshift-challenging-client"},
+		RedirectURIs:          []string{o.Endpoints.oauthURL() + "/oauth/token/implicit"},
+		RespondWithChallenges: true,
+	})
+}
+
+func oauthClientConfigMap(namespace string, client oauthClient) (*corev1.ConfigMap, error) {
+	client.APIVersion = "oauth.openshift.io/v1"
+	client.Kind = "OAuthClient"
+	client.GrantMethod = "auto"
+	data, err := yaml.Marshal(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode oauth client %s: %w", client.Metadata.Name, err)
+	}
+	return common.ConfigMap(namespace, "user-manifest-"+client.Metadata.Name, map[string]string{
+		"data": string(data),
+	}), nil
+}
+
+// Proxy is the proxy the OAuth server reaches identity providers through.
+type Proxy struct {
+	HTTPProxy  string
+	HTTPSProxy string
+	NoProxy    string
+	// TrustedCABundle are the additional CAs identity providers are trusted
+	// with
+	TrustedCABundle string
+}
+
+func (p Proxy) env() []corev1.EnvVar {
+	var env []corev1.EnvVar
+	if len(p.NoProxy) == 0 && len(p.TrustedCABundle) == 0 {
+		return env
+	}
+	if len(p.HTTPProxy) > 0 {
+		env = append(env, corev1.EnvVar{Name: "HTTP_PROXY", Value: p.HTTPProxy})
+	}
+	if len(p.HTTPSProxy) > 0 {
+		env = append(env, corev1.EnvVar{Name: "HTTPS_PROXY", Value: p.HTTPSProxy})
+	}
+	if len(p.NoProxy) > 0 {
+		env = append(env, corev1.EnvVar{Name: "NO_PROXY", Value: p.NoProxy})
+	}
+	if len(p.TrustedCABundle) > 0 {
+		// Identity providers behind the proxy are trusted with the additional
+		// CAs on top of the system ones
+		env = append(env, corev1.EnvVar{Name: "SSL_CERT_DIR", Value: "/etc/pki/tls/certs:" + trustedCAMountPath})
+	}
+	return env
+}
+
+type Deployment struct {
+	Namespace         string
+	Image             string
+	ClusterID         string
+	HighlyAvailable   bool
+	RestartDate       string
+	PriorityClassName string
+	Proxy             Proxy
+	// IdentityProviderVolumes mount the secrets and configmaps the identity
+	// providers reference
+	IdentityProviderVolumes []render.OAuthVolume
+	// IdentityProvidersHash rolls out the server when the identity providers
+	// change
+	IdentityProvidersHash string
+	Resources             corev1.ResourceRequirements
+	Scheduling            *hyperv1.ControlPlaneScheduling
+}
+
+func (o Deployment) Build() *appsv1.Deployment {
+	var annotations map[string]string
+	if len(o.RestartDate) > 0 || len(o.IdentityProvidersHash) > 0 {
+		annotations = map[string]string{}
+		if len(o.RestartDate) > 0 {
+			annotations[restartedAtAnnotation] = o.RestartDate
+		}
+		if len(o.IdentityProvidersHash) > 0 {
+			annotations[identityProvidersHashLabel] = o.IdentityProvidersHash
+		}
+	}
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      "oauth-openshift-secrets",
+			MountPath: secretsMountPath,
+		},
+		{
+			Name:      "oauth-openshift-sessionsecret",
+			MountPath: sessionSecretMountPath,
+		},
+		{
+			Name:      "oauth-openshift-config",
+			MountPath: configMountPath,
+		},
+		{
+			Name:      "oauth-openshift-configfile",
+			MountPath: configFileMountPath,
+		},
+		{
+			Name:      "logs",
+			MountPath: workDir,
+		},
+		{
+			Name:      brandingSecretName,
+			MountPath: brandingMountPath,
+			ReadOnly:  true,
+		},
+	}
+	mode := volumeDefaultMode
+	volumes := []corev1.Volume{
+		common.EmptyDirVolume("logs"),
+		secretVolume("oauth-openshift-secrets", name),
+		secretVolume("oauth-openshift-sessionsecret", sessionSecretName),
+		common.ConfigMapVolume("oauth-openshift-config", name),
+		common.ConfigMapVolume("oauth-openshift-configfile", configMapName),
+		{
+			Name: brandingSecretName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  brandingSecretName,
+					DefaultMode: &mode,
+					Items: []corev1.KeyToPath{
+						{
+							Key:  "login.html",
+							Path: "login.html",
+						},
+						{
+							Key:  "providers.html",
+							Path: "providers.html",
+						},
+						{
+							Key:  "errors.html",
+							Path: "errors.html",
+						},
+					},
+				},
+			},
+		},
+	}
+	if len(o.Proxy.TrustedCABundle) > 0 {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "oauth-openshift-trusted-ca",
+			MountPath: trustedCAMountPath,
+			ReadOnly:  true,
+		})
+		volumes = append(volumes, common.ConfigMapVolume("oauth-openshift-trusted-ca", trustedCAConfigMapName))
+	}
+	for _, volume := range o.IdentityProviderVolumes {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      volume.Name,
+			MountPath: volume.MountPath,
+			ReadOnly:  true,
+		})
+		if len(volume.SecretName) > 0 {
+			volumes = append(volumes, secretVolume(volume.Name, volume.SecretName))
+		} else {
+			volumes = append(volumes, common.ConfigMapVolume(volume.Name, volume.ConfigMapName))
+		}
+	}
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: common.Replicas(o.HighlyAvailable),
+			Strategy: common.RollingUpdateStrategy(),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app":       name,
+						"clusterID": o.ClusterID,
+					},
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					Tolerations:                  common.MultiAZWorkerTolerations(),
+					Affinity:                     common.Affinity(name, o.ClusterID),
+					AutomountServiceAccountToken: k8sutilspointer.BoolPtr(false),
+					PriorityClassName:            o.PriorityClassName,
+					Containers: []corev1.Container{
+						{
+							Name:  containerName,
+							Image: o.Image,
+							LivenessProbe: &corev1.Probe{
+								Handler: corev1.Handler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path:   "healthz",
+										Port:   intstr.FromInt(6443),
+										Scheme: corev1.URISchemeHTTPS,
+									},
+								},
+								InitialDelaySeconds: 30,
+								TimeoutSeconds:      10,
+								PeriodSeconds:       30,
+								SuccessThreshold:    1,
+								FailureThreshold:    3,
+							},
+							Args: []string{
+								"osinserver",
+								"--config=" + configFileMountPath + configKey,
+							},
+							Env:          o.Proxy.env(),
+							Resources:    o.Resources,
+							VolumeMounts: volumeMounts,
+							WorkingDir:   workDir,
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
+
+func secretVolume(name, secretName string) corev1.Volume {
+	volume := common.SecretVolume(name, secretName)
+	mode := volumeDefaultMode
+	volume.Secret.DefaultMode = &mode
+	return volume
+}
@@ -0,0 +1,289 @@
+package openshiftapiserver
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sutilspointer "k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	name                    = "openshift-apiserver"
+	configMapName           = "openshift-apiserver-config"
+	configKey               = "config.yaml"
+	aggregatorClientCAKey   = "aggregator-client-ca.crt"
+	etcdCAKey               = "etcd-ca.crt"
+	servingCAKey            = "serving-ca.crt"
+	kubeconfigKey           = "kubeconfig"
+	serverCertKey           = "server.crt"
+	serverKeyKey            = "server.key"
+	etcdClientCertKey       = "etcd-client.crt"
+	etcdClientKeyKey        = "etcd-client.key"
+	secretMountPath         = "/etc/kubernetes/secret"
+	configMountPath         = "/etc/kubernetes/config"
+	apiServerConfigMountDir = "/etc/kubernetes/apiserver-config"
+	workDir                 = "/var/run/kubernetes"
+)
+
+var (
+	requestHeaderAllowedNames   = []string{"kube-apiserver-proxy", "system:kube-apiserver-proxy", "system:openshift-aggregator"}
+	requestHeaderExtraPrefixes  = []string{"X-Remote-Extra-"}
+	requestHeaderGroupHeaders   = []string{"X-Remote-Group"}
+	requestHeaderUsernameHeader = []string{"X-Remote-User"}
+)
+
+// openShiftAPIServerConfig is the part of the OpenShiftAPIServerConfig the
+// control plane sets.
+type openShiftAPIServerConfig struct {
+	APIVersion         string              `json:"apiVersion"`
+	Kind               string              `json:"kind"`
+	AggregatorConfig   aggregatorConfig    `json:"aggregatorConfig"`
+	APIServerArguments map[string][]string `json:"apiServerArguments"`
+	AuditConfig        auditConfig         `json:"auditConfig"`
+	KubeClientConfig   kubeClientConfig    `json:"kubeClientConfig"`
+	ServingInfo        servingInfo         `json:"servingInfo"`
+	ImagePolicyConfig  imagePolicyConfig   `json:"imagePolicyConfig"`
+	ProjectConfig      projectConfig       `json:"projectConfig"`
+	RoutingConfig      routingConfig       `json:"routingConfig"`
+	StorageConfig      storageConfig       `json:"storageConfig"`
+}
+
+type aggregatorConfig struct {
+	AllowedNames        []string `json:"allowedNames"`
+	ClientCA            string   `json:"clientCA"`
+	ExtraHeaderPrefixes []string `json:"extraHeaderPrefixes"`
+	GroupHeaders        []string `json:"groupHeaders"`
+	UsernameHeaders     []string `json:"usernameHeaders"`
+}
+
+type auditConfig struct {
+	AuditFilePath            string             `json:"auditFilePath"`
+	Enabled                  bool               `json:"enabled"`
+	LogFormat                string             `json:"logFormat"`
+	MaximumFileSizeMegabytes int                `json:"maximumFileSizeMegabytes"`
+	MaximumRetainedFiles     int                `json:"maximumRetainedFiles"`
+	PolicyConfiguration      common.AuditPolicy `json:"policyConfiguration"`
+}
+
+type kubeClientConfig struct {
+	KubeConfig string `json:"kubeConfig"`
+}
+
+type servingInfo struct {
+	CertFile string `json:"certFile"`
+	KeyFile  string `json:"keyFile"`
+	ClientCA string `json:"clientCA"`
+}
+
+type imagePolicyConfig struct {
+	InternalRegistryHostname string `json:"internalRegistryHostname"`
+}
+
+type projectConfig struct {
+	ProjectRequestMessage string `json:"projectRequestMessage"`
+}
+
+type routingConfig struct {
+	Subdomain string `json:"subdomain"`
+}
+
+type storageConfig struct {
+	URLs     []string `json:"urls"`
+	CertFile string   `json:"certFile"`
+	KeyFile  string   `json:"keyFile"`
+	CA       string   `json:"ca"`
+}
+
+type ConfigMap struct {
+	Namespace string
+	// IngressSubdomain is the domain routes of the guest cluster are exposed
+	// under by default
+	IngressSubdomain string
+	// EtcdClientName is the name of the etcd client service
+	EtcdClientName string
+}
+
+func (o ConfigMap) Build() (*corev1.ConfigMap, error) {
+	config := openShiftAPIServerConfig{
+		APIVersion: "openshiftcontrolplane.config.openshift.io/v1",
+		Kind:       "OpenShiftAPIServerConfig",
+		AggregatorConfig: aggregatorConfig{
+			AllowedNames:        requestHeaderAllowedNames,
+			ClientCA:            configMountPath + "/" + aggregatorClientCAKey,
+			ExtraHeaderPrefixes: requestHeaderExtraPrefixes,
+			GroupHeaders:        requestHeaderGroupHeaders,
+			UsernameHeaders:     requestHeaderUsernameHeader,
+		},
+		APIServerArguments: map[string][]string{
+			"minimal-shutdown-duration": {"3s"},
+		},
+		AuditConfig: auditConfig{
+			AuditFilePath:            workDir + "/audit.log",
+			Enabled:                  true,
+			LogFormat:                "json",
+			MaximumFileSizeMegabytes: 100,
+			MaximumRetainedFiles:     10,
+			PolicyConfiguration:      common.DefaultAuditPolicy(hyperv1.DefaultAuditProfileType, []string{"/api*", "/version", "/healthz"}),
+		},
+		KubeClientConfig: kubeClientConfig{
+			KubeConfig: secretMountPath + "/" + kubeconfigKey,
+		},
+		ServingInfo: servingInfo{
+			CertFile: secretMountPath + "/" + serverCertKey,
+			KeyFile:  secretMountPath + "/" + serverKeyKey,
+			ClientCA: configMountPath + "/" + servingCAKey,
+		},
+		ImagePolicyConfig: imagePolicyConfig{
+			InternalRegistryHostname: "image-registry.openshift-image-registry.svc:5000",
+		},
+		RoutingConfig: routingConfig{
+			Subdomain: o.IngressSubdomain,
+		},
+		StorageConfig: storageConfig{
+			URLs:     []string{fmt.Sprintf("https://%s:2379", o.EtcdClientName)},
+			CertFile: secretMountPath + "/" + etcdClientCertKey,
+			KeyFile:  secretMountPath + "/" + etcdClientKeyKey,
+			CA:       configMountPath + "/" + etcdCAKey,
+		},
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode openshift-apiserver config: %w", err)
+	}
+	return common.ConfigMap(o.Namespace, configMapName, map[string]string{
+		configKey: string(data),
+	}), nil
+}
+
+type CAConfigMap struct {
+	Namespace string
+	CA        []byte
+}
+
+func (o CAConfigMap) Build() *corev1.ConfigMap {
+	return common.ConfigMap(o.Namespace, name, map[string]string{
+		aggregatorClientCAKey: string(o.CA),
+		etcdCAKey:             string(o.CA),
+		servingCAKey:          string(o.CA),
+	})
+}
+
+type Secret struct {
+	Namespace      string
+	Kubeconfig     []byte
+	ServerCert     []byte
+	ServerKey      []byte
+	EtcdClientCert []byte
+	EtcdClientKey  []byte
+}
+
+func (o Secret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, name, map[string][]byte{
+		kubeconfigKey:     o.Kubeconfig,
+		serverCertKey:     o.ServerCert,
+		serverKeyKey:      o.ServerKey,
+		etcdClientCertKey: o.EtcdClientCert,
+		etcdClientKeyKey:  o.EtcdClientKey,
+	})
+}
+
+type Deployment struct {
+	Namespace         string
+	Image             string
+	ClusterID         string
+	HighlyAvailable   bool
+	RestartDate       string
+	PriorityClassName string
+	Resources         corev1.ResourceRequirements
+	Scheduling        *hyperv1.ControlPlaneScheduling
+}
+
+func (o Deployment) Build() *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: common.Replicas(o.HighlyAvailable),
+			Strategy: common.RollingUpdateStrategy(),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app":       name,
+						"clusterID": o.ClusterID,
+					},
+					Annotations: common.RestartAnnotations(o.RestartDate),
+				},
+				Spec: corev1.PodSpec{
+					Tolerations:                  common.MultiAZWorkerTolerations(),
+					Affinity:                     common.Affinity(name, o.ClusterID),
+					AutomountServiceAccountToken: k8sutilspointer.BoolPtr(false),
+					PriorityClassName:            o.PriorityClassName,
+					Containers: []corev1.Container{
+						{
+							Name:  name,
+							Image: o.Image,
+							Args: []string{
+								"start",
+								"--config=" + apiServerConfigMountDir + "/" + configKey,
+								"--authorization-kubeconfig=" + secretMountPath + "/" + kubeconfigKey,
+								"--authentication-kubeconfig=" + secretMountPath + "/" + kubeconfigKey,
+								"--requestheader-client-ca-file=" + configMountPath + "/" + aggregatorClientCAKey,
+								"--requestheader-allowed-names=kube-apiserver-proxy,system:kube-apiserver-proxy,system:openshift-aggregator",
+								"--requestheader-username-headers=X-Remote-User",
+								"--requestheader-group-headers=X-Remote-Group",
+								"--requestheader-extra-headers-prefix=X-Remote-Extra-",
+								"--client-ca-file=" + configMountPath + "/" + servingCAKey,
+							},
+							Resources: o.Resources,
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "secret",
+									MountPath: secretMountPath,
+								},
+								{
+									Name:      "apiserver-config",
+									MountPath: apiServerConfigMountDir,
+								},
+								{
+									Name:      "config",
+									MountPath: configMountPath,
+								},
+								{
+									Name:      "logs",
+									MountPath: workDir,
+								},
+							},
+							WorkingDir: workDir,
+						},
+					},
+					Volumes: []corev1.Volume{
+						common.SecretVolume("secret", name),
+						common.ConfigMapVolume("config", name),
+						common.ConfigMapVolume("apiserver-config", configMapName),
+						common.EmptyDirVolume("logs"),
+					},
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
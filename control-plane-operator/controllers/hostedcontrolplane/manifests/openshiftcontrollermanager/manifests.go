@@ -0,0 +1,240 @@
+package openshiftcontrollermanager
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sutilspointer "k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	name                        = "openshift-controller-manager"
+	clusterPolicyControllerName = "cluster-policy-controller"
+	configMapName               = "openshift-controller-manager-config"
+	configKey                   = "config.yaml"
+	servingCAKey                = "serving-ca.crt"
+	kubeconfigKey               = "kubeconfig"
+	serverCertKey               = "server.crt"
+	serverKeyKey                = "server.key"
+	secretMountPath             = "/etc/kubernetes/secret"
+	configMountPath             = "/etc/kubernetes/config"
+	cmConfigMountPath           = "/etc/kubernetes/cmconfig"
+)
+
+// openShiftControllerManagerConfig is the part of the
+// OpenShiftControllerManagerConfig the control plane sets.
+type openShiftControllerManagerConfig struct {
+	APIVersion       string                 `json:"apiVersion"`
+	Kind             string                 `json:"kind"`
+	Build            buildConfig            `json:"build"`
+	Deployer         deployerConfig         `json:"deployer"`
+	DockerPullSecret dockerPullSecretConfig `json:"dockerPullSecret"`
+	Ingress          ingressConfig          `json:"ingress"`
+	KubeClientConfig kubeClientConfig       `json:"kubeClientConfig"`
+	ServingInfo      servingInfo            `json:"servingInfo"`
+}
+
+type buildConfig struct {
+	BuildDefaults       buildDefaults       `json:"buildDefaults"`
+	ImageTemplateFormat imageTemplateFormat `json:"imageTemplateFormat"`
+}
+
+type buildDefaults struct {
+	Resources corev1.ResourceRequirements `json:"resources"`
+}
+
+type deployerConfig struct {
+	ImageTemplateFormat imageTemplateFormat `json:"imageTemplateFormat"`
+}
+
+type imageTemplateFormat struct {
+	Format string `json:"format"`
+}
+
+type dockerPullSecretConfig struct {
+	InternalRegistryHostname string `json:"internalRegistryHostname"`
+}
+
+type ingressConfig struct {
+	IngressIPNetworkCIDR string `json:"ingressIPNetworkCIDR"`
+}
+
+type kubeClientConfig struct {
+	KubeConfig string `json:"kubeConfig"`
+}
+
+type servingInfo struct {
+	CertFile string `json:"certFile"`
+	KeyFile  string `json:"keyFile"`
+	ClientCA string `json:"clientCA"`
+}
+
+type ConfigMap struct {
+	Namespace string
+	// DockerBuilderImage runs the builds of the guest cluster
+	DockerBuilderImage string
+	// DeployerImage runs the deployments of deployment configs of the guest
+	// cluster
+	DeployerImage string
+}
+
+func (o ConfigMap) Build() (*corev1.ConfigMap, error) {
+	config := openShiftControllerManagerConfig{
+		APIVersion: "openshiftcontrolplane.config.openshift.io/v1",
+		Kind:       "OpenShiftControllerManagerConfig",
+		Build: buildConfig{
+			ImageTemplateFormat: imageTemplateFormat{Format: o.DockerBuilderImage},
+		},
+		Deployer: deployerConfig{
+			ImageTemplateFormat: imageTemplateFormat{Format: o.DeployerImage},
+		},
+		DockerPullSecret: dockerPullSecretConfig{
+			InternalRegistryHostname: "image-registry.openshift-image-registry.svc:5000",
+		},
+		KubeClientConfig: kubeClientConfig{
+			KubeConfig: secretMountPath + "/" + kubeconfigKey,
+		},
+		ServingInfo: servingInfo{
+			CertFile: secretMountPath + "/" + serverCertKey,
+			KeyFile:  secretMountPath + "/" + serverKeyKey,
+			ClientCA: configMountPath + "/" + servingCAKey,
+		},
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode openshift-controller-manager config: %w", err)
+	}
+	return common.ConfigMap(o.Namespace, configMapName, map[string]string{
+		configKey: string(data),
+	}), nil
+}
+
+type CAConfigMap struct {
+	Namespace string
+	CA        []byte
+}
+
+func (o CAConfigMap) Build() *corev1.ConfigMap {
+	return common.ConfigMap(o.Namespace, name, map[string]string{
+		servingCAKey: string(o.CA),
+	})
+}
+
+type Secret struct {
+	Namespace  string
+	Kubeconfig []byte
+	ServerCert []byte
+	ServerKey  []byte
+}
+
+func (o Secret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, name, map[string][]byte{
+		kubeconfigKey: o.Kubeconfig,
+		serverCertKey: o.ServerCert,
+		serverKeyKey:  o.ServerKey,
+	})
+}
+
+type Deployment struct {
+	Namespace         string
+	Image             string
+	ClusterID         string
+	HighlyAvailable   bool
+	RestartDate       string
+	PriorityClassName string
+	Resources         corev1.ResourceRequirements
+	Scheduling        *hyperv1.ControlPlaneScheduling
+}
+
+func (o Deployment) Build() *appsv1.Deployment {
+	return controllerDeployment(name, []string{
+		"start",
+		"--config=" + cmConfigMountPath + "/" + configKey,
+	}, o)
+}
+
+// ClusterPolicyControllerDeployment runs the cluster policy controller, which
+// shares the config and secret of the openshift-controller-manager.
+type ClusterPolicyControllerDeployment Deployment
+
+func (o ClusterPolicyControllerDeployment) Build() *appsv1.Deployment {
+	return controllerDeployment(clusterPolicyControllerName, []string{
+		"start",
+		"--config=" + cmConfigMountPath + "/" + configKey,
+		"--kubeconfig=" + secretMountPath + "/" + kubeconfigKey,
+	}, Deployment(o))
+}
+
+func controllerDeployment(app string, args []string, o Deployment) *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      app,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: common.Replicas(o.HighlyAvailable),
+			Strategy: common.RollingUpdateStrategy(),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": app,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app":       app,
+						"clusterID": o.ClusterID,
+					},
+					Annotations: common.RestartAnnotations(o.RestartDate),
+				},
+				Spec: corev1.PodSpec{
+					Tolerations:                  common.MultiAZWorkerTolerations(),
+					Affinity:                     common.Affinity(app, o.ClusterID),
+					AutomountServiceAccountToken: k8sutilspointer.BoolPtr(false),
+					PriorityClassName:            o.PriorityClassName,
+					Containers: []corev1.Container{
+						{
+							Name:      app,
+							Image:     o.Image,
+							Command:   []string{app},
+							Args:      args,
+							Resources: o.Resources,
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "secret",
+									MountPath: secretMountPath,
+								},
+								{
+									Name:      "cmconfig",
+									MountPath: cmConfigMountPath,
+								},
+								{
+									Name:      "config",
+									MountPath: configMountPath,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						common.SecretVolume("secret", name),
+						common.ConfigMapVolume("config", name),
+						common.ConfigMapVolume("cmconfig", configMapName),
+					},
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
@@ -0,0 +1,328 @@
+package openvpn
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	serverName         = "openvpn-server"
+	ccdConfigMapName   = "openvpn-ccd"
+	serviceAccountName = "vpn"
+	serverConfigKey    = "server.conf"
+	workerKey          = "worker"
+)
+
+// ServiceAccount is the service account of the VPN server and of the VPN
+// clients of the control plane components, which run privileged.
+type ServiceAccount struct {
+	Namespace string
+}
+
+func (o ServiceAccount) Build() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ServiceAccount",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceAccountName,
+			Namespace: o.Namespace,
+		},
+	}
+}
+
+// Networks are the networks of the guest cluster that are routed through the
+// VPN.
+type Networks struct {
+	ServiceNetworks []string
+	ClusterNetworks []render.ClusterNetwork
+}
+
+// routes returns the address and mask of each network, service networks
+// first when servicesFirst is set.
+func (n Networks) routes(servicesFirst bool) ([]string, error) {
+	var cidrs []string
+	for _, network := range n.ClusterNetworks {
+		cidrs = append(cidrs, network.CIDR)
+	}
+	if servicesFirst {
+		cidrs = append(append([]string{}, n.ServiceNetworks...), cidrs...)
+	} else {
+		cidrs = append(cidrs, n.ServiceNetworks...)
+	}
+	var routes []string
+	for _, cidr := range cidrs {
+		address, err := render.CIDRAddress(cidr)
+		if err != nil {
+			return nil, err
+		}
+		mask, err := render.CIDRMask(cidr)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, address+" "+mask)
+	}
+	return routes, nil
+}
+
+// CCDConfigMap holds the client specific configuration of the VPN server,
+// which routes the networks of the guest cluster to the worker client.
+type CCDConfigMap struct {
+	Namespace string
+	Networks  Networks
+}
+
+func (o CCDConfigMap) Build() (*corev1.ConfigMap, error) {
+	routes, err := o.Networks.routes(true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build openvpn worker routes: %w", err)
+	}
+	var iroutes []string
+	for _, route := range routes {
+		iroutes = append(iroutes, "iroute "+route)
+	}
+	return common.ConfigMap(o.Namespace, ccdConfigMapName, map[string]string{
+		workerKey: strings.Join(iroutes, "\n"),
+	}), nil
+}
+
+const serverConfigHeader = `server 192.168.255.0 255.255.255.0
+verb 3
+ca ca.crt
+cert tls.crt
+key tls.key
+dh none
+#TODO figure out how to generate this without needing the openvpn command if possible
+#tls-auth /etc/openvpn/pki/ta.key
+#key-direction 0
+keepalive 10 60
+persist-key
+persist-tun
+
+proto tcp
+# Rely on Docker to do port mapping, internally always 1194
+port 1194
+dev tun0
+status /tmp/openvpn-status.log
+
+comp-lzo no
+
+client-config-dir /etc/openvpn/ccd
+
+### Route Configurations Below
+`
+
+const serverConfigPush = `
+
+### Push Configurations Below
+push "comp-lzo no"
+
+### Extra Configurations Below
+duplicate-cn
+client-to-client`
+
+type ServerConfigMap struct {
+	Namespace string
+	Networks  Networks
+}
+
+func (o ServerConfigMap) Build() (*corev1.ConfigMap, error) {
+	routes, err := o.Networks.routes(false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build openvpn server routes: %w", err)
+	}
+	config := &strings.Builder{}
+	config.WriteString(serverConfigHeader)
+	for _, route := range routes {
+		fmt.Fprintf(config, "route %s\n", route)
+	}
+	config.WriteString(serverConfigPush)
+	for _, route := range routes {
+		fmt.Fprintf(config, "\npush \"route %s\"", route)
+	}
+	return common.ConfigMap(o.Namespace, serverName, map[string]string{
+		serverConfigKey: config.String(),
+	}), nil
+}
+
+type ServerSecret struct {
+	Namespace string
+	// PKI is the PKI of the control plane
+	PKI map[string][]byte
+}
+
+func (o ServerSecret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, serverName, map[string][]byte{
+		"tls.crt": o.PKI["openvpn-server.crt"],
+		"tls.key": o.PKI["openvpn-server.key"],
+		"ca.crt":  o.PKI["openvpn-ca.crt"],
+	})
+}
+
+type ServerDeployment struct {
+	Namespace   string
+	Image       string
+	RestartDate string
+	Scheduling  *hyperv1.ControlPlaneScheduling
+}
+
+func (o ServerDeployment) Build() *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serverName,
+			Namespace: o.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: k8sutilspointer.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": serverName,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": serverName,
+					},
+					Annotations: common.RestartAnnotations(o.RestartDate),
+				},
+				Spec: corev1.PodSpec{
+					AutomountServiceAccountToken: k8sutilspointer.BoolPtr(false),
+					ServiceAccountName:           serviceAccountName,
+					Containers: []corev1.Container{
+						{
+							Name:            serverName,
+							Image:           o.Image,
+							ImagePullPolicy: corev1.PullAlways,
+							Command:         []string{"/usr/sbin/openvpn"},
+							Args:            []string{"--config", "/etc/openvpn/config/" + serverConfigKey},
+							WorkingDir:      "/etc/openvpn/server",
+							SecurityContext: &corev1.SecurityContext{
+								Privileged: k8sutilspointer.BoolPtr(true),
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "server",
+									MountPath: "/etc/openvpn/server",
+								},
+								{
+									Name:      "ccd",
+									MountPath: "/etc/openvpn/ccd",
+								},
+								{
+									Name:      "config",
+									MountPath: "/etc/openvpn/config",
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						common.SecretVolume("server", serverName),
+						common.ConfigMapVolume("config", serverName),
+						common.ConfigMapVolume("ccd", ccdConfigMapName),
+					},
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
+
+// clientConfig connects the VPN clients of the control plane components to the
+// VPN server.
+const clientConfig = `client
+verb 3
+nobind
+dev tun
+remote-cert-tls server
+remote openvpn-server 1194 tcp
+ca secret/ca.crt
+cert secret/tls.crt
+key secret/tls.key`
+
+// ClientConfigMap holds the configuration of the VPN client of a control
+// plane component.
+type ClientConfigMap struct {
+	Namespace string
+	Name      string
+}
+
+func (o ClientConfigMap) Build() *corev1.ConfigMap {
+	return common.ConfigMap(o.Namespace, o.Name, map[string]string{
+		"client.conf": clientConfig,
+	})
+}
+
+// ClientSecret holds the client certificate of the VPN client of a control
+// plane component.
+type ClientSecret struct {
+	Namespace string
+	Name      string
+	Cert      []byte
+	Key       []byte
+	CA        []byte
+}
+
+func (o ClientSecret) Build() *corev1.Secret {
+	return common.Secret(o.Namespace, o.Name, map[string][]byte{
+		"tls.crt": o.Cert,
+		"tls.key": o.Key,
+		"ca.crt":  o.CA,
+	})
+}
+
+// ClientContainer returns the VPN client sidecar of a control plane component,
+// which mounts the volumes vpnconfig and vpnsecret of the ClientConfigMap and
+// ClientSecret of the component. The pods of the component run with the
+// ServiceAccount of the VPN.
+func ClientContainer(image string) corev1.Container {
+	return corev1.Container{
+		Name:            "openvpn-client",
+		Image:           image,
+		ImagePullPolicy: corev1.PullAlways,
+		Command:         []string{"/usr/sbin/openvpn"},
+		Args:            []string{"--config", "/etc/openvpn/config/client.conf"},
+		WorkingDir:      "/etc/openvpn/",
+		SecurityContext: &corev1.SecurityContext{
+			Privileged: k8sutilspointer.BoolPtr(true),
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "vpnsecret",
+				MountPath: "/etc/openvpn/secret",
+			},
+			{
+				Name:      "vpnconfig",
+				MountPath: "/etc/openvpn/config",
+			},
+		},
+	}
+}
+
+// ClientVolumes returns the volumes the ClientContainer mounts.
+func ClientVolumes(configMapName, secretName string) []corev1.Volume {
+	return []corev1.Volume{
+		common.ConfigMapVolume("vpnconfig", configMapName),
+		common.SecretVolume("vpnsecret", secretName),
+	}
+}
+
+// ServiceAccountName is the name of the ServiceAccount the VPN server and
+// clients run with.
+const ServiceAccountName = serviceAccountName
@@ -0,0 +1,259 @@
+package routerproxy
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/openvpn"
+	"openshift.io/hypershift/support/scheduling"
+)
+
+const (
+	name                   = "router-proxy"
+	configMapName          = "router-proxy-config"
+	vpnClientConfigMapName = "router-proxy-vpnclient-config"
+	vpnClientSecretName    = "router-proxy-vpnclient-secret"
+	configKey              = "haproxy.cfg"
+	kubeconfigMountPath    = "/etc/openshift"
+)
+
+// haproxyConfig forwards the HTTP and HTTPS traffic of the control plane
+// namespace to the default router of the guest cluster, whose service IP the
+// init container fills in.
+const haproxyConfig = `global
+  maxconn 7000
+
+defaults
+  mode tcp
+  timeout client 10m
+  timeout server 10m
+  timeout connect 10s
+  timeout client-fin 5s
+  timeout server-fin 5s
+  timeout queue 5s
+  retries 3
+
+frontend local_router_http
+  bind :8080
+  default_backend remote_router_http
+
+frontend local_router_https
+  bind :8443
+  default_backend remote_router_https
+
+backend remote_router_http
+  mode tcp
+  server remote_http ${ROUTER_SERVICE_IP}:80
+
+backend remote_router_https
+  mode tcp
+  server remote_https ${ROUTER_SERVICE_IP}:443`
+
+type ConfigMap struct {
+	Namespace string
+}
+
+func (o ConfigMap) Build() *corev1.ConfigMap {
+	return common.ConfigMap(o.Namespace, configMapName, map[string]string{
+		configKey: haproxyConfig,
+	})
+}
+
+// VPNClientConfigMap holds the configuration of the VPN client the router
+// proxy reaches the guest cluster through.
+type VPNClientConfigMap struct {
+	Namespace string
+}
+
+func (o VPNClientConfigMap) Build() *corev1.ConfigMap {
+	return openvpn.ClientConfigMap{
+		Namespace: o.Namespace,
+		Name:      vpnClientConfigMapName,
+	}.Build()
+}
+
+type VPNClientSecret struct {
+	Namespace string
+	Cert      []byte
+	Key       []byte
+	CA        []byte
+}
+
+func (o VPNClientSecret) Build() *corev1.Secret {
+	return openvpn.ClientSecret{
+		Namespace: o.Namespace,
+		Name:      vpnClientSecretName,
+		Cert:      o.Cert,
+		Key:       o.Key,
+		CA:        o.CA,
+	}.Build()
+}
+
+// Service is the service of the routes of the control plane namespace for one
+// protocol of the router proxy.
+type Service struct {
+	Namespace string
+	// Protocol is http or https
+	Protocol string
+	Port     int32
+}
+
+func (o Service) Build() *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "router-" + o.Protocol,
+			Namespace: o.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       o.Protocol,
+					Port:       o.Port,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromString(o.Protocol),
+				},
+			},
+			Selector: map[string]string{
+				"app": name,
+			},
+		},
+	}
+}
+
+// Images are the images of the containers of the router proxy pod.
+type Images struct {
+	// CLI looks up the service IP of the router of the guest cluster
+	CLI           string
+	HAProxyRouter string
+	// OpenVPN is the image of the VPN client sidecar
+	OpenVPN string
+}
+
+type Deployment struct {
+	Namespace  string
+	Images     Images
+	Scheduling *hyperv1.ControlPlaneScheduling
+}
+
+func (o Deployment) Build() *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: o.Namespace,
+			Labels: map[string]string{
+				"app": name,
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: k8sutilspointer.Int32Ptr(1),
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RecreateDeploymentStrategyType,
+			},
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": name,
+					},
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: openvpn.ServiceAccountName,
+					InitContainers: []corev1.Container{
+						{
+							Name:    "service-ip",
+							Image:   o.Images.CLI,
+							Command: []string{"/bin/bash"},
+							Args: []string{
+								"-c",
+								`#!/bin/bash
+while ! oc get service -n openshift-ingress router-internal-default; do
+  echo "Waiting for the internal default router to be available"
+  sleep 30
+done
+export ROUTER_SERVICE_IP="$(oc get service -n openshift-ingress router-internal-default -o jsonpath='{ .spec.clusterIP }')"
+sed -e "s/\${ROUTER_SERVICE_IP}/${ROUTER_SERVICE_IP}/" /config-template/haproxy.cfg > /config/haproxy.cfg`,
+							},
+							Env: []corev1.EnvVar{
+								{
+									Name:  "KUBECONFIG",
+									Value: kubeconfigMountPath + "/kubeconfig",
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "config-template",
+									MountPath: "/config-template",
+								},
+								{
+									Name:      "config",
+									MountPath: "/config",
+								},
+								{
+									Name:      "kubeconfig",
+									MountPath: kubeconfigMountPath,
+								},
+							},
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:    name,
+							Image:   o.Images.HAProxyRouter,
+							Command: []string{"haproxy"},
+							Args:    []string{"-f", "/usr/local/etc/haproxy/" + configKey},
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          "https",
+									ContainerPort: 8443,
+									Protocol:      corev1.ProtocolTCP,
+								},
+								{
+									Name:          "http",
+									ContainerPort: 8080,
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								RunAsUser: k8sutilspointer.Int64Ptr(1001),
+								Capabilities: &corev1.Capabilities{
+									Drop: []corev1.Capability{"MKNOD", "NET_ADMIN"},
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "config",
+									MountPath: "/usr/local/etc/haproxy",
+								},
+							},
+						},
+						openvpn.ClientContainer(o.Images.OpenVPN),
+					},
+					Volumes: append([]corev1.Volume{
+						common.ConfigMapVolume("config-template", configMapName),
+						common.EmptyDirVolume("config"),
+						common.SecretVolume("kubeconfig", common.ServiceNetworkAdminKubeconfigSecretName),
+					}, openvpn.ClientVolumes(vpnClientConfigMapName, vpnClientSecretName)...),
+				},
+			},
+		},
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
+	return deployment
+}
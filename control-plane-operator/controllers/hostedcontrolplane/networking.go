@@ -0,0 +1,209 @@
+package hostedcontrolplane
+
+import (
+	"fmt"
+	"net"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+// ipv6HostPrefix is the prefix length of the subnet of an IPv6 pod network
+// that is assigned to each node.
+const ipv6HostPrefix = 64
+
+// setNetworkingParams sets the network parameters of a control plane. Unset
+// fields keep their defaults.
+func setNetworkingParams(hcp *hyperv1.HostedControlPlane, params *render.ClusterParams) {
+	networking := hcp.Spec.Networking
+	params.ServiceCIDR = hcp.Spec.ServiceCIDR
+	params.PodCIDR = hcp.Spec.PodCIDR
+	if len(networking.NetworkType) > 0 {
+		params.NetworkType = string(networking.NetworkType)
+	}
+	if len(networking.MachineCIDR) > 0 {
+		params.MachineCIDR = networking.MachineCIDR
+	}
+	if networking.HostPrefix > 0 {
+		params.HostPrefix = networking.HostPrefix
+	}
+	params.NetworkMTU = networking.MTU
+
+	params.ServiceNetworks = render.SplitCIDRs(params.ServiceCIDR)
+	params.ClusterNetworks = nil
+	for _, cidr := range render.SplitCIDRs(params.PodCIDR) {
+		network := render.ClusterNetwork{CIDR: cidr, HostPrefix: params.HostPrefix}
+		if render.IsIPv6(cidr) {
+			network.HostPrefix = ipv6HostPrefix
+			network.IPv6 = true
+		}
+		params.ClusterNetworks = append(params.ClusterNetworks, network)
+	}
+}
+
+// apiServerAdvertiseAddress returns the node-local address workers use to
+// reach the kube-apiserver. It defaults to an address of the family of the
+// first service network.
+func apiServerAdvertiseAddress(hcp *hyperv1.HostedControlPlane) string {
+	if hcp.Spec.Networking.APIServer != nil && len(hcp.Spec.Networking.APIServer.AdvertiseAddress) > 0 {
+		return hcp.Spec.Networking.APIServer.AdvertiseAddress
+	}
+	if serviceNetworks := render.SplitCIDRs(hcp.Spec.ServiceCIDR); len(serviceNetworks) > 0 && render.IsIPv6(serviceNetworks[0]) {
+		return DefaultAPIServerIPv6Address
+	}
+	return DefaultAPIServerIPAddress
+}
+
+type namedNetwork struct {
+	name  string
+	cidr  string
+	ipNet *net.IPNet
+}
+
+// validateNetworking makes sure the networks of a control plane are valid and
+// don't overlap, since routing between them would break otherwise.
+func validateNetworking(hcp *hyperv1.HostedControlPlane) error {
+	params := render.NewClusterParams()
+	setNetworkingParams(hcp, params)
+
+	serviceNetworks, err := parseNetworks("service", params.ServiceNetworks)
+	if err != nil {
+		return err
+	}
+	var podCIDRs []string
+	for _, network := range params.ClusterNetworks {
+		podCIDRs = append(podCIDRs, network.CIDR)
+	}
+	podNetworks, err := parseNetworks("pod", podCIDRs)
+	if err != nil {
+		return err
+	}
+	machineNetworks, err := parseNetworks("machine", []string{params.MachineCIDR})
+	if err != nil {
+		return err
+	}
+
+	if len(serviceNetworks) != len(podNetworks) {
+		return fmt.Errorf("the service networks %s and the pod networks %s must have the same IP families", params.ServiceCIDR, params.PodCIDR)
+	}
+	for i := range serviceNetworks {
+		if isIPv6Network(serviceNetworks[i]) != isIPv6Network(podNetworks[i]) {
+			return fmt.Errorf("the service networks %s and the pod networks %s must have the same IP families in the same order", params.ServiceCIDR, params.PodCIDR)
+		}
+	}
+
+	networks := append(append(append([]namedNetwork{}, serviceNetworks...), podNetworks...), machineNetworks...)
+	for i := range networks {
+		for j := i + 1; j < len(networks); j++ {
+			if networks[i].ipNet.Contains(networks[j].ipNet.IP) || networks[j].ipNet.Contains(networks[i].ipNet.IP) {
+				return fmt.Errorf("the %s network %s overlaps with the %s network %s", networks[i].name, networks[i].cidr, networks[j].name, networks[j].cidr)
+			}
+		}
+	}
+
+	for i, network := range params.ClusterNetworks {
+		if network.IPv6 {
+			continue
+		}
+		podPrefix, bits := podNetworks[i].ipNet.Mask.Size()
+		if int(network.HostPrefix) < podPrefix || int(network.HostPrefix) > bits {
+			return fmt.Errorf("host prefix %d must be between the prefix length of the pod network %s and %d", network.HostPrefix, network.CIDR, bits)
+		}
+	}
+	if params.NetworkMTU > 0 && params.NetworkType != string(hyperv1.OpenShiftSDN) && params.NetworkType != string(hyperv1.OVNKubernetes) {
+		return fmt.Errorf("the MTU can't be set for the %s network type", params.NetworkType)
+	}
+
+	if len(serviceNetworks) > 1 && !dualStackEnabled(hcp.Spec.FeatureGates) {
+		return fmt.Errorf("dual-stack networks require the %s feature set or the IPv6DualStack feature gate", configv1.IPv6DualStackNoUpgrade)
+	}
+	for _, network := range networks {
+		if isIPv6Network(network) && hcp.Spec.Tunnel.Type != hyperv1.KonnectivityTunnel {
+			return fmt.Errorf("the IPv6 %s network %s requires the %s tunnel", network.name, network.cidr, hyperv1.KonnectivityTunnel)
+		}
+	}
+
+	address := apiServerAdvertiseAddress(hcp)
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return fmt.Errorf("invalid API server advertise address %q", address)
+	}
+	for _, network := range networks {
+		if network.ipNet.Contains(ip) {
+			return fmt.Errorf("the API server advertise address %s is in the %s network %s", address, network.name, network.cidr)
+		}
+	}
+	return nil
+}
+
+// parseNetworks parses a list of networks, of which there may be at most one
+// per IP family.
+func parseNetworks(name string, cidrs []string) ([]namedNetwork, error) {
+	if len(cidrs) == 0 {
+		return nil, fmt.Errorf("no %s network specified", name)
+	}
+	var networks []namedNetwork
+	families := map[bool]bool{}
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s network %q: %w", name, cidr, err)
+		}
+		network := namedNetwork{name: name, cidr: cidr, ipNet: ipNet}
+		if families[isIPv6Network(network)] {
+			return nil, fmt.Errorf("only one %s network per IP family is supported, got %s", name, cidr)
+		}
+		families[isIPv6Network(network)] = true
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+func isIPv6Network(network namedNetwork) bool {
+	return network.ipNet.IP.To4() == nil
+}
+
+// dualStackEnabled returns whether the feature gates of a control plane enable
+// dual-stack networking.
+func dualStackEnabled(spec configv1.FeatureGateSpec) bool {
+	switch spec.FeatureSet {
+	case configv1.IPv6DualStackNoUpgrade:
+		return true
+	case configv1.CustomNoUpgrade:
+		if spec.CustomNoUpgrade == nil {
+			return false
+		}
+		for _, name := range spec.CustomNoUpgrade.Enabled {
+			if name == "IPv6DualStack" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ingressStrategy returns the ingress strategy of a control plane. The router
+// proxy of a management router shard reaches the guest routers through the
+// VPN, which doesn't run with the Konnectivity tunnel, so control planes using
+// it default to a guest load balancer instead.
+func ingressStrategy(hcp *hyperv1.HostedControlPlane) hyperv1.IngressStrategyType {
+	if len(hcp.Spec.Ingress.Strategy) > 0 {
+		return hcp.Spec.Ingress.Strategy
+	}
+	if hcp.Spec.Tunnel.Type == hyperv1.KonnectivityTunnel {
+		return hyperv1.GuestLoadBalancer
+	}
+	return hyperv1.ManagementRouterShard
+}
+
+// validateIngressStrategy rejects a management router shard with the
+// Konnectivity tunnel, which has no route from the management cluster to the
+// guest routers.
+func validateIngressStrategy(hcp *hyperv1.HostedControlPlane) error {
+	if hcp.Spec.Tunnel.Type == hyperv1.KonnectivityTunnel && ingressStrategy(hcp) == hyperv1.ManagementRouterShard {
+		return fmt.Errorf("the %s ingress strategy proxies guest routes through the VPN and can't be used with the %s tunnel", hyperv1.ManagementRouterShard, hyperv1.KonnectivityTunnel)
+	}
+	return nil
+}
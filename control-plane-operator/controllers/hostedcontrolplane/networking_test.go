@@ -0,0 +1,209 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/stretchr/testify/assert"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestValidateNetworking(t *testing.T) {
+	tests := []struct {
+		name        string
+		serviceCIDR string
+		podCIDR     string
+		networking  hyperv1.ClusterNetworking
+		tunnel      hyperv1.TunnelType
+		featureSet  configv1.FeatureSet
+		valid       bool
+	}{
+		{
+			name:        "defaults",
+			serviceCIDR: "172.31.0.0/16",
+			podCIDR:     "10.132.0.0/14",
+			valid:       true,
+		},
+		{
+			name:        "ovn with mtu",
+			serviceCIDR: "172.31.0.0/16",
+			podCIDR:     "10.132.0.0/14",
+			networking: hyperv1.ClusterNetworking{
+				NetworkType: hyperv1.OVNKubernetes,
+				MachineCIDR: "192.168.0.0/24",
+				HostPrefix:  24,
+				MTU:         1400,
+			},
+			valid: true,
+		},
+		{
+			name:        "pod and service networks overlap",
+			serviceCIDR: "10.132.0.0/16",
+			podCIDR:     "10.132.0.0/14",
+		},
+		{
+			name:        "machine network overlaps",
+			serviceCIDR: "172.31.0.0/16",
+			podCIDR:     "10.132.0.0/14",
+			networking:  hyperv1.ClusterNetworking{MachineCIDR: "10.128.0.0/9"},
+		},
+		{
+			name:        "invalid cidr",
+			serviceCIDR: "172.31.0.0",
+			podCIDR:     "10.132.0.0/14",
+		},
+		{
+			name:        "host prefix shorter than pod network",
+			serviceCIDR: "172.31.0.0/16",
+			podCIDR:     "10.132.0.0/14",
+			networking:  hyperv1.ClusterNetworking{HostPrefix: 12},
+		},
+		{
+			name:        "mtu with other network type",
+			serviceCIDR: "172.31.0.0/16",
+			podCIDR:     "10.132.0.0/14",
+			networking:  hyperv1.ClusterNetworking{NetworkType: hyperv1.OtherNetworkType, MTU: 1400},
+		},
+		{
+			name:        "dual-stack",
+			serviceCIDR: "172.31.0.0/16,fd02::/112",
+			podCIDR:     "10.132.0.0/14,fd01::/48",
+			tunnel:      hyperv1.KonnectivityTunnel,
+			featureSet:  configv1.IPv6DualStackNoUpgrade,
+			valid:       true,
+		},
+		{
+			name:        "dual-stack without feature set",
+			serviceCIDR: "172.31.0.0/16,fd02::/112",
+			podCIDR:     "10.132.0.0/14,fd01::/48",
+			tunnel:      hyperv1.KonnectivityTunnel,
+		},
+		{
+			name:        "dual-stack with openvpn",
+			serviceCIDR: "172.31.0.0/16,fd02::/112",
+			podCIDR:     "10.132.0.0/14,fd01::/48",
+			featureSet:  configv1.IPv6DualStackNoUpgrade,
+		},
+		{
+			name:        "mismatched families",
+			serviceCIDR: "172.31.0.0/16,fd02::/112",
+			podCIDR:     "fd01::/48,10.132.0.0/14",
+			tunnel:      hyperv1.KonnectivityTunnel,
+			featureSet:  configv1.IPv6DualStackNoUpgrade,
+		},
+		{
+			name:        "two networks of one family",
+			serviceCIDR: "172.31.0.0/16,172.30.0.0/16",
+			podCIDR:     "10.132.0.0/14,10.128.0.0/14",
+			featureSet:  configv1.IPv6DualStackNoUpgrade,
+		},
+		{
+			name:        "ipv6 advertise address in ipv6 service network",
+			serviceCIDR: "fd00::/112",
+			podCIDR:     "fd01::/48",
+			networking:  hyperv1.ClusterNetworking{MachineCIDR: "fd03::/64"},
+			tunnel:      hyperv1.KonnectivityTunnel,
+		},
+		{
+			name:        "ipv6 with custom advertise address",
+			serviceCIDR: "fd00::/112",
+			podCIDR:     "fd01::/48",
+			networking: hyperv1.ClusterNetworking{
+				MachineCIDR: "fd03::/64",
+				APIServer:   &hyperv1.APIServerNetworking{AdvertiseAddress: "fd04::1"},
+			},
+			tunnel: hyperv1.KonnectivityTunnel,
+			valid:  true,
+		},
+		{
+			name:        "invalid advertise address",
+			serviceCIDR: "172.31.0.0/16",
+			podCIDR:     "10.132.0.0/14",
+			networking:  hyperv1.ClusterNetworking{APIServer: &hyperv1.APIServerNetworking{AdvertiseAddress: "api"}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hcp := &hyperv1.HostedControlPlane{}
+			hcp.Spec.ServiceCIDR = test.serviceCIDR
+			hcp.Spec.PodCIDR = test.podCIDR
+			hcp.Spec.Networking = test.networking
+			hcp.Spec.Tunnel.Type = test.tunnel
+			hcp.Spec.FeatureGates.FeatureSet = test.featureSet
+			err := validateNetworking(hcp)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSetNetworkingParams(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{}
+	hcp.Spec.ServiceCIDR = "172.31.0.0/16,fd02::/112"
+	hcp.Spec.PodCIDR = "10.132.0.0/14,fd01::/48"
+	params := render.NewClusterParams()
+	setNetworkingParams(hcp, params)
+	assert.Equal(t, []string{"172.31.0.0/16", "fd02::/112"}, params.ServiceNetworks)
+	assert.Equal(t, []render.ClusterNetwork{
+		{CIDR: "10.132.0.0/14", HostPrefix: 23},
+		{CIDR: "fd01::/48", HostPrefix: 64, IPv6: true},
+	}, params.ClusterNetworks)
+	assert.Equal(t, DefaultAPIServerIPAddress, apiServerAdvertiseAddress(hcp))
+
+	hcp.Spec.ServiceCIDR = "fd02::/112,172.31.0.0/16"
+	assert.Equal(t, DefaultAPIServerIPv6Address, apiServerAdvertiseAddress(hcp))
+}
+
+func TestIngressStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		tunnel   hyperv1.TunnelType
+		strategy hyperv1.IngressStrategyType
+		expected hyperv1.IngressStrategyType
+		valid    bool
+	}{
+		{
+			name:     "openvpn default",
+			expected: hyperv1.ManagementRouterShard,
+			valid:    true,
+		},
+		{
+			name:     "openvpn with guest load balancer",
+			tunnel:   hyperv1.OpenVPNTunnel,
+			strategy: hyperv1.GuestLoadBalancer,
+			expected: hyperv1.GuestLoadBalancer,
+			valid:    true,
+		},
+		{
+			name:     "konnectivity default",
+			tunnel:   hyperv1.KonnectivityTunnel,
+			expected: hyperv1.GuestLoadBalancer,
+			valid:    true,
+		},
+		{
+			name:     "konnectivity with management router shard",
+			tunnel:   hyperv1.KonnectivityTunnel,
+			strategy: hyperv1.ManagementRouterShard,
+			expected: hyperv1.ManagementRouterShard,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hcp := &hyperv1.HostedControlPlane{}
+			hcp.Spec.Tunnel.Type = test.tunnel
+			hcp.Spec.Ingress.Strategy = test.strategy
+			assert.Equal(t, test.expected, ingressStrategy(hcp))
+			err := validateIngressStrategy(hcp)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
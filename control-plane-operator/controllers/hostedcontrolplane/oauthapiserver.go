@@ -0,0 +1,54 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/oauthapiserver"
+)
+
+// oauthAPIServerObjects returns the objects of the oauth-apiserver of a
+// control plane.
+func oauthAPIServerObjects(cc *componentContext) ([]client.Object, error) {
+	targetNamespace := cc.hcp.GetName()
+	auditPolicy, err := oauthapiserver.AuditPolicyConfigMap{
+		Namespace:    targetNamespace,
+		Profile:      hyperv1.AuditProfileType(cc.params.AuditProfile),
+		CustomPolicy: cc.params.AuditPolicy,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	auditLog := oauthapiserver.AuditLog{
+		MaxSize:    cc.params.AuditLogMaxSize,
+		MaxBackups: cc.params.AuditLogMaxBackups,
+		MaxAge:     cc.params.AuditLogMaxAge,
+	}
+	if cc.params.APIServerAuditEnabled {
+		auditLog.WebhookSecret = auditWebhookSecretName
+		auditLog.WebhookMode = cc.params.AuditWebhookMode
+	}
+	return []client.Object{
+		oauthapiserver.Deployment{
+			Namespace:      targetNamespace,
+			Image:          cc.images["oauth-apiserver"],
+			ClusterID:      cc.params.ClusterID,
+			EtcdClientName: cc.params.EtcdClientName,
+			AuditLog:       auditLog,
+			Scheduling:     cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+		auditPolicy,
+		oauthapiserver.Secret{
+			Namespace:      targetNamespace,
+			Kubeconfig:     cc.pkiData["internal-admin.kubeconfig"],
+			ServerCert:     cc.pkiData["oauth-apiserver-server.crt"],
+			ServerKey:      cc.pkiData["oauth-apiserver-server.key"],
+			EtcdClientCert: cc.pkiData["etcd-client.crt"],
+			EtcdClientKey:  cc.pkiData["etcd-client.key"],
+		}.Build(),
+		oauthapiserver.CAConfigMap{
+			Namespace: targetNamespace,
+			CA:        cc.pkiData["root-ca.crt"],
+		}.Build(),
+	}, nil
+}
@@ -0,0 +1,97 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestOAuthAPIServerObjects(t *testing.T) {
+	customPolicy := "apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n- level: Metadata\n"
+	tests := []struct {
+		name           string
+		audit          hyperv1.AuditSpec
+		policyContains []string
+		policyExcludes []string
+		webhook        bool
+	}{
+		{
+			name:           "default profile",
+			policyContains: []string{"level: Metadata"},
+			policyExcludes: []string{"level: RequestResponse"},
+		},
+		{
+			name:           "write request bodies",
+			audit:          hyperv1.AuditSpec{Profile: hyperv1.WriteRequestBodiesAuditProfileType},
+			policyContains: []string{"level: RequestResponse", "- deletecollection"},
+		},
+		{
+			name:           "all request bodies",
+			audit:          hyperv1.AuditSpec{Profile: hyperv1.AllRequestBodiesAuditProfileType},
+			policyContains: []string{"level: RequestResponse"},
+			policyExcludes: []string{"- deletecollection"},
+		},
+		{
+			name:           "custom policy",
+			audit:          hyperv1.AuditSpec{Profile: hyperv1.CustomAuditProfileType},
+			policyContains: []string{customPolicy},
+		},
+		{
+			name: "webhook",
+			audit: hyperv1.AuditSpec{
+				Webhook: &hyperv1.AuditWebhookSpec{Mode: "blocking"},
+			},
+			webhook: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := render.NewClusterParams()
+			params.EtcdClientName = "etcd-client"
+			audit := &auditConfig{spec: test.audit}
+			if test.audit.Profile == hyperv1.CustomAuditProfileType {
+				audit.policy = customPolicy
+			}
+			audit.setParams(params)
+
+			objects, err := oauthAPIServerObjects(&componentContext{
+				hcp: &hyperv1.HostedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "example"},
+				},
+				params: params,
+				images: map[string]string{"oauth-apiserver": "example.com/oauth-apiserver:latest"},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			policy := componentObject(t, objects, "ConfigMap", "openshift-oauth-apiserver-auditpolicy").(*corev1.ConfigMap)
+			for _, s := range test.policyContains {
+				assert.Contains(t, policy.Data["policy.yaml"], s)
+			}
+			for _, s := range test.policyExcludes {
+				assert.NotContains(t, policy.Data["policy.yaml"], s)
+			}
+
+			deployment := componentObject(t, objects, "Deployment", "openshift-oauth-apiserver").(*appsv1.Deployment)
+			container := deployment.Spec.Template.Spec.Containers[0]
+			assert.Equal(t, "example.com/oauth-apiserver:latest", container.Image)
+			assert.Contains(t, container.Args, "--etcd-servers=https://etcd-client:2379")
+			if test.webhook {
+				assert.Contains(t, container.Args, "--audit-webhook-mode=blocking")
+				volumes := deployment.Spec.Template.Spec.Volumes
+				assert.Equal(t, auditWebhookSecretName, volumes[len(volumes)-1].Secret.SecretName)
+			} else {
+				for _, arg := range container.Args {
+					assert.NotContains(t, arg, "--audit-webhook")
+				}
+			}
+		})
+	}
+}
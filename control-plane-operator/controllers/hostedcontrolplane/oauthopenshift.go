@@ -0,0 +1,129 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/common"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/oauthopenshift"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+// oauthOpenshiftObjects returns the objects of the OAuth server of a control
+// plane. The service of the server is left to the service publisher, and the
+// objects of generated secrets to the first install.
+func oauthOpenshiftObjects(cc *componentContext) ([]client.Object, error) {
+	targetNamespace := cc.hcp.GetName()
+	endpoints := oauthopenshift.Endpoints{
+		APIDNSName:   cc.params.ExternalAPIDNSName,
+		APIPort:      cc.params.ExternalAPIPort,
+		OAuthDNSName: cc.params.ExternalOauthDNSName,
+		OAuthPort:    cc.params.ExternalOauthPort,
+	}
+	identityProvidersHash, err := cc.identityProviders.hash()
+	if err != nil {
+		return nil, err
+	}
+	config, err := oauthopenshift.ConfigMap{
+		Namespace:         targetNamespace,
+		BaseDomain:        cc.params.BaseDomain,
+		Endpoints:         endpoints,
+		IdentityProviders: cc.identityProviders.providers,
+		NamedCerts:        len(cc.params.NamedCerts) > 0,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	branding, err := oauthopenshift.BrandingSecret{Namespace: targetNamespace}.Build()
+	if err != nil {
+		return nil, err
+	}
+	challengingClient, err := oauthopenshift.ChallengingClient{
+		Namespace: targetNamespace,
+		Endpoints: endpoints,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	var objects []client.Object
+	if cc.random != nil {
+		objects, err = oauthOpenshiftGeneratedObjects(cc, endpoints)
+		if err != nil {
+			return nil, err
+		}
+	}
+	objects = append(objects,
+		challengingClient,
+		config,
+		oauthopenshift.Deployment{
+			Namespace:         targetNamespace,
+			Image:             cc.images["oauth-server"],
+			ClusterID:         cc.params.ClusterID,
+			HighlyAvailable:   cc.params.APIAvailabilityPolicy == render.HighlyAvailable,
+			RestartDate:       cc.params.RestartDate,
+			PriorityClassName: cc.params.MasterPriorityClass,
+			Proxy: oauthopenshift.Proxy{
+				HTTPProxy:       cc.params.HTTPProxy,
+				HTTPSProxy:      cc.params.HTTPSProxy,
+				NoProxy:         cc.params.ControlPlaneNoProxy,
+				TrustedCABundle: cc.params.TrustedCABundle,
+			},
+			IdentityProviderVolumes: cc.identityProviders.volumes,
+			IdentityProvidersHash:   identityProvidersHash,
+			Resources:               deploymentResources(cc.hcp.Spec.ControlPlaneResources, oauthServerDeploymentName),
+			Scheduling:              cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+		branding,
+		oauthopenshift.Secret{
+			Namespace:  targetNamespace,
+			Kubeconfig: cc.pkiData["internal-admin.kubeconfig"],
+			ServerCert: cc.pkiData["ingress-openshift.crt"],
+			ServerKey:  cc.pkiData["ingress-openshift.key"],
+		}.Build(),
+		oauthopenshift.CAConfigMap{
+			Namespace: targetNamespace,
+			CA:        cc.pkiData["combined-ca.crt"],
+		}.Build(),
+	)
+	if len(cc.params.TrustedCABundle) > 0 {
+		objects = append(objects, oauthopenshift.TrustedCAConfigMap{
+			Namespace: targetNamespace,
+			CABundle:  cc.params.TrustedCABundle,
+		}.Build())
+	}
+	return objects, nil
+}
+
+// oauthOpenshiftGeneratedObjects returns the session secret and the browser
+// client of the OAuth server, whose secrets are generated.
+func oauthOpenshiftGeneratedObjects(cc *componentContext, endpoints oauthopenshift.Endpoints) ([]client.Object, error) {
+	targetNamespace := cc.hcp.GetName()
+	sessionAuthentication, err := common.RandomString(cc.random, 64)
+	if err != nil {
+		return nil, err
+	}
+	sessionEncryption, err := common.RandomString(cc.random, 32)
+	if err != nil {
+		return nil, err
+	}
+	sessionSecret, err := oauthopenshift.SessionSecret{
+		Namespace:      targetNamespace,
+		Authentication: sessionAuthentication,
+		Encryption:     sessionEncryption,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	browserClientSecret, err := common.RandomString(cc.random, 32)
+	if err != nil {
+		return nil, err
+	}
+	browserClient, err := oauthopenshift.BrowserClient{
+		Namespace: targetNamespace,
+		Endpoints: endpoints,
+		Secret:    browserClientSecret,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	return []client.Object{browserClient, sessionSecret}, nil
+}
@@ -0,0 +1,137 @@
+package hostedcontrolplane
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestOAuthOpenshiftObjects(t *testing.T) {
+	tests := []struct {
+		name              string
+		identityProviders *identityProviderConfig
+		trustedCABundle   string
+		objects           int
+	}{
+		{
+			name:              "no identity providers",
+			identityProviders: &identityProviderConfig{},
+			objects:           8,
+		},
+		{
+			name: "identity providers",
+			identityProviders: &identityProviderConfig{
+				providers: []interface{}{map[string]interface{}{"name": "github"}},
+				volumes: []render.OAuthVolume{{
+					Name:       "idp-secret-0",
+					MountPath:  "/etc/oauth/idp/0/client-secret",
+					SecretName: "idp-secret-0",
+				}},
+			},
+			objects: 8,
+		},
+		{
+			name:              "trusted ca bundle",
+			identityProviders: &identityProviderConfig{},
+			trustedCABundle:   "ca",
+			objects:           9,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := render.NewClusterParams()
+			params.ExternalOauthDNSName = "oauth.example.com"
+			params.ExternalOauthPort = 443
+			params.TrustedCABundle = test.trustedCABundle
+
+			objects, err := oauthOpenshiftObjects(&componentContext{
+				hcp: &hyperv1.HostedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "example"},
+				},
+				params:            params,
+				images:            map[string]string{"oauth-server": "example.com/oauth-server:latest"},
+				identityProviders: test.identityProviders,
+				random:            rand.New(rand.NewSource(1)),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			browserClient := componentObject(t, objects, "ConfigMap", "user-manifest-openshift-browser-client").(*corev1.ConfigMap)
+			assert.Contains(t, browserClient.Data["data"], "https://oauth.example.com:443/oauth/token/display")
+
+			config := componentObject(t, objects, "ConfigMap", "oauth-openshift-config").(*corev1.ConfigMap)
+			assert.Equal(t, oauthServerConfigMapName, config.Name)
+			if len(test.identityProviders.providers) > 0 {
+				assert.Contains(t, config.Data[oauthServerConfigKey], "name: github")
+			} else {
+				assert.Contains(t, config.Data[oauthServerConfigKey], "identityProviders: []")
+			}
+
+			deployment := componentObject(t, objects, "Deployment", "oauth-openshift").(*appsv1.Deployment)
+			container := deployment.Spec.Template.Spec.Containers[0]
+			assert.Equal(t, oauthServerContainerName, container.Name)
+			assert.Equal(t, "example.com/oauth-server:latest", container.Image)
+			hash, err := test.identityProviders.hash()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assert.Equal(t, hash, deployment.Spec.Template.Annotations[identityProvidersHashAnnotation])
+			for _, volume := range test.identityProviders.volumes {
+				assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{
+					Name:      volume.Name,
+					MountPath: volume.MountPath,
+					ReadOnly:  true,
+				})
+			}
+			if len(test.trustedCABundle) > 0 {
+				assert.Contains(t, container.Env, corev1.EnvVar{
+					Name:  "SSL_CERT_DIR",
+					Value: "/etc/pki/tls/certs:/etc/oauth-openshift-trusted-ca",
+				})
+			} else {
+				assert.Empty(t, container.Env)
+			}
+
+			sessionSecret := componentObject(t, objects, "Secret", "oauth-openshift-sessionsecret").(*corev1.Secret)
+			var sessionSecrets struct {
+				Secrets []struct {
+					Authentication string `json:"authentication"`
+					Encryption     string `json:"encryption"`
+				} `json:"secrets"`
+			}
+			if err := json.Unmarshal(sessionSecret.Data["v4-0-config-system-session"], &sessionSecrets); err != nil {
+				t.Fatalf("failed to decode session secrets: %v", err)
+			}
+			assert.Len(t, sessionSecrets.Secrets[0].Authentication, 64)
+			assert.Len(t, sessionSecrets.Secrets[0].Encryption, 32)
+		})
+	}
+}
+
+func TestOAuthOpenshiftObjectsWithoutRandom(t *testing.T) {
+	objects, err := oauthOpenshiftObjects(&componentContext{
+		hcp: &hyperv1.HostedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		},
+		params:            render.NewClusterParams(),
+		images:            map[string]string{"oauth-server": "example.com/oauth-server:latest"},
+		identityProviders: &identityProviderConfig{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Len(t, objects, 6)
+	for _, object := range objects {
+		assert.NotEqual(t, "oauth-openshift-sessionsecret", object.GetName())
+		assert.NotEqual(t, "openshift-browser-client", object.GetName())
+	}
+}
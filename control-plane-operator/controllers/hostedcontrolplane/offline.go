@@ -0,0 +1,365 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	securityv1 "github.com/openshift/api/security/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	hyperapi "openshift.io/hypershift/api"
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+)
+
+// RenderOptions are the inputs of an offline render that the control plane
+// operator otherwise reads from the management cluster.
+type RenderOptions struct {
+	// PullSecret is the content of the pull secret of the control plane.
+	PullSecret []byte
+	// SSHKey is the public SSH key of the guest cluster.
+	SSHKey []byte
+	// BaseDomain is used when the HostedControlPlane has no base domain.
+	BaseDomain string
+	// Platform is the platform of the management cluster.
+	Platform configv1.PlatformType
+	// Objects are further objects referenced by the HostedControlPlane, such
+	// as the secrets of its identity providers.
+	Objects []client.Object
+}
+
+// RenderedManifest is the manifest of an object the control plane operator
+// applies for a HostedControlPlane.
+type RenderedManifest struct {
+	// Name is the file name of the manifest.
+	Name    string
+	Content []byte
+	// Sensitive is true for the manifests of secrets, including the
+	// configmaps that carry secrets to the guest cluster.
+	Sensitive bool
+}
+
+// RenderControlPlane renders the manifests of every object the control plane
+// operator applies for a HostedControlPlane, without a management cluster.
+// The operator is run against a fake client that serves the objects it reads
+// out of memory. Endpoints that would be published on the management cluster
+// are replaced by placeholder names under the base domain of the control
+// plane. The PKI and the kubeadmin password are generated on every render.
+func RenderControlPlane(ctx context.Context, hcp *hyperv1.HostedControlPlane, releaseProvider releaseinfo.Provider, opts RenderOptions) ([]RenderedManifest, error) {
+	hcp = hcp.DeepCopy()
+	objects := []client.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: hcp.Namespace, Name: hcp.Spec.PullSecret.Name},
+			Data:       map[string][]byte{".dockerconfigjson": opts.PullSecret},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: hcp.Namespace, Name: hcp.Spec.SSHKey.Name},
+			Data:       map[string][]byte{"id_rsa.pub": opts.SSHKey},
+		},
+		&configv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Spec:       configv1.DNSSpec{BaseDomain: opts.BaseDomain},
+		},
+		&configv1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Status: configv1.InfrastructureStatus{
+				PlatformStatus: &configv1.PlatformStatus{Type: opts.Platform},
+			},
+		},
+		&securityv1.SecurityContextConstraints{
+			ObjectMeta: metav1.ObjectMeta{Name: "privileged"},
+		},
+		&operatorv1.IngressController{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ingressOperatorNamespace, Name: "default"},
+		},
+	}
+	// The guest pull secret is read from the namespace of the control plane
+	// components
+	if hcp.Namespace != hcp.GetName() || hcp.Spec.PullSecret.Name != pullSecretName {
+		objects = append(objects, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: hcp.GetName(), Name: pullSecretName},
+			Data:       map[string][]byte{".dockerconfigjson": opts.PullSecret},
+		})
+	}
+	objects = append(objects, opts.Objects...)
+	c := &renderClient{applyPatchClient: newApplyPatchClient(objects...)}
+	r := &HostedControlPlaneReconciler{
+		Client:          c,
+		Log:             ctrl.Log.WithName("render"),
+		ReleaseProvider: releaseProvider,
+	}
+
+	releaseImage, err := r.lookupReleaseImage(ctx, hcp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up release info: %w", err)
+	}
+	if err := validateReleaseVersion(hcp, releaseImage); err != nil {
+		return nil, err
+	}
+	infraStatus, err := r.ensureInfrastructure(ctx, hcp)
+	if err != nil {
+		return nil, err
+	}
+	baseDomain, err := clusterBaseDomain(r, ctx, hcp)
+	if err != nil {
+		return nil, err
+	}
+	placeholders, err := offlineInfrastructureStatus(hcp, baseDomain)
+	if err != nil {
+		return nil, err
+	}
+	infraStatus = withOfflineAddresses(infraStatus, placeholders)
+	if err := r.ensureControlPlane(ctx, hcp, infraStatus, releaseImage); err != nil {
+		return nil, err
+	}
+
+	var manifests []RenderedManifest
+	for _, applied := range c.applied {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(applied.gvk)
+		if err := c.Get(ctx, applied.key, obj); err != nil {
+			return nil, fmt.Errorf("failed to get %s %s: %w", applied.gvk.Kind, applied.key.Name, err)
+		}
+		// The resource version is set by the fake client
+		unstructured.RemoveNestedField(obj.Object, "metadata", "resourceVersion")
+		content, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s %s: %w", applied.gvk.Kind, applied.key.Name, err)
+		}
+		manifests = append(manifests, RenderedManifest{
+			Name:      fmt.Sprintf("%s-%s.yaml", applied.key.Name, strings.ToLower(applied.gvk.Kind)),
+			Content:   content,
+			Sensitive: sensitiveObject(obj),
+		})
+	}
+	return manifests, nil
+}
+
+// sensitiveObject returns whether an object is a secret or a configmap with a
+// secret manifest for the guest cluster.
+func sensitiveObject(obj *unstructured.Unstructured) bool {
+	switch obj.GetKind() {
+	case "Secret":
+		return true
+	case "ConfigMap":
+		data, _, _ := unstructured.NestedStringMap(obj.Object, "data")
+		for _, value := range data {
+			typeMeta := metav1.TypeMeta{}
+			if err := yaml.Unmarshal([]byte(value), &typeMeta); err == nil && typeMeta.Kind == "Secret" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// withOfflineAddresses fills in the endpoints of an infrastructure status that
+// are still being provisioned with placeholders. The ports that are known
+// before the endpoints are provisioned are kept.
+func withOfflineAddresses(status, placeholders InfrastructureStatus) InfrastructureStatus {
+	fill := func(address *string, port *int32, placeholderAddress string, placeholderPort int32) {
+		if len(*address) > 0 {
+			return
+		}
+		*address = placeholderAddress
+		if port != nil && *port == 0 {
+			*port = placeholderPort
+		}
+	}
+	fill(&status.APIAddress, &status.APIPort, placeholders.APIAddress, placeholders.APIPort)
+	fill(&status.PrivateAPIAddress, &status.PrivateAPIPort, placeholders.PrivateAPIAddress, placeholders.PrivateAPIPort)
+	fill(&status.OAuthAddress, &status.OAuthPort, placeholders.OAuthAddress, placeholders.OAuthPort)
+	fill(&status.KonnectivityAddress, &status.KonnectivityPort, placeholders.KonnectivityAddress, placeholders.KonnectivityPort)
+	fill(&status.VPNAddress, &status.VPNPort, placeholders.VPNAddress, placeholders.VPNPort)
+	fill(&status.IgnitionProviderAddress, &status.IgnitionProviderPort, placeholders.IgnitionProviderAddress, placeholders.IgnitionProviderPort)
+	fill(&status.OpenShiftAPIAddress, nil, placeholders.OpenShiftAPIAddress, 0)
+	fill(&status.OauthAPIServerAddress, nil, placeholders.OauthAPIServerAddress, 0)
+	return status
+}
+
+// offlineInfrastructureStatus returns placeholder endpoints for the services
+// the control plane operator publishes on the management cluster. The stable
+// DNS names of the control plane are used when it requests them.
+func offlineInfrastructureStatus(hcp *hyperv1.HostedControlPlane, baseDomain string) (InfrastructureStatus, error) {
+	openShiftAPIAddress, err := nthAddress(hcp.Spec.ServiceCIDR, 10)
+	if err != nil {
+		return InfrastructureStatus{}, err
+	}
+	oauthAPIServerAddress, err := nthAddress(hcp.Spec.ServiceCIDR, 11)
+	if err != nil {
+		return InfrastructureStatus{}, err
+	}
+	apiAddress, oauthAddress := "api."+baseDomain, "oauth."+baseDomain
+	if len(hcp.Spec.APIDNSName) > 0 {
+		apiAddress = hcp.Spec.APIDNSName
+	}
+	if len(hcp.Spec.OAuthDNSName) > 0 {
+		oauthAddress = hcp.Spec.OAuthDNSName
+	}
+	status := InfrastructureStatus{
+		APIAddress:              apiAddress,
+		APIPort:                 kubeAPIServerPublishedService.port,
+		PrivateAPIAddress:       apiAddress,
+		PrivateAPIPort:          kubeAPIServerPublishedService.port,
+		OAuthAddress:            oauthAddress,
+		OAuthPort:               oauthPublishedService.port,
+		OpenShiftAPIAddress:     openShiftAPIAddress,
+		OauthAPIServerAddress:   oauthAPIServerAddress,
+		IgnitionProviderAddress: "ignition." + baseDomain,
+		IgnitionProviderPort:    ignitionPublishedService.port,
+	}
+	if hcp.Spec.Tunnel.Type == hyperv1.KonnectivityTunnel {
+		status.KonnectivityAddress = "konnectivity." + baseDomain
+		status.KonnectivityPort = konnectivityPublishedService.port
+	} else {
+		status.VPNAddress = "vpn." + baseDomain
+		status.VPNPort = vpnPublishedService.port
+	}
+	return status, nil
+}
+
+// nthAddress returns the address at an offset from the start of a network.
+func nthAddress(cidr string, n int64) (string, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", fmt.Errorf("invalid service CIDR %q: %w", cidr, err)
+	}
+	address := new(big.Int).Add(new(big.Int).SetBytes(network.IP), big.NewInt(n)).Bytes()
+	ip := make(net.IP, len(network.IP))
+	copy(ip[len(ip)-len(address):], address)
+	if !network.Contains(ip) {
+		return "", fmt.Errorf("service CIDR %q is too small", cidr)
+	}
+	return ip.String(), nil
+}
+
+// applyPatchClient is the fake client with support for server side apply
+// patches, which the fake client does not handle. They create the object or
+// are merged into the existing object.
+type applyPatchClient struct {
+	client.Client
+}
+
+func newApplyPatchClient(objects ...client.Object) *applyPatchClient {
+	return &applyPatchClient{Client: fake.NewFakeClientWithScheme(hyperapi.Scheme, objects...)}
+}
+
+func (c *applyPatchClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if patch.Type() != types.ApplyPatchType {
+		return c.Client.Patch(ctx, obj, patch, opts...)
+	}
+	data, err := patch.Data(obj)
+	if err != nil {
+		return err
+	}
+	var existing client.Object
+	if _, unstructuredObj := obj.(*unstructured.Unstructured); unstructuredObj {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+		existing = u
+	} else {
+		typed, err := c.Scheme().New(obj.GetObjectKind().GroupVersionKind())
+		if err != nil {
+			return err
+		}
+		existing = typed.(client.Object)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		return c.Create(ctx, obj)
+	}
+	if err := json.Unmarshal(data, existing); err != nil {
+		return err
+	}
+	if err := c.Update(ctx, existing); err != nil {
+		return err
+	}
+	return c.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+}
+
+// Create stores unstructured objects of known kinds as typed objects, like an
+// API server does, so that they can be listed as either.
+func (c *applyPatchClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.asTyped(obj, func(typed client.Object) error {
+		return c.Client.Create(ctx, typed, opts...)
+	})
+}
+
+// Update stores unstructured objects of known kinds as typed objects.
+func (c *applyPatchClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.asTyped(obj, func(typed client.Object) error {
+		return c.Client.Update(ctx, typed, opts...)
+	})
+}
+
+func (c *applyPatchClient) asTyped(obj client.Object, f func(client.Object) error) error {
+	u, unstructuredObj := obj.(*unstructured.Unstructured)
+	if !unstructuredObj || !c.Scheme().Recognizes(u.GroupVersionKind()) {
+		return f(obj)
+	}
+	newObj, err := c.Scheme().New(u.GroupVersionKind())
+	if err != nil {
+		return err
+	}
+	typed := newObj.(client.Object)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, typed); err != nil {
+		return err
+	}
+	if err := f(typed); err != nil {
+		return err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(typed)
+	if err != nil {
+		return err
+	}
+	u.SetUnstructuredContent(content)
+	u.SetGroupVersionKind(typed.GetObjectKind().GroupVersionKind())
+	return nil
+}
+
+// renderClient records the objects the control plane operator applies while
+// rendering, in the order they are first applied.
+type renderClient struct {
+	*applyPatchClient
+	applied []renderedObject
+}
+
+type renderedObject struct {
+	gvk schema.GroupVersionKind
+	key client.ObjectKey
+}
+
+func (c *renderClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if err := c.applyPatchClient.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+	if patch.Type() != types.ApplyPatchType {
+		return nil
+	}
+	applied := renderedObject{gvk: obj.GetObjectKind().GroupVersionKind(), key: client.ObjectKeyFromObject(obj)}
+	for _, o := range c.applied {
+		if o == applied {
+			return nil
+		}
+	}
+	c.applied = append(c.applied, applied)
+	return nil
+}
@@ -0,0 +1,124 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+)
+
+func TestNthAddress(t *testing.T) {
+	address, err := nthAddress("172.30.0.0/16", 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "172.30.0.10", address)
+
+	address, err = nthAddress("fd02::/112", 11)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "fd02::b", address)
+
+	_, err = nthAddress("172.30.0.0/29", 10)
+	assert.Error(t, err)
+}
+
+func TestOfflineInfrastructureStatus(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{
+		Spec: hyperv1.HostedControlPlaneSpec{
+			ServiceCIDR: "172.30.0.0/16",
+			Tunnel:      hyperv1.TunnelSpec{Type: hyperv1.KonnectivityTunnel},
+		},
+	}
+	status, err := offlineInfrastructureStatus(hcp, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "api.example.com", status.APIAddress)
+	assert.Equal(t, "172.30.0.10", status.OpenShiftAPIAddress)
+	assert.Equal(t, "172.30.0.11", status.OauthAPIServerAddress)
+	assert.Equal(t, "konnectivity.example.com", status.KonnectivityAddress)
+	assert.Empty(t, status.VPNAddress)
+}
+
+func TestWithOfflineAddresses(t *testing.T) {
+	status := withOfflineAddresses(InfrastructureStatus{
+		APIAddress:       "a1b2.elb.example.com",
+		APIPort:          6443,
+		KonnectivityPort: 443,
+	}, InfrastructureStatus{
+		APIAddress:          "api.example.com",
+		APIPort:             6443,
+		KonnectivityAddress: "konnectivity.example.com",
+		KonnectivityPort:    8091,
+		OpenShiftAPIAddress: "172.30.0.10",
+	})
+	assert.Equal(t, "a1b2.elb.example.com", status.APIAddress, "published addresses should be kept")
+	assert.Equal(t, "konnectivity.example.com", status.KonnectivityAddress)
+	assert.Equal(t, int32(443), status.KonnectivityPort, "the port of a route should be kept")
+	assert.Equal(t, "172.30.0.10", status.OpenShiftAPIAddress)
+}
+
+func TestRenderControlPlane(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testNamespace},
+		Spec: hyperv1.HostedControlPlaneSpec{
+			ReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.7.0-x86_64",
+			PullSecret:   corev1.LocalObjectReference{Name: "pull-secret"},
+			SSHKey:       corev1.LocalObjectReference{Name: "ssh-key"},
+			ServiceCIDR:  "172.31.0.0/16",
+			PodCIDR:      "10.132.0.0/14",
+			DNS:          hyperv1.DNSSpec{BaseDomain: testBaseDomain},
+		},
+	}
+	provider := &releaseinfo.FileProvider{Path: filepath.Join("render", "testdata", "releases", "4.7.0.json")}
+	manifests, err := RenderControlPlane(context.Background(), hcp, provider, RenderOptions{
+		PullSecret: []byte(`{"auths":{}}`),
+		Platform:   configv1.AWSPlatformType,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rendered := map[string]RenderedManifest{}
+	for _, manifest := range manifests {
+		rendered[manifest.Name] = manifest
+	}
+	for name, sensitive := range map[string]bool{
+		testNamespace + "-user-data-secret.yaml":                  true,
+		testNamespace + "-kubeconfig-secret.yaml":                 true,
+		"kubeadmin-password-secret.yaml":                          true,
+		"user-manifest-kubeadmin-password-configmap.yaml":         true,
+		"user-manifest-pullsecret-configmap.yaml":                 true,
+		"pki-secret.yaml":                                         true,
+		kubeAPIServerServiceName + "-service.yaml":                false,
+		oauthServiceName + "-service.yaml":                        false,
+		machineConfigServerName + "-route.yaml":                   false,
+		"kube-apiserver-deployment.yaml":                          false,
+		"openshift-apiserver-service.yaml":                        false,
+		clusterVersionOperatorDeploymentName + "-deployment.yaml": false,
+	} {
+		manifest, ok := rendered[name]
+		if !ok {
+			t.Errorf("expected %s to be rendered", name)
+			continue
+		}
+		assert.Equal(t, sensitive, manifest.Sensitive, "sensitivity of %s", name)
+	}
+	userData := &corev1.Secret{}
+	if err := yaml.Unmarshal(rendered[testNamespace+"-user-data-secret.yaml"].Content, userData); err != nil {
+		t.Fatalf("failed to decode user data: %v", err)
+	}
+	assert.Contains(t, string(userData.Data["value"]), "ignition."+testNamespace+"."+testBaseDomain,
+		"workers should be pointed at the placeholder of the ignition provider")
+	assert.NotContains(t, string(rendered["kube-apiserver-deployment.yaml"].Content), "resourceVersion")
+	assert.Empty(t, hcp.Status.AppliedObjects, "the rendered control plane should not be changed")
+}
@@ -0,0 +1,49 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/openshiftapiserver"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+const openshiftAPIServerDeploymentName = "openshift-apiserver"
+
+// openshiftAPIServerObjects returns the objects of the openshift-apiserver of
+// a control plane.
+func openshiftAPIServerObjects(cc *componentContext) ([]client.Object, error) {
+	targetNamespace := cc.hcp.GetName()
+	config, err := openshiftapiserver.ConfigMap{
+		Namespace:        targetNamespace,
+		IngressSubdomain: cc.params.IngressSubdomain,
+		EtcdClientName:   cc.params.EtcdClientName,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	return []client.Object{
+		openshiftapiserver.Deployment{
+			Namespace:         targetNamespace,
+			Image:             cc.images["openshift-apiserver"],
+			ClusterID:         cc.params.ClusterID,
+			HighlyAvailable:   cc.params.APIAvailabilityPolicy == render.HighlyAvailable,
+			RestartDate:       cc.params.RestartDate,
+			PriorityClassName: cc.params.MasterPriorityClass,
+			Resources:         deploymentResources(cc.hcp.Spec.ControlPlaneResources, openshiftAPIServerDeploymentName),
+			Scheduling:        cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+		config,
+		openshiftapiserver.Secret{
+			Namespace:      targetNamespace,
+			Kubeconfig:     cc.pkiData["internal-admin.kubeconfig"],
+			ServerCert:     cc.pkiData["openshift-apiserver-server.crt"],
+			ServerKey:      cc.pkiData["openshift-apiserver-server.key"],
+			EtcdClientCert: cc.pkiData["etcd-client.crt"],
+			EtcdClientKey:  cc.pkiData["etcd-client.key"],
+		}.Build(),
+		openshiftapiserver.CAConfigMap{
+			Namespace: targetNamespace,
+			CA:        cc.pkiData["root-ca.crt"],
+		}.Build(),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestOpenshiftAPIServerObjects(t *testing.T) {
+	params := render.NewClusterParams()
+	params.APIAvailabilityPolicy = render.HighlyAvailable
+	params.IngressSubdomain = "apps.example.com"
+	params.EtcdClientName = "etcd-client"
+
+	objects, err := openshiftAPIServerObjects(&componentContext{
+		hcp: &hyperv1.HostedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		},
+		params: params,
+		images: map[string]string{"openshift-apiserver": "example.com/openshift-apiserver:latest"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, obj := range objects {
+		assert.NotEqual(t, "Service", obj.GetObjectKind().GroupVersionKind().Kind, "the service is created with the other services of the control plane")
+	}
+
+	deployment := componentObject(t, objects, "Deployment", "openshift-apiserver").(*appsv1.Deployment)
+	assert.Equal(t, int32(3), *deployment.Spec.Replicas, "the API server should follow the availability policy of the API")
+	assert.Equal(t, "example.com/openshift-apiserver:latest", deployment.Spec.Template.Spec.Containers[0].Image)
+
+	config := componentObject(t, objects, "ConfigMap", "openshift-apiserver-config").(*corev1.ConfigMap)
+	assert.Contains(t, config.Data["config.yaml"], "subdomain: apps.example.com")
+	assert.Contains(t, config.Data["config.yaml"], "- https://etcd-client:2379")
+}
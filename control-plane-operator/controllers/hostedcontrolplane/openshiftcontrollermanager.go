@@ -0,0 +1,56 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/openshiftcontrollermanager"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+const (
+	openshiftControllerManagerDeploymentName = "openshift-controller-manager"
+	clusterPolicyControllerDeploymentName    = "cluster-policy-controller"
+)
+
+// openshiftControllerManagerObjects returns the objects of the
+// openshift-controller-manager and cluster policy controller of a control
+// plane.
+func openshiftControllerManagerObjects(cc *componentContext) ([]client.Object, error) {
+	targetNamespace := cc.hcp.GetName()
+	config, err := openshiftcontrollermanager.ConfigMap{
+		Namespace:          targetNamespace,
+		DockerBuilderImage: cc.images["docker-builder"],
+		DeployerImage:      cc.images["deployer"],
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	deployment := openshiftcontrollermanager.Deployment{
+		Namespace:         targetNamespace,
+		Image:             cc.images["openshift-controller-manager"],
+		ClusterID:         cc.params.ClusterID,
+		HighlyAvailable:   cc.params.ControllerAvailabilityPolicy == render.HighlyAvailable,
+		RestartDate:       cc.params.RestartDate,
+		PriorityClassName: cc.params.MasterPriorityClass,
+		Resources:         deploymentResources(cc.hcp.Spec.ControlPlaneResources, openshiftControllerManagerDeploymentName),
+		Scheduling:        cc.hcp.Spec.ControlPlaneScheduling,
+	}
+	clusterPolicyController := openshiftcontrollermanager.ClusterPolicyControllerDeployment(deployment)
+	clusterPolicyController.Image = cc.images["cluster-policy-controller"]
+	clusterPolicyController.Resources = deploymentResources(cc.hcp.Spec.ControlPlaneResources, clusterPolicyControllerDeploymentName)
+	return []client.Object{
+		config,
+		openshiftcontrollermanager.CAConfigMap{
+			Namespace: targetNamespace,
+			CA:        cc.pkiData["root-ca.crt"],
+		}.Build(),
+		openshiftcontrollermanager.Secret{
+			Namespace:  targetNamespace,
+			Kubeconfig: cc.pkiData["internal-admin.kubeconfig"],
+			ServerCert: cc.pkiData["openshift-controller-manager-server.crt"],
+			ServerKey:  cc.pkiData["openshift-controller-manager-server.key"],
+		}.Build(),
+		deployment.Build(),
+		clusterPolicyController.Build(),
+	}, nil
+}
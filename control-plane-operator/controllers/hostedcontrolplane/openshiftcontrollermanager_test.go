@@ -0,0 +1,58 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestOpenshiftControllerManagerObjects(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: hyperv1.HostedControlPlaneSpec{
+			ControlPlaneResources: &hyperv1.ControlPlaneResources{
+				ClusterPolicyController: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+				},
+			},
+		},
+	}
+	images := map[string]string{
+		"openshift-controller-manager": "example.com/openshift-controller-manager:latest",
+		"cluster-policy-controller":    "example.com/cluster-policy-controller:latest",
+		"docker-builder":               "example.com/docker-builder:latest",
+		"deployer":                     "example.com/deployer:latest",
+	}
+
+	objects, err := openshiftControllerManagerObjects(&componentContext{
+		hcp:     hcp,
+		params:  render.NewClusterParams(),
+		images:  images,
+		pkiData: map[string][]byte{"root-ca.crt": []byte("ca")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config := componentObject(t, objects, "ConfigMap", "openshift-controller-manager-config").(*corev1.ConfigMap)
+	assert.Contains(t, config.Data["config.yaml"], "format: example.com/docker-builder:latest")
+	assert.Contains(t, config.Data["config.yaml"], "format: example.com/deployer:latest")
+
+	deployment := componentObject(t, objects, "Deployment", "openshift-controller-manager").(*appsv1.Deployment)
+	assert.Equal(t, "example.com/openshift-controller-manager:latest", deployment.Spec.Template.Spec.Containers[0].Image)
+	assert.Empty(t, deployment.Spec.Template.Spec.Containers[0].Resources.Requests)
+
+	clusterPolicyController := componentObject(t, objects, "Deployment", "cluster-policy-controller").(*appsv1.Deployment)
+	assert.Equal(t, "cluster-policy-controller", clusterPolicyController.Name)
+	container := clusterPolicyController.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "example.com/cluster-policy-controller:latest", container.Image)
+	assert.Equal(t, resource.MustParse("100m"), container.Resources.Requests[corev1.ResourceCPU])
+	assert.Equal(t, "openshift-controller-manager", clusterPolicyController.Spec.Template.Spec.Volumes[0].Secret.SecretName, "the cluster policy controller should share the secret of the openshift-controller-manager")
+}
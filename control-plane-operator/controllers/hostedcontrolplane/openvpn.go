@@ -0,0 +1,51 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/openvpn"
+)
+
+// openVPNObjects returns the objects of the VPN server of a control plane
+// namespace, when the guest cluster is reached through the VPN.
+func openVPNObjects(cc *componentContext) ([]client.Object, error) {
+	if cc.params.KonnectivityEnabled {
+		return nil, nil
+	}
+	targetNamespace := cc.hcp.GetName()
+	networks := openvpn.Networks{
+		ServiceNetworks: cc.params.ServiceNetworks,
+		ClusterNetworks: cc.params.ClusterNetworks,
+	}
+	ccd, err := openvpn.CCDConfigMap{
+		Namespace: targetNamespace,
+		Networks:  networks,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	config, err := openvpn.ServerConfigMap{
+		Namespace: targetNamespace,
+		Networks:  networks,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+	return []client.Object{
+		openvpn.ServiceAccount{
+			Namespace: targetNamespace,
+		}.Build(),
+		openvpn.ServerDeployment{
+			Namespace:   targetNamespace,
+			Image:       cc.images["openvpn"],
+			RestartDate: cc.params.RestartDate,
+			Scheduling:  cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+		ccd,
+		config,
+		openvpn.ServerSecret{
+			Namespace: targetNamespace,
+			PKI:       cc.pkiData,
+		}.Build(),
+	}, nil
+}
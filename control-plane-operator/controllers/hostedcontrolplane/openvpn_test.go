@@ -0,0 +1,42 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestOpenVPNObjects(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+	}
+	params := render.NewClusterParams()
+	params.ServiceNetworks = []string{"172.31.0.0/16"}
+	params.ClusterNetworks = []render.ClusterNetwork{{CIDR: "10.132.0.0/14", HostPrefix: 23}}
+	objects, err := openVPNObjects(&componentContext{hcp: hcp, params: params})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ccd := componentObject(t, objects, "ConfigMap", "openvpn-ccd").(*corev1.ConfigMap)
+	assert.Equal(t, "iroute 172.31.0.0 255.255.0.0\niroute 10.132.0.0 255.252.0.0", ccd.Data["worker"])
+	serverConfig := componentObject(t, objects, "ConfigMap", "openvpn-server").(*corev1.ConfigMap).Data["server.conf"]
+	assert.Contains(t, serverConfig, "route 10.132.0.0 255.252.0.0\nroute 172.31.0.0 255.255.0.0\n")
+	assert.Contains(t, serverConfig, "push \"route 172.31.0.0 255.255.0.0\"")
+
+	params.KonnectivityEnabled = true
+	objects, err = openVPNObjects(&componentContext{hcp: hcp, params: params})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Empty(t, objects, "the VPN server is not needed with konnectivity")
+
+	params.KonnectivityEnabled = false
+	params.ServiceNetworks = []string{"invalid"}
+	_, err = openVPNObjects(&componentContext{hcp: hcp, params: params})
+	assert.Error(t, err)
+}
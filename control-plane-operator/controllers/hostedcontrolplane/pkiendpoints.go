@@ -0,0 +1,71 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render/pki"
+)
+
+const pkiEndpointsAnnotation = "hypershift.openshift.io/pki-endpoints"
+
+// pkiEndpoints identifies the external addresses of the API and OAuth
+// servers that the kubeconfigs and certificates of the PKI were issued for.
+func pkiEndpoints(params *render.PKIParams) string {
+	return strings.Join([]string{params.ExternalAPIAddress, params.PrivateAPIAddress, params.ExternalOauthAddress}, ",")
+}
+
+// regenerateEndpointArtifactsIfNeeded reissues the kubeconfigs and
+// certificates of the pki secret that include the external addresses of the
+// API and OAuth servers when the addresses changed since they were issued,
+// for example when a stable DNS name is set on a control plane that is
+// ready. A pki secret that doesn't record its addresses is assumed to match
+// the current ones. It returns whether the artifacts were reissued.
+func (r *HostedControlPlaneReconciler) regenerateEndpointArtifactsIfNeeded(ctx context.Context, pkiSecret *corev1.Secret, params *render.PKIParams) (bool, error) {
+	endpoints := pkiEndpoints(params)
+	recorded, ok := pkiSecret.Annotations[pkiEndpointsAnnotation]
+	if recorded == endpoints {
+		return false, nil
+	}
+	if ok {
+		r.Log.Info("Regenerating the certificates and kubeconfigs of changed endpoints", "endpoints", endpoints)
+		if err := pki.RegenerateEndpointArtifacts(params, pkiSecret.Data); err != nil {
+			return false, fmt.Errorf("failed to regenerate endpoint certificates: %w", err)
+		}
+	}
+	if pkiSecret.Annotations == nil {
+		pkiSecret.Annotations = map[string]string{}
+	}
+	pkiSecret.Annotations[pkiEndpointsAnnotation] = endpoints
+	if err := r.applyPKISecret(ctx, pkiSecret); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// applyPKISecret applies the data of the pki secret along with the
+// annotations that record what it was generated for.
+func (r *HostedControlPlaneReconciler) applyPKISecret(ctx context.Context, pkiSecret *corev1.Secret) error {
+	desired := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   pkiSecret.Namespace,
+			Name:        pkiSecret.Name,
+			Annotations: map[string]string{},
+		},
+		Data: pkiSecret.Data,
+	}
+	for _, key := range []string{kubeconfigSignerRotationAnnotation, pkiEndpointsAnnotation} {
+		if value, ok := pkiSecret.Annotations[key]; ok {
+			desired.Annotations[key] = value
+		}
+	}
+	if _, err := applyObject(ctx, r, desired); err != nil {
+		return fmt.Errorf("failed to apply pki secret: %w", err)
+	}
+	return nil
+}
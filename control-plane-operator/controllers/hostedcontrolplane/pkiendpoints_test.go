@@ -0,0 +1,84 @@
+package hostedcontrolplane
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render/pki"
+)
+
+func TestRegenerateEndpointArtifactsIfNeeded(t *testing.T) {
+	params := &render.PKIParams{
+		ExternalAPIAddress:      "a1b2.elb.example.com",
+		NodeInternalAPIServerIP: "172.20.0.1",
+		ExternalAPIPort:         6443,
+		InternalAPIPort:         6443,
+		ServiceCIDR:             "172.31.0.0/16",
+		ExternalOauthAddress:    "c3d4.elb.example.com",
+		IngressSubdomain:        "apps.example.hypershift.local",
+		Namespace:               "example",
+	}
+	data, err := pki.GeneratePKI(params)
+	if err != nil {
+		t.Fatalf("failed to generate PKI: %v", err)
+	}
+	newAPIAddress := []byte("https://api.example.hypershift.local:6443")
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		regenerated bool
+	}{
+		{
+			name:        "addresses changed",
+			annotations: map[string]string{pkiEndpointsAnnotation: pkiEndpoints(params)},
+			regenerated: true,
+		},
+		{
+			name: "addresses not recorded",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			secretData := map[string][]byte{}
+			for k, v := range data {
+				secretData[k] = v
+			}
+			pkiSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "example", Name: "pki", Annotations: test.annotations},
+				Data:       secretData,
+			}
+			c := newApplyPatchClient(pkiSecret.DeepCopy())
+			r := &HostedControlPlaneReconciler{Client: c, Log: ctrl.Log.WithName("test")}
+			changed := *params
+			changed.ExternalAPIAddress = "api.example.hypershift.local"
+
+			regenerated, err := r.regenerateEndpointArtifactsIfNeeded(ctx, pkiSecret, &changed)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assert.Equal(t, test.regenerated, regenerated)
+			applied := &corev1.Secret{}
+			if err := c.Get(ctx, client.ObjectKeyFromObject(pkiSecret), applied); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assert.Equal(t, pkiEndpoints(&changed), applied.Annotations[pkiEndpointsAnnotation], "the addresses of the pki should be recorded")
+			assert.Equal(t, test.regenerated, bytes.Contains(applied.Data["admin.kubeconfig"], newAPIAddress))
+			assert.Equal(t, data["root-ca.crt"], applied.Data["root-ca.crt"], "the CAs should be kept")
+
+			regenerated, err = r.regenerateEndpointArtifactsIfNeeded(ctx, applied, &changed)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assert.False(t, regenerated, "the artifacts should only be reissued once")
+		})
+	}
+}
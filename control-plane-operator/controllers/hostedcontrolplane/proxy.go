@@ -0,0 +1,177 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+const (
+	// trustedCABundleKey is the key of the trusted CA bundle in the ConfigMap
+	// referenced by the proxy configuration, as in standalone clusters
+	trustedCABundleKey = "ca-bundle.crt"
+	// awsMetadataAddress is the instance metadata service of AWS, which
+	// workers must reach without a proxy
+	awsMetadataAddress = "169.254.169.254"
+)
+
+// proxyConfig is the proxy configuration of a HostedControlPlane with the
+// trusted CA bundle it references resolved.
+type proxyConfig struct {
+	spec            configv1.ProxySpec
+	trustedCABundle string
+}
+
+// proxyConfig reads the trusted CA bundle referenced by the proxy
+// configuration of a HostedControlPlane from the namespace of the
+// HostedCluster.
+func (r *HostedControlPlaneReconciler) proxyConfig(ctx context.Context, hcp *hyperv1.HostedControlPlane) (*proxyConfig, error) {
+	config := &proxyConfig{spec: hcp.Spec.Proxy}
+	name := config.spec.TrustedCA.Name
+	if len(name) == 0 {
+		return config, nil
+	}
+	sourceNamespace, err := hostedClusterNamespace(hcp)
+	if err != nil {
+		return nil, err
+	}
+	source := &corev1.ConfigMap{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: sourceNamespace, Name: name}, source); err != nil {
+		return nil, fmt.Errorf("failed to get proxy trusted CA configmap %s: %w", name, err)
+	}
+	bundle, ok := source.Data[trustedCABundleKey]
+	if !ok {
+		return nil, fmt.Errorf("proxy trusted CA configmap %s is missing the %s key", name, trustedCABundleKey)
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM([]byte(bundle)) {
+		return nil, fmt.Errorf("proxy trusted CA configmap %s has no valid PEM encoded certificates", name)
+	}
+	config.trustedCABundle = bundle
+	return config, nil
+}
+
+// setParams sets the proxy parameters of the control plane. It must be called
+// after the network parameters are set, since they never go through the
+// proxy.
+func (c *proxyConfig) setParams(params *render.ClusterParams) {
+	params.HTTPProxy = c.spec.HTTPProxy
+	params.HTTPSProxy = c.spec.HTTPSProxy
+	params.NoProxy = c.spec.NoProxy
+	params.TrustedCABundle = c.trustedCABundle
+	params.EffectiveNoProxy = ""
+	params.ControlPlaneNoProxy = ""
+	if len(c.spec.HTTPProxy) == 0 && len(c.spec.HTTPSProxy) == 0 {
+		return
+	}
+	params.EffectiveNoProxy = effectiveNoProxy(params)
+	// The control plane components also reach etcd and the aggregated API
+	// servers on the management cluster directly
+	params.ControlPlaneNoProxy = joinNoProxy(params.EffectiveNoProxy, params.EtcdClientName, params.OpenShiftAPIClusterIP, params.OauthAPIClusterIP)
+}
+
+// effectiveNoProxy returns the user's no proxy list with the addresses that
+// are always reached directly from within the guest cluster, the way the
+// network operator computes the status of the Proxy cluster configuration.
+func effectiveNoProxy(params *render.ClusterParams) string {
+	entries := []string{".cluster.local", ".svc", "127.0.0.1", "localhost", params.ExternalAPIAddress, params.MachineCIDR}
+	entries = append(entries, params.ServiceNetworks...)
+	for _, network := range params.ClusterNetworks {
+		entries = append(entries, network.CIDR)
+	}
+	if params.PlatformType == string(configv1.AWSPlatformType) {
+		entries = append(entries, awsMetadataAddress)
+	}
+	entries = append(entries, strings.Split(params.NoProxy, ",")...)
+	return joinNoProxy(entries...)
+}
+
+// joinNoProxy joins no proxy entries, skipping empty and duplicate ones.
+func joinNoProxy(entries ...string) string {
+	seen := map[string]bool{}
+	var result []string
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 || seen[entry] {
+			continue
+		}
+		seen[entry] = true
+		result = append(result, entry)
+	}
+	return strings.Join(result, ",")
+}
+
+// validateProxy makes sure the proxy URLs of a control plane are valid, since
+// components fail to start with an invalid proxy in their environment.
+func validateProxy(spec configv1.ProxySpec) error {
+	for _, proxy := range []string{spec.HTTPProxy, spec.HTTPSProxy} {
+		if len(proxy) == 0 {
+			continue
+		}
+		proxyURL, err := url.Parse(proxy)
+		if err != nil {
+			return fmt.Errorf("invalid proxy %q: %w", proxy, err)
+		}
+		if (proxyURL.Scheme != "http" && proxyURL.Scheme != "https") || len(proxyURL.Host) == 0 {
+			return fmt.Errorf("invalid proxy %q: must be an http or https URL", proxy)
+		}
+	}
+	if len(spec.NoProxy) > 0 && len(spec.HTTPProxy) == 0 && len(spec.HTTPSProxy) == 0 {
+		return fmt.Errorf("no proxy requires an http or https proxy")
+	}
+	return nil
+}
+
+type ignitionProxy struct {
+	HTTPProxy  string   `json:"httpProxy,omitempty"`
+	HTTPSProxy string   `json:"httpsProxy,omitempty"`
+	NoProxy    []string `json:"noProxy,omitempty"`
+}
+
+type ignitionSecurity struct {
+	TLS struct {
+		CertificateAuthorities []ignitionResource `json:"certificateAuthorities,omitempty"`
+	} `json:"tls"`
+}
+
+type ignitionResource struct {
+	Source string `json:"source"`
+}
+
+// ignitionProxyConfig returns the ignition 3.1 proxy and security sections
+// workers fetch their configuration with, so that they can reach the machine
+// config server through the proxy.
+func ignitionProxyConfig(proxy *proxyConfig) ([]byte, []byte, error) {
+	config := ignitionProxy{
+		HTTPProxy:  proxy.spec.HTTPProxy,
+		HTTPSProxy: proxy.spec.HTTPSProxy,
+	}
+	if len(proxy.spec.NoProxy) > 0 {
+		config.NoProxy = strings.Split(joinNoProxy(strings.Split(proxy.spec.NoProxy, ",")...), ",")
+	}
+	proxyJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal ignition proxy configuration: %w", err)
+	}
+	security := ignitionSecurity{}
+	if len(proxy.trustedCABundle) > 0 {
+		security.TLS.CertificateAuthorities = []ignitionResource{{
+			Source: "data:text/plain;charset=utf-8;base64," + base64.StdEncoding.EncodeToString([]byte(proxy.trustedCABundle)),
+		}}
+	}
+	securityJSON, err := json.Marshal(security)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal ignition security configuration: %w", err)
+	}
+	return proxyJSON, securityJSON, nil
+}
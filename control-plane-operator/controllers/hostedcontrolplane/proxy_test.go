@@ -0,0 +1,86 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/stretchr/testify/assert"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestValidateProxy(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  configv1.ProxySpec
+		valid bool
+	}{
+		{
+			name:  "no proxy",
+			valid: true,
+		},
+		{
+			name:  "http and https proxies",
+			spec:  configv1.ProxySpec{HTTPProxy: "http://proxy.example.com:3128", HTTPSProxy: "https://proxy.example.com", NoProxy: ".example.com"},
+			valid: true,
+		},
+		{
+			name: "proxy without scheme",
+			spec: configv1.ProxySpec{HTTPProxy: "proxy.example.com:3128"},
+		},
+		{
+			name: "no proxy without proxy",
+			spec: configv1.ProxySpec{NoProxy: ".example.com"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateProxy(test.spec)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProxyParams(t *testing.T) {
+	hcp := &hyperv1.HostedControlPlane{}
+	hcp.Spec.ServiceCIDR = "172.31.0.0/16"
+	hcp.Spec.PodCIDR = "10.132.0.0/14"
+	params := render.NewClusterParams()
+	setNetworkingParams(hcp, params)
+	params.ExternalAPIAddress = DefaultAPIServerIPAddress
+	params.PlatformType = string(configv1.AWSPlatformType)
+	params.EtcdClientName = "etcd-client"
+
+	config := &proxyConfig{}
+	config.setParams(params)
+	assert.Empty(t, params.EffectiveNoProxy, "no proxy should only be computed with a proxy")
+
+	config.spec = configv1.ProxySpec{HTTPSProxy: "http://proxy.example.com:3128", NoProxy: ".example.com,localhost"}
+	config.setParams(params)
+	assert.Equal(t, ".cluster.local,.svc,127.0.0.1,localhost,172.20.0.1,10.0.0.0/16,172.31.0.0/16,10.132.0.0/14,169.254.169.254,.example.com", params.EffectiveNoProxy)
+	assert.Equal(t, params.EffectiveNoProxy+",etcd-client", params.ControlPlaneNoProxy)
+}
+
+func TestIgnitionProxyConfig(t *testing.T) {
+	proxy, security, err := ignitionProxyConfig(&proxyConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, `{}`, string(proxy))
+	assert.Equal(t, `{"tls":{}}`, string(security))
+
+	proxy, security, err = ignitionProxyConfig(&proxyConfig{
+		spec:            configv1.ProxySpec{HTTPProxy: "http://proxy.example.com:3128", NoProxy: "a.example.com, b.example.com"},
+		trustedCABundle: "ca",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, `{"httpProxy":"http://proxy.example.com:3128","noProxy":["a.example.com","b.example.com"]}`, string(proxy))
+	assert.Equal(t, `{"tls":{"certificateAuthorities":[{"source":"data:text/plain;charset=utf-8;base64,Y2E="}]}}`, string(security))
+}
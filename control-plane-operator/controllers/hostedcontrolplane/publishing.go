@@ -0,0 +1,297 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	configv1 "github.com/openshift/api/config/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+// publishedService describes a control plane service that is reachable from
+// outside of the management cluster.
+type publishedService struct {
+	serviceType hyperv1.ServiceType
+	name        string
+	selector    map[string]string
+	port        int32
+	targetPort  int
+	// defaultStrategy is used when the HostedControlPlane has no mapping for
+	// the service.
+	defaultStrategy hyperv1.PublishingStrategyType
+	// routable is false for services that can't be published with a Route
+	routable bool
+	// passthrough routes pass TLS through to the service, others are plain HTTP
+	passthrough bool
+	// private services are only used by guest workers, which reach them over
+	// the private network unless endpoint access is Public
+	private bool
+}
+
+// internalLoadBalancerAnnotations are the service annotations that request a
+// load balancer that is only reachable from the private network, for each
+// management cluster platform that supports it.
+var internalLoadBalancerAnnotations = map[configv1.PlatformType]map[string]string{
+	configv1.AWSPlatformType:       {"service.beta.kubernetes.io/aws-load-balancer-internal": "true"},
+	configv1.AzurePlatformType:     {"service.beta.kubernetes.io/azure-load-balancer-internal": "true"},
+	configv1.GCPPlatformType:       {"networking.gke.io/load-balancer-type": "Internal"},
+	configv1.IBMCloudPlatformType:  {"service.kubernetes.io/ibm-load-balancer-cloud-provider-ip-type": "private"},
+	configv1.OpenStackPlatformType: {"service.beta.kubernetes.io/openstack-internal-load-balancer": "true"},
+}
+
+var (
+	kubeAPIServerPublishedService = publishedService{
+		serviceType:     hyperv1.APIServer,
+		name:            kubeAPIServerServiceName,
+		selector:        map[string]string{"app": "kube-apiserver"},
+		port:            APIServerPort,
+		targetPort:      APIServerPort,
+		defaultStrategy: hyperv1.LoadBalancer,
+	}
+	oauthPublishedService = publishedService{
+		serviceType:     hyperv1.OAuthServer,
+		name:            oauthServiceName,
+		selector:        map[string]string{"app": "oauth-openshift"},
+		port:            externalOauthPort,
+		targetPort:      6443,
+		defaultStrategy: hyperv1.LoadBalancer,
+		routable:        true,
+		passthrough:     true,
+	}
+	vpnPublishedService = publishedService{
+		serviceType:     hyperv1.VPN,
+		name:            vpnServiceName,
+		selector:        map[string]string{"app": "openvpn-server"},
+		port:            1194,
+		targetPort:      1194,
+		defaultStrategy: hyperv1.LoadBalancer,
+	}
+	konnectivityPublishedService = publishedService{
+		serviceType:     hyperv1.Konnectivity,
+		name:            konnectivityServerName,
+		selector:        map[string]string{"app": "kube-apiserver"},
+		port:            konnectivityAgentPort,
+		targetPort:      konnectivityAgentPort,
+		defaultStrategy: hyperv1.Route,
+		routable:        true,
+		passthrough:     true,
+		private:         true,
+	}
+	// ignitionPublishedService publishes the service of the machine config
+	// server, which serves the ignition configs of guest workers
+	ignitionPublishedService = publishedService{
+		serviceType:     hyperv1.Ignition,
+		name:            machineConfigServerName,
+		selector:        map[string]string{"app": machineConfigServerName},
+		port:            80,
+		targetPort:      8080,
+		defaultStrategy: hyperv1.Route,
+		routable:        true,
+		private:         true,
+	}
+	// kubeAPIServerPrivatePublishedService is the additional API server
+	// endpoint used by guest workers when endpoint access is PublicAndPrivate.
+	// It is always an internal load balancer.
+	kubeAPIServerPrivatePublishedService = publishedService{
+		serviceType:     hyperv1.APIServer,
+		name:            kubeAPIServerServiceName + "-private",
+		selector:        map[string]string{"app": "kube-apiserver"},
+		port:            APIServerPort,
+		targetPort:      APIServerPort,
+		defaultStrategy: hyperv1.LoadBalancer,
+		private:         true,
+	}
+)
+
+// servicePublishingStrategy returns the publishing strategy for a service,
+// falling back to the service default when the HostedControlPlane has no
+// mapping for it. Private services default to an internal load balancer
+// unless endpoint access is Public.
+func servicePublishingStrategy(hcp *hyperv1.HostedControlPlane, svc publishedService) hyperv1.ServicePublishingStrategy {
+	for _, mapping := range hcp.Spec.Services {
+		if mapping.Service == svc.serviceType {
+			return mapping.ServicePublishingStrategy
+		}
+	}
+	if svc.private && isPrivateEndpointAccess(hcp) {
+		return hyperv1.ServicePublishingStrategy{Type: hyperv1.LoadBalancer}
+	}
+	return hyperv1.ServicePublishingStrategy{Type: svc.defaultStrategy}
+}
+
+func isPrivateEndpointAccess(hcp *hyperv1.HostedControlPlane) bool {
+	return hcp.Spec.EndpointAccess == hyperv1.Private || hcp.Spec.EndpointAccess == hyperv1.PublicAndPrivate
+}
+
+// validateEndpointAccess makes sure the private endpoints of a control plane
+// can be published on the platform of the management cluster. Without an
+// internal load balancer for the platform, the endpoints would silently be
+// published on internet-facing load balancers. Workers reach the ignition
+// provider and the konnectivity server over the private network, so they
+// can't be published with a route on the shared router of the management
+// cluster.
+func validateEndpointAccess(hcp *hyperv1.HostedControlPlane, platform configv1.PlatformType) error {
+	if !isPrivateEndpointAccess(hcp) {
+		return nil
+	}
+	if _, ok := internalLoadBalancerAnnotations[platform]; !ok {
+		return fmt.Errorf("%s endpoint access is not supported on the %s platform of the management cluster", hcp.Spec.EndpointAccess, platform)
+	}
+	for _, svc := range []publishedService{ignitionPublishedService, konnectivityPublishedService} {
+		if servicePublishingStrategy(hcp, svc).Type == hyperv1.Route {
+			return fmt.Errorf("the %s service cannot be published with a route with %s endpoint access", svc.serviceType, hcp.Spec.EndpointAccess)
+		}
+	}
+	return nil
+}
+
+// internalLoadBalancer returns whether a load balancer for the service should
+// only be reachable from the private network.
+func internalLoadBalancer(hcp *hyperv1.HostedControlPlane, svc publishedService) bool {
+	switch hcp.Spec.EndpointAccess {
+	case hyperv1.Private:
+		return true
+	case hyperv1.PublicAndPrivate:
+		return svc.private
+	}
+	return false
+}
+
+// servicePublisher publishes the services of a control plane on the
+// management cluster.
+type servicePublisher struct {
+	client    client.Client
+	hcp       *hyperv1.HostedControlPlane
+	namespace string
+	// platform is the platform of the management cluster, which determines
+	// how internal load balancers are requested
+	platform configv1.PlatformType
+	// applied records the services and routes that were published, so they
+	// can be checked for drift with the rest of the control plane
+	applied []hyperv1.AppliedObject
+}
+
+// publish exposes a control plane service according to its publishing
+// strategy and returns the externally reachable host and port. The host is
+// empty while the endpoint is still being provisioned.
+func (p *servicePublisher) publish(ctx context.Context, svc publishedService, strategy hyperv1.ServicePublishingStrategy) (string, int32, error) {
+	service := &corev1.Service{}
+	service.Namespace = p.namespace
+	service.Name = svc.name
+	service.Spec.Selector = svc.selector
+	port := corev1.ServicePort{
+		Port:       svc.port,
+		Protocol:   corev1.ProtocolTCP,
+		TargetPort: intstr.FromInt(svc.targetPort),
+	}
+	switch strategy.Type {
+	case hyperv1.LoadBalancer:
+		service.Spec.Type = corev1.ServiceTypeLoadBalancer
+		if internalLoadBalancer(p.hcp, svc) {
+			annotations, ok := internalLoadBalancerAnnotations[p.platform]
+			if !ok {
+				return "", 0, fmt.Errorf("internal load balancers are not supported on the %s platform", p.platform)
+			}
+			service.Annotations = map[string]string{}
+			for k, v := range annotations {
+				service.Annotations[k] = v
+			}
+		}
+	case hyperv1.NodePort:
+		if strategy.NodePort == nil || len(strategy.NodePort.Address) == 0 {
+			return "", 0, fmt.Errorf("a node port address is required to publish the %s service", svc.serviceType)
+		}
+		service.Spec.Type = corev1.ServiceTypeNodePort
+		port.NodePort = strategy.NodePort.Port
+	case hyperv1.Route:
+		if !svc.routable {
+			return "", 0, fmt.Errorf("the %s service cannot be published with a route", svc.serviceType)
+		}
+		service.Spec.Type = corev1.ServiceTypeClusterIP
+	default:
+		return "", 0, fmt.Errorf("unsupported publishing strategy %q for the %s service", strategy.Type, svc.serviceType)
+	}
+	service.Spec.Ports = []corev1.ServicePort{port}
+	service.OwnerReferences = ensureHCPOwnerRef(p.hcp, service.OwnerReferences)
+	appliedService, err := applyObject(ctx, p.client, service)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to apply %s service: %w", svc.name, err)
+	}
+	p.applied = append(p.applied, appliedService)
+
+	if strategy.Type == hyperv1.Route {
+		route := &routev1.Route{}
+		route.Namespace = p.namespace
+		route.Name = svc.name
+		route.Spec.To = routev1.RouteTargetReference{
+			Kind: "Service",
+			Name: svc.name,
+		}
+		if svc.passthrough {
+			route.Spec.TLS = &routev1.TLSConfig{
+				Termination: routev1.TLSTerminationPassthrough,
+			}
+		}
+		route.OwnerReferences = ensureHCPOwnerRef(p.hcp, route.OwnerReferences)
+		appliedRoute, err := applyObject(ctx, p.client, route)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to apply %s route: %w", svc.name, err)
+		}
+		p.applied = append(p.applied, appliedRoute)
+	}
+	return p.address(ctx, svc, strategy)
+}
+
+// address returns the externally reachable host and port of a published
+// service, as currently reported by its load balancer, node port or route.
+// The host is empty while the endpoint is still being provisioned.
+func (p *servicePublisher) address(ctx context.Context, svc publishedService, strategy hyperv1.ServicePublishingStrategy) (string, int32, error) {
+	key := client.ObjectKey{Namespace: p.namespace, Name: svc.name}
+	switch strategy.Type {
+	case hyperv1.LoadBalancer:
+		host, err := getLoadBalancerServiceAddress(p.client, ctx, key)
+		if err != nil {
+			return "", 0, err
+		}
+		return host, svc.port, nil
+	case hyperv1.NodePort:
+		if strategy.NodePort == nil || len(strategy.NodePort.Address) == 0 {
+			return "", 0, fmt.Errorf("a node port address is required to publish the %s service", svc.serviceType)
+		}
+		service := &corev1.Service{}
+		if err := p.client.Get(ctx, key, service); err != nil {
+			return "", 0, fmt.Errorf("failed to get service: %w", err)
+		}
+		if len(service.Spec.Ports) == 0 || service.Spec.Ports[0].NodePort == 0 {
+			return "", 0, nil
+		}
+		return strategy.NodePort.Address, service.Spec.Ports[0].NodePort, nil
+	case hyperv1.Route:
+		host, err := getRouteAddress(p.client, ctx, key)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to get route address: %w", err)
+		}
+		if svc.passthrough {
+			return host, 443, nil
+		}
+		return host, 80, nil
+	default:
+		return "", 0, fmt.Errorf("unsupported publishing strategy %q for the %s service", strategy.Type, svc.serviceType)
+	}
+}
+
+// hostPort joins a host and port, leaving out ports that are implied by the
+// URL scheme.
+func hostPort(host string, port, defaultPort int32) string {
+	if port == defaultPort {
+		return host
+	}
+	return net.JoinHostPort(host, strconv.Itoa(int(port)))
+}
@@ -0,0 +1,239 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+func TestValidateEndpointAccess(t *testing.T) {
+	tests := []struct {
+		name           string
+		endpointAccess hyperv1.EndpointAccessType
+		platform       configv1.PlatformType
+		services       []hyperv1.ServicePublishingStrategyMapping
+		valid          bool
+	}{
+		{
+			name:           "public on any platform",
+			endpointAccess: hyperv1.Public,
+			platform:       configv1.BareMetalPlatformType,
+			valid:          true,
+		},
+		{
+			name:           "private on aws",
+			endpointAccess: hyperv1.Private,
+			platform:       configv1.AWSPlatformType,
+			valid:          true,
+		},
+		{
+			name:           "private on a platform without internal load balancers",
+			endpointAccess: hyperv1.PublicAndPrivate,
+			platform:       configv1.BareMetalPlatformType,
+		},
+		{
+			name:           "private with an ignition route",
+			endpointAccess: hyperv1.Private,
+			platform:       configv1.AzurePlatformType,
+			services: []hyperv1.ServicePublishingStrategyMapping{
+				{Service: hyperv1.Ignition, ServicePublishingStrategy: hyperv1.ServicePublishingStrategy{Type: hyperv1.Route}},
+			},
+		},
+		{
+			name:           "private with a konnectivity route",
+			endpointAccess: hyperv1.PublicAndPrivate,
+			platform:       configv1.AWSPlatformType,
+			services: []hyperv1.ServicePublishingStrategyMapping{
+				{Service: hyperv1.Konnectivity, ServicePublishingStrategy: hyperv1.ServicePublishingStrategy{Type: hyperv1.Route}},
+			},
+		},
+		{
+			name:           "public with an ignition route",
+			endpointAccess: hyperv1.Public,
+			platform:       configv1.AWSPlatformType,
+			services: []hyperv1.ServicePublishingStrategyMapping{
+				{Service: hyperv1.Ignition, ServicePublishingStrategy: hyperv1.ServicePublishingStrategy{Type: hyperv1.Route}},
+			},
+			valid: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hcp := &hyperv1.HostedControlPlane{}
+			hcp.Spec.EndpointAccess = test.endpointAccess
+			hcp.Spec.Services = test.services
+			err := validateEndpointAccess(hcp, test.platform)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPublishService(t *testing.T) {
+	const namespace = "master-example"
+	tests := []struct {
+		name                string
+		svc                 publishedService
+		strategy            hyperv1.ServicePublishingStrategy
+		endpointAccess      hyperv1.EndpointAccessType
+		platform            configv1.PlatformType
+		existing            []client.Object
+		expectedHost        string
+		expectedPort        int32
+		expectedAnnotations map[string]string
+		expectedTLS         *routev1.TLSConfig
+		expectedApplied     []string
+		valid               bool
+	}{
+		{
+			name:     "load balancer",
+			svc:      kubeAPIServerPublishedService,
+			strategy: hyperv1.ServicePublishingStrategy{Type: hyperv1.LoadBalancer},
+			existing: []client.Object{&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: kubeAPIServerServiceName},
+				Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{{Hostname: "a1b2.elb.example.com"}},
+				}},
+			}},
+			expectedHost:    "a1b2.elb.example.com",
+			expectedPort:    APIServerPort,
+			expectedApplied: []string{"Service/" + kubeAPIServerServiceName},
+			valid:           true,
+		},
+		{
+			name:            "load balancer still provisioning",
+			svc:             kubeAPIServerPublishedService,
+			strategy:        hyperv1.ServicePublishingStrategy{Type: hyperv1.LoadBalancer},
+			expectedPort:    APIServerPort,
+			expectedApplied: []string{"Service/" + kubeAPIServerServiceName},
+			valid:           true,
+		},
+		{
+			name:                "internal load balancer",
+			svc:                 kubeAPIServerPublishedService,
+			strategy:            hyperv1.ServicePublishingStrategy{Type: hyperv1.LoadBalancer},
+			endpointAccess:      hyperv1.Private,
+			platform:            configv1.AzurePlatformType,
+			expectedPort:        APIServerPort,
+			expectedAnnotations: map[string]string{"service.beta.kubernetes.io/azure-load-balancer-internal": "true"},
+			expectedApplied:     []string{"Service/" + kubeAPIServerServiceName},
+			valid:               true,
+		},
+		{
+			name:           "internal load balancer on an unsupported platform",
+			svc:            kubeAPIServerPublishedService,
+			strategy:       hyperv1.ServicePublishingStrategy{Type: hyperv1.LoadBalancer},
+			endpointAccess: hyperv1.Private,
+			platform:       configv1.BareMetalPlatformType,
+		},
+		{
+			name: "node port",
+			svc:  kubeAPIServerPublishedService,
+			strategy: hyperv1.ServicePublishingStrategy{
+				Type:     hyperv1.NodePort,
+				NodePort: &hyperv1.NodePortPublishingStrategy{Address: "10.0.0.10", Port: 30443},
+			},
+			expectedHost:    "10.0.0.10",
+			expectedPort:    30443,
+			expectedApplied: []string{"Service/" + kubeAPIServerServiceName},
+			valid:           true,
+		},
+		{
+			name: "node port without an address",
+			svc:  kubeAPIServerPublishedService,
+			strategy: hyperv1.ServicePublishingStrategy{
+				Type:     hyperv1.NodePort,
+				NodePort: &hyperv1.NodePortPublishingStrategy{Port: 30443},
+			},
+		},
+		{
+			name:     "passthrough route",
+			svc:      oauthPublishedService,
+			strategy: hyperv1.ServicePublishingStrategy{Type: hyperv1.Route},
+			existing: []client.Object{&routev1.Route{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: oauthServiceName},
+				Spec:       routev1.RouteSpec{Host: "oauth.apps.example.com"},
+			}},
+			expectedHost:    "oauth.apps.example.com",
+			expectedPort:    443,
+			expectedTLS:     &routev1.TLSConfig{Termination: routev1.TLSTerminationPassthrough},
+			expectedApplied: []string{"Service/" + oauthServiceName, "Route/" + oauthServiceName},
+			valid:           true,
+		},
+		{
+			name:     "http route",
+			svc:      ignitionPublishedService,
+			strategy: hyperv1.ServicePublishingStrategy{Type: hyperv1.Route},
+			existing: []client.Object{&routev1.Route{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: machineConfigServerName},
+				Spec:       routev1.RouteSpec{Host: "ignition.apps.example.com"},
+			}},
+			expectedHost:    "ignition.apps.example.com",
+			expectedPort:    80,
+			expectedApplied: []string{"Service/" + machineConfigServerName, "Route/" + machineConfigServerName},
+			valid:           true,
+		},
+		{
+			name:     "route for a service that is not routable",
+			svc:      kubeAPIServerPublishedService,
+			strategy: hyperv1.ServicePublishingStrategy{Type: hyperv1.Route},
+		},
+		{
+			name:     "unknown strategy",
+			svc:      kubeAPIServerPublishedService,
+			strategy: hyperv1.ServicePublishingStrategy{Type: "Magic"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hcp := &hyperv1.HostedControlPlane{}
+			hcp.Namespace = "master"
+			hcp.Name = "example"
+			hcp.Spec.EndpointAccess = test.endpointAccess
+			c := newApplyPatchClient(test.existing...)
+			publisher := &servicePublisher{client: c, hcp: hcp, namespace: namespace, platform: test.platform}
+			host, port, err := publisher.publish(context.Background(), test.svc, test.strategy)
+			if !test.valid {
+				assert.Error(t, err)
+				assert.Empty(t, publisher.applied)
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assert.Equal(t, test.expectedHost, host)
+			assert.Equal(t, test.expectedPort, port)
+
+			service := &corev1.Service{}
+			if err := c.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: test.svc.name}, service); err != nil {
+				t.Fatalf("failed to get service: %v", err)
+			}
+			assert.Equal(t, test.expectedAnnotations, service.Annotations)
+			if test.strategy.Type == hyperv1.Route {
+				route := &routev1.Route{}
+				if err := c.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: test.svc.name}, route); err != nil {
+					t.Fatalf("failed to get route: %v", err)
+				}
+				assert.Equal(t, test.expectedTLS, route.Spec.TLS)
+			}
+
+			var applied []string
+			for _, o := range publisher.applied {
+				assert.Equal(t, namespace, o.Namespace)
+				applied = append(applied, o.Kind+"/"+o.Name)
+			}
+			assert.Equal(t, test.expectedApplied, applied)
+		})
+	}
+}
@@ -3,10 +3,8 @@ package render
 import (
 	"bufio"
 	"bytes"
-	"crypto/rand"
 	"encoding/base64"
 	"fmt"
-	"net"
 	"strings"
 	"unicode"
 
@@ -32,46 +30,46 @@ func versionFunc(versions map[string]string) func(string) string {
 	}
 }
 
-func lessThanVersionFunc(versions map[string]string) func(string) bool {
+func lessThanVersionFunc(versions map[string]string) func(string) (bool, error) {
 	return versionCompareFunc(versions, func(a, e semver.Version) bool { return a.LT(e) })
 }
 
-func atLeastVersionFunc(versions map[string]string) func(string) bool {
+func atLeastVersionFunc(versions map[string]string) func(string) (bool, error) {
 	return versionCompareFunc(versions, func(a, e semver.Version) bool { return a.GTE(e) })
 }
 
-func versionCompareFunc(versions map[string]string, versionCompareFn func(actual, expected semver.Version) bool) func(string) bool {
+func versionCompareFunc(versions map[string]string, versionCompareFn func(actual, expected semver.Version) bool) func(string) (bool, error) {
 	releaseVersionStr := versions["release"]
-	releaseVersion, err := semver.Parse(releaseVersionStr)
-	if err != nil {
-		panic(fmt.Sprintf("failed to parse release version %s: %v", releaseVersionStr, err))
-	}
+	releaseVersion, releaseErr := semver.Parse(releaseVersionStr)
 	releaseVersion.Pre = nil
 	releaseVersion.Build = nil
-	return func(version string) bool {
+	return func(version string) (bool, error) {
+		if releaseErr != nil {
+			return false, fmt.Errorf("failed to parse release version %s: %w", releaseVersionStr, releaseErr)
+		}
 		parsedVersion, err := semver.Parse(version)
 		if err != nil {
-			panic(fmt.Sprintf("failed to parse version %s: %v", version, err))
+			return false, fmt.Errorf("failed to parse version %s: %w", version, err)
 		}
-		return versionCompareFn(releaseVersion, parsedVersion)
+		return versionCompareFn(releaseVersion, parsedVersion), nil
 	}
 }
 
-func pkiFunc(pki map[string][]byte) func(string) string {
-	return func(fileName string) string {
+func pkiFunc(pki map[string][]byte) func(string) (string, error) {
+	return func(fileName string) (string, error) {
 		b, found := pki[fileName]
 		if !found {
-			panic(fmt.Sprintf("pki file %s not found", fileName))
+			return "", fmt.Errorf("pki file %s not found", fileName)
 		}
-		return base64.StdEncoding.EncodeToString(b)
+		return base64.StdEncoding.EncodeToString(b), nil
 	}
 }
 
-func includePKIFunc(pki map[string][]byte) func(string, int) string {
-	return func(fileName string, indent int) string {
+func includePKIFunc(pki map[string][]byte) func(string, int) (string, error) {
+	return func(fileName string, indent int) (string, error) {
 		b, found := pki[fileName]
 		if !found {
-			panic(fmt.Sprintf("pki file %s not found", fileName))
+			return "", fmt.Errorf("pki file %s not found", fileName)
 		}
 		input := bytes.NewBuffer(b)
 		output := &bytes.Buffer{}
@@ -79,7 +77,7 @@ func includePKIFunc(pki map[string][]byte) func(string, int) string {
 		for scanner.Scan() {
 			fmt.Fprintf(output, "%s%s\n", strings.Repeat(" ", indent), scanner.Text())
 		}
-		return output.String()
+		return output.String(), nil
 	}
 }
 
@@ -89,13 +87,13 @@ func pullSecretBase64(data []byte) func() string {
 	}
 }
 
-func base64Func(params interface{}, rc *renderContext) func(string) string {
-	return func(fileName string) string {
+func base64Func(params interface{}, rc *renderContext) func(string) (string, error) {
+	return func(fileName string) (string, error) {
 		result, err := rc.substituteParams(params, fileName)
 		if err != nil {
-			panic(err.Error())
+			return "", err
 		}
-		return base64.StdEncoding.EncodeToString([]byte(result))
+		return base64.StdEncoding.EncodeToString([]byte(result)), nil
 	}
 }
 
@@ -111,62 +109,25 @@ func includeDataFunc() func(string, int) string {
 	}
 }
 
-func includeFileFunc(params interface{}, rc *renderContext) func(string, int) string {
-	return func(fileName string, indent int) string {
+func includeFileFunc(params interface{}, rc *renderContext) func(string, int) (string, error) {
+	return func(fileName string, indent int) (string, error) {
 		result, err := rc.substituteParams(params, fileName)
 		if err != nil {
-			panic(err.Error())
+			return "", err
 		}
 		includeFn := includeDataFunc()
-		return includeFn(string(result), indent)
+		return includeFn(string(result), indent), nil
 	}
 }
 
-func dataURLEncode(params interface{}, rc *renderContext) func(string) string {
-	return func(fileName string) string {
+func dataURLEncode(params interface{}, rc *renderContext) func(string) (string, error) {
+	return func(fileName string) (string, error) {
 		result, err := rc.substituteParams(params, fileName)
 		if err != nil {
-			panic(err.Error())
+			return "", err
 		}
-		return dataurl.EncodeBytes([]byte(result))
-	}
-}
-
-func cidrAddress(cidr string) string {
-	ip, _, err := net.ParseCIDR(cidr)
-	if err != nil {
-		panic(err.Error())
-	}
-	return ip.String()
-}
-
-func cidrMask(cidr string) string {
-	_, ipNet, err := net.ParseCIDR(cidr)
-	if err != nil {
-		panic(err.Error())
-	}
-	m := ipNet.Mask
-	if len(m) != 4 {
-		panic("Expecting a 4-byte mask")
-	}
-	return fmt.Sprintf("%d.%d.%d.%d", m[0], m[1], m[2], m[3])
-}
-
-// randomString uses RawURLEncoding to ensure we do not get / characters or trailing ='s
-func randomString(size int) string {
-	// each byte (8 bits) gives us 4/3 base64 (6 bits) characters
-	// we account for that conversion and add one to handle truncation
-	b64size := base64.RawURLEncoding.DecodedLen(size) + 1
-	// trim down to the original requested size since we added one above
-	return base64.RawURLEncoding.EncodeToString(randomBytes(b64size))[:size]
-}
-
-func randomBytes(size int) []byte {
-	b := make([]byte, size)
-	if _, err := rand.Read(b); err != nil {
-		panic(err) // rand should never fail
+		return dataurl.EncodeBytes([]byte(result)), nil
 	}
-	return b
 }
 
 func indent(spaces int, v string) string {
@@ -9,6 +9,7 @@ import (
 
 	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/assets"
 	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+	"openshift.io/hypershift/support/mirrors"
 )
 
 func RenderClusterManifests(params *ClusterParams, image *releaseinfo.ReleaseImage, pullSecret []byte, pki map[string][]byte) (map[string][]byte, error) {
@@ -16,8 +17,13 @@ func RenderClusterManifests(params *ClusterParams, image *releaseinfo.ReleaseIma
 	if err != nil {
 		return nil, err
 	}
-	ctx := newClusterManifestContext(image.ComponentImages(), componentVersions, params, pullSecret, pki)
-	ctx.setupManifests()
+	// The control plane pulls component images from their mirrors, while the
+	// guest cluster resolves them through its ImageContentSourcePolicy
+	images := mirrors.MirroredImages(image.ComponentImages(), params.ImageContentSources)
+	ctx := newClusterManifestContext(images, componentVersions, params, pullSecret, pki)
+	if err := ctx.setupManifests(); err != nil {
+		return nil, err
+	}
 	return ctx.renderManifests()
 }
 
@@ -37,12 +43,10 @@ func newClusterManifestContext(images, versions map[string]string, params interf
 		"imageFor":          imageFunc(images),
 		"base64String":      base64StringEncode,
 		"indent":            indent,
-		"address":           cidrAddress,
-		"mask":              cidrMask,
+		"isIPv6":            IsIPv6,
 		"include":           includeFileFunc(params, ctx.renderContext),
-		"includeVPN":        includeVPNFunc(true),
+		"includeVPN":        includeVPNFunc(!params.(*ClusterParams).KonnectivityEnabled),
 		"dataURLEncode":     dataURLEncode(params, ctx.renderContext),
-		"randomString":      randomString,
 		"includeData":       includeDataFunc(),
 		"trimTrailingSpace": trimTrailingSpace,
 		"pki":               pkiFunc(pki),
@@ -54,127 +58,38 @@ func newClusterManifestContext(images, versions map[string]string, params interf
 	return ctx
 }
 
-func (c *clusterManifestContext) setupManifests() {
-	c.hostedClusterConfigOperator()
-	c.serviceAdminKubeconfig()
-	c.etcd()
-	c.kubeAPIServer()
-	c.kubeControllerManager()
-	c.kubeScheduler()
-	c.clusterVersionOperator()
-	c.openshiftAPIServer()
-	c.oauthAPIServer()
+func (c *clusterManifestContext) setupManifests() error {
+	if err := c.openshiftAPIServer(); err != nil {
+		return err
+	}
+	if err := c.oauthAPIServer(); err != nil {
+		return err
+	}
 	c.openshiftControllerManager()
-	c.clusterBootstrap()
+	if err := c.clusterBootstrap(); err != nil {
+		return err
+	}
 	c.oauthOpenshiftServer()
-	c.openVPN()
+	if c.params.(*ClusterParams).KonnectivityEnabled {
+		c.konnectivity()
+	} else {
+		c.openVPN()
+	}
 	c.registry()
-	c.userManifestsBootstrapper()
-	c.routerProxy()
-	c.machineConfigServer()
-	c.ignitionConfigs()
-}
-
-func (c *clusterManifestContext) hostedClusterConfigOperator() {
-	c.addManifestFiles(
-		"hosted-cluster-config-operator/cp-operator-serviceaccount.yaml",
-		"hosted-cluster-config-operator/cp-operator-role.yaml",
-		"hosted-cluster-config-operator/cp-operator-rolebinding.yaml",
-		"hosted-cluster-config-operator/cp-operator-deployment.yaml",
-		"hosted-cluster-config-operator/cp-operator-configmap.yaml",
-	)
-}
-
-func (c *clusterManifestContext) serviceAdminKubeconfig() {
-	c.addManifestFiles(
-		"common/service-network-admin-kubeconfig-secret.yaml",
-	)
-}
-
-func (c *clusterManifestContext) etcd() {
-	c.addManifestFiles(
-		"etcd/etcd-cluster-crd.yaml",
-		"etcd/etcd-cluster.yaml",
-		"etcd/etcd-operator-cluster-role-binding.yaml",
-		"etcd/etcd-operator-cluster-role.yaml",
-		"etcd/etcd-operator-serviceaccount.yaml",
-		"etcd/etcd-operator.yaml",
-	)
-
-	for _, secret := range []string{"etcd-client", "server", "peer"} {
-		file := secret
-		if file != "etcd-client" {
-			file = "etcd-" + secret
-		}
-		params := map[string]string{
-			"secret": secret,
-			"file":   file,
-		}
-		content, err := c.substituteParams(params, "etcd/etcd-secret-template.yaml")
-		if err != nil {
-			panic(err.Error())
-		}
-		c.addManifest(file+"-tls-secret.yaml", content)
+	if err := c.userManifestsBootstrapper(); err != nil {
+		return err
 	}
+	c.machineConfigServer()
+	return c.ignitionConfigs()
 }
 
 func (c *clusterManifestContext) oauthOpenshiftServer() {
-	c.addManifestFiles(
-		"oauth-openshift/oauth-browser-client.yaml",
-		"oauth-openshift/oauth-challenging-client.yaml",
-		"oauth-openshift/oauth-server-config-configmap.yaml",
-		"oauth-openshift/oauth-server-deployment.yaml",
-		"oauth-openshift/oauth-server-service.yaml",
-		"oauth-openshift/v4-0-config-system-branding.yaml",
-		"oauth-openshift/oauth-server-sessionsecret-secret.yaml",
-		"oauth-openshift/oauth-server-secret.yaml",
-		"oauth-openshift/oauth-server-configmap.yaml",
-	)
 	c.addUserManifestFiles(
 		"oauth-openshift/ingress-certs-secret.yaml",
 	)
 }
 
-func (c *clusterManifestContext) kubeAPIServer() {
-	c.addManifestFiles(
-		"kube-apiserver/kube-apiserver-deployment.yaml",
-		"kube-apiserver/kube-apiserver-service.yaml",
-		"kube-apiserver/kube-apiserver-config-configmap.yaml",
-		"kube-apiserver/kube-apiserver-oauth-metadata-configmap.yaml",
-		"kube-apiserver/kube-apiserver-vpnclient-config.yaml",
-		"kube-apiserver/kube-apiserver-secret.yaml",
-		"kube-apiserver/kube-apiserver-configmap.yaml",
-		"kube-apiserver/kube-apiserver-vpnclient-secret.yaml",
-		"kube-apiserver/kube-apiserver-default-audit-policy.yaml",
-		"kube-apiserver/kube-apiserver-localhost-kubeconfig-secret.yaml",
-	)
-}
-
-func (c *clusterManifestContext) kubeControllerManager() {
-	c.addManifestFiles(
-		"kube-controller-manager/kube-controller-manager-deployment.yaml",
-		"kube-controller-manager/kube-controller-manager-config-configmap.yaml",
-		"kube-controller-manager/kube-controller-manager-secret.yaml",
-		"kube-controller-manager/kube-controller-manager-configmap.yaml",
-	)
-}
-
-func (c *clusterManifestContext) kubeScheduler() {
-	c.addManifestFiles(
-		"kube-scheduler/kube-scheduler-deployment.yaml",
-		"kube-scheduler/kube-scheduler-config-configmap.yaml",
-		"kube-scheduler/kube-scheduler-secret.yaml",
-	)
-}
-
-func (c *clusterManifestContext) openshiftAPIServer() {
-	c.addManifestFiles(
-		"openshift-apiserver/openshift-apiserver-deployment.yaml",
-		"openshift-apiserver/openshift-apiserver-service.yaml",
-		"openshift-apiserver/openshift-apiserver-config-configmap.yaml",
-		"openshift-apiserver/openshift-apiserver-secret.yaml",
-		"openshift-apiserver/openshift-apiserver-configmap.yaml",
-	)
+func (c *clusterManifestContext) openshiftAPIServer() error {
 	c.addUserManifestFiles(
 		"openshift-apiserver/openshift-apiserver-user-service.yaml",
 		"openshift-apiserver/openshift-apiserver-user-endpoint.yaml",
@@ -197,20 +112,15 @@ func (c *clusterManifestContext) openshiftAPIServer() {
 		}
 		entry, err := c.substituteParams(params, "openshift-apiserver/service-template.yaml")
 		if err != nil {
-			panic(err.Error())
+			return err
 		}
 		apiServices.Write(entry)
 	}
 	c.addUserManifest("openshift-apiserver-apiservices.yaml", apiServices.String())
+	return nil
 }
 
-func (c *clusterManifestContext) oauthAPIServer() {
-	c.addManifestFiles(
-		"oauth-apiserver/oauth-apiserver-deployment.yaml",
-		"oauth-apiserver/oauth-apiserver-auditpolicy.yaml",
-		"oauth-apiserver/oauth-apiserver-secret.yaml",
-		"oauth-apiserver/oauth-apiserver-configmap.yaml",
-	)
+func (c *clusterManifestContext) oauthAPIServer() error {
 	c.addUserManifestFiles(
 		"oauth-apiserver/oauth-apiserver-user-service.yaml",
 		"oauth-apiserver/oauth-apiserver-user-endpoint.yaml",
@@ -227,66 +137,55 @@ func (c *clusterManifestContext) oauthAPIServer() {
 		}
 		entry, err := c.substituteParams(params, "oauth-apiserver/service-template.yaml")
 		if err != nil {
-			panic(err.Error())
+			return err
 		}
 		apiServices.Write(entry)
 	}
 	c.addUserManifest("oauth-apiserver-apiservices.yaml", apiServices.String())
+	return nil
 }
 
 func (c *clusterManifestContext) openshiftControllerManager() {
-	c.addManifestFiles(
-		"openshift-controller-manager/openshift-controller-manager-deployment.yaml",
-		"openshift-controller-manager/openshift-controller-manager-config-configmap.yaml",
-		"openshift-controller-manager/cluster-policy-controller-deployment.yaml",
-		"openshift-controller-manager/openshift-controller-manager-secret.yaml",
-		"openshift-controller-manager/openshift-controller-manager-configmap.yaml",
-	)
 	c.addUserManifestFiles(
 		"openshift-controller-manager/00-openshift-controller-manager-namespace.yaml",
 		"openshift-controller-manager/openshift-controller-manager-service-ca.yaml",
 	)
 }
 
-func (c *clusterManifestContext) clusterVersionOperator() {
-	c.addManifestFiles(
-		"cluster-version-operator/cluster-version-operator-deployment.yaml",
-	)
-}
-
 func (c *clusterManifestContext) registry() {
 	c.addUserManifestFiles("registry/cluster-imageregistry-config.yaml")
 }
 
-func (c *clusterManifestContext) clusterBootstrap() {
+func (c *clusterManifestContext) clusterBootstrap() error {
 	manifests, err := assets.AssetDir("cluster-bootstrap")
 	if err != nil {
-		panic(err)
+		return err
 	}
 	for _, m := range manifests {
+		// The network operator configuration is only needed to set the MTU,
+		// the network operator generates it otherwise
+		if m == "cluster-network-03-config.yaml" && c.params.(*ClusterParams).NetworkMTU == 0 {
+			continue
+		}
+		if m == "user-ca-bundle-configmap.yaml" && len(c.params.(*ClusterParams).TrustedCABundle) == 0 {
+			continue
+		}
+		if m == "image-content-source-policy.yaml" && len(c.params.(*ClusterParams).ImageContentSources) == 0 {
+			continue
+		}
 		c.addUserManifestFiles("cluster-bootstrap/" + m)
 	}
+	return nil
 }
 
 func (c *clusterManifestContext) machineConfigServer() {
 	c.addManifestFiles(
 		"machine-config-server/machine-config-server-configmap.yaml",
-		"machine-config-server/machine-config-server-serviceaccount.yaml",
-		"machine-config-server/machine-config-server-rolebinding.yaml",
-		"machine-config-server/machine-config-server-deployment.yaml",
-		"machine-config-server/machine-config-server-service.yaml",
-		"machine-config-server/machine-config-server-secret.yaml",
-		"machine-config-server/machine-config-server-kubeconfig-secret.yaml",
 	)
 }
 
 func (c *clusterManifestContext) openVPN() {
 	c.addManifestFiles(
-		"openvpn/openvpn-serviceaccount.yaml",
-		"openvpn/openvpn-server-deployment.yaml",
-		"openvpn/openvpn-ccd-configmap.yaml",
-		"openvpn/openvpn-server-configmap.yaml",
-		"openvpn/openvpn-server-secret.yaml",
 		"openvpn/openvpn-client-secret.yaml",
 	)
 	c.addUserManifestFiles(
@@ -295,14 +194,12 @@ func (c *clusterManifestContext) openVPN() {
 	)
 }
 
-func (c *clusterManifestContext) routerProxy() {
+func (c *clusterManifestContext) konnectivity() {
 	c.addManifestFiles(
-		"router-proxy/router-proxy-deployment.yaml",
-		"router-proxy/router-proxy-configmap.yaml",
-		"router-proxy/router-proxy-vpnclient-configmap.yaml",
-		"router-proxy/router-proxy-http-service.yaml",
-		"router-proxy/router-proxy-https-service.yaml",
-		"router-proxy/router-proxy-vpnclient-secret.yaml",
+		"konnectivity/konnectivity-worker-agent-secret.yaml",
+	)
+	c.addUserManifestFiles(
+		"konnectivity/konnectivity-worker-agent-deployment.yaml",
 	)
 }
 
@@ -320,7 +217,7 @@ func (c *clusterManifestContext) roksMetrics() {
 	)
 }
 
-func (c *clusterManifestContext) userManifestsBootstrapper() {
+func (c *clusterManifestContext) userManifestsBootstrapper() error {
 	c.addManifestFiles(
 		"user-manifests-bootstrapper/user-manifests-bootstrapper-serviceaccount.yaml",
 		"user-manifests-bootstrapper/user-manifests-bootstrapper-rolebinding.yaml",
@@ -329,7 +226,7 @@ func (c *clusterManifestContext) userManifestsBootstrapper() {
 	for _, file := range c.userManifestFiles {
 		data, err := c.substituteParams(c.params, file)
 		if err != nil {
-			panic(err.Error())
+			return err
 		}
 		name := path.Base(file)
 		params := map[string]string{
@@ -338,7 +235,7 @@ func (c *clusterManifestContext) userManifestsBootstrapper() {
 		}
 		manifest, err := c.substituteParams(params, "user-manifests-bootstrapper/user-manifest-template.yaml")
 		if err != nil {
-			panic(err.Error())
+			return err
 		}
 		c.addManifest("user-manifest-"+name, manifest)
 	}
@@ -350,10 +247,11 @@ func (c *clusterManifestContext) userManifestsBootstrapper() {
 		}
 		manifest, err := c.substituteParams(params, "user-manifests-bootstrapper/user-manifest-template.yaml")
 		if err != nil {
-			panic(err.Error())
+			return err
 		}
 		c.addManifest("user-manifest-"+name, manifest)
 	}
+	return nil
 }
 
 const ignitionConfigTemplate = `apiVersion: v1
@@ -367,15 +265,15 @@ data:
 {{ indent 4 .content }}
 `
 
-func (c *clusterManifestContext) ignitionConfigs() {
+func (c *clusterManifestContext) ignitionConfigs() error {
 	manifests, err := assets.AssetDir("ignition-configs")
 	if err != nil {
-		panic(err)
+		return err
 	}
 	for _, m := range manifests {
 		content, err := c.substituteParams(c.params, "ignition-configs/"+m)
 		if err != nil {
-			panic(err)
+			return err
 		}
 		name := fmt.Sprintf("ignition-config-%s", strings.TrimSuffix(m, ".yaml"))
 		params := map[string]string{
@@ -384,10 +282,11 @@ func (c *clusterManifestContext) ignitionConfigs() {
 		}
 		cm, err := c.substituteParamsInBytes(params, []byte(ignitionConfigTemplate))
 		if err != nil {
-			panic(err)
+			return err
 		}
 		c.addManifest(name+".yaml", cm)
 	}
+	return nil
 }
 
 func (c *clusterManifestContext) addUserManifestFiles(name ...string) {
@@ -0,0 +1,234 @@
+package render_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render/pki"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+	"openshift.io/hypershift/support/certs"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata/golden")
+
+// releases are the versions of the release fixtures in testdata/releases
+var releases = []string{"4.5.0", "4.6.0", "4.7.0"}
+
+const (
+	namespace  = "clusters-example"
+	baseDomain = "example.hypershift.local"
+)
+
+// TestRenderClusterManifests renders the manifests of a cluster for each
+// release fixture and compares them with the golden files of the release.
+// Run it with -update to regenerate the golden files after changing a
+// template.
+func TestRenderClusterManifests(t *testing.T) {
+	pkiData, err := pki.GeneratePKIWithSource(pkiParams(), fixtureSource(t))
+	if err != nil {
+		t.Fatalf("failed to generate PKI: %v", err)
+	}
+	for _, version := range releases {
+		t.Run(version, func(t *testing.T) {
+			provider := &releaseinfo.FileProvider{Path: filepath.Join("testdata", "releases", version+".json")}
+			image, err := provider.Lookup(context.Background(), "")
+			if err != nil {
+				t.Fatalf("failed to read release fixture: %v", err)
+			}
+			params := clusterParams(version, pkiData)
+			manifests, err := render.RenderClusterManifests(params, releaseinfo.WithStaticComponentImages(image), []byte(`{"auths":{}}`), pkiData)
+			if err != nil {
+				t.Fatalf("failed to render manifests: %v", err)
+			}
+			checkGoldenFiles(t, filepath.Join("testdata", "golden", version), manifests)
+		})
+	}
+}
+
+// TestRenderKonnectivityManifests renders the manifests of a cluster that
+// uses the Konnectivity tunnel, which replace the OpenVPN and router proxy
+// manifests, and compares them with their golden files.
+func TestRenderKonnectivityManifests(t *testing.T) {
+	const version = "4.7.0"
+	pkiData, err := pki.GeneratePKIWithSource(pkiParams(), fixtureSource(t))
+	if err != nil {
+		t.Fatalf("failed to generate PKI: %v", err)
+	}
+	provider := &releaseinfo.FileProvider{Path: filepath.Join("testdata", "releases", version+".json")}
+	image, err := provider.Lookup(context.Background(), "")
+	if err != nil {
+		t.Fatalf("failed to read release fixture: %v", err)
+	}
+	params := clusterParams(version, pkiData)
+	params.KonnectivityEnabled = true
+	params.ExternalKonnectivityAddress = "konnectivity." + baseDomain
+	params.ExternalKonnectivityPort = 443
+	params.GuestIngressLoadBalancer = true
+	params.HostedClusterConfigOperatorControllers = []string{"controller-manager-ca", "cluster-operator", "auto-approver", "kubeadmin-password", "cluster-version", "kubelet-serving-ca", "openshift-apiserver-monitor", "infrastatus", "node", "ingress-status"}
+	manifests, err := render.RenderClusterManifests(params, releaseinfo.WithStaticComponentImages(image), []byte(`{"auths":{}}`), pkiData)
+	if err != nil {
+		t.Fatalf("failed to render manifests: %v", err)
+	}
+	checkGoldenFiles(t, filepath.Join("testdata", "golden", version+"-konnectivity"), manifests)
+}
+
+// checkGoldenFiles compares rendered manifests with the golden files in a
+// directory, or replaces the golden files with them when run with -update.
+// fixtureSource returns the source of the PKI of the golden files, which
+// hands out the fixture keys of testdata/keys.pem
+func fixtureSource(t *testing.T) *certs.Source {
+	source, err := certs.NewFixtureSource(filepath.Join("testdata", "keys.pem"), 1, time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("failed to read fixture keys: %v", err)
+	}
+	return source
+}
+
+func checkGoldenFiles(t *testing.T, goldenDir string, manifests map[string][]byte) {
+	if *update {
+		if err := writeGoldenFiles(goldenDir, manifests); err != nil {
+			t.Fatalf("failed to update golden files: %v", err)
+		}
+		return
+	}
+	golden, err := readGoldenFiles(goldenDir)
+	if err != nil {
+		t.Fatalf("failed to read golden files, run the test with -update to create them: %v", err)
+	}
+	for _, name := range sortedNames(golden) {
+		manifest, rendered := manifests[name]
+		if !rendered {
+			t.Errorf("%s was not rendered", name)
+			continue
+		}
+		if line, differs := firstDifference(golden[name], manifest); differs {
+			t.Errorf("%s differs from its golden file at line %d", name, line)
+		}
+	}
+	for _, name := range sortedNames(manifests) {
+		if _, exists := golden[name]; !exists {
+			t.Errorf("%s has no golden file", name)
+		}
+	}
+}
+
+func pkiParams() *render.PKIParams {
+	return &render.PKIParams{
+		ExternalAPIAddress:          "api." + baseDomain,
+		NodeInternalAPIServerIP:     "172.20.0.1",
+		ExternalAPIPort:             6443,
+		PrivateAPIAddress:           "api." + baseDomain,
+		PrivateAPIPort:              6443,
+		InternalAPIPort:             6443,
+		ServiceCIDR:                 "172.31.0.0/16",
+		ExternalOauthAddress:        "oauth." + baseDomain,
+		IngressSubdomain:            "apps." + baseDomain,
+		MachineConfigServerAddress:  "ignition." + baseDomain,
+		ExternalOpenVPNAddress:      "vpn." + baseDomain,
+		ExternalKonnectivityAddress: "konnectivity." + baseDomain,
+		Namespace:                   namespace,
+	}
+}
+
+func clusterParams(version string, pkiData map[string][]byte) *render.ClusterParams {
+	params := render.NewClusterParams()
+	params.Namespace = namespace
+	params.ExternalAPIDNSName = "api." + baseDomain
+	params.ExternalAPIPort = 6443
+	params.PrivateAPIDNSName = "api." + baseDomain
+	params.PrivateAPIPort = 6443
+	params.ExternalAPIAddress = "172.20.0.1"
+	params.ExternalOpenVPNAddress = "vpn." + baseDomain
+	params.ExternalOpenVPNPort = 1194
+	params.ExternalOauthDNSName = "oauth." + baseDomain
+	params.ExternalOauthPort = 443
+	params.ReleaseImage = "quay.io/openshift-release-dev/ocp-release:" + version + "-x86_64"
+	params.ReleaseImagePullSpec = params.ReleaseImage
+	params.IngressSubdomain = "apps." + baseDomain
+	params.OpenShiftAPIClusterIP = "172.31.0.10"
+	params.OauthAPIClusterIP = "172.31.0.11"
+	params.BaseDomain = baseDomain
+	params.MachineConfigServerAddress = "ignition." + baseDomain
+	params.CloudProvider = "AWS"
+	params.PlatformType = "AWS"
+	params.InternalAPIPort = 6443
+	params.EtcdClientName = "etcd-client"
+	params.ServiceCIDR = "172.31.0.0/16"
+	params.PodCIDR = "10.132.0.0/14"
+	params.ServiceNetworks = []string{params.ServiceCIDR}
+	params.ClusterNetworks = []render.ClusterNetwork{{CIDR: params.PodCIDR, HostPrefix: params.HostPrefix}}
+	params.ImageRegistryHTTPSecret = "image-registry-http-secret"
+	params.APIAvailabilityPolicy = render.SingleReplica
+	params.ControllerAvailabilityPolicy = render.SingleReplica
+	params.SSHKey = "ssh-rsa AAAA example"
+	params.HypershiftOperatorControllers = []string{"route-sync", "auto-approver", "kubeadmin-password", "node"}
+	params.HostedClusterConfigOperatorControllers = []string{"controller-manager-ca", "cluster-operator", "auto-approver", "kubeadmin-password", "cluster-version", "kubelet-serving-ca", "openshift-apiserver-monitor", "infrastatus", "node", "route-sync"}
+	params.OpenshiftAPIServerCABundle = base64.StdEncoding.EncodeToString(pkiData["combined-ca.crt"])
+	params.OauthAPIServerCABundle = params.OpenshiftAPIServerCABundle
+	return params
+}
+
+func writeGoldenFiles(dir string, manifests map[string][]byte) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for name, manifest := range manifests {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), manifest, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readGoldenFiles(dir string) (map[string][]byte, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	golden := map[string][]byte{}
+	for _, file := range files {
+		content, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		golden[file.Name()] = content
+	}
+	return golden, nil
+}
+
+func sortedNames(manifests map[string][]byte) []string {
+	names := make([]string, 0, len(manifests))
+	for name := range manifests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// firstDifference returns the first line at which two manifests differ.
+func firstDifference(expected, actual []byte) (int, bool) {
+	if bytes.Equal(expected, actual) {
+		return 0, false
+	}
+	expectedLines := strings.Split(string(expected), "\n")
+	actualLines := strings.Split(string(actual), "\n")
+	for i := range expectedLines {
+		if i >= len(actualLines) || expectedLines[i] != actualLines[i] {
+			return i + 1, true
+		}
+	}
+	return len(expectedLines) + 1, true
+}
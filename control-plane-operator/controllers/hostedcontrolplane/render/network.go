@@ -0,0 +1,60 @@
+package render
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ClusterNetwork is a pod network and the prefix length of the subnet of it
+// that is assigned to each node.
+type ClusterNetwork struct {
+	CIDR       string `json:"cidr"`
+	HostPrefix int32  `json:"hostPrefix"`
+	IPv6       bool   `json:"ipv6"`
+}
+
+// SplitCIDRs splits a comma separated list of networks, the way dual-stack
+// service and pod networks are specified.
+func SplitCIDRs(cidrs string) []string {
+	var result []string
+	for _, cidr := range strings.Split(cidrs, ",") {
+		if cidr = strings.TrimSpace(cidr); len(cidr) > 0 {
+			result = append(result, cidr)
+		}
+	}
+	return result
+}
+
+// IsIPv6 returns whether an address or network is an IPv6 address or network.
+func IsIPv6(address string) bool {
+	if ip, _, err := net.ParseCIDR(address); err == nil {
+		return ip.To4() == nil
+	}
+	ip := net.ParseIP(address)
+	return ip != nil && ip.To4() == nil
+}
+
+// CIDRAddress returns the address of a network.
+func CIDRAddress(cidr string) (string, error) {
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", err
+	}
+	return ip.String(), nil
+}
+
+// CIDRMask returns the mask of an IPv4 network in dotted notation, and the
+// prefix length of an IPv6 network.
+func CIDRMask(cidr string) (string, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", err
+	}
+	m := ipNet.Mask
+	if len(m) != 4 {
+		ones, _ := m.Size()
+		return fmt.Sprintf("%d", ones), nil
+	}
+	return fmt.Sprintf("%d.%d.%d.%d", m[0], m[1], m[2], m[3]), nil
+}
@@ -8,31 +8,90 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+	"openshift.io/hypershift/support/certs"
 )
 
 func GeneratePKI(params *render.PKIParams) (map[string][]byte, error) {
+	return GeneratePKIWithSource(params, certs.DefaultSource)
+}
+
+// GeneratePKIWithSource generates the PKI artifacts of a control plane from
+// the given source. A fixture source generates the same artifacts for the same
+// parameters.
+func GeneratePKIWithSource(params *render.PKIParams, source *certs.Source) (map[string][]byte, error) {
 	log.Info("Generating PKI artifacts")
 
+	cas, kubeconfigs, certSpecs, err := pkiSpecs(params)
+	if err != nil {
+		return nil, err
+	}
+	caMap, err := generateCAs(source, cas)
+	if err != nil {
+		return nil, err
+	}
+	kubeconfigMap, err := generateKubeconfigs(source, kubeconfigs, caMap)
+	if err != nil {
+		return nil, err
+	}
+	certMap, err := generateCerts(source, certSpecs, caMap)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string][]byte{}
+
+	serializeCAs(caMap, result)
+	if err := serializeKubeconfigs(kubeconfigMap, result); err != nil {
+		return nil, err
+	}
+	serializeCerts(certMap, result)
+
+	// Miscellaneous PKI artifacts
+	if err := serializeCombinedCA([]string{"root-ca", "cluster-signer"}, caMap, "combined-ca.crt", result); err != nil {
+		return nil, err
+	}
+	if err := serializeCombinedCA([]string{"root-ca", "cluster-signer", "kubeconfig-signer"}, caMap, "client-ca.crt", result); err != nil {
+		return nil, err
+	}
+	if err := serializeRSAKey(source, "service-account", result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// pkiSpecs returns the CAs, kubeconfigs and certificates of a control plane.
+func pkiSpecs(params *render.PKIParams) ([]caSpec, []kubeconfigSpec, []certSpec, error) {
 	cas := []caSpec{
 		ca("root-ca", "root-ca", "openshift"),
 		ca("cluster-signer", "cluster-signer", "openshift"),
 		ca("openvpn-ca", "openvpn-ca", "openshift"),
+		ca("konnectivity-ca", "konnectivity-ca", "openshift"),
+		ca("kubeconfig-signer", "kubeconfig-signer", "openshift"),
 	}
 
 	externalAPIServerAddress := fmt.Sprintf("https://%s:%d", params.ExternalAPIAddress, params.ExternalAPIPort)
 	internalAPIServerAddress := fmt.Sprintf("https://kube-apiserver:%d", params.InternalAPIPort)
+	privateAPIServerAddress := externalAPIServerAddress
+	if len(params.PrivateAPIAddress) > 0 {
+		privateAPIServerAddress = fmt.Sprintf("https://%s:%d", params.PrivateAPIAddress, params.PrivateAPIPort)
+	}
 	kubeconfigs := []kubeconfigSpec{
 		kubeconfig("admin", externalAPIServerAddress, "root-ca", "system:admin", "system:masters"),
 		kubeconfig("internal-admin", internalAPIServerAddress, "root-ca", "system:admin", "system:masters"),
 		kubeconfig("localhost-admin", "https://localhost:6443", "root-ca", "system:admin", "system:masters"),
-		kubeconfig("kubelet-bootstrap", externalAPIServerAddress, "cluster-signer", "system:bootstrapper", "system:bootstrappers"),
+		kubeconfig("kubelet-bootstrap", privateAPIServerAddress, "cluster-signer", "system:bootstrapper", "system:bootstrappers"),
 	}
 
-	_, serviceIPNet, err := net.ParseCIDR(params.ServiceCIDR)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse service CIDR: %q", params.ServiceCIDR)
+	// The kubernetes service has an IP in every service network of a
+	// dual-stack cluster
+	var kubeIPs []string
+	for _, serviceCIDR := range render.SplitCIDRs(params.ServiceCIDR) {
+		_, serviceIPNet, err := net.ParseCIDR(serviceCIDR)
+		if err != nil {
+			return nil, nil, nil, errors.Wrapf(err, "failed to parse service CIDR: %q", serviceCIDR)
+		}
+		kubeIPs = append(kubeIPs, firstIP(serviceIPNet).String())
 	}
-	kubeIP := firstIP(serviceIPNet)
 	apiServerHostNames := []string{
 		"localhost",
 		"kubernetes",
@@ -42,15 +101,17 @@ func GeneratePKI(params *render.PKIParams) (map[string][]byte, error) {
 		fmt.Sprintf("kube-apiserver.%s.svc", params.Namespace),
 		fmt.Sprintf("kube-apiserver.%s.svc.cluster.local", params.Namespace),
 	}
-	apiServerIPs := []string{
-		"127.0.0.1",
-		kubeIP.String(),
-		params.NodeInternalAPIServerIP,
-	}
-	if isNumericIP(params.ExternalAPIAddress) {
-		apiServerIPs = append(apiServerIPs, params.ExternalAPIAddress)
-	} else {
-		apiServerHostNames = append(apiServerHostNames, params.ExternalAPIAddress)
+	apiServerIPs := append([]string{"127.0.0.1"}, kubeIPs...)
+	apiServerIPs = append(apiServerIPs, params.NodeInternalAPIServerIP)
+	for i, address := range []string{params.ExternalAPIAddress, params.PrivateAPIAddress} {
+		if len(address) == 0 || (i > 0 && address == params.ExternalAPIAddress) {
+			continue
+		}
+		if isNumericIP(address) {
+			apiServerIPs = append(apiServerIPs, address)
+		} else {
+			apiServerHostNames = append(apiServerHostNames, address)
+		}
 	}
 	var ingressNumericIPs, ingressHostNames []string
 	if isNumericIP(params.ExternalOauthAddress) {
@@ -60,7 +121,7 @@ func GeneratePKI(params *render.PKIParams) (map[string][]byte, error) {
 	}
 	ingressHostNames = append(ingressHostNames, fmt.Sprintf("*.%s", params.IngressSubdomain))
 
-	certs := []certSpec{
+	certSpecs := []certSpec{
 		// kube-apiserver
 		cert("kube-apiserver-server", "root-ca", "kubernetes", "kubernetes", apiServerHostNames, apiServerIPs),
 		cert("kube-apiserver-kubelet", "root-ca", "system:kube-apiserver", "kubernetes", nil, nil),
@@ -130,38 +191,99 @@ func GeneratePKI(params *render.PKIParams) (map[string][]byte, error) {
 		cert("openvpn-kube-apiserver-client", "openvpn-ca", "kube-apiserver", "kubernetes", nil, nil),
 		cert("openvpn-router-proxy-client", "openvpn-ca", "router-proxy", "kubernetes", nil, nil),
 		cert("openvpn-worker-client", "openvpn-ca", "worker", "kubernetes", nil, nil),
+
+		// konnectivity
+		cert("konnectivity-server", "konnectivity-ca", "konnectivity-server", "kubernetes",
+			[]string{
+				"konnectivity-server",
+				fmt.Sprintf("konnectivity-server.%s.svc", params.Namespace),
+				fmt.Sprintf("konnectivity-server.%s.svc.cluster.local", params.Namespace),
+				params.ExternalKonnectivityAddress,
+			}, nil),
+		cert("konnectivity-agent", "konnectivity-ca", "konnectivity-agent", "kubernetes", nil, nil),
 	}
-	caMap, err := generateCAs(cas)
-	if err != nil {
-		return nil, err
-	}
-	kubeconfigMap, err := generateKubeconfigs(kubeconfigs, caMap)
+	return cas, kubeconfigs, certSpecs, nil
+}
+
+func isNumericIP(s string) bool {
+	return net.ParseIP(s) != nil
+}
+
+// RotateKubeconfigSigner replaces the kubeconfig signer in the given PKI data
+// and updates the client CA bundle of the kube-apiserver to trust the new
+// signer instead of the old one.
+func RotateKubeconfigSigner(data map[string][]byte) error {
+	signer, err := certs.GenerateCA("kubeconfig-signer", "openshift")
 	if err != nil {
-		return nil, err
+		return err
 	}
-	certMap, err := generateCerts(certs, caMap)
-	if err != nil {
-		return nil, err
+	caMap := map[string]*certs.CA{"kubeconfig-signer": signer}
+	for _, name := range []string{"root-ca", "cluster-signer"} {
+		cert, err := certs.PemToCertificate(data[name+".crt"])
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse %s certificate", name)
+		}
+		caMap[name] = &certs.CA{Cert: cert}
 	}
+	certBytes, keyBytes := signer.Serialize()
+	data["kubeconfig-signer.crt"] = certBytes
+	data["kubeconfig-signer.key"] = keyBytes
+	return serializeCombinedCA([]string{"root-ca", "cluster-signer", "kubeconfig-signer"}, caMap, "client-ca.crt", data)
+}
 
-	result := map[string][]byte{}
+// endpointArtifacts are the kubeconfigs and certificates that include the
+// external addresses of the API and OAuth servers.
+var endpointArtifacts = map[string]bool{
+	"admin":                 true,
+	"kubelet-bootstrap":     true,
+	"kube-apiserver-server": true,
+	"ingress-openshift":     true,
+}
 
-	serializeCAs(caMap, result)
-	if err := serializeKubeconfigs(kubeconfigMap, result); err != nil {
-		return nil, err
+// RegenerateEndpointArtifacts reissues the kubeconfigs and certificates in the
+// given PKI data that include the external addresses of the API and OAuth
+// servers, for example after a stable DNS name was set for them. The CAs of
+// the PKI data are kept, so the artifacts stay trusted by existing clients.
+func RegenerateEndpointArtifacts(params *render.PKIParams, data map[string][]byte) error {
+	_, kubeconfigSpecs, certSpecs, err := pkiSpecs(params)
+	if err != nil {
+		return err
 	}
-	serializeCerts(certMap, result)
-
-	// Miscellaneous PKI artifacts
-	if err := serializeCombinedCA([]string{"root-ca", "cluster-signer"}, caMap, "combined-ca.crt", result); err != nil {
-		return nil, err
+	caMap := map[string]*certs.CA{}
+	for _, name := range []string{"root-ca", "cluster-signer"} {
+		cert, err := certs.PemToCertificate(data[name+".crt"])
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse %s certificate", name)
+		}
+		key, err := certs.PemToPrivateKey(data[name+".key"])
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse %s key", name)
+		}
+		caMap[name] = &certs.CA{Cert: cert, Key: key}
 	}
-	if err := serializeRSAKey("service-account", result); err != nil {
-		return nil, err
+	var kubeconfigs []kubeconfigSpec
+	for _, spec := range kubeconfigSpecs {
+		if endpointArtifacts[spec.name] {
+			kubeconfigs = append(kubeconfigs, spec)
+		}
 	}
-	return result, nil
-}
-
-func isNumericIP(s string) bool {
-	return net.ParseIP(s) != nil
+	var endpointCerts []certSpec
+	for _, spec := range certSpecs {
+		if endpointArtifacts[spec.name] {
+			endpointCerts = append(endpointCerts, spec)
+		}
+	}
+	kubeconfigMap, err := generateKubeconfigs(certs.DefaultSource, kubeconfigs, caMap)
+	if err != nil {
+		return err
+	}
+	certMap, err := generateCerts(certs.DefaultSource, endpointCerts, caMap)
+	if err != nil {
+		return err
+	}
+	if err := serializeKubeconfigs(kubeconfigMap, data); err != nil {
+		return err
+	}
+	serializeCerts(certMap, data)
+	return nil
 }
@@ -0,0 +1,173 @@
+package pki
+
+import (
+	"bytes"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+	"openshift.io/hypershift/support/certs"
+)
+
+func TestRotateKubeconfigSigner(t *testing.T) {
+	data := map[string][]byte{}
+	caMap, err := generateCAs(certs.DefaultSource, []caSpec{
+		ca("root-ca", "root-ca", "openshift"),
+		ca("cluster-signer", "cluster-signer", "openshift"),
+		ca("kubeconfig-signer", "kubeconfig-signer", "openshift"),
+	})
+	if err != nil {
+		t.Fatalf("failed to generate CAs: %v", err)
+	}
+	serializeCAs(caMap, data)
+	if err := serializeCombinedCA([]string{"root-ca", "cluster-signer", "kubeconfig-signer"}, caMap, "client-ca.crt", data); err != nil {
+		t.Fatalf("failed to serialize client CA: %v", err)
+	}
+
+	oldCert, err := certs.GenerateClientCert("user", []string{"group"}, time.Hour, caMap["kubeconfig-signer"])
+	if err != nil {
+		t.Fatalf("failed to generate client certificate: %v", err)
+	}
+	if !verifiesClientCert(data["client-ca.crt"], oldCert.Cert) {
+		t.Fatalf("client certificate should be trusted before rotation")
+	}
+
+	if err := RotateKubeconfigSigner(data); err != nil {
+		t.Fatalf("failed to rotate kubeconfig signer: %v", err)
+	}
+	if verifiesClientCert(data["client-ca.crt"], oldCert.Cert) {
+		t.Errorf("client certificate of the old signer should not be trusted after rotation")
+	}
+
+	signerCert, err := certs.PemToCertificate(data["kubeconfig-signer.crt"])
+	if err != nil {
+		t.Fatalf("failed to parse kubeconfig signer certificate: %v", err)
+	}
+	signerKey, err := certs.PemToPrivateKey(data["kubeconfig-signer.key"])
+	if err != nil {
+		t.Fatalf("failed to parse kubeconfig signer key: %v", err)
+	}
+	newCert, err := certs.GenerateClientCert("user", []string{"group"}, time.Hour, &certs.CA{Cert: signerCert, Key: signerKey})
+	if err != nil {
+		t.Fatalf("failed to generate client certificate: %v", err)
+	}
+	if !verifiesClientCert(data["client-ca.crt"], newCert.Cert) {
+		t.Errorf("client certificate of the new signer should be trusted after rotation")
+	}
+	kubeletCert, err := certs.GenerateCert("system:node:test", "system:nodes", nil, nil, caMap["cluster-signer"])
+	if err != nil {
+		t.Fatalf("failed to generate kubelet certificate: %v", err)
+	}
+	if !verifiesClientCert(data["client-ca.crt"], kubeletCert.Cert) {
+		t.Errorf("client certificates of the cluster signer should still be trusted after rotation")
+	}
+}
+
+func TestKonnectivityCerts(t *testing.T) {
+	data, err := GeneratePKI(&render.PKIParams{
+		ExternalAPIAddress:          "api.example.hypershift.local",
+		NodeInternalAPIServerIP:     "172.20.0.1",
+		ExternalAPIPort:             6443,
+		InternalAPIPort:             6443,
+		ServiceCIDR:                 "172.31.0.0/16",
+		ExternalOauthAddress:        "oauth.example.hypershift.local",
+		IngressSubdomain:            "apps.example.hypershift.local",
+		MachineConfigServerAddress:  "ignition.example.hypershift.local",
+		ExternalKonnectivityAddress: "konnectivity.example.hypershift.local",
+		Namespace:                   "clusters-example",
+	})
+	if err != nil {
+		t.Fatalf("failed to generate PKI: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data["konnectivity-ca.crt"]) {
+		t.Fatalf("failed to parse konnectivity CA")
+	}
+	parse := func(name string) *x509.Certificate {
+		cert, err := certs.PemToCertificate(data[name])
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", name, err)
+		}
+		return cert
+	}
+
+	server := parse("konnectivity-server.crt")
+	// Agents in the guest dial the external address, the API server and the
+	// agent for the control plane namespace dial the service
+	for _, name := range []string{"konnectivity.example.hypershift.local", "konnectivity-server", "konnectivity-server.clusters-example.svc"} {
+		if _, err := server.Verify(x509.VerifyOptions{Roots: pool, DNSName: name, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}}); err != nil {
+			t.Errorf("konnectivity server certificate is not valid for %s: %v", name, err)
+		}
+	}
+	if !verifiesClientCert(data["konnectivity-ca.crt"], parse("konnectivity-agent.crt")) {
+		t.Errorf("konnectivity agent certificate should be trusted by the konnectivity CA")
+	}
+	if verifiesClientCert(data["konnectivity-ca.crt"], parse("openvpn-worker-client.crt")) {
+		t.Errorf("certificates of other CAs should not be trusted by the konnectivity CA")
+	}
+}
+
+func verifiesClientCert(bundle []byte, cert *x509.Certificate) bool {
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(bundle)
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:     pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err == nil
+}
+
+func TestRegenerateEndpointArtifacts(t *testing.T) {
+	params := &render.PKIParams{
+		ExternalAPIAddress:      "a1b2.elb.example.com",
+		NodeInternalAPIServerIP: "172.20.0.1",
+		ExternalAPIPort:         6443,
+		InternalAPIPort:         6443,
+		ServiceCIDR:             "172.31.0.0/16",
+		ExternalOauthAddress:    "c3d4.elb.example.com",
+		IngressSubdomain:        "apps.example.hypershift.local",
+		Namespace:               "clusters-example",
+	}
+	data, err := GeneratePKI(params)
+	if err != nil {
+		t.Fatalf("failed to generate PKI: %v", err)
+	}
+	original := map[string][]byte{}
+	for k, v := range data {
+		original[k] = v
+	}
+
+	params.ExternalAPIAddress = "api.example.hypershift.local"
+	params.ExternalOauthAddress = "oauth.example.hypershift.local"
+	if err := RegenerateEndpointArtifacts(params, data); err != nil {
+		t.Fatalf("failed to regenerate endpoint artifacts: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data["root-ca.crt"]) {
+		t.Fatalf("failed to parse root CA")
+	}
+	for name, host := range map[string]string{
+		"kube-apiserver-server.crt": "api.example.hypershift.local",
+		"ingress-openshift.crt":     "oauth.example.hypershift.local",
+	} {
+		cert, err := certs.PemToCertificate(data[name])
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", name, err)
+		}
+		if _, err := cert.Verify(x509.VerifyOptions{Roots: pool, DNSName: host, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}}); err != nil {
+			t.Errorf("%s is not valid for %s: %v", name, host, err)
+		}
+	}
+	if !bytes.Contains(data["admin.kubeconfig"], []byte("https://api.example.hypershift.local:6443")) {
+		t.Errorf("admin kubeconfig should point at the new API address")
+	}
+	if !bytes.Contains(data["kubelet-bootstrap.kubeconfig"], []byte("https://api.example.hypershift.local:6443")) {
+		t.Errorf("kubelet bootstrap kubeconfig should point at the new API address")
+	}
+	for _, name := range []string{"root-ca.crt", "root-ca.key", "cluster-signer.crt", "etcd-server.crt", "service-account.key", "internal-admin.kubeconfig"} {
+		if !bytes.Equal(original[name], data[name]) {
+			t.Errorf("%s should not change", name)
+		}
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
-	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render/pki/util"
+	"openshift.io/hypershift/support/certs"
 )
 
 type caSpec struct {
@@ -29,11 +29,11 @@ type kubeconfigSpec struct {
 	serverAddress string
 }
 
-func generateCAs(caSpecs []caSpec) (map[string]*util.CA, error) {
-	result := make(map[string]*util.CA)
+func generateCAs(source *certs.Source, caSpecs []caSpec) (map[string]*certs.CA, error) {
+	result := make(map[string]*certs.CA)
 	for _, caSpec := range caSpecs {
 		log.Infof("Generating CA %s (cn=%s,ou=%s)", caSpec.name, caSpec.commonName, caSpec.organizationalUnit)
-		ca, err := util.GenerateCA(caSpec.commonName, caSpec.organizationalUnit)
+		ca, err := source.GenerateCA(caSpec.commonName, caSpec.organizationalUnit)
 		if err != nil {
 			return nil, err
 		}
@@ -42,15 +42,15 @@ func generateCAs(caSpecs []caSpec) (map[string]*util.CA, error) {
 	return result, nil
 }
 
-func generateKubeconfigs(kubeconfigSpecs []kubeconfigSpec, cas map[string]*util.CA) (map[string]*util.Kubeconfig, error) {
-	result := make(map[string]*util.Kubeconfig)
+func generateKubeconfigs(source *certs.Source, kubeconfigSpecs []kubeconfigSpec, cas map[string]*certs.CA) (map[string]*certs.Kubeconfig, error) {
+	result := make(map[string]*certs.Kubeconfig)
 	for _, spec := range kubeconfigSpecs {
 		log.Infof("Generating kubeconfig %s (cn=%s,o=%s)", spec.name, spec.commonName, spec.organization)
 		ca := cas[spec.ca]
 		if ca == nil {
 			return nil, errors.Errorf("CA %s for kubeconfig %s not found", spec.ca, spec.name)
 		}
-		kubeconfig, err := util.GenerateKubeconfig(spec.serverAddress, spec.commonName, spec.organization, cas["root-ca"], ca)
+		kubeconfig, err := source.GenerateKubeconfig(spec.serverAddress, spec.commonName, spec.organization, cas["root-ca"], ca)
 		if err != nil {
 			return nil, err
 		}
@@ -59,15 +59,15 @@ func generateKubeconfigs(kubeconfigSpecs []kubeconfigSpec, cas map[string]*util.
 	return result, nil
 }
 
-func generateCerts(certSpecs []certSpec, cas map[string]*util.CA) (map[string]*util.Cert, error) {
-	result := make(map[string]*util.Cert)
+func generateCerts(source *certs.Source, certSpecs []certSpec, cas map[string]*certs.CA) (map[string]*certs.Cert, error) {
+	result := make(map[string]*certs.Cert)
 	for _, spec := range certSpecs {
 		log.Infof("Generating certificate %s (cn=%s,o=%s)", spec.name, spec.commonName, spec.organization)
 		ca := cas[spec.ca]
 		if ca == nil {
 			return nil, errors.Errorf("CA %s for certificate %s not found", spec.ca, spec.name)
 		}
-		cert, err := util.GenerateCert(spec.commonName, spec.organization, spec.hostNames, spec.ips, ca)
+		cert, err := source.GenerateCert(spec.commonName, spec.organization, spec.hostNames, spec.ips, ca)
 		if err != nil {
 			return nil, err
 		}
@@ -107,7 +107,7 @@ func kubeconfig(name, serverAddress, ca, commonName, organization string) kubeco
 	}
 }
 
-func serializeCerts(certMap map[string]*util.Cert, output map[string][]byte) {
+func serializeCerts(certMap map[string]*certs.Cert, output map[string][]byte) {
 	for k, v := range certMap {
 		certBytes, keyBytes := v.Serialize()
 		output[k+".crt"] = certBytes
@@ -115,7 +115,7 @@ func serializeCerts(certMap map[string]*util.Cert, output map[string][]byte) {
 	}
 }
 
-func serializeKubeconfigs(kubeconfigMap map[string]*util.Kubeconfig, output map[string][]byte) error {
+func serializeKubeconfigs(kubeconfigMap map[string]*certs.Kubeconfig, output map[string][]byte) error {
 	for k, v := range kubeconfigMap {
 		kubeconfigBytes, err := v.Serialize()
 		if err != nil {
@@ -126,7 +126,7 @@ func serializeKubeconfigs(kubeconfigMap map[string]*util.Kubeconfig, output map[
 	return nil
 }
 
-func serializeCAs(caMap map[string]*util.CA, output map[string][]byte) {
+func serializeCAs(caMap map[string]*certs.CA, output map[string][]byte) {
 	for k, v := range caMap {
 		certBytes, keyBytes := v.Serialize()
 		output[k+".crt"] = certBytes
@@ -134,8 +134,8 @@ func serializeCAs(caMap map[string]*util.CA, output map[string][]byte) {
 	}
 }
 
-func serializeCombinedCA(cas []string, caMap map[string]*util.CA, fileName string, output map[string][]byte) error {
-	var caList util.CAList
+func serializeCombinedCA(cas []string, caMap map[string]*certs.CA, fileName string, output map[string][]byte) error {
+	var caList certs.CAList
 	for _, c := range cas {
 		ca := caMap[c]
 		if ca == nil {
@@ -147,13 +147,13 @@ func serializeCombinedCA(cas []string, caMap map[string]*util.CA, fileName strin
 	return nil
 }
 
-func serializeRSAKey(name string, output map[string][]byte) error {
-	key, err := util.PrivateKey()
+func serializeRSAKey(source *certs.Source, name string, output map[string][]byte) error {
+	key, err := source.PrivateKey()
 	if err != nil {
 		return errors.Wrapf(err, "cannot generate a private key")
 	}
-	privateKeyBytes := util.PrivateKeyToPem(key)
-	publicKeyBytes, err := util.PublicKeyToPem(&key.PublicKey)
+	privateKeyBytes := certs.PrivateKeyToPem(key)
+	publicKeyBytes, err := certs.PublicKeyToPem(&key.PublicKey)
 	if err != nil {
 		errors.Wrapf(err, "cannot serialize RSA key public key")
 	}
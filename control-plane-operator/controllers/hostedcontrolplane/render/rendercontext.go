@@ -53,13 +53,20 @@ func (c *renderContext) addManifest(name string, content []byte) {
 }
 
 func (c *renderContext) substituteParams(data interface{}, fileName string) ([]byte, error) {
-	return c.substituteParamsInBytes(data, assets.MustAsset(fileName))
+	content, err := assets.Asset(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return c.substituteParamsInBytes(data, content)
 }
 
 func (c *renderContext) substituteParamsInBytes(data interface{}, content []byte) ([]byte, error) {
 	out := &bytes.Buffer{}
-	t := template.Must(template.New("template").Funcs(c.funcs).Parse(string(content)))
-	err := t.Execute(out, data)
+	t, err := template.New("template").Funcs(c.funcs).Parse(string(content))
+	if err != nil {
+		return nil, err
+	}
+	err = t.Execute(out, data)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,10 @@
 package render
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+
+	"openshift.io/hypershift/support/mirrors"
+)
 
 // NewClusterParams returns a new default cluster params struct
 func NewClusterParams() *ClusterParams {
@@ -11,16 +15,25 @@ func NewClusterParams() *ClusterParams {
 		"RotateKubeletServerCertificate=true",
 	}
 	p.ImageRegistryHTTPSecret = uuid.New().String()
+	p.NetworkType = "OpenShiftSDN"
+	p.MachineCIDR = "10.0.0.0/16"
+	p.HostPrefix = 23
+	p.AuditProfile = "Default"
+	p.AuditWebhookMode = "batch"
+	p.AuditLogMaxSize = 100
+	p.AuditLogMaxBackups = 10
 	return p
 }
 
 type PKIParams struct {
 	// API Server
 	ExternalAPIAddress      string // An externally accessible DNS name or IP for the API server. Currently obtained from the load balancer DNS name.
-	NodeInternalAPIServerIP string // A fixed IP that pods on worker nodes will use to communicate with the API server - 172.20.0.1
-	ExternalAPIPort         uint   // External API server port - fixed at 6443. This is used for kubeconfig generation.
+	NodeInternalAPIServerIP string // A fixed IP that pods on worker nodes will use to communicate with the API server - 172.20.0.1 by default
+	ExternalAPIPort         uint   // External API server port. This is used for kubeconfig generation.
+	PrivateAPIAddress       string // A DNS name or IP for the API server on the private network, used by guest workers. Same as ExternalAPIAddress unless endpoint access is PublicAndPrivate.
+	PrivateAPIPort          uint   // Private API server port. This is used for the kubelet bootstrap kubeconfig.
 	InternalAPIPort         uint   // Internal API server network (on service network of host) - fixed at 6443. Used for kubeconfig generation.
-	ServiceCIDR             string // Used to determine the internal IP address of the Kube service and generate an IP for it.
+	ServiceCIDR             string // Used to determine the internal IP addresses of the Kube service and generate IPs for them. Comma separated for dual-stack clusters.
 
 	// OAuth Server address
 	ExternalOauthAddress string // An externally accessible DNS name or IP for the Oauth server. Currently obtained from Oauth load balancer DNS name.
@@ -34,6 +47,9 @@ type PKIParams struct {
 	// VPN Server
 	ExternalOpenVPNAddress string // An externally accessible DNS name or IP for the VPN Server. Currently obtained from VPN load balancer DNS name.
 
+	// Konnectivity Server
+	ExternalKonnectivityAddress string // An externally accessible DNS name for the konnectivity server agent endpoint. Currently generated using a route hostname.
+
 	// Common
 	Namespace string // Used to generate internal DNS names for services.
 }
@@ -47,11 +63,11 @@ type ClusterParams struct {
 	ExternalOpenVPNPort     uint        `json:"externalVPNPort"`
 	ExternalOauthDNSName    string      `json:"externalOauthDNSName"`
 	ExternalOauthPort       uint        `json:"externalOauthPort"`
-	IdentityProviders       string      `json:"identityProviders"`
 	ServiceCIDR             string      `json:"serviceCIDR"`
 	NamedCerts              []NamedCert `json:"namedCerts,omitempty"`
 	PodCIDR                 string      `json:"podCIDR"`
 	ReleaseImage            string      `json:"releaseImage"`
+	ReleaseImagePullSpec    string      `json:"releaseImagePullSpec"`
 	IngressSubdomain        string      `json:"ingressSubdomain"`
 	OpenShiftAPIClusterIP   string      `json:"openshiftAPIClusterIP"`
 	OauthAPIClusterIP       string      `json:"oauthAPIClusterIP"`
@@ -60,6 +76,13 @@ type ClusterParams struct {
 	RouterNodePortHTTPS     string      `json:"routerNodePortHTTPS"`
 	BaseDomain              string      `json:"baseDomain"`
 	NetworkType             string      `json:"networkType"`
+	MachineCIDR             string      `json:"machineCIDR"`
+	HostPrefix              int32       `json:"hostPrefix"`
+	NetworkMTU              int32       `json:"networkMTU"`
+	// ServiceNetworks and ClusterNetworks are the networks of ServiceCIDR
+	// and PodCIDR, which are comma separated for dual-stack clusters
+	ServiceNetworks []string         `json:"serviceNetworks"`
+	ClusterNetworks []ClusterNetwork `json:"clusterNetworks"`
 	// APIAvailabilityPolicy defines the availability of components that support end-user facing API requests
 	APIAvailabilityPolicy AvailabilityPolicy `json:"apiAvailabilityPolicy"`
 	// ControllerAvailabilityPolicy defines the availability of controller components for the cluster
@@ -72,15 +95,6 @@ type ClusterParams struct {
 	CVOSetupImage                          string                 `json:"cvoSetupImage"`
 	InternalAPIPort                        uint                   `json:"internalAPIPort"`
 	RouterServiceType                      string                 `json:"routerServiceType"`
-	KubeAPIServerResources                 []ResourceRequirements `json:"kubeAPIServerResources"`
-	OpenshiftControllerManagerResources    []ResourceRequirements `json:"openshiftControllerManagerResources"`
-	ClusterVersionOperatorResources        []ResourceRequirements `json:"clusterVersionOperatorResources"`
-	KubeControllerManagerResources         []ResourceRequirements `json:"kubeControllerManagerResources"`
-	OpenshiftAPIServerResources            []ResourceRequirements `json:"openshiftAPIServerResources"`
-	KubeSchedulerResources                 []ResourceRequirements `json:"kubeSchedulerResources"`
-	HostedClusterConfigOperatorResources   []ResourceRequirements `json:"hostedClusterConfigOperatorResources"`
-	OAuthServerResources                   []ResourceRequirements `json:"oAuthServerResources"`
-	ClusterPolicyControllerResources       []ResourceRequirements `json:"clusterPolicyControllerResources"`
 	AutoApproverResources                  []ResourceRequirements `json:"autoApproverResources"`
 	OpenVPNClientResources                 []ResourceRequirements `json:"openVPNClientResources"`
 	OpenVPNServerResources                 []ResourceRequirements `json:"openVPNServerResources"`
@@ -96,19 +110,53 @@ type ClusterParams struct {
 	HypershiftOperatorResources            []ResourceRequirements `json:"hypershiftOperatorResourceRequirements"`
 	HypershiftOperatorControllers          []string               `json:"hypershiftOperatorControllers"`
 	MachineConfigServerAddress             string                 `json:"machineConfigServerAddress"`
+	GuestIngressLoadBalancer               bool                   `json:"guestIngressLoadBalancer"`
+	PrivateAPIDNSName                      string                 `json:"privateAPIDNSName"`
+	PrivateAPIPort                         uint                   `json:"privateAPIPort"`
+	KonnectivityEnabled                    bool                   `json:"konnectivityEnabled"`
+	ExternalKonnectivityAddress            string                 `json:"externalKonnectivityAddress"`
+	ExternalKonnectivityPort               uint                   `json:"externalKonnectivityPort"`
+	PublicZoneID                           string                 `json:"publicZoneID"`
+	PrivateZoneID                          string                 `json:"privateZoneID"`
+	AuditProfile                           string                 `json:"auditProfile"`
+	AuditPolicy                            string                 `json:"auditPolicy"`
+	AuditWebhookMode                       string                 `json:"auditWebhookMode"`
+	AuditLogMaxSize                        int32                  `json:"auditLogMaxSize"`
+	AuditLogMaxBackups                     int32                  `json:"auditLogMaxBackups"`
+	AuditLogMaxAge                         int32                  `json:"auditLogMaxAge"`
+	SecretEncryptionType                   string                 `json:"secretEncryptionType"`
+	EncryptionConfigHash                   string                 `json:"encryptionConfigHash"`
+	FeatureSet                             string                 `json:"featureSet"`
+	CustomEnabledFeatureGates              []string               `json:"customEnabledFeatureGates"`
+	CustomDisabledFeatureGates             []string               `json:"customDisabledFeatureGates"`
 	SSHKey                                 string                 `json:"sshKey"`
+	HTTPProxy                              string                 `json:"httpProxy"`
+	HTTPSProxy                             string                 `json:"httpsProxy"`
+	NoProxy                                string                 `json:"noProxy"`
+	EffectiveNoProxy                       string                 `json:"effectiveNoProxy"`
+	ControlPlaneNoProxy                    string                 `json:"controlPlaneNoProxy"`
+	TrustedCABundle                        string                 `json:"trustedCABundle"`
 	DefaultFeatureGates                    []string
+	// ImageContentSources are the mirrors the control plane pulls images from
+	ImageContentSources []mirrors.ImageContentSource `json:"imageContentSources"`
 
 	// Fields below are are taken from the ROKs type
-	EndpointPublishingStrategyScope string                 `json:"endpointPublishingStrategyScope"`
-	ClusterID                       string                 `json:"clusterID"`
-	MasterPriorityClass             string                 `json:"masterPriorityClass"`
-	KMSServerResources              []ResourceRequirements `json:"kmsServerResources"`
-	KMSImage                        string                 `json:"kmsImage"`
-	KPInfo                          string                 `json:"kpInfo"`
-	KPRegion                        string                 `json:"kpRegion"`
-	KPAPIKey                        string                 `json:"kpAPIKey"`
-	APINodePort                     uint                   `json:"apiNodePort"`
+	EndpointPublishingStrategyScope string `json:"endpointPublishingStrategyScope"`
+	ClusterID                       string `json:"clusterID"`
+	MasterPriorityClass             string `json:"masterPriorityClass"`
+	KMSImage                        string `json:"kmsImage"`
+	KPInfo                          string `json:"kpInfo"`
+	KPRegion                        string `json:"kpRegion"`
+	KPAPIKey                        string `json:"kpAPIKey"`
+	APINodePort                     uint   `json:"apiNodePort"`
+}
+
+// OAuthVolume is a Secret or ConfigMap that is mounted into the OAuth server.
+type OAuthVolume struct {
+	Name          string `json:"name"`
+	MountPath     string `json:"mountPath"`
+	SecretName    string `json:"secretName,omitempty"`
+	ConfigMapName string `json:"configMapName,omitempty"`
 }
 
 type NamedCert struct {
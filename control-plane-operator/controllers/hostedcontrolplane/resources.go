@@ -0,0 +1,171 @@
+package hostedcontrolplane
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+// controlPlaneComponent is a control plane container with configurable
+// compute resources.
+type controlPlaneComponent struct {
+	deployment string
+	container  string
+	// resources returns the resources of the component in a spec
+	resources func(*hyperv1.ControlPlaneResources) *corev1.ResourceRequirements
+	// sizes are the resources of the component in each size preset
+	sizes map[hyperv1.ControlPlaneSize]corev1.ResourceRequirements
+}
+
+var controlPlaneComponents = []controlPlaneComponent{
+	{
+		deployment: "kube-apiserver",
+		container:  "kube-apiserver",
+		resources: func(r *hyperv1.ControlPlaneResources) *corev1.ResourceRequirements {
+			return r.KubeAPIServer
+		},
+		sizes: sizes("250m", "1Gi", "500m", "2Gi", "1", "4Gi"),
+	},
+	{
+		deployment: "kube-controller-manager",
+		container:  "kube-controller-manager",
+		resources: func(r *hyperv1.ControlPlaneResources) *corev1.ResourceRequirements {
+			return r.KubeControllerManager
+		},
+		sizes: sizes("100m", "300Mi", "200m", "500Mi", "400m", "1Gi"),
+	},
+	{
+		deployment: "kube-scheduler",
+		container:  "kube-scheduler",
+		resources: func(r *hyperv1.ControlPlaneResources) *corev1.ResourceRequirements {
+			return r.KubeScheduler
+		},
+		sizes: sizes("25m", "150Mi", "50m", "200Mi", "100m", "400Mi"),
+	},
+	{
+		deployment: "openshift-apiserver",
+		container:  "openshift-apiserver",
+		resources: func(r *hyperv1.ControlPlaneResources) *corev1.ResourceRequirements {
+			return r.OpenShiftAPIServer
+		},
+		sizes: sizes("100m", "200Mi", "200m", "400Mi", "400m", "800Mi"),
+	},
+	{
+		deployment: "openshift-controller-manager",
+		container:  "openshift-controller-manager",
+		resources: func(r *hyperv1.ControlPlaneResources) *corev1.ResourceRequirements {
+			return r.OpenShiftControllerManager
+		},
+		sizes: sizes("50m", "100Mi", "100m", "200Mi", "200m", "400Mi"),
+	},
+	{
+		deployment: "cluster-policy-controller",
+		container:  "cluster-policy-controller",
+		resources: func(r *hyperv1.ControlPlaneResources) *corev1.ResourceRequirements {
+			return r.ClusterPolicyController
+		},
+		sizes: sizes("10m", "100Mi", "20m", "200Mi", "40m", "400Mi"),
+	},
+	{
+		deployment: "oauth-openshift",
+		container:  "openshift-oauthserver",
+		resources: func(r *hyperv1.ControlPlaneResources) *corev1.ResourceRequirements {
+			return r.OAuthServer
+		},
+		sizes: sizes("25m", "50Mi", "50m", "100Mi", "100m", "200Mi"),
+	},
+	{
+		deployment: "cluster-version-operator",
+		container:  "cluster-version-operator",
+		resources: func(r *hyperv1.ControlPlaneResources) *corev1.ResourceRequirements {
+			return r.ClusterVersionOperator
+		},
+		sizes: sizes("20m", "50Mi", "40m", "100Mi", "80m", "200Mi"),
+	},
+	{
+		deployment: "hosted-cluster-config-operator",
+		container:  "hosted-cluster-config-operator",
+		resources: func(r *hyperv1.ControlPlaneResources) *corev1.ResourceRequirements {
+			return r.HostedClusterConfigOperator
+		},
+		sizes: sizes("20m", "50Mi", "40m", "100Mi", "80m", "200Mi"),
+	},
+}
+
+// sizes returns the cpu and memory requests of a component in the small,
+// medium and large presets. Presets don't limit components, so that a busy
+// control plane isn't throttled or killed.
+func sizes(smallCPU, smallMemory, mediumCPU, mediumMemory, largeCPU, largeMemory string) map[hyperv1.ControlPlaneSize]corev1.ResourceRequirements {
+	requests := func(cpu, memory string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+		}
+	}
+	return map[hyperv1.ControlPlaneSize]corev1.ResourceRequirements{
+		hyperv1.SmallControlPlane:  requests(smallCPU, smallMemory),
+		hyperv1.MediumControlPlane: requests(mediumCPU, mediumMemory),
+		hyperv1.LargeControlPlane:  requests(largeCPU, largeMemory),
+	}
+}
+
+// componentResources returns the resources of a component, or nil if the
+// component keeps the resources of its manifests.
+func componentResources(spec *hyperv1.ControlPlaneResources, component controlPlaneComponent) *corev1.ResourceRequirements {
+	if spec == nil {
+		return nil
+	}
+	if resources := component.resources(spec); resources != nil {
+		return resources
+	}
+	if resources, ok := component.sizes[spec.Size]; ok {
+		return &resources
+	}
+	return nil
+}
+
+// deploymentResources returns the resources of the container of a component
+// deployment.
+func deploymentResources(spec *hyperv1.ControlPlaneResources, deployment string) corev1.ResourceRequirements {
+	for _, component := range controlPlaneComponents {
+		if component.deployment != deployment {
+			continue
+		}
+		if resources := componentResources(spec, component); resources != nil {
+			return *resources
+		}
+	}
+	return corev1.ResourceRequirements{}
+}
+
+// validateControlPlaneResources makes sure only cpu and memory are set, the
+// size presets of the components only cover those.
+func validateControlPlaneResources(spec *hyperv1.ControlPlaneResources) error {
+	if spec == nil {
+		return nil
+	}
+	for _, component := range controlPlaneComponents {
+		resources := component.resources(spec)
+		if resources == nil {
+			continue
+		}
+		for _, list := range []corev1.ResourceList{resources.Requests, resources.Limits} {
+			for name := range list {
+				if name != corev1.ResourceCPU && name != corev1.ResourceMemory {
+					return fmt.Errorf("unsupported resource %s for %s, only cpu and memory can be set", name, component.deployment)
+				}
+			}
+		}
+		for name, limit := range resources.Limits {
+			if request, ok := resources.Requests[name]; ok && request.Cmp(limit) > 0 {
+				return fmt.Errorf("the %s request of %s exceeds its limit", name, component.deployment)
+			}
+		}
+	}
+	return nil
+}
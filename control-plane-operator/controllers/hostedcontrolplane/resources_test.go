@@ -0,0 +1,48 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+func TestValidateControlPlaneResources(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources hyperv1.ControlPlaneResources
+		valid     bool
+	}{
+		{
+			name:      "size preset",
+			resources: hyperv1.ControlPlaneResources{Size: hyperv1.LargeControlPlane},
+			valid:     true,
+		},
+		{
+			name: "unsupported resource",
+			resources: hyperv1.ControlPlaneResources{OAuthServer: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("1Gi")},
+			}},
+		},
+		{
+			name: "request exceeds limit",
+			resources: hyperv1.ControlPlaneResources{KubeScheduler: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+			}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateControlPlaneResources(&test.resources)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
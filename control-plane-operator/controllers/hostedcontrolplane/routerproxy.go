@@ -0,0 +1,51 @@
+package hostedcontrolplane
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/routerproxy"
+)
+
+// routerProxyObjects returns the objects of the router proxy of a control
+// plane namespace, which forwards the traffic of the routes of the guest
+// cluster to its default router. It isn't needed when the default router is
+// exposed by its own load balancer.
+func routerProxyObjects(cc *componentContext) ([]client.Object, error) {
+	if cc.params.GuestIngressLoadBalancer {
+		return nil, nil
+	}
+	targetNamespace := cc.hcp.GetName()
+	return []client.Object{
+		routerproxy.Deployment{
+			Namespace: targetNamespace,
+			Images: routerproxy.Images{
+				CLI:           cc.images["cli"],
+				HAProxyRouter: cc.images["haproxy-router"],
+				OpenVPN:       cc.images["openvpn"],
+			},
+			Scheduling: cc.hcp.Spec.ControlPlaneScheduling,
+		}.Build(),
+		routerproxy.ConfigMap{
+			Namespace: targetNamespace,
+		}.Build(),
+		routerproxy.VPNClientConfigMap{
+			Namespace: targetNamespace,
+		}.Build(),
+		routerproxy.Service{
+			Namespace: targetNamespace,
+			Protocol:  "http",
+			Port:      80,
+		}.Build(),
+		routerproxy.Service{
+			Namespace: targetNamespace,
+			Protocol:  "https",
+			Port:      443,
+		}.Build(),
+		routerproxy.VPNClientSecret{
+			Namespace: targetNamespace,
+			Cert:      cc.pkiData["openvpn-router-proxy-client.crt"],
+			Key:       cc.pkiData["openvpn-router-proxy-client.key"],
+			CA:        cc.pkiData["openvpn-ca.crt"],
+		}.Build(),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+)
+
+func TestRouterProxyObjects(t *testing.T) {
+	tests := []struct {
+		name                     string
+		guestIngressLoadBalancer bool
+		expected                 int
+	}{
+		{
+			name:     "routes through the control plane namespace",
+			expected: 6,
+		},
+		{
+			name:                     "guest ingress load balancer",
+			guestIngressLoadBalancer: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := render.NewClusterParams()
+			params.GuestIngressLoadBalancer = test.guestIngressLoadBalancer
+			objects, err := routerProxyObjects(&componentContext{
+				hcp: &hyperv1.HostedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "example"},
+				},
+				params: params,
+				images: map[string]string{
+					"cli":            "example.com/cli:latest",
+					"haproxy-router": "example.com/haproxy-router:latest",
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if test.expected == 0 {
+				return
+			}
+			deployment := componentObject(t, objects, "Deployment", "router-proxy").(*appsv1.Deployment)
+			spec := deployment.Spec.Template.Spec
+			assert.Equal(t, "example.com/cli:latest", spec.InitContainers[0].Image)
+			assert.Equal(t, "example.com/haproxy-router:latest", spec.Containers[0].Image)
+			assert.Equal(t, "openvpn-client", spec.Containers[1].Name)
+			assert.Equal(t, "vpn", spec.ServiceAccountName)
+		})
+	}
+}
@@ -0,0 +1,481 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/kubeapiserver"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/render"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+	"openshift.io/hypershift/support/mirrors"
+)
+
+const (
+	encryptionConfigSecretName       = "kube-apiserver-encryption-config"
+	encryptionConfigKey              = "encryption-config.yaml"
+	encryptionKeyRotationAnnotation  = "hypershift.openshift.io/encryption-key-rotation"
+	encryptionConfigHashAnnotation   = "hypershift.openshift.io/encryption-config-hash"
+	kubeAPIServerDeploymentName      = "kube-apiserver"
+	kmsCredentialsAPIKeyKey          = "apiKey"
+	kmsPluginEndpoint                = "unix:///var/run/kmsplugin/socket.sock"
+	encryptionKeyNamePrefix          = "key-"
+	encryptionKeyRolloutPollInterval = 30 * time.Second
+)
+
+// encryptionConfiguration is the subset of the apiserver.config.k8s.io/v1
+// EncryptionConfiguration that is generated for the kube-apiserver.
+type encryptionConfiguration struct {
+	APIVersion string                            `json:"apiVersion"`
+	Kind       string                            `json:"kind"`
+	Resources  []encryptionResourceConfiguration `json:"resources"`
+}
+
+type encryptionResourceConfiguration struct {
+	Resources []string                          `json:"resources"`
+	Providers []encryptionProviderConfiguration `json:"providers"`
+}
+
+type encryptionProviderConfiguration struct {
+	AESCBC   *encryptionKeysConfiguration `json:"aescbc,omitempty"`
+	KMS      *encryptionKMSConfiguration  `json:"kms,omitempty"`
+	Identity *struct{}                    `json:"identity,omitempty"`
+}
+
+type encryptionKeysConfiguration struct {
+	Keys []encryptionKey `json:"keys"`
+}
+
+type encryptionKey struct {
+	Name   string `json:"name"`
+	Secret string `json:"secret"`
+}
+
+type encryptionKMSConfiguration struct {
+	Name      string `json:"name"`
+	Endpoint  string `json:"endpoint"`
+	CacheSize int32  `json:"cachesize"`
+	Timeout   string `json:"timeout"`
+}
+
+// secretEncryptionConfig is the secret encryption configuration of a
+// HostedControlPlane with the objects it references resolved.
+type secretEncryptionConfig struct {
+	spec *hyperv1.SecretEncryptionSpec
+	// apiKey is the API key of the key management service
+	apiKey string
+	// configSecret holds the EncryptionConfiguration of the kube-apiserver
+	configSecret *corev1.Secret
+}
+
+// secretEncryptionConfig reads the KMS credentials referenced by the secret
+// encryption configuration of a HostedControlPlane from the namespace of the
+// HostedCluster and makes sure the EncryptionConfiguration of the
+// kube-apiserver exists. The EncryptionConfiguration is generated just once,
+// since it holds the keys secrets are encrypted with.
+func (r *HostedControlPlaneReconciler) secretEncryptionConfig(ctx context.Context, hcp *hyperv1.HostedControlPlane) (*secretEncryptionConfig, error) {
+	config := &secretEncryptionConfig{spec: hcp.Spec.SecretEncryption}
+	if config.spec == nil {
+		return config, nil
+	}
+
+	if config.spec.Type == hyperv1.KMSSecretEncryptionType {
+		if config.spec.KMS == nil {
+			return nil, fmt.Errorf("the kms secret encryption type requires a kms configuration")
+		}
+		sourceNamespace, err := hostedClusterNamespace(hcp)
+		if err != nil {
+			return nil, err
+		}
+		name := config.spec.KMS.Credentials.Name
+		source := &corev1.Secret{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: sourceNamespace, Name: name}, source); err != nil {
+			return nil, fmt.Errorf("failed to get kms credentials secret %s: %w", name, err)
+		}
+		apiKey, ok := source.Data[kmsCredentialsAPIKeyKey]
+		if !ok {
+			return nil, fmt.Errorf("kms credentials secret %s is missing the %s key", name, kmsCredentialsAPIKeyKey)
+		}
+		config.apiKey = string(apiKey)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: hcp.GetName(),
+			Name:      encryptionConfigSecretName,
+		},
+	}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(secret), secret); err == nil {
+		config.configSecret = secret
+		return config, nil
+	} else if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get encryption config secret: %w", err)
+	}
+	encryptionConfig, err := generateEncryptionConfig(config.spec)
+	if err != nil {
+		return nil, err
+	}
+	secret.Type = corev1.SecretTypeOpaque
+	secret.Data = map[string][]byte{encryptionConfigKey: encryptionConfig}
+	secret.Annotations = map[string]string{
+		encryptionKeyRotationAnnotation: aescbcKeyRotation(config.spec),
+	}
+	secret.OwnerReferences = ensureHCPOwnerRef(hcp, secret.OwnerReferences)
+	if _, err := applyObject(ctx, r, secret); err != nil {
+		return nil, fmt.Errorf("failed to apply encryption config secret: %w", err)
+	}
+	r.Log.Info("created encryption config secret")
+	config.configSecret = secret
+	return config, nil
+}
+
+// setParams sets the parameters for rendering the kube-apiserver with secret
+// encryption.
+func (c *secretEncryptionConfig) setParams(params *render.ClusterParams) {
+	if c.spec == nil {
+		return
+	}
+	params.SecretEncryptionType = string(c.spec.Type)
+	params.EncryptionConfigHash = encryptionConfigHash(c.configSecret.Data[encryptionConfigKey])
+	if c.spec.Type == hyperv1.KMSSecretEncryptionType {
+		params.KMSImage = c.spec.KMS.Image
+		params.KPInfo = c.spec.KMS.KeyInfo
+		params.KPRegion = c.spec.KMS.Region
+		params.KPAPIKey = c.apiKey
+	}
+}
+
+// reconcileSecretEncryption rotates the aescbc key of a control plane that is
+// already installed. A new key is first added as a key that is only used for
+// decryption. Once every kube-apiserver can decrypt with it, it is promoted to
+// the key used for encryption, so that no kube-apiserver reads a secret it
+// can't decrypt during the rollout. Once every kube-apiserver encrypts with
+// it, the secrets of the guest cluster are rewritten and the old keys are
+// removed. The kube-apiserver rolls out with each step when the control plane
+// is applied. It returns whether a rotation is still in progress.
+func (r *HostedControlPlaneReconciler) reconcileSecretEncryption(ctx context.Context, hcp *hyperv1.HostedControlPlane, releaseImage *releaseinfo.ReleaseImage) (bool, error) {
+	spec := hcp.Spec.SecretEncryption
+	if spec == nil || spec.Type != hyperv1.AESCBCSecretEncryptionType {
+		return false, nil
+	}
+	targetNamespace := hcp.GetName()
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: targetNamespace, Name: encryptionConfigSecretName}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The encryption config is generated with the current rotation
+			// when the control plane is installed
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get encryption config secret: %w", err)
+	}
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: targetNamespace, Name: kubeAPIServerDeploymentName}, deployment); err != nil && !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("failed to get kube-apiserver deployment: %w", err)
+	}
+
+	rotation := aescbcKeyRotation(spec)
+	config := secret.Data[encryptionConfigKey]
+	var err error
+	switch {
+	case secret.Annotations[encryptionKeyRotationAnnotation] != rotation:
+		r.Log.Info("Adding encryption key", "rotation", rotation)
+		config, err = addEncryptionKey(config)
+		if err != nil {
+			return false, err
+		}
+	case !isRolledOut(deployment, encryptionConfigHash(config)):
+		promoted, err := isPromoted(config)
+		if err != nil {
+			return false, err
+		}
+		return !promoted, nil
+	default:
+		var promoted bool
+		config, promoted, err = promoteEncryptionKey(config)
+		if err != nil {
+			return false, err
+		}
+		if promoted {
+			r.Log.Info("Promoting encryption key", "rotation", rotation)
+			break
+		}
+		// Secrets written before the promotion are still encrypted with the
+		// old keys, which can only be removed once they are rewritten
+		var pruned bool
+		config, pruned, err = pruneEncryptionKeys(config)
+		if err != nil || !pruned {
+			return false, err
+		}
+		migrated, err := r.migrateEncryptedSecrets(ctx, hcp, releaseImage, rotation)
+		if err != nil || !migrated {
+			return err == nil, err
+		}
+		r.Log.Info("Removing old encryption keys", "rotation", rotation)
+	}
+
+	desired := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: targetNamespace,
+			Name:      encryptionConfigSecretName,
+			Annotations: map[string]string{
+				encryptionKeyRotationAnnotation: rotation,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{encryptionConfigKey: config},
+	}
+	desired.OwnerReferences = ensureHCPOwnerRef(hcp, desired.OwnerReferences)
+	if _, err := applyObject(ctx, r, desired); err != nil {
+		return false, fmt.Errorf("failed to apply encryption config secret: %w", err)
+	}
+	return true, nil
+}
+
+// migrateEncryptedSecrets runs a job that rewrites the secrets of the guest
+// cluster, so that they are encrypted with the promoted key of a rotation. It
+// returns whether the job completed. The job is removed once it completed for
+// the rotation, and retried when it failed.
+func (r *HostedControlPlaneReconciler) migrateEncryptedSecrets(ctx context.Context, hcp *hyperv1.HostedControlPlane, releaseImage *releaseinfo.ReleaseImage, rotation string) (bool, error) {
+	job := &batchv1.Job{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: hcp.GetName(), Name: kubeapiserver.EncryptionMigrationJobName}, job)
+	if apierrors.IsNotFound(err) {
+		job = kubeapiserver.EncryptionMigrationJob{
+			Namespace:  hcp.GetName(),
+			Image:      mirrors.MirroredImage(releaseImage.ComponentImages()["cli"], imageContentSources(hcp)),
+			Rotation:   rotation,
+			Scheduling: hcp.Spec.ControlPlaneScheduling,
+		}.Build()
+		job.OwnerReferences = ensureHCPOwnerRef(hcp, job.OwnerReferences)
+		r.Log.Info("Migrating secrets to the promoted encryption key", "rotation", rotation)
+		if _, err := applyObject(ctx, r, job); err != nil {
+			return false, fmt.Errorf("failed to apply encryption migration job: %w", err)
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get encryption migration job: %w", err)
+	}
+	if job.Annotations[encryptionKeyRotationAnnotation] != rotation {
+		// The job of an earlier rotation is replaced
+		return false, r.deleteEncryptionMigrationJob(ctx, job)
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, r.deleteEncryptionMigrationJob(ctx, job)
+		case batchv1.JobFailed:
+			if err := r.deleteEncryptionMigrationJob(ctx, job); err != nil {
+				return false, err
+			}
+			return false, fmt.Errorf("failed to migrate secrets to the promoted encryption key: %s", condition.Message)
+		}
+	}
+	return false, nil
+}
+
+func (r *HostedControlPlaneReconciler) deleteEncryptionMigrationJob(ctx context.Context, job *batchv1.Job) error {
+	if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete encryption migration job: %w", err)
+	}
+	return nil
+}
+
+// isRolledOut returns whether every replica of a deployment runs with the
+// given encryption config.
+func isRolledOut(deployment *appsv1.Deployment, hash string) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return deployment.Spec.Template.Annotations[encryptionConfigHashAnnotation] == hash &&
+		deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.Replicas == replicas &&
+		deployment.Status.AvailableReplicas == replicas
+}
+
+// generateEncryptionConfig returns the initial EncryptionConfiguration for a
+// secret encryption configuration. Secrets that are stored in plaintext stay
+// readable through the identity provider.
+func generateEncryptionConfig(spec *hyperv1.SecretEncryptionSpec) ([]byte, error) {
+	var provider encryptionProviderConfiguration
+	switch spec.Type {
+	case hyperv1.AESCBCSecretEncryptionType:
+		key, err := generateEncryptionKey(1)
+		if err != nil {
+			return nil, err
+		}
+		provider.AESCBC = &encryptionKeysConfiguration{Keys: []encryptionKey{key}}
+	case hyperv1.KMSSecretEncryptionType:
+		provider.KMS = &encryptionKMSConfiguration{
+			Name:      "kms-plugin",
+			Endpoint:  kmsPluginEndpoint,
+			CacheSize: 1000,
+			Timeout:   "3s",
+		}
+	default:
+		return nil, fmt.Errorf("unsupported secret encryption type %q", spec.Type)
+	}
+	return marshalEncryptionConfig(&encryptionConfiguration{
+		APIVersion: "apiserver.config.k8s.io/v1",
+		Kind:       "EncryptionConfiguration",
+		Resources: []encryptionResourceConfiguration{
+			{
+				Resources: []string{"secrets"},
+				Providers: []encryptionProviderConfiguration{provider, {Identity: &struct{}{}}},
+			},
+		},
+	})
+}
+
+// addEncryptionKey adds a new aescbc key right after the key that is used for
+// encryption, so that it is only used for decryption until it is promoted.
+func addEncryptionKey(data []byte) ([]byte, error) {
+	config, keys, err := parseEncryptionKeys(data)
+	if err != nil {
+		return nil, err
+	}
+	newest, err := newestEncryptionKey(keys.Keys)
+	if err != nil {
+		return nil, err
+	}
+	key, err := generateEncryptionKey(newest + 1)
+	if err != nil {
+		return nil, err
+	}
+	keys.Keys = append(keys.Keys[:1], append([]encryptionKey{key}, keys.Keys[1:]...)...)
+	return marshalEncryptionConfig(config)
+}
+
+// promoteEncryptionKey makes the newest aescbc key the key that is used for
+// encryption. It returns whether the keys changed.
+func promoteEncryptionKey(data []byte) ([]byte, bool, error) {
+	config, keys, err := parseEncryptionKeys(data)
+	if err != nil {
+		return nil, false, err
+	}
+	newest, err := newestEncryptionKey(keys.Keys)
+	if err != nil {
+		return nil, false, err
+	}
+	for i, key := range keys.Keys {
+		if key.Name != encryptionKeyName(newest) {
+			continue
+		}
+		if i == 0 {
+			return data, false, nil
+		}
+		keys.Keys = append([]encryptionKey{key}, append(keys.Keys[:i:i], keys.Keys[i+1:]...)...)
+		break
+	}
+	out, err := marshalEncryptionConfig(config)
+	return out, true, err
+}
+
+// pruneEncryptionKeys removes every aescbc key but the key that is used for
+// encryption. It returns whether the keys changed.
+func pruneEncryptionKeys(data []byte) ([]byte, bool, error) {
+	config, keys, err := parseEncryptionKeys(data)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(keys.Keys) == 1 {
+		return data, false, nil
+	}
+	keys.Keys = keys.Keys[:1]
+	out, err := marshalEncryptionConfig(config)
+	return out, true, err
+}
+
+// isPromoted returns whether the newest aescbc key is used for encryption.
+func isPromoted(data []byte) (bool, error) {
+	_, keys, err := parseEncryptionKeys(data)
+	if err != nil {
+		return false, err
+	}
+	newest, err := newestEncryptionKey(keys.Keys)
+	if err != nil {
+		return false, err
+	}
+	return keys.Keys[0].Name == encryptionKeyName(newest), nil
+}
+
+func parseEncryptionKeys(data []byte) (*encryptionConfiguration, *encryptionKeysConfiguration, error) {
+	config := &encryptionConfiguration{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse encryption config: %w", err)
+	}
+	for _, resource := range config.Resources {
+		for _, provider := range resource.Providers {
+			if provider.AESCBC != nil && len(provider.AESCBC.Keys) > 0 {
+				return config, provider.AESCBC, nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("encryption config has no aescbc keys")
+}
+
+func newestEncryptionKey(keys []encryptionKey) (int, error) {
+	newest := 0
+	for _, key := range keys {
+		n, err := strconv.Atoi(strings.TrimPrefix(key.Name, encryptionKeyNamePrefix))
+		if err != nil {
+			return 0, fmt.Errorf("unexpected encryption key name %q", key.Name)
+		}
+		if n > newest {
+			newest = n
+		}
+	}
+	return newest, nil
+}
+
+func generateEncryptionKey(n int) (encryptionKey, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return encryptionKey{}, fmt.Errorf("failed to generate encryption key: %w", err)
+	}
+	return encryptionKey{
+		Name:   encryptionKeyName(n),
+		Secret: base64.StdEncoding.EncodeToString(secret),
+	}, nil
+}
+
+func encryptionKeyName(n int) string {
+	return encryptionKeyNamePrefix + strconv.Itoa(n)
+}
+
+func marshalEncryptionConfig(config *encryptionConfiguration) ([]byte, error) {
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize encryption config: %w", err)
+	}
+	return out, nil
+}
+
+func encryptionConfigHash(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+func aescbcKeyRotation(spec *hyperv1.SecretEncryptionSpec) string {
+	if spec == nil || spec.AESCBC == nil {
+		return ""
+	}
+	return spec.AESCBC.KeyRotation
+}
@@ -0,0 +1,195 @@
+package hostedcontrolplane
+
+import (
+	"context"
+	"testing"
+
+	imageapi "github.com/openshift/api/image/v1"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests/kubeapiserver"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+)
+
+func TestEncryptionKeyRotation(t *testing.T) {
+	config, err := generateEncryptionConfig(&hyperv1.SecretEncryptionSpec{Type: hyperv1.AESCBCSecretEncryptionType})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, []string{"key-1"}, encryptionKeyNames(t, config))
+	assert.True(t, promotedKey(t, config))
+
+	config, err = addEncryptionKey(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, []string{"key-1", "key-2"}, encryptionKeyNames(t, config))
+	assert.False(t, promotedKey(t, config))
+
+	config, promoted, err := promoteEncryptionKey(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.True(t, promoted)
+	assert.Equal(t, []string{"key-2", "key-1"}, encryptionKeyNames(t, config))
+	assert.True(t, promotedKey(t, config))
+
+	_, promoted, err = promoteEncryptionKey(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.False(t, promoted)
+
+	config, err = addEncryptionKey(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, []string{"key-2", "key-3", "key-1"}, encryptionKeyNames(t, config))
+
+	config, pruned, err := pruneEncryptionKeys(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.True(t, pruned)
+	assert.Equal(t, []string{"key-2"}, encryptionKeyNames(t, config), "only the key used for encryption should be kept")
+	_, pruned, err = pruneEncryptionKeys(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.False(t, pruned)
+
+	parsed := &encryptionConfiguration{}
+	if err := yaml.Unmarshal(config, parsed); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	providers := parsed.Resources[0].Providers
+	assert.NotNil(t, providers[len(providers)-1].Identity, "the identity provider should be last")
+}
+
+func TestGenerateKMSEncryptionConfig(t *testing.T) {
+	config, err := generateEncryptionConfig(&hyperv1.SecretEncryptionSpec{Type: hyperv1.KMSSecretEncryptionType})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parsed := &encryptionConfiguration{}
+	if err := yaml.Unmarshal(config, parsed); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(parsed.Resources) != 1 {
+		t.Fatalf("expected 1 resource configuration, got %d", len(parsed.Resources))
+	}
+	assert.Equal(t, []string{"secrets"}, parsed.Resources[0].Resources)
+	providers := parsed.Resources[0].Providers
+	if len(providers) != 2 || providers[0].KMS == nil {
+		t.Fatalf("expected a kms and an identity provider, got %+v", providers)
+	}
+	assert.Equal(t, kmsPluginEndpoint, providers[0].KMS.Endpoint)
+	assert.NotNil(t, providers[1].Identity)
+
+	_, err = addEncryptionKey(config)
+	assert.Error(t, err, "kms encryption configs have no keys to rotate")
+}
+
+func TestIsPromotedCorruptConfig(t *testing.T) {
+	_, err := isPromoted([]byte("resources: {"))
+	assert.Error(t, err)
+
+	config := []byte(`resources:
+- resources: ["secrets"]
+  providers:
+  - aescbc:
+      keys:
+      - name: first
+        secret: c2VjcmV0
+`)
+	_, err = isPromoted(config)
+	assert.Error(t, err, "key names must be numbered")
+}
+
+func TestMigrateEncryptedSecrets(t *testing.T) {
+	ctx := context.Background()
+	hcp := &hyperv1.HostedControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "example", Name: "example"}}
+	releaseImage := releaseinfo.WithComponentImages(&releaseinfo.ReleaseImage{ImageStream: &imageapi.ImageStream{}}, map[string]string{
+		"cli": "quay.io/openshift/cli@sha256:abc",
+	})
+	c := newApplyPatchClient()
+	r := &HostedControlPlaneReconciler{Client: c, Log: ctrl.Log.WithName("test")}
+	jobKey := client.ObjectKey{Namespace: "example", Name: kubeapiserver.EncryptionMigrationJobName}
+
+	migrated, err := r.migrateEncryptedSecrets(ctx, hcp, releaseImage, "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.False(t, migrated)
+	job := &batchv1.Job{}
+	if err := c.Get(ctx, jobKey, job); err != nil {
+		t.Fatalf("expected a migration job: %v", err)
+	}
+	assert.Equal(t, "quay.io/openshift/cli@sha256:abc", job.Spec.Template.Spec.Containers[0].Image)
+
+	migrated, err = r.migrateEncryptedSecrets(ctx, hcp, releaseImage, "2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.False(t, migrated)
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, jobKey, &batchv1.Job{})), "the job of an earlier rotation should be removed")
+
+	if _, err := r.migrateEncryptedSecrets(ctx, hcp, releaseImage, "2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Get(ctx, jobKey, job); err != nil {
+		t.Fatalf("expected a migration job: %v", err)
+	}
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}}
+	if err := c.Update(ctx, job); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = r.migrateEncryptedSecrets(ctx, hcp, releaseImage, "2")
+	assert.Error(t, err)
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, jobKey, &batchv1.Job{})), "a failed job should be removed to be retried")
+
+	if _, err := r.migrateEncryptedSecrets(ctx, hcp, releaseImage, "2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Get(ctx, jobKey, job); err != nil {
+		t.Fatalf("expected a migration job: %v", err)
+	}
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	if err := c.Update(ctx, job); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	migrated, err = r.migrateEncryptedSecrets(ctx, hcp, releaseImage, "2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.True(t, migrated)
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, jobKey, &batchv1.Job{})), "a completed job should be removed")
+}
+
+func encryptionKeyNames(t *testing.T, config []byte) []string {
+	_, keys, err := parseEncryptionKeys(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var names []string
+	for _, key := range keys.Keys {
+		names = append(names, key.Name)
+	}
+	return names
+}
+
+func promotedKey(t *testing.T, config []byte) bool {
+	promoted, err := isPromoted(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return promoted
+}
@@ -0,0 +1,30 @@
+package hostedcontrolplane
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+	"openshift.io/hypershift/support/supportedversion"
+)
+
+// validateReleaseVersion reports whether the control plane operator supports
+// the version of a release in the UnsupportedRelease condition, and returns an
+// error if it does not.
+func validateReleaseVersion(hcp *hyperv1.HostedControlPlane, releaseImage *releaseinfo.ReleaseImage) error {
+	version, err := semver.Parse(releaseImage.Version())
+	if err != nil {
+		err = fmt.Errorf("cannot parse release version (%s): %w", releaseImage.Version(), err)
+	} else {
+		err = supportedversion.IsSupported(version)
+	}
+	if err != nil {
+		setConditionByType(&hcp.Status.Conditions, hyperv1.UnsupportedRelease, hyperv1.ConditionTrue, "UnsupportedVersion",
+			fmt.Sprintf("%v, supported versions are %s", err, supportedversion.String()))
+		return err
+	}
+	setConditionByType(&hcp.Status.Conditions, hyperv1.UnsupportedRelease, hyperv1.ConditionFalse, "AsExpected", fmt.Sprintf("Release version %s is supported", version))
+	return nil
+}
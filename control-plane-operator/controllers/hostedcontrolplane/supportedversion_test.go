@@ -0,0 +1,34 @@
+package hostedcontrolplane
+
+import (
+	"testing"
+
+	imageapi "github.com/openshift/api/image/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/control-plane-operator/releaseinfo"
+)
+
+func TestValidateReleaseVersion(t *testing.T) {
+	release := func(version string) *releaseinfo.ReleaseImage {
+		return &releaseinfo.ReleaseImage{ImageStream: &imageapi.ImageStream{ObjectMeta: metav1.ObjectMeta{Name: version}}}
+	}
+	hcp := &hyperv1.HostedControlPlane{}
+	if err := validateReleaseVersion(hcp, release("4.7.0")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	condition := getConditionByType(hcp.Status.Conditions, hyperv1.UnsupportedRelease)
+	if condition == nil {
+		t.Fatalf("expected an %s condition", hyperv1.UnsupportedRelease)
+	}
+	assert.Equal(t, hyperv1.ConditionFalse, condition.Status)
+
+	for _, version := range []string{"4.4.0", "4.99.0", "latest"} {
+		assert.Error(t, validateReleaseVersion(hcp, release(version)), version)
+		condition = getConditionByType(hcp.Status.Conditions, hyperv1.UnsupportedRelease)
+		assert.Equal(t, hyperv1.ConditionTrue, condition.Status, version)
+		assert.Equal(t, "UnsupportedVersion", condition.Reason, version)
+	}
+}
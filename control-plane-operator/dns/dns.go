@@ -0,0 +1,71 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// Provider manages the DNS records that give control plane endpoints stable
+// names.
+type Provider interface {
+	// EnsureRecord creates or updates the record for name so that it resolves
+	// to target, which is either a host name or an IP address.
+	EnsureRecord(ctx context.Context, name, target string) error
+	// DeleteRecord removes the record for name. Deleting a record that does
+	// not exist is not an error.
+	DeleteRecord(ctx context.Context, name string) error
+}
+
+// RecordType returns the type of record needed to resolve a name to target.
+func RecordType(target string) string {
+	if ip := net.ParseIP(target); ip != nil {
+		if ip.To4() != nil {
+			return "A"
+		}
+		return "AAAA"
+	}
+	return "CNAME"
+}
+
+// Resolver looks up the addresses of host names. It is implemented by
+// *net.Resolver.
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// Resolves returns whether name already resolves to target, that is to at
+// least one of the addresses of target. Names and targets that don't resolve
+// yet are not an error.
+func Resolves(ctx context.Context, resolver Resolver, name, target string) (bool, error) {
+	addresses, err := lookupHost(ctx, resolver, name)
+	if err != nil || len(addresses) == 0 {
+		return false, err
+	}
+	targetAddresses := []string{target}
+	if net.ParseIP(target) == nil {
+		targetAddresses, err = lookupHost(ctx, resolver, target)
+		if err != nil {
+			return false, err
+		}
+	}
+	for _, address := range addresses {
+		for _, targetAddress := range targetAddresses {
+			if net.ParseIP(address).Equal(net.ParseIP(targetAddress)) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// lookupHost looks up the addresses of a host, returning none for hosts that
+// don't resolve.
+func lookupHost(ctx context.Context, resolver Resolver, host string) ([]string, error) {
+	addresses, err := resolver.LookupHost(ctx, host)
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return nil, nil
+	}
+	return addresses, err
+}
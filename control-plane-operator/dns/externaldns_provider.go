@@ -0,0 +1,77 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ Provider = (*ExternalDNSProvider)(nil)
+
+var dnsEndpointGVK = schema.GroupVersionKind{
+	Group:   "externaldns.k8s.io",
+	Version: "v1alpha1",
+	Kind:    "DNSEndpoint",
+}
+
+// ExternalDNSProvider publishes records as ExternalDNS DNSEndpoint resources,
+// leaving it to an ExternalDNS deployment on the management cluster to sync
+// them to the DNS service. Each record is stored in a DNSEndpoint named after
+// the record, with the wildcard label of a wildcard record spelled out.
+type ExternalDNSProvider struct {
+	Client    client.Client
+	Namespace string
+	// TTL is the record TTL in seconds. Zero uses the ExternalDNS default.
+	TTL int64
+}
+
+func (p *ExternalDNSProvider) EnsureRecord(ctx context.Context, name, target string) error {
+	endpoint := map[string]interface{}{
+		"dnsName":    name,
+		"recordType": RecordType(target),
+		"targets":    []interface{}{target},
+	}
+	if p.TTL > 0 {
+		endpoint["recordTTL"] = p.TTL
+	}
+
+	obj := p.dnsEndpoint(name)
+	err := p.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get dns endpoint %s: %w", name, err)
+	}
+	exists := err == nil
+	if err := unstructured.SetNestedSlice(obj.Object, []interface{}{endpoint}, "spec", "endpoints"); err != nil {
+		return fmt.Errorf("failed to set dns endpoint %s: %w", name, err)
+	}
+	if exists {
+		if err := p.Client.Update(ctx, obj); err != nil {
+			return fmt.Errorf("failed to update dns endpoint %s: %w", name, err)
+		}
+		return nil
+	}
+	if err := p.Client.Create(ctx, obj); err != nil {
+		return fmt.Errorf("failed to create dns endpoint %s: %w", name, err)
+	}
+	return nil
+}
+
+func (p *ExternalDNSProvider) DeleteRecord(ctx context.Context, name string) error {
+	if err := p.Client.Delete(ctx, p.dnsEndpoint(name)); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete dns endpoint %s: %w", name, err)
+	}
+	return nil
+}
+
+func (p *ExternalDNSProvider) dnsEndpoint(name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(dnsEndpointGVK)
+	obj.SetNamespace(p.Namespace)
+	obj.SetName(strings.Replace(name, "*", "wildcard", 1))
+	return obj
+}
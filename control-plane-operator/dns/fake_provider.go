@@ -0,0 +1,54 @@
+package dns
+
+import (
+	"context"
+	"net"
+	"sync"
+)
+
+var (
+	_ Provider = (*FakeProvider)(nil)
+	_ Resolver = (*FakeResolver)(nil)
+)
+
+// FakeProvider keeps records in memory. It is meant for tests.
+type FakeProvider struct {
+	// Records maps record names to their targets.
+	Records map[string]string
+
+	lock sync.Mutex
+}
+
+func (p *FakeProvider) EnsureRecord(ctx context.Context, name, target string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.Records == nil {
+		p.Records = map[string]string{}
+	}
+	p.Records[name] = target
+	return nil
+}
+
+func (p *FakeProvider) DeleteRecord(ctx context.Context, name string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.Records, name)
+	return nil
+}
+
+// FakeResolver resolves host names from memory. It is meant for tests.
+type FakeResolver struct {
+	// Addresses maps host names to their addresses. Other hosts are not
+	// found.
+	Addresses map[string][]string
+}
+
+func (r *FakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	addresses, ok := r.Addresses[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addresses, nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,6 +18,7 @@ import (
 
 	hyperapi "openshift.io/hypershift/api"
 	"openshift.io/hypershift/control-plane-operator/controllers/hostedcontrolplane"
+	"openshift.io/hypershift/control-plane-operator/dns"
 	"openshift.io/hypershift/control-plane-operator/releaseinfo"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -139,6 +141,11 @@ func NewStartCommand() *cobra.Command {
 		if err := (&hostedcontrolplane.HostedControlPlaneReconciler{
 			Client:          mgr.GetClient(),
 			ReleaseProvider: releaseProvider,
+			DNSProvider: &dns.ExternalDNSProvider{
+				Client:    mgr.GetClient(),
+				Namespace: namespace,
+			},
+			DNSResolver: net.DefaultResolver,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "hosted-control-plane")
 			os.Exit(1)
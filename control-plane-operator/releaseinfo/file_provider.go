@@ -0,0 +1,32 @@
+package releaseinfo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	imageapi "github.com/openshift/api/image/v1"
+)
+
+var _ Provider = (*FileProvider)(nil)
+
+// FileProvider reads the release image metadata from a local copy of the
+// /release-manifests/image-references file of a release image, so that
+// releases can be inspected without a cluster. The same metadata is returned
+// for any image.
+type FileProvider struct {
+	Path string
+}
+
+func (p *FileProvider) Lookup(ctx context.Context, image string) (*ReleaseImage, error) {
+	data, err := ioutil.ReadFile(p.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image references: %w", err)
+	}
+	var imageStream imageapi.ImageStream
+	if err := json.Unmarshal(data, &imageStream); err != nil {
+		return nil, fmt.Errorf("couldn't read %s as a serialized ImageStream: %w", p.Path, err)
+	}
+	return &ReleaseImage{ImageStream: &imageStream}, nil
+}
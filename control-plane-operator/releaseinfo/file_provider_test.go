@@ -0,0 +1,48 @@
+package releaseinfo
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const imageReferences = `{
+  "kind": "ImageStream",
+  "apiVersion": "image.openshift.io/v1",
+  "metadata": {"name": "4.7.0"},
+  "spec": {
+    "tags": [
+      {
+        "name": "hyperkube",
+        "annotations": {"io.openshift.build.versions": "kubernetes=1.20.0"},
+        "from": {"kind": "DockerImage", "name": "quay.io/ocp/release@sha256:hyperkube"}
+      }
+    ]
+  }
+}`
+
+func TestFileProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "image-references")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "image-references")
+	if err := ioutil.WriteFile(path, []byte(imageReferences), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	release, err := (&FileProvider{Path: path}).Lookup(context.Background(), "quay.io/ocp/release:4.7.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "4.7.0", release.Version())
+	assert.Equal(t, map[string]string{"hyperkube": "quay.io/ocp/release@sha256:hyperkube"}, release.ComponentImages())
+
+	_, err = (&FileProvider{Path: filepath.Join(dir, "missing")}).Lookup(context.Background(), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package releaseinfo
+
+import (
+	"sort"
+
+	imageapi "github.com/openshift/api/image/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// StaticComponentImages are the images of the control plane components that
+// the release doesn't ship.
+var StaticComponentImages = map[string]string{
+	"etcd-operator":       "quay.io/coreos/etcd-operator:v0.9.4",
+	"etcd-member":         "quay.io/coreos/etcd:v3.4.9",
+	"konnectivity-server": "k8s.gcr.io/kas-network-proxy/proxy-server:v0.0.27",
+	"konnectivity-agent":  "k8s.gcr.io/kas-network-proxy/proxy-agent:v0.0.27",
+	"openvpn":             "quay.io/hypershift/openvpn:latest",
+}
+
+// NetworkProxyComponent is the release component that ships the konnectivity
+// server and agent binaries in /usr/bin.
+const NetworkProxyComponent = "apiserver-network-proxy"
+
+// shippedAs are the release components that ship StaticComponentImages in the
+// releases that include them.
+var shippedAs = map[string]string{
+	"konnectivity-server": NetworkProxyComponent,
+	"konnectivity-agent":  NetworkProxyComponent,
+}
+
+// WithStaticComponentImages returns a copy of a release image with the
+// StaticComponentImages it doesn't ship added to its components, so that they
+// are mirrored and overridden like the components of the release. Components
+// the release ships under another name take the image of the release.
+func WithStaticComponentImages(image *ReleaseImage) *ReleaseImage {
+	shipped := image.ComponentImages()
+	images := map[string]string{}
+	for component, pullSpec := range StaticComponentImages {
+		if _, ok := shipped[component]; ok {
+			continue
+		}
+		if releaseImage, ok := shipped[shippedAs[component]]; ok {
+			pullSpec = releaseImage
+		}
+		images[component] = pullSpec
+	}
+	return WithComponentImages(image, images)
+}
+
+// WithComponentImages returns a copy of a release image with the images of
+// components replaced by the given images. Replaced components keep the
+// version annotations of the release, components the release doesn't ship are
+// added.
+func WithComponentImages(image *ReleaseImage, images map[string]string) *ReleaseImage {
+	if len(images) == 0 {
+		return image
+	}
+	overridden := &ReleaseImage{ImageStream: image.ImageStream.DeepCopy()}
+	components := make([]string, 0, len(images))
+	for component := range images {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+	for _, component := range components {
+		found := false
+		for i := range overridden.Spec.Tags {
+			tag := &overridden.Spec.Tags[i]
+			if tag.Name != component {
+				continue
+			}
+			tag.From = &corev1.ObjectReference{Kind: "DockerImage", Name: images[component]}
+			found = true
+		}
+		if !found {
+			overridden.Spec.Tags = append(overridden.Spec.Tags, imageapi.TagReference{
+				Name: component,
+				From: &corev1.ObjectReference{Kind: "DockerImage", Name: images[component]},
+			})
+		}
+	}
+	return overridden
+}
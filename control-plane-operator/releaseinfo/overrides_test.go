@@ -0,0 +1,70 @@
+package releaseinfo
+
+import (
+	"testing"
+
+	imageapi "github.com/openshift/api/image/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestWithComponentImages(t *testing.T) {
+	release := &ReleaseImage{ImageStream: &imageapi.ImageStream{}}
+	release.Name = "4.7.0"
+	release.Spec.Tags = []imageapi.TagReference{
+		{
+			Name:        "hyperkube",
+			Annotations: map[string]string{annotationBuildVersions: "kubernetes=1.20.0"},
+			From:        &corev1.ObjectReference{Name: "quay.io/ocp/release@sha256:hyperkube"},
+		},
+		{
+			Name: "etcd",
+			From: &corev1.ObjectReference{Name: "quay.io/ocp/release@sha256:etcd"},
+		},
+	}
+
+	overridden := WithComponentImages(release, map[string]string{
+		"hyperkube":    "quay.io/dev/hyperkube:fix",
+		"new-operator": "quay.io/dev/new-operator:latest",
+	})
+	assert.Equal(t, map[string]string{
+		"hyperkube":    "quay.io/dev/hyperkube:fix",
+		"etcd":         "quay.io/ocp/release@sha256:etcd",
+		"new-operator": "quay.io/dev/new-operator:latest",
+	}, overridden.ComponentImages())
+	versions, err := overridden.ComponentVersions()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "1.20.0", versions["kubernetes"], "overridden components should keep the versions of the release")
+	assert.Equal(t, "quay.io/ocp/release@sha256:hyperkube", release.ComponentImages()["hyperkube"], "the looked up release should not be modified")
+}
+
+func TestWithStaticComponentImages(t *testing.T) {
+	release := &ReleaseImage{ImageStream: &imageapi.ImageStream{}}
+	release.Spec.Tags = []imageapi.TagReference{
+		{
+			Name: "openvpn",
+			From: &corev1.ObjectReference{Name: "quay.io/ocp/release@sha256:openvpn"},
+		},
+	}
+	images := WithStaticComponentImages(release).ComponentImages()
+	assert.Equal(t, "quay.io/ocp/release@sha256:openvpn", images["openvpn"], "components the release ships should be taken from the release")
+	assert.Equal(t, StaticComponentImages["etcd-operator"], images["etcd-operator"])
+	assert.Equal(t, StaticComponentImages["konnectivity-server"], images["konnectivity-server"])
+	assert.Equal(t, StaticComponentImages["konnectivity-agent"], images["konnectivity-agent"])
+}
+
+func TestWithStaticComponentImagesNetworkProxy(t *testing.T) {
+	release := &ReleaseImage{ImageStream: &imageapi.ImageStream{}}
+	release.Spec.Tags = []imageapi.TagReference{
+		{
+			Name: NetworkProxyComponent,
+			From: &corev1.ObjectReference{Name: "quay.io/ocp/release@sha256:proxy"},
+		},
+	}
+	images := WithStaticComponentImages(release).ComponentImages()
+	assert.Equal(t, "quay.io/ocp/release@sha256:proxy", images["konnectivity-server"], "the konnectivity server should be taken from the release")
+	assert.Equal(t, "quay.io/ocp/release@sha256:proxy", images["konnectivity-agent"], "the konnectivity agent should be taken from the release")
+	assert.Equal(t, StaticComponentImages["etcd-member"], images["etcd-member"])
+}
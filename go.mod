@@ -26,6 +26,7 @@ require (
 	sigs.k8s.io/cluster-api-provider-aws v0.6.3
 	sigs.k8s.io/controller-runtime v0.7.0-alpha.6.0.20201109223643-114431a4df15
 	sigs.k8s.io/controller-tools v0.3.0
+	sigs.k8s.io/yaml v1.2.0
 )
 
 replace sigs.k8s.io/controller-tools => github.com/vincepri/controller-tools v0.2.0-beta.2.0.20201007210946-d01e24361430
@@ -0,0 +1,83 @@
+package ingressstatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+const (
+	routerNamespace   = "openshift-ingress"
+	routerServiceName = "router-default"
+)
+
+// IngressStatusReconciler publishes the address of the load balancer in front
+// of the guest's default ingress controller on the HostedControlPlane status.
+type IngressStatusReconciler struct {
+	// Client is a client that allows access to the management cluster
+	Client client.Client
+
+	// Namespace is the namespace where the control plane of the cluster
+	// lives on the management server. The HostedControlPlane has the same name.
+	Namespace string
+
+	// ServiceLister is a lister for target cluster services in the router namespace
+	ServiceLister corelisters.ServiceLister
+
+	Log logr.Logger
+}
+
+func (r *IngressStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if req.Namespace != routerNamespace || req.Name != routerServiceName {
+		return ctrl.Result{}, nil
+	}
+	r.Log.Info("Reconciling", "service", req.NamespacedName.String())
+
+	endpoint := ""
+	svc, err := r.ServiceLister.Services(routerNamespace).Get(routerServiceName)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("cannot fetch router service: %w", err)
+	}
+	if err == nil {
+		endpoint = loadBalancerAddress(svc)
+	}
+
+	hcp := &hyperv1.HostedControlPlane{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: r.Namespace, Name: r.Namespace}, hcp); err != nil {
+		return ctrl.Result{}, fmt.Errorf("cannot fetch hosted control plane: %w", err)
+	}
+	if hcp.Status.IngressEndpoint == endpoint {
+		return ctrl.Result{}, nil
+	}
+	r.Log.Info("Updating ingress endpoint", "endpoint", endpoint)
+	hcp.Status.IngressEndpoint = endpoint
+	if err := r.Client.Status().Update(ctx, hcp); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update hosted control plane status: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+func loadBalancerAddress(svc *corev1.Service) string {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return ""
+	}
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		if ingress.Hostname != "" {
+			return ingress.Hostname
+		}
+		if ingress.IP != "" {
+			return ingress.IP
+		}
+	}
+	return ""
+}
@@ -0,0 +1,48 @@
+package ingressstatus
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadBalancerAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		svcType  corev1.ServiceType
+		ingress  []corev1.LoadBalancerIngress
+		expected string
+	}{
+		{
+			name:     "hostname",
+			svcType:  corev1.ServiceTypeLoadBalancer,
+			ingress:  []corev1.LoadBalancerIngress{{Hostname: "router.elb.example.com"}},
+			expected: "router.elb.example.com",
+		},
+		{
+			name:     "ip",
+			svcType:  corev1.ServiceTypeLoadBalancer,
+			ingress:  []corev1.LoadBalancerIngress{{IP: "192.0.2.10"}},
+			expected: "192.0.2.10",
+		},
+		{
+			name:    "not provisioned",
+			svcType: corev1.ServiceTypeLoadBalancer,
+		},
+		{
+			name:    "not a load balancer",
+			svcType: corev1.ServiceTypeClusterIP,
+			ingress: []corev1.LoadBalancerIngress{{IP: "192.0.2.10"}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := &corev1.Service{}
+			svc.Spec.Type = test.svcType
+			svc.Status.LoadBalancer.Ingress = test.ingress
+			assert.Equal(t, test.expected, loadBalancerAddress(svc))
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package ingressstatus
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	hyperapi "openshift.io/hypershift/api"
+	"openshift.io/hypershift/hosted-cluster-config-operator/operator"
+)
+
+func Setup(cfg *operator.HostedClusterConfigOperatorConfig) error {
+	hostClient, err := client.New(cfg.Config(), client.Options{Scheme: hyperapi.Scheme})
+	if err != nil {
+		return err
+	}
+	services := cfg.TargetKubeInformersForNamespace(routerNamespace).Core().V1().Services()
+	reconciler := &IngressStatusReconciler{
+		Client:        hostClient,
+		Namespace:     cfg.Namespace(),
+		ServiceLister: services.Lister(),
+		Log:           cfg.Logger().WithName("IngressStatus"),
+	}
+	c, err := controller.New("ingress-status", cfg.Manager(), controller.Options{Reconciler: reconciler})
+	if err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Informer{Informer: services.Informer()}, &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+	return nil
+}
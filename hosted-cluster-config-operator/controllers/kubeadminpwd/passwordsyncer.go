@@ -0,0 +1,97 @@
+package kubeadminpwd
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/crypto/bcrypt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	PasswordSecret    = "kubeadmin-password"
+	PasswordSecretKey = "password"
+	KubeAdminKey      = "kubeadmin"
+)
+
+// PasswordSyncer keeps the kubeadmin secret of the target cluster in sync with
+// the kubeadmin password secret in the control plane namespace. Removing the
+// password secret removes the kubeadmin secret, which disables kubeadmin.
+type PasswordSyncer struct {
+	// TargetClient is a client for the target cluster
+	TargetClient kubeclient.Interface
+
+	// Log is the logger for this controller
+	Log logr.Logger
+
+	// Namespace is the namespace where the control plane of the cluster
+	// lives on the management server
+	Namespace string
+
+	// PasswordSecretLister is a lister for secrets in the control plane namespace
+	PasswordSecretLister corelisters.SecretLister
+
+	// TargetSecretLister is a lister for target cluster secrets
+	TargetSecretLister corelisters.SecretLister
+}
+
+func (s *PasswordSyncer) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	s.Log.Info("Begin reconciling")
+
+	passwordSecret, err := s.PasswordSecretLister.Secrets(s.Namespace).Get(PasswordSecret)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	hasPassword := err == nil
+	targetSecret, err := s.TargetSecretLister.Secrets(metav1.NamespaceSystem).Get(KubeAdminSecret)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	hasTargetSecret := err == nil
+
+	if !hasPassword {
+		if !hasTargetSecret {
+			return ctrl.Result{}, nil
+		}
+		s.Log.Info("Removing kubeadmin secret")
+		err := s.TargetClient.CoreV1().Secrets(metav1.NamespaceSystem).Delete(ctx, KubeAdminSecret, metav1.DeleteOptions{})
+		if errors.IsNotFound(err) {
+			err = nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	password := passwordSecret.Data[PasswordSecretKey]
+	// Hashes are salted, so compare against the password rather than
+	// generating a new hash that would always differ
+	if hasTargetSecret && bcrypt.CompareHashAndPassword(targetSecret.Data[KubeAdminKey], password) == nil {
+		return ctrl.Result{}, nil
+	}
+	hash, err := bcrypt.GenerateFromPassword(password, bcrypt.DefaultCost)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !hasTargetSecret {
+		s.Log.Info("Creating kubeadmin secret")
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceSystem,
+				Name:      KubeAdminSecret,
+			},
+			Data: map[string][]byte{KubeAdminKey: hash},
+		}
+		_, err = s.TargetClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, secret, metav1.CreateOptions{})
+		return ctrl.Result{}, err
+	}
+	s.Log.Info("Updating kubeadmin secret")
+	secret := targetSecret.DeepCopy()
+	secret.Data = map[string][]byte{KubeAdminKey: hash}
+	_, err = s.TargetClient.CoreV1().Secrets(metav1.NamespaceSystem).Update(ctx, secret, metav1.UpdateOptions{})
+	return ctrl.Result{}, err
+}
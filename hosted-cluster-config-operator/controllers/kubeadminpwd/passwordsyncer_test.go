@@ -0,0 +1,100 @@
+package kubeadminpwd
+
+import (
+	"context"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordSyncerReconcile(t *testing.T) {
+	tests := []struct {
+		name             string
+		password         string
+		existingPassword string
+		expectSecret     bool
+	}{
+		{
+			name:         "create secret",
+			password:     "12345",
+			expectSecret: true,
+		},
+		{
+			name:             "keep secret",
+			password:         "12345",
+			existingPassword: "12345",
+			expectSecret:     true,
+		},
+		{
+			name:             "rotate secret",
+			password:         "67890",
+			existingPassword: "12345",
+			expectSecret:     true,
+		},
+		{
+			name:             "remove secret",
+			existingPassword: "12345",
+		},
+		{
+			name: "no secret",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			passwordIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			if len(test.password) > 0 {
+				passwordIndexer.Add(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: hostedNamespace,
+						Name:      PasswordSecret,
+					},
+					Data: map[string][]byte{PasswordSecretKey: []byte(test.password)},
+				})
+			}
+			targetIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			var targetObjects []runtime.Object
+			if len(test.existingPassword) > 0 {
+				hash, err := bcrypt.GenerateFromPassword([]byte(test.existingPassword), bcrypt.MinCost)
+				assert.NoError(t, err)
+				secret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: metav1.NamespaceSystem,
+						Name:      KubeAdminSecret,
+					},
+					Data: map[string][]byte{KubeAdminKey: hash},
+				}
+				targetIndexer.Add(secret)
+				targetObjects = append(targetObjects, secret)
+			}
+			targetClient := fake.NewSimpleClientset(targetObjects...)
+			syncer := &PasswordSyncer{
+				TargetClient:         targetClient,
+				Log:                  ctrl.Log.WithName("reconcile-test"),
+				Namespace:            hostedNamespace,
+				PasswordSecretLister: corelisters.NewSecretLister(passwordIndexer),
+				TargetSecretLister:   corelisters.NewSecretLister(targetIndexer),
+			}
+			_, err := syncer.Reconcile(context.TODO(), ctrl.Request{})
+			assert.NoError(t, err, "Unexpected error")
+
+			secret, err := targetClient.CoreV1().Secrets(metav1.NamespaceSystem).Get(context.TODO(), KubeAdminSecret, metav1.GetOptions{})
+			if !test.expectSecret {
+				assert.True(t, errors.IsNotFound(err), "kubeadmin secret should not exist")
+				return
+			}
+			assert.NoError(t, err)
+			assert.NoError(t, bcrypt.CompareHashAndPassword(secret.Data[KubeAdminKey], []byte(test.password)), "kubeadmin secret should match the password")
+		})
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/informers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"openshift.io/hypershift/hosted-cluster-config-operator/controllers"
@@ -38,5 +41,36 @@ func Setup(cfg *operator.HostedClusterConfigOperatorConfig) error {
 	if err := c.Watch(&source.Informer{Informer: secrets.Informer()}, &handler.EnqueueRequestForObject{}); err != nil {
 		return err
 	}
+
+	passwordInformerFactory := informers.NewSharedInformerFactoryWithOptions(cfg.KubeClient(), controllers.DefaultResync, informers.WithNamespace(cfg.Namespace()))
+	cfg.Manager().Add(manager.RunnableFunc(func(ctx context.Context) error {
+		passwordInformerFactory.Start(ctx.Done())
+		return nil
+	}))
+	passwordSecrets := passwordInformerFactory.Core().V1().Secrets()
+	syncer := &PasswordSyncer{
+		TargetClient:         cfg.TargetKubeClient(),
+		Namespace:            cfg.Namespace(),
+		PasswordSecretLister: passwordSecrets.Lister(),
+		TargetSecretLister:   secrets.Lister(),
+		Log:                  cfg.Logger().WithName("PasswordSyncer"),
+	}
+	c, err = controller.New("kubeadmin-password-syncer", cfg.Manager(), controller.Options{Reconciler: syncer})
+	if err != nil {
+		return err
+	}
+	// Both secrets map to the same work, only changes to them are relevant
+	enqueueSync := handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+		if obj.GetName() != PasswordSecret && obj.GetName() != KubeAdminSecret {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: cfg.Namespace(), Name: PasswordSecret}}}
+	})
+	if err := c.Watch(&source.Informer{Informer: passwordSecrets.Informer()}, enqueueSync); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Informer{Informer: secrets.Informer()}, enqueueSync); err != nil {
+		return err
+	}
 	return nil
 }
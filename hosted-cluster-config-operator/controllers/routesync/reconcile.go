@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/go-logr/logr"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	errorsutil "k8s.io/apimachinery/pkg/util/errors"
+	corelister "k8s.io/client-go/listers/core/v1"
 	"k8s.io/utils/pointer"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -25,6 +28,16 @@ const (
 	httpsServiceName = "router-https"
 
 	routeLabel = "hypershift.openshift.io/cluster"
+
+	// managementRouterName is the router name used for the ingress status
+	// entry that this controller writes back to target cluster routes.
+	managementRouterName = "hypershift-management"
+
+	// managedConfigNamespace and routerCAConfigMap locate the CA that signed
+	// the default certificate of the target cluster's routers.
+	managedConfigNamespace = "openshift-config-managed"
+	routerCAConfigMap      = "router-ca"
+	routerCAKey            = "ca-bundle.crt"
 )
 
 var (
@@ -51,10 +64,16 @@ const (
 // RouteSyncReconciler holds the fields necessary to run the Route Sync reconciliation
 type RouteSyncReconciler struct {
 	HostClient   routeclient.Interface
+	TargetClient routeclient.Interface
 	Namespace    string // Note: the target cluster name and the namespace it resides in are the same
 	TargetLister routelister.RouteLister
 	HostLister   routelister.RouteLister
-	Log          logr.Logger
+	// RouterCALister lists the configmaps of the managed config namespace of
+	// the target cluster. Its router-ca configmap holds the CA that signed the
+	// default certificate of the target cluster's routers, which routes that are
+	// re-encrypted on the host cluster use to validate them.
+	RouterCALister corelister.ConfigMapNamespaceLister
+	Log            logr.Logger
 }
 
 // Reconcile will react to any Route change and reconcile all the remote target routes
@@ -75,6 +94,12 @@ func (r *RouteSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, err
 	}
 
+	routerCA, err := r.routerCA()
+	if err != nil {
+		r.Log.Error(err, "failed to get router ca")
+		return ctrl.Result{}, err
+	}
+
 	routesToCreate := []*routev1.Route{}
 	routesToUpdate := []*routev1.Route{}
 	routesToSkip := []*routev1.Route{}
@@ -83,7 +108,16 @@ func (r *RouteSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	// Walk through each remote target route, and identify which needs
 	// creation, update, or skipping.
 	for _, targetRoute := range targetRoutes {
-		syncRoute, action := r.createSyncRouteFromTarget(currentHostRoutes, targetRoute)
+		if needsDestinationCA(targetRoute) && len(routerCA) == 0 {
+			// The route can't be re-encrypted until the target cluster publishes
+			// the CA of its routers, keep a previously synced route until then.
+			r.Log.Info("Waiting for the router CA of the target cluster", "route", targetRoute.Namespace+"/"+targetRoute.Name)
+			if existingRoute := findRoute(currentHostRoutes, generateRouteName(targetRouteKey(targetRoute), r.Namespace)); existingRoute != nil {
+				routesToSkip = append(routesToSkip, existingRoute)
+			}
+			continue
+		}
+		syncRoute, action := r.createSyncRouteFromTarget(currentHostRoutes, targetRoute, routerCA)
 
 		switch action {
 		case createRoute:
@@ -137,9 +171,112 @@ func (r *RouteSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		}
 	}
 
+	if r.TargetClient != nil {
+		for _, targetRoute := range targetRoutes {
+			ingress := r.targetRouteIngress(currentHostRoutes, targetRoute)
+			if ingress == nil {
+				continue
+			}
+			if err := r.updateTargetRouteStatus(ctx, targetRoute, *ingress); err != nil {
+				r.Log.Error(err, "failed to update target route status", "route", targetRoute.Namespace+"/"+targetRoute.Name)
+				errorList = append(errorList, err)
+			}
+		}
+	}
+
 	return ctrl.Result{}, errorsutil.NewAggregate(errorList)
 }
 
+// targetRouteIngress computes the ingress status entry reported on a target
+// route from the admission state of its host route. The host routers reject
+// a host route whose hostname is already claimed by an older route of another
+// hosted cluster, which is reported as a HostAlreadyClaimed condition. It
+// returns nil if the admission state of the route is not known yet.
+func (r *RouteSyncReconciler) targetRouteIngress(currentRoutes []*routev1.Route, tRoute *routev1.Route) *routev1.RouteIngress {
+	ingress := &routev1.RouteIngress{
+		Host:       tRoute.Spec.Host,
+		RouterName: managementRouterName,
+	}
+
+	syncedRouteName := generateRouteName(targetRouteKey(tRoute), r.Namespace)
+	for _, cRoute := range currentRoutes {
+		if cRoute.Name != syncedRouteName {
+			continue
+		}
+		// Report the admission state of the host route as seen by the host routers
+		for _, hostIngress := range cRoute.Status.Ingress {
+			for _, condition := range hostIngress.Conditions {
+				if condition.Type != routev1.RouteAdmitted {
+					continue
+				}
+				ingress.Conditions = []routev1.RouteIngressCondition{
+					{
+						Type:    routev1.RouteAdmitted,
+						Status:  condition.Status,
+						Reason:  condition.Reason,
+						Message: condition.Message,
+					},
+				}
+				if condition.Status != corev1.ConditionTrue {
+					// A rejection by any of the host routers wins
+					return ingress
+				}
+			}
+		}
+	}
+	if len(ingress.Conditions) == 0 {
+		return nil
+	}
+	return ingress
+}
+
+// updateTargetRouteStatus sets the ingress status entry owned by this controller
+// on the target route, leaving entries of the target cluster's routers untouched.
+func (r *RouteSyncReconciler) updateTargetRouteStatus(ctx context.Context, tRoute *routev1.Route, ingress routev1.RouteIngress) error {
+	route := tRoute.DeepCopy()
+	found := false
+	for i := range route.Status.Ingress {
+		existing := &route.Status.Ingress[i]
+		if existing.RouterName != managementRouterName {
+			continue
+		}
+		found = true
+		if routeIngressEqual(existing, &ingress) {
+			return nil
+		}
+		ingress.Conditions[0].LastTransitionTime = transitionTime(existing, &ingress)
+		*existing = ingress
+	}
+	if !found {
+		now := metav1.Now()
+		ingress.Conditions[0].LastTransitionTime = &now
+		route.Status.Ingress = append(route.Status.Ingress, ingress)
+	}
+	_, err := r.TargetClient.RouteV1().Routes(route.Namespace).UpdateStatus(ctx, route, metav1.UpdateOptions{})
+	return err
+}
+
+func routeIngressEqual(ingress1, ingress2 *routev1.RouteIngress) bool {
+	if ingress1.Host != ingress2.Host || len(ingress1.Conditions) != len(ingress2.Conditions) {
+		return false
+	}
+	for i := range ingress1.Conditions {
+		c1, c2 := ingress1.Conditions[i], ingress2.Conditions[i]
+		if c1.Type != c2.Type || c1.Status != c2.Status || c1.Reason != c2.Reason || c1.Message != c2.Message {
+			return false
+		}
+	}
+	return true
+}
+
+func transitionTime(existing, ingress *routev1.RouteIngress) *metav1.Time {
+	if len(existing.Conditions) > 0 && existing.Conditions[0].Status == ingress.Conditions[0].Status {
+		return existing.Conditions[0].LastTransitionTime
+	}
+	now := metav1.Now()
+	return &now
+}
+
 func hostRouteNeedsDeletion(proposedHostRoutes []*routev1.Route, hostRoute *routev1.Route) bool {
 	for _, route := range proposedHostRoutes {
 		if route.Name == hostRoute.Name {
@@ -149,9 +286,34 @@ func hostRouteNeedsDeletion(proposedHostRoutes []*routev1.Route, hostRoute *rout
 	return true
 }
 
-func (r *RouteSyncReconciler) createSyncRouteFromTarget(currentRoutes []*routev1.Route, tRoute *routev1.Route) (*routev1.Route, processRoute) {
-	targetRouteNamespacedName := fmt.Sprintf("%s-%s", tRoute.Namespace, tRoute.Name)
-	syncedRouteName := generateRouteName(targetRouteNamespacedName, r.Namespace)
+// routerCA returns the CA that signed the default certificate of the target
+// cluster's routers, or an empty string if the target cluster didn't publish
+// it yet.
+func (r *RouteSyncReconciler) routerCA() (string, error) {
+	if r.RouterCALister == nil {
+		return "", nil
+	}
+	configMap, err := r.RouterCALister.Get(routerCAConfigMap)
+	if errors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[routerCAKey], nil
+}
+
+func findRoute(routes []*routev1.Route, name string) *routev1.Route {
+	for _, route := range routes {
+		if route.Name == name {
+			return route
+		}
+	}
+	return nil
+}
+
+func (r *RouteSyncReconciler) createSyncRouteFromTarget(currentRoutes []*routev1.Route, tRoute *routev1.Route, routerCA string) (*routev1.Route, processRoute) {
+	syncedRouteName := generateRouteName(targetRouteKey(tRoute), r.Namespace)
 
 	var existingRoute *routev1.Route
 
@@ -176,9 +338,24 @@ func (r *RouteSyncReconciler) createSyncRouteFromTarget(currentRoutes []*routev1
 		Host: tRoute.Spec.Host,
 	}
 
-	if tRoute.Spec.TLS == nil {
+	switch {
+	case tRoute.Spec.TLS == nil:
 		sRoute.Spec.To = httpRouteTarget
-	} else {
+	case needsDestinationCA(tRoute):
+		sRoute.Spec.To = httpsRouteTarget
+		sRoute.Spec.TLS = &routev1.TLSConfig{
+			// The host router cannot pass through traffic for a certificate that
+			// the target cluster's router doesn't serve by default. Terminate with
+			// the route's certificate and re-encrypt towards the target cluster.
+			Termination:              routev1.TLSTerminationReencrypt,
+			Certificate:              tRoute.Spec.TLS.Certificate,
+			Key:                      tRoute.Spec.TLS.Key,
+			CACertificate:            tRoute.Spec.TLS.CACertificate,
+			DestinationCACertificate: destinationCA(tRoute.Spec.TLS, routerCA),
+			// Use the same edge termination policy as the target route
+			InsecureEdgeTerminationPolicy: tRoute.Spec.TLS.InsecureEdgeTerminationPolicy,
+		}
+	default:
 		sRoute.Spec.To = httpsRouteTarget
 		sRoute.Spec.TLS = &routev1.TLSConfig{
 			// Always pass through to the target cluster's route and allow the target
@@ -203,6 +380,39 @@ func (r *RouteSyncReconciler) createSyncRouteFromTarget(currentRoutes []*routev1
 	return existingRoute, updateRoute
 }
 
+// needsDestinationCA returns true if the target route is re-encrypted on the
+// host cluster, which is the case for edge and reencrypt routes that specify
+// their own serving certificate.
+func needsDestinationCA(tRoute *routev1.Route) bool {
+	return tRoute.Spec.TLS != nil && hasCustomCertificate(tRoute.Spec.TLS)
+}
+
+// destinationCA returns the CAs that the host router trusts when it
+// re-encrypts traffic towards the target cluster's routers. These serve
+// either their default certificate, or the route's own certificate for its
+// host, so both the router CA and the CA of the route are trusted.
+func destinationCA(tls *routev1.TLSConfig, routerCA string) string {
+	if len(tls.CACertificate) == 0 {
+		return routerCA
+	}
+	return strings.TrimRight(routerCA, "\n") + "\n" + tls.CACertificate
+}
+
+// hasCustomCertificate returns true if the TLS configuration of an edge or
+// reencrypt route specifies its own serving certificate.
+func hasCustomCertificate(tls *routev1.TLSConfig) bool {
+	switch tls.Termination {
+	case routev1.TLSTerminationEdge, routev1.TLSTerminationReencrypt:
+		return len(tls.Certificate) > 0 && len(tls.Key) > 0
+	default:
+		return false
+	}
+}
+
+func targetRouteKey(tRoute *routev1.Route) string {
+	return fmt.Sprintf("%s-%s", tRoute.Namespace, tRoute.Name)
+}
+
 func generateRouteName(routeName, clusterName string) string {
 	suffix := fmt.Sprintf("%s-%s", clusterName, routeName)
 	return GetResourceName("childroute", suffix)
@@ -7,9 +7,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	corelister "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -22,6 +25,7 @@ import (
 
 const (
 	testNamespace = "testnamespace"
+	testRouterCA  = "router-ca"
 )
 
 func TestRouteSyncReconcile(t *testing.T) {
@@ -30,6 +34,7 @@ func TestRouteSyncReconcile(t *testing.T) {
 		name               string
 		targetRoutes       []*routev1.Route
 		hostRoutes         []*routev1.Route
+		noRouterCA         bool
 		expectedHostRoutes []*routev1.Route
 	}{
 		{
@@ -92,6 +97,78 @@ func TestRouteSyncReconcile(t *testing.T) {
 				}),
 			},
 		},
+		{
+			name: "reencrypt route with custom certificate",
+			targetRoutes: []*routev1.Route{
+				createTestTargetRoute("testroute", "targetnamespace", "test.example.com", &routev1.TLSConfig{
+					Termination:                   routev1.TLSTerminationEdge,
+					Certificate:                   "cert",
+					Key:                           "key",
+					InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyAllow,
+				}),
+			},
+			expectedHostRoutes: []*routev1.Route{
+				withTLS(createTestHostRoute(generateRouteName("targetnamespace-testroute", testNamespace), testNamespace, "test.example.com", httpsRouteTarget, nil), &routev1.TLSConfig{
+					Termination:                   routev1.TLSTerminationReencrypt,
+					Certificate:                   "cert",
+					Key:                           "key",
+					DestinationCACertificate:      testRouterCA,
+					InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyAllow,
+				}),
+			},
+		},
+		{
+			name: "reencrypt route with custom certificate and ca",
+			targetRoutes: []*routev1.Route{
+				createTestTargetRoute("testroute", "targetnamespace", "test.example.com", &routev1.TLSConfig{
+					Termination:   routev1.TLSTerminationReencrypt,
+					Certificate:   "cert",
+					Key:           "key",
+					CACertificate: "route-ca",
+				}),
+			},
+			expectedHostRoutes: []*routev1.Route{
+				withTLS(createTestHostRoute(generateRouteName("targetnamespace-testroute", testNamespace), testNamespace, "test.example.com", httpsRouteTarget, nil), &routev1.TLSConfig{
+					Termination:              routev1.TLSTerminationReencrypt,
+					Certificate:              "cert",
+					Key:                      "key",
+					CACertificate:            "route-ca",
+					DestinationCACertificate: testRouterCA + "\nroute-ca",
+				}),
+			},
+		},
+		{
+			name: "keep reencrypt route until the router ca is published",
+			targetRoutes: []*routev1.Route{
+				createTestTargetRoute("testroute", "targetnamespace", "test.example.com", &routev1.TLSConfig{
+					Termination: routev1.TLSTerminationEdge,
+					Certificate: "cert",
+					Key:         "key",
+				}),
+				createTestTargetRoute("newroute", "targetnamespace", "new.example.com", &routev1.TLSConfig{
+					Termination: routev1.TLSTerminationEdge,
+					Certificate: "cert",
+					Key:         "key",
+				}),
+			},
+			hostRoutes: []*routev1.Route{
+				withTLS(createTestHostRoute(generateRouteName("targetnamespace-testroute", testNamespace), testNamespace, "test.example.com", httpsRouteTarget, nil), &routev1.TLSConfig{
+					Termination:              routev1.TLSTerminationReencrypt,
+					Certificate:              "cert",
+					Key:                      "key",
+					DestinationCACertificate: "old-router-ca",
+				}),
+			},
+			noRouterCA: true,
+			expectedHostRoutes: []*routev1.Route{
+				withTLS(createTestHostRoute(generateRouteName("targetnamespace-testroute", testNamespace), testNamespace, "test.example.com", httpsRouteTarget, nil), &routev1.TLSConfig{
+					Termination:              routev1.TLSTerminationReencrypt,
+					Certificate:              "cert",
+					Key:                      "key",
+					DestinationCACertificate: "old-router-ca",
+				}),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -106,12 +183,21 @@ func TestRouteSyncReconcile(t *testing.T) {
 				routes: test.hostRoutes,
 			}
 
+			routerCAs := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			if !test.noRouterCA {
+				routerCAs.Add(&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: managedConfigNamespace, Name: routerCAConfigMap},
+					Data:       map[string]string{routerCAKey: testRouterCA},
+				})
+			}
+
 			rsReconciler := &RouteSyncReconciler{
-				HostClient:   hostClient,
-				Namespace:    testNamespace,
-				Log:          log.Log.WithName("testroutesync"),
-				TargetLister: targetLister,
-				HostLister:   hostLister,
+				HostClient:     hostClient,
+				Namespace:      testNamespace,
+				Log:            log.Log.WithName("testroutesync"),
+				TargetLister:   targetLister,
+				HostLister:     hostLister,
+				RouterCALister: corelister.NewConfigMapLister(routerCAs).ConfigMaps(managedConfigNamespace),
 			}
 
 			_, err := rsReconciler.Reconcile(context.TODO(), ctrl.Request{})
@@ -125,6 +211,61 @@ func TestRouteSyncReconcile(t *testing.T) {
 	assert.True(t, true)
 }
 
+func TestRouteSyncHostAdmission(t *testing.T) {
+	ownRouteName := generateRouteName("targetnamespace-testroute", testNamespace)
+	ownRoute := createTestHostRoute(ownRouteName, testNamespace, "test.example.com", httpRouteTarget, nil)
+
+	tests := []struct {
+		name           string
+		hostRoute      *routev1.Route
+		expectedStatus corev1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "host claimed by another cluster",
+			hostRoute:      withAdmissionStatus(ownRoute, corev1.ConditionFalse, "HostAlreadyClaimed"),
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: "HostAlreadyClaimed",
+		},
+		{
+			name:           "admission by host router is reported",
+			hostRoute:      withAdmissionStatus(ownRoute, corev1.ConditionTrue, ""),
+			expectedStatus: corev1.ConditionTrue,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			targetRoute := createTestTargetRoute("testroute", "targetnamespace", "test.example.com", nil)
+			hostClient := fake.NewSimpleClientset(test.hostRoute)
+			targetClient := fake.NewSimpleClientset(targetRoute)
+
+			rsReconciler := &RouteSyncReconciler{
+				HostClient:   hostClient,
+				TargetClient: targetClient,
+				Namespace:    testNamespace,
+				Log:          log.Log.WithName("testroutesync"),
+				TargetLister: &fakeRouteLister{routes: []*routev1.Route{targetRoute}},
+				HostLister:   &fakeRouteLister{routes: []*routev1.Route{test.hostRoute}},
+			}
+
+			_, err := rsReconciler.Reconcile(context.TODO(), ctrl.Request{})
+			assert.NoError(t, err, "unexpected error during Reconcile()")
+
+			validateRoutes(t, hostClient, []*routev1.Route{ownRoute})
+
+			route, err := targetClient.RouteV1().Routes(targetRoute.Namespace).Get(context.TODO(), targetRoute.Name, metav1.GetOptions{})
+			assert.NoError(t, err, "unexpected error fetching target route from fake client")
+			if assert.Len(t, route.Status.Ingress, 1, "unexpected number of ingress status entries") {
+				ingress := route.Status.Ingress[0]
+				assert.Equal(t, managementRouterName, ingress.RouterName)
+				assert.Equal(t, test.expectedStatus, ingress.Conditions[0].Status)
+				assert.Equal(t, test.expectedReason, ingress.Conditions[0].Reason)
+			}
+		})
+	}
+}
+
 func validateRoutes(t *testing.T, client routeclient.Interface, expectedRoutes []*routev1.Route) {
 
 	routes, err := client.RouteV1().Routes(testNamespace).List(context.TODO(), metav1.ListOptions{
@@ -147,7 +288,9 @@ func createTestTargetRoute(name, namespace, host string, tlsConfig *routev1.TLSC
 		Name: "testservice",
 	}
 
-	return createTestHostRoute(name, namespace, host, target, tlsConfig)
+	route := createTestHostRoute(name, namespace, host, target, nil)
+	route.Spec.TLS = tlsConfig
+	return route
 }
 
 func createTestHostRoute(name, namespace, host string, specTo routev1.RouteTargetReference, tlsConfig *routev1.TLSConfig) *routev1.Route {
@@ -176,6 +319,29 @@ func createTestHostRoute(name, namespace, host string, specTo routev1.RouteTarge
 	return route
 }
 
+func withTLS(route *routev1.Route, tlsConfig *routev1.TLSConfig) *routev1.Route {
+	route.Spec.TLS = tlsConfig
+	return route
+}
+
+func withAdmissionStatus(route *routev1.Route, status corev1.ConditionStatus, reason string) *routev1.Route {
+	route = route.DeepCopy()
+	route.Status.Ingress = []routev1.RouteIngress{
+		{
+			Host:       route.Spec.Host,
+			RouterName: "default",
+			Conditions: []routev1.RouteIngressCondition{
+				{
+					Type:   routev1.RouteAdmitted,
+					Status: status,
+					Reason: reason,
+				},
+			},
+		},
+	}
+	return route
+}
+
 func routeListToRuntimeList(routeList []*routev1.Route) []runtime.Object {
 	runtimeList := []runtime.Object{}
 
@@ -40,12 +40,18 @@ func Setup(cfg *operator.HostedClusterConfigOperatorConfig) error {
 	}))
 	hostRoutes := hostInformerFactory.Route().V1().Routes()
 
+	// The router CA of the target cluster, used to re-encrypt routes with
+	// custom certificates
+	routerCAs := cfg.TargetKubeInformersForNamespace(managedConfigNamespace).Core().V1().ConfigMaps()
+
 	reconciler := &RouteSyncReconciler{
-		HostClient:   hostClient,
-		Namespace:    cfg.Namespace(),
-		TargetLister: targetRoutes.Lister(),
-		HostLister:   hostRoutes.Lister(),
-		Log:          cfg.Logger().WithName("RouteSync"),
+		HostClient:     hostClient,
+		TargetClient:   targetClient,
+		Namespace:      cfg.Namespace(),
+		TargetLister:   targetRoutes.Lister(),
+		HostLister:     hostRoutes.Lister(),
+		RouterCALister: routerCAs.Lister().ConfigMaps(managedConfigNamespace),
+		Log:            cfg.Logger().WithName("RouteSync"),
 	}
 	c, err := controller.New("route-sync", cfg.Manager(), controller.Options{Reconciler: reconciler})
 	if err != nil {
@@ -54,6 +60,13 @@ func Setup(cfg *operator.HostedClusterConfigOperatorConfig) error {
 	if err := c.Watch(&source.Informer{Informer: targetRoutes.Informer()}, &handler.EnqueueRequestForObject{}); err != nil {
 		return err
 	}
+	if err := c.Watch(&source.Informer{Informer: routerCAs.Informer()}, controllers.NamedResourceHandler(routerCAConfigMap)); err != nil {
+		return err
+	}
+	// Host route changes carry admission status from the host routers
+	if err := c.Watch(&source.Informer{Informer: hostRoutes.Informer()}, &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
 
 	return nil
 }
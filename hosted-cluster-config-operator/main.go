@@ -18,6 +18,7 @@ import (
 	"openshift.io/hypershift/hosted-cluster-config-operator/controllers/clusterversion"
 	"openshift.io/hypershift/hosted-cluster-config-operator/controllers/cmca"
 	"openshift.io/hypershift/hosted-cluster-config-operator/controllers/infrastatus"
+	"openshift.io/hypershift/hosted-cluster-config-operator/controllers/ingressstatus"
 	"openshift.io/hypershift/hosted-cluster-config-operator/controllers/kubeadminpwd"
 	"openshift.io/hypershift/hosted-cluster-config-operator/controllers/kubeletservingca"
 	"openshift.io/hypershift/hosted-cluster-config-operator/controllers/node"
@@ -51,9 +52,10 @@ var controllerFuncs = map[string]operator.ControllerSetupFunc{
 	"openshift-apiserver-monitor": openshiftapiservermonitor.Setup,
 	// TODO: non-essential, can't statically link to operator
 	//"openshift-controller-manager": openshiftcontrollermanager.Setup,
-	"route-sync":  routesync.Setup,
-	"infrastatus": infrastatus.Setup,
-	"node":        node.Setup,
+	"route-sync":     routesync.Setup,
+	"infrastatus":    infrastatus.Setup,
+	"node":           node.Setup,
+	"ingress-status": ingressstatus.Setup,
 }
 
 type HostedClusterConfigOperator struct {
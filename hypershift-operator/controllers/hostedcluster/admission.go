@@ -0,0 +1,162 @@
+package hostedcluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/support/supportedversion"
+)
+
+// HostedClusterValidator rejects HostedClusters with a release image that the
+// control plane operator does not support. The version of a release image is
+// read from its tag. Images whose tag has no version are admitted with a
+// warning, the control plane operator checks them once it looks up their
+// release and reports the result in the UnsupportedRelease condition. Release
+// images referenced by tag are rejected when image content sources are set,
+// since their mirrors only serve images by digest. The networks and feature
+// gates of a cluster can't be changed once it is created.
+type HostedClusterValidator struct {
+	decoder *admission.Decoder
+}
+
+var _ admission.Handler = &HostedClusterValidator{}
+var _ admission.DecoderInjector = &HostedClusterValidator{}
+
+func (v *HostedClusterValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	hcluster := &hyperv1.HostedCluster{}
+	if err := v.decoder.Decode(req, hcluster); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	oldHCluster := &hyperv1.HostedCluster{}
+	if req.Operation == admissionv1beta1.Update {
+		if err := v.decoder.DecodeRaw(req.OldObject, oldHCluster); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if err := validateUpdate(oldHCluster, hcluster); err != nil {
+			return admission.Denied(err.Error())
+		}
+	}
+	releaseChanged := oldHCluster.Spec.Release.Image != hcluster.Spec.Release.Image
+	var warnings []string
+	// Clusters whose release went out of support can still be updated as
+	// long as their release image is not changed
+	if releaseChanged {
+		verified, err := validateReleaseImage(hcluster.Spec.Release.Image)
+		if err != nil {
+			return admission.Denied(err.Error())
+		}
+		if !verified {
+			warnings = append(warnings, fmt.Sprintf("the version of release image %s can't be read from its tag, the UnsupportedRelease condition reports whether it is supported once its release is looked up", hcluster.Spec.Release.Image))
+		}
+	}
+	if releaseChanged || !equality.Semantic.DeepEqual(oldHCluster.Spec.ImageContentSources, hcluster.Spec.ImageContentSources) {
+		if err := validateMirroredReleaseImage(hcluster.Spec.Release.Image, hcluster.Spec.ImageContentSources); err != nil {
+			return admission.Denied(err.Error())
+		}
+	}
+	return admission.Allowed("").WithWarnings(warnings...)
+}
+
+func (v *HostedClusterValidator) InjectDecoder(decoder *admission.Decoder) error {
+	v.decoder = decoder
+	return nil
+}
+
+// validateReleaseImage returns an error if the version in the tag of a release
+// image is not supported, and whether the image has a version in its tag.
+func validateReleaseImage(image string) (bool, error) {
+	version, ok := releaseImageVersion(image)
+	if !ok {
+		return false, nil
+	}
+	if err := supportedversion.IsSupported(version); err != nil {
+		return true, fmt.Errorf("release image %s is not supported: %w, supported versions are %s", image, err, supportedversion.String())
+	}
+	return true, nil
+}
+
+func validateMirroredReleaseImage(image string, sources []hyperv1.ImageContentSource) error {
+	if len(sources) > 0 && !strings.Contains(image, "@") {
+		return fmt.Errorf("release image %s must be referenced by digest to be pulled from the mirrors of the image content sources", image)
+	}
+	return nil
+}
+
+// validateUpdate returns an error if fields of the spec of a cluster that
+// can't be changed after it is created change. The certificates and the
+// network plugin of the cluster are set up for the networks it is created
+// with, feature sets other than the default prevent upgrades, and stored
+// secrets can only be read with the encryption they were written with.
+func validateUpdate(oldHCluster, hcluster *hyperv1.HostedCluster) error {
+	if oldHCluster.Spec.ServiceCIDR != hcluster.Spec.ServiceCIDR {
+		return fmt.Errorf("the service network of a cluster can't be changed")
+	}
+	if oldHCluster.Spec.PodCIDR != hcluster.Spec.PodCIDR {
+		return fmt.Errorf("the pod network of a cluster can't be changed")
+	}
+	if !equality.Semantic.DeepEqual(oldHCluster.Spec.Networking, hcluster.Spec.Networking) {
+		return fmt.Errorf("the networking configuration of a cluster can't be changed")
+	}
+	if !equality.Semantic.DeepEqual(oldHCluster.Spec.FeatureGates, hcluster.Spec.FeatureGates) {
+		return fmt.Errorf("the feature gates of a cluster can't be changed")
+	}
+	if !equality.Semantic.DeepEqual(withoutKeyRotation(oldHCluster.Spec.SecretEncryption), withoutKeyRotation(hcluster.Spec.SecretEncryption)) {
+		return fmt.Errorf("the secret encryption of a cluster can't be changed, except for rotating its aescbc key")
+	}
+	return nil
+}
+
+// withoutKeyRotation returns a secret encryption configuration without its
+// aescbc key rotation, which can be changed at any time.
+func withoutKeyRotation(spec *hyperv1.SecretEncryptionSpec) *hyperv1.SecretEncryptionSpec {
+	if spec == nil || spec.AESCBC == nil {
+		return spec
+	}
+	spec = spec.DeepCopy()
+	spec.AESCBC.KeyRotation = ""
+	return spec
+}
+
+// releaseTagVersion matches the version at the start of the tag of a release
+// image, which is followed by suffixes such as the architecture, for example
+// 4.7.0-x86_64 or 4.7.0-0.nightly-2021-02-01-000000.
+var releaseTagVersion = regexp.MustCompile(`^v?(\d+)\.(\d+)(?:\.(\d+))?(?:[-+].*)?$`)
+
+// releaseImageVersion returns the version in the tag of a release image, if it
+// has a tag that starts with a version.
+func releaseImageVersion(image string) (semver.Version, bool) {
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+	// A colon before the last slash separates the port of the registry
+	i := strings.LastIndex(name, ":")
+	if i < 0 || strings.Contains(name[i:], "/") {
+		return semver.Version{}, false
+	}
+	match := releaseTagVersion.FindStringSubmatch(name[i+1:])
+	if match == nil {
+		return semver.Version{}, false
+	}
+	var parts [3]uint64
+	for i, part := range match[1:] {
+		if len(part) == 0 {
+			continue
+		}
+		var err error
+		if parts[i], err = strconv.ParseUint(part, 10, 64); err != nil {
+			return semver.Version{}, false
+		}
+	}
+	return semver.Version{Major: parts[0], Minor: parts[1], Patch: parts[2]}, true
+}
@@ -0,0 +1,163 @@
+package hostedcluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/stretchr/testify/assert"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	hyperapi "openshift.io/hypershift/api"
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+func TestValidateReleaseImage(t *testing.T) {
+	tests := []struct {
+		image    string
+		valid    bool
+		verified bool
+	}{
+		{image: "quay.io/openshift-release-dev/ocp-release:4.7.0-x86_64", valid: true, verified: true},
+		{image: "quay.io/openshift-release-dev/ocp-release:4.6.0-rc.4-x86_64", valid: true, verified: true},
+		{image: "quay.io/openshift-release-dev/ocp-release:4.4.30-x86_64", valid: false, verified: true},
+		{image: "quay.io/openshift-release-dev/ocp-release:4.99.0-x86_64", valid: false, verified: true},
+		{image: "registry.ci.openshift.org/ocp/release:4.99.0-0.nightly-2021-02-01-000000", valid: false, verified: true},
+		// The version of images without a version tag is checked by the
+		// control plane operator
+		{image: "quay.io/openshift-release-dev/ocp-release@sha256:8a1b8d0e7e2c2f0b3c6e3a9e0b0c6e2f3d1a4b5c6d7e8f9a0b1c2d3e4f5a6b7c", valid: true},
+		{image: "registry.ci.openshift.org/ocp/release:latest", valid: true},
+		{image: "registry.example.com:5000/ocp/release", valid: true},
+		{image: "registry.example.com:5000/ocp/release:4.4.30", valid: false, verified: true},
+	}
+	for _, test := range tests {
+		verified, err := validateReleaseImage(test.image)
+		if test.valid {
+			assert.NoError(t, err, test.image)
+		} else {
+			assert.Error(t, err, test.image)
+		}
+		assert.Equal(t, test.verified, verified, test.image)
+	}
+}
+
+func TestHostedClusterValidator(t *testing.T) {
+	decoder, err := admission.NewDecoder(hyperapi.Scheme)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	validator := &HostedClusterValidator{}
+	if err := validator.InjectDecoder(decoder); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hostedCluster := func(image string) runtime.RawExtension {
+		hcluster := &hyperv1.HostedCluster{Spec: hyperv1.HostedClusterSpec{Release: hyperv1.Release{Image: image}}}
+		raw, err := json.Marshal(hcluster)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return runtime.RawExtension{Raw: raw}
+	}
+	request := func(operation admissionv1beta1.Operation, oldImage, image string) admission.Request {
+		req := admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{Operation: operation, Object: hostedCluster(image)}}
+		if operation == admissionv1beta1.Update {
+			req.OldObject = hostedCluster(oldImage)
+		}
+		return req
+	}
+	supported := "quay.io/openshift-release-dev/ocp-release:4.7.0-x86_64"
+	unsupported := "quay.io/openshift-release-dev/ocp-release:4.4.30-x86_64"
+
+	assert.True(t, validator.Handle(context.Background(), request(admissionv1beta1.Create, "", supported)).Allowed)
+	assert.False(t, validator.Handle(context.Background(), request(admissionv1beta1.Create, "", unsupported)).Allowed)
+	assert.False(t, validator.Handle(context.Background(), request(admissionv1beta1.Update, supported, unsupported)).Allowed)
+	assert.True(t, validator.Handle(context.Background(), request(admissionv1beta1.Update, unsupported, unsupported)).Allowed,
+		"clusters that run an unsupported release should still be updatable")
+
+	response := validator.Handle(context.Background(), request(admissionv1beta1.Create, "", "quay.io/openshift-release-dev/ocp-release@sha256:abc"))
+	assert.True(t, response.Allowed)
+	assert.Len(t, response.Warnings, 1, "release images whose version can't be verified should be admitted with a warning")
+	assert.Empty(t, validator.Handle(context.Background(), request(admissionv1beta1.Create, "", supported)).Warnings)
+}
+
+func TestValidateMirroredReleaseImage(t *testing.T) {
+	sources := []hyperv1.ImageContentSource{{Source: "quay.io/openshift-release-dev", Mirrors: []string{"mirror.example.com/release"}}}
+	assert.NoError(t, validateMirroredReleaseImage("quay.io/openshift-release-dev/ocp-release:4.7.0-x86_64", nil))
+	assert.NoError(t, validateMirroredReleaseImage("quay.io/openshift-release-dev/ocp-release@sha256:abc", sources))
+	assert.Error(t, validateMirroredReleaseImage("quay.io/openshift-release-dev/ocp-release:4.7.0-x86_64", sources),
+		"release images referenced by tag can't be pulled from mirrors")
+}
+
+func TestValidateUpdate(t *testing.T) {
+	hcluster := &hyperv1.HostedCluster{Spec: hyperv1.HostedClusterSpec{
+		ServiceCIDR: "172.31.0.0/16",
+		PodCIDR:     "10.132.0.0/14",
+		Networking:  hyperv1.ClusterNetworking{MachineCIDR: "10.0.0.0/16"},
+		SecretEncryption: &hyperv1.SecretEncryptionSpec{
+			Type:   hyperv1.AESCBCSecretEncryptionType,
+			AESCBC: &hyperv1.AESCBCSpec{KeyRotation: "1"},
+		},
+	}}
+	tests := []struct {
+		name   string
+		update func(*hyperv1.HostedCluster)
+		valid  bool
+	}{
+		{
+			name:   "unchanged networks and feature gates",
+			update: func(hcluster *hyperv1.HostedCluster) { hcluster.Spec.Proxy.HTTPProxy = "http://proxy.example.com" },
+			valid:  true,
+		},
+		{
+			name:   "changed service network",
+			update: func(hcluster *hyperv1.HostedCluster) { hcluster.Spec.ServiceCIDR = "172.30.0.0/16" },
+		},
+		{
+			name:   "changed pod network",
+			update: func(hcluster *hyperv1.HostedCluster) { hcluster.Spec.PodCIDR = "10.128.0.0/14" },
+		},
+		{
+			name:   "changed machine network",
+			update: func(hcluster *hyperv1.HostedCluster) { hcluster.Spec.Networking.MachineCIDR = "10.1.0.0/16" },
+		},
+		{
+			name: "changed feature set",
+			update: func(hcluster *hyperv1.HostedCluster) {
+				hcluster.Spec.FeatureGates.FeatureSet = configv1.TechPreviewNoUpgrade
+			},
+		},
+		{
+			name:   "rotated aescbc key",
+			update: func(hcluster *hyperv1.HostedCluster) { hcluster.Spec.SecretEncryption.AESCBC.KeyRotation = "2" },
+			valid:  true,
+		},
+		{
+			name: "changed secret encryption type",
+			update: func(hcluster *hyperv1.HostedCluster) {
+				hcluster.Spec.SecretEncryption = &hyperv1.SecretEncryptionSpec{
+					Type: hyperv1.KMSSecretEncryptionType,
+					KMS:  &hyperv1.KMSSpec{Image: "example.com/kms-plugin:latest"},
+				}
+			},
+		},
+		{
+			name:   "disabled secret encryption",
+			update: func(hcluster *hyperv1.HostedCluster) { hcluster.Spec.SecretEncryption = nil },
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			updated := hcluster.DeepCopy()
+			test.update(updated)
+			err := validateUpdate(hcluster, updated)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package hostedcluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hyperapi "openshift.io/hypershift/api"
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/hypershift-operator/controllers/hostedcluster/manifests/clusterapi"
+)
+
+func TestProviderTrustedCA(t *testing.T) {
+	hcluster := &hyperv1.HostedCluster{ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example"}}
+	targetNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "clusters-example"}}
+	r := &HostedClusterReconciler{Client: fake.NewFakeClientWithScheme(hyperapi.Scheme, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "proxy-ca"},
+		Data:       map[string]string{"ca-bundle.crt": "bundle"},
+	})}
+
+	trustedCA, err := r.providerTrustedCA(context.Background(), hcluster, targetNamespace)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Nil(t, trustedCA, "a proxy without trusted CAs needs no bundle")
+
+	hcluster.Spec.Proxy.TrustedCA.Name = "proxy-ca"
+	trustedCA, err = r.providerTrustedCA(context.Background(), hcluster, targetNamespace)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "clusters-example", trustedCA.Namespace)
+	assert.Equal(t, "bundle", trustedCA.Data["ca-bundle.crt"])
+
+	deployment := clusterapi.AWSProviderDeployment{
+		Namespace:           targetNamespace,
+		ServiceAccount:      &corev1.ServiceAccount{},
+		ProviderCredentials: &corev1.Secret{},
+		Proxy:               hcluster.Spec.Proxy,
+		TrustedCA:           trustedCA,
+	}.Build()
+	podSpec := deployment.Spec.Template.Spec
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name: "trusted-ca",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: trustedCA.Name}},
+		},
+	})
+	assert.Contains(t, podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "trusted-ca", MountPath: "/etc/pki/hypershift/trusted-ca", ReadOnly: true})
+	assert.Contains(t, podSpec.Containers[0].Env, corev1.EnvVar{Name: "SSL_CERT_DIR", Value: "/etc/ssl/certs:/etc/pki/tls/certs:/etc/pki/hypershift/trusted-ca"})
+	hash := deployment.Spec.Template.Annotations["hypershift.openshift.io/trusted-ca-hash"]
+	assert.NotEmpty(t, hash, "the provider should restart when the bundle changes")
+
+	trustedCA.Data["ca-bundle.crt"] = "rotated"
+	deployment = clusterapi.AWSProviderDeployment{
+		Namespace:           targetNamespace,
+		ServiceAccount:      &corev1.ServiceAccount{},
+		ProviderCredentials: &corev1.Secret{},
+		TrustedCA:           trustedCA,
+	}.Build()
+	assert.NotEqual(t, hash, deployment.Spec.Template.Annotations["hypershift.openshift.io/trusted-ca-hash"])
+
+	hcluster.Spec.Proxy.TrustedCA.Name = "missing"
+	if _, err := r.providerTrustedCA(context.Background(), hcluster, targetNamespace); err == nil {
+		t.Fatalf("expected an error for a missing configmap")
+	}
+}
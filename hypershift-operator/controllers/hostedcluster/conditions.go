@@ -0,0 +1,46 @@
+package hostedcluster
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+// controlPlaneConditions are the conditions of a hosted control plane that are
+// reported on its hosted cluster.
+var controlPlaneConditions = []hyperv1.ConditionType{
+	hyperv1.Unsupported,
+	hyperv1.UnsupportedRelease,
+}
+
+// setControlPlaneConditions copies the controlPlaneConditions a hosted control
+// plane reports to its hosted cluster, and returns whether any of them
+// changed.
+func setControlPlaneConditions(hcluster *hyperv1.HostedCluster, hcp *hyperv1.HostedControlPlane) bool {
+	changed := false
+	for _, conditionType := range controlPlaneConditions {
+		var condition *hyperv1.HostedControlPlaneCondition
+		for i := range hcp.Status.Conditions {
+			if hcp.Status.Conditions[i].Type == conditionType {
+				condition = &hcp.Status.Conditions[i]
+			}
+		}
+		if condition == nil {
+			continue
+		}
+		existing := meta.FindStatusCondition(hcluster.Status.Conditions, string(conditionType))
+		if existing != nil && string(existing.Status) == string(condition.Status) &&
+			existing.Reason == condition.Reason && existing.Message == condition.Message {
+			continue
+		}
+		meta.SetStatusCondition(&hcluster.Status.Conditions, metav1.Condition{
+			Type:    string(conditionType),
+			Status:  metav1.ConditionStatus(condition.Status),
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		})
+		changed = true
+	}
+	return changed
+}
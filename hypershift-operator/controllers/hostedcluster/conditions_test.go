@@ -0,0 +1,36 @@
+package hostedcluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+func TestSetControlPlaneConditions(t *testing.T) {
+	hcluster := &hyperv1.HostedCluster{}
+	hcp := &hyperv1.HostedControlPlane{}
+	assert.False(t, setControlPlaneConditions(hcluster, hcp), "conditions the control plane doesn't report yet should be left out")
+	assert.Empty(t, hcluster.Status.Conditions)
+
+	hcp.Status.Conditions = []hyperv1.HostedControlPlaneCondition{
+		{Type: hyperv1.Available, Status: hyperv1.ConditionTrue, Reason: "AsExpected"},
+		{Type: hyperv1.Unsupported, Status: hyperv1.ConditionFalse, Reason: "AsExpected"},
+		{Type: hyperv1.UnsupportedRelease, Status: hyperv1.ConditionTrue, Reason: "UnsupportedVersion", Message: "4.4.30 is not supported"},
+	}
+	assert.True(t, setControlPlaneConditions(hcluster, hcp))
+	assert.Len(t, hcluster.Status.Conditions, 2)
+	assert.Nil(t, meta.FindStatusCondition(hcluster.Status.Conditions, string(hyperv1.Available)), "only the reported conditions should be copied")
+	assert.True(t, meta.IsStatusConditionFalse(hcluster.Status.Conditions, string(hyperv1.Unsupported)))
+	condition := meta.FindStatusCondition(hcluster.Status.Conditions, string(hyperv1.UnsupportedRelease))
+	if condition == nil {
+		t.Fatalf("expected an %s condition", hyperv1.UnsupportedRelease)
+	}
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, "UnsupportedVersion", condition.Reason)
+	assert.Equal(t, "4.4.30 is not supported", condition.Message)
+	assert.False(t, setControlPlaneConditions(hcluster, hcp), "unchanged conditions should not update the hosted cluster")
+}
@@ -34,6 +34,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	capiv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -70,14 +71,15 @@ type HostedClusterReconciler struct {
 
 func (r *HostedClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&hyperv1.HostedCluster{}).
+		// The status of the watched objects is reported on the hosted
+		// cluster, only changes to its own spec are filtered
+		For(&hyperv1.HostedCluster{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Watches(&source.Kind{Type: &hyperv1.ExternalInfraCluster{}}, handler.EnqueueRequestsFromMapFunc(enqueueParentHostedCluster)).
 		Watches(&source.Kind{Type: &hyperv1.HostedControlPlane{}}, handler.EnqueueRequestsFromMapFunc(enqueueParentHostedCluster)).
 		Watches(&source.Kind{Type: &capiv1.Cluster{}}, handler.EnqueueRequestsFromMapFunc(enqueueParentHostedCluster)).
 		WithOptions(controller.Options{
 			RateLimiter: workqueue.NewItemExponentialFailureRateLimiter(1*time.Second, 10*time.Second),
 		}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
 		Complete(r)
 }
 
@@ -152,6 +154,8 @@ func (r *HostedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	}
 	r.Log.Info("Created target namespace", "namespace", targetNamespace)
 
+	images := r.controlPlaneImages(hcluster)
+
 	// Create the shared provider credentials secret
 
 	var providerCredsSecret corev1.Secret
@@ -185,8 +189,9 @@ func (r *HostedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	}.Build()
 	capiManagerDeployment := clusterapi.ManagerDeployment{
 		Namespace:      targetNamespace,
-		Image:          "quay.io/hypershift/cluster-api:hypershift",
+		Image:          images["cluster-api"],
 		ServiceAccount: capiManagerServiceAccount,
+		Scheduling:     hcluster.Spec.ControlPlaneScheduling,
 	}.Build()
 	capiAwsProviderClusterRole := clusterapi.AWSProviderClusterRole{}.Build()
 	capiAwsProviderServiceAccount := clusterapi.AWSProviderServiceAccount{Namespace: targetNamespace}.Build()
@@ -194,11 +199,19 @@ func (r *HostedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		ClusterRole:    capiAwsProviderClusterRole,
 		ServiceAccount: capiAwsProviderServiceAccount,
 	}.Build()
+	capiAwsProviderTrustedCA, err := r.providerTrustedCA(ctx, hcluster, targetNamespace)
+	if err != nil {
+		r.Log.Error(err, "failed to get proxy trusted CA")
+		return ctrl.Result{}, err
+	}
 	capiAwsProviderDeployment := clusterapi.AWSProviderDeployment{
 		Namespace:           targetNamespace,
-		Image:               "quay.io/hypershift/cluster-api-provider-aws:master",
+		Image:               images["cluster-api-provider-aws"],
 		ServiceAccount:      capiAwsProviderServiceAccount,
 		ProviderCredentials: targetProviderCredsSecret,
+		Proxy:               hcluster.Spec.Proxy,
+		TrustedCA:           capiAwsProviderTrustedCA,
+		Scheduling:          hcluster.Spec.ControlPlaneScheduling,
 	}.Build()
 	capiManagerObjects := []ctrlclient.Object{
 		capiManagerClusterRole,
@@ -208,8 +221,11 @@ func (r *HostedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		capiAwsProviderClusterRole,
 		capiAwsProviderServiceAccount,
 		capiAwsProviderClusterRoleBinding,
-		capiAwsProviderDeployment,
 	}
+	if capiAwsProviderTrustedCA != nil {
+		capiManagerObjects = append(capiManagerObjects, capiAwsProviderTrustedCA)
+	}
+	capiManagerObjects = append(capiManagerObjects, capiAwsProviderDeployment)
 
 	err = r.applyObjects(ctx, capiManagerObjects...)
 	if err != nil {
@@ -275,8 +291,9 @@ func (r *HostedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	}.Build()
 	controlPlaneOperatorDeployment := controlplaneoperator.OperatorDeployment{
 		Namespace:      targetNamespace,
-		OperatorImage:  r.OperatorImage,
+		OperatorImage:  images["control-plane-operator"],
 		ServiceAccount: controlPlaneOperatorServiceAccount,
+		Scheduling:     hcluster.Spec.ControlPlaneScheduling,
 	}.Build()
 
 	controlPlaneObjects := []ctrlclient.Object{
@@ -341,6 +358,27 @@ func (r *HostedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		}
 	}
 
+	// Fields of the spec of the cluster can change after the control plane is
+	// created, the control plane operator rolls them out
+	if controlplaneoperator.SyncHostedControlPlaneSpec(hcp, hcluster) {
+		if err := r.Update(ctx, hcp); err != nil {
+			r.Log.Error(err, "failed to update hosted control plane spec")
+			return ctrl.Result{}, fmt.Errorf("failed to update hosted control plane spec: %w", err)
+		}
+		r.Log.Info("updated hosted control plane spec")
+	}
+
+	// The control plane operator verifies the release versions the admission
+	// webhook can't read from the release image
+	if setControlPlaneConditions(hcluster, hcp) {
+		if err = r.Status().Update(ctx, hcluster); err != nil {
+			r.Log.Error(err, "failed to update conditions in hosted cluster status")
+			return ctrl.Result{}, fmt.Errorf("failed to update conditions in hosted cluster status: %w", err)
+		}
+		r.Log.Info("updated hostedcluster conditions, requeueing")
+		return ctrl.Result{Requeue: true}, nil
+	}
+
 	if hcp.Status.Version != hcluster.Status.Version.History[0].Version {
 		hcluster.Status.Version.History[0].Version = hcp.Status.Version
 		if err = r.Status().Update(ctx, hcluster); err != nil {
@@ -351,6 +389,16 @@ func (r *HostedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	if hcp.Status.IngressEndpoint != hcluster.Status.IngressEndpoint {
+		hcluster.Status.IngressEndpoint = hcp.Status.IngressEndpoint
+		if err = r.Status().Update(ctx, hcluster); err != nil {
+			r.Log.Error(err, "failed to update ingress endpoint in hosted cluster status")
+			return ctrl.Result{}, fmt.Errorf("failed to update ingress endpoint in hosted cluster status: %w", err)
+		}
+		r.Log.Info("updated hostedcluster ingress endpoint, requeueing")
+		return ctrl.Result{Requeue: true}, nil
+	}
+
 	// When the hosted control plane kubeconfig secret is available, copy it to the
 	// hostedcluster namespace and update status
 	if hcp.Status.KubeConfig != nil {
@@ -406,8 +454,9 @@ func (r *HostedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		autoScalerDeployment := autoscaler.Deployment{
 			Namespace:        targetNamespace,
 			ServiceAccount:   autoScalerServiceAccount,
-			Image:            "k8s.gcr.io/autoscaling/cluster-autoscaler:v1.20.0",
+			Image:            images["cluster-autoscaler"],
 			TargetKubeConfig: &targetKubeConfigSecret,
+			Scheduling:       hcluster.Spec.ControlPlaneScheduling,
 		}.Build()
 		autoScalerObjects := []ctrlclient.Object{
 			autoScalerRole,
@@ -453,6 +502,28 @@ func (r *HostedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	return ctrl.Result{}, nil
 }
 
+// providerTrustedCA returns the copy of the trusted CA bundle of the proxy of
+// a hosted cluster for its cluster api provider, or nil when the proxy has no
+// additional trusted CAs.
+func (r *HostedClusterReconciler) providerTrustedCA(ctx context.Context, hcluster *hyperv1.HostedCluster, targetNamespace *corev1.Namespace) (*corev1.ConfigMap, error) {
+	name := hcluster.Spec.Proxy.TrustedCA.Name
+	if len(name) == 0 {
+		return nil, nil
+	}
+	var source corev1.ConfigMap
+	if err := r.Client.Get(ctx, ctrlclient.ObjectKey{Namespace: hcluster.GetNamespace(), Name: name}, &source); err != nil {
+		return nil, fmt.Errorf("failed to get proxy trusted CA configmap %s: %w", name, err)
+	}
+	bundle, ok := source.Data["ca-bundle.crt"]
+	if !ok {
+		return nil, fmt.Errorf("proxy trusted CA configmap %s is missing the ca-bundle.crt key", name)
+	}
+	return clusterapi.AWSProviderTrustedCA{
+		Namespace: targetNamespace,
+		Bundle:    bundle,
+	}.Build(), nil
+}
+
 func (r *HostedClusterReconciler) applyObjects(ctx context.Context, objects ...ctrlclient.Object) error {
 	for i := range objects {
 		object := objects[i]
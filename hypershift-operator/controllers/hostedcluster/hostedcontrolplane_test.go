@@ -0,0 +1,50 @@
+package hostedcluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/hypershift-operator/controllers/hostedcluster/manifests/controlplaneoperator"
+)
+
+func TestSyncHostedControlPlaneSpec(t *testing.T) {
+	hcluster := &hyperv1.HostedCluster{Spec: hyperv1.HostedClusterSpec{
+		ServiceCIDR: "172.31.0.0/16",
+		SecretEncryption: &hyperv1.SecretEncryptionSpec{
+			Type:   hyperv1.AESCBCSecretEncryptionType,
+			AESCBC: &hyperv1.AESCBCSpec{KeyRotation: "1"},
+		},
+	}}
+	hcp := controlplaneoperator.HostedControlPlane{
+		Namespace:           &corev1.Namespace{},
+		HostedCluster:       hcluster,
+		ProviderCredentials: &corev1.Secret{},
+		PullSecret:          &corev1.Secret{},
+		SSHKey:              &corev1.Secret{},
+	}.Build()
+	assert.False(t, controlplaneoperator.SyncHostedControlPlaneSpec(hcp, hcluster), "a new control plane should have the spec of its cluster")
+
+	hcluster.Spec.Audit.Profile = hyperv1.WriteRequestBodiesAuditProfileType
+	hcluster.Spec.Proxy.HTTPProxy = "http://proxy.example.com"
+	hcluster.Spec.ImageContentSources = []hyperv1.ImageContentSource{{Source: "quay.io/openshift-release-dev", Mirrors: []string{"mirror.example.com/release"}}}
+	hcluster.Spec.ControlPlaneScheduling = &hyperv1.ControlPlaneScheduling{PriorityClassName: "hypershift-control-plane"}
+	hcluster.Spec.ComponentImageOverrides = map[string]string{"hyperkube": "quay.io/dev/hyperkube:fix"}
+	hcluster.Spec.SecretEncryption.AESCBC.KeyRotation = "2"
+	hcluster.Spec.APIDNSName = "api.example.hypershift.local"
+	hcluster.Spec.OAuthDNSName = "oauth.example.hypershift.local"
+	hcluster.Spec.ServiceCIDR = "172.30.0.0/16"
+	assert.True(t, controlplaneoperator.SyncHostedControlPlaneSpec(hcp, hcluster))
+	assert.Equal(t, hcluster.Spec.Audit, hcp.Spec.Audit)
+	assert.Equal(t, hcluster.Spec.Proxy, hcp.Spec.Proxy)
+	assert.Equal(t, hcluster.Spec.ImageContentSources, hcp.Spec.ImageContentSources)
+	assert.Equal(t, hcluster.Spec.ControlPlaneScheduling, hcp.Spec.ControlPlaneScheduling)
+	assert.Equal(t, hcluster.Spec.ComponentImageOverrides, hcp.Spec.ComponentImageOverrides)
+	assert.Equal(t, "2", hcp.Spec.SecretEncryption.AESCBC.KeyRotation)
+	assert.Equal(t, "api.example.hypershift.local", hcp.Spec.APIDNSName)
+	assert.Equal(t, "oauth.example.hypershift.local", hcp.Spec.OAuthDNSName)
+	assert.Equal(t, "172.31.0.0/16", hcp.Spec.ServiceCIDR, "the networks of a cluster are not synced")
+	assert.False(t, controlplaneoperator.SyncHostedControlPlaneSpec(hcp, hcluster))
+}
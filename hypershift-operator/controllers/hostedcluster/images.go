@@ -0,0 +1,38 @@
+package hostedcluster
+
+import (
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/support/mirrors"
+)
+
+// The images of the components the operator runs in the control plane
+// namespace of a hosted cluster, besides the control plane operator.
+const (
+	clusterAPIManagerImage     = "quay.io/hypershift/cluster-api:hypershift"
+	clusterAPIAWSProviderImage = "quay.io/hypershift/cluster-api-provider-aws:master"
+	clusterAutoscalerImage     = "k8s.gcr.io/autoscaling/cluster-autoscaler:v1.20.0"
+)
+
+// controlPlaneImages returns the images of the components the operator runs in
+// the control plane namespace of a hosted cluster, on the mirrors of the image
+// content sources of the cluster.
+func (r *HostedClusterReconciler) controlPlaneImages(hcluster *hyperv1.HostedCluster) map[string]string {
+	var sources []mirrors.ImageContentSource
+	for _, source := range hcluster.Spec.ImageContentSources {
+		sources = append(sources, mirrors.ImageContentSource{
+			Source:  source.Source,
+			Mirrors: source.Mirrors,
+		})
+	}
+	images := mirrors.MirroredImages(map[string]string{
+		"control-plane-operator":   r.OperatorImage,
+		"cluster-api":              clusterAPIManagerImage,
+		"cluster-api-provider-aws": clusterAPIAWSProviderImage,
+		"cluster-autoscaler":       clusterAutoscalerImage,
+	}, sources)
+	if unmirrored := mirrors.UnmirroredImages(images, sources); len(unmirrored) > 0 {
+		r.Log.Info("images referenced by tag are pulled from their source rather than from the image content sources of the cluster",
+			"cluster", hcluster.Name, "components", unmirrored)
+	}
+	return images
+}
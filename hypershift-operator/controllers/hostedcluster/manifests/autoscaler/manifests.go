@@ -6,6 +6,9 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/support/scheduling"
 )
 
 type Deployment struct {
@@ -13,6 +16,7 @@ type Deployment struct {
 	ServiceAccount   *corev1.ServiceAccount
 	Image            string
 	TargetKubeConfig *corev1.Secret
+	Scheduling       *hyperv1.ControlPlaneScheduling
 }
 
 func (o Deployment) Build() *appsv1.Deployment {
@@ -100,6 +104,7 @@ func (o Deployment) Build() *appsv1.Deployment {
 			},
 		},
 	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
 	return deployment
 }
 
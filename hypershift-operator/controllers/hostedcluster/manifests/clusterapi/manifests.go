@@ -1,18 +1,27 @@
 package clusterapi
 
 import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/support/scheduling"
 )
 
 type ManagerDeployment struct {
 	Namespace      *corev1.Namespace
 	Image          string
 	ServiceAccount *corev1.ServiceAccount
+	Scheduling     *hyperv1.ControlPlaneScheduling
 }
 
 func (o ManagerDeployment) Build() *appsv1.Deployment {
@@ -63,6 +72,7 @@ func (o ManagerDeployment) Build() *appsv1.Deployment {
 			},
 		},
 	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
 	return deployment
 }
 
@@ -170,11 +180,52 @@ func (o ManagerClusterRoleBinding) Build() *rbacv1.ClusterRoleBinding {
 	return binding
 }
 
+const (
+	// providerTrustedCAKey is the key of the trusted CA bundle, as in the
+	// ConfigMap referenced by the proxy configuration of a hosted cluster
+	providerTrustedCAKey = "ca-bundle.crt"
+	// providerTrustedCADir is a directory of CA bundles the provider trusts
+	// in addition to the system CAs
+	providerTrustedCADir = "/etc/pki/hypershift/trusted-ca"
+	// providerTrustedCAHashAnnotation rolls out the provider when the trusted
+	// CA bundle changes, since it is only read at startup
+	providerTrustedCAHashAnnotation = "hypershift.openshift.io/trusted-ca-hash"
+)
+
+// AWSProviderTrustedCA is the copy of the proxy trusted CA bundle of a hosted
+// cluster for the cluster api provider, which reaches its cloud API through
+// the proxy.
+type AWSProviderTrustedCA struct {
+	Namespace *corev1.Namespace
+	Bundle    string
+}
+
+func (o AWSProviderTrustedCA) Build() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.Namespace.Name,
+			Name:      "capa-trusted-ca",
+		},
+		Data: map[string]string{
+			providerTrustedCAKey: o.Bundle,
+		},
+	}
+}
+
 type AWSProviderDeployment struct {
 	Namespace           *corev1.Namespace
 	Image               string
 	ServiceAccount      *corev1.ServiceAccount
 	ProviderCredentials *corev1.Secret
+	Proxy               configv1.ProxySpec
+	// TrustedCA is the AWSProviderTrustedCA of a proxy with additional
+	// trusted CAs, if any
+	TrustedCA  *corev1.ConfigMap
+	Scheduling *hyperv1.ControlPlaneScheduling
 }
 
 func (o AWSProviderDeployment) Build() *appsv1.Deployment {
@@ -275,9 +326,65 @@ func (o AWSProviderDeployment) Build() *appsv1.Deployment {
 			},
 		},
 	}
+	deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env, proxyEnv(o.Proxy)...)
+	if o.TrustedCA != nil {
+		mountTrustedCA(&deployment.Spec.Template, o.TrustedCA)
+	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
 	return deployment
 }
 
+// mountTrustedCA makes the manager of a provider trust the CA bundle of a
+// ConfigMap in addition to the system CAs. Go loads the system CA bundle
+// file along with every bundle in the directories of SSL_CERT_DIR, which
+// replace its default system directories.
+func mountTrustedCA(template *corev1.PodTemplateSpec, trustedCA *corev1.ConfigMap) {
+	template.Spec.Volumes = append(template.Spec.Volumes, corev1.Volume{
+		Name: "trusted-ca",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: trustedCA.Name},
+			},
+		},
+	})
+	container := &template.Spec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      "trusted-ca",
+		MountPath: providerTrustedCADir,
+		ReadOnly:  true,
+	})
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  "SSL_CERT_DIR",
+		Value: strings.Join([]string{"/etc/ssl/certs", "/etc/pki/tls/certs", providerTrustedCADir}, ":"),
+	})
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[providerTrustedCAHashAnnotation] = fmt.Sprintf("%x", sha256.Sum256([]byte(trustedCA.Data[providerTrustedCAKey])))
+}
+
+// proxyEnv returns the environment that makes a provider reach its cloud API
+// through the proxy of a hosted cluster. The management cluster API server is
+// always reached directly.
+func proxyEnv(proxy configv1.ProxySpec) []corev1.EnvVar {
+	if len(proxy.HTTPProxy) == 0 && len(proxy.HTTPSProxy) == 0 {
+		return nil
+	}
+	var env []corev1.EnvVar
+	if len(proxy.HTTPProxy) > 0 {
+		env = append(env, corev1.EnvVar{Name: "HTTP_PROXY", Value: proxy.HTTPProxy})
+	}
+	if len(proxy.HTTPSProxy) > 0 {
+		env = append(env, corev1.EnvVar{Name: "HTTPS_PROXY", Value: proxy.HTTPSProxy})
+	}
+	noProxy := []string{".cluster.local", ".svc", "127.0.0.1", "localhost", "$(KUBERNETES_SERVICE_HOST)"}
+	if len(proxy.NoProxy) > 0 {
+		noProxy = append(noProxy, proxy.NoProxy)
+	}
+	env = append(env, corev1.EnvVar{Name: "NO_PROXY", Value: strings.Join(noProxy, ",")})
+	return env
+}
+
 type AWSProviderServiceAccount struct {
 	Namespace *corev1.Namespace
 }
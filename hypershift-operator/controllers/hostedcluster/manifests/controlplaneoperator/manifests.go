@@ -5,10 +5,12 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	k8sutilspointer "k8s.io/utils/pointer"
 	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/support/scheduling"
 	capiv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
 )
 
@@ -20,6 +22,7 @@ type OperatorDeployment struct {
 	Namespace      *corev1.Namespace
 	OperatorImage  string
 	ServiceAccount *corev1.ServiceAccount
+	Scheduling     *hyperv1.ControlPlaneScheduling
 }
 
 func (o OperatorDeployment) Build() *appsv1.Deployment {
@@ -70,6 +73,7 @@ func (o OperatorDeployment) Build() *appsv1.Deployment {
 			},
 		},
 	}
+	scheduling.ApplyToPodSpec(&deployment.Spec.Template.Spec, o.Scheduling, deployment.Spec.Selector)
 	return deployment
 }
 
@@ -172,6 +176,11 @@ func (o OperatorClusterRole) Build() *rbacv1.ClusterRole {
 				Resources: []string{"deployments"},
 				Verbs:     []string{"*"},
 			},
+			{
+				APIGroups: []string{"batch"},
+				Resources: []string{"jobs"},
+				Verbs:     []string{"*"},
+			},
 			{
 				APIGroups: []string{"etcd.database.coreos.com"},
 				Resources: []string{"*"},
@@ -182,6 +191,11 @@ func (o OperatorClusterRole) Build() *rbacv1.ClusterRole {
 				Resources: []string{"*"},
 				Verbs:     []string{"*"},
 			},
+			{
+				APIGroups: []string{"externaldns.k8s.io"},
+				Resources: []string{"dnsendpoints"},
+				Verbs:     []string{"*"},
+			},
 		},
 	}
 	return role
@@ -285,14 +299,68 @@ func (o HostedControlPlane) Build() *hyperv1.HostedControlPlane {
 			SSHKey: corev1.LocalObjectReference{
 				Name: o.SSHKey.Name,
 			},
-			ServiceCIDR:  o.HostedCluster.Spec.ServiceCIDR,
-			PodCIDR:      o.HostedCluster.Spec.PodCIDR,
-			ReleaseImage: o.HostedCluster.Spec.Release.Image,
+			ServiceCIDR:    o.HostedCluster.Spec.ServiceCIDR,
+			PodCIDR:        o.HostedCluster.Spec.PodCIDR,
+			Networking:     o.HostedCluster.Spec.Networking,
+			ReleaseImage:   o.HostedCluster.Spec.Release.Image,
+			Ingress:        o.HostedCluster.Spec.Ingress,
+			Tunnel:         o.HostedCluster.Spec.Tunnel,
+			Services:       o.HostedCluster.Spec.Services,
+			EndpointAccess: o.HostedCluster.Spec.EndpointAccess,
+			APIDNSName:     o.HostedCluster.Spec.APIDNSName,
+			OAuthDNSName:   o.HostedCluster.Spec.OAuthDNSName,
+			DNS:            o.HostedCluster.Spec.DNS,
+			FeatureGates:   *o.HostedCluster.Spec.FeatureGates.DeepCopy(),
 		},
 	}
+	if o.HostedCluster.Spec.SecretEncryption != nil {
+		hcp.Spec.SecretEncryption = o.HostedCluster.Spec.SecretEncryption.DeepCopy()
+	}
+	SyncHostedControlPlaneSpec(hcp, o.HostedCluster)
 	return hcp
 }
 
+// SyncHostedControlPlaneSpec copies the fields of the spec of a hosted cluster
+// that can change after its control plane is created to the spec of the
+// control plane, which the control plane operator rolls out. It returns
+// whether the spec of the control plane changed.
+func SyncHostedControlPlaneSpec(hcp *hyperv1.HostedControlPlane, hcluster *hyperv1.HostedCluster) bool {
+	spec := hcp.Spec.DeepCopy()
+	spec.OAuth = *hcluster.Spec.OAuth.DeepCopy()
+	spec.Audit = *hcluster.Spec.Audit.DeepCopy()
+	spec.Proxy = *hcluster.Spec.Proxy.DeepCopy()
+	spec.APIDNSName = hcluster.Spec.APIDNSName
+	spec.OAuthDNSName = hcluster.Spec.OAuthDNSName
+	spec.ImageContentSources = nil
+	for _, source := range hcluster.Spec.ImageContentSources {
+		spec.ImageContentSources = append(spec.ImageContentSources, *source.DeepCopy())
+	}
+	spec.ComponentImageOverrides = nil
+	for component, image := range hcluster.Spec.ComponentImageOverrides {
+		if spec.ComponentImageOverrides == nil {
+			spec.ComponentImageOverrides = map[string]string{}
+		}
+		spec.ComponentImageOverrides[component] = image
+	}
+	spec.ControlPlaneResources = hcluster.Spec.ControlPlaneResources.DeepCopy()
+	spec.ControlPlaneScheduling = hcluster.Spec.ControlPlaneScheduling.DeepCopy()
+	// Of the secret encryption configuration, only the aescbc key rotation
+	// can change after the control plane is created
+	keyRotation, desiredAESCBC := aescbcKeyRotation(hcluster.Spec.SecretEncryption)
+	currentKeyRotation, currentAESCBC := aescbcKeyRotation(spec.SecretEncryption)
+	if desiredAESCBC && currentAESCBC && currentKeyRotation != keyRotation {
+		if spec.SecretEncryption.AESCBC == nil {
+			spec.SecretEncryption.AESCBC = &hyperv1.AESCBCSpec{}
+		}
+		spec.SecretEncryption.AESCBC.KeyRotation = keyRotation
+	}
+	if equality.Semantic.DeepEqual(spec, &hcp.Spec) {
+		return false
+	}
+	hcp.Spec = *spec
+	return true
+}
+
 type ExternalInfraCluster struct {
 	Namespace     *corev1.Namespace
 	HostedCluster *hyperv1.HostedCluster
@@ -326,3 +394,15 @@ func namespacedName(obj metav1.Object) types.NamespacedName {
 		Name:      obj.GetName(),
 	}
 }
+
+// aescbcKeyRotation returns the aescbc key rotation of a secret encryption
+// configuration and whether it uses the aescbc type.
+func aescbcKeyRotation(spec *hyperv1.SecretEncryptionSpec) (string, bool) {
+	if spec == nil || spec.Type != hyperv1.AESCBCSecretEncryptionType {
+		return "", false
+	}
+	if spec.AESCBC == nil {
+		return "", true
+	}
+	return spec.AESCBC.KeyRotation, true
+}
@@ -0,0 +1,384 @@
+package hostedclusterkubeconfig
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/hypershift-operator/controllers/hostedcluster/manifests"
+	"openshift.io/hypershift/support/certs"
+)
+
+const (
+	hostedClusterAnnotation = "hypershift.openshift.io/cluster"
+	pkiSecretName           = "pki"
+	kubeconfigKey           = "kubeconfig"
+
+	// kubeAPIServerConfigMapName and kubeAPIServerClientCAKey locate the
+	// client CA bundle the kube-apiserver of a control plane trusts
+	kubeAPIServerConfigMapName = "kube-apiserver"
+	kubeAPIServerClientCAKey   = "client-ca.crt"
+
+	// defaultValidity is used when a request doesn't specify a validity
+	defaultValidity = 24 * time.Hour
+
+	// signerPollInterval is how often to check for a kubeconfig signer that
+	// isn't available yet or is being rotated.
+	signerPollInterval = 10 * time.Second
+)
+
+// HostedClusterKubeconfigReconciler issues short-lived kubeconfigs for hosted
+// clusters. The client certificates are signed by the kubeconfig signer in
+// the pki secret of the control plane, and revoked by asking the control
+// plane operator to rotate that signer. A rotation revokes every kubeconfig
+// of the hosted cluster, so the ones that weren't revoked are re-issued with
+// the new signer.
+type HostedClusterKubeconfigReconciler struct {
+	ctrlclient.Client
+	Log logr.Logger
+
+	// now is used to evaluate expiration, and defaults to time.Now
+	now func() time.Time
+}
+
+func (r *HostedClusterKubeconfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.now == nil {
+		r.now = time.Now
+	}
+	_, err := ctrl.NewControllerManagedBy(mgr).
+		For(&hyperv1.HostedClusterKubeconfig{}).
+		Owns(&corev1.Secret{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.enqueueForPKISecret)).
+		WithOptions(controller.Options{
+			RateLimiter: workqueue.NewItemExponentialFailureRateLimiter(1*time.Second, 10*time.Second),
+		}).
+		Build(r)
+	if err != nil {
+		return errors.Wrap(err, "failed setting up with a controller manager")
+	}
+	return nil
+}
+
+func (r *HostedClusterKubeconfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.Log = ctrl.LoggerFrom(ctx)
+	r.Log.Info("Reconciling")
+
+	kubeconfig := &hyperv1.HostedClusterKubeconfig{}
+	if err := r.Get(ctx, req.NamespacedName, kubeconfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "error getting hosted cluster kubeconfig")
+		return ctrl.Result{}, err
+	}
+	// The issued secret is owned by the request and garbage collected with it
+	if !kubeconfig.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(kubeconfig, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	result, err := r.reconcile(ctx, kubeconfig)
+	if err != nil {
+		r.Log.Error(err, "Failed to reconcile hosted cluster kubeconfig")
+	}
+	if err := patchHelper.Patch(ctx, kubeconfig); err != nil {
+		r.Log.Error(err, "failed to patch")
+		return ctrl.Result{}, fmt.Errorf("failed to patch: %w", err)
+	}
+	return result, err
+}
+
+func (r *HostedClusterKubeconfigReconciler) reconcile(ctx context.Context, kubeconfig *hyperv1.HostedClusterKubeconfig) (ctrl.Result, error) {
+	hcluster := &hyperv1.HostedCluster{}
+	if err := r.Get(ctx, ctrlclient.ObjectKey{Namespace: kubeconfig.Namespace, Name: kubeconfig.Spec.HostedCluster}, hcluster); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get hosted cluster %q: %w", kubeconfig.Spec.HostedCluster, err)
+	}
+	controlPlaneNamespace := manifests.HostedControlPlaneNamespace{HostedCluster: hcluster}.Build().Name
+	hcp := &hyperv1.HostedControlPlane{}
+	if err := r.Get(ctx, ctrlclient.ObjectKey{Namespace: controlPlaneNamespace, Name: hcluster.Name}, hcp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.signerNotReady(kubeconfig, "The hosted control plane does not exist yet")
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get hosted control plane: %w", err)
+	}
+	pkiSecret := &corev1.Secret{}
+	if err := r.Get(ctx, ctrlclient.ObjectKey{Namespace: controlPlaneNamespace, Name: pkiSecretName}, pkiSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.signerNotReady(kubeconfig, "The control plane PKI has not been generated yet")
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get pki secret: %w", err)
+	}
+	if _, hasSigner := pkiSecret.Data["kubeconfig-signer.crt"]; !hasSigner || len(hcp.Status.ControlPlaneEndpoint.Host) == 0 {
+		return r.signerNotReady(kubeconfig, "The kubeconfig signer of the control plane is not available yet")
+	}
+	signer, err := parseCA(pkiSecret.Data, "kubeconfig-signer")
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	fingerprint := certs.Fingerprint(signer.Cert)
+
+	if kubeconfig.Spec.Revoked {
+		return r.revoke(ctx, kubeconfig, hcp, fingerprint)
+	}
+
+	now := r.now()
+	if expiration := kubeconfig.Status.ExpirationTime; expiration != nil && !now.Before(expiration.Time) {
+		if err := r.deleteKubeconfigSecret(ctx, kubeconfig); err != nil {
+			return ctrl.Result{}, err
+		}
+		meta.SetStatusCondition(&kubeconfig.Status.Conditions, metav1.Condition{
+			Type:    hyperv1.HostedClusterKubeconfigExpiredConditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  hyperv1.HostedClusterKubeconfigCertificateExpiredReason,
+			Message: fmt.Sprintf("The client certificate expired at %s", expiration.UTC().Format(time.RFC3339)),
+		})
+		meta.SetStatusCondition(&kubeconfig.Status.Conditions, metav1.Condition{
+			Type:   hyperv1.HostedClusterKubeconfigIssuedConditionType,
+			Status: metav1.ConditionFalse,
+			Reason: hyperv1.HostedClusterKubeconfigCertificateExpiredReason,
+		})
+		return ctrl.Result{}, nil
+	}
+
+	secret := kubeconfigSecret(kubeconfig)
+	err = r.Get(ctx, ctrlclient.ObjectKeyFromObject(secret), secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("failed to get kubeconfig secret: %w", err)
+	}
+	if err != nil || kubeconfig.Status.SignerFingerprint != fingerprint {
+		// A kubeconfig re-issued after a signer rotation keeps its original
+		// expiration
+		validity := kubeconfig.Spec.Validity.Duration
+		if validity <= 0 {
+			validity = defaultValidity
+		}
+		if kubeconfig.Status.ExpirationTime != nil {
+			validity = kubeconfig.Status.ExpirationTime.Sub(now)
+		}
+		rootCA, err := parseCA(pkiSecret.Data, "root-ca")
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		cert, err := certs.GenerateClientCert(kubeconfig.Spec.User, kubeconfig.Spec.Groups, validity, signer)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to generate client certificate: %w", err)
+		}
+		data, err := (&certs.Kubeconfig{
+			RootCA:        rootCA,
+			Cert:          cert,
+			ServerAddress: fmt.Sprintf("https://%s:%d", hcp.Status.ControlPlaneEndpoint.Host, hcp.Status.ControlPlaneEndpoint.Port),
+		}).Serialize()
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to serialize kubeconfig: %w", err)
+		}
+		if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+			secret.Type = corev1.SecretTypeOpaque
+			secret.Data = map[string][]byte{kubeconfigKey: data}
+			return controllerutil.SetControllerReference(kubeconfig, secret, r.Scheme())
+		}); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to reconcile kubeconfig secret: %w", err)
+		}
+		r.Log.Info("Issued kubeconfig", "user", kubeconfig.Spec.User, "groups", kubeconfig.Spec.Groups, "expiration", cert.Cert.NotAfter)
+		kubeconfig.Status.ExpirationTime = &metav1.Time{Time: cert.Cert.NotAfter}
+		kubeconfig.Status.SignerFingerprint = fingerprint
+	}
+
+	kubeconfig.Status.KubeConfig = &corev1.LocalObjectReference{Name: secret.Name}
+	meta.SetStatusCondition(&kubeconfig.Status.Conditions, metav1.Condition{
+		Type:   hyperv1.HostedClusterKubeconfigIssuedConditionType,
+		Status: metav1.ConditionTrue,
+		Reason: hyperv1.HostedClusterKubeconfigAsExpectedReason,
+	})
+	return ctrl.Result{RequeueAfter: kubeconfig.Status.ExpirationTime.Sub(now)}, nil
+}
+
+// revoke removes the issued kubeconfig and makes sure the signer that issued
+// it is no longer trusted by the hosted cluster.
+func (r *HostedClusterKubeconfigReconciler) revoke(ctx context.Context, kubeconfig *hyperv1.HostedClusterKubeconfig, hcp *hyperv1.HostedControlPlane, fingerprint string) (ctrl.Result, error) {
+	if err := r.deleteKubeconfigSecret(ctx, kubeconfig); err != nil {
+		return ctrl.Result{}, err
+	}
+	meta.SetStatusCondition(&kubeconfig.Status.Conditions, metav1.Condition{
+		Type:   hyperv1.HostedClusterKubeconfigIssuedConditionType,
+		Status: metav1.ConditionFalse,
+		Reason: hyperv1.HostedClusterKubeconfigRevokedReason,
+	})
+
+	if kubeconfig.Status.SignerFingerprint == "" {
+		meta.SetStatusCondition(&kubeconfig.Status.Conditions, metav1.Condition{
+			Type:    hyperv1.HostedClusterKubeconfigRevokedConditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  hyperv1.HostedClusterKubeconfigRevokedReason,
+			Message: "No client certificate was issued",
+		})
+		return ctrl.Result{}, nil
+	}
+	if kubeconfig.Status.SignerFingerprint != fingerprint {
+		// The signer is rotated in the pki secret before the kube-apiserver
+		// stops trusting the old one
+		trusted, err := r.isTrustedByKubeAPIServer(ctx, hcp, kubeconfig.Status.SignerFingerprint)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if trusted {
+			meta.SetStatusCondition(&kubeconfig.Status.Conditions, metav1.Condition{
+				Type:    hyperv1.HostedClusterKubeconfigRevokedConditionType,
+				Status:  metav1.ConditionFalse,
+				Reason:  hyperv1.HostedClusterKubeconfigSignerRotatingReason,
+				Message: "Waiting for the kube-apiserver to stop trusting the old kubeconfig signer",
+			})
+			return ctrl.Result{RequeueAfter: signerPollInterval}, nil
+		}
+		meta.SetStatusCondition(&kubeconfig.Status.Conditions, metav1.Condition{
+			Type:    hyperv1.HostedClusterKubeconfigRevokedConditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  hyperv1.HostedClusterKubeconfigSignerRotatedReason,
+			Message: "The signer of the client certificate is no longer trusted",
+		})
+		return ctrl.Result{}, nil
+	}
+
+	rotation := string(kubeconfig.UID)
+	if hcp.Spec.KubeconfigSignerRotation != rotation {
+		hcp.Spec.KubeconfigSignerRotation = rotation
+		if err := r.Update(ctx, hcp); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to request kubeconfig signer rotation: %w", err)
+		}
+		r.Log.Info("Requested kubeconfig signer rotation", "rotation", rotation)
+	}
+	meta.SetStatusCondition(&kubeconfig.Status.Conditions, metav1.Condition{
+		Type:    hyperv1.HostedClusterKubeconfigRevokedConditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  hyperv1.HostedClusterKubeconfigSignerRotatingReason,
+		Message: "Waiting for the control plane to rotate the kubeconfig signer",
+	})
+	return ctrl.Result{RequeueAfter: signerPollInterval}, nil
+}
+
+// isTrustedByKubeAPIServer returns whether the client CA bundle of the
+// kube-apiserver of a control plane still contains the signer with the given
+// fingerprint. A bundle that doesn't exist yet can't be verified, so the
+// signer is considered trusted until it does.
+func (r *HostedClusterKubeconfigReconciler) isTrustedByKubeAPIServer(ctx context.Context, hcp *hyperv1.HostedControlPlane, fingerprint string) (bool, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := r.Get(ctx, ctrlclient.ObjectKey{Namespace: hcp.Namespace, Name: kubeAPIServerConfigMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get kube-apiserver configmap: %w", err)
+	}
+	rest := []byte(configMap.Data[kubeAPIServerClientCAKey])
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return false, nil
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse kube-apiserver client ca: %w", err)
+		}
+		if certs.Fingerprint(cert) == fingerprint {
+			return true, nil
+		}
+	}
+}
+
+func (r *HostedClusterKubeconfigReconciler) signerNotReady(kubeconfig *hyperv1.HostedClusterKubeconfig, message string) (ctrl.Result, error) {
+	meta.SetStatusCondition(&kubeconfig.Status.Conditions, metav1.Condition{
+		Type:    hyperv1.HostedClusterKubeconfigIssuedConditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  hyperv1.HostedClusterKubeconfigSignerNotReadyReason,
+		Message: message,
+	})
+	return ctrl.Result{RequeueAfter: signerPollInterval}, nil
+}
+
+func (r *HostedClusterKubeconfigReconciler) deleteKubeconfigSecret(ctx context.Context, kubeconfig *hyperv1.HostedClusterKubeconfig) error {
+	kubeconfig.Status.KubeConfig = nil
+	if err := r.Delete(ctx, kubeconfigSecret(kubeconfig)); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete kubeconfig secret: %w", err)
+	}
+	return nil
+}
+
+// enqueueForPKISecret enqueues the kubeconfigs of a hosted cluster when the
+// pki secret of its control plane changes, so they are re-issued as soon as
+// the kubeconfig signer is rotated.
+func (r *HostedClusterKubeconfigReconciler) enqueueForPKISecret(obj ctrlclient.Object) []reconcile.Request {
+	if obj.GetName() != pkiSecretName {
+		return nil
+	}
+	ctx := context.Background()
+	log := ctrl.Log.WithName("hostedclusterkubeconfig")
+	hcpList := &hyperv1.HostedControlPlaneList{}
+	if err := r.List(ctx, hcpList, ctrlclient.InNamespace(obj.GetNamespace())); err != nil {
+		log.Error(err, "failed to list hosted control planes", "namespace", obj.GetNamespace())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, hcp := range hcpList.Items {
+		parts := strings.SplitN(hcp.Annotations[hostedClusterAnnotation], string(types.Separator), 2)
+		if len(parts) != 2 {
+			continue
+		}
+		kubeconfigs := &hyperv1.HostedClusterKubeconfigList{}
+		if err := r.List(ctx, kubeconfigs, ctrlclient.InNamespace(parts[0])); err != nil {
+			log.Error(err, "failed to list hosted cluster kubeconfigs", "namespace", parts[0])
+			continue
+		}
+		for _, kubeconfig := range kubeconfigs.Items {
+			if kubeconfig.Spec.HostedCluster == parts[1] {
+				requests = append(requests, reconcile.Request{NamespacedName: ctrlclient.ObjectKeyFromObject(&kubeconfig)})
+			}
+		}
+	}
+	return requests
+}
+
+func kubeconfigSecret(kubeconfig *hyperv1.HostedClusterKubeconfig) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: kubeconfig.Namespace,
+			Name:      kubeconfig.Name + "-kubeconfig",
+		},
+	}
+}
+
+func parseCA(data map[string][]byte, name string) (*certs.CA, error) {
+	cert, err := certs.PemToCertificate(data[name+".crt"])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s certificate: %w", name, err)
+	}
+	ca := &certs.CA{Cert: cert}
+	if keyBytes, hasKey := data[name+".key"]; hasKey {
+		if ca.Key, err = certs.PemToPrivateKey(keyBytes); err != nil {
+			return nil, fmt.Errorf("failed to parse %s key: %w", name, err)
+		}
+	}
+	return ca, nil
+}
@@ -0,0 +1,333 @@
+package hostedclusterkubeconfig
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hyperapi "openshift.io/hypershift/api"
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+	"openshift.io/hypershift/support/certs"
+)
+
+const (
+	namespace             = "clusters"
+	hostedClusterName     = "example"
+	controlPlaneNamespace = "example"
+)
+
+// fixture is a hosted cluster with a control plane whose kubeconfig signer is
+// available.
+type fixture struct {
+	client ctrlclient.Client
+	r      *HostedClusterKubeconfigReconciler
+	now    time.Time
+}
+
+func newFixture(t *testing.T, kubeconfigs ...*hyperv1.HostedClusterKubeconfig) *fixture {
+	pkiData := map[string][]byte{}
+	for _, name := range []string{"root-ca", "cluster-signer", "kubeconfig-signer"} {
+		ca, err := certs.GenerateCA(name, "openshift")
+		if err != nil {
+			t.Fatalf("failed to generate %s: %v", name, err)
+		}
+		pkiData[name+".crt"], pkiData[name+".key"] = ca.Serialize()
+	}
+	objects := []ctrlclient.Object{
+		&hyperv1.HostedCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: hostedClusterName},
+		},
+		&hyperv1.HostedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   controlPlaneNamespace,
+				Name:        hostedClusterName,
+				Annotations: map[string]string{hostedClusterAnnotation: namespace + "/" + hostedClusterName},
+			},
+			Status: hyperv1.HostedControlPlaneStatus{
+				ControlPlaneEndpoint: hyperv1.APIEndpoint{Host: "api.example.hypershift.local", Port: 6443},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: controlPlaneNamespace, Name: pkiSecretName},
+			Data:       pkiData,
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: controlPlaneNamespace, Name: kubeAPIServerConfigMapName},
+			Data:       map[string]string{kubeAPIServerClientCAKey: string(pkiData["kubeconfig-signer.crt"])},
+		},
+	}
+	for _, kubeconfig := range kubeconfigs {
+		objects = append(objects, kubeconfig)
+	}
+	f := &fixture{
+		client: fake.NewFakeClientWithScheme(hyperapi.Scheme, objects...),
+		now:    time.Now(),
+	}
+	f.r = &HostedClusterKubeconfigReconciler{
+		Client: f.client,
+		Log:    ctrl.Log.WithName("hostedclusterkubeconfig-test"),
+		now:    func() time.Time { return f.now },
+	}
+	return f
+}
+
+func newKubeconfig(name, user string, groups ...string) *hyperv1.HostedClusterKubeconfig {
+	return &hyperv1.HostedClusterKubeconfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: types.UID("uid-" + name)},
+		Spec: hyperv1.HostedClusterKubeconfigSpec{
+			HostedCluster: hostedClusterName,
+			User:          user,
+			Groups:        groups,
+			Validity:      metav1.Duration{Duration: time.Hour},
+		},
+	}
+}
+
+func (f *fixture) reconcile(t *testing.T, name string) ctrl.Result {
+	result, err := f.r.Reconcile(context.Background(), ctrl.Request{NamespacedName: ctrlclient.ObjectKey{Namespace: namespace, Name: name}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return result
+}
+
+func (f *fixture) kubeconfig(t *testing.T, name string) *hyperv1.HostedClusterKubeconfig {
+	kubeconfig := &hyperv1.HostedClusterKubeconfig{}
+	if err := f.client.Get(context.Background(), ctrlclient.ObjectKey{Namespace: namespace, Name: name}, kubeconfig); err != nil {
+		t.Fatalf("failed to get kubeconfig %s: %v", name, err)
+	}
+	return kubeconfig
+}
+
+func (f *fixture) signer(t *testing.T) *x509.Certificate {
+	secret := &corev1.Secret{}
+	if err := f.client.Get(context.Background(), ctrlclient.ObjectKey{Namespace: controlPlaneNamespace, Name: pkiSecretName}, secret); err != nil {
+		t.Fatalf("failed to get pki secret: %v", err)
+	}
+	cert, err := certs.PemToCertificate(secret.Data["kubeconfig-signer.crt"])
+	if err != nil {
+		t.Fatalf("failed to parse kubeconfig signer: %v", err)
+	}
+	return cert
+}
+
+// rotateSigner rotates the kubeconfig signer the way the control plane
+// operator does when the rotation requested in the control plane changes.
+func (f *fixture) rotateSigner(t *testing.T) {
+	secret := &corev1.Secret{}
+	if err := f.client.Get(context.Background(), ctrlclient.ObjectKey{Namespace: controlPlaneNamespace, Name: pkiSecretName}, secret); err != nil {
+		t.Fatalf("failed to get pki secret: %v", err)
+	}
+	signer, err := certs.GenerateCA("kubeconfig-signer", "openshift")
+	if err != nil {
+		t.Fatalf("failed to rotate kubeconfig signer: %v", err)
+	}
+	secret.Data["kubeconfig-signer.crt"], secret.Data["kubeconfig-signer.key"] = signer.Serialize()
+	secret.Data[kubeAPIServerClientCAKey] = secret.Data["kubeconfig-signer.crt"]
+	if err := f.client.Update(context.Background(), secret); err != nil {
+		t.Fatalf("failed to update pki secret: %v", err)
+	}
+}
+
+// applyClientCA updates the client CA bundle of the kube-apiserver from the
+// pki secret, as the control plane operator does when it applies the control
+// plane.
+func (f *fixture) applyClientCA(t *testing.T) {
+	secret := &corev1.Secret{}
+	if err := f.client.Get(context.Background(), ctrlclient.ObjectKey{Namespace: controlPlaneNamespace, Name: pkiSecretName}, secret); err != nil {
+		t.Fatalf("failed to get pki secret: %v", err)
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := f.client.Get(context.Background(), ctrlclient.ObjectKey{Namespace: controlPlaneNamespace, Name: kubeAPIServerConfigMapName}, configMap); err != nil {
+		t.Fatalf("failed to get kube-apiserver configmap: %v", err)
+	}
+	configMap.Data[kubeAPIServerClientCAKey] = string(secret.Data[kubeAPIServerClientCAKey])
+	if err := f.client.Update(context.Background(), configMap); err != nil {
+		t.Fatalf("failed to update kube-apiserver configmap: %v", err)
+	}
+}
+
+// clientCert returns the client certificate of an issued kubeconfig, or nil
+// if its secret doesn't exist.
+func (f *fixture) clientCert(t *testing.T, name string) *x509.Certificate {
+	secret := &corev1.Secret{}
+	err := f.client.Get(context.Background(), ctrlclient.ObjectKey{Namespace: namespace, Name: name + "-kubeconfig"}, secret)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		t.Fatalf("failed to get kubeconfig secret: %v", err)
+	}
+	config, err := clientcmd.Load(secret.Data[kubeconfigKey])
+	if err != nil {
+		t.Fatalf("failed to load kubeconfig: %v", err)
+	}
+	for _, authInfo := range config.AuthInfos {
+		cert, err := certs.PemToCertificate(authInfo.ClientCertificateData)
+		if err != nil {
+			t.Fatalf("failed to parse client certificate: %v", err)
+		}
+		return cert
+	}
+	t.Fatalf("kubeconfig %s has no user", name)
+	return nil
+}
+
+func signedBy(cert, signer *x509.Certificate) bool {
+	return cert.CheckSignatureFrom(signer) == nil
+}
+
+func TestReconcileIssuesKubeconfig(t *testing.T) {
+	f := newFixture(t, newKubeconfig("admin", "admin", "system:masters", "developers"))
+
+	result := f.reconcile(t, "admin")
+
+	cert := f.clientCert(t, "admin")
+	if cert == nil {
+		t.Fatalf("kubeconfig secret was not created")
+	}
+	assert.Equal(t, "admin", cert.Subject.CommonName)
+	assert.ElementsMatch(t, []string{"system:masters", "developers"}, cert.Subject.Organization)
+	assert.True(t, signedBy(cert, f.signer(t)), "client certificate should be signed by the kubeconfig signer")
+
+	kubeconfig := f.kubeconfig(t, "admin")
+	assert.Equal(t, &corev1.LocalObjectReference{Name: "admin-kubeconfig"}, kubeconfig.Status.KubeConfig)
+	assert.Equal(t, certs.Fingerprint(f.signer(t)), kubeconfig.Status.SignerFingerprint)
+	if assert.NotNil(t, kubeconfig.Status.ExpirationTime) {
+		assert.True(t, kubeconfig.Status.ExpirationTime.Time.Equal(cert.NotAfter.Truncate(time.Second)), "expiration time should be the end of the certificate validity")
+	}
+	assert.True(t, meta.IsStatusConditionTrue(kubeconfig.Status.Conditions, hyperv1.HostedClusterKubeconfigIssuedConditionType))
+	assert.InDelta(t, time.Hour.Seconds(), result.RequeueAfter.Seconds(), time.Minute.Seconds(), "should requeue when the certificate expires")
+}
+
+func TestReconcileRequeuesAtExpiry(t *testing.T) {
+	f := newFixture(t, newKubeconfig("admin", "admin"))
+	f.reconcile(t, "admin")
+	expiration := f.kubeconfig(t, "admin").Status.ExpirationTime.Time
+
+	f.now = expiration.Add(-10 * time.Minute)
+	result := f.reconcile(t, "admin")
+
+	assert.Equal(t, 10*time.Minute, result.RequeueAfter)
+	assert.NotNil(t, f.clientCert(t, "admin"), "kubeconfig should not be re-issued before it expires")
+}
+
+func TestReconcileDeletesExpiredKubeconfig(t *testing.T) {
+	f := newFixture(t, newKubeconfig("admin", "admin"))
+	f.reconcile(t, "admin")
+	expiration := f.kubeconfig(t, "admin").Status.ExpirationTime.Time
+
+	f.now = expiration
+	result := f.reconcile(t, "admin")
+
+	assert.Equal(t, ctrl.Result{}, result)
+	assert.Nil(t, f.clientCert(t, "admin"), "expired kubeconfig secret should be deleted")
+	kubeconfig := f.kubeconfig(t, "admin")
+	assert.Nil(t, kubeconfig.Status.KubeConfig)
+	assert.True(t, meta.IsStatusConditionTrue(kubeconfig.Status.Conditions, hyperv1.HostedClusterKubeconfigExpiredConditionType))
+	assert.True(t, meta.IsStatusConditionFalse(kubeconfig.Status.Conditions, hyperv1.HostedClusterKubeconfigIssuedConditionType))
+}
+
+func TestReconcileRevokesKubeconfig(t *testing.T) {
+	f := newFixture(t, newKubeconfig("admin", "admin"), newKubeconfig("developer", "developer"))
+	f.reconcile(t, "admin")
+	f.reconcile(t, "developer")
+	developerExpiration := f.kubeconfig(t, "developer").Status.ExpirationTime.Time
+
+	admin := f.kubeconfig(t, "admin")
+	admin.Spec.Revoked = true
+	if err := f.client.Update(context.Background(), admin); err != nil {
+		t.Fatalf("failed to revoke kubeconfig: %v", err)
+	}
+	result := f.reconcile(t, "admin")
+
+	assert.Equal(t, signerPollInterval, result.RequeueAfter, "should wait for the signer rotation")
+	assert.Nil(t, f.clientCert(t, "admin"), "revoked kubeconfig secret should be deleted")
+	hcp := &hyperv1.HostedControlPlane{}
+	if err := f.client.Get(context.Background(), ctrlclient.ObjectKey{Namespace: controlPlaneNamespace, Name: hostedClusterName}, hcp); err != nil {
+		t.Fatalf("failed to get hosted control plane: %v", err)
+	}
+	assert.Equal(t, "uid-admin", hcp.Spec.KubeconfigSignerRotation, "revocation should request a signer rotation")
+	revoked := meta.FindStatusCondition(f.kubeconfig(t, "admin").Status.Conditions, hyperv1.HostedClusterKubeconfigRevokedConditionType)
+	if assert.NotNil(t, revoked) {
+		assert.Equal(t, metav1.ConditionFalse, revoked.Status)
+		assert.Equal(t, hyperv1.HostedClusterKubeconfigSignerRotatingReason, revoked.Reason)
+	}
+
+	oldSigner := f.signer(t)
+	f.rotateSigner(t)
+	newSigner := f.signer(t)
+
+	// The other kubeconfigs of the cluster are re-issued with the new signer
+	// and keep their expiration
+	assert.True(t, signedBy(f.clientCert(t, "developer"), oldSigner))
+	f.reconcile(t, "developer")
+	developer := f.kubeconfig(t, "developer")
+	assert.True(t, signedBy(f.clientCert(t, "developer"), newSigner), "kubeconfig should be re-issued with the new signer")
+	assert.Equal(t, certs.Fingerprint(newSigner), developer.Status.SignerFingerprint)
+	assert.WithinDuration(t, developerExpiration, developer.Status.ExpirationTime.Time, time.Second)
+
+	result = f.reconcile(t, "admin")
+	assert.Equal(t, signerPollInterval, result.RequeueAfter, "should wait for the kube-apiserver to stop trusting the old signer")
+	assert.Nil(t, f.clientCert(t, "admin"), "revoked kubeconfig should not be re-issued")
+	assert.True(t, meta.IsStatusConditionFalse(f.kubeconfig(t, "admin").Status.Conditions, hyperv1.HostedClusterKubeconfigRevokedConditionType), "old signer is still trusted")
+
+	f.applyClientCA(t)
+	f.reconcile(t, "admin")
+	assert.Nil(t, f.clientCert(t, "admin"), "revoked kubeconfig should not be re-issued")
+	assert.True(t, meta.IsStatusConditionTrue(f.kubeconfig(t, "admin").Status.Conditions, hyperv1.HostedClusterKubeconfigRevokedConditionType))
+}
+
+func TestReconcileRevocationWaitsForClientCA(t *testing.T) {
+	f := newFixture(t, newKubeconfig("admin", "admin"))
+	f.reconcile(t, "admin")
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: controlPlaneNamespace, Name: kubeAPIServerConfigMapName}}
+	if err := f.client.Delete(context.Background(), configMap); err != nil {
+		t.Fatalf("failed to delete kube-apiserver configmap: %v", err)
+	}
+
+	admin := f.kubeconfig(t, "admin")
+	admin.Spec.Revoked = true
+	if err := f.client.Update(context.Background(), admin); err != nil {
+		t.Fatalf("failed to revoke kubeconfig: %v", err)
+	}
+	f.reconcile(t, "admin")
+	f.rotateSigner(t)
+	result := f.reconcile(t, "admin")
+
+	assert.Equal(t, signerPollInterval, result.RequeueAfter)
+	revoked := meta.FindStatusCondition(f.kubeconfig(t, "admin").Status.Conditions, hyperv1.HostedClusterKubeconfigRevokedConditionType)
+	if assert.NotNil(t, revoked) {
+		assert.Equal(t, metav1.ConditionFalse, revoked.Status, "revocation can't be verified without the client CA bundle")
+	}
+}
+
+func TestEnqueueForPKISecret(t *testing.T) {
+	f := newFixture(t, newKubeconfig("admin", "admin"), newKubeconfig("developer", "developer"))
+	other := newKubeconfig("other", "admin")
+	other.Spec.HostedCluster = "other"
+	if err := f.client.Create(context.Background(), other); err != nil {
+		t.Fatalf("failed to create kubeconfig: %v", err)
+	}
+
+	requests := f.r.enqueueForPKISecret(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: controlPlaneNamespace, Name: pkiSecretName}})
+
+	var names []string
+	for _, request := range requests {
+		names = append(names, request.Name)
+	}
+	assert.ElementsMatch(t, []string{"admin", "developer"}, names)
+	assert.Empty(t, f.r.enqueueForPKISecret(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: controlPlaneNamespace, Name: "other"}}))
+}
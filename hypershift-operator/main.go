@@ -31,12 +31,15 @@ import (
 	hyperapi "openshift.io/hypershift/api"
 	"openshift.io/hypershift/hypershift-operator/controllers/externalinfracluster"
 	"openshift.io/hypershift/hypershift-operator/controllers/hostedcluster"
+	"openshift.io/hypershift/hypershift-operator/controllers/hostedclusterkubeconfig"
 	"openshift.io/hypershift/hypershift-operator/controllers/nodepool"
+	"openshift.io/hypershift/support/webhooks"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -71,6 +74,7 @@ func NewStartCommand() *cobra.Command {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var operatorImage string
+	var certDir string
 
 	cmd.Flags().StringVar(&namespace, "namespace", "hypershift", "The namespace this operator lives in")
 	cmd.Flags().StringVar(&deploymentName, "deployment-name", "operator", "The name of the deployment of this operator")
@@ -79,6 +83,7 @@ func NewStartCommand() *cobra.Command {
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 	cmd.Flags().StringVar(&operatorImage, "operator-image", "", "A control plane operator image to use (defaults to match this operator if running in a deployment)")
+	cmd.Flags().StringVar(&certDir, "cert-dir", "", "The directory with the serving certificate of the admission webhooks, which are disabled if unset")
 
 	cmd.Run = func(cmd *cobra.Command, args []string) {
 		ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
@@ -89,6 +94,7 @@ func NewStartCommand() *cobra.Command {
 			Port:               9443,
 			LeaderElection:     enableLeaderElection,
 			LeaderElectionID:   "b2ed43ca.hypershift.openshift.io",
+			CertDir:            certDir,
 			// Use a non-caching client everywhere. The default split client does not
 			// promise to invalidate the cache during writes (nor does it promise
 			// sequential create/get coherence), and we have code which (probably
@@ -161,6 +167,19 @@ func NewStartCommand() *cobra.Command {
 			os.Exit(1)
 		}
 
+		if err := (&hostedclusterkubeconfig.HostedClusterKubeconfigReconciler{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "HostedClusterKubeconfig")
+			os.Exit(1)
+		}
+
+		if len(certDir) > 0 {
+			mgr.GetWebhookServer().Register(webhooks.HostedClusterValidatingPath, &webhook.Admission{Handler: &hostedcluster.HostedClusterValidator{}})
+		} else {
+			setupLog.Info("no serving certificate directory given, admission webhooks are disabled")
+		}
+
 		// +kubebuilder:scaffold:builder
 
 		setupLog.Info("starting manager")
@@ -24,6 +24,7 @@ import (
 
 	createcmd "openshift.io/hypershift/cmd/create"
 	installcmd "openshift.io/hypershift/cmd/install"
+	rendercmd "openshift.io/hypershift/cmd/render"
 )
 
 func main() {
@@ -36,6 +37,7 @@ func main() {
 	}
 	cmd.AddCommand(installcmd.NewCommand())
 	cmd.AddCommand(createcmd.NewCommand())
+	cmd.AddCommand(rendercmd.NewCommand())
 
 	if err := cmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
@@ -1,4 +1,6 @@
-package util
+// Package certs generates and parses the CAs, certificates and kubeconfigs
+// of hosted control planes.
+package certs
 
 import (
 	"bytes"
@@ -18,6 +20,11 @@ type CAList []*CA
 
 // GenerateCA generates a CA key pair with the given filename
 func GenerateCA(commonName, organizationalUnit string) (*CA, error) {
+	return DefaultSource.GenerateCA(commonName, organizationalUnit)
+}
+
+// GenerateCA generates a CA key pair from the source
+func (s *Source) GenerateCA(commonName, organizationalUnit string) (*CA, error) {
 	cfg := &CertCfg{
 		Subject:      pkix.Name{CommonName: commonName, OrganizationalUnit: []string{organizationalUnit}},
 		KeyUsages:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
@@ -26,7 +33,7 @@ func GenerateCA(commonName, organizationalUnit string) (*CA, error) {
 		IsCA:         true,
 	}
 
-	key, crt, err := GenerateSelfSignedCertificate(cfg)
+	key, crt, err := s.GenerateSelfSignedCertificate(cfg)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to generate CA with cn=%s,ou=%s", commonName, organizationalUnit)
 	}
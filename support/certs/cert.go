@@ -0,0 +1,79 @@
+package certs
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func GenerateCert(commonName, organization string, hostNames, addresses []string, ca *CA) (*Cert, error) {
+	return DefaultSource.GenerateCert(commonName, organization, hostNames, addresses, ca)
+}
+
+// GenerateCert generates a serving and client certificate signed by the CA
+// from the source.
+func (s *Source) GenerateCert(commonName, organization string, hostNames, addresses []string, ca *CA) (*Cert, error) {
+	ipAddr := []net.IP{}
+	for _, ip := range addresses {
+		ipAddr = append(ipAddr, net.ParseIP(ip))
+	}
+	cfg := &CertCfg{
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{organization}},
+		KeyUsages:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		Validity:     ValidityOneYear,
+		DNSNames:     hostNames,
+		IPAddresses:  ipAddr,
+	}
+	key, crt, err := s.GenerateSignedCertificate(ca.Key, ca.Cert, cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate signed certificate for cn=%s,o=%s", commonName, organization)
+	}
+	return &Cert{
+		Parent: ca,
+		Key:    key,
+		Cert:   crt,
+	}, nil
+}
+
+// GenerateClientCert generates a client certificate for the given user and
+// groups that is valid for the given duration.
+func GenerateClientCert(user string, groups []string, validity time.Duration, ca *CA) (*Cert, error) {
+	return DefaultSource.GenerateClientCert(user, groups, validity, ca)
+}
+
+// GenerateClientCert generates a client certificate for the given user and
+// groups from the source.
+func (s *Source) GenerateClientCert(user string, groups []string, validity time.Duration, ca *CA) (*Cert, error) {
+	cfg := &CertCfg{
+		Subject:      pkix.Name{CommonName: user, Organization: groups},
+		KeyUsages:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		Validity:     validity,
+	}
+	key, crt, err := s.GenerateSignedCertificate(ca.Key, ca.Cert, cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate client certificate for cn=%s,o=%v", user, groups)
+	}
+	return &Cert{
+		Parent: ca,
+		Key:    key,
+		Cert:   crt,
+	}, nil
+}
+
+type Cert struct {
+	Parent *CA
+	Key    *rsa.PrivateKey
+	Cert   *x509.Certificate
+}
+
+func (c *Cert) Serialize() ([]byte, []byte) {
+	certBytes := CertToPem(c.Cert)
+	keyBytes := PrivateKeyToPem(c.Key)
+	return certBytes, keyBytes
+}
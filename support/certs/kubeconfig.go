@@ -1,4 +1,4 @@
-package util
+package certs
 
 import (
 	"bytes"
@@ -8,7 +8,13 @@ import (
 )
 
 func GenerateKubeconfig(serverAddress, commonName, organization string, rootCA, signingCA *CA) (*Kubeconfig, error) {
-	cert, err := GenerateCert(commonName, organization, nil, nil, signingCA)
+	return DefaultSource.GenerateKubeconfig(serverAddress, commonName, organization, rootCA, signingCA)
+}
+
+// GenerateKubeconfig generates a kubeconfig with a client certificate signed
+// by the signing CA from the source.
+func (s *Source) GenerateKubeconfig(serverAddress, commonName, organization string, rootCA, signingCA *CA) (*Kubeconfig, error) {
+	cert, err := s.GenerateCert(commonName, organization, nil, nil, signingCA)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,61 @@
+package certs
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	mathrand "math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Source provides the randomness and the current time that keys and
+// certificates are generated from.
+type Source struct {
+	Rand io.Reader
+	Now  func() time.Time
+
+	// keys are handed out in order instead of generating private keys, when
+	// the source was created from fixture keys
+	keys []*rsa.PrivateKey
+}
+
+// DefaultSource generates keys from a cryptographically secure random number
+// generator and certificates that are valid from the current time.
+var DefaultSource = &Source{
+	Rand: rand.Reader,
+	Now:  time.Now,
+}
+
+// NewFixtureSource returns a source that hands out the private keys of a PEM
+// file in order and generates the same certificates for the same keys, seed
+// and time. Its keys are published, so it must only be used to generate test
+// data.
+func NewFixtureSource(keysFile string, seed int64, now time.Time) (*Source, error) {
+	data, err := ioutil.ReadFile(keysFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read fixture keys")
+	}
+	keys := []*rsa.PrivateKey{}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse fixture key %d", len(keys))
+		}
+		keys = append(keys, key)
+	}
+	return &Source{
+		Rand: mathrand.New(mathrand.NewSource(seed)),
+		Now:  func() time.Time { return now },
+		keys: keys,
+	}, nil
+}
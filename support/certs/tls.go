@@ -1,4 +1,4 @@
-package util
+package certs
 
 import (
 	"crypto"
@@ -7,10 +7,12 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha1"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/pem"
 	"math"
 	"math/big"
@@ -47,12 +49,18 @@ type rsaPublicKey struct {
 
 // GenerateSelfSignedCertificate generates a key/cert pair defined by CertCfg.
 func GenerateSelfSignedCertificate(cfg *CertCfg) (*rsa.PrivateKey, *x509.Certificate, error) {
-	key, err := PrivateKey()
+	return DefaultSource.GenerateSelfSignedCertificate(cfg)
+}
+
+// GenerateSelfSignedCertificate generates a key/cert pair defined by CertCfg
+// from the source.
+func (s *Source) GenerateSelfSignedCertificate(cfg *CertCfg) (*rsa.PrivateKey, *x509.Certificate, error) {
+	key, err := s.PrivateKey()
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to generate private key")
 	}
 
-	crt, err := SelfSignedCertificate(cfg, key)
+	crt, err := s.SelfSignedCertificate(cfg, key)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to create self-signed certificate")
 	}
@@ -62,16 +70,23 @@ func GenerateSelfSignedCertificate(cfg *CertCfg) (*rsa.PrivateKey, *x509.Certifi
 // GenerateSignedCertificate generate a key and cert defined by CertCfg and signed by CA.
 func GenerateSignedCertificate(caKey *rsa.PrivateKey, caCert *x509.Certificate,
 	cfg *CertCfg) (*rsa.PrivateKey, *x509.Certificate, error) {
+	return DefaultSource.GenerateSignedCertificate(caKey, caCert, cfg)
+}
+
+// GenerateSignedCertificate generate a key and cert defined by CertCfg and
+// signed by CA from the source.
+func (s *Source) GenerateSignedCertificate(caKey *rsa.PrivateKey, caCert *x509.Certificate,
+	cfg *CertCfg) (*rsa.PrivateKey, *x509.Certificate, error) {
 
 	// create a private key
-	key, err := PrivateKey()
+	key, err := s.PrivateKey()
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to generate private key")
 	}
 
 	// create a CSR
 	csrTmpl := x509.CertificateRequest{Subject: cfg.Subject, DNSNames: cfg.DNSNames, IPAddresses: cfg.IPAddresses}
-	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &csrTmpl, key)
+	csrBytes, err := x509.CreateCertificateRequest(s.Rand, &csrTmpl, key)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to create certificate request")
 	}
@@ -81,7 +96,7 @@ func GenerateSignedCertificate(caKey *rsa.PrivateKey, caCert *x509.Certificate,
 	}
 
 	// create a cert
-	cert, err := SignedCertificate(cfg, csr, key, caCert, caKey)
+	cert, err := s.SignedCertificate(cfg, csr, key, caCert, caKey)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to create a signed certificate")
 	}
@@ -90,7 +105,21 @@ func GenerateSignedCertificate(caKey *rsa.PrivateKey, caCert *x509.Certificate,
 
 // PrivateKey generates an RSA Private key and returns the value
 func PrivateKey() (*rsa.PrivateKey, error) {
-	rsaKey, err := rsa.GenerateKey(rand.Reader, keySize)
+	return DefaultSource.PrivateKey()
+}
+
+// PrivateKey generates an RSA Private key from the source, or returns its
+// next fixture key
+func (s *Source) PrivateKey() (*rsa.PrivateKey, error) {
+	if s.keys != nil {
+		if len(s.keys) == 0 {
+			return nil, errors.Errorf("ran out of fixture keys")
+		}
+		key := s.keys[0]
+		s.keys = s.keys[1:]
+		return key, nil
+	}
+	rsaKey, err := rsa.GenerateKey(s.Rand, keySize)
 	if err != nil {
 		return nil, errors.Wrap(err, "error generating RSA private key")
 	}
@@ -100,16 +129,22 @@ func PrivateKey() (*rsa.PrivateKey, error) {
 
 // SelfSignedCertificate creates a self signed certificate
 func SelfSignedCertificate(cfg *CertCfg, key *rsa.PrivateKey) (*x509.Certificate, error) {
-	serial, err := rand.Int(rand.Reader, new(big.Int).SetInt64(math.MaxInt64))
+	return DefaultSource.SelfSignedCertificate(cfg, key)
+}
+
+// SelfSignedCertificate creates a self signed certificate from the source
+func (s *Source) SelfSignedCertificate(cfg *CertCfg, key *rsa.PrivateKey) (*x509.Certificate, error) {
+	serial, err := rand.Int(s.Rand, new(big.Int).SetInt64(math.MaxInt64))
 	if err != nil {
 		return nil, err
 	}
+	now := s.Now()
 	cert := x509.Certificate{
 		BasicConstraintsValid: true,
 		IsCA:                  cfg.IsCA,
 		KeyUsage:              cfg.KeyUsages,
-		NotAfter:              time.Now().Add(cfg.Validity),
-		NotBefore:             time.Now(),
+		NotAfter:              now.Add(cfg.Validity),
+		NotBefore:             now,
 		SerialNumber:          serial,
 		Subject:               cfg.Subject,
 	}
@@ -122,7 +157,7 @@ func SelfSignedCertificate(cfg *CertCfg, key *rsa.PrivateKey) (*x509.Certificate
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to set subject key identifier")
 	}
-	certBytes, err := x509.CreateCertificate(rand.Reader, &cert, &cert, key.Public(), key)
+	certBytes, err := x509.CreateCertificate(s.Rand, &cert, &cert, key.Public(), key)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create certificate")
 	}
@@ -137,7 +172,19 @@ func SignedCertificate(
 	caCert *x509.Certificate,
 	caKey *rsa.PrivateKey,
 ) (*x509.Certificate, error) {
-	serial, err := rand.Int(rand.Reader, new(big.Int).SetInt64(math.MaxInt64))
+	return DefaultSource.SignedCertificate(cfg, csr, key, caCert, caKey)
+}
+
+// SignedCertificate creates a new X.509 certificate based on a template from
+// the source.
+func (s *Source) SignedCertificate(
+	cfg *CertCfg,
+	csr *x509.CertificateRequest,
+	key *rsa.PrivateKey,
+	caCert *x509.Certificate,
+	caKey *rsa.PrivateKey,
+) (*x509.Certificate, error) {
+	serial, err := rand.Int(s.Rand, new(big.Int).SetInt64(math.MaxInt64))
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +194,7 @@ func SignedCertificate(
 		ExtKeyUsage:           cfg.ExtKeyUsages,
 		IPAddresses:           csr.IPAddresses,
 		KeyUsage:              cfg.KeyUsages,
-		NotAfter:              time.Now().Add(cfg.Validity),
+		NotAfter:              s.Now().Add(cfg.Validity),
 		NotBefore:             caCert.NotBefore,
 		SerialNumber:          serial,
 		Subject:               csr.Subject,
@@ -160,7 +207,7 @@ func SignedCertificate(
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to set subject key identifier")
 	}
-	certBytes, err := x509.CreateCertificate(rand.Reader, &certTmpl, caCert, key.Public(), caKey)
+	certBytes, err := x509.CreateCertificate(s.Rand, &certTmpl, caCert, key.Public(), caKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create x509 certificate")
 	}
@@ -258,3 +305,9 @@ func PemToCertificate(data []byte) (*x509.Certificate, error) {
 func Base64(data []byte) string {
 	return base64.StdEncoding.EncodeToString(data)
 }
+
+// Fingerprint returns the hex encoded SHA-256 fingerprint of a certificate.
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
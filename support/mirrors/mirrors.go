@@ -0,0 +1,75 @@
+// Package mirrors resolves images on the mirrors of their repositories, like
+// ImageContentSourcePolicies do for the nodes of a cluster.
+package mirrors
+
+import (
+	"sort"
+	"strings"
+)
+
+// ImageContentSource is a repository and the repositories that mirror it, in
+// order of preference.
+type ImageContentSource struct {
+	Source  string   `json:"source"`
+	Mirrors []string `json:"mirrors,omitempty"`
+}
+
+// MirroredImage returns the pull spec of an image on the first mirror of its
+// repository. Like ImageContentSourcePolicies, only images referred to by
+// digest are mirrored, since a tag may point at different images on a source
+// and its mirrors. Images without a mirror are returned as they are.
+func MirroredImage(image string, sources []ImageContentSource) string {
+	i := strings.Index(image, "@")
+	if i < 0 {
+		return image
+	}
+	repository, digest := image[:i], image[i:]
+	var match *ImageContentSource
+	for j := range sources {
+		source := &sources[j]
+		if len(source.Mirrors) == 0 {
+			continue
+		}
+		if repository != source.Source && !strings.HasPrefix(repository, source.Source+"/") {
+			continue
+		}
+		// The most specific source wins
+		if match == nil || len(source.Source) > len(match.Source) {
+			match = source
+		}
+	}
+	if match == nil {
+		return image
+	}
+	return match.Mirrors[0] + strings.TrimPrefix(repository, match.Source) + digest
+}
+
+// MirroredImages returns the pull specs of component images on the first
+// mirror of their repositories.
+func MirroredImages(images map[string]string, sources []ImageContentSource) map[string]string {
+	if len(sources) == 0 {
+		return images
+	}
+	mirrored := make(map[string]string, len(images))
+	for component, image := range images {
+		mirrored[component] = MirroredImage(image, sources)
+	}
+	return mirrored
+}
+
+// UnmirroredImages returns the components whose images are referenced by tag
+// while mirrors are configured. They are pulled from their source, which is
+// unreachable in disconnected installs.
+func UnmirroredImages(images map[string]string, sources []ImageContentSource) []string {
+	if len(sources) == 0 {
+		return nil
+	}
+	var components []string
+	for component, image := range images {
+		if !strings.Contains(image, "@") {
+			components = append(components, component)
+		}
+	}
+	sort.Strings(components)
+	return components
+}
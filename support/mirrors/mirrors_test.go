@@ -0,0 +1,56 @@
+package mirrors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMirroredImage(t *testing.T) {
+	sources := []ImageContentSource{
+		{Source: "quay.io/openshift-release-dev", Mirrors: []string{"mirror.example.com/release"}},
+		{Source: "quay.io/openshift-release-dev/ocp-v4.0-art-dev", Mirrors: []string{"mirror.example.com/art", "backup.example.com/art"}},
+		{Source: "registry.example.com/unmirrored"},
+	}
+	tests := []struct {
+		image    string
+		expected string
+	}{
+		{
+			image:    "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:abc",
+			expected: "mirror.example.com/art@sha256:abc",
+		},
+		{
+			image:    "quay.io/openshift-release-dev/ocp-release@sha256:abc",
+			expected: "mirror.example.com/release/ocp-release@sha256:abc",
+		},
+		{
+			image:    "quay.io/openshift-release-dev/ocp-release:4.7.0-x86_64",
+			expected: "quay.io/openshift-release-dev/ocp-release:4.7.0-x86_64",
+		},
+		{
+			image:    "quay.io/openshift-release-dev-other/ocp-release@sha256:abc",
+			expected: "quay.io/openshift-release-dev-other/ocp-release@sha256:abc",
+		},
+		{
+			image:    "registry.example.com/unmirrored/image@sha256:abc",
+			expected: "registry.example.com/unmirrored/image@sha256:abc",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.image, func(t *testing.T) {
+			assert.Equal(t, test.expected, MirroredImage(test.image, sources))
+		})
+	}
+}
+
+func TestUnmirroredImages(t *testing.T) {
+	images := map[string]string{
+		"etcd":          "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:abc",
+		"openvpn":       "quay.io/hypershift/openvpn:latest",
+		"etcd-operator": "quay.io/coreos/etcd-operator:v0.9.4",
+	}
+	assert.Empty(t, UnmirroredImages(images, nil), "images are pulled from their source without mirrors")
+	sources := []ImageContentSource{{Source: "quay.io/openshift-release-dev", Mirrors: []string{"mirror.example.com/release"}}}
+	assert.Equal(t, []string{"etcd-operator", "openvpn"}, UnmirroredImages(images, sources))
+}
@@ -0,0 +1,168 @@
+// Package scheduling applies the scheduling constraints of a hosted control
+// plane to the pods of its components.
+package scheduling
+
+import (
+	"bytes"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+const zoneTopologyKey = "topology.kubernetes.io/zone"
+
+// ApplyToPodSpec adds the scheduling constraints of a control plane to the
+// spec of a component's pods. The selector selects the replicas of the
+// component, which are spread across zones when requested.
+func ApplyToPodSpec(spec *corev1.PodSpec, scheduling *hyperv1.ControlPlaneScheduling, selector *metav1.LabelSelector) {
+	if scheduling == nil {
+		return
+	}
+	for key, value := range scheduling.NodeSelector {
+		if spec.NodeSelector == nil {
+			spec.NodeSelector = map[string]string{}
+		}
+		spec.NodeSelector[key] = value
+	}
+	for i := range scheduling.Tolerations {
+		toleration := scheduling.Tolerations[i]
+		if !hasToleration(spec.Tolerations, &toleration) {
+			spec.Tolerations = append(spec.Tolerations, toleration)
+		}
+	}
+	if len(scheduling.PriorityClassName) > 0 {
+		spec.PriorityClassName = scheduling.PriorityClassName
+	}
+	if scheduling.SpreadAcrossZones && selector != nil && !hasTopologySpreadConstraint(spec.TopologySpreadConstraints, zoneTopologyKey) {
+		// Single zone management clusters can still run the replicas
+		spec.TopologySpreadConstraints = append(spec.TopologySpreadConstraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       zoneTopologyKey,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     selector.DeepCopy(),
+		})
+	}
+}
+
+func hasToleration(tolerations []corev1.Toleration, toleration *corev1.Toleration) bool {
+	for _, t := range tolerations {
+		if t.MatchToleration(toleration) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasTopologySpreadConstraint(constraints []corev1.TopologySpreadConstraint, topologyKey string) bool {
+	for _, constraint := range constraints {
+		if constraint.TopologyKey == topologyKey {
+			return true
+		}
+	}
+	return false
+}
+
+// schedulingFields are the fields of a pod spec ApplyToPodSpec sets.
+var schedulingFields = []string{"nodeSelector", "tolerations", "priorityClassName", "topologySpreadConstraints"}
+
+// ApplyToManifests adds the scheduling constraints of a control plane to the
+// pods of the Deployments, StatefulSets, Pods and etcd clusters among rendered
+// manifests. Other manifests are left as they are.
+func ApplyToManifests(manifests map[string][]byte, scheduling *hyperv1.ControlPlaneScheduling) error {
+	if scheduling == nil {
+		return nil
+	}
+	for name, manifest := range manifests {
+		obj := &unstructured.Unstructured{}
+		if err := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 100).Decode(obj); err != nil {
+			return fmt.Errorf("failed to decode manifest %s: %w", name, err)
+		}
+		applied, err := applyToObject(obj, scheduling)
+		if err != nil {
+			return fmt.Errorf("failed to apply control plane scheduling to manifest %s: %w", name, err)
+		}
+		if !applied {
+			continue
+		}
+		data, err := sigsyaml.Marshal(obj.Object)
+		if err != nil {
+			return fmt.Errorf("failed to encode manifest %s: %w", name, err)
+		}
+		manifests[name] = data
+	}
+	return nil
+}
+
+// ApplyToObject adds the scheduling constraints of a control plane to the pods
+// of a Deployment, StatefulSet, Pod or etcd cluster built as an unstructured
+// object. Other objects are left as they are.
+func ApplyToObject(obj *unstructured.Unstructured, scheduling *hyperv1.ControlPlaneScheduling) error {
+	if scheduling == nil {
+		return nil
+	}
+	_, err := applyToObject(obj, scheduling)
+	return err
+}
+
+func applyToObject(obj *unstructured.Unstructured, scheduling *hyperv1.ControlPlaneScheduling) (bool, error) {
+	switch obj.GetKind() {
+	case "Deployment", "StatefulSet":
+		selector := &metav1.LabelSelector{}
+		if err := nestedObject(obj, selector, "spec", "selector"); err != nil {
+			return false, err
+		}
+		return true, applyToNestedPodSpec(obj, scheduling, selector, "spec", "template", "spec")
+	case "Pod":
+		return true, applyToNestedPodSpec(obj, scheduling, nil, "spec")
+	case "EtcdCluster":
+		// The pod policy of the etcd operator only has a node selector and
+		// tolerations
+		etcdScheduling := &hyperv1.ControlPlaneScheduling{
+			NodeSelector: scheduling.NodeSelector,
+			Tolerations:  scheduling.Tolerations,
+		}
+		return true, applyToNestedPodSpec(obj, etcdScheduling, nil, "spec", "pod")
+	}
+	return false, nil
+}
+
+// applyToNestedPodSpec applies scheduling constraints to the pod spec at a
+// path of an object, leaving the fields it doesn't set untouched.
+func applyToNestedPodSpec(obj *unstructured.Unstructured, scheduling *hyperv1.ControlPlaneScheduling, selector *metav1.LabelSelector, path ...string) error {
+	spec := &corev1.PodSpec{}
+	if err := nestedObject(obj, spec, path...); err != nil {
+		return err
+	}
+	ApplyToPodSpec(spec, scheduling, selector)
+	fields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(spec)
+	if err != nil {
+		return err
+	}
+	for _, field := range schedulingFields {
+		value, ok := fields[field]
+		if !ok {
+			continue
+		}
+		if err := unstructured.SetNestedField(obj.Object, value, append(path, field)...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nestedObject converts the object at a path into out. The path doesn't have
+// to exist.
+func nestedObject(obj *unstructured.Unstructured, out interface{}, path ...string) error {
+	nested, _, err := unstructured.NestedMap(obj.Object, path...)
+	if err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(nested, out)
+}
@@ -0,0 +1,129 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "openshift.io/hypershift/api/v1alpha1"
+)
+
+var testScheduling = &hyperv1.ControlPlaneScheduling{
+	NodeSelector: map[string]string{"hypershift.openshift.io/control-plane": "true"},
+	Tolerations: []corev1.Toleration{
+		{Key: "hypershift.openshift.io/control-plane", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	},
+	PriorityClassName: "hypershift-control-plane",
+	SpreadAcrossZones: true,
+}
+
+func TestApplyToPodSpec(t *testing.T) {
+	spec := &corev1.PodSpec{
+		NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+		Tolerations: []corev1.Toleration{
+			{Key: "node-role.kubernetes.io/master", Effect: corev1.TaintEffectNoSchedule},
+		},
+	}
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "kube-apiserver"}}
+	ApplyToPodSpec(spec, testScheduling, selector)
+	// Applying twice doesn't add constraints twice
+	ApplyToPodSpec(spec, testScheduling, selector)
+
+	assert.Equal(t, map[string]string{"kubernetes.io/os": "linux", "hypershift.openshift.io/control-plane": "true"}, spec.NodeSelector)
+	assert.Len(t, spec.Tolerations, 2)
+	assert.Equal(t, "hypershift-control-plane", spec.PriorityClassName)
+	assert.Equal(t, []corev1.TopologySpreadConstraint{{
+		MaxSkew:           1,
+		TopologyKey:       zoneTopologyKey,
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+		LabelSelector:     selector,
+	}}, spec.TopologySpreadConstraints)
+}
+
+func TestApplyToManifests(t *testing.T) {
+	configMap := []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  key: value
+`)
+	manifests := map[string][]byte{
+		"config.yaml": configMap,
+		"deployment.yaml": []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: kube-scheduler
+spec:
+  selector:
+    matchLabels:
+      app: kube-scheduler
+  template:
+    metadata:
+      labels:
+        app: kube-scheduler
+    spec:
+      containers:
+      - name: kube-scheduler
+        image: hyperkube
+`),
+		"etcd-cluster.yaml": []byte(`apiVersion: etcd.database.coreos.com/v1beta2
+kind: EtcdCluster
+metadata:
+  name: etcd
+spec:
+  size: 1
+`),
+	}
+	if err := ApplyToManifests(manifests, testScheduling); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, string(configMap), string(manifests["config.yaml"]), "manifests without pods should be left as they are")
+	assert.Equal(t, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: kube-scheduler
+spec:
+  selector:
+    matchLabels:
+      app: kube-scheduler
+  template:
+    metadata:
+      labels:
+        app: kube-scheduler
+    spec:
+      containers:
+      - image: hyperkube
+        name: kube-scheduler
+      nodeSelector:
+        hypershift.openshift.io/control-plane: "true"
+      priorityClassName: hypershift-control-plane
+      tolerations:
+      - effect: NoSchedule
+        key: hypershift.openshift.io/control-plane
+        operator: Exists
+      topologySpreadConstraints:
+      - labelSelector:
+          matchLabels:
+            app: kube-scheduler
+        maxSkew: 1
+        topologyKey: topology.kubernetes.io/zone
+        whenUnsatisfiable: ScheduleAnyway
+`, string(manifests["deployment.yaml"]))
+	assert.Equal(t, `apiVersion: etcd.database.coreos.com/v1beta2
+kind: EtcdCluster
+metadata:
+  name: etcd
+spec:
+  pod:
+    nodeSelector:
+      hypershift.openshift.io/control-plane: "true"
+    tolerations:
+    - effect: NoSchedule
+      key: hypershift.openshift.io/control-plane
+      operator: Exists
+  size: 1
+`, string(manifests["etcd-cluster.yaml"]))
+}
@@ -0,0 +1,37 @@
+package supportedversion
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+)
+
+// The range of OCP minor versions the control plane operator can deploy.
+// Templates only branch with the atleast_version and lessthan_version helpers
+// on versions within this range, so it must be extended together with them.
+var (
+	MinSupportedVersion    = semver.MustParse("4.5.0")
+	LatestSupportedVersion = semver.MustParse("4.7.0")
+)
+
+// IsSupported returns an error if the minor version of a release is outside of
+// the range of supported versions.
+func IsSupported(version semver.Version) error {
+	minor := semver.Version{Major: version.Major, Minor: version.Minor}
+	if minor.LT(MinSupportedVersion) {
+		return fmt.Errorf("release version %s is older than the oldest supported version %s", version, minorString(MinSupportedVersion))
+	}
+	if minor.GT(LatestSupportedVersion) {
+		return fmt.Errorf("release version %s is newer than the latest supported version %s", version, minorString(LatestSupportedVersion))
+	}
+	return nil
+}
+
+// String describes the range of supported versions.
+func String() string {
+	return fmt.Sprintf("%s-%s", minorString(MinSupportedVersion), minorString(LatestSupportedVersion))
+}
+
+func minorString(version semver.Version) string {
+	return fmt.Sprintf("%d.%d", version.Major, version.Minor)
+}
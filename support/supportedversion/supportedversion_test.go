@@ -0,0 +1,31 @@
+package supportedversion
+
+import (
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSupported(t *testing.T) {
+	tests := []struct {
+		version   string
+		supported bool
+	}{
+		{version: "4.4.30", supported: false},
+		{version: "4.5.0", supported: true},
+		{version: "4.6.0-rc.4", supported: true},
+		{version: "4.7.12", supported: true},
+		{version: "4.8.0-fc.0", supported: false},
+		{version: "5.0.0", supported: false},
+	}
+	for _, test := range tests {
+		err := IsSupported(semver.MustParse(test.version))
+		if test.supported {
+			assert.NoError(t, err, test.version)
+		} else {
+			assert.Error(t, err, test.version)
+		}
+	}
+	assert.Equal(t, "4.5-4.7", String())
+}
@@ -0,0 +1,7 @@
+// Package webhooks holds the paths of the admission webhooks the hypershift
+// operator serves, which its installer registers.
+package webhooks
+
+// HostedClusterValidatingPath is the path the HostedCluster validating
+// admission webhook is served at.
+const HostedClusterValidatingPath = "/validate-hypershift-openshift-io-v1alpha1-hostedcluster"